@@ -385,7 +385,7 @@ func (in *AppService) GetAppDetails(ctx context.Context, criteria AppCriteria) (
 
 	appInstance.Workloads = make([]models.WorkloadItem, len(appDetails.Workloads))
 	for i, wkd := range appDetails.Workloads {
-		appInstance.Workloads[i] = models.WorkloadItem{WorkloadName: wkd.Name, WorkloadGVK: wkd.WorkloadGVK, IstioSidecar: wkd.IstioSidecar, Labels: wkd.Labels, IsAmbient: wkd.IsAmbient, ServiceAccountNames: wkd.Pods.ServiceAccounts(), WaypointWorkloads: wkd.WaypointWorkloads}
+		appInstance.Workloads[i] = models.WorkloadItem{WorkloadName: wkd.Name, WorkloadGVK: wkd.WorkloadGVK, IstioSidecar: wkd.IstioSidecar, Labels: wkd.Labels, Rollout: wkd.Rollout, IsAmbient: wkd.IsAmbient, ServiceAccountNames: wkd.Pods.ServiceAccounts(), WaypointWorkloads: wkd.WaypointWorkloads}
 	}
 
 	appInstance.ServiceNames = make([]string, len(appDetails.Services))
@@ -412,11 +412,12 @@ func (in *AppService) GetAppDetails(ctx context.Context, criteria AppCriteria) (
 	}
 	appInstance.IsAmbient = isAmbient
 	appInstance.Cluster = appDetails.cluster
+	appInstance.DeepLinks = models.GetDeepLinks(in.conf, models.DeepLinkKindApp, appDetails.cluster, criteria.Namespace, criteria.AppName)
 
 	return *appInstance, nil
 }
 
-// AppDetails holds Services and Workloads having the same "app" label
+// AppDetails holds Services and Workloads having the same canonical app
 type appDetails struct {
 	app       string
 	cluster   string
@@ -427,8 +428,8 @@ type appDetails struct {
 // NamespaceApps is a map of app_name and cluster x AppDetails
 type namespaceApps = map[string]*appDetails
 
-func castAppDetails(appLabel string, allEntities namespaceApps, ss *models.ServiceList, w *models.Workload, cluster string) {
-	if app, ok := w.Labels[appLabel]; ok {
+func castAppDetails(allEntities namespaceApps, ss *models.ServiceList, w *models.Workload, cluster string) {
+	if app, ok := models.WorkloadApp(w.Labels); ok {
 		if appEntities, ok := allEntities[app]; ok {
 			appEntities.Workloads = append(appEntities.Workloads, w)
 		} else {
@@ -458,6 +459,9 @@ func castAppDetails(appLabel string, allEntities namespaceApps, ss *models.Servi
 
 // Helper method to fetch all applications for a given namespace.
 // Optionally if appName parameter is provided, it filters apps for that name.
+// Workloads are grouped by their canonical app, so the app label is not required when
+// the app.kubernetes.io/name or the Istio canonical name labels are set. The Revisions of a
+// Knative Service are grouped under the Service.
 // Return an error on any problem.
 func (in *AppService) fetchNamespaceApps(ctx context.Context, namespace string, cluster string, appName string) (namespaceApps, error) {
 	var ss *models.ServiceList
@@ -480,8 +484,18 @@ func (in *AppService) fetchNamespaceApps(ctx context.Context, namespace string,
 	if err != nil {
 		return nil, err
 	}
+	// The app can be defined by the canonical or Knative labels instead of the app label
+	if appName != "" && !hasWorkloadApp(ws, appName) {
+		ws, err = in.businessLayer.Workload.fetchWorkloadsFromCluster(ctx, cluster, namespace, "")
+		if err != nil {
+			return nil, err
+		}
+	}
 	allEntities := make(namespaceApps)
 	for _, w := range ws {
+		if app, _ := models.WorkloadApp(w.Labels); appName != "" && app != appName {
+			continue
+		}
 		// WorkloadGroup.Labels can be empty
 		if len(w.Labels) > 0 {
 			// Check if namespace is cached
@@ -500,12 +514,22 @@ func (in *AppService) fetchNamespaceApps(ctx context.Context, namespace string,
 		} else {
 			ss = nil
 		}
-		castAppDetails(in.conf.IstioLabels.AppLabelName, allEntities, ss, w, cluster)
+		castAppDetails(allEntities, ss, w, cluster)
 	}
 
 	return allEntities, nil
 }
 
+// hasWorkloadApp returns whether any of the workloads belongs to the app.
+func hasWorkloadApp(ws models.Workloads, appName string) bool {
+	for _, w := range ws {
+		if app, _ := models.WorkloadApp(w.Labels); app == appName {
+			return true
+		}
+	}
+	return false
+}
+
 // GetAppTracingName returns the tracing app name
 // If the app has any Waypoint, the information is included, as it will be the search name used by the tracing backend
 func (in *AppService) GetAppTracingName(ctx context.Context, cluster, namespace, app string) models.TracingName {
@@ -0,0 +1,231 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	api_security_v1 "istio.io/api/security/v1"
+	api_type_v1beta1 "istio.io/api/type/v1beta1"
+	security_v1 "istio.io/client-go/pkg/apis/security/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const (
+	// DefaultAuthorizationPolicyTrafficWindow is the window used to look for the sources of a service when none is provided.
+	DefaultAuthorizationPolicyTrafficWindow = "1d"
+
+	// GeneratedAuthorizationPolicySuffix is appended to the name of the service to name its generated AuthorizationPolicy.
+	GeneratedAuthorizationPolicySuffix = "-allow-observed"
+)
+
+// GenerateAuthorizationPolicy generates the AuthorizationPolicy allowing only the sources observed calling the service
+// during the traffic window, by principal or by namespace, without applying it. Sources are identified by their mTLS
+// peer identity: the workloads calling the service without mTLS are reported but can't be allowed.
+func (in *SvcService) GenerateAuthorizationPolicy(ctx context.Context, cluster, namespace, service, granularity string, trafficWindow time.Duration) (*models.GeneratedAuthorizationPolicy, error) {
+	switch granularity {
+	case "":
+		granularity = models.AuthorizationPolicyGranularityPrincipal
+	case models.AuthorizationPolicyGranularityPrincipal, models.AuthorizationPolicyGranularityNamespace:
+	default:
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("invalid granularity [%s], expected [%s] or [%s]", granularity, models.AuthorizationPolicyGranularityPrincipal, models.AuthorizationPolicyGranularityNamespace))
+	}
+
+	// Checks the user access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := kubeCache.GetService(namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("service [%s] has no selector, its workloads are unknown", service))
+	}
+
+	generated := &models.GeneratedAuthorizationPolicy{
+		Cluster:         cluster,
+		Namespace:       namespace,
+		Service:         service,
+		TrafficWindow:   model.Duration(trafficWindow).String(),
+		Granularity:     granularity,
+		Sources:         []models.AuthorizationPolicySource{},
+		Unauthenticated: []string{},
+	}
+
+	// The destination proxy reports the principal of the mTLS peer
+	labels := fmt.Sprintf(`{reporter="destination",destination_cluster="%s",destination_service_namespace="%s",destination_service_name="%s"}`, cluster, namespace, service)
+	grouping := "source_principal,source_workload_namespace,source_workload"
+	now := time.Now()
+
+	sources := map[string]*models.AuthorizationPolicySource{}
+	sourceWorkloads := map[string]map[string]bool{}
+	unauthenticated := map[string]bool{}
+	for _, metricName := range []string{"istio_requests_total", "istio_tcp_connections_opened_total"} {
+		metric := in.prom.FetchIncrease(metricName, labels, grouping, now, trafficWindow)
+		if metric.Err != nil {
+			return nil, metric.Err
+		}
+		for _, stream := range metric.Matrix {
+			count := 0.0
+			for _, value := range stream.Values {
+				count += float64(value.Value)
+			}
+			if count <= 0 {
+				continue
+			}
+
+			workload := string(stream.Metric["source_workload_namespace"]) + "/" + string(stream.Metric["source_workload"])
+			principal := string(stream.Metric["source_principal"])
+			if principal == "" || principal == "unknown" {
+				unauthenticated[workload] = true
+				continue
+			}
+			// Istio telemetry reports SPIFFE IDs, AuthorizationPolicies match the identity without the scheme
+			principal = strings.TrimPrefix(principal, "spiffe://")
+
+			key := principal
+			if granularity == models.AuthorizationPolicyGranularityNamespace {
+				key = principalNamespace(principal)
+				if key == "" {
+					continue
+				}
+			}
+			source, found := sources[key]
+			if !found {
+				source = &models.AuthorizationPolicySource{Source: key}
+				sources[key] = source
+				sourceWorkloads[key] = map[string]bool{}
+			}
+			sourceWorkloads[key][workload] = true
+			if metricName == "istio_requests_total" {
+				source.Requests += count
+			} else {
+				source.TCPConnections += count
+			}
+		}
+	}
+	if len(sources) == 0 {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("no mTLS traffic to service [%s] during the last %s", service, generated.TrafficWindow))
+	}
+
+	allowed := make([]string, 0, len(sources))
+	for key, source := range sources {
+		allowed = append(allowed, key)
+		source.Workloads = make([]string, 0, len(sourceWorkloads[key]))
+		for workload := range sourceWorkloads[key] {
+			source.Workloads = append(source.Workloads, workload)
+		}
+		sort.Strings(source.Workloads)
+		generated.Sources = append(generated.Sources, *source)
+	}
+	sort.Strings(allowed)
+	sort.Slice(generated.Sources, func(i, j int) bool {
+		return generated.Sources[i].Source < generated.Sources[j].Source
+	})
+	for workload := range unauthenticated {
+		generated.Unauthenticated = append(generated.Unauthenticated, workload)
+	}
+	sort.Strings(generated.Unauthenticated)
+
+	name := service + GeneratedAuthorizationPolicySuffix
+	// The current spec is nil when the policy has not been generated yet
+	var current interface{}
+	existing, err := kubeCache.GetAuthorizationPolicy(ctx, namespace, name)
+	switch {
+	case err == nil:
+		current = existing.DeepCopy()
+		generated.Exists = true
+		generated.AuthorizationPolicy = existing
+	case api_errors.IsNotFound(err):
+		generated.AuthorizationPolicy = &security_v1.AuthorizationPolicy{
+			TypeMeta: meta_v1.TypeMeta{
+				APIVersion: kubernetes.AuthorizationPolicies.GroupVersion().String(),
+				Kind:       kubernetes.AuthorizationPolicies.Kind,
+			},
+			ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: namespace},
+		}
+	default:
+		return nil, err
+	}
+
+	from := &api_security_v1.Source{}
+	if granularity == models.AuthorizationPolicyGranularityNamespace {
+		from.Namespaces = allowed
+	} else {
+		from.Principals = allowed
+	}
+	spec := &generated.AuthorizationPolicy.Spec
+	spec.Selector = &api_type_v1beta1.WorkloadSelector{MatchLabels: svc.Spec.Selector}
+	spec.Action = api_security_v1.AuthorizationPolicy_ALLOW
+	spec.Rules = []*api_security_v1.Rule{{From: []*api_security_v1.Rule_From{{Source: from}}}}
+
+	generated.Changes, err = diffObjectSpecs(current, generated.AuthorizationPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+// principalNamespace returns the namespace of a principal in the <trust domain>/ns/<namespace>/sa/<service account>
+// format, or an empty string for any other format.
+func principalNamespace(principal string) string {
+	parts := strings.Split(principal, "/")
+	for i := 0; i+1 < len(parts); i++ {
+		if parts[i] == "ns" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// ApplyAuthorizationPolicy creates or updates the generated AuthorizationPolicy, unless it is unchanged.
+func (in *SvcService) ApplyAuthorizationPolicy(ctx context.Context, generated *models.GeneratedAuthorizationPolicy, user string) error {
+	if generated.Exists && len(generated.Changes) == 0 {
+		return nil
+	}
+
+	payload, err := GeneratedAuthorizationPolicyPayload(generated)
+	if err != nil {
+		return err
+	}
+	name := generated.AuthorizationPolicy.Name
+	operation := models.IstioConfigMutationCreate
+	if generated.Exists {
+		operation = models.IstioConfigMutationUpdate
+		_, err = in.businessLayer.IstioConfig.UpdateIstioConfigDetail(ctx, generated.Cluster, generated.Namespace, kubernetes.AuthorizationPolicies, name, string(payload))
+	} else {
+		_, err = in.businessLayer.IstioConfig.CreateIstioConfigDetail(ctx, generated.Cluster, generated.Namespace, kubernetes.AuthorizationPolicies, payload)
+	}
+	if err != nil {
+		return err
+	}
+	in.businessLayer.IstioConfig.RecordConfigChange(generated.Cluster, generated.Namespace, kubernetes.AuthorizationPolicies, name, operation, user)
+	generated.Applied = true
+	return nil
+}
+
+// GeneratedAuthorizationPolicyPayload returns the payload applying the generated AuthorizationPolicy: the merge patch
+// of the spec of an existing policy, or the new policy.
+func GeneratedAuthorizationPolicyPayload(generated *models.GeneratedAuthorizationPolicy) ([]byte, error) {
+	if !generated.Exists {
+		return json.Marshal(generated.AuthorizationPolicy)
+	}
+	// A merge patch replaces the whole list of rules
+	return json.Marshal(map[string]interface{}{
+		"spec": &generated.AuthorizationPolicy.Spec,
+	})
+}
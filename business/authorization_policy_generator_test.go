@@ -0,0 +1,130 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	api_security_v1 "istio.io/api/security/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func setupGenerateAuthorizationPolicy(t *testing.T, objects ...runtime.Object) (*Layer, kubernetes.ClientInterface) {
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	config.Set(conf)
+
+	reviews := kubetest.FakeService("bookinfo", "reviews")
+	objects = append(objects, kubetest.FakeNamespace("bookinfo"), &reviews)
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	stream := func(principal, namespace, workload string, value float64) *model.SampleStream {
+		return &model.SampleStream{
+			Metric: model.Metric{
+				"source_principal":          model.LabelValue(principal),
+				"source_workload_namespace": model.LabelValue(namespace),
+				"source_workload":           model.LabelValue(workload),
+			},
+			Values: []model.SamplePair{{Value: model.SampleValue(value)}},
+		}
+	}
+	grouping := "source_principal,source_workload_namespace,source_workload"
+	prom := new(prometheustest.PromClientMock)
+	prom.On("FetchIncrease", "istio_requests_total", mock.AnythingOfType("string"), grouping, mock.AnythingOfType("time.Time"), 24*time.Hour).
+		Return(prometheus.Metric{Matrix: model.Matrix{
+			stream("spiffe://cluster.local/ns/bookinfo/sa/bookinfo-productpage", "bookinfo", "productpage-v1", 30),
+			stream("spiffe://cluster.local/ns/bookinfo/sa/bookinfo-productpage", "bookinfo", "productpage-v2", 10),
+			stream("spiffe://cluster.local/ns/istio-system/sa/istio-ingressgateway", "istio-system", "istio-ingressgateway", 5),
+			stream("unknown", "legacy", "client-v1", 3),
+			stream("spiffe://cluster.local/ns/idle/sa/default", "idle", "idle-v1", 0),
+		}})
+	prom.On("FetchIncrease", "istio_tcp_connections_opened_total", mock.AnythingOfType("string"), grouping, mock.AnythingOfType("time.Time"), 24*time.Hour).
+		Return(prometheus.Metric{Matrix: model.Matrix{
+			stream("spiffe://cluster.local/ns/bookinfo/sa/bookinfo-ratings", "bookinfo", "ratings-v1", 4),
+		}})
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	return NewWithBackends(k8sclients, k8sclients, prom, nil), k8s
+}
+
+func TestGenerateAuthorizationPolicy(t *testing.T) {
+	require := require.New(t)
+
+	layer, k8s := setupGenerateAuthorizationPolicy(t)
+	cluster := config.Get().KubernetesConfig.ClusterName
+
+	generated, err := layer.Svc.GenerateAuthorizationPolicy(context.TODO(), cluster, "bookinfo", "reviews", "", 24*time.Hour)
+	require.NoError(err)
+	require.Equal("1d", generated.TrafficWindow)
+	require.Equal(models.AuthorizationPolicyGranularityPrincipal, generated.Granularity)
+	require.False(generated.Exists)
+	require.Equal("reviews"+GeneratedAuthorizationPolicySuffix, generated.AuthorizationPolicy.Name)
+	require.Equal([]string{"legacy/client-v1"}, generated.Unauthenticated)
+
+	require.Len(generated.Sources, 3)
+	require.Equal("cluster.local/ns/bookinfo/sa/bookinfo-productpage", generated.Sources[0].Source)
+	require.Equal(float64(40), generated.Sources[0].Requests)
+	require.Equal([]string{"bookinfo/productpage-v1", "bookinfo/productpage-v2"}, generated.Sources[0].Workloads)
+	require.Equal(float64(4), generated.Sources[1].TCPConnections)
+
+	spec := &generated.AuthorizationPolicy.Spec
+	require.Equal(api_security_v1.AuthorizationPolicy_ALLOW, spec.Action)
+	require.Equal(map[string]string{"app": "reviews"}, spec.Selector.MatchLabels)
+	expected := []string{
+		"cluster.local/ns/bookinfo/sa/bookinfo-productpage",
+		"cluster.local/ns/bookinfo/sa/bookinfo-ratings",
+		"cluster.local/ns/istio-system/sa/istio-ingressgateway",
+	}
+	require.Equal(expected, spec.Rules[0].From[0].Source.Principals)
+
+	require.NoError(layer.Svc.ApplyAuthorizationPolicy(context.TODO(), generated, "jdoe"))
+	require.True(generated.Applied)
+	created, err := k8s.Istio().SecurityV1().AuthorizationPolicies("bookinfo").Get(context.TODO(), generated.AuthorizationPolicy.Name, meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Equal(expected, created.Spec.Rules[0].From[0].Source.Principals)
+}
+
+func TestGenerateAuthorizationPolicyByNamespace(t *testing.T) {
+	require := require.New(t)
+
+	current := data.CreateAuthorizationPolicyWithPrincipals("reviews"+GeneratedAuthorizationPolicySuffix, "bookinfo", []string{"cluster.local/ns/bookinfo/sa/default"})
+	layer, k8s := setupGenerateAuthorizationPolicy(t, current)
+	cluster := config.Get().KubernetesConfig.ClusterName
+
+	generated, err := layer.Svc.GenerateAuthorizationPolicy(context.TODO(), cluster, "bookinfo", "reviews", models.AuthorizationPolicyGranularityNamespace, 24*time.Hour)
+	require.NoError(err)
+	require.True(generated.Exists)
+	require.NotEmpty(generated.Changes)
+	require.Len(generated.Sources, 2)
+	require.Equal([]string{"bookinfo", "istio-system"}, generated.AuthorizationPolicy.Spec.Rules[0].From[0].Source.Namespaces)
+	require.Empty(generated.AuthorizationPolicy.Spec.Rules[0].From[0].Source.Principals)
+
+	require.NoError(layer.Svc.ApplyAuthorizationPolicy(context.TODO(), generated, "jdoe"))
+	updated, err := k8s.Istio().SecurityV1().AuthorizationPolicies("bookinfo").Get(context.TODO(), current.Name, meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Len(updated.Spec.Rules, 1)
+	require.Equal([]string{"bookinfo", "istio-system"}, updated.Spec.Rules[0].From[0].Source.Namespaces)
+
+	_, err = layer.Svc.GenerateAuthorizationPolicy(context.TODO(), cluster, "bookinfo", "reviews", "workload", 24*time.Hour)
+	require.Error(err)
+}
+
+func TestPrincipalNamespace(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("bookinfo", principalNamespace("cluster.local/ns/bookinfo/sa/default"))
+	require.Empty(principalNamespace("example.com/workload"))
+}
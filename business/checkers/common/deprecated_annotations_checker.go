@@ -0,0 +1,64 @@
+package common
+
+import (
+	"sort"
+
+	"istio.io/api/annotation"
+	"istio.io/api/label"
+
+	"github.com/kiali/kiali/models"
+)
+
+// deprecatedAnnotations are the Istio annotations flagged as deprecated, by name.
+var deprecatedAnnotations = func() map[string]*annotation.Instance {
+	deprecated := map[string]*annotation.Instance{}
+	for _, a := range annotation.AllResourceAnnotations() {
+		if a.Deprecated {
+			deprecated[a.Name] = a
+		}
+	}
+	return deprecated
+}()
+
+// DeprecatedAnnotationsChecker reports the deprecated Istio annotations of a resource of the given type,
+// the same ones the deprecated annotation analyzer of istioctl reports.
+type DeprecatedAnnotationsChecker struct {
+	Annotations map[string]string
+	Labels      map[string]string
+	// Path of the annotations in the resource
+	Path     string
+	Resource annotation.ResourceTypes
+}
+
+func (dac DeprecatedAnnotationsChecker) Check() ([]*models.IstioCheck, bool) {
+	checks := make([]*models.IstioCheck, 0)
+
+	names := make([]string, 0, len(dac.Annotations))
+	for name := range dac.Annotations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		deprecated, found := deprecatedAnnotations[name]
+		if !found || !dac.appliesTo(deprecated) {
+			continue
+		}
+		// The injection annotation is kept together with the label replacing it to support older Istio versions
+		if _, labeled := dac.Labels[label.SidecarInject.Name]; labeled && name == annotation.SidecarInject.Name {
+			continue
+		}
+		check := models.Build("generic.annotation.deprecated", dac.Path+"/"+name)
+		checks = append(checks, &check)
+	}
+	return checks, true
+}
+
+func (dac DeprecatedAnnotationsChecker) appliesTo(instance *annotation.Instance) bool {
+	for _, resource := range instance.Resources {
+		if resource == annotation.Any || resource == dac.Resource {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"istio.io/api/annotation"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestDeprecatedAnnotations(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	vals, valid := DeprecatedAnnotationsChecker{
+		Annotations: map[string]string{
+			"sidecar.istio.io/statsInclusionPrefixes": "cluster.outbound",
+			"sidecar.istio.io/proxyCPU":               "100m",
+			"sidecar.istio.io/controlPlaneAuthPolicy": "NONE",
+			"sidecar.istio.io/inject":                 "true",
+		},
+		Path:     "spec/template/metadata/annotations",
+		Resource: annotation.Pod,
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 3)
+	for _, val := range vals {
+		assert.Equal(models.WarningSeverity, val.Severity)
+		assert.NoError(validations.ConfirmIstioCheckMessage("generic.annotation.deprecated", val))
+	}
+	assert.Equal("spec/template/metadata/annotations/sidecar.istio.io/controlPlaneAuthPolicy", vals[0].Path)
+	assert.Equal("spec/template/metadata/annotations/sidecar.istio.io/inject", vals[1].Path)
+	assert.Equal("spec/template/metadata/annotations/sidecar.istio.io/statsInclusionPrefixes", vals[2].Path)
+}
+
+func TestDeprecatedInjectionAnnotationWithLabel(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	vals, valid := DeprecatedAnnotationsChecker{
+		Annotations: map[string]string{"sidecar.istio.io/inject": "true"},
+		Labels:      map[string]string{"sidecar.istio.io/inject": "true"},
+		Path:        "metadata/annotations",
+		Resource:    annotation.Pod,
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestDeprecatedAnnotationsOfOtherResources(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	// The deprecated pod annotations don't apply to a Service
+	vals, valid := DeprecatedAnnotationsChecker{
+		Annotations: map[string]string{"sidecar.istio.io/statsInclusionPrefixes": "cluster.outbound"},
+		Path:        "metadata/annotations",
+		Resource:    annotation.Service,
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
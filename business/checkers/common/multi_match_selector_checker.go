@@ -2,7 +2,9 @@ package common
 
 import (
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1 "istio.io/client-go/pkg/apis/security/v1"
+	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -47,6 +49,33 @@ func PeerAuthenticationMultiMatchChecker(cluster string, objectGVK schema.GroupV
 	}
 }
 
+func ProxyConfigMultiMatchChecker(cluster string, objectGVK schema.GroupVersionKind, pc []*networking_v1beta1.ProxyConfig, workloadsPerNamespace map[string]models.WorkloadList) GenericMultiMatchChecker {
+	keys := []models.IstioValidationKey{}
+	selectors := make(map[int]map[string]string, len(pc))
+	for i, p := range pc {
+		key := models.IstioValidationKey{
+			ObjectGVK: objectGVK,
+			Name:      p.Name,
+			Namespace: p.Namespace,
+			Cluster:   cluster,
+		}
+		keys = append(keys, key)
+		selectors[i] = make(map[string]string)
+		if p.Spec.Selector != nil {
+			selectors[i] = p.Spec.Selector.MatchLabels
+		}
+	}
+	return GenericMultiMatchChecker{
+		Cluster:               cluster,
+		ObjectGVK:             objectGVK,
+		Keys:                  keys,
+		Selectors:             selectors,
+		WorkloadsPerNamespace: workloadsPerNamespace,
+		Path:                  "spec/selector",
+		skipSelSubj:           false,
+	}
+}
+
 func RequestAuthenticationMultiMatchChecker(cluster string, objectGVK schema.GroupVersionKind, ra []*security_v1.RequestAuthentication, workloadsPerNamespace map[string]models.WorkloadList) GenericMultiMatchChecker {
 	keys := []models.IstioValidationKey{}
 	selectors := make(map[int]map[string]string, len(ra))
@@ -111,6 +140,40 @@ func SidecarSelectorMultiMatchChecker(cluster string, objectGVK schema.GroupVers
 	}
 }
 
+func TelemetryMultiMatchChecker(cluster string, objectGVK schema.GroupVersionKind, tm []*telemetry_v1.Telemetry, workloadsPerNamespace map[string]models.WorkloadList) GenericMultiMatchChecker {
+	keys := []models.IstioValidationKey{}
+	selectors := make(map[int]map[string]string, len(tm))
+	i := 0
+	for _, t := range tm {
+		// Telemetries attached to target references apply to the referenced resources only, neither to the namespace
+		// nor to selected workloads
+		if t.Spec.TargetRef != nil || len(t.Spec.TargetRefs) > 0 {
+			continue
+		}
+		key := models.IstioValidationKey{
+			ObjectGVK: objectGVK,
+			Name:      t.Name,
+			Namespace: t.Namespace,
+			Cluster:   cluster,
+		}
+		keys = append(keys, key)
+		selectors[i] = make(map[string]string)
+		if t.Spec.Selector != nil {
+			selectors[i] = t.Spec.Selector.MatchLabels
+		}
+		i++
+	}
+	return GenericMultiMatchChecker{
+		Cluster:               cluster,
+		ObjectGVK:             objectGVK,
+		Keys:                  keys,
+		Selectors:             selectors,
+		WorkloadsPerNamespace: workloadsPerNamespace,
+		Path:                  "spec/selector",
+		skipSelSubj:           false,
+	}
+}
+
 type KeyWithIndex struct {
 	Index int
 	Key   *models.IstioValidationKey
@@ -4,7 +4,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api_networking_v1beta1 "istio.io/api/networking/v1beta1"
+	api_telemetry_v1 "istio.io/api/telemetry/v1"
+	api_type_v1beta1 "istio.io/api/type/v1beta1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
@@ -155,6 +162,130 @@ func TestSidecarsDifferentNamespaces(t *testing.T) {
 	assert.Empty(validations)
 }
 
+func TestTwoTelemetriesTargetingOneDeployment(t *testing.T) {
+	validations := TelemetryMultiMatchChecker(
+		config.Get().KubernetesConfig.ClusterName,
+		kubernetes.Telemetries,
+		[]*telemetry_v1.Telemetry{
+			createTelemetry("telemetry1", "bookinfo", map[string]string{"app": "details"}),
+			createTelemetry("telemetry2", "bookinfo", map[string]string{"app": "details", "version": "v1"}),
+			createTelemetry("telemetry3", "bookinfo", map[string]string{"app": "reviews"}),
+		},
+		workloadList(),
+	).Check()
+
+	assertTelemetryMultimatchFailure(t, "generic.multimatch.selector", validations, "telemetry1", []string{"telemetry2"})
+	assertTelemetryMultimatchFailure(t, "generic.multimatch.selector", validations, "telemetry2", []string{"telemetry1"})
+	assert.NotContains(t, validations, models.IstioValidationKey{ObjectGVK: kubernetes.Telemetries, Namespace: "bookinfo", Name: "telemetry3"})
+}
+
+func TestTwoTelemetriesWithoutSelector(t *testing.T) {
+	targetRefTelemetry := createTelemetry("telemetry3", "bookinfo", nil)
+	targetRefTelemetry.Spec.TargetRefs = []*api_type_v1beta1.PolicyTargetReference{{Kind: "Service", Name: "details"}}
+
+	validations := TelemetryMultiMatchChecker(
+		config.Get().KubernetesConfig.ClusterName,
+		kubernetes.Telemetries,
+		[]*telemetry_v1.Telemetry{
+			createTelemetry("telemetry1", "bookinfo", nil),
+			createTelemetry("telemetry2", "bookinfo", nil),
+			targetRefTelemetry,
+			createTelemetry("telemetry4", "bookinfo2", nil),
+		},
+		workloadList(),
+	).Check()
+
+	assert.Len(t, validations, 2)
+	assertTelemetryMultimatchFailure(t, "generic.multimatch.selectorless", validations, "telemetry1", []string{"telemetry2"})
+	assertTelemetryMultimatchFailure(t, "generic.multimatch.selectorless", validations, "telemetry2", []string{"telemetry1"})
+}
+
+func createTelemetry(name, namespace string, selector map[string]string) *telemetry_v1.Telemetry {
+	telemetry := &telemetry_v1.Telemetry{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       api_telemetry_v1.Telemetry{},
+	}
+	if selector != nil {
+		telemetry.Spec.Selector = &api_type_v1beta1.WorkloadSelector{MatchLabels: selector}
+	}
+	return telemetry
+}
+
+func assertTelemetryMultimatchFailure(t *testing.T, code string, vals models.IstioValidations, item string, references []string) {
+	require := require.New(t)
+
+	validation, ok := vals[models.IstioValidationKey{ObjectGVK: kubernetes.Telemetries, Namespace: "bookinfo", Name: item}]
+	require.True(ok)
+	require.False(validation.Valid)
+	require.NotEmpty(validation.Checks)
+	require.NoError(validations.ConfirmIstioCheckMessage(code, validation.Checks[0]))
+	require.Len(validation.References, len(references))
+	for i, ref := range references {
+		require.Equal(ref, validation.References[i].Name)
+		require.Equal(kubernetes.Telemetries.String(), validation.References[i].ObjectGVK.String())
+	}
+}
+
+func TestTwoProxyConfigsTargetingOneDeployment(t *testing.T) {
+	validations := ProxyConfigMultiMatchChecker(
+		config.Get().KubernetesConfig.ClusterName,
+		kubernetes.ProxyConfigs,
+		[]*networking_v1beta1.ProxyConfig{
+			createProxyConfig("proxyconfig1", "bookinfo", map[string]string{"app": "details"}),
+			createProxyConfig("proxyconfig2", "bookinfo", map[string]string{"version": "v1"}),
+			createProxyConfig("proxyconfig3", "bookinfo", map[string]string{"app": "reviews"}),
+		},
+		workloadList(),
+	).Check()
+
+	assertProxyConfigMultimatchFailure(t, "generic.multimatch.selector", validations, "proxyconfig1", []string{"proxyconfig2"})
+	assertProxyConfigMultimatchFailure(t, "generic.multimatch.selector", validations, "proxyconfig2", []string{"proxyconfig1"})
+	assert.NotContains(t, validations, models.IstioValidationKey{ObjectGVK: kubernetes.ProxyConfigs, Namespace: "bookinfo", Name: "proxyconfig3"})
+}
+
+func TestTwoProxyConfigsWithoutSelector(t *testing.T) {
+	validations := ProxyConfigMultiMatchChecker(
+		config.Get().KubernetesConfig.ClusterName,
+		kubernetes.ProxyConfigs,
+		[]*networking_v1beta1.ProxyConfig{
+			createProxyConfig("proxyconfig1", "bookinfo", nil),
+			createProxyConfig("proxyconfig2", "bookinfo", nil),
+			createProxyConfig("proxyconfig3", "bookinfo2", nil),
+		},
+		workloadList(),
+	).Check()
+
+	assert.Len(t, validations, 2)
+	assertProxyConfigMultimatchFailure(t, "generic.multimatch.selectorless", validations, "proxyconfig1", []string{"proxyconfig2"})
+	assertProxyConfigMultimatchFailure(t, "generic.multimatch.selectorless", validations, "proxyconfig2", []string{"proxyconfig1"})
+}
+
+func createProxyConfig(name, namespace string, selector map[string]string) *networking_v1beta1.ProxyConfig {
+	proxyConfig := &networking_v1beta1.ProxyConfig{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       api_networking_v1beta1.ProxyConfig{},
+	}
+	if selector != nil {
+		proxyConfig.Spec.Selector = &api_type_v1beta1.WorkloadSelector{MatchLabels: selector}
+	}
+	return proxyConfig
+}
+
+func assertProxyConfigMultimatchFailure(t *testing.T, code string, vals models.IstioValidations, item string, references []string) {
+	require := require.New(t)
+
+	validation, ok := vals[models.IstioValidationKey{ObjectGVK: kubernetes.ProxyConfigs, Namespace: "bookinfo", Name: item}]
+	require.True(ok)
+	require.False(validation.Valid)
+	require.NotEmpty(validation.Checks)
+	require.NoError(validations.ConfirmIstioCheckMessage(code, validation.Checks[0]))
+	require.Len(validation.References, len(references))
+	for i, ref := range references {
+		require.Equal(ref, validation.References[i].Name)
+		require.Equal(kubernetes.ProxyConfigs.String(), validation.References[i].ObjectGVK.String())
+	}
+}
+
 func assertMultimatchFailure(t *testing.T, code string, vals models.IstioValidations, item string, references []string) {
 	assert := assert.New(t)
 
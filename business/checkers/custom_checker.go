@@ -0,0 +1,107 @@
+package checkers
+
+import (
+	"sync"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// CustomCheckedObject is an Istio object checked by the custom checkers.
+type CustomCheckedObject struct {
+	ObjectGVK schema.GroupVersionKind
+	// The typed Istio object, i.e. *networking_v1.VirtualService
+	Object meta_v1.Object
+}
+
+// CustomChecker validates the Istio config with the rules of an organization, i.e. naming conventions or mandatory
+// annotations. It checks the Istio objects of a namespace and returns the validations of the objects with checks,
+// which are merged with the ones of the built-in checkers. Its check codes should not collide with the KIA codes.
+type CustomChecker interface {
+	// Name identifies the checker in the logs
+	Name() string
+	Check(cluster, namespace string, objects []CustomCheckedObject) (models.IstioValidations, error)
+}
+
+var customCheckers = struct {
+	sync.RWMutex
+	checkers []CustomChecker
+}{}
+
+// RegisterCustomChecker adds a checker to the ones run by the validations, replacing the checker of the same name.
+// It is meant to be called from the init function of a package compiled into Kiali.
+func RegisterCustomChecker(checker CustomChecker) {
+	customCheckers.Lock()
+	defer customCheckers.Unlock()
+
+	for i, registered := range customCheckers.checkers {
+		if registered.Name() == checker.Name() {
+			customCheckers.checkers[i] = checker
+			return
+		}
+	}
+	customCheckers.checkers = append(customCheckers.checkers, checker)
+}
+
+// UnregisterCustomChecker removes the checker of the name from the ones run by the validations.
+func UnregisterCustomChecker(name string) {
+	customCheckers.Lock()
+	defer customCheckers.Unlock()
+
+	for i, registered := range customCheckers.checkers {
+		if registered.Name() == name {
+			customCheckers.checkers = append(customCheckers.checkers[:i:i], customCheckers.checkers[i+1:]...)
+			return
+		}
+	}
+}
+
+// RegisteredCustomCheckers returns the checkers registered with RegisterCustomChecker.
+func RegisteredCustomCheckers() []CustomChecker {
+	customCheckers.RLock()
+	defer customCheckers.RUnlock()
+
+	return append([]CustomChecker(nil), customCheckers.checkers...)
+}
+
+// CustomValidation returns the validation of the object with the checks of a custom checker. The object is not
+// valid when one of the checks has the error severity.
+func CustomValidation(cluster string, object CustomCheckedObject, checks []*models.IstioCheck) (models.IstioValidationKey, *models.IstioValidation) {
+	key, validation := EmptyValidValidation(object.Object.GetName(), object.Object.GetNamespace(), object.ObjectGVK, cluster)
+	for _, check := range checks {
+		validation.Checks = append(validation.Checks, check)
+		if check.Severity == models.ErrorSeverity {
+			validation.Valid = false
+		}
+	}
+	return key, validation
+}
+
+// CustomObjectChecker runs the custom checkers on the Istio objects of a namespace.
+type CustomObjectChecker struct {
+	Checkers  []CustomChecker
+	Cluster   string
+	Namespace string
+	Objects   []CustomCheckedObject
+}
+
+func (c CustomObjectChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+	if len(c.Objects) == 0 {
+		return validations
+	}
+
+	for _, checker := range c.Checkers {
+		checkerValidations, err := checker.Check(c.Cluster, c.Namespace, c.Objects)
+		if err != nil {
+			// A failing custom checker doesn't prevent the built-in validations
+			log.Warningf("Custom checker [%s] failed on namespace [%s] of cluster [%s]: %s", checker.Name(), c.Namespace, c.Cluster, err)
+			continue
+		}
+		validations.MergeValidations(checkerValidations)
+	}
+	return validations
+}
@@ -36,6 +36,7 @@ func (in DestinationRulesChecker) runGroupChecks() models.IstioValidations {
 	}
 
 	enabledDRCheckers = append(enabledDRCheckers, destinationrules.TrafficPolicyChecker{DestinationRules: in.DestinationRules, MTLSDetails: in.MTLSDetails, Cluster: in.Cluster})
+	enabledDRCheckers = append(enabledDRCheckers, destinationrules.TLSConflictChecker{DestinationRules: in.DestinationRules, Namespaces: in.Namespaces, Cluster: in.Cluster})
 
 	for _, checker := range enabledDRCheckers {
 		validations = validations.MergeValidations(checker.Check())
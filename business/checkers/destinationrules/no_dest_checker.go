@@ -1,6 +1,7 @@
 package destinationrules
 
 import (
+	"sort"
 	"strconv"
 
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
@@ -25,6 +26,7 @@ func (n NoDestinationChecker) Check() ([]*models.IstioCheck, bool) {
 	valid := true
 	validations := make([]*models.IstioCheck, 0)
 	labelValidations := make([]*models.IstioCheck, 0)
+	labelKeyValidations := make([]*models.IstioCheck, 0)
 
 	namespace := n.DestinationRule.Namespace
 
@@ -51,6 +53,13 @@ func (n NoDestinationChecker) Check() ([]*models.IstioCheck, bool) {
 						validation.Severity = models.Unknown
 					}
 					validations = append(validations, &validation)
+					// Pinpoint the labels of the subset that no workload of the host has
+					for _, key := range n.unmatchedSubsetLabels(fqdn, subset.Labels) {
+						keyValidation := models.Build("destinationrules.nodest.subsetlabelkey",
+							"spec/subsets["+strconv.Itoa(i)+"]/labels/"+key)
+						keyValidation.Severity = validation.Severity
+						labelKeyValidations = append(labelKeyValidations, &keyValidation)
+					}
 				} else {
 					hasLabel = true
 				}
@@ -67,10 +76,60 @@ func (n NoDestinationChecker) Check() ([]*models.IstioCheck, bool) {
 			}
 			validations = append(validations, v)
 		}
+		validations = append(validations, labelKeyValidations...)
 	}
 	return validations, valid
 }
 
+// unmatchedSubsetLabels returns the sorted keys of the subset labels whose key and value are not found in any
+// workload selected by the Service of the host. Nothing is returned when the workloads of the host are unknown.
+func (n NoDestinationChecker) unmatchedSubsetLabels(host kubernetes.Host, subsetLabels map[string]string) []string {
+	unmatched := []string{}
+	if host.IsWildcard() {
+		return unmatched
+	}
+
+	localSvc, localNs := kubernetes.ParseTwoPartHost(host)
+
+	var selectors map[string]string
+	for _, s := range n.RegistryServices {
+		if s.Attributes.Name == localSvc && s.Attributes.Namespace == localNs {
+			selectors = s.Attributes.LabelSelectors
+			break
+		}
+	}
+	if len(selectors) == 0 {
+		return unmatched
+	}
+
+	selector := labels.SelectorFromSet(labels.Set(selectors))
+	hostWorkloads := make([]labels.Set, 0)
+	for _, wl := range n.WorkloadsPerNamespace[localNs].Workloads {
+		wlLabelSet := labels.Set(wl.Labels)
+		if selector.Matches(wlLabelSet) {
+			hostWorkloads = append(hostWorkloads, wlLabelSet)
+		}
+	}
+	if len(hostWorkloads) == 0 {
+		return unmatched
+	}
+
+	for key, value := range subsetLabels {
+		found := false
+		for _, wlLabelSet := range hostWorkloads {
+			if wlLabelSet.Has(key) && wlLabelSet.Get(key) == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, key)
+		}
+	}
+	sort.Strings(unmatched)
+	return unmatched
+}
+
 func (n NoDestinationChecker) hasMatchingWorkload(host kubernetes.Host, subsetLabels map[string]string) bool {
 	// Check wildcard hosts - needs to match "*" and "*.suffix" also..
 	if host.IsWildcard() {
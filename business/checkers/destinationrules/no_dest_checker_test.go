@@ -295,6 +295,47 @@ func TestNoMatchingSubsetWithMoreLabels(t *testing.T) {
 	assert.Equal("spec/subsets[0]", vals[0].Path)
 }
 
+func TestNoMatchingSubsetLabelKeys(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	s1 := &api_networking_v1.Subset{
+		Name: "reviewsv3",
+		Labels: map[string]string{
+			"version": "v3",
+			"app":     "reviews",
+			"seek":    "notfound",
+		},
+	}
+	dr := data.AddSubsetToDestinationRule(s1, data.CreateEmptyDestinationRule("test-namespace", "name", "reviews"))
+
+	vals, valid := NoDestinationChecker{
+		WorkloadsPerNamespace: map[string]models.WorkloadList{
+			"test-namespace": data.CreateWorkloadList("test-namespace",
+				data.CreateWorkloadListItem("reviews", appVersionLabel("reviews", "v1")),
+				data.CreateWorkloadListItem("reviews", appVersionLabel("reviews", "v2"))),
+		},
+		RegistryServices: data.CreateFakeRegistryServicesLabels("reviews", "test-namespace"),
+		DestinationRule:  dr,
+		VirtualServices: []*networking_v1.VirtualService{data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "reviewsv3", 100),
+			data.CreateEmptyVirtualService("reviews", "test-namespace", []string{"reviews"}),
+		)},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 3)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.nodest.subsetlabels", vals[0]))
+	assert.Equal("spec/subsets[0]", vals[0].Path)
+	for _, val := range vals[1:] {
+		assert.Equal(models.ErrorSeverity, val.Severity)
+		assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.nodest.subsetlabelkey", val))
+	}
+	assert.Equal("spec/subsets[0]/labels/seek", vals[1].Path)
+	assert.Equal("spec/subsets[0]/labels/version", vals[2].Path)
+}
+
 func TestSubsetNotReferenced(t *testing.T) {
 	assert := assert.New(t)
 
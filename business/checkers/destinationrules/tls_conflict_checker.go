@@ -0,0 +1,68 @@
+package destinationrules
+
+import (
+	"sort"
+
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// TLSConflictChecker flags the DestinationRules of the same host setting different TLS modes.
+// Istio applies a single DestinationRule per client namespace, so the clients of different namespaces
+// would use different TLS modes to reach the host, which usually ends in 503 UF errors.
+type TLSConflictChecker struct {
+	Cluster          string
+	DestinationRules []*networking_v1.DestinationRule
+	Namespaces       models.Namespaces
+}
+
+func (t TLSConflictChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	// [fqdn][tls mode] DestinationRules
+	hostModes := map[string]map[string][]*networking_v1.DestinationRule{}
+	for _, dr := range t.DestinationRules {
+		tls := dr.Spec.GetTrafficPolicy().GetTls()
+		if tls == nil {
+			// The mode is inherited, it doesn't conflict
+			continue
+		}
+		fqdn := kubernetes.GetHost(dr.Spec.Host, dr.Namespace, t.Namespaces.GetNames())
+		if fqdn.IsWildcard() {
+			continue
+		}
+		if _, found := hostModes[fqdn.String()]; !found {
+			hostModes[fqdn.String()] = map[string][]*networking_v1.DestinationRule{}
+		}
+		hostModes[fqdn.String()][tls.Mode.String()] = append(hostModes[fqdn.String()][tls.Mode.String()], dr)
+	}
+
+	for _, modes := range hostModes {
+		if len(modes) < 2 {
+			continue
+		}
+		drs := []*networking_v1.DestinationRule{}
+		for _, modeDrs := range modes {
+			drs = append(drs, modeDrs...)
+		}
+		sort.Slice(drs, func(i, j int) bool {
+			return drs[i].Namespace+"/"+drs[i].Name < drs[j].Namespace+"/"+drs[j].Name
+		})
+
+		for _, dr := range drs {
+			refKeys := []models.IstioValidationKey{}
+			for _, other := range drs {
+				if other.Spec.TrafficPolicy.Tls.Mode != dr.Spec.TrafficPolicy.Tls.Mode {
+					refKeys = append(refKeys, models.BuildKey(kubernetes.DestinationRules, other.Name, other.Namespace, t.Cluster))
+				}
+			}
+			check := models.Build("destinationrules.trafficpolicy.tlsconflict", "spec/trafficPolicy/tls/mode")
+			key := models.BuildKey(kubernetes.DestinationRules, dr.Name, dr.Namespace, t.Cluster)
+			validations.MergeValidations(models.IstioValidations{key: buildDestinationRuleValidation(dr, check, true, refKeys, t.Cluster)})
+		}
+	}
+
+	return validations
+}
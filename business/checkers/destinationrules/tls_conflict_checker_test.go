@@ -0,0 +1,69 @@
+package destinationrules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestTLSConflictSameMode(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	destinationRules := []*networking_v1.DestinationRule{
+		data.AddTrafficPolicyToDestinationRule(data.CreateMTLSTrafficPolicyForDestinationRules(), data.CreateTestDestinationRule("bookinfo", "reviews", "reviews")),
+		data.AddTrafficPolicyToDestinationRule(data.CreateMTLSTrafficPolicyForDestinationRules(), data.CreateTestDestinationRule("travel", "reviews", "reviews.bookinfo.svc.cluster.local")),
+		// The TLS mode is inherited
+		data.CreateTestDestinationRule("istio-system", "reviews", "reviews.bookinfo.svc.cluster.local"),
+		// Wildcards are overridden by the DestinationRules of the host
+		data.AddTrafficPolicyToDestinationRule(data.CreateDisabledMTLSTrafficPolicyForDestinationRules(), data.CreateTestDestinationRule("bookinfo", "all", "*.bookinfo.svc.cluster.local")),
+	}
+
+	vals := TLSConflictChecker{
+		DestinationRules: destinationRules,
+	}.Check()
+
+	assert.Empty(vals)
+}
+
+func TestTLSConflictDifferentModes(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	destinationRules := []*networking_v1.DestinationRule{
+		data.AddTrafficPolicyToDestinationRule(data.CreateMTLSTrafficPolicyForDestinationRules(), data.CreateTestDestinationRule("bookinfo", "reviews", "reviews")),
+		data.AddTrafficPolicyToDestinationRule(data.CreateMTLSTrafficPolicyForDestinationRules(), data.CreateTestDestinationRule("istio-system", "reviews", "reviews.bookinfo.svc.cluster.local")),
+		data.AddTrafficPolicyToDestinationRule(data.CreateDisabledMTLSTrafficPolicyForDestinationRules(), data.CreateTestDestinationRule("travel", "reviews", "reviews.bookinfo.svc.cluster.local")),
+		data.AddTrafficPolicyToDestinationRule(data.CreateDisabledMTLSTrafficPolicyForDestinationRules(), data.CreateTestDestinationRule("bookinfo", "ratings", "ratings")),
+	}
+
+	vals := TLSConflictChecker{
+		Cluster:          "east",
+		DestinationRules: destinationRules,
+	}.Check()
+
+	assert.Len(vals, 3)
+	validation, ok := vals[models.BuildKey(kubernetes.DestinationRules, "reviews", "travel", "east")]
+	assert.True(ok)
+	assert.True(validation.Valid)
+	assert.NoError(validations.ConfirmIstioCheckMessage("destinationrules.trafficpolicy.tlsconflict", validation.Checks[0]))
+	assert.Equal("spec/trafficPolicy/tls/mode", validation.Checks[0].Path)
+	assert.ElementsMatch([]models.IstioValidationKey{
+		models.BuildKey(kubernetes.DestinationRules, "reviews", "bookinfo", "east"),
+		models.BuildKey(kubernetes.DestinationRules, "reviews", "istio-system", "east"),
+	}, validation.References)
+
+	validation = vals[models.BuildKey(kubernetes.DestinationRules, "reviews", "bookinfo", "east")]
+	assert.Equal([]models.IstioValidationKey{models.BuildKey(kubernetes.DestinationRules, "reviews", "travel", "east")}, validation.References)
+}
@@ -50,9 +50,13 @@ func runVirtualServiceCheck(virtualService *networking_v1.VirtualService, servic
 		RegistryServices:  registryStatus,
 		PolicyAllowAny:    policyAllowAny,
 	}.Check()
+	shortHosts, _ := virtualservices.ShortHostChecker{
+		VirtualService:   virtualService,
+		RegistryServices: registryStatus,
+	}.Check()
 
 	validations.Valid = valid
-	validations.Checks = result
+	validations.Checks = append(result, shortHosts...)
 
 	return models.IstioValidations{key: validations}
 }
@@ -0,0 +1,74 @@
+package checkers
+
+import (
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+
+	"github.com/kiali/kiali/business/checkers/common"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+type ProxyConfigChecker struct {
+	Cluster               string
+	ProxyConfigs          []*networking_v1beta1.ProxyConfig
+	WorkloadsPerNamespace map[string]models.WorkloadList
+}
+
+// An Object Checker runs all checkers for an specific object type (i.e.: pod, route rule,...)
+// It run two kinds of checkers:
+// 1. Individual checks: validating individual objects.
+// 2. Group checks: validating behaviour between configurations.
+func (in ProxyConfigChecker) Check() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	validations = validations.MergeValidations(in.runIndividualChecks())
+	validations = validations.MergeValidations(in.runGroupChecks())
+
+	return validations
+}
+
+// runGroupChecks checks that a workload is selected by a single ProxyConfig of its namespace, as Istio does not
+// define which one applies otherwise.
+func (in ProxyConfigChecker) runGroupChecks() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	enabledCheckers := []GroupChecker{
+		common.ProxyConfigMultiMatchChecker(in.Cluster, kubernetes.ProxyConfigs, in.ProxyConfigs, in.WorkloadsPerNamespace),
+	}
+
+	for _, checker := range enabledCheckers {
+		validations = validations.MergeValidations(checker.Check())
+	}
+
+	return validations
+}
+
+func (in ProxyConfigChecker) runIndividualChecks() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	for _, proxyConfig := range in.ProxyConfigs {
+		validations.MergeValidations(in.runChecks(proxyConfig))
+	}
+
+	return validations
+}
+
+func (in ProxyConfigChecker) runChecks(proxyConfig *networking_v1beta1.ProxyConfig) models.IstioValidations {
+	key, rrValidation := EmptyValidValidation(proxyConfig.Name, proxyConfig.Namespace, kubernetes.ProxyConfigs, in.Cluster)
+	selectorLabels := make(map[string]string)
+	if proxyConfig.Spec.Selector != nil {
+		selectorLabels = proxyConfig.Spec.Selector.MatchLabels
+	}
+
+	enabledCheckers := []Checker{
+		common.SelectorNoWorkloadFoundChecker(kubernetes.ProxyConfigs, selectorLabels, in.WorkloadsPerNamespace),
+	}
+
+	for _, checker := range enabledCheckers {
+		checks, validChecker := checker.Check()
+		rrValidation.Checks = append(rrValidation.Checks, checks...)
+		rrValidation.Valid = rrValidation.Valid && validChecker
+	}
+
+	return models.IstioValidations{key: rrValidation}
+}
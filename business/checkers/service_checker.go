@@ -1,11 +1,14 @@
 package checkers
 
 import (
+	osroutes_v1 "github.com/openshift/api/route/v1"
+	"istio.io/api/annotation"
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/kiali/kiali/business/checkers/common"
 	"github.com/kiali/kiali/business/checkers/services"
 	"github.com/kiali/kiali/models"
 )
@@ -16,7 +19,11 @@ type ServiceChecker struct {
 	Services    []v1.Service
 	Deployments []apps_v1.Deployment
 	Pods        []core_v1.Pod
-	Cluster     string
+	// Routes are the OpenShift Routes of the namespaces of the Services, if any
+	Routes  []osroutes_v1.Route
+	Cluster string
+	// AppProtocolSupported is whether the cluster supports the appProtocol of the Service ports
+	AppProtocolSupported bool
 }
 
 func (sc ServiceChecker) Check() models.IstioValidations {
@@ -34,7 +41,11 @@ func (sc ServiceChecker) runSingleChecks(service v1.Service) models.IstioValidat
 	key, validations := EmptyValidValidation(service.GetObjectMeta().GetName(), service.GetObjectMeta().GetNamespace(), schema.GroupVersionKind{Group: "", Version: "", Kind: ServiceCheckerType}, sc.Cluster)
 
 	enabledCheckers := []Checker{
-		services.PortMappingChecker{Service: service, Deployments: sc.Deployments, Pods: sc.Pods},
+		services.PortMappingChecker{Service: service, Deployments: sc.Deployments},
+		services.LabelConsistencyChecker{Service: service, Deployments: sc.Deployments},
+		services.PortProtocolChecker{Service: service, Pods: sc.Pods, AppProtocolSupported: sc.AppProtocolSupported},
+		services.RouteChecker{Service: service, Pods: sc.Pods, Routes: sc.Routes},
+		common.DeprecatedAnnotationsChecker{Annotations: service.Annotations, Labels: service.Labels, Path: "metadata/annotations", Resource: annotation.Service},
 	}
 
 	for _, checker := range enabledCheckers {
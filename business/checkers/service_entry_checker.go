@@ -33,6 +33,7 @@ func (s ServiceEntryChecker) runSingleChecks(se *networking_v1.ServiceEntry, wor
 
 	enabledCheckers := []Checker{
 		serviceentries.HasMatchingWorkloadEntryAddress{ServiceEntry: se, WorkloadEntries: workloadEntriesMap},
+		serviceentries.ResolutionChecker{ServiceEntry: se},
 	}
 	if !s.Namespaces.IsNamespaceAmbient(se.Namespace, s.Cluster) {
 		enabledCheckers = append(enabledCheckers, common.ExportToNamespaceChecker{ExportTo: se.Spec.ExportTo, Namespaces: s.Namespaces})
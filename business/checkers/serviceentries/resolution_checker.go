@@ -0,0 +1,39 @@
+package serviceentries
+
+import (
+	"fmt"
+	"strings"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/models"
+)
+
+// ResolutionChecker warns about ServiceEntries with resolution NONE and HTTPS or TLS ports. Without addresses,
+// the proxies listen on the port for any destination, so the ServiceEntry intercepts the traffic
+// to every host on that port.
+type ResolutionChecker struct {
+	ServiceEntry *networking_v1.ServiceEntry
+}
+
+func (r ResolutionChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if r.ServiceEntry.Spec.Resolution != api_networking_v1.ServiceEntry_NONE || len(r.ServiceEntry.Spec.Addresses) > 0 {
+		return validations, true
+	}
+
+	for portIndex, port := range r.ServiceEntry.Spec.Ports {
+		if port == nil {
+			continue
+		}
+		protocol := strings.ToUpper(port.Protocol)
+		if protocol == "HTTPS" || protocol == "TLS" {
+			validation := models.Build("serviceentries.resolution.none.https",
+				fmt.Sprintf("spec/ports[%d]/protocol", portIndex))
+			validations = append(validations, &validation)
+		}
+	}
+	return validations, true
+}
@@ -0,0 +1,66 @@
+package serviceentries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_networking_v1 "istio.io/api/networking/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestResolutionNoneWithHTTPSPort(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	se := data.AddPortDefinitionToServiceEntry(
+		data.CreateEmptyServicePortDefinition(443, "https", "HTTPS"),
+		data.AddPortDefinitionToServiceEntry(
+			data.CreateEmptyServicePortDefinition(80, "http", "HTTP"),
+			data.CreateEmptyMeshExternalServiceEntry("external-se", "test", []string{"www.example.com"}),
+		),
+	)
+	se.Spec.Resolution = api_networking_v1.ServiceEntry_NONE
+
+	vals, valid := ResolutionChecker{ServiceEntry: se}.Check()
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("serviceentries.resolution.none.https", vals[0]))
+	assert.Equal("spec/ports[1]/protocol", vals[0].Path)
+}
+
+func TestResolutionNoneWithAddresses(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	se := data.AddPortDefinitionToServiceEntry(
+		data.CreateEmptyServicePortDefinition(443, "tls", "TLS"),
+		data.CreateEmptyMeshExternalServiceEntry("external-se", "test", []string{"www.example.com"}),
+	)
+	se.Spec.Resolution = api_networking_v1.ServiceEntry_NONE
+	se.Spec.Addresses = []string{"10.10.0.0/16"}
+
+	vals, valid := ResolutionChecker{ServiceEntry: se}.Check()
+	assert.True(t, valid)
+	assert.Empty(t, vals)
+}
+
+func TestResolutionDNSWithHTTPSPort(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	se := data.AddPortDefinitionToServiceEntry(
+		data.CreateEmptyServicePortDefinition(443, "https", "HTTPS"),
+		data.CreateEmptyMeshExternalServiceEntry("external-se", "test", []string{"www.example.com"}),
+	)
+
+	vals, valid := ResolutionChecker{ServiceEntry: se}.Check()
+	assert.True(t, valid)
+	assert.Empty(t, vals)
+}
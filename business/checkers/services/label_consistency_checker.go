@@ -0,0 +1,92 @@
+package services
+
+import (
+	"sort"
+
+	apps_v1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// LabelConsistencyChecker verifies that the selector of the Service, the selectors of the Deployments and the labels
+// of their pod templates are consistent. When the Service selects no pod template, the selector labels that don't
+// match the pod template of the closest Deployment, the one matching most of them, are reported.
+type LabelConsistencyChecker struct {
+	Service     v1.Service
+	Deployments []apps_v1.Deployment
+}
+
+func (l LabelConsistencyChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	selector := l.Service.Spec.Selector
+	// Services without selector have their endpoints managed out of the Deployments
+	if len(selector) == 0 {
+		return validations, len(validations) == 0
+	}
+	if config.IsIstioNamespace(l.Service.Namespace) {
+		log.Tracef("Skipping label consistency check for Service %s from Istio Namespace %s", l.Service.Name, l.Service.Namespace)
+		return validations, len(validations) == 0
+	}
+	// Ignoring waypoint Services as auto-generated
+	if config.IsWaypoint(l.Service.Labels) {
+		log.Tracef("Skipping label consistency check for waypoint Service %s from Namespace %s", l.Service.Name, l.Service.Namespace)
+		return validations, len(validations) == 0
+	}
+
+	selected := false
+	var closestMismatches []string
+	for _, d := range l.Deployments {
+		mismatches := mismatchedLabels(selector, d.Spec.Template.Labels)
+		if len(mismatches) == 0 {
+			selected = true
+			if !selectsTemplate(d) {
+				validation := models.Build("service.deployment.selectortemplate", "spec/selector")
+				validations = append(validations, &validation)
+			}
+			continue
+		}
+		// A Deployment matching some of the selector labels is likely meant to be selected
+		if len(mismatches) < len(selector) && (closestMismatches == nil || len(mismatches) < len(closestMismatches)) {
+			closestMismatches = mismatches
+		}
+	}
+
+	if !selected {
+		for _, key := range closestMismatches {
+			validation := models.Build("service.selector.templatelabel", "spec/selector/"+key)
+			validations = append(validations, &validation)
+		}
+	}
+
+	return validations, len(validations) == 0
+}
+
+// mismatchedLabels returns the sorted keys of the selector whose value is not the one of the labels.
+func mismatchedLabels(selector, podLabels map[string]string) []string {
+	mismatches := []string{}
+	for key, value := range selector {
+		if podValue, found := podLabels[key]; !found || podValue != value {
+			mismatches = append(mismatches, key)
+		}
+	}
+	sort.Strings(mismatches)
+	return mismatches
+}
+
+// selectsTemplate returns whether the selector of the Deployment matches the labels of its pod template.
+func selectsTemplate(deployment apps_v1.Deployment) bool {
+	if deployment.Spec.Selector == nil {
+		return true
+	}
+	selector, err := meta_v1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(deployment.Spec.Template.Labels))
+}
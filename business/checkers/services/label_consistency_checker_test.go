@@ -0,0 +1,127 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apps_v1 "k8s.io/api/apps/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestLabelConsistencyMatch(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	service := getService(9080, "http", nil, "test-namespace", "app", "labelName1")
+	service.Spec.Selector = map[string]string{"app": "reviews", "version": "v1"}
+
+	lcc := LabelConsistencyChecker{
+		Service:     service,
+		Deployments: []apps_v1.Deployment{getLabeledDeployment(map[string]string{"app": "reviews"}, map[string]string{"app": "reviews", "version": "v1"})},
+	}
+
+	vals, valid := lcc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestLabelConsistencySelectorTemplateMismatch(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	service := getService(9080, "http", nil, "test-namespace", "app", "labelName1")
+	service.Spec.Selector = map[string]string{"app": "reviews"}
+
+	lcc := LabelConsistencyChecker{
+		Service:     service,
+		Deployments: []apps_v1.Deployment{getLabeledDeployment(map[string]string{"app": "ratings"}, map[string]string{"app": "reviews"})},
+	}
+
+	vals, valid := lcc.Check()
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("service.deployment.selectortemplate", vals[0]))
+	assert.Equal("spec/selector", vals[0].Path)
+}
+
+func TestLabelConsistencyServiceSelectorMismatch(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	service := getService(9080, "http", nil, "test-namespace", "app", "labelName1")
+	service.Spec.Selector = map[string]string{"app": "reviews", "version": "v2", "tier": "backend", "zone": "east"}
+
+	lcc := LabelConsistencyChecker{
+		Service: service,
+		Deployments: []apps_v1.Deployment{
+			getLabeledDeployment(map[string]string{"app": "details"}, map[string]string{"app": "details", "version": "v2"}),
+			getLabeledDeployment(map[string]string{"app": "reviews"}, map[string]string{"app": "reviews", "version": "v1", "tier": "frontend", "zone": "east"}),
+		},
+	}
+
+	vals, valid := lcc.Check()
+	assert.False(valid)
+	assert.Len(vals, 2)
+	for _, val := range vals {
+		assert.Equal(models.WarningSeverity, val.Severity)
+		assert.NoError(validations.ConfirmIstioCheckMessage("service.selector.templatelabel", val))
+	}
+	assert.Equal("spec/selector/tier", vals[0].Path)
+	assert.Equal("spec/selector/version", vals[1].Path)
+}
+
+func TestLabelConsistencyNoCloseDeployment(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	service := getService(9080, "http", nil, "test-namespace", "app", "labelName1")
+	service.Spec.Selector = map[string]string{"app": "reviews"}
+
+	lcc := LabelConsistencyChecker{
+		Service:     service,
+		Deployments: []apps_v1.Deployment{getLabeledDeployment(map[string]string{"app": "details"}, map[string]string{"app": "details"})},
+	}
+
+	vals, valid := lcc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestLabelConsistencySkipIstioNamespace(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	service := getService(9080, "http", nil, conf.IstioNamespace, "app", "labelName1")
+	service.Spec.Selector = map[string]string{"app": "reviews"}
+
+	lcc := LabelConsistencyChecker{
+		Service:     service,
+		Deployments: []apps_v1.Deployment{getLabeledDeployment(map[string]string{"app": "ratings"}, map[string]string{"app": "reviews"})},
+	}
+
+	vals, valid := lcc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func getLabeledDeployment(matchLabels map[string]string, templateLabels map[string]string) apps_v1.Deployment {
+	deployment := getDeployment(9080)[0]
+	deployment.Spec.Selector = &meta_v1.LabelSelector{MatchLabels: matchLabels}
+	deployment.Spec.Template.Labels = templateLabels
+	return deployment
+}
@@ -2,16 +2,13 @@ package services
 
 import (
 	"fmt"
-	"strings"
 
 	apps_v1 "k8s.io/api/apps/v1"
-	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kiali/kiali/config"
-	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/log"
 	"github.com/kiali/kiali/models"
 )
@@ -19,29 +16,11 @@ import (
 type PortMappingChecker struct {
 	Service     v1.Service
 	Deployments []apps_v1.Deployment
-	Pods        []core_v1.Pod
 }
 
 func (p PortMappingChecker) Check() ([]*models.IstioCheck, bool) {
 	validations := make([]*models.IstioCheck, 0)
 
-	// Check Port naming for services in the service mesh
-	if p.hasMatchingPodsWithSidecar(p.Service) {
-		for portIndex, sp := range p.Service.Spec.Ports {
-			if _, ok := p.Service.Labels["kiali_wizard"]; ok || strings.ToLower(string(sp.Protocol)) == "udp" {
-				continue
-			} else if sp.AppProtocol != nil {
-				if !kubernetes.MatchPortAppProtocolWithValidProtocols(sp.AppProtocol) {
-					validation := models.Build("port.appprotocol.mismatch", fmt.Sprintf("spec/ports[%d]", portIndex))
-					validations = append(validations, &validation)
-				}
-			} else if !kubernetes.MatchPortNameWithValidProtocols(sp.Name) {
-				validation := models.Build("port.name.mismatch", fmt.Sprintf("spec/ports[%d]", portIndex))
-				validations = append(validations, &validation)
-			}
-		}
-	}
-
 	// Ignoring istio-system Services as some ports are used for debug purposes and not exposed in deployments
 	if config.IsIstioNamespace(p.Service.Namespace) {
 		log.Tracef("Skipping Port matching check for Service %s from Istio Namespace %s", p.Service.Name, p.Service.Namespace)
@@ -59,12 +38,6 @@ func (p PortMappingChecker) Check() ([]*models.IstioCheck, bool) {
 	return validations, len(validations) == 0
 }
 
-func (p PortMappingChecker) hasMatchingPodsWithSidecar(service v1.Service) bool {
-	sPods := models.Pods{}
-	sPods.Parse(kubernetes.FilterPodsByService(&service, p.Pods))
-	return sPods.HasIstioSidecar()
-}
-
 func (p PortMappingChecker) findMatchingDeployment(selectors map[string]string) *apps_v1.Deployment {
 	if len(selectors) == 0 {
 		return nil
@@ -22,7 +22,6 @@ func TestPortMappingMatch(t *testing.T) {
 	pmc := PortMappingChecker{
 		Service:     getService(9080, "http", nil, "test-namespace", "app", "labelName1"),
 		Deployments: getDeployment(9080),
-		Pods:        getPods(true),
 	}
 
 	vals, valid := pmc.Check()
@@ -51,7 +50,6 @@ func TestTargetPortMappingMatch(t *testing.T) {
 	pmc := PortMappingChecker{
 		Service:     service,
 		Deployments: getDeployment(8080),
-		Pods:        getPods(true),
 	}
 
 	vals, valid := pmc.Check()
@@ -76,7 +74,6 @@ func TestPortMappingMismatch(t *testing.T) {
 	pmc := PortMappingChecker{
 		Service:     getService(9080, "http", nil, "test-namespace", "app", "labelName1"),
 		Deployments: getDeployment(8080),
-		Pods:        getPods(true),
 	}
 
 	vals, valid := pmc.Check()
@@ -95,7 +92,6 @@ func TestPortMappingSkipWaypoint(t *testing.T) {
 	pmc := PortMappingChecker{
 		Service:     getService(9080, "http", nil, "test-namespace", config.WaypointLabel, config.WaypointLabelValue),
 		Deployments: getDeployment(8080),
-		Pods:        getPods(false),
 	}
 
 	vals, valid := pmc.Check()
@@ -113,110 +109,6 @@ func TestPortMappingNoMismatchIstio(t *testing.T) {
 	pmc := PortMappingChecker{
 		Service:     getService(9080, "http", nil, "istio-system", "app", "labelName1"),
 		Deployments: getDeployment(8080),
-		Pods:        getPods(true),
-	}
-
-	vals, valid := pmc.Check()
-	assert.True(valid)
-	assert.Empty(vals)
-}
-
-func TestServicePortNaming(t *testing.T) {
-	conf := config.NewConfig()
-	config.Set(conf)
-
-	assert := assert.New(t)
-
-	pmc := PortMappingChecker{
-		Service:     getService(9080, "http2foo", nil, "test-namespace", "app", "labelName1"),
-		Deployments: getDeployment(9080),
-		Pods:        getPods(true),
-	}
-
-	vals, valid := pmc.Check()
-	assert.False(valid)
-	assert.NotEmpty(vals)
-	assert.NoError(validations.ConfirmIstioCheckMessage("port.name.mismatch", vals[0]))
-	assert.Equal("spec/ports[0]", vals[0].Path)
-}
-
-func TestServicePortNamingIstioSystem(t *testing.T) {
-	conf := config.NewConfig()
-	config.Set(conf)
-
-	assert := assert.New(t)
-
-	pmc := PortMappingChecker{
-		Service:     getService(9080, "http2foo", nil, "istio-system", "app", "labelName1"),
-		Deployments: getDeployment(9080),
-		Pods:        getPods(true),
-	}
-
-	vals, valid := pmc.Check()
-	assert.False(valid)
-	assert.NotEmpty(vals)
-	assert.NoError(validations.ConfirmIstioCheckMessage("port.name.mismatch", vals[0]))
-	assert.Equal("spec/ports[0]", vals[0].Path)
-}
-
-func TestServicePortNamingWizard(t *testing.T) {
-	conf := config.NewConfig()
-	config.Set(conf)
-
-	assert := assert.New(t)
-
-	pmc := PortMappingChecker{
-		Service:     getService(9080, "status-port", nil, "test-namespace", "kiali_wizard", "labelName1"),
-		Deployments: getDeployment(9080),
-		Pods:        getPods(true),
-	}
-
-	vals, valid := pmc.Check()
-	assert.True(valid)
-	assert.Empty(vals)
-}
-
-func TestServicePortAppProtocol(t *testing.T) {
-	conf := config.NewConfig()
-	config.Set(conf)
-
-	assert := assert.New(t)
-
-	appProtocol := "mysql-wrong"
-	pmc := PortMappingChecker{
-		Service:     getService(9080, "database", &appProtocol, "test-namespace", "app", "labelName1"),
-		Deployments: getDeployment(9080),
-		Pods:        getPods(true),
-	}
-
-	vals, valid := pmc.Check()
-	assert.False(valid)
-	assert.NotEmpty(vals)
-	assert.NoError(validations.ConfirmIstioCheckMessage("port.appprotocol.mismatch", vals[0]))
-	assert.Equal("spec/ports[0]", vals[0].Path)
-
-	appProtocol = "mysql"
-	pmc = PortMappingChecker{
-		Service:     getService(9080, "database", &appProtocol, "test-namespace", "app", "labelName1"),
-		Deployments: getDeployment(9080),
-		Pods:        getPods(true),
-	}
-
-	vals, valid = pmc.Check()
-	assert.True(valid)
-	assert.Empty(vals)
-}
-
-func TestServicePortNamingWithoutSidecar(t *testing.T) {
-	conf := config.NewConfig()
-	config.Set(conf)
-
-	assert := assert.New(t)
-
-	pmc := PortMappingChecker{
-		Service:     getService(9080, "http2foo", nil, "test-namespace", "app", "labelName1"),
-		Deployments: getDeployment(9080),
-		Pods:        getPods(false),
 	}
 
 	vals, valid := pmc.Check()
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// PortProtocolChecker validates that Istio can tell the protocol of the ports of a Service of the mesh, from their
+// appProtocol or else from their name following the <protocol>[-suffix] convention. Otherwise Istio sniffs the
+// protocol of the connections, which breaks the routing and the telemetry of the server first protocols.
+type PortProtocolChecker struct {
+	Service core_v1.Service
+	Pods    []core_v1.Pod
+	// AppProtocolSupported is whether the cluster supports the appProtocol of the ports, preferred to their name
+	AppProtocolSupported bool
+}
+
+func (p PortProtocolChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if _, ok := p.Service.Labels["kiali_wizard"]; ok || !hasMatchingPodsWithSidecar(p.Service, p.Pods) {
+		return validations, true
+	}
+
+	for portIndex, sp := range p.Service.Spec.Ports {
+		path := fmt.Sprintf("spec/ports[%d]", portIndex)
+		if strings.ToLower(string(sp.Protocol)) == "udp" {
+			continue
+		} else if sp.AppProtocol != nil {
+			if !kubernetes.MatchPortAppProtocolWithValidProtocols(sp.AppProtocol) {
+				validation := models.Build("port.appprotocol.mismatch", path)
+				validations = append(validations, &validation)
+			}
+		} else if !kubernetes.MatchPortNameWithValidProtocols(sp.Name) {
+			validation := models.Build("port.name.mismatch", path)
+			validations = append(validations, &validation)
+		} else if p.AppProtocolSupported {
+			validation := models.Build("port.appprotocol.missing", path)
+			validations = append(validations, &validation)
+		}
+	}
+
+	valid := true
+	for _, validation := range validations {
+		valid = valid && validation.Severity != models.ErrorSeverity
+	}
+	return validations, valid
+}
+
+func hasMatchingPodsWithSidecar(service core_v1.Service, pods []core_v1.Pod) bool {
+	sPods := models.Pods{}
+	sPods.Parse(kubernetes.FilterPodsByService(&service, pods))
+	return sPods.HasIstioSidecar()
+}
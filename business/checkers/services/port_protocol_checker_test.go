@@ -0,0 +1,144 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestServicePortNaming(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	ppc := PortProtocolChecker{
+		Service: getService(9080, "http2foo", nil, "test-namespace", "app", "labelName1"),
+		Pods:    getPods(true),
+	}
+
+	vals, valid := ppc.Check()
+	assert.False(valid)
+	assert.NotEmpty(vals)
+	assert.NoError(validations.ConfirmIstioCheckMessage("port.name.mismatch", vals[0]))
+	assert.Equal("spec/ports[0]", vals[0].Path)
+}
+
+func TestServicePortNamingIstioSystem(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	ppc := PortProtocolChecker{
+		Service: getService(9080, "http2foo", nil, "istio-system", "app", "labelName1"),
+		Pods:    getPods(true),
+	}
+
+	vals, valid := ppc.Check()
+	assert.False(valid)
+	assert.NotEmpty(vals)
+	assert.NoError(validations.ConfirmIstioCheckMessage("port.name.mismatch", vals[0]))
+	assert.Equal("spec/ports[0]", vals[0].Path)
+}
+
+func TestServicePortNamingWizard(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	ppc := PortProtocolChecker{
+		Service: getService(9080, "status-port", nil, "test-namespace", "kiali_wizard", "labelName1"),
+		Pods:    getPods(true),
+	}
+
+	vals, valid := ppc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestServicePortAppProtocol(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	appProtocol := "mysql-wrong"
+	ppc := PortProtocolChecker{
+		Service: getService(9080, "database", &appProtocol, "test-namespace", "app", "labelName1"),
+		Pods:    getPods(true),
+	}
+
+	vals, valid := ppc.Check()
+	assert.False(valid)
+	assert.NotEmpty(vals)
+	assert.NoError(validations.ConfirmIstioCheckMessage("port.appprotocol.mismatch", vals[0]))
+	assert.Equal("spec/ports[0]", vals[0].Path)
+
+	appProtocol = "mysql"
+	ppc = PortProtocolChecker{
+		Service: getService(9080, "database", &appProtocol, "test-namespace", "app", "labelName1"),
+		Pods:    getPods(true),
+	}
+
+	vals, valid = ppc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestServicePortNamingWithoutSidecar(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	ppc := PortProtocolChecker{
+		Service: getService(9080, "http2foo", nil, "test-namespace", "app", "labelName1"),
+		Pods:    getPods(false),
+	}
+
+	vals, valid := ppc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestServicePortAppProtocolMissing(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	ppc := PortProtocolChecker{
+		Service:              getService(9080, "http-web", nil, "test-namespace", "app", "labelName1"),
+		Pods:                 getPods(true),
+		AppProtocolSupported: true,
+	}
+
+	vals, valid := ppc.Check()
+	assert.True(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.Unknown, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("port.appprotocol.missing", vals[0]))
+	assert.Equal("spec/ports[0]", vals[0].Path)
+
+	// The name is the only way to set the protocol when the cluster doesn't support appProtocol
+	ppc.AppProtocolSupported = false
+	vals, valid = ppc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+
+	appProtocol := "http"
+	ppc = PortProtocolChecker{
+		Service:              getService(9080, "web", &appProtocol, "test-namespace", "app", "labelName1"),
+		Pods:                 getPods(true),
+		AppProtocolSupported: true,
+	}
+	vals, valid = ppc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
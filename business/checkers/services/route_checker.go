@@ -0,0 +1,48 @@
+package services
+
+import (
+	osroutes_v1 "github.com/openshift/api/route/v1"
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// RouteChecker validates that the OpenShift Routes of a Service of the mesh expose it through an ingress gateway.
+// A Route sending the traffic straight to the workloads bypasses the gateway, so the edge traffic gets neither mTLS
+// nor the telemetry of the mesh.
+type RouteChecker struct {
+	Service core_v1.Service
+	Pods    []core_v1.Pod
+	Routes  []osroutes_v1.Route
+}
+
+func (r RouteChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	if len(r.Service.Spec.Selector) == 0 || len(kubernetes.FilterRoutesByService(r.Routes, r.Service.Namespace, r.Service.Name)) == 0 {
+		return validations, true
+	}
+
+	pods := kubernetes.FilterPodsByService(&r.Service, r.Pods)
+	if len(pods) == 0 {
+		return validations, true
+	}
+	for _, pod := range pods {
+		workload := models.Workload{}
+		workload.Labels = pod.Labels
+		workload.TemplateAnnotations = pod.Annotations
+		if workload.IsGateway() {
+			return validations, true
+		}
+	}
+
+	sPods := models.Pods{}
+	sPods.Parse(pods)
+	if sPods.HasAnyIstioSidecar() || sPods.HasAnyAmbient() {
+		validation := models.Build("service.route.bypassgateway", "")
+		validations = append(validations, &validation)
+	}
+
+	return validations, len(validations) == 0
+}
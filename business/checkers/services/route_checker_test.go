@@ -0,0 +1,123 @@
+package services
+
+import (
+	"testing"
+
+	osroutes_v1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/assert"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestRouteBypassingGateway(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	rc := RouteChecker{
+		Service: getService(9080, "http", nil, "test-namespace", "app", "labelName1"),
+		Pods:    getPods(true),
+		Routes:  []osroutes_v1.Route{getRoute("service1", "test-namespace")},
+	}
+
+	vals, valid := rc.Check()
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("service.route.bypassgateway", vals[0]))
+}
+
+func TestRouteAlternateBackendBypassingGateway(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	route := getRoute("service2", "test-namespace")
+	route.Spec.AlternateBackends = []osroutes_v1.RouteTargetReference{{Kind: "Service", Name: "service1"}}
+
+	rc := RouteChecker{
+		Service: getService(9080, "http", nil, "test-namespace", "app", "labelName1"),
+		Pods:    getPods(true),
+		Routes:  []osroutes_v1.Route{route},
+	}
+
+	vals, valid := rc.Check()
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.NoError(validations.ConfirmIstioCheckMessage("service.route.bypassgateway", vals[0]))
+}
+
+func TestRouteToIngressGateway(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	pods := getPods(true)
+	pods[0].Labels["istio"] = "ingressgateway"
+
+	rc := RouteChecker{
+		Service: getService(9080, "http", nil, "test-namespace", "app", "labelName1"),
+		Pods:    pods,
+		Routes:  []osroutes_v1.Route{getRoute("service1", "test-namespace")},
+	}
+
+	vals, valid := rc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestRouteOutOfMesh(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	rc := RouteChecker{
+		Service: getService(9080, "http", nil, "test-namespace", "app", "labelName1"),
+		Pods:    getPods(false),
+		Routes:  []osroutes_v1.Route{getRoute("service1", "test-namespace")},
+	}
+
+	vals, valid := rc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestNoRouteToService(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	rc := RouteChecker{
+		Service: getService(9080, "http", nil, "test-namespace", "app", "labelName1"),
+		Pods:    getPods(true),
+		Routes:  []osroutes_v1.Route{getRoute("service1", "other-namespace"), getRoute("service2", "test-namespace")},
+	}
+
+	vals, valid := rc.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func getRoute(serviceName string, namespace string) osroutes_v1.Route {
+	return osroutes_v1.Route{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "route1",
+			Namespace: namespace,
+		},
+		Spec: osroutes_v1.RouteSpec{
+			Host: "service1.apps.example.com",
+			To: osroutes_v1.RouteTargetReference{
+				Kind: "Service",
+				Name: serviceName,
+			},
+		},
+	}
+}
@@ -3,12 +3,16 @@ package checkers
 import (
 	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
 
+	"github.com/kiali/kiali/business/checkers/common"
+	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
 )
 
 type TelemetryChecker struct {
-	Namespaces  models.Namespaces
-	Telemetries []*telemetry_v1.Telemetry
+	Cluster               string
+	Namespaces            models.Namespaces
+	Telemetries           []*telemetry_v1.Telemetry
+	WorkloadsPerNamespace map[string]models.WorkloadList
 }
 
 // An Object Checker runs all checkers for an specific object type (i.e.: pod, route rule,...)
@@ -18,5 +22,34 @@ type TelemetryChecker struct {
 func (in TelemetryChecker) Check() models.IstioValidations {
 	validations := models.IstioValidations{}
 
+	validations = validations.MergeValidations(in.runIndividualChecks())
+	validations = validations.MergeValidations(in.runGroupChecks())
+
+	return validations
+}
+
+// runGroupChecks checks that a workload is selected by a single Telemetry of its namespace, as Istio does not define
+// which one applies otherwise.
+func (in TelemetryChecker) runGroupChecks() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	enabledCheckers := []GroupChecker{
+		common.TelemetryMultiMatchChecker(in.Cluster, kubernetes.Telemetries, in.Telemetries, in.WorkloadsPerNamespace),
+	}
+
+	for _, checker := range enabledCheckers {
+		validations = validations.MergeValidations(checker.Check())
+	}
+
+	return validations
+}
+
+func (in TelemetryChecker) runIndividualChecks() models.IstioValidations {
+	validations := models.IstioValidations{}
+
+	for _, telemetry := range in.Telemetries {
+		validations.MergeValidations(EmptyValidValidations(telemetry.Name, telemetry.Namespace, kubernetes.Telemetries, in.Cluster))
+	}
+
 	return validations
 }
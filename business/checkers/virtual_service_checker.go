@@ -62,6 +62,9 @@ func (in VirtualServiceChecker) runChecks(virtualService *networking_v1.VirtualS
 
 	enabledCheckers := []Checker{
 		virtualservices.RouteChecker{VirtualService: virtualService, Namespaces: in.Namespaces.GetNames()},
+		virtualservices.RegexChecker{VirtualService: virtualService},
+		virtualservices.RedirectChecker{VirtualService: virtualService},
+		virtualservices.CorsChecker{VirtualService: virtualService},
 		virtualservices.SubsetPresenceChecker{Namespaces: in.Namespaces.GetNames(), VirtualService: virtualService, DestinationRules: in.DestinationRules},
 	}
 	if !in.Namespaces.IsNamespaceAmbient(virtualService.Namespace, in.Cluster) {
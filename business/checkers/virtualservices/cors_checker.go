@@ -0,0 +1,91 @@
+package virtualservices
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/models"
+)
+
+// CorsChecker checks the CORS policies of the HTTP routes of a VirtualService:
+// 1. The allowed origins are a scheme and a host, with an optional port, as sent by the browsers in the Origin header.
+// 2. The maxAge is a valid, not negative, duration.
+// 3. The credentials are not allowed along any origin, which the browsers reject.
+type CorsChecker struct {
+	VirtualService *networking_v1.VirtualService
+}
+
+func (c CorsChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+	valid := true
+
+	add := func(key, path string) {
+		validation := models.Build(key, path)
+		valid = valid && validation.Severity != models.ErrorSeverity
+		validations = append(validations, &validation)
+	}
+
+	for i, httpRoute := range c.VirtualService.Spec.Http {
+		if httpRoute == nil || httpRoute.CorsPolicy == nil {
+			continue
+		}
+		cors := httpRoute.CorsPolicy
+		path := fmt.Sprintf("spec/http[%d]/corsPolicy", i)
+
+		wildcard := false
+		for j, origin := range cors.AllowOrigin {
+			if origin == "*" {
+				wildcard = true
+			} else if !isValidOrigin(origin) {
+				add("virtualservices.cors.origin.invalid", fmt.Sprintf("%s/allowOrigin[%d]", path, j))
+			}
+		}
+		for j, origin := range cors.AllowOrigins {
+			if origin == nil {
+				continue
+			}
+			switch m := origin.MatchType.(type) {
+			case *api_networking_v1.StringMatch_Exact:
+				if m.Exact == "*" {
+					wildcard = true
+				} else if !isValidOrigin(m.Exact) {
+					add("virtualservices.cors.origin.invalid", fmt.Sprintf("%s/allowOrigins[%d]/exact", path, j))
+				}
+			case *api_networking_v1.StringMatch_Prefix:
+				if m.Prefix == "" || m.Prefix == "*" {
+					wildcard = true
+				} else if !strings.Contains(m.Prefix, "://") {
+					add("virtualservices.cors.origin.invalid", fmt.Sprintf("%s/allowOrigins[%d]/prefix", path, j))
+				}
+			case *api_networking_v1.StringMatch_Regex:
+				if m.Regex == ".*" || m.Regex == ".+" {
+					wildcard = true
+				}
+			}
+		}
+
+		if cors.MaxAge != nil && (cors.MaxAge.CheckValid() != nil || cors.MaxAge.AsDuration() < 0) {
+			add("virtualservices.cors.maxage.invalid", path+"/maxAge")
+		}
+
+		if wildcard && cors.AllowCredentials != nil && cors.AllowCredentials.Value {
+			add("virtualservices.cors.credentials.wildcard", path+"/allowCredentials")
+		}
+	}
+
+	return validations, valid
+}
+
+// isValidOrigin returns whether the value is a serialized origin: a scheme and a host, with an optional port,
+// without any path.
+func isValidOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return u.Scheme != "" && u.Host != "" && u.User == nil && u.Path == "" && u.RawQuery == "" && u.Fragment == "" && !u.ForceQuery
+}
@@ -0,0 +1,81 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func fakeCorsVirtualService(cors *api_networking_v1.CorsPolicy) *networking_v1.VirtualService {
+	vs := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 100),
+		data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}),
+	)
+	vs.Spec.Http[0].CorsPolicy = cors
+	return vs
+}
+
+func exactMatch(value string) *api_networking_v1.StringMatch {
+	return &api_networking_v1.StringMatch{MatchType: &api_networking_v1.StringMatch_Exact{Exact: value}}
+}
+
+func TestValidCorsPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := fakeCorsVirtualService(&api_networking_v1.CorsPolicy{
+		AllowOrigin:      []string{"http://localhost:3000"},
+		AllowOrigins:     []*api_networking_v1.StringMatch{exactMatch("https://bookinfo.example.com"), regexMatch(`https://.*\.example\.com`)},
+		MaxAge:           durationpb.New(86400 * 1e9),
+		AllowCredentials: wrapperspb.Bool(true),
+	})
+
+	vals, valid := CorsChecker{VirtualService: vs}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestInvalidCorsOrigins(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := fakeCorsVirtualService(&api_networking_v1.CorsPolicy{
+		AllowOrigin: []string{"bookinfo.example.com"},
+		AllowOrigins: []*api_networking_v1.StringMatch{
+			exactMatch("https://bookinfo.example.com/"),
+			{MatchType: &api_networking_v1.StringMatch_Prefix{Prefix: "bookinfo"}},
+		},
+	})
+
+	vals, valid := CorsChecker{VirtualService: vs}.Check()
+	assert.True(valid)
+	assert.Len(vals, 3)
+	for _, val := range vals {
+		assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.cors.origin.invalid", val))
+	}
+	assert.Equal("spec/http[0]/corsPolicy/allowOrigin[0]", vals[0].Path)
+	assert.Equal("spec/http[0]/corsPolicy/allowOrigins[0]/exact", vals[1].Path)
+	assert.Equal("spec/http[0]/corsPolicy/allowOrigins[1]/prefix", vals[2].Path)
+}
+
+func TestCorsCredentialsWithWildcard(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := fakeCorsVirtualService(&api_networking_v1.CorsPolicy{
+		AllowOrigins:     []*api_networking_v1.StringMatch{exactMatch("*")},
+		MaxAge:           durationpb.New(-1e9),
+		AllowCredentials: wrapperspb.Bool(true),
+	})
+
+	vals, valid := CorsChecker{VirtualService: vs}.Check()
+	assert.False(valid)
+	assert.Len(vals, 2)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.cors.maxage.invalid", vals[0]))
+	assert.Equal("spec/http[0]/corsPolicy/maxAge", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.cors.credentials.wildcard", vals[1]))
+	assert.Equal("spec/http[0]/corsPolicy/allowCredentials", vals[1].Path)
+}
@@ -0,0 +1,146 @@
+package virtualservices
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/models"
+)
+
+// RedirectChecker checks the redirects and the rewrites of the HTTP routes of a VirtualService, whose mistakes
+// otherwise only show at request time:
+// 1. A route has only one of a redirect, destinations or a direct response.
+// 2. A redirect does not send the clients back to the route itself, in an endless loop.
+// 3. A URI rewrite replaces a matched URI prefix, otherwise it replaces the whole path.
+// 4. A host rewrite is a plain authority, without a scheme nor a path.
+type RedirectChecker struct {
+	VirtualService *networking_v1.VirtualService
+}
+
+func (r RedirectChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+	valid := true
+
+	add := func(key, path string) {
+		validation := models.Build(key, path)
+		valid = valid && validation.Severity != models.ErrorSeverity
+		validations = append(validations, &validation)
+	}
+
+	for i, httpRoute := range r.VirtualService.Spec.Http {
+		if httpRoute == nil {
+			continue
+		}
+		path := fmt.Sprintf("spec/http[%d]", i)
+
+		if redirect := httpRoute.Redirect; redirect != nil {
+			if len(httpRoute.Route) > 0 || httpRoute.DirectResponse != nil {
+				add("virtualservices.redirect.conflict", path+"/redirect")
+			} else if r.isRedirectLoop(httpRoute.Match, redirect) {
+				add("virtualservices.redirect.loop", path+"/redirect")
+			}
+		}
+
+		if rewrite := httpRoute.Rewrite; rewrite != nil {
+			if rewrite.Uri != "" && !matchesUriPrefixes(httpRoute.Match) {
+				add("virtualservices.rewrite.noprefix", path+"/rewrite/uri")
+			}
+			if rewrite.Authority != "" && strings.ContainsAny(rewrite.Authority, "/ \t") {
+				add("virtualservices.rewrite.authority.invalid", path+"/rewrite/authority")
+			}
+		}
+	}
+
+	return validations, valid
+}
+
+// isRedirectLoop returns whether the redirected request surely matches the route again: it keeps the host, the scheme
+// and the port, and its path matches a condition of the route that only checks the URI. A route without conditions
+// matches any path.
+func (r RedirectChecker) isRedirectLoop(matches []*api_networking_v1.HTTPMatchRequest, redirect *api_networking_v1.HTTPRedirect) bool {
+	if redirect.Authority != "" && !r.hasHost(redirect.Authority) {
+		return false
+	}
+	if redirect.Scheme != "" && redirect.Scheme != "http" {
+		return false
+	}
+	switch port := redirect.RedirectPort.(type) {
+	case *api_networking_v1.HTTPRedirect_Port:
+		if port.Port != 0 {
+			return false
+		}
+	case *api_networking_v1.HTTPRedirect_DerivePort:
+		if port.DerivePort != api_networking_v1.HTTPRedirect_FROM_REQUEST_PORT {
+			return false
+		}
+	}
+
+	if len(matches) == 0 {
+		return true
+	}
+	for _, match := range matches {
+		if match == nil || !onlyMatchesUri(match) {
+			continue
+		}
+		// The path is kept when the redirect has no URI
+		if redirect.Uri == "" || matchesUri(match, redirect.Uri) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r RedirectChecker) hasHost(authority string) bool {
+	for _, host := range r.VirtualService.Spec.Hosts {
+		if host == authority {
+			return true
+		}
+	}
+	return false
+}
+
+// onlyMatchesUri returns whether the condition only checks the URI of the requests.
+func onlyMatchesUri(match *api_networking_v1.HTTPMatchRequest) bool {
+	return match.Uri != nil && match.Scheme == nil && match.Method == nil && match.Authority == nil &&
+		len(match.Headers) == 0 && len(match.QueryParams) == 0 && len(match.WithoutHeaders) == 0 &&
+		match.Port == 0 && len(match.SourceLabels) == 0 && len(match.Gateways) == 0 && match.SourceNamespace == ""
+}
+
+func matchesUri(match *api_networking_v1.HTTPMatchRequest, uri string) bool {
+	expected := func(value string) string {
+		if match.IgnoreUriCase {
+			return strings.ToLower(value)
+		}
+		return value
+	}
+	switch m := match.Uri.MatchType.(type) {
+	case *api_networking_v1.StringMatch_Exact:
+		return expected(uri) == expected(m.Exact)
+	case *api_networking_v1.StringMatch_Prefix:
+		return strings.HasPrefix(expected(uri), expected(m.Prefix))
+	case *api_networking_v1.StringMatch_Regex:
+		re, err := regexp.Compile("^(?:" + m.Regex + ")$")
+		return err == nil && re.MatchString(uri)
+	}
+	return false
+}
+
+// matchesUriPrefixes returns whether all the conditions of the route match a URI prefix.
+func matchesUriPrefixes(matches []*api_networking_v1.HTTPMatchRequest) bool {
+	if len(matches) == 0 {
+		return false
+	}
+	for _, match := range matches {
+		if match == nil || match.Uri == nil {
+			return false
+		}
+		if _, ok := match.Uri.MatchType.(*api_networking_v1.StringMatch_Prefix); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func prefixMatch(prefix string) *api_networking_v1.HTTPMatchRequest {
+	return &api_networking_v1.HTTPMatchRequest{Uri: &api_networking_v1.StringMatch{MatchType: &api_networking_v1.StringMatch_Prefix{Prefix: prefix}}}
+}
+
+func fakeRedirectVirtualService(match *api_networking_v1.HTTPMatchRequest, redirect *api_networking_v1.HTTPRedirect) *networking_v1.VirtualService {
+	vs := data.CreateEmptyVirtualService("reviews", "test", []string{"reviews.example.com"})
+	vs.Spec.Http = []*api_networking_v1.HTTPRoute{{Redirect: redirect}}
+	if match != nil {
+		vs.Spec.Http[0].Match = []*api_networking_v1.HTTPMatchRequest{match}
+	}
+	return vs
+}
+
+func TestValidRedirects(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, vs := range []*networking_v1.VirtualService{
+		// https upgrade
+		fakeRedirectVirtualService(nil, &api_networking_v1.HTTPRedirect{Scheme: "https"}),
+		// Another host
+		fakeRedirectVirtualService(nil, &api_networking_v1.HTTPRedirect{Authority: "ratings.example.com"}),
+		// Another port
+		fakeRedirectVirtualService(nil, &api_networking_v1.HTTPRedirect{RedirectPort: &api_networking_v1.HTTPRedirect_Port{Port: 8443}}),
+		// A path out of the match
+		fakeRedirectVirtualService(prefixMatch("/v1/"), &api_networking_v1.HTTPRedirect{Uri: "/v2/reviews"}),
+	} {
+		vals, valid := RedirectChecker{VirtualService: vs}.Check()
+		assert.True(valid)
+		assert.Empty(vals)
+	}
+}
+
+func TestRedirectLoops(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, vs := range []*networking_v1.VirtualService{
+		fakeRedirectVirtualService(nil, &api_networking_v1.HTTPRedirect{Uri: "/reviews"}),
+		fakeRedirectVirtualService(prefixMatch("/v1/"), &api_networking_v1.HTTPRedirect{Authority: "reviews.example.com"}),
+		fakeRedirectVirtualService(prefixMatch("/v1/"), &api_networking_v1.HTTPRedirect{Uri: "/v1/reviews", Scheme: "http"}),
+	} {
+		vals, valid := RedirectChecker{VirtualService: vs}.Check()
+		assert.False(valid)
+		assert.Len(vals, 1)
+		assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.redirect.loop", vals[0]))
+		assert.Equal("spec/http[0]/redirect", vals[0].Path)
+	}
+}
+
+func TestRedirectLoopOtherConditions(t *testing.T) {
+	assert := assert.New(t)
+
+	// The redirected request may not have the header anymore
+	match := prefixMatch("/v1/")
+	match.Headers = map[string]*api_networking_v1.StringMatch{"end-user": {MatchType: &api_networking_v1.StringMatch_Exact{Exact: "jason"}}}
+	vs := fakeRedirectVirtualService(match, &api_networking_v1.HTTPRedirect{Uri: "/v1/reviews"})
+
+	vals, valid := RedirectChecker{VirtualService: vs}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestRedirectConflict(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 100),
+		data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}),
+	)
+	vs.Spec.Http[0].Redirect = &api_networking_v1.HTTPRedirect{Authority: "ratings"}
+
+	vals, valid := RedirectChecker{VirtualService: vs}.Check()
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.redirect.conflict", vals[0]))
+	assert.Equal("spec/http[0]/redirect", vals[0].Path)
+}
+
+func TestRewrites(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 100),
+		data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}),
+	)
+	vs.Spec.Http[0].Match = []*api_networking_v1.HTTPMatchRequest{prefixMatch("/v1/")}
+	vs.Spec.Http[0].Rewrite = &api_networking_v1.HTTPRewrite{Uri: "/", Authority: "reviews.example.com"}
+
+	vals, valid := RedirectChecker{VirtualService: vs}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+
+	vs.Spec.Http[0].Match = nil
+	vs.Spec.Http[0].Rewrite.Authority = "http://reviews.example.com/"
+
+	vals, valid = RedirectChecker{VirtualService: vs}.Check()
+	assert.False(valid)
+	assert.Len(vals, 2)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.rewrite.noprefix", vals[0]))
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/rewrite/uri", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.rewrite.authority.invalid", vals[1]))
+	assert.Equal("spec/http[0]/rewrite/authority", vals[1].Path)
+}
@@ -0,0 +1,109 @@
+package virtualservices
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"sort"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/models"
+)
+
+// The program sizes of the regexes above which Envoy warns and rejects the config, as set by Istio in the runtime of
+// the proxies (re2.max_program_size.warn_level and re2.max_program_size.error_level).
+const (
+	regexProgramSizeWarnLevel  = 1024
+	regexProgramSizeErrorLevel = 32768
+)
+
+// RegexChecker compiles the regexes of the HTTP matches and of the CORS policies of a VirtualService with the RE2
+// syntax used by Envoy. Invalid or empty regexes and regexes exceeding the program size accepted by Envoy are errors:
+// Envoy rejects the whole config. The program size is approximated by the number of instructions of the Go regexp,
+// which implements the same syntax.
+type RegexChecker struct {
+	VirtualService *networking_v1.VirtualService
+}
+
+func (r RegexChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+	valid := true
+
+	check := func(stringMatch *api_networking_v1.StringMatch, path string) {
+		if stringMatch == nil {
+			return
+		}
+		regex, ok := stringMatch.MatchType.(*api_networking_v1.StringMatch_Regex)
+		if !ok {
+			return
+		}
+		var key string
+		switch size := regexProgramSize(regex.Regex); {
+		case size < 0:
+			key = "virtualservices.match.regex.invalid"
+		case size > regexProgramSizeErrorLevel:
+			key = "virtualservices.match.regex.toolarge"
+		case size > regexProgramSizeWarnLevel:
+			key = "virtualservices.match.regex.large"
+		default:
+			return
+		}
+		validation := models.Build(key, path+"/regex")
+		valid = valid && validation.Severity != models.ErrorSeverity
+		validations = append(validations, &validation)
+	}
+	// The regexes of the maps are checked in the order of their keys, for stable paths
+	checkMap := func(stringMatches map[string]*api_networking_v1.StringMatch, path string) {
+		names := make([]string, 0, len(stringMatches))
+		for name := range stringMatches {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			check(stringMatches[name], fmt.Sprintf("%s/%s", path, name))
+		}
+	}
+
+	for i, httpRoute := range r.VirtualService.Spec.Http {
+		if httpRoute == nil {
+			continue
+		}
+		for j, match := range httpRoute.Match {
+			if match == nil {
+				continue
+			}
+			path := fmt.Sprintf("spec/http[%d]/match[%d]", i, j)
+			check(match.Uri, path+"/uri")
+			check(match.Scheme, path+"/scheme")
+			check(match.Method, path+"/method")
+			check(match.Authority, path+"/authority")
+			checkMap(match.Headers, path+"/headers")
+			checkMap(match.QueryParams, path+"/queryParams")
+			checkMap(match.WithoutHeaders, path+"/withoutHeaders")
+		}
+		if httpRoute.CorsPolicy != nil {
+			for k, origin := range httpRoute.CorsPolicy.AllowOrigins {
+				check(origin, fmt.Sprintf("spec/http[%d]/corsPolicy/allowOrigins[%d]", i, k))
+			}
+		}
+	}
+
+	return validations, valid
+}
+
+// regexProgramSize returns the number of instructions of the compiled regex, or -1 when it is empty or invalid.
+func regexProgramSize(regex string) int {
+	if regex == "" {
+		return -1
+	}
+	re, err := syntax.Parse(regex, syntax.Perl)
+	if err != nil {
+		return -1
+	}
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return -1
+	}
+	return len(prog.Inst)
+}
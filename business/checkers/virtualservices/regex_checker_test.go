@@ -0,0 +1,84 @@
+package virtualservices
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func regexMatch(regex string) *api_networking_v1.StringMatch {
+	return &api_networking_v1.StringMatch{MatchType: &api_networking_v1.StringMatch_Regex{Regex: regex}}
+}
+
+func fakeRegexVirtualService(match *api_networking_v1.HTTPMatchRequest) *networking_v1.VirtualService {
+	vs := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 100),
+		data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}),
+	)
+	vs.Spec.Http[0].Match = []*api_networking_v1.HTTPMatchRequest{match}
+	return vs
+}
+
+func TestValidRegexes(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := fakeRegexVirtualService(&api_networking_v1.HTTPMatchRequest{
+		Uri:     regexMatch("/reviews/[0-9]+"),
+		Headers: map[string]*api_networking_v1.StringMatch{"end-user": regexMatch("(jason|kiali)")},
+	})
+	vs.Spec.Http[0].CorsPolicy = &api_networking_v1.CorsPolicy{AllowOrigins: []*api_networking_v1.StringMatch{regexMatch(`https://.*\.example\.com`)}}
+
+	vals, valid := RegexChecker{VirtualService: vs}.Check()
+	assert.True(valid)
+	assert.Empty(vals)
+}
+
+func TestInvalidRegexes(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := fakeRegexVirtualService(&api_networking_v1.HTTPMatchRequest{
+		// Lookarounds are not supported by RE2
+		Uri: regexMatch("/reviews/(?!admin).*"),
+		Headers: map[string]*api_networking_v1.StringMatch{
+			"end-user": regexMatch(""),
+			"x-id":     {MatchType: &api_networking_v1.StringMatch_Exact{Exact: "(("}},
+		},
+		QueryParams: map[string]*api_networking_v1.StringMatch{"id": regexMatch("[0-9]{1001}")},
+	})
+
+	vals, valid := RegexChecker{VirtualService: vs}.Check()
+	assert.False(valid)
+	assert.Len(vals, 3)
+	for _, val := range vals {
+		assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.match.regex.invalid", val))
+		assert.Equal(models.ErrorSeverity, val.Severity)
+	}
+	assert.Equal("spec/http[0]/match[0]/uri/regex", vals[0].Path)
+	assert.Equal("spec/http[0]/match[0]/headers/end-user/regex", vals[1].Path)
+	assert.Equal("spec/http[0]/match[0]/queryParams/id/regex", vals[2].Path)
+}
+
+func TestLargeRegexes(t *testing.T) {
+	assert := assert.New(t)
+
+	vs := fakeRegexVirtualService(&api_networking_v1.HTTPMatchRequest{
+		Uri:       regexMatch("/reviews/[a-z]{600}/[a-z]{600}"),
+		Authority: regexMatch("(" + strings.Repeat("abcdefghij", 4) + "){1000}"),
+	})
+
+	vals, valid := RegexChecker{VirtualService: vs}.Check()
+	assert.False(valid)
+	assert.Len(vals, 2)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.match.regex.large", vals[0]))
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.Equal("spec/http[0]/match[0]/uri/regex", vals[0].Path)
+	assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.match.regex.toolarge", vals[1]))
+	assert.Equal(models.ErrorSeverity, vals[1].Severity)
+	assert.Equal("spec/http[0]/match[0]/authority/regex", vals[1].Path)
+}
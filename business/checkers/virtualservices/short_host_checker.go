@@ -0,0 +1,82 @@
+package virtualservices
+
+import (
+	"fmt"
+
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// ShortHostChecker warns about the short host names of a VirtualService, i.e. "reviews", when services of the same
+// name exist in other namespaces. Istio resolves a short name in the namespace of the VirtualService, which may not
+// be the namespace of the service the user meant.
+type ShortHostChecker struct {
+	VirtualService   *networking_v1.VirtualService
+	RegistryServices []*kubernetes.RegistryService
+}
+
+func (s ShortHostChecker) Check() ([]*models.IstioCheck, bool) {
+	validations := make([]*models.IstioCheck, 0)
+
+	check := func(host, path string) {
+		if s.isAmbiguous(host) {
+			validation := models.Build("virtualservices.host.shortname", path)
+			validations = append(validations, &validation)
+		}
+	}
+
+	for i, host := range s.VirtualService.Spec.Hosts {
+		check(host, fmt.Sprintf("spec/hosts[%d]", i))
+	}
+	for k, httpRoute := range s.VirtualService.Spec.Http {
+		if httpRoute == nil {
+			continue
+		}
+		for i, dest := range httpRoute.Route {
+			if dest != nil && dest.Destination != nil {
+				check(dest.Destination.Host, fmt.Sprintf("spec/http[%d]/route[%d]/destination/host", k, i))
+			}
+		}
+		if httpRoute.Mirror != nil {
+			check(httpRoute.Mirror.Host, fmt.Sprintf("spec/http[%d]/mirror/host", k))
+		}
+	}
+	for k, tcpRoute := range s.VirtualService.Spec.Tcp {
+		if tcpRoute == nil {
+			continue
+		}
+		for i, dest := range tcpRoute.Route {
+			if dest != nil && dest.Destination != nil {
+				check(dest.Destination.Host, fmt.Sprintf("spec/tcp[%d]/route[%d]/destination/host", k, i))
+			}
+		}
+	}
+	for k, tlsRoute := range s.VirtualService.Spec.Tls {
+		if tlsRoute == nil {
+			continue
+		}
+		for i, dest := range tlsRoute.Route {
+			if dest != nil && dest.Destination != nil {
+				check(dest.Destination.Host, fmt.Sprintf("spec/tls[%d]/route[%d]/destination/host", k, i))
+			}
+		}
+	}
+
+	return validations, true
+}
+
+// isAmbiguous returns whether the host is a short name that would match a service in another namespace.
+func (s ShortHostChecker) isAmbiguous(host string) bool {
+	if !kubernetes.IsShortHost(host) {
+		return false
+	}
+	for _, rs := range s.RegistryServices {
+		namespace := rs.Attributes.Namespace
+		if namespace != s.VirtualService.Namespace && rs.Hostname == kubernetes.NormalizeHost(host, namespace, nil) {
+			return true
+		}
+	}
+	return false
+}
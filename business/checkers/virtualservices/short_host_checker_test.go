@@ -0,0 +1,61 @@
+package virtualservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestShortHostInOtherNamespace(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	virtualService := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", -1),
+		data.AddTcpRoutesToVirtualService(data.CreateTcpRoute("ratings.bookinfo2", "v1", -1),
+			data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+		),
+	)
+	registryServices := append(data.CreateFakeRegistryServices("reviews.bookinfo.svc.cluster.local", "bookinfo", "*"),
+		data.CreateFakeRegistryServices("reviews.bookinfo2.svc.cluster.local", "bookinfo2", "*")...)
+	registryServices = append(registryServices, data.CreateFakeRegistryServices("ratings.bookinfo2.svc.cluster.local", "bookinfo2", "*")...)
+
+	vals, valid := ShortHostChecker{
+		VirtualService:   virtualService,
+		RegistryServices: registryServices,
+	}.Check()
+
+	assert.True(valid)
+	assert.Len(vals, 2)
+	for _, val := range vals {
+		assert.Equal(models.WarningSeverity, val.Severity)
+		assert.NoError(validations.ConfirmIstioCheckMessage("virtualservices.host.shortname", val))
+	}
+	assert.Equal("spec/hosts[0]", vals[0].Path)
+	assert.Equal("spec/http[0]/route[0]/destination/host", vals[1].Path)
+}
+
+func TestShortHostInSameNamespace(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	virtualService := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", -1),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+	)
+
+	vals, valid := ShortHostChecker{
+		VirtualService:   virtualService,
+		RegistryServices: data.CreateFakeRegistryServices("reviews.bookinfo.svc.cluster.local", "bookinfo", "*"),
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
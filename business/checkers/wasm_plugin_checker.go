@@ -3,12 +3,17 @@ package checkers
 import (
 	extentions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 
+	"github.com/kiali/kiali/business/checkers/common"
+	"github.com/kiali/kiali/business/checkers/wasmplugins"
+	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
 )
 
 type WasmPluginChecker struct {
-	Namespaces  models.Namespaces
-	WasmPlugins []*extentions_v1alpha1.WasmPlugin
+	Cluster               string
+	Namespaces            models.Namespaces
+	WasmPlugins           []*extentions_v1alpha1.WasmPlugin
+	WorkloadsPerNamespace map[string]models.WorkloadList
 }
 
 // An Object Checker runs all checkers for an specific object type (i.e.: pod, route rule,...)
@@ -18,5 +23,29 @@ type WasmPluginChecker struct {
 func (in WasmPluginChecker) Check() models.IstioValidations {
 	validations := models.IstioValidations{}
 
+	for _, wasmPlugin := range in.WasmPlugins {
+		validations.MergeValidations(in.runChecks(wasmPlugin))
+	}
+
 	return validations
 }
+
+func (in WasmPluginChecker) runChecks(wasmPlugin *extentions_v1alpha1.WasmPlugin) models.IstioValidations {
+	key, rrValidation := EmptyValidValidation(wasmPlugin.Name, wasmPlugin.Namespace, kubernetes.WasmPlugins, in.Cluster)
+
+	enabledCheckers := []Checker{
+		wasmplugins.URLChecker{WasmPlugin: wasmPlugin},
+	}
+	// The plugins attached to Gateways by targetRef do not use the selector
+	if wasmPlugin.Spec.TargetRef == nil && len(wasmPlugin.Spec.TargetRefs) == 0 && wasmPlugin.Spec.Selector != nil {
+		enabledCheckers = append(enabledCheckers, common.SelectorNoWorkloadFoundChecker(kubernetes.WasmPlugins, wasmPlugin.Spec.Selector.MatchLabels, in.WorkloadsPerNamespace))
+	}
+
+	for _, checker := range enabledCheckers {
+		checks, validChecker := checker.Check()
+		rrValidation.Checks = append(rrValidation.Checks, checks...)
+		rrValidation.Valid = rrValidation.Valid && validChecker
+	}
+
+	return models.IstioValidations{key: rrValidation}
+}
@@ -0,0 +1,49 @@
+package wasmplugins
+
+import (
+	"net/url"
+
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+
+	"github.com/kiali/kiali/models"
+)
+
+// URLChecker validates the URL the proxies fetch the Wasm module from. As Istio does, a URL without scheme is an
+// OCI image reference.
+type URLChecker struct {
+	WasmPlugin *extensions_v1alpha1.WasmPlugin
+}
+
+func (uc URLChecker) Check() ([]*models.IstioCheck, bool) {
+	checks, valid := make([]*models.IstioCheck, 0), true
+	moduleURL := uc.WasmPlugin.Spec.Url
+	if moduleURL == "" {
+		return checks, valid
+	}
+
+	parsed, err := url.Parse(moduleURL)
+	if err != nil || !isValidModuleURL(parsed) {
+		validation := models.Build("wasmplugin.url.invalid", "spec/url")
+		return append(checks, &validation), false
+	}
+
+	if parsed.Scheme == "http" && uc.WasmPlugin.Spec.Sha256 == "" {
+		validation := models.Build("wasmplugin.url.insecure", "spec/url")
+		checks = append(checks, &validation)
+	}
+
+	return checks, valid
+}
+
+func isValidModuleURL(parsed *url.URL) bool {
+	switch parsed.Scheme {
+	case "":
+		return parsed.Path != ""
+	case "oci", "http", "https":
+		return parsed.Host != ""
+	case "file":
+		return parsed.Path != ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,59 @@
+package wasmplugins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	api_extensions_v1alpha1 "istio.io/api/extensions/v1alpha1"
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func wasmPlugin(url, sha256 string) *extensions_v1alpha1.WasmPlugin {
+	return &extensions_v1alpha1.WasmPlugin{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "plugin", Namespace: "bookinfo"},
+		Spec:       api_extensions_v1alpha1.WasmPlugin{Url: url, Sha256: sha256},
+	}
+}
+
+func TestValidModuleURLs(t *testing.T) {
+	for _, url := range []string{
+		"oci://ghcr.io/istio-ecosystem/wasm-extensions/basic_auth:1.12.0",
+		"ghcr.io/istio-ecosystem/wasm-extensions/basic_auth:1.12.0",
+		"https://storage.example.com/plugins/basic_auth.wasm",
+		"file:///opt/filters/basic_auth.wasm",
+	} {
+		checks, valid := URLChecker{WasmPlugin: wasmPlugin(url, "")}.Check()
+		assert.True(t, valid, url)
+		assert.Empty(t, checks, url)
+	}
+}
+
+func TestInvalidModuleURLs(t *testing.T) {
+	for _, url := range []string{
+		"ftp://storage.example.com/plugins/basic_auth.wasm",
+		"https:///plugins/basic_auth.wasm",
+		"oci://",
+		"file://",
+		"http://[::1",
+	} {
+		checks, valid := URLChecker{WasmPlugin: wasmPlugin(url, "")}.Check()
+		assert.False(t, valid, url)
+		assert.Len(t, checks, 1, url)
+		assert.NoError(t, validations.ConfirmIstioCheckMessage("wasmplugin.url.invalid", checks[0]))
+		assert.Equal(t, "spec/url", checks[0].Path)
+	}
+}
+
+func TestInsecureModuleURL(t *testing.T) {
+	checks, valid := URLChecker{WasmPlugin: wasmPlugin("http://storage.example.com/plugins/basic_auth.wasm", "")}.Check()
+	assert.True(t, valid)
+	assert.Len(t, checks, 1)
+	assert.NoError(t, validations.ConfirmIstioCheckMessage("wasmplugin.url.insecure", checks[0]))
+
+	checks, valid = URLChecker{WasmPlugin: wasmPlugin("http://storage.example.com/plugins/basic_auth.wasm", "5c4b2e3a")}.Check()
+	assert.True(t, valid)
+	assert.Empty(t, checks)
+}
@@ -0,0 +1,23 @@
+package workloads
+
+import (
+	"github.com/kiali/kiali/models"
+)
+
+// ImageAutoChecker reports workloads with pods running a container with the image "auto", as the image
+// auto analyzer of istioctl does. The injector replaces that image by the proxy one, so these pods were
+// not injected, typically because the injection is not enabled for their namespace or revision.
+type ImageAutoChecker struct {
+	Workload models.WorkloadListItem
+}
+
+func (iac ImageAutoChecker) Check() ([]*models.IstioCheck, bool) {
+	checks := make([]*models.IstioCheck, 0)
+
+	if len(iac.Workload.ImageAutoPods) > 0 {
+		check := models.Build("workload.image.auto", "workload")
+		checks = append(checks, &check)
+	}
+
+	return checks, len(checks) == 0
+}
@@ -0,0 +1,45 @@
+package workloads
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestImageAuto(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	pods := models.Pods{
+		{Name: "istio-ingressgateway-1234", Containers: []*models.ContainerInfo{{Name: "istio-proxy", Image: "auto"}}},
+		{Name: "istio-ingressgateway-5678", IstioContainers: []*models.ContainerInfo{{Name: "istio-proxy", Image: "docker.io/istio/proxyv2:1.22.0"}}},
+	}
+	vals, valid := ImageAutoChecker{
+		Workload: models.WorkloadListItem{Name: "istio-ingressgateway", ImageAutoPods: pods.ImageAuto()},
+	}.Check()
+
+	assert.False(valid)
+	assert.Len(vals, 1)
+	assert.Equal(models.ErrorSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("workload.image.auto", vals[0]))
+	assert.Equal("workload", vals[0].Path)
+}
+
+func TestInjectedImageAuto(t *testing.T) {
+	config.Set(config.NewConfig())
+	assert := assert.New(t)
+
+	pods := models.Pods{
+		{Name: "istio-ingressgateway-5678", IstioContainers: []*models.ContainerInfo{{Name: "istio-proxy", Image: "docker.io/istio/proxyv2:1.22.0"}}},
+	}
+	vals, valid := ImageAutoChecker{
+		Workload: models.WorkloadListItem{Name: "istio-ingressgateway", ImageAutoPods: pods.ImageAuto()},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
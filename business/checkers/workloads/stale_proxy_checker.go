@@ -0,0 +1,22 @@
+package workloads
+
+import (
+	"github.com/kiali/kiali/models"
+)
+
+// StaleProxyChecker warns about workloads with pods whose proxy has not acknowledged
+// the last xDS configuration (CDS, EDS, LDS or RDS) pushed by istiod.
+type StaleProxyChecker struct {
+	Workload models.WorkloadListItem
+}
+
+func (spc StaleProxyChecker) Check() ([]*models.IstioCheck, bool) {
+	checks, valid := make([]*models.IstioCheck, 0), true
+
+	if len(spc.Workload.StaleProxyPods) > 0 {
+		check := models.Build("workload.proxy.stale", "workload")
+		checks = append(checks, &check)
+	}
+
+	return checks, valid
+}
@@ -0,0 +1,42 @@
+package workloads
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/testutils/validations"
+)
+
+func TestStaleProxies(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := StaleProxyChecker{
+		Workload: models.WorkloadListItem{Name: "details-v1", StaleProxyPods: []string{"details-v1-1234"}},
+	}.Check()
+
+	assert.True(valid)
+	assert.NotEmpty(vals)
+	assert.Equal(models.WarningSeverity, vals[0].Severity)
+	assert.NoError(validations.ConfirmIstioCheckMessage("workload.proxy.stale", vals[0]))
+	assert.Equal("workload", vals[0].Path)
+}
+
+func TestSyncedProxies(t *testing.T) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert := assert.New(t)
+
+	vals, valid := StaleProxyChecker{
+		Workload: models.WorkloadListItem{Name: "details-v1", StaleProxyPods: []string{}},
+	}.Check()
+
+	assert.True(valid)
+	assert.Empty(vals)
+}
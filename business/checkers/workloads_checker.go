@@ -1,10 +1,13 @@
 package checkers
 
 import (
+	"istio.io/api/annotation"
 	security_v1 "istio.io/client-go/pkg/apis/security/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/kiali/kiali/business/checkers/common"
 	"github.com/kiali/kiali/business/checkers/workloads"
+	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/models"
 )
 
@@ -35,6 +38,9 @@ func (w WorkloadChecker) runChecks(workload models.WorkloadListItem, namespace s
 
 	enabledCheckers := []Checker{
 		workloads.UncoveredWorkloadChecker{Workload: workload, Namespace: namespace, AuthorizationPolicies: w.AuthorizationPolicies},
+		workloads.StaleProxyChecker{Workload: workload},
+		workloads.ImageAutoChecker{Workload: workload},
+		common.DeprecatedAnnotationsChecker{Annotations: workload.TemplateAnnotations, Labels: workload.Labels, Path: annotationsPath(workload), Resource: annotation.Pod},
 	}
 
 	for _, checker := range enabledCheckers {
@@ -45,3 +51,11 @@ func (w WorkloadChecker) runChecks(workload models.WorkloadListItem, namespace s
 
 	return models.IstioValidations{key: rrValidation}
 }
+
+// annotationsPath returns the path of the pod annotations of the workload.
+func annotationsPath(workload models.WorkloadListItem) string {
+	if workload.WorkloadGVK == kubernetes.Pods {
+		return "metadata/annotations"
+	}
+	return "spec/template/metadata/annotations"
+}
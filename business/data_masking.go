@@ -0,0 +1,136 @@
+package business
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+)
+
+// MaskedValue replaces the redacted values.
+const MaskedValue = "*****"
+
+// DataMasker redacts the sensitive values of the Istio config, see config.DataMasking.
+type DataMasker struct {
+	fields             [][]string
+	annotationPatterns []*regexp.Regexp
+}
+
+// NewDataMasker creates a new DataMasker redacting the configured fields and annotations.
+func NewDataMasker(conf config.DataMasking) (*DataMasker, error) {
+	masker := &DataMasker{}
+	for _, field := range conf.Fields {
+		masker.fields = append(masker.fields, strings.Split(field, "."))
+	}
+	for _, pattern := range conf.AnnotationPatterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		masker.annotationPatterns = append(masker.annotationPatterns, re)
+	}
+	return masker, nil
+}
+
+// Mask returns the JSON representation of the payload, as generic maps and lists, with the sensitive values redacted.
+// The payload is left untouched.
+func (m *DataMasker) Mask(payload interface{}) (interface{}, error) {
+	content, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	if err := json.Unmarshal(content, &tree); err != nil {
+		return nil, err
+	}
+	return m.mask(tree, nil), nil
+}
+
+// mask redacts the value found at the path, the keys of the maps leading to it. The lists are not part of the path.
+func (m *DataMasker) mask(value interface{}, path []string) interface{} {
+	if m.isMaskedField(path) {
+		return redact(value)
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		isAnnotations := len(path) >= 2 && path[len(path)-2] == "metadata" && path[len(path)-1] == "annotations"
+		for key, child := range v {
+			if isAnnotations && m.isMaskedAnnotation(key) {
+				v[key] = redact(child)
+				continue
+			}
+			v[key] = m.mask(child, append(path[:len(path):len(path)], key))
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = m.mask(child, path)
+		}
+	}
+	return value
+}
+
+func (m *DataMasker) isMaskedField(path []string) bool {
+	for _, field := range m.fields {
+		if len(field) <= len(path) && slices.Equal(field, path[len(path)-len(field):]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *DataMasker) isMaskedAnnotation(key string) bool {
+	for _, re := range m.annotationPatterns {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact replaces the value, keeping the lists so that the clients still find the type they expect.
+func redact(value interface{}) interface{} {
+	if list, ok := value.([]interface{}); ok {
+		for i, item := range list {
+			list[i] = redact(item)
+		}
+		return list
+	}
+	return MaskedValue
+}
+
+// indexRegexp matches the indexes of the lists in the paths of the fields.
+var indexRegexp = regexp.MustCompile(`\[[0-9]+\]`)
+
+// isMaskedPath returns whether the field of the path is redacted, the path being like
+// "spec.servers[0].tls.credentialName".
+func (m *DataMasker) isMaskedPath(path string) bool {
+	segments := strings.Split(indexRegexp.ReplaceAllString(path, ""), ".")
+	for i := range segments {
+		if m.isMaskedField(segments[:i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskDiff redacts the values of the sensitive fields of the diff. The values are still compared, the client only
+// learns that they differ.
+func (m *DataMasker) MaskDiff(diff *models.IstioConfigDiff) {
+	for i := range diff.Objects {
+		for j := range diff.Objects[i].Fields {
+			field := &diff.Objects[i].Fields[j]
+			if !m.isMaskedPath(field.Path) {
+				continue
+			}
+			if field.Source != nil {
+				field.Source = MaskedValue
+			}
+			if field.Target != nil {
+				field.Target = MaskedValue
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	api_security_v1 "istio.io/api/security/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	security_v1 "istio.io/client-go/pkg/apis/security/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+)
+
+func TestDataMaskerMask(t *testing.T) {
+	require := require.New(t)
+
+	masker, err := NewDataMasker(config.NewConfig().DataMasking)
+	require.NoError(err)
+
+	gateway := &networking_v1.Gateway{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:        "bookinfo-gateway",
+			Annotations: map[string]string{"example.com/api-token": "abc", "example.com/owner": "team-a"},
+		},
+		Spec: api_networking_v1.Gateway{
+			Servers: []*api_networking_v1.Server{{
+				Hosts: []string{"bookinfo.example.com"},
+				Tls:   &api_networking_v1.ServerTLSSettings{CredentialName: "bookinfo-cert"},
+			}},
+		},
+	}
+	requestAuthentication := &security_v1.RequestAuthentication{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "jwt"},
+		Spec: api_security_v1.RequestAuthentication{
+			JwtRules: []*api_security_v1.JWTRule{{Issuer: "issuer", Audiences: []string{"bookinfo", "travels"}}},
+		},
+	}
+
+	masked, err := masker.Mask(map[string]interface{}{"gateways": []interface{}{gateway}, "requestAuthentication": requestAuthentication})
+	require.NoError(err)
+	tree := masked.(map[string]interface{})
+
+	maskedGateway := tree["gateways"].([]interface{})[0].(map[string]interface{})
+	annotations := maskedGateway["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+	require.Equal(MaskedValue, annotations["example.com/api-token"])
+	require.Equal("team-a", annotations["example.com/owner"])
+	server := maskedGateway["spec"].(map[string]interface{})["servers"].([]interface{})[0].(map[string]interface{})
+	require.Equal(MaskedValue, server["tls"].(map[string]interface{})["credentialName"])
+	require.Equal([]interface{}{"bookinfo.example.com"}, server["hosts"])
+
+	jwtRule := tree["requestAuthentication"].(map[string]interface{})["spec"].(map[string]interface{})["jwtRules"].([]interface{})[0].(map[string]interface{})
+	// The lists are kept
+	require.Equal([]interface{}{MaskedValue, MaskedValue}, jwtRule["audiences"])
+	require.Equal("issuer", jwtRule["issuer"])
+
+	// The payload is left untouched
+	require.Equal("bookinfo-cert", gateway.Spec.Servers[0].Tls.CredentialName)
+	require.Equal("abc", gateway.Annotations["example.com/api-token"])
+}
+
+func TestDataMaskerMaskDiff(t *testing.T) {
+	require := require.New(t)
+
+	masker, err := NewDataMasker(config.NewConfig().DataMasking)
+	require.NoError(err)
+
+	diff := &models.IstioConfigDiff{Objects: []models.IstioConfigObjectDiff{{
+		Name: "bookinfo-gateway",
+		Fields: []models.IstioConfigFieldDiff{
+			{Path: "spec.servers[0].tls.credentialName", Source: "east-cert", Target: "west-cert"},
+			{Path: "spec.servers[0].tls.mode", Source: "SIMPLE", Target: "MUTUAL"},
+			{Path: "spec.jwtRules[1].audiences[0]", Source: "bookinfo"},
+		},
+	}}}
+	masker.MaskDiff(diff)
+
+	fields := diff.Objects[0].Fields
+	require.Equal(MaskedValue, fields[0].Source)
+	require.Equal(MaskedValue, fields[0].Target)
+	require.Equal("SIMPLE", fields[1].Source)
+	require.Equal(MaskedValue, fields[2].Source)
+	// Unset values stay unset
+	require.Nil(fields[2].Target)
+}
@@ -0,0 +1,215 @@
+package business
+
+import (
+	"context"
+	"sort"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// The fields of a traffic policy combined field by field.
+var trafficPolicyFieldNames = []string{"connectionPool", "loadBalancer", "outlierDetection", "tls", "tunnel", "proxyProtocol"}
+
+// trafficPolicyLayer is the fields of a traffic policy set at a level.
+type trafficPolicyLayer struct {
+	level  string
+	source models.IstioReference
+	fields map[string]interface{}
+}
+
+// GetDestinationRuleTrafficPolicies computes how the traffic policy of a DestinationRule combines, field by field,
+// as Istio does when the DestinationRule inheritance is enabled: the policy of the oldest wildcard DestinationRule
+// of the root namespace matching the host is overridden by the one of the namespace, then by the one of the
+// DestinationRule. The port level settings and then the subsets override the result for their connections.
+func (in *IstioConfigService) GetDestinationRuleTrafficPolicies(ctx context.Context, cluster, namespace, name string) (*models.DestinationRuleTrafficPolicies, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	dr, err := kubeCache.GetDestinationRule(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	istioConfigList, err := in.GetIstioConfigList(ctx, cluster, IstioConfigCriteria{IncludeDestinationRules: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return combineTrafficPolicies(dr, istioConfigList.DestinationRules, in.config.ExternalServices.Istio.RootNamespace), nil
+}
+
+// combineTrafficPolicies combines the traffic policies of the DestinationRule with the ones of the defaults found
+// among the other DestinationRules.
+func combineTrafficPolicies(dr *networking_v1.DestinationRule, destinationRules []*networking_v1.DestinationRule, rootNamespace string) *models.DestinationRuleTrafficPolicies {
+	host := resolveHostFQDN(dr.Spec.Host, dr.Namespace)
+	result := &models.DestinationRuleTrafficPolicies{
+		DestinationRule: drReference(dr),
+		Host:            host,
+		Scopes:          []models.TrafficPolicyScope{},
+	}
+
+	var meshDefault, namespaceDefault *networking_v1.DestinationRule
+	for _, other := range destinationRules {
+		if (other.Name == dr.Name && other.Namespace == dr.Namespace) || other.Spec.WorkloadSelector != nil {
+			continue
+		}
+		if matches, exact := hostPatternMatches(resolveHostFQDN(other.Spec.Host, other.Namespace), host); !matches || exact {
+			continue
+		}
+		switch other.Namespace {
+		case rootNamespace:
+			if meshDefault == nil || other.CreationTimestamp.Before(&meshDefault.CreationTimestamp) {
+				meshDefault = other
+			}
+		case dr.Namespace:
+			if namespaceDefault == nil || other.CreationTimestamp.Before(&namespaceDefault.CreationTimestamp) {
+				namespaceDefault = other
+			}
+		}
+	}
+
+	hostLayers := []trafficPolicyLayer{}
+	if meshDefault != nil {
+		ref := drReference(meshDefault)
+		result.MeshDefault = &ref
+		hostLayers = append(hostLayers, trafficPolicyLayer{level: models.TrafficPolicyLevelMesh, source: ref, fields: policyFields(meshDefault.Spec.TrafficPolicy)})
+	}
+	if namespaceDefault != nil {
+		ref := drReference(namespaceDefault)
+		result.NamespaceDefault = &ref
+		hostLayers = append(hostLayers, trafficPolicyLayer{level: models.TrafficPolicyLevelNamespace, source: ref, fields: policyFields(namespaceDefault.Spec.TrafficPolicy)})
+	}
+	hostLayers = append(hostLayers, trafficPolicyLayer{level: models.TrafficPolicyLevelHost, source: result.DestinationRule, fields: policyFields(dr.Spec.TrafficPolicy)})
+
+	result.Scopes = append(result.Scopes, models.TrafficPolicyScope{Fields: combineTrafficPolicyLayers(hostLayers)})
+	hostPorts := policyPorts(dr.Spec.TrafficPolicy)
+	for _, port := range hostPorts {
+		layers := append(hostLayers[:len(hostLayers):len(hostLayers)], portLayer(models.TrafficPolicyLevelPort, result.DestinationRule, dr.Spec.TrafficPolicy, port))
+		result.Scopes = append(result.Scopes, models.TrafficPolicyScope{Port: port, Fields: combineTrafficPolicyLayers(layers)})
+	}
+
+	for _, subset := range dr.Spec.Subsets {
+		subsetLayer := trafficPolicyLayer{level: models.TrafficPolicyLevelSubset, source: result.DestinationRule, fields: policyFields(subset.TrafficPolicy)}
+		layers := append(hostLayers[:len(hostLayers):len(hostLayers)], subsetLayer)
+		result.Scopes = append(result.Scopes, models.TrafficPolicyScope{Subset: subset.Name, Fields: combineTrafficPolicyLayers(layers)})
+
+		ports := map[uint32]bool{}
+		for _, port := range append(hostPorts, policyPorts(subset.TrafficPolicy)...) {
+			ports[port] = true
+		}
+		for _, port := range sortedPorts(ports) {
+			layers := append(hostLayers[:len(hostLayers):len(hostLayers)],
+				portLayer(models.TrafficPolicyLevelPort, result.DestinationRule, dr.Spec.TrafficPolicy, port),
+				subsetLayer,
+				portLayer(models.TrafficPolicyLevelSubsetPort, result.DestinationRule, subset.TrafficPolicy, port))
+			result.Scopes = append(result.Scopes, models.TrafficPolicyScope{Port: port, Subset: subset.Name, Fields: combineTrafficPolicyLayers(layers)})
+		}
+	}
+
+	return result
+}
+
+// combineTrafficPolicyLayers returns the fields set by the layers, the most specific layer setting a field winning.
+func combineTrafficPolicyLayers(layers []trafficPolicyLayer) []models.TrafficPolicyField {
+	fields := []models.TrafficPolicyField{}
+	for _, name := range trafficPolicyFieldNames {
+		var field *models.TrafficPolicyField
+		for _, layer := range layers {
+			value, found := layer.fields[name]
+			if !found {
+				continue
+			}
+			overridden := []string{}
+			if field != nil {
+				overridden = append(field.Overridden, field.Level)
+			}
+			field = &models.TrafficPolicyField{Field: name, Level: layer.level, Source: layer.source, Value: value, Overridden: overridden}
+		}
+		if field != nil {
+			fields = append(fields, *field)
+		}
+	}
+	return fields
+}
+
+func policyFields(policy *api_networking_v1.TrafficPolicy) map[string]interface{} {
+	fields := map[string]interface{}{}
+	if policy == nil {
+		return fields
+	}
+	if policy.ConnectionPool != nil {
+		fields["connectionPool"] = policy.ConnectionPool
+	}
+	if policy.LoadBalancer != nil {
+		fields["loadBalancer"] = policy.LoadBalancer
+	}
+	if policy.OutlierDetection != nil {
+		fields["outlierDetection"] = policy.OutlierDetection
+	}
+	if policy.Tls != nil {
+		fields["tls"] = policy.Tls
+	}
+	if policy.Tunnel != nil {
+		fields["tunnel"] = policy.Tunnel
+	}
+	if policy.ProxyProtocol != nil {
+		fields["proxyProtocol"] = policy.ProxyProtocol
+	}
+	return fields
+}
+
+// portLayer returns the layer of the port level settings of the policy for the port.
+func portLayer(level string, source models.IstioReference, policy *api_networking_v1.TrafficPolicy, port uint32) trafficPolicyLayer {
+	layer := trafficPolicyLayer{level: level, source: source, fields: map[string]interface{}{}}
+	for _, settings := range policy.GetPortLevelSettings() {
+		if settings.GetPort().GetNumber() != port {
+			continue
+		}
+		if settings.ConnectionPool != nil {
+			layer.fields["connectionPool"] = settings.ConnectionPool
+		}
+		if settings.LoadBalancer != nil {
+			layer.fields["loadBalancer"] = settings.LoadBalancer
+		}
+		if settings.OutlierDetection != nil {
+			layer.fields["outlierDetection"] = settings.OutlierDetection
+		}
+		if settings.Tls != nil {
+			layer.fields["tls"] = settings.Tls
+		}
+	}
+	return layer
+}
+
+// policyPorts returns the sorted ports of the port level settings of the policy.
+func policyPorts(policy *api_networking_v1.TrafficPolicy) []uint32 {
+	ports := map[uint32]bool{}
+	for _, settings := range policy.GetPortLevelSettings() {
+		if number := settings.GetPort().GetNumber(); number > 0 {
+			ports[number] = true
+		}
+	}
+	return sortedPorts(ports)
+}
+
+func sortedPorts(ports map[uint32]bool) []uint32 {
+	sorted := make([]uint32, 0, len(ports))
+	for port := range ports {
+		sorted = append(sorted, port)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func drReference(dr *networking_v1.DestinationRule) models.IstioReference {
+	return models.IstioReference{ObjectGVK: kubernetes.DestinationRules, Name: dr.Name, Namespace: dr.Namespace}
+}
@@ -0,0 +1,74 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestCombineTrafficPolicies(t *testing.T) {
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	meshDefault := data.AddTrafficPolicyToDestinationRule(data.CreateMTLSTrafficPolicyForDestinationRules(),
+		data.CreateEmptyDestinationRule("istio-system", "default", "*.local"))
+	meshDefault.Spec.TrafficPolicy.LoadBalancer = &api_networking_v1.LoadBalancerSettings{
+		LbPolicy: &api_networking_v1.LoadBalancerSettings_Simple{Simple: api_networking_v1.LoadBalancerSettings_ROUND_ROBIN},
+	}
+	namespaceDefault := data.CreateEmptyDestinationRule("bookinfo", "default", "*.bookinfo.svc.cluster.local")
+	namespaceDefault.Spec.TrafficPolicy = &api_networking_v1.TrafficPolicy{
+		OutlierDetection: &api_networking_v1.OutlierDetection{},
+	}
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")
+	dr.Spec.TrafficPolicy = &api_networking_v1.TrafficPolicy{
+		LoadBalancer: &api_networking_v1.LoadBalancerSettings{
+			LbPolicy: &api_networking_v1.LoadBalancerSettings_Simple{Simple: api_networking_v1.LoadBalancerSettings_LEAST_REQUEST},
+		},
+		PortLevelSettings: []*api_networking_v1.TrafficPolicy_PortTrafficPolicy{
+			{Port: &api_networking_v1.PortSelector{Number: 9080}, Tls: &api_networking_v1.ClientTLSSettings{Mode: api_networking_v1.ClientTLSSettings_DISABLE}},
+		},
+	}
+	dr.Spec.Subsets = []*api_networking_v1.Subset{
+		{Name: "v1"},
+		{Name: "v2", TrafficPolicy: &api_networking_v1.TrafficPolicy{Tls: &api_networking_v1.ClientTLSSettings{Mode: api_networking_v1.ClientTLSSettings_SIMPLE}}},
+	}
+
+	policies := combineTrafficPolicies(dr, []*networking_v1.DestinationRule{meshDefault, namespaceDefault, dr, data.CreateEmptyDestinationRule("travel", "default", "*.travel.svc.cluster.local")}, "istio-system")
+	require.Equal("reviews.bookinfo.svc.cluster.local", policies.Host)
+	require.Equal("default", policies.MeshDefault.Name)
+	require.Equal("bookinfo", policies.NamespaceDefault.Namespace)
+
+	levels := func(scope models.TrafficPolicyScope) map[string]string {
+		fields := map[string]string{}
+		for _, f := range scope.Fields {
+			fields[f.Field] = f.Level
+		}
+		return fields
+	}
+	// host, port 9080, v1, v1 on 9080, v2, v2 on 9080
+	require.Len(policies.Scopes, 6)
+	require.Equal(map[string]string{"loadBalancer": "host", "outlierDetection": "namespace", "tls": "mesh"}, levels(policies.Scopes[0]))
+	require.Equal([]string{"mesh"}, policies.Scopes[0].Fields[0].Overridden)
+
+	require.Equal(uint32(9080), policies.Scopes[1].Port)
+	require.Equal("port", levels(policies.Scopes[1])["tls"])
+	require.Equal("mesh", levels(policies.Scopes[2])["tls"])
+	require.Equal("port", levels(policies.Scopes[3])["tls"])
+
+	// The subset overrides the port level settings of the host
+	require.Equal("v2", policies.Scopes[5].Subset)
+	require.Equal(uint32(9080), policies.Scopes[5].Port)
+	require.Equal("subset", levels(policies.Scopes[5])["tls"])
+	for _, f := range policies.Scopes[5].Fields {
+		if f.Field == "tls" {
+			require.Equal([]string{"mesh", "port"}, f.Overridden)
+		}
+	}
+}
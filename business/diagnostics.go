@@ -0,0 +1,177 @@
+package business
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nitishm/engarde/pkg/parser"
+	"github.com/prometheus/common/model"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+)
+
+// DefaultTelemetryWindow is the window used to compare the telemetry with the access logs when none is provided.
+const DefaultTelemetryWindow = "10m"
+
+// maxAccessLogLines limits the proxy log lines read per pod.
+const maxAccessLogLines = int64(1000)
+
+// telemetryMetrics are the Istio metrics expected for the workloads with sidecars receiving or sending traffic.
+var telemetryMetrics = []string{"istio_requests_total", "istio_tcp_connections_opened_total"}
+
+// DiagnosticsService runs checks detecting problems of the mesh setup.
+type DiagnosticsService struct {
+	businessLayer *Layer
+	prom          prometheus.ClientInterface
+	userClients   map[string]kubernetes.ClientInterface
+}
+
+// NewDiagnosticsService creates a new DiagnosticsService.
+func NewDiagnosticsService(businessLayer *Layer, prom prometheus.ClientInterface, userClients map[string]kubernetes.ClientInterface) DiagnosticsService {
+	return DiagnosticsService{
+		businessLayer: businessLayer,
+		prom:          prom,
+		userClients:   userClients,
+	}
+}
+
+// GetTelemetryGaps returns the workloads with sidecars of the namespace without Istio metrics during the window
+// although their proxies logged requests. Workloads without metrics nor access logs are reported as idle, since
+// the access logs can be disabled.
+func (in *DiagnosticsService) GetTelemetryGaps(ctx context.Context, cluster, namespace string, window time.Duration, queryTime time.Time) (*models.TelemetryDiagnostics, error) {
+	// Checks the user access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+	userClient, found := in.userClients[cluster]
+	if !found {
+		return nil, fmt.Errorf("user client for cluster [%s] not found", cluster)
+	}
+
+	workloads, err := in.businessLayer.Workload.fetchWorkloadsFromCluster(ctx, cluster, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	reporting, err := in.getWorkloadsWithTelemetry(cluster, namespace, window, queryTime)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := &models.TelemetryDiagnostics{
+		Cluster:       cluster,
+		GeneratedAt:   queryTime,
+		Namespace:     namespace,
+		Window:        model.Duration(window).String(),
+		IdleWorkloads: []string{},
+		Gaps:          []models.TelemetryGap{},
+	}
+	for _, w := range workloads {
+		if !w.IstioSidecar {
+			continue
+		}
+		diagnostics.CheckedWorkloads++
+		if reporting[w.Name] {
+			continue
+		}
+
+		gap := models.TelemetryGap{Workload: w.Name, Pods: []string{}}
+		for _, pod := range w.Pods {
+			entries, err := in.countProxyAccessLogEntries(userClient, namespace, pod.Name, window)
+			if err != nil {
+				log.Debugf("Unable to read the proxy logs of pod [%s/%s]: %s", namespace, pod.Name, err)
+				continue
+			}
+			if entries > 0 {
+				gap.Pods = append(gap.Pods, pod.Name)
+				gap.AccessLogEntries += entries
+			}
+		}
+		if gap.AccessLogEntries == 0 {
+			diagnostics.IdleWorkloads = append(diagnostics.IdleWorkloads, w.Name)
+			continue
+		}
+		gap.Message = fmt.Sprintf("The proxies logged %d requests but no Istio metrics were found in the last %s. Check the Prometheus scrape config of the pods and the Telemetry config.", gap.AccessLogEntries, diagnostics.Window)
+		diagnostics.Gaps = append(diagnostics.Gaps, gap)
+	}
+
+	sort.Strings(diagnostics.IdleWorkloads)
+	sort.Slice(diagnostics.Gaps, func(i, j int) bool {
+		return diagnostics.Gaps[i].Workload < diagnostics.Gaps[j].Workload
+	})
+	return diagnostics, nil
+}
+
+// getWorkloadsWithTelemetry returns the workloads of the namespace reported as source or destination
+// of any Istio metric sample during the window.
+func (in *DiagnosticsService) getWorkloadsWithTelemetry(cluster, namespace string, window time.Duration, queryTime time.Time) (map[string]bool, error) {
+	reporting := map[string]bool{}
+	for _, side := range []string{"source", "destination"} {
+		labels := fmt.Sprintf(`{%s_cluster="%s",%s_workload_namespace="%s"}`, side, cluster, side, namespace)
+		for _, metricName := range telemetryMetrics {
+			metric := in.prom.FetchDelta(metricName, labels, "", queryTime, window)
+			if metric.Err != nil {
+				return nil, errors.NewServiceUnavailable(metric.Err.Error())
+			}
+			// Any series means samples were scraped, even without new requests
+			for _, stream := range metric.Matrix {
+				reporting[string(stream.Metric[model.LabelName(side+"_workload")])] = true
+			}
+		}
+	}
+	return reporting, nil
+}
+
+// countProxyAccessLogEntries returns the number of access log entries written by the proxy of the pod during the window.
+func (in *DiagnosticsService) countProxyAccessLogEntries(userClient kubernetes.ClientInterface, namespace, pod string, window time.Duration) (int, error) {
+	sinceSeconds := int64(window.Seconds())
+	tailLines := maxAccessLogLines
+	logs, err := userClient.StreamPodLogs(namespace, pod, &core_v1.PodLogOptions{
+		Container:    models.IstioProxy,
+		SinceSeconds: &sinceSeconds,
+		TailLines:    &tailLines,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer logs.Close()
+	return countAccessLogEntries(logs)
+}
+
+// countAccessLogEntries returns the number of access log entries among the log lines,
+// in the default text format or in JSON.
+func countAccessLogEntries(logs io.Reader) (int, error) {
+	engardeParser := parser.New(parser.IstioProxyAccessLogsPattern)
+	entries := 0
+	scanner := bufio.NewScanner(logs)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "{") {
+			var entry map[string]interface{}
+			if err := json.Unmarshal([]byte(line), &entry); err == nil {
+				if _, found := entry["response_code"]; found {
+					entries++
+				}
+			}
+			continue
+		}
+		if !strings.HasPrefix(line, "[") {
+			continue
+		}
+		if al, err := engardeParser.Parse(line); err == nil && !isAccessLogEmpty(al) {
+			entries++
+		}
+	}
+	return entries, scanner.Err()
+}
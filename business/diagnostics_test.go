@@ -0,0 +1,95 @@
+package business
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+const proxyAccessLog = `[2021-02-01T21:34:35.533Z] "GET /hotels/Ljubljana HTTP/1.1" 200 - via_upstream - "-" 0 99 14 14 "-" "Go-http-client/1.1" "7e7e2dd0-0a96-4535-950b-e303805b7e27" "hotels.travel-agency:8000" "127.0.0.1:8000" inbound|8000|| 127.0.0.1:33704 10.129.0.72:8000 10.128.0.79:39880 outbound_.8000_._.hotels.travel-agency.svc.cluster.local default`
+
+type fakeLogsClient struct {
+	kubernetes.ClientInterface
+	logs string
+}
+
+func (c *fakeLogsClient) StreamPodLogs(namespace, name string, opts *core_v1.PodLogOptions) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(c.logs)), nil
+}
+
+func newDiagnosticsTestLayer(t *testing.T, prom prometheus.ClientInterface, logs string) *Layer {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	pod := FakePodsSyncedWithDeployments()[0]
+	pod.Labels = FakeRSSyncedWithPods()[0].Spec.Template.Labels
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("Namespace"),
+		&FakeDepSyncedWithRS()[0],
+		&FakeRSSyncedWithPods()[0],
+		&pod,
+	)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: &fakeLogsClient{ClientInterface: k8s, logs: logs}}
+	return NewWithBackends(k8sclients, k8sclients, prom, nil)
+}
+
+func TestGetTelemetryGaps(t *testing.T) {
+	require := require.New(t)
+
+	prom := new(prometheustest.PromClientMock)
+	prom.On("FetchDelta", mock.Anything, mock.Anything, "", mock.AnythingOfType("time.Time"), 10*time.Minute).Return(prometheus.Metric{})
+
+	layer := newDiagnosticsTestLayer(t, prom, "2021-02-01T21:34:30.000Z\tinfo\tEnvoy proxy is ready\n"+proxyAccessLog+"\n"+proxyAccessLog)
+	diagnostics, err := layer.Diagnostics.GetTelemetryGaps(context.TODO(), config.Get().KubernetesConfig.ClusterName, "Namespace", 10*time.Minute, time.Now())
+	require.NoError(err)
+	require.Equal("10m", diagnostics.Window)
+	require.Equal(1, diagnostics.CheckedWorkloads)
+	require.Empty(diagnostics.IdleWorkloads)
+	require.Len(diagnostics.Gaps, 1)
+	require.Equal("details-v1", diagnostics.Gaps[0].Workload)
+	require.Equal([]string{"details-v1-3618568057-dnkjp"}, diagnostics.Gaps[0].Pods)
+	require.Equal(2, diagnostics.Gaps[0].AccessLogEntries)
+}
+
+func TestGetTelemetryGapsWithTelemetry(t *testing.T) {
+	require := require.New(t)
+
+	prom := new(prometheustest.PromClientMock)
+	cluster := config.NewConfig().KubernetesConfig.ClusterName
+	prom.On("FetchDelta", "istio_requests_total", `{destination_cluster="`+cluster+`",destination_workload_namespace="Namespace"}`, "", mock.AnythingOfType("time.Time"), 10*time.Minute).
+		Return(prometheus.Metric{Matrix: model.Matrix{{Metric: model.Metric{"destination_workload": "details-v1"}}}})
+	prom.On("FetchDelta", mock.Anything, mock.Anything, "", mock.AnythingOfType("time.Time"), 10*time.Minute).Return(prometheus.Metric{})
+
+	layer := newDiagnosticsTestLayer(t, prom, proxyAccessLog)
+	diagnostics, err := layer.Diagnostics.GetTelemetryGaps(context.TODO(), cluster, "Namespace", 10*time.Minute, time.Now())
+	require.NoError(err)
+	require.Equal(1, diagnostics.CheckedWorkloads)
+	require.Empty(diagnostics.Gaps)
+}
+
+func TestCountAccessLogEntries(t *testing.T) {
+	logs := strings.Join([]string{
+		proxyAccessLog,
+		`{"response_code":200,"method":"GET","path":"/"}`,
+		`{"level":"info","msg":"not an access log"}`,
+		"2021-02-01T21:34:30.000Z\twarn\tenvoy config\tgRPC config stream closed",
+	}, "\n")
+	entries, err := countAccessLogEntries(strings.NewReader(logs))
+	require.NoError(t, err)
+	require.Equal(t, 2, entries)
+}
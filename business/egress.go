@@ -0,0 +1,126 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+)
+
+const (
+	// DefaultEgressTrafficWindow is the window used to look for the egress traffic when none is provided.
+	DefaultEgressTrafficWindow = "1d"
+
+	// Envoy clusters handling the outbound traffic to destinations unknown to the mesh
+	egressBlackHoleCluster   = "BlackHoleCluster"
+	egressPassthroughCluster = "PassthroughCluster"
+)
+
+// EgressService reports the outbound traffic to destinations unknown to the mesh.
+type EgressService struct {
+	businessLayer *Layer
+	conf          *config.Config
+	kialiCache    cache.KialiCache
+	prom          prometheus.ClientInterface
+}
+
+// NewEgressService creates a new EgressService.
+func NewEgressService(businessLayer *Layer, conf *config.Config, kialiCache cache.KialiCache, prom prometheus.ClientInterface) EgressService {
+	return EgressService{
+		businessLayer: businessLayer,
+		conf:          conf,
+		kialiCache:    kialiCache,
+		prom:          prom,
+	}
+}
+
+// GetEgressReport returns the destinations, not covered by any ServiceEntry, that the workloads of the namespace
+// sent traffic to during the traffic window. Only the limit most used destinations are returned when limit is positive.
+func (in *EgressService) GetEgressReport(ctx context.Context, cluster, namespace string, trafficWindow time.Duration, limit int) (*models.EgressReport, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	report := &models.EgressReport{
+		Cluster:       cluster,
+		GeneratedAt:   time.Now(),
+		Namespace:     namespace,
+		TrafficWindow: model.Duration(trafficWindow).String(),
+		Destinations:  []models.EgressDestination{},
+	}
+
+	labels := fmt.Sprintf(`{reporter="source",source_cluster="%s",source_workload_namespace="%s",destination_service_name=~"%s|%s"}`,
+		cluster, namespace, egressPassthroughCluster, egressBlackHoleCluster)
+	grouping := "destination_service_name,destination_service,source_workload_namespace,source_workload"
+
+	type destinationKey struct {
+		egressCluster string
+		host          string
+	}
+	destinations := map[destinationKey]*models.EgressDestination{}
+	sources := map[destinationKey]map[string]bool{}
+	for _, metricName := range []string{"istio_requests_total", "istio_tcp_connections_opened_total"} {
+		metric := in.prom.FetchIncrease(metricName, labels, grouping, report.GeneratedAt, trafficWindow)
+		if metric.Err != nil {
+			return nil, metric.Err
+		}
+		for _, stream := range metric.Matrix {
+			count := 0.0
+			for _, value := range stream.Values {
+				count += float64(value.Value)
+			}
+			if count <= 0 {
+				continue
+			}
+
+			key := destinationKey{egressCluster: string(stream.Metric["destination_service_name"]), host: string(stream.Metric["destination_service"])}
+			if key.host == "" || key.host == "unknown" {
+				key.host = key.egressCluster
+			}
+			destination, found := destinations[key]
+			if !found {
+				destination = &models.EgressDestination{EgressCluster: key.egressCluster, Host: key.host}
+				destinations[key] = destination
+				sources[key] = map[string]bool{}
+			}
+			if metricName == "istio_requests_total" {
+				destination.Requests += count
+			} else {
+				destination.TCPConnections += count
+			}
+			sources[key][string(stream.Metric["source_workload_namespace"])+"/"+string(stream.Metric["source_workload"])] = true
+		}
+	}
+
+	for key, destination := range destinations {
+		destination.Sources = make([]string, 0, len(sources[key]))
+		for source := range sources[key] {
+			destination.Sources = append(destination.Sources, source)
+		}
+		sort.Strings(destination.Sources)
+		report.Destinations = append(report.Destinations, *destination)
+	}
+	sort.Slice(report.Destinations, func(i, j int) bool {
+		di, dj := report.Destinations[i], report.Destinations[j]
+		if di.Requests+di.TCPConnections != dj.Requests+dj.TCPConnections {
+			return di.Requests+di.TCPConnections > dj.Requests+dj.TCPConnections
+		}
+		if di.Host != dj.Host {
+			return di.Host < dj.Host
+		}
+		return di.EgressCluster < dj.EgressCluster
+	})
+	if limit > 0 && len(report.Destinations) > limit {
+		report.Destinations = report.Destinations[:limit]
+	}
+
+	return report, nil
+}
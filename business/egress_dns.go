@@ -0,0 +1,224 @@
+package business
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"istio.io/api/annotation"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+const (
+	// Proxy metadata enabling the DNS proxy of the sidecars
+	dnsCaptureMetadata      = "ISTIO_META_DNS_CAPTURE"
+	dnsAutoAllocateMetadata = "ISTIO_META_DNS_AUTO_ALLOCATE"
+
+	dnsCaptureMeshSource       = "meshConfig"
+	dnsCaptureAnnotationSource = "annotation"
+
+	// ServiceRegistry of the services created from ServiceEntries
+	externalServiceRegistry = "External"
+)
+
+// GetDNSCapture returns the DNS capture of the workloads of the namespace, and the addresses answered by their
+// sidecars to the DNS queries of the hosts of the ServiceEntries visible from the namespace.
+// The DNS capture settings are merged as Istio does: the mesh config, then the ProxyConfigs of the root namespace,
+// of the namespace and of the workload, and finally the proxy.istio.io/config annotation.
+func (in *EgressService) GetDNSCapture(ctx context.Context, cluster, namespace string) (*models.DNSCaptureReport, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	meshConfig := in.businessLayer.Mesh.GetMeshConfig()
+	report := &models.DNSCaptureReport{
+		Cluster:        cluster,
+		Namespace:      namespace,
+		Mesh:           models.DNSCaptureSettings{Source: dnsCaptureMeshSource},
+		Workloads:      []models.WorkloadDNSCapture{},
+		ServiceEntries: []models.ServiceEntryDNS{},
+	}
+	applyDNSCaptureMetadata(&report.Mesh, meshConfig.DefaultConfig.ProxyMetadata, dnsCaptureMeshSource)
+
+	proxyConfigs, err := in.getProxyConfigs(ctx, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := in.businessLayer.Workload.fetchWorkloadsFromCluster(ctx, cluster, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range workloads {
+		if !w.IstioSidecar {
+			continue
+		}
+		workloadDNS := models.WorkloadDNSCapture{Name: w.Name, DNSCaptureSettings: report.Mesh}
+		for _, pc := range proxyConfigs {
+			if pc.Spec.Selector != nil && len(pc.Spec.Selector.MatchLabels) > 0 {
+				if !labels.SelectorFromSet(pc.Spec.Selector.MatchLabels).Matches(labels.Set(w.Labels)) {
+					continue
+				}
+			}
+			applyDNSCaptureMetadata(&workloadDNS.DNSCaptureSettings, pc.Spec.EnvironmentVariables, pc.Namespace+"/"+pc.Name)
+		}
+		if proxyConfigAnnotation, found := w.TemplateAnnotations[annotation.ProxyConfig.Name]; found {
+			proxyConfig := struct {
+				ProxyMetadata map[string]string `json:"proxyMetadata"`
+			}{}
+			if err := k8syaml.Unmarshal([]byte(proxyConfigAnnotation), &proxyConfig); err != nil {
+				log.Debugf("Invalid %s annotation of workload %s/%s: %s", annotation.ProxyConfig.Name, namespace, w.Name, err)
+			} else {
+				applyDNSCaptureMetadata(&workloadDNS.DNSCaptureSettings, proxyConfig.ProxyMetadata, dnsCaptureAnnotationSource)
+			}
+		}
+		report.Workloads = append(report.Workloads, workloadDNS)
+	}
+	sort.Slice(report.Workloads, func(i, j int) bool {
+		return report.Workloads[i].Name < report.Workloads[j].Name
+	})
+
+	istioConfigList, err := in.businessLayer.IstioConfig.GetIstioConfigList(ctx, cluster, IstioConfigCriteria{IncludeServiceEntries: true})
+	if err != nil {
+		return nil, err
+	}
+	autoAllocated := map[string][]string{}
+	for _, rs := range in.businessLayer.RegistryStatus.GetRegistryServices(RegistryCriteria{AllNamespaces: true, Cluster: cluster}) {
+		if rs.Attributes.ServiceRegistry != externalServiceRegistry {
+			continue
+		}
+		key := rs.Attributes.Namespace + "/" + rs.Hostname
+		for _, address := range []string{rs.AutoAllocatedIPv4Address, rs.AutoAllocatedIPv6Address} {
+			if address != "" {
+				autoAllocated[key] = append(autoAllocated[key], address)
+			}
+		}
+	}
+	for _, se := range istioConfigList.ServiceEntries {
+		exportTo := se.Spec.ExportTo
+		if len(exportTo) == 0 {
+			exportTo = meshConfig.DefaultServiceExportTo
+		}
+		if !exportedTo(exportTo, se.Namespace, namespace) {
+			continue
+		}
+		seDNS := models.ServiceEntryDNS{
+			Name:       se.Name,
+			Namespace:  se.Namespace,
+			Resolution: se.Spec.Resolution.String(),
+			Hosts:      []models.ServiceEntryHostDNS{},
+		}
+		for _, host := range se.Spec.Hosts {
+			seDNS.Hosts = append(seDNS.Hosts, resolveServiceEntryHost(se.Spec.Resolution, host, se.Spec.Addresses, autoAllocated[se.Namespace+"/"+host]))
+		}
+		report.ServiceEntries = append(report.ServiceEntries, seDNS)
+	}
+	sort.Slice(report.ServiceEntries, func(i, j int) bool {
+		if report.ServiceEntries[i].Namespace != report.ServiceEntries[j].Namespace {
+			return report.ServiceEntries[i].Namespace < report.ServiceEntries[j].Namespace
+		}
+		return report.ServiceEntries[i].Name < report.ServiceEntries[j].Name
+	})
+
+	return report, nil
+}
+
+// getProxyConfigs returns the ProxyConfigs applied to the proxies of the namespace, in increasing precedence: those
+// of the root namespace, then those of the namespace without selector and then those of the namespace with selector.
+// ProxyConfigs with selector are ignored in the root namespace.
+func (in *EgressService) getProxyConfigs(ctx context.Context, cluster, namespace string) ([]*networking_v1beta1.ProxyConfig, error) {
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var rootProxyConfigs, namespaceProxyConfigs, workloadProxyConfigs []*networking_v1beta1.ProxyConfig
+	rootNamespace := in.conf.ExternalServices.Istio.RootNamespace
+	if rootNamespace != "" && rootNamespace != namespace {
+		if rootProxyConfigs, err = kubeCache.GetProxyConfigs(ctx, rootNamespace, ""); err != nil {
+			return nil, err
+		}
+	}
+	proxyConfigs, err := kubeCache.GetProxyConfigs(ctx, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, pc := range proxyConfigs {
+		if pc.Spec.Selector != nil && len(pc.Spec.Selector.MatchLabels) > 0 {
+			workloadProxyConfigs = append(workloadProxyConfigs, pc)
+		} else {
+			namespaceProxyConfigs = append(namespaceProxyConfigs, pc)
+		}
+	}
+
+	applied := []*networking_v1beta1.ProxyConfig{}
+	for _, pc := range rootProxyConfigs {
+		if pc.Spec.Selector == nil || len(pc.Spec.Selector.MatchLabels) == 0 {
+			applied = append(applied, pc)
+		}
+	}
+	applied = append(applied, namespaceProxyConfigs...)
+	return append(applied, workloadProxyConfigs...), nil
+}
+
+// applyDNSCaptureMetadata overrides the DNS capture settings with those set by the proxy metadata, if any.
+func applyDNSCaptureMetadata(settings *models.DNSCaptureSettings, metadata map[string]string, source string) {
+	if value, found := metadata[dnsCaptureMetadata]; found {
+		settings.Capture, _ = strconv.ParseBool(value)
+		settings.Source = source
+	}
+	if value, found := metadata[dnsAutoAllocateMetadata]; found {
+		settings.AutoAllocate, _ = strconv.ParseBool(value)
+		settings.Source = source
+	}
+}
+
+// resolveServiceEntryHost returns the addresses answered by the sidecars capturing DNS to the queries of a host of a
+// ServiceEntry. As Istio does, the addresses declared by the ServiceEntry are answered first, ignoring the CIDRs, and
+// the VIPs allocated by Istio otherwise. The queries of the wildcard hosts are always forwarded to the upstream DNS.
+func resolveServiceEntryHost(resolution api_networking_v1.ServiceEntry_Resolution, host string, addresses, autoAllocated []string) models.ServiceEntryHostDNS {
+	hostDNS := models.ServiceEntryHostDNS{
+		Host:                   host,
+		Addresses:              append([]string{}, addresses...),
+		AutoAllocatedAddresses: []string{},
+		ResolvedAddresses:      []string{},
+	}
+
+	if strings.HasPrefix(host, "*") {
+		hostDNS.Reason = "Wildcard hosts are not resolved by the sidecars"
+		return hostDNS
+	}
+	for _, address := range addresses {
+		if net.ParseIP(address) != nil {
+			hostDNS.ResolvedAddresses = append(hostDNS.ResolvedAddresses, address)
+		}
+	}
+	if len(hostDNS.ResolvedAddresses) > 0 {
+		return hostDNS
+	}
+	if len(addresses) > 0 {
+		hostDNS.Reason = "CIDR addresses are not resolved by the sidecars"
+		return hostDNS
+	}
+
+	hostDNS.AutoAllocatedAddresses = append(hostDNS.AutoAllocatedAddresses, autoAllocated...)
+	if len(autoAllocated) > 0 {
+		hostDNS.ResolvedAddresses = append(hostDNS.ResolvedAddresses, autoAllocated...)
+		hostDNS.AutoAllocated = true
+		return hostDNS
+	}
+	if resolution == api_networking_v1.ServiceEntry_NONE {
+		hostDNS.Reason = "Istio does not allocate addresses to the ServiceEntries with resolution NONE"
+	} else {
+		hostDNS.Reason = "No address is allocated by Istio"
+	}
+	return hostDNS
+}
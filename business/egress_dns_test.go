@@ -0,0 +1,89 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	api_networking_v1beta1 "istio.io/api/networking/v1beta1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func TestGetDNSCapture(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	serviceEntry := func(name, namespace string, resolution api_networking_v1.ServiceEntry_Resolution, hosts, addresses, exportTo []string) *networking_v1.ServiceEntry {
+		return &networking_v1.ServiceEntry{
+			ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       api_networking_v1.ServiceEntry{Hosts: hosts, Addresses: addresses, Resolution: resolution, ExportTo: exportTo},
+		}
+	}
+	deployment := FakeDepSyncedWithRS()[0]
+	deployment.Spec.Template.Annotations = map[string]string{"proxy.istio.io/config": "proxyMetadata:\n  ISTIO_META_DNS_AUTO_ALLOCATE: \"true\"\n"}
+	pod := FakePodsSyncedWithDeployments()[0]
+	pod.Labels = FakeRSSyncedWithPods()[0].Spec.Template.Labels
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("Namespace"),
+		kubetest.FakeNamespace("other"),
+		&deployment,
+		&FakeRSSyncedWithPods()[0],
+		&pod,
+		&networking_v1beta1.ProxyConfig{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "dns-capture", Namespace: "Namespace"},
+			Spec:       api_networking_v1beta1.ProxyConfig{EnvironmentVariables: map[string]string{"ISTIO_META_DNS_CAPTURE": "true"}},
+		},
+		serviceEntry("external-api", "Namespace", api_networking_v1.ServiceEntry_DNS, []string{"api.example.com", "*.example.org"}, nil, nil),
+		serviceEntry("database", "other", api_networking_v1.ServiceEntry_STATIC, []string{"db.example.com"}, []string{"10.0.0.5", "10.1.0.0/16"}, nil),
+		serviceEntry("passthrough", "other", api_networking_v1.ServiceEntry_NONE, []string{"tcp.example.com"}, nil, nil),
+		serviceEntry("private", "other", api_networking_v1.ServiceEntry_DNS, []string{"private.example.com"}, nil, []string{"."}),
+	)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	registryService := &kubernetes.RegistryService{}
+	registryService.Hostname = "api.example.com"
+	registryService.Attributes.ServiceRegistry = "External"
+	registryService.Attributes.Namespace = "Namespace"
+	registryService.AutoAllocatedIPv4Address = "240.240.0.1"
+	cache.SetRegistryStatus(map[string]*kubernetes.RegistryStatus{
+		conf.KubernetesConfig.ClusterName: {Services: []*kubernetes.RegistryService{registryService}},
+	})
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	report, err := layer.Egress.GetDNSCapture(context.TODO(), conf.KubernetesConfig.ClusterName, "Namespace")
+	require.NoError(err)
+	require.Equal(models.DNSCaptureSettings{Source: "meshConfig"}, report.Mesh)
+
+	require.Len(report.Workloads, 1)
+	require.Equal("details-v1", report.Workloads[0].Name)
+	require.Equal(models.DNSCaptureSettings{Capture: true, AutoAllocate: true, Source: "annotation"}, report.Workloads[0].DNSCaptureSettings)
+
+	require.Len(report.ServiceEntries, 3)
+	require.Equal("external-api", report.ServiceEntries[0].Name)
+	require.Equal("DNS", report.ServiceEntries[0].Resolution)
+	require.Len(report.ServiceEntries[0].Hosts, 2)
+	require.Equal([]string{"240.240.0.1"}, report.ServiceEntries[0].Hosts[0].ResolvedAddresses)
+	require.True(report.ServiceEntries[0].Hosts[0].AutoAllocated)
+	require.Empty(report.ServiceEntries[0].Hosts[1].ResolvedAddresses)
+	require.NotEmpty(report.ServiceEntries[0].Hosts[1].Reason)
+
+	require.Equal("database", report.ServiceEntries[1].Name)
+	require.Equal([]string{"10.0.0.5"}, report.ServiceEntries[1].Hosts[0].ResolvedAddresses)
+	require.False(report.ServiceEntries[1].Hosts[0].AutoAllocated)
+
+	require.Equal("passthrough", report.ServiceEntries[2].Name)
+	require.Empty(report.ServiceEntries[2].Hosts[0].ResolvedAddresses)
+	require.Equal("Istio does not allocate addresses to the ServiceEntries with resolution NONE", report.ServiceEntries[2].Hosts[0].Reason)
+}
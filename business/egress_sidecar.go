@@ -0,0 +1,181 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/model"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// GeneratedSidecarName is the name of the namespace-wide Sidecar created by the generator.
+const GeneratedSidecarName = "default"
+
+// GenerateSidecar generates the namespace-wide Sidecar allowing the egress of the workloads of the namespace only to
+// the Services and ServiceEntries they sent traffic to during the traffic window, without applying it. The egress of
+// the current namespace-wide Sidecar is replaced, its other settings are kept.
+func (in *EgressService) GenerateSidecar(ctx context.Context, cluster, namespace string, trafficWindow time.Duration) (*models.GeneratedSidecar, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	generated := &models.GeneratedSidecar{
+		Cluster:       cluster,
+		Namespace:     namespace,
+		TrafficWindow: model.Duration(trafficWindow).String(),
+		Hosts:         []models.SidecarEgressHost{},
+		Unregistered:  []string{},
+	}
+
+	labels := fmt.Sprintf(`{reporter="source",source_cluster="%s",source_workload_namespace="%s"}`, cluster, namespace)
+	grouping := "destination_service_namespace,destination_service_name,destination_service"
+	now := time.Now()
+
+	hosts := map[string]*models.SidecarEgressHost{}
+	unregistered := map[string]bool{}
+	for _, metricName := range []string{"istio_requests_total", "istio_tcp_connections_opened_total"} {
+		metric := in.prom.FetchIncrease(metricName, labels, grouping, now, trafficWindow)
+		if metric.Err != nil {
+			return nil, metric.Err
+		}
+		for _, stream := range metric.Matrix {
+			count := 0.0
+			for _, value := range stream.Values {
+				count += float64(value.Value)
+			}
+			if count <= 0 {
+				continue
+			}
+
+			serviceName := string(stream.Metric["destination_service_name"])
+			dnsName := string(stream.Metric["destination_service"])
+			if serviceName == egressPassthroughCluster || serviceName == egressBlackHoleCluster {
+				if dnsName == "" || dnsName == "unknown" {
+					dnsName = serviceName
+				}
+				unregistered[dnsName] = true
+				continue
+			}
+			if dnsName == "" || dnsName == "unknown" {
+				continue
+			}
+			hostNamespace := string(stream.Metric["destination_service_namespace"])
+			if hostNamespace == "" || hostNamespace == "unknown" {
+				hostNamespace = "*"
+			}
+
+			key := hostNamespace + "/" + dnsName
+			host, found := hosts[key]
+			if !found {
+				host = &models.SidecarEgressHost{Host: key}
+				hosts[key] = host
+			}
+			if metricName == "istio_requests_total" {
+				host.Requests += count
+			} else {
+				host.TCPConnections += count
+			}
+		}
+	}
+	if len(hosts) == 0 {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("no traffic from namespace [%s] to the mesh during the last %s", namespace, generated.TrafficWindow))
+	}
+
+	egressHosts := make([]string, 0, len(hosts))
+	for key, host := range hosts {
+		egressHosts = append(egressHosts, key)
+		generated.Hosts = append(generated.Hosts, *host)
+	}
+	sort.Strings(egressHosts)
+	sort.Slice(generated.Hosts, func(i, j int) bool {
+		return generated.Hosts[i].Host < generated.Hosts[j].Host
+	})
+	for host := range unregistered {
+		generated.Unregistered = append(generated.Unregistered, host)
+	}
+	sort.Strings(generated.Unregistered)
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	sidecars, err := kubeCache.GetSidecars(ctx, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	// The current spec is nil when there is no namespace-wide Sidecar
+	var current interface{}
+	for _, sidecar := range sidecars {
+		if sidecar.Spec.WorkloadSelector == nil {
+			current = sidecar
+			generated.Exists = true
+			generated.Sidecar = sidecar.DeepCopy()
+			break
+		}
+	}
+	if generated.Sidecar == nil {
+		generated.Sidecar = &networking_v1.Sidecar{
+			TypeMeta: meta_v1.TypeMeta{
+				APIVersion: kubernetes.Sidecars.GroupVersion().String(),
+				Kind:       kubernetes.Sidecars.Kind,
+			},
+			ObjectMeta: meta_v1.ObjectMeta{Name: GeneratedSidecarName, Namespace: namespace},
+		}
+	}
+	generated.Sidecar.Spec.Egress = []*api_networking_v1.IstioEgressListener{{Hosts: egressHosts}}
+
+	generated.Changes, err = diffObjectSpecs(current, generated.Sidecar)
+	if err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+// ApplySidecar creates or updates the generated Sidecar, unless it is unchanged. Only the egress of an existing
+// Sidecar is updated.
+func (in *EgressService) ApplySidecar(ctx context.Context, generated *models.GeneratedSidecar, user string) error {
+	if generated.Exists && len(generated.Changes) == 0 {
+		return nil
+	}
+
+	payload, err := GeneratedSidecarPayload(generated)
+	if err != nil {
+		return err
+	}
+	name := generated.Sidecar.Name
+	operation := models.IstioConfigMutationCreate
+	if generated.Exists {
+		operation = models.IstioConfigMutationUpdate
+		_, err = in.businessLayer.IstioConfig.UpdateIstioConfigDetail(ctx, generated.Cluster, generated.Namespace, kubernetes.Sidecars, name, string(payload))
+	} else {
+		_, err = in.businessLayer.IstioConfig.CreateIstioConfigDetail(ctx, generated.Cluster, generated.Namespace, kubernetes.Sidecars, payload)
+	}
+	if err != nil {
+		return err
+	}
+	in.businessLayer.IstioConfig.RecordConfigChange(generated.Cluster, generated.Namespace, kubernetes.Sidecars, name, operation, user)
+	generated.Applied = true
+	return nil
+}
+
+// GeneratedSidecarPayload returns the payload applying the generated Sidecar: the merge patch of the egress of an
+// existing Sidecar, or the new Sidecar.
+func GeneratedSidecarPayload(generated *models.GeneratedSidecar) ([]byte, error) {
+	if !generated.Exists {
+		return json.Marshal(generated.Sidecar)
+	}
+	// A merge patch replaces the whole list of egress listeners
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"egress": generated.Sidecar.Spec.Egress},
+	})
+}
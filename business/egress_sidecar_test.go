@@ -0,0 +1,119 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func setupGenerateSidecar(t *testing.T, objects ...runtime.Object) (*Layer, kubernetes.ClientInterface) {
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	config.Set(conf)
+
+	objects = append(objects, kubetest.FakeNamespace("bookinfo"))
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	stream := func(namespace, name, host string, value float64) *model.SampleStream {
+		return &model.SampleStream{
+			Metric: model.Metric{
+				"destination_service_namespace": model.LabelValue(namespace),
+				"destination_service_name":      model.LabelValue(name),
+				"destination_service":           model.LabelValue(host),
+			},
+			Values: []model.SamplePair{{Value: model.SampleValue(value)}},
+		}
+	}
+	grouping := "destination_service_namespace,destination_service_name,destination_service"
+	prom := new(prometheustest.PromClientMock)
+	prom.On("FetchIncrease", "istio_requests_total", mock.AnythingOfType("string"), grouping, mock.AnythingOfType("time.Time"), 24*time.Hour).
+		Return(prometheus.Metric{Matrix: model.Matrix{
+			stream("bookinfo", "reviews", "reviews.bookinfo.svc.cluster.local", 30),
+			stream("istio-system", "zipkin", "zipkin.istio-system.svc.cluster.local", 5),
+			stream("unknown", "api.github.com", "api.github.com", 8),
+			stream("unknown", "PassthroughCluster", "httpbin.org", 3),
+			stream("bookinfo", "idle", "idle.bookinfo.svc.cluster.local", 0),
+		}})
+	prom.On("FetchIncrease", "istio_tcp_connections_opened_total", mock.AnythingOfType("string"), grouping, mock.AnythingOfType("time.Time"), 24*time.Hour).
+		Return(prometheus.Metric{Matrix: model.Matrix{
+			stream("bookinfo", "mongodb", "mongodb.bookinfo.svc.cluster.local", 4),
+		}})
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	return NewWithBackends(k8sclients, k8sclients, prom, nil), k8s
+}
+
+func TestGenerateSidecar(t *testing.T) {
+	require := require.New(t)
+
+	layer, k8s := setupGenerateSidecar(t)
+	cluster := config.Get().KubernetesConfig.ClusterName
+
+	generated, err := layer.Egress.GenerateSidecar(context.TODO(), cluster, "bookinfo", 24*time.Hour)
+	require.NoError(err)
+	require.Equal("1d", generated.TrafficWindow)
+	require.False(generated.Exists)
+	require.Equal(GeneratedSidecarName, generated.Sidecar.Name)
+	require.Equal([]string{"httpbin.org"}, generated.Unregistered)
+
+	expected := []string{
+		"*/api.github.com",
+		"bookinfo/mongodb.bookinfo.svc.cluster.local",
+		"bookinfo/reviews.bookinfo.svc.cluster.local",
+		"istio-system/zipkin.istio-system.svc.cluster.local",
+	}
+	require.Len(generated.Sidecar.Spec.Egress, 1)
+	require.Equal(expected, generated.Sidecar.Spec.Egress[0].Hosts)
+	require.Len(generated.Hosts, 4)
+	require.Equal(float64(4), generated.Hosts[1].TCPConnections)
+	require.Equal(float64(30), generated.Hosts[2].Requests)
+
+	require.NoError(layer.Egress.ApplySidecar(context.TODO(), generated, "jdoe"))
+	require.True(generated.Applied)
+	created, err := k8s.Istio().NetworkingV1().Sidecars("bookinfo").Get(context.TODO(), GeneratedSidecarName, meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Equal(expected, created.Spec.Egress[0].Hosts)
+}
+
+func TestGenerateSidecarUpdatesNamespaceSidecar(t *testing.T) {
+	require := require.New(t)
+
+	current := data.AddHostsToSidecar([]string{"./*", "istio-system/*"}, data.CreateSidecar("restricted", "bookinfo"))
+	current.Spec.OutboundTrafficPolicy = &api_networking_v1.OutboundTrafficPolicy{Mode: api_networking_v1.OutboundTrafficPolicy_REGISTRY_ONLY}
+	// Sidecars selecting workloads are not replaced
+	selected := data.AddSelectorToSidecar(map[string]string{"app": "reviews"}, data.CreateSidecar("reviews", "bookinfo"))
+
+	layer, k8s := setupGenerateSidecar(t, current, selected)
+	cluster := config.Get().KubernetesConfig.ClusterName
+
+	generated, err := layer.Egress.GenerateSidecar(context.TODO(), cluster, "bookinfo", 24*time.Hour)
+	require.NoError(err)
+	require.True(generated.Exists)
+	require.Equal("restricted", generated.Sidecar.Name)
+	require.NotEmpty(generated.Changes)
+	// The other settings of the current Sidecar are kept
+	require.NotNil(generated.Sidecar.Spec.OutboundTrafficPolicy)
+	// The cached Sidecar is not modified
+	require.Equal([]string{"./*", "istio-system/*"}, current.Spec.Egress[0].Hosts)
+
+	require.NoError(layer.Egress.ApplySidecar(context.TODO(), generated, "jdoe"))
+	updated, err := k8s.Istio().NetworkingV1().Sidecars("bookinfo").Get(context.TODO(), "restricted", meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Len(updated.Spec.Egress[0].Hosts, 4)
+	require.NotNil(updated.Spec.OutboundTrafficPolicy)
+}
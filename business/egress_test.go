@@ -0,0 +1,73 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestGetEgressReport(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("bookinfo"))
+	SetupBusinessLayer(t, k8s, *conf)
+
+	stream := func(egressCluster, host, workload string, value float64) *model.SampleStream {
+		return &model.SampleStream{
+			Metric: model.Metric{
+				"destination_service_name":  model.LabelValue(egressCluster),
+				"destination_service":       model.LabelValue(host),
+				"source_workload_namespace": "bookinfo",
+				"source_workload":           model.LabelValue(workload),
+			},
+			Values: []model.SamplePair{{Value: model.SampleValue(value)}},
+		}
+	}
+	grouping := "destination_service_name,destination_service,source_workload_namespace,source_workload"
+	prom := new(prometheustest.PromClientMock)
+	prom.On("FetchIncrease", "istio_requests_total", mock.AnythingOfType("string"), grouping, mock.AnythingOfType("time.Time"), 24*time.Hour).
+		Return(prometheus.Metric{Matrix: model.Matrix{
+			stream("PassthroughCluster", "api.github.com", "reviews-v1", 30),
+			stream("PassthroughCluster", "api.github.com", "ratings-v1", 20),
+			stream("BlackHoleCluster", "blocked.example.com", "reviews-v1", 5),
+			stream("PassthroughCluster", "idle.example.com", "reviews-v1", 0),
+		}})
+	prom.On("FetchIncrease", "istio_tcp_connections_opened_total", mock.AnythingOfType("string"), grouping, mock.AnythingOfType("time.Time"), 24*time.Hour).
+		Return(prometheus.Metric{Matrix: model.Matrix{
+			stream("PassthroughCluster", "PassthroughCluster", "details-v1", 12),
+		}})
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, prom, nil)
+
+	report, err := layer.Egress.GetEgressReport(context.TODO(), conf.KubernetesConfig.ClusterName, "bookinfo", 24*time.Hour, 0)
+	require.NoError(err)
+	require.Equal("1d", report.TrafficWindow)
+	require.Len(report.Destinations, 3)
+
+	require.Equal("api.github.com", report.Destinations[0].Host)
+	require.Equal(float64(50), report.Destinations[0].Requests)
+	require.Equal([]string{"bookinfo/ratings-v1", "bookinfo/reviews-v1"}, report.Destinations[0].Sources)
+
+	require.Equal("PassthroughCluster", report.Destinations[1].Host)
+	require.Equal(float64(12), report.Destinations[1].TCPConnections)
+
+	require.Equal("blocked.example.com", report.Destinations[2].Host)
+	require.Equal("BlackHoleCluster", report.Destinations[2].EgressCluster)
+
+	report, err = layer.Egress.GetEgressReport(context.TODO(), conf.KubernetesConfig.ClusterName, "bookinfo", 24*time.Hour, 1)
+	require.NoError(err)
+	require.Len(report.Destinations, 1)
+}
@@ -0,0 +1,192 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// VerifyVirtualServiceRoutes checks whether the last change of a VirtualService is live in the proxy of a pod:
+// the destinations of the HTTP routes of the VirtualService applying to the sidecars are compared with the ones
+// of the routes of the proxy generated from it, and the routes must have been updated after the change.
+// TCP and TLS routes are not verified as they are not part of the routes of the proxy.
+func (in *ProxyStatusService) VerifyVirtualServiceRoutes(ctx context.Context, cluster, namespace, pod, vsNamespace, vsName string) (*models.EnvoyRouteVerification, error) {
+	// Check the user has access to the namespaces
+	for _, ns := range []string{namespace, vsNamespace} {
+		if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, ns, cluster); err != nil {
+			return nil, err
+		}
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	vs, err := kubeCache.GetVirtualService(ctx, vsNamespace, vsName)
+	if err != nil {
+		return nil, err
+	}
+
+	kialiSAClient, ok := in.kialiSAClients[cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster [%s] not found", cluster)
+	}
+	dump, err := kialiSAClient.GetConfigDump(namespace, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := verifyEnvoyRoutes(vs, dump)
+	if err != nil {
+		return nil, err
+	}
+	verification.Cluster = cluster
+	verification.Namespace = namespace
+	verification.Pod = pod
+	if status := in.GetPodProxyStatus(cluster, namespace, pod, true); status != nil {
+		verification.RDS = status.RDS
+	}
+	return verification, nil
+}
+
+// verifyEnvoyRoutes compares the VirtualService with the routes of the config dump generated from it.
+func verifyEnvoyRoutes(vs *networking_v1.VirtualService, dump *kubernetes.ConfigDump) (*models.EnvoyRouteVerification, error) {
+	routesDump, err := dump.GetRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	verification := &models.EnvoyRouteVerification{
+		VirtualService:  models.IstioReference{ObjectGVK: kubernetes.VirtualServices, Name: vs.Name, Namespace: vs.Namespace},
+		ResourceVersion: vs.ResourceVersion,
+		ChangedAt:       vs.CreationTimestamp.Time,
+		RouteConfigs:    []string{},
+		Missing:         []models.EnvoyRouteDestination{},
+		Unexpected:      []models.EnvoyRouteDestination{},
+	}
+	for _, managedFields := range vs.ManagedFields {
+		if managedFields.Time != nil && managedFields.Time.After(verification.ChangedAt) {
+			verification.ChangedAt = managedFields.Time.Time
+		}
+	}
+
+	expected := map[models.EnvoyRouteDestination]bool{}
+	for _, route := range vs.Spec.Http {
+		gateways := [][]string{}
+		for _, match := range route.Match {
+			gateways = append(gateways, match.Gateways)
+		}
+		if !routeAppliesToMesh(gateways) {
+			continue
+		}
+		for _, dest := range route.Route {
+			if dest.GetDestination() != nil {
+				expected[models.EnvoyRouteDestination{Host: resolveHostFQDN(dest.Destination.Host, vs.Namespace), Subset: dest.Destination.Subset}] = true
+			}
+		}
+	}
+	if !appliesToMesh(vs.Spec.Gateways) {
+		expected = map[models.EnvoyRouteDestination]bool{}
+	}
+
+	proxy := map[models.EnvoyRouteDestination]bool{}
+	var lastUpdated *time.Time
+	for _, routeConfigs := range [][]kubernetes.EnvoyRouteConfig{routesDump.DynamicRouteConfigs, routesDump.StaticRouteConfigs} {
+		for _, routeConfig := range routeConfigs {
+			if routeConfig.RouteConfig == nil {
+				continue
+			}
+			generated := false
+			for _, vhs := range routeConfig.RouteConfig.VirtualHosts {
+				for _, r := range vhs.Routes {
+					if !generatedFrom(r.Metadata, vs) || r.Route == nil {
+						continue
+					}
+					generated = true
+					clusters := []string{r.Route.Cluster}
+					if r.Route.WeightedClusters != nil {
+						for _, c := range r.Route.WeightedClusters.Clusters {
+							clusters = append(clusters, c.Name)
+						}
+					}
+					for _, c := range clusters {
+						if dest, ok := parseOutboundCluster(c); ok {
+							proxy[dest] = true
+						}
+					}
+				}
+			}
+			if !generated {
+				continue
+			}
+			verification.RouteConfigs = append(verification.RouteConfigs, routeConfig.RouteConfig.Name)
+			if updated, err := time.Parse(time.RFC3339Nano, routeConfig.LastUpdated); err == nil && (lastUpdated == nil || updated.After(*lastUpdated)) {
+				lastUpdated = &updated
+			}
+		}
+	}
+
+	verification.Expected = sortedDestinations(expected)
+	verification.Proxy = sortedDestinations(proxy)
+	for _, dest := range verification.Expected {
+		if !proxy[dest] {
+			verification.Missing = append(verification.Missing, dest)
+		}
+	}
+	for _, dest := range verification.Proxy {
+		if !expected[dest] {
+			verification.Unexpected = append(verification.Unexpected, dest)
+		}
+	}
+	sort.Strings(verification.RouteConfigs)
+
+	verification.Propagated = len(verification.Missing) == 0 && len(verification.Unexpected) == 0
+	if lastUpdated != nil {
+		if lastUpdated.Before(verification.ChangedAt) {
+			verification.Propagated = false
+		} else if verification.Propagated {
+			verification.PropagatedAt = lastUpdated
+		}
+	}
+	return verification, nil
+}
+
+// generatedFrom returns whether the Istio metadata of a route, i.e.
+// "/apis/networking.istio.io/v1/namespaces/bookinfo/virtual-service/reviews", references the VirtualService.
+func generatedFrom(metadata *kubernetes.EnvoyMetadata, vs *networking_v1.VirtualService) bool {
+	if metadata == nil || metadata.FilterMetadata == nil || metadata.FilterMetadata.Istio == nil {
+		return false
+	}
+	parts := strings.Split(metadata.FilterMetadata.Istio.Config, "/")
+	return len(parts) == 8 && parts[2] == kubernetes.VirtualServices.Group && parts[5] == vs.Namespace && parts[6] == "virtual-service" && parts[7] == vs.Name
+}
+
+// parseOutboundCluster returns the destination of an outbound cluster, i.e. "outbound|9080|v1|reviews.bookinfo.svc.cluster.local".
+func parseOutboundCluster(cluster string) (models.EnvoyRouteDestination, bool) {
+	parts := strings.Split(cluster, "|")
+	if len(parts) != 4 || parts[0] != "outbound" {
+		return models.EnvoyRouteDestination{}, false
+	}
+	return models.EnvoyRouteDestination{Host: parts[3], Subset: parts[2]}, true
+}
+
+func sortedDestinations(destinations map[models.EnvoyRouteDestination]bool) []models.EnvoyRouteDestination {
+	sorted := make([]models.EnvoyRouteDestination, 0, len(destinations))
+	for dest := range destinations {
+		sorted = append(sorted, dest)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Host != sorted[j].Host {
+			return sorted[i].Host < sorted[j].Host
+		}
+		return sorted[i].Subset < sorted[j].Subset
+	})
+	return sorted
+}
@@ -0,0 +1,87 @@
+package business
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func fakeRoutesDump(lastUpdated string, clusters ...string) *kubernetes.ConfigDump {
+	weighted := []interface{}{}
+	for _, c := range clusters {
+		weighted = append(weighted, map[string]interface{}{"name": c, "weight": 50})
+	}
+	route := func(config string, action map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"match":    map[string]interface{}{"prefix": "/"},
+			"metadata": map[string]interface{}{"filter_metadata": map[string]interface{}{"istio": map[string]interface{}{"config": config}}},
+			"route":    action,
+		}
+	}
+	return &kubernetes.ConfigDump{Configs: []interface{}{
+		map[string]interface{}{
+			"@type": "type.googleapis.com/envoy.admin.v3.RoutesConfigDump",
+			"dynamic_route_configs": []interface{}{
+				map[string]interface{}{
+					"version_info": "2024-05-01T10:00:05Z/42",
+					"last_updated": lastUpdated,
+					"route_config": map[string]interface{}{
+						"name": "9080",
+						"virtual_hosts": []interface{}{
+							map[string]interface{}{
+								"name":    "reviews.bookinfo.svc.cluster.local:9080",
+								"domains": []interface{}{"reviews.bookinfo.svc.cluster.local"},
+								"routes": []interface{}{
+									route("/apis/networking.istio.io/v1/namespaces/bookinfo/virtual-service/reviews", map[string]interface{}{"weighted_clusters": map[string]interface{}{"clusters": weighted}}),
+									route("/apis/networking.istio.io/v1/namespaces/bookinfo/virtual-service/ratings", map[string]interface{}{"cluster": "outbound|9080|v1|ratings.bookinfo.svc.cluster.local"}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+}
+
+func TestVerifyEnvoyRoutes(t *testing.T) {
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	vs := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v2", 50),
+		data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 50),
+			data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})))
+	changedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	vs.ManagedFields = []meta_v1.ManagedFieldsEntry{{Manager: "kiali", Time: &meta_v1.Time{Time: changedAt}}}
+
+	verification, err := verifyEnvoyRoutes(vs, fakeRoutesDump("2024-05-01T10:00:05.123Z",
+		"outbound|9080|v1|reviews.bookinfo.svc.cluster.local", "outbound|9080|v2|reviews.bookinfo.svc.cluster.local"))
+	require.NoError(err)
+	require.True(verification.Propagated)
+	require.Equal(changedAt, verification.ChangedAt)
+	require.Equal(time.Date(2024, 5, 1, 10, 0, 5, 123000000, time.UTC), *verification.PropagatedAt)
+	require.Equal([]string{"9080"}, verification.RouteConfigs)
+	require.Len(verification.Proxy, 2)
+
+	// The proxy still routes to the previous subset
+	verification, err = verifyEnvoyRoutes(vs, fakeRoutesDump("2024-05-01T10:00:05Z",
+		"outbound|9080|v1|reviews.bookinfo.svc.cluster.local", "outbound|9080|v3|reviews.bookinfo.svc.cluster.local"))
+	require.NoError(err)
+	require.False(verification.Propagated)
+	require.Nil(verification.PropagatedAt)
+	require.Equal([]models.EnvoyRouteDestination{{Host: "reviews.bookinfo.svc.cluster.local", Subset: "v2"}}, verification.Missing)
+	require.Equal([]models.EnvoyRouteDestination{{Host: "reviews.bookinfo.svc.cluster.local", Subset: "v3"}}, verification.Unexpected)
+
+	// The routes were not updated since the change
+	verification, err = verifyEnvoyRoutes(vs, fakeRoutesDump("2024-05-01T09:59:00Z",
+		"outbound|9080|v1|reviews.bookinfo.svc.cluster.local", "outbound|9080|v2|reviews.bookinfo.svc.cluster.local"))
+	require.NoError(err)
+	require.False(verification.Propagated)
+}
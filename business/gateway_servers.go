@@ -0,0 +1,102 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/business/checkers/gateways"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// GetGatewayServerMap returns the servers of all the Istio Gateways of the cluster grouped by host and port, with the
+// ingress workloads serving them. The servers conflicting with the servers of other Gateways, as validated by the
+// Gateway multi match checker, are highlighted.
+func (in *IstioConfigService) GetGatewayServerMap(ctx context.Context, cluster string) (*models.GatewayServerMap, error) {
+	istioConfigList, err := in.GetIstioConfigList(ctx, cluster, IstioConfigCriteria{IncludeGateways: true})
+	if err != nil {
+		return nil, err
+	}
+	gatewayWorkloads, err := in.businessLayer.Workload.GetAllGateways(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := map[string]bool{}
+	validations := gateways.MultiMatchChecker{Cluster: cluster, Gateways: istioConfigList.Gateways}.Check()
+	for key, validation := range validations {
+		for _, check := range validation.Checks {
+			conflicts[key.Namespace+"/"+key.Name+"/"+check.Path] = true
+		}
+	}
+
+	hostPorts := map[string]*models.GatewayHostPort{}
+	for _, gw := range istioConfigList.Gateways {
+		workloads := []models.WorkloadReference{}
+		if len(gw.Spec.Selector) > 0 {
+			selector := labels.SelectorFromSet(gw.Spec.Selector)
+			for _, w := range gatewayWorkloads {
+				if selector.Matches(labels.Set(w.Labels)) {
+					workloads = append(workloads, models.WorkloadReference{Name: w.Name, Namespace: w.Namespace})
+				}
+			}
+		}
+
+		for i, server := range gw.Spec.Servers {
+			if server == nil || server.Port == nil {
+				continue
+			}
+			port := int(server.Port.Number)
+			for j, host := range server.Hosts {
+				hostname := host
+				if parts := strings.Split(host, "/"); len(parts) > 1 {
+					hostname = parts[1]
+				}
+				hostname = strings.ToLower(hostname)
+
+				key := fmt.Sprintf("%s:%d", hostname, port)
+				hostPort, found := hostPorts[key]
+				if !found {
+					hostPort = &models.GatewayHostPort{Host: hostname, Port: port, Servers: []models.GatewayServer{}}
+					hostPorts[key] = hostPort
+				}
+				conflict := conflicts[fmt.Sprintf("%s/%s/spec/servers[%d]/hosts[%d]", gw.Namespace, gw.Name, i, j)]
+				hostPort.Servers = append(hostPort.Servers, models.GatewayServer{
+					IstioReference: models.IstioReference{ObjectGVK: kubernetes.Gateways, Name: gw.Name, Namespace: gw.Namespace},
+					Field:          fmt.Sprintf("spec.servers[%d].hosts[%d]", i, j),
+					Value:          host,
+					Protocol:       server.Port.Protocol,
+					Workloads:      workloads,
+					Conflict:       conflict,
+				})
+				hostPort.Conflict = hostPort.Conflict || conflict
+			}
+		}
+	}
+
+	result := &models.GatewayServerMap{Cluster: cluster, HostPorts: make([]models.GatewayHostPort, 0, len(hostPorts))}
+	for _, hostPort := range hostPorts {
+		sort.SliceStable(hostPort.Servers, func(i, j int) bool {
+			if hostPort.Servers[i].Namespace != hostPort.Servers[j].Namespace {
+				return hostPort.Servers[i].Namespace < hostPort.Servers[j].Namespace
+			}
+			return hostPort.Servers[i].Name < hostPort.Servers[j].Name
+		})
+		result.HostPorts = append(result.HostPorts, *hostPort)
+		if hostPort.Conflict {
+			result.Conflicts++
+		}
+	}
+	sort.Slice(result.HostPorts, func(i, j int) bool {
+		if result.HostPorts[i].Host != result.HostPorts[j].Host {
+			return result.HostPorts[i].Host < result.HostPorts[j].Host
+		}
+		return result.HostPorts[i].Port < result.HostPorts[j].Port
+	})
+
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestGetGatewayServerMap(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	kubernetes.SetConfig(t, *conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	gatewayLabels := map[string]string{"istio": "ingressgateway"}
+	objects := []runtime.Object{
+		kubetest.FakeNamespace("bookinfo"),
+		kubetest.FakeNamespace("reviews"),
+		kubetest.FakeNamespace("istio-system"),
+		&apps_v1.Deployment{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "istio-ingressgateway", Namespace: "istio-system", Labels: gatewayLabels},
+			Spec: apps_v1.DeploymentSpec{
+				Selector: &meta_v1.LabelSelector{MatchLabels: gatewayLabels},
+				Template: core_v1.PodTemplateSpec{ObjectMeta: meta_v1.ObjectMeta{Labels: gatewayLabels}},
+			},
+		},
+		data.AddServerToGateway(data.CreateServer([]string{"bookinfo.example.com"}, 80, "http", "HTTP"),
+			data.CreateEmptyGateway("bookinfo-gateway", "bookinfo", gatewayLabels)),
+		data.AddServerToGateway(data.CreateServer([]string{"*/Bookinfo.example.com"}, 80, "http", "HTTP"),
+			data.CreateEmptyGateway("reviews-gateway", "reviews", gatewayLabels)),
+		data.AddServerToGateway(data.CreateServer([]string{"reviews.example.com"}, 443, "https", "HTTPS"),
+			data.CreateEmptyGateway("secure-gateway", "reviews", gatewayLabels)),
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{cluster: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	serverMap, err := layer.IstioConfig.GetGatewayServerMap(context.TODO(), cluster)
+	require.NoError(err)
+	require.Equal(cluster, serverMap.Cluster)
+	require.Len(serverMap.HostPorts, 2)
+	require.Equal(1, serverMap.Conflicts)
+
+	conflicting := serverMap.HostPorts[0]
+	require.Equal("bookinfo.example.com", conflicting.Host)
+	require.Equal(80, conflicting.Port)
+	require.True(conflicting.Conflict)
+	require.Len(conflicting.Servers, 2)
+	require.Equal("bookinfo-gateway", conflicting.Servers[0].Name)
+	require.Equal("spec.servers[0].hosts[0]", conflicting.Servers[0].Field)
+	require.True(conflicting.Servers[0].Conflict)
+	require.Equal([]models.WorkloadReference{{Name: "istio-ingressgateway", Namespace: "istio-system"}}, conflicting.Servers[0].Workloads)
+	require.Equal("reviews-gateway", conflicting.Servers[1].Name)
+	require.Equal("*/Bookinfo.example.com", conflicting.Servers[1].Value)
+	require.True(conflicting.Servers[1].Conflict)
+
+	secure := serverMap.HostPorts[1]
+	require.Equal("reviews.example.com", secure.Host)
+	require.Equal(443, secure.Port)
+	require.False(secure.Conflict)
+	require.Len(secure.Servers, 1)
+	require.Equal("HTTPS", secure.Servers[0].Protocol)
+}
@@ -3,12 +3,15 @@ package business
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/prometheus/common/model"
 	"k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/cache"
 	"github.com/kiali/kiali/models"
 	"github.com/kiali/kiali/observability"
 	"github.com/kiali/kiali/prometheus"
@@ -18,6 +21,7 @@ import (
 type HealthService struct {
 	prom          prometheus.ClientInterface
 	businessLayer *Layer
+	kialiCache    cache.KialiCache
 	userClients   map[string]kubernetes.ClientInterface
 }
 
@@ -45,7 +49,7 @@ func (in *HealthService) GetServiceHealth(ctx context.Context, namespace, cluste
 	defer end()
 
 	rqHealth, err := in.getServiceRequestsHealth(namespace, cluster, service, rateInterval, queryTime, svc)
-	return models.ServiceHealth{Requests: rqHealth}, err
+	return models.ServiceHealth{Requests: rqHealth, SLO: in.getServiceSLOStatus(cluster, namespace, service)}, err
 }
 
 // GetAppHealth returns an app health from just Namespace and app name (thus, it fetches data from K8S and Prometheus)
@@ -79,7 +83,7 @@ func (in *HealthService) getAppHealth(namespace, cluster, app, rateInterval stri
 	// Fetch services requests rates
 	var errRate error
 	if hasSidecar {
-		rate, err := in.getAppRequestsHealth(namespace, cluster, app, rateInterval, queryTime)
+		rate, err := in.getAppRequestsHealth(namespace, cluster, appCanonicalServices(app, ws), rateInterval, queryTime)
 		health.Requests = rate
 		errRate = err
 	}
@@ -154,6 +158,8 @@ func (in *HealthService) getNamespaceAppHealth(appEntities namespaceApps, criter
 	// Perf: do not bother fetching request rate if no workloads or no workload has sidecar
 	sidecarPresent := false
 	var appSidecars = make(map[string]bool)
+	// Key: canonical service of a Knative Revision; Value: app of its Knative Service
+	var canonicalApps = make(map[string]string)
 
 	// Prepare all data
 	for app, entities := range appEntities {
@@ -169,6 +175,21 @@ func (in *HealthService) getNamespaceAppHealth(appEntities namespaceApps, criter
 						break
 					}
 				}
+				for _, canonicalService := range appCanonicalServices(app, entities.Workloads) {
+					if canonicalService != app {
+						canonicalApps[canonicalService] = app
+					}
+				}
+				// The graph still reports the Knative Revisions as apps, as they are in the telemetry
+				for _, w := range entities.Workloads {
+					if _, ok := canonicalApps[w.CanonicalService]; ok {
+						if _, exists := allHealth[w.CanonicalService]; !exists {
+							rh := models.EmptyAppHealth()
+							allHealth[w.CanonicalService] = &rh
+						}
+						allHealth[w.CanonicalService].WorkloadStatuses = append(allHealth[w.CanonicalService].WorkloadStatuses, w.CastWorkloadStatus())
+					}
+				}
 			}
 		}
 	}
@@ -180,7 +201,7 @@ func (in *HealthService) getNamespaceAppHealth(appEntities namespaceApps, criter
 			return allHealth, errors.NewServiceUnavailable(err.Error())
 		}
 		// Fill with collected request rates
-		fillAppRequestRates(allHealth, rates, appSidecars)
+		fillAppRequestRates(allHealth, rates, appSidecars, canonicalApps)
 	}
 
 	return allHealth, nil
@@ -239,6 +260,7 @@ func (in *HealthService) getNamespaceServiceHealth(services *models.ServiceList,
 		for _, service := range services.Services {
 			h := models.EmptyServiceHealth()
 			h.Requests.HealthAnnotations = service.HealthAnnotations
+			h.SLO = in.getServiceSLOStatus(cluster, namespace, service.Name)
 			allHealth[service.Name] = &h
 		}
 	}
@@ -327,18 +349,25 @@ func (in *HealthService) getNamespaceWorkloadHealth(ws models.Workloads, criteri
 }
 
 // fillAppRequestRates aggregates requests rates from metrics fetched from Prometheus, and stores the result in the health map.
-func fillAppRequestRates(allHealth models.NamespaceAppHealth, rates model.Vector, appSidecars map[string]bool) {
+// The rates of the canonical services of the Knative Revisions are aggregated in the app of their Knative Service.
+func fillAppRequestRates(allHealth models.NamespaceAppHealth, rates model.Vector, appSidecars map[string]bool, canonicalApps map[string]string) {
 	lblDest := model.LabelName("destination_canonical_service")
 	lblSrc := model.LabelName("source_canonical_service")
+	appName := func(canonicalService string) string {
+		if app, ok := canonicalApps[canonicalService]; ok {
+			return app
+		}
+		return canonicalService
+	}
 
 	for _, sample := range rates {
-		name := string(sample.Metric[lblDest])
+		name := appName(string(sample.Metric[lblDest]))
 		// include requests only to apps which have a sidecar
 		if _, ok := appSidecars[name]; ok {
 			if health, ok := allHealth[name]; ok {
 				health.Requests.AggregateInbound(sample)
 			}
-			name = string(sample.Metric[lblSrc])
+			name = appName(string(sample.Metric[lblSrc]))
 			if health, ok := allHealth[name]; ok {
 				health.Requests.AggregateOutbound(sample)
 			}
@@ -390,23 +419,54 @@ func (in *HealthService) getServiceRequestsHealth(namespace, cluster, service, r
 	return rqHealth, nil
 }
 
-func (in *HealthService) getAppRequestsHealth(namespace, cluster, app, rateInterval string, queryTime time.Time) (models.RequestHealth, error) {
-	rqHealth := models.NewEmptyRequestHealth()
-
-	inbound, outbound, err := in.prom.GetAppRequestRates(namespace, cluster, app, rateInterval, queryTime)
-	if err != nil {
-		return rqHealth, errors.NewServiceUnavailable(err.Error())
+// getServiceSLOStatus returns the last SLO evaluation of the service, if any.
+func (in *HealthService) getServiceSLOStatus(cluster, namespace, service string) *models.SLOStatus {
+	if in.kialiCache == nil {
+		return nil
 	}
-	for _, sample := range inbound {
-		rqHealth.AggregateInbound(sample)
+	if status, found := in.kialiCache.SLOStatuses().Get(models.SLOKey{Cluster: cluster, Namespace: namespace, Service: service}); found {
+		return status
 	}
-	for _, sample := range outbound {
-		rqHealth.AggregateOutbound(sample)
+	return nil
+}
+
+// getAppRequestsHealth aggregates the request rates of the canonical services of an app, which are more than one
+// for the Revisions of a Knative Service.
+func (in *HealthService) getAppRequestsHealth(namespace, cluster string, canonicalServices []string, rateInterval string, queryTime time.Time) (models.RequestHealth, error) {
+	rqHealth := models.NewEmptyRequestHealth()
+
+	for _, canonicalService := range canonicalServices {
+		inbound, outbound, err := in.prom.GetAppRequestRates(namespace, cluster, canonicalService, rateInterval, queryTime)
+		if err != nil {
+			return rqHealth, errors.NewServiceUnavailable(err.Error())
+		}
+		for _, sample := range inbound {
+			rqHealth.AggregateInbound(sample)
+		}
+		for _, sample := range outbound {
+			rqHealth.AggregateOutbound(sample)
+		}
 	}
 	rqHealth.CombineReporters()
 	return rqHealth, nil
 }
 
+// appCanonicalServices returns the canonical services reporting the telemetry of an app: the app itself,
+// or the canonical services of the Revisions when the app is a Knative Service.
+func appCanonicalServices(app string, ws models.Workloads) []string {
+	canonicalServices := []string{}
+	for _, w := range ws {
+		if w.Knative != nil && w.Knative.App() == app && !slices.Contains(canonicalServices, w.CanonicalService) {
+			canonicalServices = append(canonicalServices, w.CanonicalService)
+		}
+	}
+	if len(canonicalServices) == 0 {
+		return []string{app}
+	}
+	sort.Strings(canonicalServices)
+	return canonicalServices
+}
+
 func (in *HealthService) getWorkloadRequestsHealth(namespace, cluster, workload, rateInterval string, queryTime time.Time, w *models.Workload) (models.RequestHealth, error) {
 	rqHealth := models.NewEmptyRequestHealth()
 	// @TODO include w.Cluster into query
@@ -125,6 +125,49 @@ func TestGetAppHealth(t *testing.T) {
 	assert.Equal(result, health.Requests.Outbound)
 }
 
+func TestGetKnativeAppHealth(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+	k8s := kubetest.NewFakeK8sClient(&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "ns"}})
+	k8s.OpenShift = true
+	prom := new(prometheustest.PromClientMock)
+
+	clients := make(map[string]kubernetes.ClientInterface)
+	clients[conf.KubernetesConfig.ClusterName] = k8s
+
+	hs := HealthService{prom: prom, businessLayer: NewWithBackends(clients, clients, prom, nil), userClients: clients}
+
+	queryTime := time.Date(2017, 1, 15, 0, 0, 0, 0, time.UTC)
+	prom.MockAppRequestRates("ns", conf.KubernetesConfig.ClusterName, "reviews-00001", otherRatesIn, model.Vector{})
+	prom.MockAppRequestRates("ns", conf.KubernetesConfig.ClusterName, "reviews-00002", otherRatesIn, model.Vector{})
+
+	revisionWorkload := func(revision string, replicas int32) *models.Workload {
+		w := models.Workload{DesiredReplicas: replicas, CurrentReplicas: replicas, AvailableReplicas: replicas}
+		w.Name = revision + "-deployment"
+		w.IstioSidecar = true
+		w.CanonicalService = revision
+		w.Knative = &models.KnativeInfo{Service: "reviews", Configuration: "reviews", Revision: revision}
+		return &w
+	}
+	mockApp := appDetails{
+		Workloads: models.Workloads{revisionWorkload("reviews-00001", 0), revisionWorkload("reviews-00002", 1)},
+	}
+
+	health, _ := hs.GetAppHealth(context.TODO(), "ns", conf.KubernetesConfig.ClusterName, "reviews", "1m", queryTime, &mockApp)
+
+	prom.AssertNumberOfCalls(t, "GetAppRequestRates", 2)
+	result := map[string]map[string]float64{
+		"http": {
+			"500": 3.2,
+		},
+	}
+	assert.Equal(result, health.Requests.Inbound)
+	assert.Len(health.WorkloadStatuses, 2)
+	assert.True(health.WorkloadStatuses[0].ScaledToZero)
+	assert.False(health.WorkloadStatuses[1].ScaledToZero)
+}
+
 func TestGetWorkloadHealth(t *testing.T) {
 	assert := assert.New(t)
 	conf := config.NewConfig()
@@ -0,0 +1,178 @@
+package business
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// healthTransitionsBuffer is the number of transitions kept for a slow watcher. A watcher falling further behind is
+// dropped: its stream ends, and the client gets the current statuses again when it reconnects.
+const healthTransitionsBuffer = 100
+
+// appHealthWatchKey identifies the evaluations of the health of the apps of a namespace shared by the watchers.
+type appHealthWatchKey struct {
+	cluster      string
+	interval     time.Duration
+	namespace    string
+	rateInterval string
+}
+
+// appHealthWatch evaluates the health of the apps of a namespace every interval, and sends the changes of their
+// statuses to its watchers.
+type appHealthWatch struct {
+	cancel context.CancelFunc
+	// ready is true once the first evaluation is done, the statuses are then the current ones
+	ready    bool
+	statuses map[string]string
+	watchers map[chan models.AppHealthTransition]struct{}
+}
+
+// appHealthWatches keeps a single evaluation per namespace, whatever the number of its watchers. It is safe for
+// concurrent use.
+type appHealthWatches struct {
+	lock    sync.Mutex
+	watches map[appHealthWatchKey]*appHealthWatch
+}
+
+// healthWatches are the health evaluations followed by the health event streams of the Kiali instance.
+var healthWatches = &appHealthWatches{watches: map[appHealthWatchKey]*appHealthWatch{}}
+
+// WatchNamespaceAppHealth returns the changes of the health statuses of the apps of a namespace, evaluated every
+// interval, until the context is done. The first transitions report the current status of every app. The query time
+// of the criteria is ignored, each evaluation is made at the current time. An evaluation that fails is skipped.
+// The watchers of a namespace share its evaluations, made with the Kiali service account once the access of the user
+// to the namespace is checked.
+func (in *HealthService) WatchNamespaceAppHealth(ctx context.Context, criteria NamespaceHealthCriteria, interval time.Duration) (<-chan models.AppHealthTransition, error) {
+	// Checks the user access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, criteria.Namespace, criteria.Cluster); err != nil {
+		return nil, err
+	}
+
+	saClients := in.businessLayer.Namespace.kialiSAClients
+	evaluate := func(ctx context.Context, queryTime time.Time) (models.ReportHealth, error) {
+		criteria.QueryTime = queryTime
+		health, err := NewWithBackends(saClients, saClients, in.prom, nil).Health.GetNamespaceAppHealth(ctx, criteria)
+		if err != nil {
+			return models.ReportHealth{}, err
+		}
+		return reportHealth(health), nil
+	}
+	key := appHealthWatchKey{cluster: criteria.Cluster, interval: interval, namespace: criteria.Namespace, rateInterval: criteria.RateInterval}
+	return healthWatches.watch(ctx, key, evaluate), nil
+}
+
+// watch adds a watcher to the evaluation of the key, started by its first watcher and stopped after its last one.
+func (in *appHealthWatches) watch(ctx context.Context, key appHealthWatchKey, evaluate func(ctx context.Context, queryTime time.Time) (models.ReportHealth, error)) <-chan models.AppHealthTransition {
+	in.lock.Lock()
+	defer in.lock.Unlock()
+
+	watch, found := in.watches[key]
+	buffer := healthTransitionsBuffer
+	if found {
+		buffer += len(watch.statuses)
+	}
+	watcher := make(chan models.AppHealthTransition, buffer)
+	if !found {
+		watchCtx, cancel := context.WithCancel(context.Background())
+		watch = &appHealthWatch{cancel: cancel, statuses: map[string]string{}, watchers: map[chan models.AppHealthTransition]struct{}{}}
+		in.watches[key] = watch
+		go in.evaluate(watchCtx, key, watch, evaluate)
+	} else if watch.ready {
+		// The evaluation is shared, the statuses of the previous evaluation are the first transitions of the watcher
+		now := time.Now()
+		for _, transition := range appHealthTransitions(key.namespace, map[string]string{}, statusesHealth(watch.statuses), now) {
+			watcher <- transition
+		}
+	}
+	watch.watchers[watcher] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		in.lock.Lock()
+		defer in.lock.Unlock()
+		in.remove(key, watch, watcher)
+	}()
+	return watcher
+}
+
+// evaluate evaluates the health of the apps now and every interval of the key, until the watch is cancelled.
+func (in *appHealthWatches) evaluate(ctx context.Context, key appHealthWatchKey, watch *appHealthWatch, evaluate func(ctx context.Context, queryTime time.Time) (models.ReportHealth, error)) {
+	ticker := time.NewTicker(key.interval)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		if health, err := evaluate(ctx, now); err != nil {
+			log.Debugf("Unable to evaluate the health of the apps of namespace [%s]: %s", key.namespace, err)
+		} else {
+			in.lock.Lock()
+			watch.ready = true
+			for _, transition := range appHealthTransitions(key.namespace, watch.statuses, health, now) {
+				for watcher := range watch.watchers {
+					select {
+					case watcher <- transition:
+					default:
+						in.remove(key, watch, watcher)
+					}
+				}
+			}
+			in.lock.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// remove closes a watcher, and stops the evaluation after its last watcher. The lock must be held.
+func (in *appHealthWatches) remove(key appHealthWatchKey, watch *appHealthWatch, watcher chan models.AppHealthTransition) {
+	if _, found := watch.watchers[watcher]; !found {
+		return
+	}
+	delete(watch.watchers, watcher)
+	close(watcher)
+	if len(watch.watchers) == 0 && in.watches[key] == watch {
+		watch.cancel()
+		delete(in.watches, key)
+	}
+}
+
+// statusesHealth returns the health of the apps with the given statuses.
+func statusesHealth(statuses map[string]string) models.ReportHealth {
+	health := models.ReportHealth{Apps: make([]models.ReportAppHealth, 0, len(statuses))}
+	for name, status := range statuses {
+		health.Apps = append(health.Apps, models.ReportAppHealth{Name: name, Status: status})
+	}
+	return health
+}
+
+// appHealthTransitions returns the changes between the previous statuses of the apps and their current health,
+// sorted by app, and updates the previous statuses.
+func appHealthTransitions(namespace string, previous map[string]string, health models.ReportHealth, now time.Time) []models.AppHealthTransition {
+	transitions := []models.AppHealthTransition{}
+	current := make(map[string]bool, len(health.Apps))
+	for _, app := range health.Apps {
+		current[app.Name] = true
+		if from, found := previous[app.Name]; !found || from != app.Status {
+			transitions = append(transitions, models.AppHealthTransition{Namespace: namespace, App: app.Name, From: from, To: app.Status, Timestamp: now})
+			previous[app.Name] = app.Status
+		}
+	}
+	for name, from := range previous {
+		if !current[name] {
+			transitions = append(transitions, models.AppHealthTransition{Namespace: namespace, App: name, From: from, Timestamp: now})
+			delete(previous, name)
+		}
+	}
+	sort.Slice(transitions, func(i, j int) bool {
+		return transitions[i].App < transitions[j].App
+	})
+	return transitions
+}
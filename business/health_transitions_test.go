@@ -0,0 +1,138 @@
+package business
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	osproject_v1 "github.com/openshift/api/project/v1"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestAppHealthTransitions(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	previous := map[string]string{}
+
+	transitions := appHealthTransitions("ns", previous, models.ReportHealth{Apps: []models.ReportAppHealth{
+		{Name: "reviews", Status: models.ReportHealthHealthy},
+		{Name: "details", Status: models.ReportHealthDegraded},
+	}}, now)
+	require.Equal([]models.AppHealthTransition{
+		{Namespace: "ns", App: "details", To: models.ReportHealthDegraded, Timestamp: now},
+		{Namespace: "ns", App: "reviews", To: models.ReportHealthHealthy, Timestamp: now},
+	}, transitions)
+
+	// Only the changes are reported, including the apps that are gone
+	transitions = appHealthTransitions("ns", previous, models.ReportHealth{Apps: []models.ReportAppHealth{
+		{Name: "reviews", Status: models.ReportHealthFailure},
+	}}, now.Add(time.Minute))
+	require.Equal([]models.AppHealthTransition{
+		{Namespace: "ns", App: "details", From: models.ReportHealthDegraded, Timestamp: now.Add(time.Minute)},
+		{Namespace: "ns", App: "reviews", From: models.ReportHealthHealthy, To: models.ReportHealthFailure, Timestamp: now.Add(time.Minute)},
+	}, transitions)
+	require.Equal(map[string]string{"reviews": models.ReportHealthFailure}, previous)
+
+	require.Empty(appHealthTransitions("ns", previous, models.ReportHealth{Apps: []models.ReportAppHealth{
+		{Name: "reviews", Status: models.ReportHealthFailure},
+	}}, now.Add(2*time.Minute)))
+}
+
+func TestWatchNamespaceAppHealth(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	objects := []runtime.Object{
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "ns"}},
+		&core_v1.Service{ObjectMeta: meta_v1.ObjectMeta{Name: "httpbin", Namespace: "ns"}},
+	}
+	for _, obj := range fakeDeploymentsHealthReview() {
+		o := obj
+		objects = append(objects, &o)
+	}
+	for _, obj := range fakePodsHealthReviewWithoutIstio() {
+		o := obj
+		objects = append(objects, &o)
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	k8s.OpenShift = true
+	prom := new(prometheustest.PromClientMock)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	clients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	hs := NewWithBackends(clients, clients, prom, nil).Health
+	criteria := NamespaceHealthCriteria{Cluster: conf.KubernetesConfig.ClusterName, Namespace: "ns", RateInterval: "1m", IncludeMetrics: true}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	transitions, err := hs.WatchNamespaceAppHealth(ctx, criteria, time.Hour)
+	require.NoError(err)
+
+	// The first evaluation reports the status of every app
+	transition := <-transitions
+	require.Equal("ns", transition.Namespace)
+	require.Equal("reviews", transition.App)
+	require.Empty(transition.From)
+	require.Equal(models.ReportHealthFailure, transition.To)
+
+	cancel()
+	for range transitions {
+	}
+}
+
+func TestAppHealthWatchesShareEvaluations(t *testing.T) {
+	require := require.New(t)
+
+	watches := &appHealthWatches{watches: map[appHealthWatchKey]*appHealthWatch{}}
+	key := appHealthWatchKey{cluster: "east", interval: time.Hour, namespace: "ns", rateInterval: "1m"}
+	var lock sync.Mutex
+	evaluations := 0
+	evaluate := func(ctx context.Context, queryTime time.Time) (models.ReportHealth, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		evaluations++
+		return models.ReportHealth{Apps: []models.ReportAppHealth{{Name: "reviews", Status: models.ReportHealthHealthy}}}, nil
+	}
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	first := watches.watch(ctx1, key, evaluate)
+	require.Equal(models.AppHealthTransition{Namespace: "ns", App: "reviews", To: models.ReportHealthHealthy}, withoutTimestamp(<-first))
+
+	// A second watcher gets the current statuses from the evaluation of the first one
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	second := watches.watch(ctx2, key, evaluate)
+	require.Equal(models.AppHealthTransition{Namespace: "ns", App: "reviews", To: models.ReportHealthHealthy}, withoutTimestamp(<-second))
+	lock.Lock()
+	require.Equal(1, evaluations)
+	lock.Unlock()
+
+	// The evaluation stops after the last watcher
+	cancel1()
+	for range first {
+	}
+	watches.lock.Lock()
+	require.Len(watches.watches, 1)
+	watches.lock.Unlock()
+	cancel2()
+	for range second {
+	}
+	watches.lock.Lock()
+	require.Empty(watches.watches)
+	watches.lock.Unlock()
+}
+
+func withoutTimestamp(transition models.AppHealthTransition) models.AppHealthTransition {
+	transition.Timestamp = time.Time{}
+	return transition
+}
@@ -0,0 +1,31 @@
+package business
+
+import (
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// filterByHelmRelease keeps only the objects of the list managed by the given Helm release.
+func filterByHelmRelease(istioConfigList *models.IstioConfigList, release string) {
+	annotation := models.HelmReleaseNameAnnotation
+	istioConfigList.AuthorizationPolicies = kubernetes.FilterByAnnotationValue(istioConfigList.AuthorizationPolicies, annotation, release)
+	istioConfigList.DestinationRules = kubernetes.FilterByAnnotationValue(istioConfigList.DestinationRules, annotation, release)
+	istioConfigList.EnvoyFilters = kubernetes.FilterByAnnotationValue(istioConfigList.EnvoyFilters, annotation, release)
+	istioConfigList.Gateways = kubernetes.FilterByAnnotationValue(istioConfigList.Gateways, annotation, release)
+	istioConfigList.K8sGateways = kubernetes.FilterByAnnotationValue(istioConfigList.K8sGateways, annotation, release)
+	istioConfigList.K8sGRPCRoutes = kubernetes.FilterByAnnotationValue(istioConfigList.K8sGRPCRoutes, annotation, release)
+	istioConfigList.K8sHTTPRoutes = kubernetes.FilterByAnnotationValue(istioConfigList.K8sHTTPRoutes, annotation, release)
+	istioConfigList.K8sReferenceGrants = kubernetes.FilterByAnnotationValue(istioConfigList.K8sReferenceGrants, annotation, release)
+	istioConfigList.K8sTCPRoutes = kubernetes.FilterByAnnotationValue(istioConfigList.K8sTCPRoutes, annotation, release)
+	istioConfigList.K8sTLSRoutes = kubernetes.FilterByAnnotationValue(istioConfigList.K8sTLSRoutes, annotation, release)
+	istioConfigList.PeerAuthentications = kubernetes.FilterByAnnotationValue(istioConfigList.PeerAuthentications, annotation, release)
+	istioConfigList.ProxyConfigs = kubernetes.FilterByAnnotationValue(istioConfigList.ProxyConfigs, annotation, release)
+	istioConfigList.RequestAuthentications = kubernetes.FilterByAnnotationValue(istioConfigList.RequestAuthentications, annotation, release)
+	istioConfigList.ServiceEntries = kubernetes.FilterByAnnotationValue(istioConfigList.ServiceEntries, annotation, release)
+	istioConfigList.Sidecars = kubernetes.FilterByAnnotationValue(istioConfigList.Sidecars, annotation, release)
+	istioConfigList.Telemetries = kubernetes.FilterByAnnotationValue(istioConfigList.Telemetries, annotation, release)
+	istioConfigList.VirtualServices = kubernetes.FilterByAnnotationValue(istioConfigList.VirtualServices, annotation, release)
+	istioConfigList.WasmPlugins = kubernetes.FilterByAnnotationValue(istioConfigList.WasmPlugins, annotation, release)
+	istioConfigList.WorkloadEntries = kubernetes.FilterByAnnotationValue(istioConfigList.WorkloadEntries, annotation, release)
+	istioConfigList.WorkloadGroups = kubernetes.FilterByAnnotationValue(istioConfigList.WorkloadGroups, annotation, release)
+}
@@ -0,0 +1,61 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestIstioConfigHelmRelease(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	managed := data.CreateEmptyVirtualService("managed", "bookinfo", []string{"reviews"})
+	managed.Annotations = map[string]string{
+		models.HelmReleaseNameAnnotation:      "bookinfo",
+		models.HelmReleaseNamespaceAnnotation: "bookinfo",
+	}
+	managed.Labels = map[string]string{models.HelmChartLabel: "bookinfo-routes-1.2.0"}
+	unmanaged := data.CreateEmptyVirtualService("unmanaged", "bookinfo", []string{"ratings"})
+
+	k8s := kubetest.NewFakeK8sClient([]runtime.Object{kubetest.FakeNamespace("bookinfo"), managed, unmanaged}...)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	cluster := conf.KubernetesConfig.ClusterName
+	k8sclients := map[string]kubernetes.ClientInterface{cluster: k8s}
+	service := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, businessLayer: NewWithBackends(k8sclients, k8sclients, nil, nil)}
+
+	criteria := IstioConfigCriteria{IncludeVirtualServices: true}
+	list, err := service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Len(list.VirtualServices, 2)
+
+	criteria.HelmRelease = "bookinfo"
+	list, err = service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Len(list.VirtualServices, 1)
+	require.Equal("managed", list.VirtualServices[0].Name)
+
+	criteria.HelmRelease = "ratings"
+	list, err = service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Empty(list.VirtualServices)
+
+	details, err := service.GetIstioConfigDetails(context.TODO(), cluster, "bookinfo", kubernetes.VirtualServices, "managed")
+	require.NoError(err)
+	require.Equal(&models.HelmRelease{Name: "bookinfo", Namespace: "bookinfo", Chart: "bookinfo-routes", Version: "1.2.0"}, details.HelmRelease)
+
+	details, err = service.GetIstioConfigDetails(context.TODO(), cluster, "bookinfo", kubernetes.VirtualServices, "unmanaged")
+	require.NoError(err)
+	require.Nil(details.HelmRelease)
+}
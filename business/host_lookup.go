@@ -0,0 +1,253 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// hostCandidate is a host declaration ranked to compute its precedence.
+type hostCandidate struct {
+	ref *models.HostReference
+	// group of candidates competing with each other
+	group string
+	// lower is applied first
+	namespaceRank int
+	exact         bool
+	created       int64
+}
+
+// GetHostReferences returns every Istio object of the cluster referencing the host: VirtualServices, DestinationRules,
+// ServiceEntries, Gateways and Sidecars. Short host names are resolved in clientNamespace, which is also used to compute
+// the precedence of the DestinationRules, and can be empty.
+// The precedence of the objects declaring the host follows Istio: exact hosts are applied before wildcards, more specific
+// wildcards before less specific ones and older objects before newer ones. DestinationRules are first looked up in the
+// client namespace, then in the host namespace and then in the root namespace.
+func (in *IstioConfigService) GetHostReferences(ctx context.Context, cluster, host, clientNamespace string) (*models.HostReferences, error) {
+	criteria := IstioConfigCriteria{
+		IncludeDestinationRules: true,
+		IncludeGateways:         true,
+		IncludeServiceEntries:   true,
+		IncludeSidecars:         true,
+		IncludeVirtualServices:  true,
+	}
+	istioConfigList, err := in.GetIstioConfigList(ctx, cluster, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	if clientNamespace != "" {
+		host = resolveHostFQDN(host, clientNamespace)
+	}
+	hostNamespace := ""
+	if parsed := kubernetes.ParseHost(host, ""); parsed.CompleteInput {
+		hostNamespace = parsed.Namespace
+	}
+	rootNamespace := in.config.ExternalServices.Istio.RootNamespace
+
+	result := &models.HostReferences{Cluster: cluster, Host: host, References: []models.HostReference{}}
+	candidates := []hostCandidate{}
+	newRef := func(gvk models.IstioReference, field, value string) *models.HostReference {
+		return &models.HostReference{IstioReference: gvk, Field: field, Value: value}
+	}
+
+	for _, vs := range istioConfigList.VirtualServices {
+		ref := models.IstioReference{ObjectGVK: kubernetes.VirtualServices, Name: vs.Name, Namespace: vs.Namespace}
+		gateways := append([]string{}, vs.Spec.Gateways...)
+		if len(gateways) == 0 {
+			gateways = []string{"mesh"}
+		}
+		sort.Strings(gateways)
+		for i, h := range vs.Spec.Hosts {
+			if matches, exact := hostPatternMatches(resolveHostFQDN(h, vs.Namespace), host); matches {
+				candidates = append(candidates, hostCandidate{
+					ref:     newRef(ref, fmt.Sprintf("spec.hosts[%d]", i), h),
+					group:   "VirtualService/" + strings.Join(gateways, ","),
+					exact:   exact,
+					created: vs.CreationTimestamp.Unix(),
+				})
+			}
+		}
+		for i, route := range vs.Spec.Http {
+			for j, dest := range route.GetRoute() {
+				if dest.GetDestination() != nil && resolveHostFQDN(dest.Destination.Host, vs.Namespace) == host {
+					result.References = append(result.References, *newRef(ref, fmt.Sprintf("spec.http[%d].route[%d].destination.host", i, j), dest.Destination.Host))
+				}
+			}
+			if mirror := route.GetMirror(); mirror != nil && resolveHostFQDN(mirror.Host, vs.Namespace) == host {
+				result.References = append(result.References, *newRef(ref, fmt.Sprintf("spec.http[%d].mirror.host", i), mirror.Host))
+			}
+		}
+		for i, route := range vs.Spec.Tcp {
+			for j, dest := range route.GetRoute() {
+				if dest.GetDestination() != nil && resolveHostFQDN(dest.Destination.Host, vs.Namespace) == host {
+					result.References = append(result.References, *newRef(ref, fmt.Sprintf("spec.tcp[%d].route[%d].destination.host", i, j), dest.Destination.Host))
+				}
+			}
+		}
+		for i, route := range vs.Spec.Tls {
+			for j, dest := range route.GetRoute() {
+				if dest.GetDestination() != nil && resolveHostFQDN(dest.Destination.Host, vs.Namespace) == host {
+					result.References = append(result.References, *newRef(ref, fmt.Sprintf("spec.tls[%d].route[%d].destination.host", i, j), dest.Destination.Host))
+				}
+			}
+		}
+	}
+
+	for _, dr := range istioConfigList.DestinationRules {
+		if matches, exact := hostPatternMatches(resolveHostFQDN(dr.Spec.Host, dr.Namespace), host); matches {
+			namespaceRank := 3
+			switch {
+			case clientNamespace != "" && dr.Namespace == clientNamespace:
+				namespaceRank = 0
+			case hostNamespace != "" && dr.Namespace == hostNamespace:
+				namespaceRank = 1
+			case dr.Namespace == rootNamespace:
+				namespaceRank = 2
+			}
+			candidates = append(candidates, hostCandidate{
+				ref:           newRef(models.IstioReference{ObjectGVK: kubernetes.DestinationRules, Name: dr.Name, Namespace: dr.Namespace}, "spec.host", dr.Spec.Host),
+				group:         "DestinationRule",
+				namespaceRank: namespaceRank,
+				exact:         exact,
+				created:       dr.CreationTimestamp.Unix(),
+			})
+		}
+	}
+
+	for _, se := range istioConfigList.ServiceEntries {
+		for i, h := range se.Spec.Hosts {
+			if matches, exact := hostPatternMatches(h, host); matches {
+				candidates = append(candidates, hostCandidate{
+					ref:     newRef(models.IstioReference{ObjectGVK: kubernetes.ServiceEntries, Name: se.Name, Namespace: se.Namespace}, fmt.Sprintf("spec.hosts[%d]", i), h),
+					group:   "ServiceEntry",
+					exact:   exact,
+					created: se.CreationTimestamp.Unix(),
+				})
+			}
+		}
+	}
+
+	for _, gw := range istioConfigList.Gateways {
+		ref := models.IstioReference{ObjectGVK: kubernetes.Gateways, Name: gw.Name, Namespace: gw.Namespace}
+		for i, server := range gw.Spec.Servers {
+			for j, h := range server.GetHosts() {
+				if namespacedHostMatches(h, gw.Namespace, host, hostNamespace) {
+					result.References = append(result.References, *newRef(ref, fmt.Sprintf("spec.servers[%d].hosts[%d]", i, j), h))
+				}
+			}
+		}
+	}
+
+	for _, sc := range istioConfigList.Sidecars {
+		ref := models.IstioReference{ObjectGVK: kubernetes.Sidecars, Name: sc.Name, Namespace: sc.Namespace}
+		for i, egress := range sc.Spec.Egress {
+			for j, h := range egress.GetHosts() {
+				if namespacedHostMatches(h, sc.Namespace, host, hostNamespace) {
+					result.References = append(result.References, *newRef(ref, fmt.Sprintf("spec.egress[%d].hosts[%d]", i, j), h))
+				}
+			}
+		}
+	}
+
+	result.References = append(result.References, rankHostCandidates(candidates)...)
+	sort.SliceStable(result.References, func(i, j int) bool {
+		ri, rj := result.References[i], result.References[j]
+		if ri.ObjectGVK.Kind != rj.ObjectGVK.Kind {
+			return ri.ObjectGVK.Kind < rj.ObjectGVK.Kind
+		}
+		// Declarations first, by precedence
+		if (ri.Precedence == 0) != (rj.Precedence == 0) {
+			return ri.Precedence != 0
+		}
+		if ri.Precedence != rj.Precedence {
+			return ri.Precedence < rj.Precedence
+		}
+		if ri.Namespace != rj.Namespace {
+			return ri.Namespace < rj.Namespace
+		}
+		return ri.Name < rj.Name
+	})
+
+	return result, nil
+}
+
+// rankHostCandidates sets the precedence of the candidates within their group.
+func rankHostCandidates(candidates []hostCandidate) []models.HostReference {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if ci.group != cj.group {
+			return ci.group < cj.group
+		}
+		if ci.namespaceRank != cj.namespaceRank {
+			return ci.namespaceRank < cj.namespaceRank
+		}
+		if ci.exact != cj.exact {
+			return ci.exact
+		}
+		// Longer wildcards are more specific
+		if len(ci.ref.Value) != len(cj.ref.Value) {
+			return len(ci.ref.Value) > len(cj.ref.Value)
+		}
+		if ci.created != cj.created {
+			return ci.created < cj.created
+		}
+		return ci.ref.Namespace+"/"+ci.ref.Name < cj.ref.Namespace+"/"+cj.ref.Name
+	})
+
+	refs := make([]models.HostReference, 0, len(candidates))
+	precedence := 0
+	var applied *models.HostReference
+	for i, c := range candidates {
+		if i == 0 || c.group != candidates[i-1].group {
+			precedence = 0
+			applied = c.ref
+		}
+		precedence++
+		c.ref.Precedence = precedence
+		if precedence == 1 {
+			c.ref.PrecedenceReason = "Applied"
+		} else {
+			c.ref.PrecedenceReason = fmt.Sprintf("Shadowed by %s %s/%s", applied.ObjectGVK.Kind, applied.Namespace, applied.Name)
+		}
+		refs = append(refs, *c.ref)
+	}
+	return refs
+}
+
+// hostPatternMatches returns whether the host pattern, which can be a wildcard, matches the host
+// and whether it is an exact match.
+func hostPatternMatches(pattern, host string) (bool, bool) {
+	if pattern == host {
+		return true, true
+	}
+	if pattern == "*" || kubernetes.HostWithinWildcardHost(host, pattern) {
+		return true, false
+	}
+	return false, false
+}
+
+// namespacedHostMatches returns whether the Gateway or Sidecar host, written as namespace/host, matches the host.
+// The namespace part can be "*" for any namespace, "." for the namespace of the object and "~" for none. When the
+// namespace of the host is unknown, as for ServiceEntry hosts, only the host part is checked.
+func namespacedHostMatches(value, objectNamespace, host, hostNamespace string) bool {
+	namespace, pattern := "*", value
+	if i := strings.Index(value, "/"); i >= 0 {
+		namespace, pattern = value[:i], value[i+1:]
+	}
+	switch namespace {
+	case "~":
+		return false
+	case ".":
+		namespace = objectNamespace
+	}
+	if namespace != "*" && hostNamespace != "" && namespace != hostNamespace {
+		return false
+	}
+	matches, _ := hostPatternMatches(pattern, host)
+	return matches
+}
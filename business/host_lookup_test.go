@@ -0,0 +1,77 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestGetHostReferences(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	objects := []runtime.Object{
+		kubetest.FakeNamespace("bookinfo"),
+		kubetest.FakeNamespace("istio-system"),
+		kubetest.FakeNamespace("travel"),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+		data.CreateEmptyVirtualService("wildcard", "bookinfo", []string{"*.bookinfo.svc.cluster.local"}),
+		data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews.bookinfo.svc.cluster.local", "v1", -1),
+			data.CreateEmptyVirtualService("productpage", "bookinfo", []string{"productpage"})),
+		data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+		data.CreateEmptyDestinationRule("istio-system", "mesh-wide", "*.local"),
+		data.CreateEmptyDestinationRule("travel", "client", "reviews.bookinfo.svc.cluster.local"),
+		data.CreateEmptyDestinationRule("bookinfo", "ratings", "ratings"),
+		data.AddServerToGateway(data.CreateServer([]string{"bookinfo/reviews.bookinfo.svc.cluster.local"}, 80, "http", "HTTP"),
+			data.CreateEmptyGateway("gateway", "bookinfo", nil)),
+		data.AddHostsToSidecar([]string{"./*", "istio-system/*"}, data.CreateSidecar("default", "bookinfo")),
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	configService := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, businessLayer: NewWithBackends(k8sclients, k8sclients, nil, nil)}
+
+	refs, err := configService.GetHostReferences(context.TODO(), conf.KubernetesConfig.ClusterName, "reviews", "travel")
+	require.NoError(err)
+	require.Equal("reviews.travel.svc.cluster.local", refs.Host)
+
+	refs, err = configService.GetHostReferences(context.TODO(), conf.KubernetesConfig.ClusterName, "reviews.bookinfo.svc.cluster.local", "travel")
+	require.NoError(err)
+
+	found := []string{}
+	for _, ref := range refs.References {
+		found = append(found, ref.ObjectGVK.Kind+"/"+ref.Namespace+"/"+ref.Name+" "+ref.Field)
+	}
+	require.Equal([]string{
+		"DestinationRule/travel/client spec.host",
+		"DestinationRule/bookinfo/reviews spec.host",
+		"DestinationRule/istio-system/mesh-wide spec.host",
+		"Gateway/bookinfo/gateway spec.servers[0].hosts[0]",
+		"Sidecar/bookinfo/default spec.egress[0].hosts[0]",
+		"VirtualService/bookinfo/reviews spec.hosts[0]",
+		"VirtualService/bookinfo/wildcard spec.hosts[0]",
+		"VirtualService/bookinfo/productpage spec.http[0].route[0].destination.host",
+	}, found)
+
+	drs := refs.References[:3]
+	require.Equal(1, drs[0].Precedence)
+	require.Equal("Applied", drs[0].PrecedenceReason)
+	require.Equal(2, drs[1].Precedence)
+	require.Equal("Shadowed by DestinationRule travel/client", drs[1].PrecedenceReason)
+	require.Equal(3, drs[2].Precedence)
+
+	vss := refs.References[5:]
+	require.Equal(1, vss[0].Precedence)
+	require.Equal(2, vss[1].Precedence)
+	require.Equal(0, vss[2].Precedence)
+}
@@ -0,0 +1,228 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+)
+
+// ingressLatencyQuantiles are the request duration quantiles reported for each route.
+var ingressLatencyQuantiles = []string{"0.5", "0.95", "0.99"}
+
+// IngressService maps the traffic entering the mesh through the gateways to the config routing it.
+type IngressService struct {
+	businessLayer *Layer
+	prom          prometheus.ClientInterface
+}
+
+// NewIngressService creates a new IngressService.
+func NewIngressService(businessLayer *Layer, prom prometheus.ClientInterface) IngressService {
+	return IngressService{
+		businessLayer: businessLayer,
+		prom:          prom,
+	}
+}
+
+// GetGatewayTraffic returns the traffic going through the workloads selected by the Gateway,
+// broken down by the routes of the VirtualServices bound to it. Traffic to destinations that are not routed
+// by any of those VirtualServices is also reported, without VirtualService.
+// Only the HTTP routes are reported: the TCP and TLS routes are left out, their traffic has no request metrics.
+func (in *IngressService) GetGatewayTraffic(ctx context.Context, cluster, namespace, gateway, rateInterval string, queryTime time.Time) (*models.IngressTraffic, error) {
+	// Checks the user access to the gateway
+	gwDetails, err := in.businessLayer.IstioConfig.GetIstioConfigDetails(ctx, cluster, namespace, kubernetes.Gateways, gateway)
+	if err != nil {
+		return nil, err
+	}
+	gw := gwDetails.Gateway
+
+	istioConfigList, err := in.businessLayer.IstioConfig.GetIstioConfigList(ctx, cluster, IstioConfigCriteria{IncludeVirtualServices: true})
+	if err != nil {
+		return nil, err
+	}
+
+	gatewayWorkloads, err := in.businessLayer.Workload.GetAllGateways(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	traffic := &models.IngressTraffic{
+		Cluster:      cluster,
+		Namespace:    namespace,
+		Gateway:      gateway,
+		QueryTime:    queryTime,
+		RateInterval: rateInterval,
+		Workloads:    []string{},
+		Routes:       []models.IngressRouteTraffic{},
+	}
+
+	selector := labels.SelectorFromSet(gw.Spec.Selector)
+	// the request health of each destination, with the rates of every reporter combined after the last workload
+	rates := map[string]*models.RequestHealth{}
+	workloadNames := []string{}
+	workloadNamespaces := map[string]bool{}
+	for _, w := range gatewayWorkloads {
+		if len(gw.Spec.Selector) == 0 || !selector.Matches(labels.Set(w.Labels)) {
+			continue
+		}
+		traffic.Workloads = append(traffic.Workloads, w.Namespace+"/"+w.Name)
+		workloadNames = append(workloadNames, w.Name)
+		workloadNamespaces[w.Namespace] = true
+
+		_, outbound, err := in.prom.GetWorkloadRequestRates(w.Namespace, cluster, w.Name, rateInterval, queryTime)
+		if err != nil {
+			return nil, errors.NewServiceUnavailable(err.Error())
+		}
+		addIngressRequestRates(rates, outbound)
+	}
+	sort.Strings(traffic.Workloads)
+	for _, destinationRates := range rates {
+		destinationRates.CombineReporters()
+	}
+
+	latencies := map[string]map[string]float64{}
+	if len(workloadNames) > 0 {
+		nsNames := make([]string, 0, len(workloadNamespaces))
+		for ns := range workloadNamespaces {
+			nsNames = append(nsNames, regexp.QuoteMeta(ns))
+		}
+		for i, name := range workloadNames {
+			workloadNames[i] = regexp.QuoteMeta(name)
+		}
+		// The gateways report the request duration as seen by the clients
+		lbl := fmt.Sprintf(`{reporter="source",source_cluster="%s",source_workload_namespace=~"%s",source_workload=~"%s"}`, cluster, strings.Join(nsNames, "|"), strings.Join(workloadNames, "|"))
+		histogram, err := in.prom.FetchHistogramValues("istio_request_duration_milliseconds", lbl, "destination_service", rateInterval, false, ingressLatencyQuantiles, queryTime)
+		if err != nil {
+			return nil, err
+		}
+		for quantile, vector := range histogram {
+			for _, sample := range vector {
+				// Destinations without requests have no quantile
+				if math.IsNaN(float64(sample.Value)) {
+					continue
+				}
+				destination := string(sample.Metric["destination_service"])
+				if latencies[destination] == nil {
+					latencies[destination] = map[string]float64{}
+				}
+				latencies[destination][quantile] = float64(sample.Value)
+			}
+		}
+	}
+
+	routeTraffic := func(destination string) models.IngressRouteTraffic {
+		routeTraffic := models.IngressRouteTraffic{Destination: destination, Latencies: latencies[destination]}
+		if routeTraffic.Latencies == nil {
+			routeTraffic.Latencies = map[string]float64{}
+		}
+		if destinationRates, found := rates[destination]; found {
+			routeTraffic.RequestRate, routeTraffic.ErrorRatio = destinationRates.ErrorRatio()
+		}
+		return routeTraffic
+	}
+
+	// The metrics are reported per destination, not per route: the traffic of a destination is reported once, by its
+	// first route, so that the totals of the routes don't count it twice
+	routed := map[string]string{}
+	virtualServices := filterVirtualServicesByGateway(istioConfigList.VirtualServices, gw)
+	for _, vs := range virtualServices {
+		ref := &models.IstioReference{ObjectGVK: kubernetes.VirtualServices, Name: vs.Name, Namespace: vs.Namespace}
+		for i, route := range vs.Spec.Http {
+			if route == nil {
+				continue
+			}
+			routeName := route.Name
+			if routeName == "" {
+				routeName = fmt.Sprintf("%d", i)
+			}
+			for _, dest := range route.Route {
+				if dest == nil || dest.Destination == nil {
+					continue
+				}
+				destination := resolveHostFQDN(dest.Destination.Host, vs.Namespace)
+				var rt models.IngressRouteTraffic
+				if reportedBy, found := routed[destination]; found {
+					rt = models.IngressRouteTraffic{Destination: destination, Latencies: map[string]float64{}, TrafficReportedBy: reportedBy}
+				} else {
+					routed[destination] = vs.Namespace + "/" + vs.Name + "/" + routeName
+					rt = routeTraffic(destination)
+				}
+				rt.VirtualService = ref
+				rt.Hosts = vs.Spec.Hosts
+				rt.Route = routeName
+				traffic.Routes = append(traffic.Routes, rt)
+			}
+		}
+	}
+
+	unrouted := []string{}
+	for destination := range rates {
+		if _, found := routed[destination]; !found {
+			unrouted = append(unrouted, destination)
+		}
+	}
+	sort.Strings(unrouted)
+	for _, destination := range unrouted {
+		rt := routeTraffic(destination)
+		rt.Hosts = []string{}
+		traffic.Routes = append(traffic.Routes, rt)
+	}
+
+	return traffic, nil
+}
+
+// filterVirtualServicesByGateway returns the VirtualServices bound to the gateway, sorted by namespace and name.
+func filterVirtualServicesByGateway(virtualServices []*networking_v1.VirtualService, gw *networking_v1.Gateway) []*networking_v1.VirtualService {
+	bound := []*networking_v1.VirtualService{}
+	for _, vs := range virtualServices {
+		for _, gwName := range vs.Spec.Gateways {
+			if !strings.Contains(gwName, "/") {
+				gwName = vs.Namespace + "/" + gwName
+			}
+			if gwName == gw.Namespace+"/"+gw.Name {
+				bound = append(bound, vs)
+				break
+			}
+		}
+	}
+	sort.Slice(bound, func(i, j int) bool {
+		if bound[i].Namespace != bound[j].Namespace {
+			return bound[i].Namespace < bound[j].Namespace
+		}
+		return bound[i].Name < bound[j].Name
+	})
+	return bound
+}
+
+// resolveHostFQDN returns the FQDN of short service hosts, resolved in the namespace, which is also the host
+// reported in the destination_service label. Wildcards and other hosts, such as ServiceEntry hosts, are returned as is.
+func resolveHostFQDN(host, namespace string) string {
+	return kubernetes.NormalizeHost(host, namespace, nil)
+}
+
+func addIngressRequestRates(rates map[string]*models.RequestHealth, vector model.Vector) {
+	for _, sample := range vector {
+		destination := string(sample.Metric["destination_service"])
+		if destination == "" || destination == "unknown" {
+			continue
+		}
+		if rates[destination] == nil {
+			destinationRates := models.NewEmptyRequestHealth()
+			rates[destination] = &destinationRates
+		}
+		// The requests can be reported by both the gateway and the destination proxies, they are counted once
+		rates[destination].AggregateInbound(sample)
+	}
+}
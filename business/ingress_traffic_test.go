@@ -0,0 +1,115 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestGetGatewayTraffic(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	cluster := conf.KubernetesConfig.ClusterName
+	queryTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	gatewayLabels := map[string]string{"istio": "ingressgateway"}
+	bookinfo := data.AddGatewaysToVirtualService([]string{"bookinfo-gateway"},
+		data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("productpage", "", -1),
+			data.CreateEmptyVirtualService("bookinfo", "bookinfo", []string{"bookinfo.example.com"})))
+	// A second route of the same destination
+	bookinfo.Spec.Http = append(bookinfo.Spec.Http, &api_networking_v1.HTTPRoute{
+		Name:  "canary",
+		Route: []*api_networking_v1.HTTPRouteDestination{data.CreateHttpRouteDestination("productpage", "v2", -1)},
+	})
+	objects := []runtime.Object{
+		kubetest.FakeNamespace("bookinfo"),
+		kubetest.FakeNamespace("istio-system"),
+		&apps_v1.Deployment{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "istio-ingressgateway", Namespace: "istio-system", Labels: gatewayLabels},
+			Spec: apps_v1.DeploymentSpec{
+				Selector: &meta_v1.LabelSelector{MatchLabels: gatewayLabels},
+				Template: core_v1.PodTemplateSpec{ObjectMeta: meta_v1.ObjectMeta{Labels: gatewayLabels}},
+			},
+		},
+		data.CreateEmptyGateway("bookinfo-gateway", "bookinfo", gatewayLabels),
+		bookinfo,
+		// Not bound to the gateway
+		data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "", -1),
+			data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})),
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	sample := func(destination, reporter, code string, value float64) *model.Sample {
+		return &model.Sample{
+			Metric: model.Metric{"destination_service": model.LabelValue(destination), "reporter": model.LabelValue(reporter), "response_code": model.LabelValue(code)},
+			Value:  model.SampleValue(value),
+		}
+	}
+	prom := new(prometheustest.PromClientMock)
+	prom.On("GetWorkloadRequestRates", "istio-system", cluster, "istio-ingressgateway", "10m", queryTime).Return(model.Vector{}, model.Vector{
+		sample("productpage.bookinfo.svc.cluster.local", "source", "200", 6),
+		sample("productpage.bookinfo.svc.cluster.local", "source", "404", 2),
+		sample("productpage.bookinfo.svc.cluster.local", "source", "503", 2),
+		// Also reported by the destination
+		sample("productpage.bookinfo.svc.cluster.local", "destination", "200", 6),
+		sample("productpage.bookinfo.svc.cluster.local", "destination", "404", 2),
+		sample("productpage.bookinfo.svc.cluster.local", "destination", "503", 2),
+		sample("details.bookinfo.svc.cluster.local", "source", "200", 1),
+	}, nil)
+	prom.On("FetchHistogramValues", "istio_request_duration_milliseconds", mock.AnythingOfType("string"), "destination_service", "10m", false, ingressLatencyQuantiles, queryTime).
+		Return(map[string]model.Vector{
+			"0.5":  {{Metric: model.Metric{"destination_service": "productpage.bookinfo.svc.cluster.local"}, Value: 12}},
+			"0.95": {{Metric: model.Metric{"destination_service": "productpage.bookinfo.svc.cluster.local"}, Value: 80}},
+		}, nil)
+
+	k8sclients := map[string]kubernetes.ClientInterface{cluster: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, prom, nil)
+
+	traffic, err := layer.Ingress.GetGatewayTraffic(context.TODO(), cluster, "bookinfo", "bookinfo-gateway", "10m", queryTime)
+	require.NoError(err)
+	require.Equal([]string{"istio-system/istio-ingressgateway"}, traffic.Workloads)
+	require.Len(traffic.Routes, 3)
+
+	routed := traffic.Routes[0]
+	require.Equal("bookinfo", routed.VirtualService.Name)
+	require.Equal([]string{"bookinfo.example.com"}, routed.Hosts)
+	require.Equal("0", routed.Route)
+	require.Equal("productpage.bookinfo.svc.cluster.local", routed.Destination)
+	require.Equal(float64(10), routed.RequestRate)
+	// 4xx codes are not failures
+	require.Equal(0.2, routed.ErrorRatio)
+	require.Equal(map[string]float64{"0.5": 12, "0.95": 80}, routed.Latencies)
+	require.Empty(routed.TrafficReportedBy)
+
+	// The traffic of the destination is not counted twice
+	shared := traffic.Routes[1]
+	require.Equal("canary", shared.Route)
+	require.Equal("productpage.bookinfo.svc.cluster.local", shared.Destination)
+	require.Equal("bookinfo/bookinfo/0", shared.TrafficReportedBy)
+	require.Zero(shared.RequestRate)
+	require.Zero(shared.ErrorRatio)
+	require.Empty(shared.Latencies)
+
+	unrouted := traffic.Routes[2]
+	require.Nil(unrouted.VirtualService)
+	require.Equal("details.bookinfo.svc.cluster.local", unrouted.Destination)
+	require.Equal(float64(1), unrouted.RequestRate)
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	"golang.org/x/sync/errgroup"
 	extentions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1 "istio.io/client-go/pkg/apis/security/v1"
 	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
 	api_errors "k8s.io/apimachinery/pkg/api/errors"
@@ -52,6 +57,7 @@ type IstioConfigCriteria struct {
 	IncludeSidecars               bool
 	IncludeAuthorizationPolicies  bool
 	IncludePeerAuthentications    bool
+	IncludeProxyConfigs           bool
 	IncludeWorkloadEntries        bool
 	IncludeWorkloadGroups         bool
 	IncludeRequestAuthentications bool
@@ -60,6 +66,18 @@ type IstioConfigCriteria struct {
 	IncludeTelemetry              bool
 	LabelSelector                 string
 	WorkloadSelector              string
+
+	// FilterByTeams keeps only the objects owned by one of the Teams.
+	// Team ownership is set with the Ownership.TeamLabel label.
+	FilterByTeams bool
+	Teams         []string
+
+	// HelmRelease keeps only the objects managed by the Helm release with this name, when not empty.
+	HelmRelease string
+
+	// ChangedSince, when not 0, is the bookmark of a delta list: only the objects changed after this resourceVersion
+	// are kept and the deletions since then are added.
+	ChangedSince uint64
 }
 
 func (icc IstioConfigCriteria) Include(resource schema.GroupVersionKind) bool {
@@ -92,6 +110,8 @@ func (icc IstioConfigCriteria) Include(resource schema.GroupVersionKind) bool {
 		return icc.IncludeAuthorizationPolicies
 	case kubernetes.PeerAuthentications:
 		return icc.IncludePeerAuthentications
+	case kubernetes.ProxyConfigs:
+		return icc.IncludeProxyConfigs
 	case kubernetes.WorkloadEntries:
 		return icc.IncludeWorkloadEntries && !isWorkloadSelector
 	case kubernetes.WorkloadGroups:
@@ -108,6 +128,20 @@ func (icc IstioConfigCriteria) Include(resource schema.GroupVersionKind) bool {
 	return false
 }
 
+// requestedTypes returns the types of objects included by the criteria, in a stable order.
+func (icc IstioConfigCriteria) requestedTypes() []schema.GroupVersionKind {
+	requested := []schema.GroupVersionKind{}
+	for _, gvk := range kubernetes.ResourceTypesToAPI {
+		if icc.Include(gvk) {
+			requested = append(requested, gvk)
+		}
+	}
+	sort.Slice(requested, func(i, j int) bool {
+		return requested[i].String() < requested[j].String()
+	})
+	return requested
+}
+
 // IstioConfig types used in the IstioConfig New Page Form
 // networking.istio.io
 var newNetworkingConfigTypes = []schema.GroupVersionKind{
@@ -129,30 +163,48 @@ var newSecurityConfigTypes = []schema.GroupVersionKind{
 	kubernetes.RequestAuthentications,
 }
 
+// newFanOutGroup returns an errgroup, and its derived context, that runs at most limit goroutines at a time.
+// The context is cancelled as soon as one of the goroutines returns an error. A limit <= 0 means no limit.
+func newFanOutGroup(ctx context.Context, limit int) (*errgroup.Group, context.Context) {
+	g, gctx := errgroup.WithContext(ctx)
+	if limit > 0 {
+		g.SetLimit(limit)
+	}
+	return g, gctx
+}
+
 // GetIstioConfigMap returns a map of Istio config objects list per cluster
 // @TODO this method should replace GetIstioConfigList
 func (in *IstioConfigService) GetIstioConfigMap(ctx context.Context, namespace string, criteria IstioConfigCriteria) (models.IstioConfigMap, error) {
 	istioConfigMap := models.IstioConfigMap{}
+	var mu sync.Mutex
+
+	g, gctx := newFanOutGroup(ctx, in.config.KubernetesConfig.ListParallelism)
 	for cluster := range in.userClients {
-		var (
-			singleClusterConfigList *models.IstioConfigList
-			err                     error
-		)
-		if namespace == meta_v1.NamespaceAll {
-			singleClusterConfigList, err = in.GetIstioConfigList(ctx, cluster, criteria)
-			if err != nil {
-				return nil, err
+		g.Go(func() error {
+			var (
+				singleClusterConfigList *models.IstioConfigList
+				err                     error
+			)
+			if namespace == meta_v1.NamespaceAll {
+				singleClusterConfigList, err = in.GetIstioConfigList(gctx, cluster, criteria)
+			} else {
+				singleClusterConfigList, err = in.GetIstioConfigListForNamespace(gctx, cluster, namespace, criteria)
 			}
-		} else {
-			singleClusterConfigList, err = in.GetIstioConfigListForNamespace(ctx, cluster, namespace, criteria)
 			if err != nil {
-				return nil, err
+				return err
 			}
-		}
 
-		istioConfigMap[cluster] = *singleClusterConfigList
+			mu.Lock()
+			istioConfigMap[cluster] = *singleClusterConfigList
+			mu.Unlock()
+			return nil
+		})
 	}
 
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
 	return istioConfigMap, nil
 }
 
@@ -163,12 +215,12 @@ func (in *IstioConfigService) GetIstioConfigListForNamespace(ctx context.Context
 		// Check if the namespace exists on the cluster in multi-cluster mode.
 		// TODO: Remove this once other business methods stop looping over all clusters.
 		if (api_errors.IsNotFound(err) || api_errors.IsForbidden(err)) && len(in.userClients) > 1 {
-			return &models.IstioConfigList{}, nil
+			return &models.IstioConfigList{RequestedTypes: criteria.requestedTypes()}, nil
 		}
 		return nil, err
 	}
 
-	istioConfigs, err := in.getIstioConfigList(ctx, cluster, namespace, criteria)
+	istioConfigs, err := in.getIstioConfigList(ctx, cluster, namespace, criteria, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -176,7 +228,9 @@ func (in *IstioConfigService) GetIstioConfigListForNamespace(ctx context.Context
 	return istioConfigs, nil
 }
 
-func (in *IstioConfigService) getIstioConfigList(ctx context.Context, cluster string, namespace string, criteria IstioConfigCriteria) (*models.IstioConfigList, error) {
+// getIstioConfigList fetches the Istio objects of the namespace, or of all the namespaces, matching the criteria.
+// When not nil, onFetched is called, possibly concurrently, as soon as the objects of a type are set in the list.
+func (in *IstioConfigService) getIstioConfigList(ctx context.Context, cluster string, namespace string, criteria IstioConfigCriteria, onFetched func(gvk schema.GroupVersionKind, list *models.IstioConfigList) error) (*models.IstioConfigList, error) {
 	var end observability.EndFunc
 	_, end = observability.StartSpan(ctx, "GetIstioConfigListForNamespace",
 		observability.Attribute("package", "business"),
@@ -187,6 +241,7 @@ func (in *IstioConfigService) getIstioConfigList(ctx context.Context, cluster st
 		DestinationRules: []*networking_v1.DestinationRule{},
 		EnvoyFilters:     []*networking_v1alpha3.EnvoyFilter{},
 		Gateways:         []*networking_v1.Gateway{},
+		ProxyConfigs:     []*networking_v1beta1.ProxyConfig{},
 		VirtualServices:  []*networking_v1.VirtualService{},
 		ServiceEntries:   []*networking_v1.ServiceEntry{},
 		Sidecars:         []*networking_v1.Sidecar{},
@@ -205,6 +260,8 @@ func (in *IstioConfigService) getIstioConfigList(ctx context.Context, cluster st
 		AuthorizationPolicies:  []*security_v1.AuthorizationPolicy{},
 		PeerAuthentications:    []*security_v1.PeerAuthentication{},
 		RequestAuthentications: []*security_v1.RequestAuthentication{},
+
+		RequestedTypes: criteria.requestedTypes(),
 	}
 
 	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
@@ -230,165 +287,166 @@ func (in *IstioConfigService) getIstioConfigList(ctx context.Context, cluster st
 		workloadSelector = criteria.WorkloadSelector
 	}
 
-	if criteria.Include(kubernetes.DestinationRules) {
-		istioConfigList.DestinationRules, err = kubeCache.GetDestinationRules(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
+	// Each type is fetched in its own goroutine and sets its own field of the list.
+	// The first error cancels the types that have not started yet.
+	g, gctx := newFanOutGroup(ctx, in.config.KubernetesConfig.ListParallelism)
+	fetch := func(gvk schema.GroupVersionKind, include bool, f func() error) {
+		if !include {
+			return
 		}
+		g.Go(func() error {
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+			if err := f(); err != nil || onFetched == nil {
+				return err
+			}
+			return onFetched(gvk, istioConfigList)
+		})
 	}
 
-	if criteria.Include(kubernetes.EnvoyFilters) {
-		istioConfigList.EnvoyFilters, err = kubeCache.GetEnvoyFilters(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-		if isWorkloadSelector {
-			istioConfigList.EnvoyFilters = kubernetes.FilterEnvoyFiltersBySelector(workloadSelector, istioConfigList.EnvoyFilters)
-		}
-	}
+	fetch(kubernetes.DestinationRules, criteria.Include(kubernetes.DestinationRules), func() (err error) {
+		istioConfigList.DestinationRules, err = kubeCache.GetDestinationRules(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-	if criteria.Include(kubernetes.Gateways) {
-		istioConfigList.Gateways, err = kubeCache.GetGateways(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
+	fetch(kubernetes.EnvoyFilters, criteria.Include(kubernetes.EnvoyFilters), func() (err error) {
+		istioConfigList.EnvoyFilters, err = kubeCache.GetEnvoyFilters(ctx, namespace, criteria.LabelSelector)
+		if err == nil && isWorkloadSelector {
+			istioConfigList.EnvoyFilters = kubernetes.FilterEnvoyFiltersBySelector(workloadSelector, istioConfigList.EnvoyFilters)
 		}
+		return err
+	})
 
-		if isWorkloadSelector {
+	fetch(kubernetes.Gateways, criteria.Include(kubernetes.Gateways), func() (err error) {
+		istioConfigList.Gateways, err = kubeCache.GetGateways(ctx, namespace, criteria.LabelSelector)
+		if err == nil && isWorkloadSelector {
 			istioConfigList.Gateways = kubernetes.FilterGatewaysBySelector(workloadSelector, istioConfigList.Gateways)
 		}
-	}
-
-	if userClient.IsGatewayAPI() && criteria.Include(kubernetes.K8sGateways) {
-		istioConfigList.K8sGateways, err = kubeCache.GetK8sGateways(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+		return err
+	})
 
-	if userClient.IsGatewayAPI() && criteria.Include(kubernetes.K8sGRPCRoutes) {
-		istioConfigList.K8sGRPCRoutes, err = kubeCache.GetK8sGRPCRoutes(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+	fetch(kubernetes.K8sGateways, userClient.IsGatewayAPI() && criteria.Include(kubernetes.K8sGateways), func() (err error) {
+		istioConfigList.K8sGateways, err = kubeCache.GetK8sGateways(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-	if userClient.IsGatewayAPI() && criteria.Include(kubernetes.K8sHTTPRoutes) {
-		istioConfigList.K8sHTTPRoutes, err = kubeCache.GetK8sHTTPRoutes(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+	fetch(kubernetes.K8sGRPCRoutes, userClient.IsGatewayAPI() && criteria.Include(kubernetes.K8sGRPCRoutes), func() (err error) {
+		istioConfigList.K8sGRPCRoutes, err = kubeCache.GetK8sGRPCRoutes(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-	if userClient.IsGatewayAPI() && criteria.Include(kubernetes.K8sReferenceGrants) {
-		istioConfigList.K8sReferenceGrants, err = kubeCache.GetK8sReferenceGrants(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+	fetch(kubernetes.K8sHTTPRoutes, userClient.IsGatewayAPI() && criteria.Include(kubernetes.K8sHTTPRoutes), func() (err error) {
+		istioConfigList.K8sHTTPRoutes, err = kubeCache.GetK8sHTTPRoutes(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-	if userClient.IsExpGatewayAPI() && criteria.Include(kubernetes.K8sTCPRoutes) {
-		istioConfigList.K8sTCPRoutes, err = kubeCache.GetK8sTCPRoutes(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+	fetch(kubernetes.K8sReferenceGrants, userClient.IsGatewayAPI() && criteria.Include(kubernetes.K8sReferenceGrants), func() (err error) {
+		istioConfigList.K8sReferenceGrants, err = kubeCache.GetK8sReferenceGrants(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-	if userClient.IsExpGatewayAPI() && criteria.Include(kubernetes.K8sTLSRoutes) {
-		istioConfigList.K8sTLSRoutes, err = kubeCache.GetK8sTLSRoutes(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+	fetch(kubernetes.K8sTCPRoutes, userClient.IsExpGatewayAPI() && criteria.Include(kubernetes.K8sTCPRoutes), func() (err error) {
+		istioConfigList.K8sTCPRoutes, err = kubeCache.GetK8sTCPRoutes(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-	if criteria.Include(kubernetes.ServiceEntries) {
-		istioConfigList.ServiceEntries, err = kubeCache.GetServiceEntries(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+	fetch(kubernetes.K8sTLSRoutes, userClient.IsExpGatewayAPI() && criteria.Include(kubernetes.K8sTLSRoutes), func() (err error) {
+		istioConfigList.K8sTLSRoutes, err = kubeCache.GetK8sTLSRoutes(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-	if criteria.Include(kubernetes.Sidecars) {
-		var err error
-		istioConfigList.Sidecars, err = kubeCache.GetSidecars(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
+	fetch(kubernetes.ServiceEntries, criteria.Include(kubernetes.ServiceEntries), func() (err error) {
+		istioConfigList.ServiceEntries, err = kubeCache.GetServiceEntries(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-		if isWorkloadSelector {
+	fetch(kubernetes.Sidecars, criteria.Include(kubernetes.Sidecars), func() (err error) {
+		istioConfigList.Sidecars, err = kubeCache.GetSidecars(ctx, namespace, criteria.LabelSelector)
+		if err == nil && isWorkloadSelector {
 			istioConfigList.Sidecars = kubernetes.FilterSidecarsBySelector(workloadSelector, istioConfigList.Sidecars)
 		}
-	}
-
-	if criteria.Include(kubernetes.VirtualServices) {
-		istioConfigList.VirtualServices, err = kubeCache.GetVirtualServices(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+		return err
+	})
 
-	if criteria.Include(kubernetes.WorkloadEntries) {
-		istioConfigList.WorkloadEntries, err = kubeCache.GetWorkloadEntries(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
-	}
+	fetch(kubernetes.VirtualServices, criteria.Include(kubernetes.VirtualServices), func() (err error) {
+		istioConfigList.VirtualServices, err = kubeCache.GetVirtualServices(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-	if criteria.Include(kubernetes.WorkloadGroups) {
-		istioConfigList.WorkloadGroups, err = kubeCache.GetWorkloadGroups(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
+	fetch(kubernetes.WorkloadEntries, criteria.Include(kubernetes.WorkloadEntries), func() (err error) {
+		istioConfigList.WorkloadEntries, err = kubeCache.GetWorkloadEntries(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
 
-		if isWorkloadSelector {
+	fetch(kubernetes.WorkloadGroups, criteria.Include(kubernetes.WorkloadGroups), func() (err error) {
+		istioConfigList.WorkloadGroups, err = kubeCache.GetWorkloadGroups(ctx, namespace, criteria.LabelSelector)
+		if err == nil && isWorkloadSelector {
 			istioConfigList.WorkloadGroups = kubernetes.FilterWorkloadGroupsBySelector(workloadSelector, istioConfigList.WorkloadGroups)
 		}
-	}
+		return err
+	})
 
-	if criteria.Include(kubernetes.WasmPlugins) {
-		istioConfigList.WasmPlugins, err = kubeCache.GetWasmPlugins(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
+	fetch(kubernetes.WasmPlugins, criteria.Include(kubernetes.WasmPlugins), func() (err error) {
+		istioConfigList.WasmPlugins, err = kubeCache.GetWasmPlugins(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
+
+	fetch(kubernetes.Telemetries, criteria.Include(kubernetes.Telemetries), func() (err error) {
+		istioConfigList.Telemetries, err = kubeCache.GetTelemetries(ctx, namespace, criteria.LabelSelector)
+		return err
+	})
+
+	fetch(kubernetes.AuthorizationPolicies, criteria.Include(kubernetes.AuthorizationPolicies), func() (err error) {
+		istioConfigList.AuthorizationPolicies, err = kubeCache.GetAuthorizationPolicies(ctx, namespace, criteria.LabelSelector)
+		if err == nil && isWorkloadSelector {
+			istioConfigList.AuthorizationPolicies = kubernetes.FilterAuthorizationPoliciesBySelector(workloadSelector, istioConfigList.AuthorizationPolicies)
 		}
-	}
+		return err
+	})
 
-	if criteria.Include(kubernetes.Telemetries) {
-		istioConfigList.Telemetries, err = kubeCache.GetTelemetries(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
+	fetch(kubernetes.PeerAuthentications, criteria.Include(kubernetes.PeerAuthentications), func() (err error) {
+		istioConfigList.PeerAuthentications, err = kubeCache.GetPeerAuthentications(ctx, namespace, criteria.LabelSelector)
+		if err == nil && isWorkloadSelector {
+			istioConfigList.PeerAuthentications = kubernetes.FilterPeerAuthenticationsBySelector(workloadSelector, istioConfigList.PeerAuthentications)
 		}
-	}
+		return err
+	})
 
-	if criteria.Include(kubernetes.AuthorizationPolicies) {
-		istioConfigList.AuthorizationPolicies, err = kubeCache.GetAuthorizationPolicies(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
+	fetch(kubernetes.ProxyConfigs, criteria.Include(kubernetes.ProxyConfigs), func() (err error) {
+		istioConfigList.ProxyConfigs, err = kubeCache.GetProxyConfigs(ctx, namespace, criteria.LabelSelector)
+		if err == nil && isWorkloadSelector {
+			istioConfigList.ProxyConfigs = kubernetes.FilterProxyConfigsBySelector(workloadSelector, istioConfigList.ProxyConfigs)
 		}
+		return err
+	})
 
-		if isWorkloadSelector {
-			istioConfigList.AuthorizationPolicies = kubernetes.FilterAuthorizationPoliciesBySelector(workloadSelector, istioConfigList.AuthorizationPolicies)
+	fetch(kubernetes.RequestAuthentications, criteria.Include(kubernetes.RequestAuthentications), func() (err error) {
+		istioConfigList.RequestAuthentications, err = kubeCache.GetRequestAuthentications(ctx, namespace, criteria.LabelSelector)
+		if err == nil && isWorkloadSelector {
+			istioConfigList.RequestAuthentications = kubernetes.FilterRequestAuthenticationsBySelector(workloadSelector, istioConfigList.RequestAuthentications)
 		}
+		return err
+	})
+
+	if err := g.Wait(); err != nil {
+		return nil, err
 	}
 
-	if criteria.Include(kubernetes.PeerAuthentications) {
-		istioConfigList.PeerAuthentications, err = kubeCache.GetPeerAuthentications(namespace, criteria.LabelSelector)
-		if err != nil {
-			return nil, err
-		}
+	if criteria.FilterByTeams && in.config.Ownership.Enabled {
+		filterByTeams(istioConfigList, in.config.Ownership.TeamLabel, criteria.Teams)
+	}
 
-		if isWorkloadSelector {
-			istioConfigList.PeerAuthentications = kubernetes.FilterPeerAuthenticationsBySelector(workloadSelector, istioConfigList.PeerAuthentications)
-		}
+	if criteria.HelmRelease != "" {
+		filterByHelmRelease(istioConfigList, criteria.HelmRelease)
 	}
 
-	if criteria.Include(kubernetes.RequestAuthentications) {
-		istioConfigList.RequestAuthentications, err = kubeCache.GetRequestAuthentications(namespace, criteria.LabelSelector)
-		if err != nil {
+	if criteria.ChangedSince > 0 {
+		if err := setDelta(istioConfigList, kubeCache, namespace, criteria); err != nil {
 			return nil, err
 		}
-
-		if isWorkloadSelector {
-			istioConfigList.RequestAuthentications = kubernetes.FilterRequestAuthenticationsBySelector(workloadSelector, istioConfigList.RequestAuthentications)
-		}
+	} else if latest := istioConfigList.LatestResourceVersion(); latest > 0 {
+		istioConfigList.ResourceVersion = strconv.FormatUint(latest, 10)
 	}
 
 	return istioConfigList, nil
@@ -401,7 +459,7 @@ func (in *IstioConfigService) GetIstioConfigList(ctx context.Context, cluster st
 	)
 	defer end()
 
-	istioConfigs, err := in.getIstioConfigList(ctx, cluster, meta_v1.NamespaceAll, criteria)
+	istioConfigs, err := in.getIstioConfigList(ctx, cluster, meta_v1.NamespaceAll, criteria, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -412,32 +470,93 @@ func (in *IstioConfigService) GetIstioConfigList(ctx context.Context, cluster st
 		return nil, err
 	}
 
-	namespaceNames := make([]string, 0, len(namespaces))
+	namespaceSet := make(map[string]bool, len(namespaces))
 	for _, namespace := range namespaces {
-		namespaceNames = append(namespaceNames, namespace.Name)
-	}
-
-	return &models.IstioConfigList{
-		AuthorizationPolicies:  kubernetes.FilterByNamespaceNames(istioConfigs.AuthorizationPolicies, namespaceNames),
-		DestinationRules:       kubernetes.FilterByNamespaceNames(istioConfigs.DestinationRules, namespaceNames),
-		EnvoyFilters:           kubernetes.FilterByNamespaceNames(istioConfigs.EnvoyFilters, namespaceNames),
-		Gateways:               kubernetes.FilterByNamespaceNames(istioConfigs.Gateways, namespaceNames),
-		K8sGateways:            kubernetes.FilterByNamespaceNames(istioConfigs.K8sGateways, namespaceNames),
-		K8sGRPCRoutes:          kubernetes.FilterByNamespaceNames(istioConfigs.K8sGRPCRoutes, namespaceNames),
-		K8sHTTPRoutes:          kubernetes.FilterByNamespaceNames(istioConfigs.K8sHTTPRoutes, namespaceNames),
-		K8sReferenceGrants:     kubernetes.FilterByNamespaceNames(istioConfigs.K8sReferenceGrants, namespaceNames),
-		K8sTCPRoutes:           kubernetes.FilterByNamespaceNames(istioConfigs.K8sTCPRoutes, namespaceNames),
-		K8sTLSRoutes:           kubernetes.FilterByNamespaceNames(istioConfigs.K8sTLSRoutes, namespaceNames),
-		PeerAuthentications:    kubernetes.FilterByNamespaceNames(istioConfigs.PeerAuthentications, namespaceNames),
-		RequestAuthentications: kubernetes.FilterByNamespaceNames(istioConfigs.RequestAuthentications, namespaceNames),
-		ServiceEntries:         kubernetes.FilterByNamespaceNames(istioConfigs.ServiceEntries, namespaceNames),
-		Sidecars:               kubernetes.FilterByNamespaceNames(istioConfigs.Sidecars, namespaceNames),
-		Telemetries:            kubernetes.FilterByNamespaceNames(istioConfigs.Telemetries, namespaceNames),
-		VirtualServices:        kubernetes.FilterByNamespaceNames(istioConfigs.VirtualServices, namespaceNames),
-		WasmPlugins:            kubernetes.FilterByNamespaceNames(istioConfigs.WasmPlugins, namespaceNames),
-		WorkloadEntries:        kubernetes.FilterByNamespaceNames(istioConfigs.WorkloadEntries, namespaceNames),
-		WorkloadGroups:         kubernetes.FilterByNamespaceNames(istioConfigs.WorkloadGroups, namespaceNames),
-	}, nil
+		namespaceSet[namespace.Name] = true
+	}
+
+	// The list is filtered in place: the objects of the filtered out namespaces
+	// are released instead of being retained alongside a filtered copy of the list.
+	istioConfigs.AuthorizationPolicies = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.AuthorizationPolicies, namespaceSet)
+	istioConfigs.DestinationRules = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.DestinationRules, namespaceSet)
+	istioConfigs.EnvoyFilters = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.EnvoyFilters, namespaceSet)
+	istioConfigs.Gateways = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.Gateways, namespaceSet)
+	istioConfigs.K8sGateways = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.K8sGateways, namespaceSet)
+	istioConfigs.K8sGRPCRoutes = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.K8sGRPCRoutes, namespaceSet)
+	istioConfigs.K8sHTTPRoutes = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.K8sHTTPRoutes, namespaceSet)
+	istioConfigs.K8sReferenceGrants = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.K8sReferenceGrants, namespaceSet)
+	istioConfigs.K8sTCPRoutes = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.K8sTCPRoutes, namespaceSet)
+	istioConfigs.K8sTLSRoutes = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.K8sTLSRoutes, namespaceSet)
+	istioConfigs.PeerAuthentications = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.PeerAuthentications, namespaceSet)
+	istioConfigs.ProxyConfigs = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.ProxyConfigs, namespaceSet)
+	istioConfigs.RequestAuthentications = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.RequestAuthentications, namespaceSet)
+	istioConfigs.ServiceEntries = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.ServiceEntries, namespaceSet)
+	istioConfigs.Sidecars = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.Sidecars, namespaceSet)
+	istioConfigs.Telemetries = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.Telemetries, namespaceSet)
+	istioConfigs.VirtualServices = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.VirtualServices, namespaceSet)
+	istioConfigs.WasmPlugins = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.WasmPlugins, namespaceSet)
+	istioConfigs.WorkloadEntries = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.WorkloadEntries, namespaceSet)
+	istioConfigs.WorkloadGroups = kubernetes.FilterInPlaceByNamespaceSet(istioConfigs.WorkloadGroups, namespaceSet)
+	if istioConfigs.Deleted != nil {
+		deleted := istioConfigs.Deleted[:0]
+		for _, event := range istioConfigs.Deleted {
+			if namespaceSet[event.Namespace] {
+				deleted = append(deleted, event)
+			}
+		}
+		istioConfigs.Deleted = deleted
+	}
+
+	return istioConfigs, nil
+}
+
+// GetIstioConfigListForNamespaces returns the Istio objects of each of the namespaces of the cluster, or of each of the
+// namespaces accessible to the user when none is given. The namespaces are fetched concurrently, at most
+// ListParallelism at a time. A namespace that cannot be listed, i.e. because it is not accessible, holds its error
+// instead of failing the whole call.
+func (in *IstioConfigService) GetIstioConfigListForNamespaces(ctx context.Context, cluster string, namespaces []string, criteria IstioConfigCriteria) (models.IstioConfigNamespaceMap, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "GetIstioConfigListForNamespaces",
+		observability.Attribute("package", "business"),
+	)
+	defer end()
+
+	if len(namespaces) == 0 {
+		accessible, err := in.businessLayer.Namespace.GetClusterNamespaces(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+		for _, ns := range accessible {
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+
+	istioConfigMap := models.IstioConfigNamespaceMap{}
+	var mu sync.Mutex
+
+	// Errors are kept per namespace, so the group never cancels the other namespaces
+	g, gctx := newFanOutGroup(ctx, in.config.KubernetesConfig.ListParallelism)
+	for _, namespace := range namespaces {
+		g.Go(func() error {
+			result := models.IstioConfigNamespaceResult{}
+			istioConfigList, err := in.GetIstioConfigListForNamespace(gctx, cluster, namespace, criteria)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.List = istioConfigList
+			}
+
+			mu.Lock()
+			istioConfigMap[namespace] = result
+			mu.Unlock()
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return istioConfigMap, nil
 }
 
 // GetIstioConfigDetails returns a specific Istio configuration object.
@@ -595,6 +714,12 @@ func (in *IstioConfigService) GetIstioConfigDetails(ctx context.Context, cluster
 			istioConfigDetail.PeerAuthentication.Kind = kubernetes.PeerAuthentications.Kind
 			istioConfigDetail.PeerAuthentication.APIVersion = kubernetes.PeerAuthentications.GroupVersion().String()
 		}
+	case kubernetes.ProxyConfigs:
+		istioConfigDetail.ProxyConfig, err = in.userClients[cluster].Istio().NetworkingV1beta1().ProxyConfigs(namespace).Get(ctx, object, getOpts)
+		if err == nil {
+			istioConfigDetail.ProxyConfig.Kind = kubernetes.ProxyConfigs.Kind
+			istioConfigDetail.ProxyConfig.APIVersion = kubernetes.ProxyConfigs.GroupVersion().String()
+		}
 	case kubernetes.RequestAuthentications:
 		istioConfigDetail.RequestAuthentication, err = in.userClients[cluster].Istio().SecurityV1().RequestAuthentications(namespace).Get(ctx, object, getOpts)
 		if err == nil {
@@ -607,6 +732,13 @@ func (in *IstioConfigService) GetIstioConfigDetails(ctx context.Context, cluster
 
 	wg.Wait()
 
+	if err == nil {
+		istioConfigDetail.DeepLinks = models.GetDeepLinks(&in.config, objectGVK.Kind, cluster, namespace, object)
+		if resource := istioConfigDetail.Resource(); resource != nil {
+			istioConfigDetail.HelmRelease = models.GetHelmRelease(resource)
+		}
+	}
+
 	return istioConfigDetail, err
 }
 
@@ -634,6 +766,14 @@ func (in *IstioConfigService) DeleteIstioConfigDetail(ctx context.Context, clust
 		return err
 	}
 
+	// The object is saved before it is deleted, so that an object that could not be saved is never deleted
+	var deleted *models.DeletedIstioConfig
+	if in.config.IstioConfigSoftDelete.Enabled {
+		if deleted, err = in.saveDeletedIstioConfig(ctx, kubeCache, cluster, namespace, resourceType, name, time.Now()); err != nil {
+			return fmt.Errorf("error saving %s [%s/%s] before its deletion: %w", resourceType.Kind, namespace, name, err)
+		}
+	}
+
 	switch resourceType {
 	case kubernetes.DestinationRules:
 		err = userClient.Istio().NetworkingV1().DestinationRules(namespace).Delete(ctx, name, delOpts)
@@ -667,6 +807,8 @@ func (in *IstioConfigService) DeleteIstioConfigDetail(ctx context.Context, clust
 		err = userClient.Istio().SecurityV1().AuthorizationPolicies(namespace).Delete(ctx, name, delOpts)
 	case kubernetes.PeerAuthentications:
 		err = userClient.Istio().SecurityV1().PeerAuthentications(namespace).Delete(ctx, name, delOpts)
+	case kubernetes.ProxyConfigs:
+		err = userClient.Istio().NetworkingV1beta1().ProxyConfigs(namespace).Delete(ctx, name, delOpts)
 	case kubernetes.RequestAuthentications:
 		err = userClient.Istio().SecurityV1().RequestAuthentications(namespace).Delete(ctx, name, delOpts)
 	case kubernetes.WasmPlugins:
@@ -677,6 +819,11 @@ func (in *IstioConfigService) DeleteIstioConfigDetail(ctx context.Context, clust
 		err = fmt.Errorf("object type not found: %v", resourceType)
 	}
 	if err != nil {
+		if deleted != nil {
+			if err := in.deletedIstioConfigStore().Delete(deleted.ID); err != nil {
+				log.Errorf("Error removing the saved %s [%s/%s] after its deletion failed: %s", resourceType.Kind, namespace, name, err)
+			}
+		}
 		return err
 	}
 
@@ -697,11 +844,15 @@ func (in *IstioConfigService) DeleteIstioConfigDetail(ctx context.Context, clust
 }
 
 func (in *IstioConfigService) UpdateIstioConfigDetail(ctx context.Context, cluster, namespace string, resourceType schema.GroupVersionKind, name, jsonPatch string) (models.IstioConfigDetails, error) {
+	return in.updateIstioConfigDetail(ctx, cluster, namespace, resourceType, name, jsonPatch, meta_v1.PatchOptions{})
+}
+
+// updateIstioConfigDetail patches the object. Nothing else is done after a dry-run patch, the object is not persisted.
+func (in *IstioConfigService) updateIstioConfigDetail(ctx context.Context, cluster, namespace string, resourceType schema.GroupVersionKind, name, jsonPatch string, patchOpts meta_v1.PatchOptions) (models.IstioConfigDetails, error) {
 	istioConfigDetail := models.IstioConfigDetails{}
 	istioConfigDetail.Namespace = models.Namespace{Name: namespace}
 	istioConfigDetail.ObjectGVK = resourceType
 
-	patchOpts := meta_v1.PatchOptions{}
 	patchType := api_types.MergePatchType
 	bytePatch := []byte(jsonPatch)
 
@@ -765,6 +916,9 @@ func (in *IstioConfigService) UpdateIstioConfigDetail(ctx context.Context, clust
 	case kubernetes.PeerAuthentications.String():
 		istioConfigDetail.PeerAuthentication = &security_v1.PeerAuthentication{}
 		istioConfigDetail.PeerAuthentication, err = userClient.Istio().SecurityV1().PeerAuthentications(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
+	case kubernetes.ProxyConfigs.String():
+		istioConfigDetail.ProxyConfig = &networking_v1beta1.ProxyConfig{}
+		istioConfigDetail.ProxyConfig, err = userClient.Istio().NetworkingV1beta1().ProxyConfigs(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
 	case kubernetes.RequestAuthentications.String():
 		istioConfigDetail.RequestAuthentication = &security_v1.RequestAuthentication{}
 		istioConfigDetail.RequestAuthentication, err = userClient.Istio().SecurityV1().RequestAuthentications(namespace).Patch(ctx, name, patchType, bytePatch, patchOpts)
@@ -777,7 +931,7 @@ func (in *IstioConfigService) UpdateIstioConfigDetail(ctx context.Context, clust
 	default:
 		err = fmt.Errorf("object type not found: %v", resourceType)
 	}
-	if err != nil {
+	if err != nil || len(patchOpts.DryRun) > 0 {
 		return istioConfigDetail, err
 	}
 
@@ -794,12 +948,15 @@ func (in *IstioConfigService) UpdateIstioConfigDetail(ctx context.Context, clust
 }
 
 func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, cluster, namespace string, resourceType schema.GroupVersionKind, body []byte) (models.IstioConfigDetails, error) {
+	return in.createIstioConfigDetail(ctx, cluster, namespace, resourceType, body, meta_v1.CreateOptions{})
+}
+
+// createIstioConfigDetail creates the object. Nothing else is done after a dry-run creation, the object is not persisted.
+func (in *IstioConfigService) createIstioConfigDetail(ctx context.Context, cluster, namespace string, resourceType schema.GroupVersionKind, body []byte, createOpts meta_v1.CreateOptions) (models.IstioConfigDetails, error) {
 	istioConfigDetail := models.IstioConfigDetails{}
 	istioConfigDetail.Namespace = models.Namespace{Name: namespace}
 	istioConfigDetail.ObjectGVK = resourceType
 
-	createOpts := meta_v1.CreateOptions{}
-
 	userClient := in.userClients[cluster]
 	if userClient == nil {
 		return istioConfigDetail, fmt.Errorf("K8s Client [%s] is not found or is not accessible for Kiali", cluster)
@@ -810,6 +967,7 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 		return istioConfigDetail, nil
 	}
 
+	// The clients can return a nil object along with the creation error
 	var name string
 	switch resourceType.String() {
 	case kubernetes.DestinationRules.String():
@@ -819,7 +977,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.DestinationRule, err = userClient.Istio().NetworkingV1().DestinationRules(namespace).Create(ctx, istioConfigDetail.DestinationRule, createOpts)
-		name = istioConfigDetail.DestinationRule.Name
+		if err == nil {
+			name = istioConfigDetail.DestinationRule.Name
+		}
 	case kubernetes.EnvoyFilters.String():
 		istioConfigDetail.EnvoyFilter = &networking_v1alpha3.EnvoyFilter{}
 		err = json.Unmarshal(body, istioConfigDetail.EnvoyFilter)
@@ -827,7 +987,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.EnvoyFilter, err = userClient.Istio().NetworkingV1alpha3().EnvoyFilters(namespace).Create(ctx, istioConfigDetail.EnvoyFilter, createOpts)
-		name = istioConfigDetail.EnvoyFilter.Name
+		if err == nil {
+			name = istioConfigDetail.EnvoyFilter.Name
+		}
 	case kubernetes.Gateways.String():
 		istioConfigDetail.Gateway = &networking_v1.Gateway{}
 		err = json.Unmarshal(body, istioConfigDetail.Gateway)
@@ -835,7 +997,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.Gateway, err = userClient.Istio().NetworkingV1().Gateways(namespace).Create(ctx, istioConfigDetail.Gateway, createOpts)
-		name = istioConfigDetail.Gateway.Name
+		if err == nil {
+			name = istioConfigDetail.Gateway.Name
+		}
 	case kubernetes.K8sGateways.String():
 		istioConfigDetail.K8sGateway = &k8s_networking_v1.Gateway{}
 		err = json.Unmarshal(body, istioConfigDetail.K8sGateway)
@@ -843,7 +1007,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.K8sGateway, err = userClient.GatewayAPI().GatewayV1().Gateways(namespace).Create(ctx, istioConfigDetail.K8sGateway, createOpts)
-		name = istioConfigDetail.K8sGateway.Name
+		if err == nil {
+			name = istioConfigDetail.K8sGateway.Name
+		}
 	case kubernetes.K8sHTTPRoutes.String():
 		istioConfigDetail.K8sHTTPRoute = &k8s_networking_v1.HTTPRoute{}
 		err = json.Unmarshal(body, istioConfigDetail.K8sHTTPRoute)
@@ -851,7 +1017,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.K8sHTTPRoute, err = userClient.GatewayAPI().GatewayV1().HTTPRoutes(namespace).Create(ctx, istioConfigDetail.K8sHTTPRoute, createOpts)
-		name = istioConfigDetail.K8sHTTPRoute.Name
+		if err == nil {
+			name = istioConfigDetail.K8sHTTPRoute.Name
+		}
 	case kubernetes.K8sGRPCRoutes.String():
 		istioConfigDetail.K8sGRPCRoute = &k8s_networking_v1.GRPCRoute{}
 		err = json.Unmarshal(body, istioConfigDetail.K8sGRPCRoute)
@@ -859,7 +1027,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.K8sGRPCRoute, err = userClient.GatewayAPI().GatewayV1().GRPCRoutes(namespace).Create(ctx, istioConfigDetail.K8sGRPCRoute, createOpts)
-		name = istioConfigDetail.K8sGRPCRoute.Name
+		if err == nil {
+			name = istioConfigDetail.K8sGRPCRoute.Name
+		}
 	case kubernetes.K8sReferenceGrants.String():
 		istioConfigDetail.K8sReferenceGrant = &k8s_networking_v1beta1.ReferenceGrant{}
 		err = json.Unmarshal(body, istioConfigDetail.K8sReferenceGrant)
@@ -867,7 +1037,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.K8sReferenceGrant, err = userClient.GatewayAPI().GatewayV1beta1().ReferenceGrants(namespace).Create(ctx, istioConfigDetail.K8sReferenceGrant, createOpts)
-		name = istioConfigDetail.K8sReferenceGrant.Name
+		if err == nil {
+			name = istioConfigDetail.K8sReferenceGrant.Name
+		}
 	case kubernetes.ServiceEntries.String():
 		istioConfigDetail.ServiceEntry = &networking_v1.ServiceEntry{}
 		err = json.Unmarshal(body, istioConfigDetail.ServiceEntry)
@@ -875,7 +1047,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.ServiceEntry, err = userClient.Istio().NetworkingV1().ServiceEntries(namespace).Create(ctx, istioConfigDetail.ServiceEntry, createOpts)
-		name = istioConfigDetail.ServiceEntry.Name
+		if err == nil {
+			name = istioConfigDetail.ServiceEntry.Name
+		}
 	case kubernetes.Sidecars.String():
 		istioConfigDetail.Sidecar = &networking_v1.Sidecar{}
 		err = json.Unmarshal(body, istioConfigDetail.Sidecar)
@@ -883,7 +1057,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.Sidecar, err = userClient.Istio().NetworkingV1().Sidecars(namespace).Create(ctx, istioConfigDetail.Sidecar, createOpts)
-		name = istioConfigDetail.Sidecar.Name
+		if err == nil {
+			name = istioConfigDetail.Sidecar.Name
+		}
 	case kubernetes.VirtualServices.String():
 		istioConfigDetail.VirtualService = &networking_v1.VirtualService{}
 		err = json.Unmarshal(body, istioConfigDetail.VirtualService)
@@ -891,7 +1067,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.VirtualService, err = userClient.Istio().NetworkingV1().VirtualServices(namespace).Create(ctx, istioConfigDetail.VirtualService, createOpts)
-		name = istioConfigDetail.VirtualService.Name
+		if err == nil {
+			name = istioConfigDetail.VirtualService.Name
+		}
 	case kubernetes.WorkloadEntries.String():
 		istioConfigDetail.WorkloadEntry = &networking_v1.WorkloadEntry{}
 		err = json.Unmarshal(body, istioConfigDetail.WorkloadEntry)
@@ -899,7 +1077,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.WorkloadEntry, err = userClient.Istio().NetworkingV1().WorkloadEntries(namespace).Create(ctx, istioConfigDetail.WorkloadEntry, createOpts)
-		name = istioConfigDetail.WorkloadEntry.Name
+		if err == nil {
+			name = istioConfigDetail.WorkloadEntry.Name
+		}
 	case kubernetes.WorkloadGroups.String():
 		istioConfigDetail.WorkloadGroup = &networking_v1.WorkloadGroup{}
 		err = json.Unmarshal(body, istioConfigDetail.WorkloadGroup)
@@ -907,7 +1087,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.WorkloadGroup, err = userClient.Istio().NetworkingV1().WorkloadGroups(namespace).Create(ctx, istioConfigDetail.WorkloadGroup, createOpts)
-		name = istioConfigDetail.WorkloadGroup.Name
+		if err == nil {
+			name = istioConfigDetail.WorkloadGroup.Name
+		}
 	case kubernetes.WasmPlugins.String():
 		istioConfigDetail.WasmPlugin = &extentions_v1alpha1.WasmPlugin{}
 		err = json.Unmarshal(body, istioConfigDetail.WasmPlugin)
@@ -915,7 +1097,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.WasmPlugin, err = userClient.Istio().ExtensionsV1alpha1().WasmPlugins(namespace).Create(ctx, istioConfigDetail.WasmPlugin, createOpts)
-		name = istioConfigDetail.WasmPlugin.Name
+		if err == nil {
+			name = istioConfigDetail.WasmPlugin.Name
+		}
 	case kubernetes.Telemetries.String():
 		istioConfigDetail.Telemetry = &telemetry_v1.Telemetry{}
 		err = json.Unmarshal(body, istioConfigDetail.Telemetry)
@@ -923,7 +1107,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.Telemetry, err = userClient.Istio().TelemetryV1().Telemetries(namespace).Create(ctx, istioConfigDetail.Telemetry, createOpts)
-		name = istioConfigDetail.Telemetry.Name
+		if err == nil {
+			name = istioConfigDetail.Telemetry.Name
+		}
 	case kubernetes.AuthorizationPolicies.String():
 		istioConfigDetail.AuthorizationPolicy = &security_v1.AuthorizationPolicy{}
 		err = json.Unmarshal(body, istioConfigDetail.AuthorizationPolicy)
@@ -931,7 +1117,9 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.AuthorizationPolicy, err = userClient.Istio().SecurityV1().AuthorizationPolicies(namespace).Create(ctx, istioConfigDetail.AuthorizationPolicy, createOpts)
-		name = istioConfigDetail.AuthorizationPolicy.Name
+		if err == nil {
+			name = istioConfigDetail.AuthorizationPolicy.Name
+		}
 	case kubernetes.PeerAuthentications.String():
 		istioConfigDetail.PeerAuthentication = &security_v1.PeerAuthentication{}
 		err = json.Unmarshal(body, istioConfigDetail.PeerAuthentication)
@@ -939,7 +1127,19 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.PeerAuthentication, err = userClient.Istio().SecurityV1().PeerAuthentications(namespace).Create(ctx, istioConfigDetail.PeerAuthentication, createOpts)
-		name = istioConfigDetail.PeerAuthentication.Name
+		if err == nil {
+			name = istioConfigDetail.PeerAuthentication.Name
+		}
+	case kubernetes.ProxyConfigs.String():
+		istioConfigDetail.ProxyConfig = &networking_v1beta1.ProxyConfig{}
+		err = json.Unmarshal(body, istioConfigDetail.ProxyConfig)
+		if err != nil {
+			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
+		}
+		istioConfigDetail.ProxyConfig, err = userClient.Istio().NetworkingV1beta1().ProxyConfigs(namespace).Create(ctx, istioConfigDetail.ProxyConfig, createOpts)
+		if err == nil {
+			name = istioConfigDetail.ProxyConfig.Name
+		}
 	case kubernetes.RequestAuthentications.String():
 		istioConfigDetail.RequestAuthentication = &security_v1.RequestAuthentication{}
 		err = json.Unmarshal(body, istioConfigDetail.RequestAuthentication)
@@ -947,11 +1147,13 @@ func (in *IstioConfigService) CreateIstioConfigDetail(ctx context.Context, clust
 			return istioConfigDetail, api_errors.NewBadRequest(err.Error())
 		}
 		istioConfigDetail.RequestAuthentication, err = userClient.Istio().SecurityV1().RequestAuthentications(namespace).Create(ctx, istioConfigDetail.RequestAuthentication, createOpts)
-		name = istioConfigDetail.RequestAuthentication.Name
+		if err == nil {
+			name = istioConfigDetail.RequestAuthentication.Name
+		}
 	default:
 		err = fmt.Errorf("object type not found: %v", resourceType)
 	}
-	if err != nil {
+	if err != nil || len(createOpts.DryRun) > 0 {
 		return istioConfigDetail, err
 	}
 
@@ -1001,6 +1203,18 @@ func (in *IstioConfigService) GetIstioConfigPermissions(ctx context.Context, nam
 		return nil
 	}
 
+	// The permissions are cached per user, the RBAC changes refresh them
+	identity := k8s.GetIdentity()
+	uncachedNamespaces := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		if permissions, found := in.kialiCache.GetPermissions(cluster, identity, ns); found {
+			istioConfigPermissions[ns] = permissions
+		} else {
+			uncachedNamespaces = append(uncachedNamespaces, ns)
+		}
+	}
+	namespaces = uncachedNamespaces
+
 	if len(namespaces) > 0 {
 		networkingPermissions := make(models.IstioConfigPermissions, len(namespaces))
 		k8sNetworkingPermissions := make(models.IstioConfigPermissions, len(namespaces))
@@ -1075,6 +1289,7 @@ func (in *IstioConfigService) GetIstioConfigPermissions(ctx context.Context, nam
 			for resource, permissions := range *securityPermissions[ns] {
 				(*istioConfigPermissions[ns])[resource] = permissions
 			}
+			in.kialiCache.SetPermissions(cluster, identity, ns, istioConfigPermissions[ns])
 		}
 	}
 	return istioConfigPermissions
@@ -1152,6 +1367,7 @@ func ParseIstioConfigCriteria(objects, labelSelector, workloadSelector string) I
 	criteria.IncludeSidecars = defaultInclude
 	criteria.IncludeAuthorizationPolicies = defaultInclude
 	criteria.IncludePeerAuthentications = defaultInclude
+	criteria.IncludeProxyConfigs = defaultInclude
 	criteria.IncludeWorkloadEntries = defaultInclude
 	criteria.IncludeWorkloadGroups = defaultInclude
 	criteria.IncludeRequestAuthentications = defaultInclude
@@ -1205,6 +1421,9 @@ func ParseIstioConfigCriteria(objects, labelSelector, workloadSelector string) I
 	if checkType(types, kubernetes.PeerAuthentications.String()) {
 		criteria.IncludePeerAuthentications = true
 	}
+	if checkType(types, kubernetes.ProxyConfigs.String()) {
+		criteria.IncludeProxyConfigs = true
+	}
 	if checkType(types, kubernetes.WorkloadEntries.String()) {
 		criteria.IncludeWorkloadEntries = true
 	}
@@ -0,0 +1,100 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/common/model"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/models"
+)
+
+// configActivitySubscriptionBuffer is the number of changes kept for a subscriber not reading them fast enough.
+const configActivitySubscriptionBuffer = 100
+
+// GetConfigActivity returns the latest changes of the Istio config of a namespace that happened after since,
+// newest first. Up to limit changes are returned when it is positive.
+func (in *IstioConfigService) GetConfigActivity(ctx context.Context, cluster, namespace string, since time.Time, limit int) ([]models.IstioConfigEvent, error) {
+	// Checks the user access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return kubeCache.Activity().List(namespace, since, limit), nil
+}
+
+// SubscribeConfigActivity returns the changes of the Istio config of the cluster recorded from now on, until the
+// returned function is called. The user must have access to the namespace, whose changes are the only ones to report.
+func (in *IstioConfigService) SubscribeConfigActivity(ctx context.Context, cluster, namespace string) (<-chan models.IstioConfigEvent, func(), error) {
+	// Checks the user access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, nil, err
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	events, cancel := kubeCache.Activity().Subscribe(configActivitySubscriptionBuffer)
+	return events, cancel, nil
+}
+
+// GetConfigChurn returns the rate of the changes of the Istio config of the namespaces of the cluster accessible by the
+// user during the window, up to an hour, the most changed first.
+func (in *IstioConfigService) GetConfigChurn(ctx context.Context, cluster string, window time.Duration) (*models.IstioConfigChurn, error) {
+	if window <= 0 || window > cache.ConfigChurnRetention {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("the window must be positive and up to %s", model.Duration(cache.ConfigChurnRetention)))
+	}
+
+	namespaces, err := in.businessLayer.Namespace.GetClusterNamespaces(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	accessible := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		accessible[namespace.Name] = true
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	churn := &models.IstioConfigChurn{
+		Cluster:    cluster,
+		Window:     model.Duration(window).String(),
+		Namespaces: []models.NamespaceConfigChurn{},
+	}
+	for _, nsChurn := range kubeCache.Churn().Churn(window, time.Now()) {
+		if accessible[nsChurn.Namespace] {
+			churn.Namespaces = append(churn.Namespaces, nsChurn)
+		}
+	}
+	return churn, nil
+}
+
+// RecordConfigChange records a change of the Istio config made through Kiali by a user. The same change seen by the
+// watch of the Istio config is merged with it.
+func (in *IstioConfigService) RecordConfigChange(cluster, namespace string, objectGVK schema.GroupVersionKind, name, operation, user string) {
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return
+	}
+	kubeCache.Activity().Record(models.IstioConfigEvent{
+		Author:    user,
+		Cluster:   cluster,
+		Name:      name,
+		Namespace: namespace,
+		ObjectGVK: objectGVK,
+		Timestamp: time.Now(),
+		Type:      operation,
+		ViaKiali:  true,
+	})
+}
@@ -0,0 +1,162 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kiali/kiali/models"
+)
+
+// The well known template variables of the Istio config bundles
+const (
+	TemplateVariableNamespace  = "namespace"
+	TemplateVariableHostSuffix = "hostSuffix"
+	TemplateVariableGateway    = "gateway"
+)
+
+// templateVariable matches the ${name} placeholders of the bundle templates.
+var templateVariable = regexp.MustCompile(`\$\{([A-Za-z][A-Za-z0-9_]*)\}`)
+
+// RenderIstioConfigBundle replaces the template variables of the bundle objects, to be applied to the namespace.
+// The namespace variable defaults to the target namespace. It fails when a variable used by the objects is not
+// defined, when a well known variable has an invalid value or when a rendered object is not valid.
+func RenderIstioConfigBundle(bundle models.IstioConfigBundle, namespace string) ([]models.IstioConfigSnapshotObject, error) {
+	variables := map[string]string{TemplateVariableNamespace: namespace}
+	for name, value := range bundle.Variables {
+		variables[name] = value
+	}
+	if err := validateTemplateVariables(variables); err != nil {
+		return nil, err
+	}
+
+	// The values are escaped to be embedded in the JSON strings of the object definitions
+	escaped := make(map[string]string, len(variables))
+	for name, value := range variables {
+		quoted, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		escaped[name] = string(quoted[1 : len(quoted)-1])
+	}
+
+	missing := map[string]bool{}
+	render := func(text string, values map[string]string) string {
+		return templateVariable.ReplaceAllStringFunc(text, func(placeholder string) string {
+			name := templateVariable.FindStringSubmatch(placeholder)[1]
+			value, ok := values[name]
+			if !ok {
+				missing[name] = true
+				return placeholder
+			}
+			return value
+		})
+	}
+
+	objects := make([]models.IstioConfigSnapshotObject, 0, len(bundle.Objects))
+	for _, o := range bundle.Objects {
+		objects = append(objects, models.IstioConfigSnapshotObject{
+			ObjectGVK: o.ObjectGVK,
+			Name:      render(o.Name, variables),
+			Object:    json.RawMessage(render(string(o.Object), escaped)),
+		})
+	}
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("undefined template variables: %s", strings.Join(names, ", "))
+	}
+
+	for _, o := range objects {
+		if !GetIstioAPI(o.ObjectGVK) {
+			return nil, fmt.Errorf("object type not managed: %s", o.ObjectGVK.String())
+		}
+		if errs := validation.IsDNS1123Subdomain(o.Name); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid name [%s] of %s: %s", o.Name, o.ObjectGVK.Kind, strings.Join(errs, ", "))
+		}
+		var object struct {
+			Metadata struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(o.Object, &object); err != nil {
+			return nil, fmt.Errorf("invalid definition of %s [%s]: %s", o.ObjectGVK.Kind, o.Name, err)
+		}
+		if object.Metadata.Name != "" && object.Metadata.Name != o.Name {
+			return nil, fmt.Errorf("the definition of %s [%s] has a different name [%s]", o.ObjectGVK.Kind, o.Name, object.Metadata.Name)
+		}
+		if object.Metadata.Namespace != "" && object.Metadata.Namespace != namespace {
+			return nil, fmt.Errorf("the definition of %s [%s] targets a different namespace [%s]", o.ObjectGVK.Kind, o.Name, object.Metadata.Namespace)
+		}
+	}
+	return objects, nil
+}
+
+// validateTemplateVariables checks the values of the well known template variables.
+func validateTemplateVariables(variables map[string]string) error {
+	if errs := validation.IsDNS1123Label(variables[TemplateVariableNamespace]); len(errs) > 0 {
+		return fmt.Errorf("invalid %s variable: %s", TemplateVariableNamespace, strings.Join(errs, ", "))
+	}
+	if hostSuffix, ok := variables[TemplateVariableHostSuffix]; ok {
+		if errs := validation.IsDNS1123Subdomain(hostSuffix); len(errs) > 0 {
+			return fmt.Errorf("invalid %s variable: %s", TemplateVariableHostSuffix, strings.Join(errs, ", "))
+		}
+	}
+	if gateway, ok := variables[TemplateVariableGateway]; ok {
+		// The gateway is referenced as <name> or <namespace>/<name>
+		name := gateway
+		if gwNamespace, gwName, found := strings.Cut(gateway, "/"); found {
+			if errs := validation.IsDNS1123Label(gwNamespace); len(errs) > 0 {
+				return fmt.Errorf("invalid %s variable: %s", TemplateVariableGateway, strings.Join(errs, ", "))
+			}
+			name = gwName
+		}
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return fmt.Errorf("invalid %s variable: %s", TemplateVariableGateway, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// ApplyIstioObjects creates the objects in the namespace on behalf of the user. All the objects are checked against
+// the team ownership of the user and reviewed before any is created. Existing objects are left untouched.
+func (in *IstioConfigService) ApplyIstioObjects(ctx context.Context, cluster, namespace string, objects []models.IstioConfigSnapshotObject, user string) (*models.IstioConfigBundleApply, error) {
+	for _, o := range objects {
+		if err := in.CheckTeamOwnershipForPayload(o.ObjectGVK, o.Object, user); err != nil {
+			return nil, fmt.Errorf("error applying %s %s/%s: %w", o.ObjectGVK.Kind, namespace, o.Name, err)
+		}
+		mutation := models.IstioConfigMutation{Operation: models.IstioConfigMutationCreate, Cluster: cluster, Namespace: namespace, ObjectGVK: o.ObjectGVK, Name: o.Name, User: user}
+		if err := in.ReviewMutation(ctx, mutation, o.Object); err != nil {
+			return nil, fmt.Errorf("error applying %s %s/%s: %w", o.ObjectGVK.Kind, namespace, o.Name, err)
+		}
+	}
+
+	result := &models.IstioConfigBundleApply{
+		Applied: []models.IstioReference{},
+		Skipped: []models.IstioReference{},
+	}
+	for _, o := range objects {
+		ref := models.IstioReference{ObjectGVK: o.ObjectGVK, Name: o.Name, Namespace: namespace}
+		_, err := in.CreateIstioConfigDetail(ctx, cluster, namespace, o.ObjectGVK, o.Object)
+		switch {
+		case api_errors.IsAlreadyExists(err):
+			result.Skipped = append(result.Skipped, ref)
+		case err != nil:
+			return nil, fmt.Errorf("error applying %s %s/%s: %w", o.ObjectGVK.Kind, namespace, o.Name, err)
+		default:
+			in.RecordConfigChange(cluster, namespace, o.ObjectGVK, o.Name, models.IstioConfigMutationCreate, user)
+			result.Applied = append(result.Applied, ref)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,84 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func fakeBundle() models.IstioConfigBundle {
+	return models.IstioConfigBundle{
+		Objects: []models.IstioConfigSnapshotObject{
+			{
+				ObjectGVK: kubernetes.VirtualServices,
+				Name:      "reviews-${namespace}",
+				Object:    []byte(`{"metadata":{"name":"reviews-${namespace}"},"spec":{"hosts":["reviews.${hostSuffix}"],"gateways":["${gateway}"]}}`),
+			},
+		},
+		Variables: map[string]string{"hostSuffix": "prod.example.com", "gateway": "istio-system/public"},
+	}
+}
+
+func TestRenderIstioConfigBundle(t *testing.T) {
+	require := require.New(t)
+
+	objects, err := RenderIstioConfigBundle(fakeBundle(), "prod")
+	require.NoError(err)
+	require.Len(objects, 1)
+	require.Equal("reviews-prod", objects[0].Name)
+	require.JSONEq(`{"metadata":{"name":"reviews-prod"},"spec":{"hosts":["reviews.prod.example.com"],"gateways":["istio-system/public"]}}`, string(objects[0].Object))
+
+	bundle := fakeBundle()
+	delete(bundle.Variables, "gateway")
+	_, err = RenderIstioConfigBundle(bundle, "prod")
+	require.EqualError(err, "undefined template variables: gateway")
+
+	bundle = fakeBundle()
+	bundle.Variables["hostSuffix"] = "Not A Host"
+	_, err = RenderIstioConfigBundle(bundle, "prod")
+	require.ErrorContains(err, "invalid hostSuffix variable")
+
+	bundle = fakeBundle()
+	bundle.Objects[0].Object = []byte(`{"metadata":{"name":"reviews","namespace":"dev"}}`)
+	bundle.Objects[0].Name = "reviews"
+	_, err = RenderIstioConfigBundle(bundle, "prod")
+	require.ErrorContains(err, "targets a different namespace [dev]")
+}
+
+func TestApplyIstioObjects(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	kubernetes.SetConfig(t, *conf)
+
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("prod"))
+	SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	objects, err := RenderIstioConfigBundle(fakeBundle(), "prod")
+	require.NoError(err)
+
+	result, err := layer.IstioConfig.ApplyIstioObjects(context.TODO(), conf.KubernetesConfig.ClusterName, "prod", objects, "")
+	require.NoError(err)
+	require.Equal([]models.IstioReference{{ObjectGVK: kubernetes.VirtualServices, Name: "reviews-prod", Namespace: "prod"}}, result.Applied)
+	require.Empty(result.Skipped)
+
+	vs, err := k8s.Istio().NetworkingV1().VirtualServices("prod").Get(context.TODO(), "reviews-prod", meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Equal([]string{"reviews.prod.example.com"}, vs.Spec.Hosts)
+
+	// Applying the bundle again leaves the existing objects untouched
+	result, err = layer.IstioConfig.ApplyIstioObjects(context.TODO(), conf.KubernetesConfig.ClusterName, "prod", objects, "")
+	require.NoError(err)
+	require.Empty(result.Applied)
+	require.Len(result.Skipped, 1)
+}
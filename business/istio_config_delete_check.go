@@ -0,0 +1,146 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// maxDeleteCheckConcurrency is the number of objects checked in parallel.
+const maxDeleteCheckConcurrency = 10
+
+// deleteProtectedKinds maps the kinds protected from deletion to the kinds of their dependents, the references that
+// break when the object is deleted: the VirtualServices bound to a Gateway or routing to the subsets of a
+// DestinationRule, and the objects using the hosts of a ServiceEntry.
+var deleteProtectedKinds = map[schema.GroupKind][]schema.GroupKind{
+	kubernetes.DestinationRules.GroupKind(): {kubernetes.VirtualServices.GroupKind()},
+	kubernetes.Gateways.GroupKind():         {kubernetes.VirtualServices.GroupKind()},
+	kubernetes.ServiceEntries.GroupKind():   {kubernetes.AuthorizationPolicies.GroupKind(), kubernetes.DestinationRules.GroupKind(), kubernetes.Sidecars.GroupKind()},
+}
+
+// dependents returns the references of an object that break when it is deleted.
+func dependents(objectGVK schema.GroupVersionKind, references []models.IstioReference) []models.IstioReference {
+	result := []models.IstioReference{}
+	for _, ref := range references {
+		for _, kind := range deleteProtectedKinds[objectGVK.GroupKind()] {
+			if ref.ObjectGVK.GroupKind() == kind {
+				result = append(result, ref)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// CheckDelete reports, for each of the objects about to be deleted, whether it still exists, whether the user
+// can delete it and the Istio objects related to it. The objects are checked in parallel and reported in the
+// requested order.
+func (in *IstioConfigService) CheckDelete(ctx context.Context, cluster string, objects []models.IstioReference) ([]models.IstioConfigDeleteCheck, error) {
+	userClient, ok := in.userClients[cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster [%s] is not found or is not accessible for Kiali", cluster)
+	}
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]models.IstioConfigDeleteCheck, len(objects))
+	g, gctx := newFanOutGroup(ctx, maxDeleteCheckConcurrency)
+	for i, object := range objects {
+		checks[i] = models.IstioConfigDeleteCheck{IstioReference: object, References: []models.IstioReference{}, Dependents: []models.IstioReference{}}
+		check := &checks[i]
+		g.Go(func() error {
+			if _, err := in.businessLayer.Namespace.GetClusterNamespace(gctx, object.Namespace, cluster); err != nil {
+				// The user can't see the namespace, nor delete its objects
+				if IsAccessibleError(err) || api_errors.IsNotFound(err) || api_errors.IsForbidden(err) {
+					return nil
+				}
+				return err
+			}
+			if _, err := getCachedIstioObject(ctx, kubeCache, object.Namespace, object.ObjectGVK, object.Name); err != nil {
+				if api_errors.IsNotFound(err) {
+					return nil
+				}
+				return err
+			}
+			check.Exists = true
+			_, _, check.CanDelete = getPermissions(gctx, userClient, cluster, object.Namespace, object.ObjectGVK)
+
+			_, references, err := in.businessLayer.Validations.GetIstioObjectValidations(gctx, cluster, object.Namespace, object.ObjectGVK, object.Name)
+			if err != nil {
+				return err
+			}
+			key := models.IstioReferenceKey{ObjectGVK: object.ObjectGVK, Namespace: object.Namespace, Name: object.Name}
+			if refs, found := references[key]; found && refs != nil {
+				check.References = append(check.References, refs.ObjectReferences...)
+			}
+			if mode := in.config.IstioConfigDeleteProtection.Mode; mode != config.DeleteProtectionDisabled {
+				check.Dependents = dependents(object.ObjectGVK, check.References)
+				check.DeleteBlocked = mode == config.DeleteProtectionBlock && len(check.Dependents) > 0
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+// CheckDeleteProtection returns the dependents of the Gateways, DestinationRules and ServiceEntries about to be
+// deleted, excluding the objects deleted with them. It returns a Conflict error when the delete protection blocks
+// the deletion of the objects with dependents.
+func (in *IstioConfigService) CheckDeleteProtection(ctx context.Context, cluster string, objects []models.IstioReference) ([]models.IstioReference, error) {
+	mode := in.config.IstioConfigDeleteProtection.Mode
+	if mode == config.DeleteProtectionDisabled {
+		return nil, nil
+	}
+
+	// The objects are identified regardless of the version of their kind
+	deletedKey := func(ref models.IstioReference) string {
+		return ref.ObjectGVK.GroupKind().String() + "/" + ref.Namespace + "/" + ref.Name
+	}
+	deleted := make(map[string]bool, len(objects))
+	for _, object := range objects {
+		deleted[deletedKey(object)] = true
+	}
+
+	result := []models.IstioReference{}
+	for _, object := range objects {
+		if _, protected := deleteProtectedKinds[object.ObjectGVK.GroupKind()]; !protected {
+			continue
+		}
+		_, references, err := in.businessLayer.Validations.GetIstioObjectValidations(ctx, cluster, object.Namespace, object.ObjectGVK, object.Name)
+		if err != nil {
+			return nil, err
+		}
+		key := models.IstioReferenceKey{ObjectGVK: object.ObjectGVK, Namespace: object.Namespace, Name: object.Name}
+		refs, found := references[key]
+		if !found || refs == nil {
+			continue
+		}
+
+		objectDependents := []string{}
+		for _, dependent := range dependents(object.ObjectGVK, refs.ObjectReferences) {
+			if !deleted[deletedKey(dependent)] {
+				result = append(result, dependent)
+				objectDependents = append(objectDependents, fmt.Sprintf("%s %s/%s", dependent.ObjectGVK.Kind, dependent.Namespace, dependent.Name))
+			}
+		}
+		if mode == config.DeleteProtectionBlock && len(objectDependents) > 0 {
+			return result, api_errors.NewConflict(schema.GroupResource{Group: object.ObjectGVK.Group, Resource: object.ObjectGVK.Kind}, object.Name,
+				fmt.Errorf("it is referenced by %s", strings.Join(objectDependents, ", ")))
+		}
+	}
+
+	return result, nil
+}
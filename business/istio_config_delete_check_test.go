@@ -0,0 +1,98 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestCheckDelete(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	kubernetes.SetConfig(t, *conf)
+
+	gw := data.CreateEmptyGateway("bookinfo-gateway", "bookinfo", map[string]string{"istio": "ingressgateway"})
+	vs := data.AddGatewaysToVirtualService([]string{"bookinfo-gateway"}, data.CreateEmptyVirtualService("bookinfo", "bookinfo", []string{"*"}))
+
+	k8s := kubetest.NewFakeK8sClient([]runtime.Object{kubetest.FakeNamespace("bookinfo"), gw, vs}...)
+	SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	objects := []models.IstioReference{
+		{ObjectGVK: kubernetes.Gateways, Namespace: "bookinfo", Name: "bookinfo-gateway"},
+		{ObjectGVK: kubernetes.VirtualServices, Namespace: "bookinfo", Name: "deleted"},
+		{ObjectGVK: kubernetes.VirtualServices, Namespace: "unknown", Name: "bookinfo"},
+	}
+	checks, err := layer.IstioConfig.CheckDelete(context.TODO(), conf.KubernetesConfig.ClusterName, objects)
+	require.NoError(err)
+	require.Len(checks, 3)
+
+	require.Equal(objects[0], checks[0].IstioReference)
+	require.True(checks[0].Exists)
+	require.Contains(checks[0].References, models.IstioReference{ObjectGVK: kubernetes.VirtualServices, Namespace: "bookinfo", Name: "bookinfo"})
+	require.Equal([]models.IstioReference{{ObjectGVK: kubernetes.VirtualServices, Namespace: "bookinfo", Name: "bookinfo"}}, checks[0].Dependents)
+	require.False(checks[0].DeleteBlocked)
+
+	require.Equal(objects[1], checks[1].IstioReference)
+	require.False(checks[1].Exists)
+	require.False(checks[1].CanDelete)
+	require.Empty(checks[1].References)
+
+	require.False(checks[2].Exists)
+}
+
+func TestCheckDeleteProtection(t *testing.T) {
+	require := require.New(t)
+
+	gw := data.CreateEmptyGateway("bookinfo-gateway", "bookinfo", map[string]string{"istio": "ingressgateway"})
+	vs := data.AddGatewaysToVirtualService([]string{"bookinfo-gateway"}, data.CreateEmptyVirtualService("bookinfo", "bookinfo", []string{"*"}))
+	gwRef := models.IstioReference{ObjectGVK: kubernetes.Gateways, Namespace: "bookinfo", Name: "bookinfo-gateway"}
+	vsRef := models.IstioReference{ObjectGVK: kubernetes.VirtualServices, Namespace: "bookinfo", Name: "bookinfo"}
+
+	newLayer := func(mode string) (*Layer, string) {
+		conf := config.NewConfig()
+		conf.ExternalServices.Istio.IstioAPIEnabled = false
+		conf.IstioConfigDeleteProtection.Mode = mode
+		kubernetes.SetConfig(t, *conf)
+
+		k8s := kubetest.NewFakeK8sClient([]runtime.Object{kubetest.FakeNamespace("bookinfo"), gw, vs}...)
+		SetupBusinessLayer(t, k8s, *conf)
+		k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+		return NewWithBackends(k8sclients, k8sclients, nil, nil), conf.KubernetesConfig.ClusterName
+	}
+
+	layer, cluster := newLayer(config.DeleteProtectionWarn)
+	dependents, err := layer.IstioConfig.CheckDeleteProtection(context.TODO(), cluster, []models.IstioReference{gwRef})
+	require.NoError(err)
+	require.Equal([]models.IstioReference{vsRef}, dependents)
+
+	// The dependents deleted with the object don't count
+	dependents, err = layer.IstioConfig.CheckDeleteProtection(context.TODO(), cluster, []models.IstioReference{gwRef, vsRef})
+	require.NoError(err)
+	require.Empty(dependents)
+
+	layer, cluster = newLayer(config.DeleteProtectionBlock)
+	_, err = layer.IstioConfig.CheckDeleteProtection(context.TODO(), cluster, []models.IstioReference{gwRef})
+	require.True(api_errors.IsConflict(err))
+
+	checks, err := layer.IstioConfig.CheckDelete(context.TODO(), cluster, []models.IstioReference{gwRef})
+	require.NoError(err)
+	require.True(checks[0].DeleteBlocked)
+
+	layer, cluster = newLayer(config.DeleteProtectionDisabled)
+	dependents, err = layer.IstioConfig.CheckDeleteProtection(context.TODO(), cluster, []models.IstioReference{gwRef})
+	require.NoError(err)
+	require.Empty(dependents)
+}
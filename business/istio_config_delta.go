@@ -0,0 +1,69 @@
+package business
+
+import (
+	"fmt"
+	"strconv"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/models"
+)
+
+// setDelta turns the list into a delta list: only the objects changed after the bookmark of the criteria are kept,
+// and the deletions seen since the bookmark are added. The deletions are not filtered by labels, their objects are
+// gone. A ResourceExpired error is returned when the deletions since the bookmark are not known anymore, the client
+// needs a full list then.
+func setDelta(istioConfigList *models.IstioConfigList, kubeCache cache.KubeCache, namespace string, criteria IstioConfigCriteria) error {
+	since := criteria.ChangedSince
+
+	deleted, complete := kubeCache.Activity().DeletedSince(namespace, since)
+	if !complete {
+		return api_errors.NewResourceExpired(fmt.Sprintf("the changes since resourceVersion [%d] are not available anymore, a full list is required", since))
+	}
+
+	istioConfigList.Deleted = []models.IstioConfigEvent{}
+	latest := istioConfigList.LatestResourceVersion()
+	for _, event := range deleted {
+		if !criteria.Include(event.ObjectGVK) {
+			continue
+		}
+		istioConfigList.Deleted = append(istioConfigList.Deleted, event)
+		if rv, err := strconv.ParseUint(event.ResourceVersion, 10, 64); err == nil && rv > latest {
+			latest = rv
+		}
+	}
+	// Nothing changed: the client keeps its bookmark
+	if latest < since {
+		latest = since
+	}
+	istioConfigList.ResourceVersion = strconv.FormatUint(latest, 10)
+
+	filterChangedSince(istioConfigList, since)
+	return nil
+}
+
+// filterChangedSince keeps only the objects of the list changed after the given resourceVersion.
+func filterChangedSince(istioConfigList *models.IstioConfigList, resourceVersion uint64) {
+	istioConfigList.AuthorizationPolicies = kubernetes.FilterByResourceVersionAfter(istioConfigList.AuthorizationPolicies, resourceVersion)
+	istioConfigList.DestinationRules = kubernetes.FilterByResourceVersionAfter(istioConfigList.DestinationRules, resourceVersion)
+	istioConfigList.EnvoyFilters = kubernetes.FilterByResourceVersionAfter(istioConfigList.EnvoyFilters, resourceVersion)
+	istioConfigList.Gateways = kubernetes.FilterByResourceVersionAfter(istioConfigList.Gateways, resourceVersion)
+	istioConfigList.K8sGateways = kubernetes.FilterByResourceVersionAfter(istioConfigList.K8sGateways, resourceVersion)
+	istioConfigList.K8sGRPCRoutes = kubernetes.FilterByResourceVersionAfter(istioConfigList.K8sGRPCRoutes, resourceVersion)
+	istioConfigList.K8sHTTPRoutes = kubernetes.FilterByResourceVersionAfter(istioConfigList.K8sHTTPRoutes, resourceVersion)
+	istioConfigList.K8sReferenceGrants = kubernetes.FilterByResourceVersionAfter(istioConfigList.K8sReferenceGrants, resourceVersion)
+	istioConfigList.K8sTCPRoutes = kubernetes.FilterByResourceVersionAfter(istioConfigList.K8sTCPRoutes, resourceVersion)
+	istioConfigList.K8sTLSRoutes = kubernetes.FilterByResourceVersionAfter(istioConfigList.K8sTLSRoutes, resourceVersion)
+	istioConfigList.PeerAuthentications = kubernetes.FilterByResourceVersionAfter(istioConfigList.PeerAuthentications, resourceVersion)
+	istioConfigList.ProxyConfigs = kubernetes.FilterByResourceVersionAfter(istioConfigList.ProxyConfigs, resourceVersion)
+	istioConfigList.RequestAuthentications = kubernetes.FilterByResourceVersionAfter(istioConfigList.RequestAuthentications, resourceVersion)
+	istioConfigList.ServiceEntries = kubernetes.FilterByResourceVersionAfter(istioConfigList.ServiceEntries, resourceVersion)
+	istioConfigList.Sidecars = kubernetes.FilterByResourceVersionAfter(istioConfigList.Sidecars, resourceVersion)
+	istioConfigList.Telemetries = kubernetes.FilterByResourceVersionAfter(istioConfigList.Telemetries, resourceVersion)
+	istioConfigList.VirtualServices = kubernetes.FilterByResourceVersionAfter(istioConfigList.VirtualServices, resourceVersion)
+	istioConfigList.WasmPlugins = kubernetes.FilterByResourceVersionAfter(istioConfigList.WasmPlugins, resourceVersion)
+	istioConfigList.WorkloadEntries = kubernetes.FilterByResourceVersionAfter(istioConfigList.WorkloadEntries, resourceVersion)
+	istioConfigList.WorkloadGroups = kubernetes.FilterByResourceVersionAfter(istioConfigList.WorkloadGroups, resourceVersion)
+}
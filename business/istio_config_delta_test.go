@@ -0,0 +1,82 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestGetIstioConfigListDelta(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.KubernetesConfig.ConfigActivitySize = 3
+	config.Set(conf)
+
+	unchanged := data.CreateEmptyVirtualService("unchanged", "bookinfo", []string{"reviews"})
+	unchanged.ResourceVersion = "5"
+	changed := data.CreateEmptyVirtualService("changed", "bookinfo", []string{"ratings"})
+	changed.ResourceVersion = "12"
+
+	k8s := kubetest.NewFakeK8sClient([]runtime.Object{kubetest.FakeNamespace("bookinfo"), unchanged, changed}...)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	cluster := conf.KubernetesConfig.ClusterName
+	k8sclients := map[string]kubernetes.ClientInterface{cluster: k8s}
+	service := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, businessLayer: NewWithBackends(k8sclients, k8sclients, nil, nil)}
+
+	// The full list holds the bookmark
+	criteria := IstioConfigCriteria{IncludeVirtualServices: true, IncludeDestinationRules: true}
+	list, err := service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Len(list.VirtualServices, 2)
+	require.Equal("12", list.ResourceVersion)
+	require.Nil(list.Deleted)
+
+	kubeCache, err := cache.GetKubeCache(cluster)
+	require.NoError(err)
+	kubeCache.Activity().Record(models.IstioConfigEvent{
+		Cluster: cluster, Name: "old", Namespace: "bookinfo", ObjectGVK: kubernetes.VirtualServices,
+		ResourceVersion: "8", Timestamp: time.Now(), Type: models.IstioConfigMutationDelete,
+	})
+	kubeCache.Activity().Record(models.IstioConfigEvent{
+		Cluster: cluster, Name: "removed", Namespace: "bookinfo", ObjectGVK: kubernetes.VirtualServices,
+		ResourceVersion: "14", Timestamp: time.Now(), Type: models.IstioConfigMutationDelete,
+	})
+
+	criteria.ChangedSince = 7
+	list, err = service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Len(list.VirtualServices, 1)
+	require.Equal("changed", list.VirtualServices[0].Name)
+	require.Len(list.Deleted, 2)
+	require.Equal("removed", list.Deleted[0].Name)
+	require.Equal("14", list.ResourceVersion)
+
+	// Nothing changed since the bookmark
+	criteria.ChangedSince = 14
+	list, err = service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Empty(list.VirtualServices)
+	require.Empty(list.Deleted)
+	require.Equal("14", list.ResourceVersion)
+
+	// The deletions before the oldest change kept are unknown
+	kubeCache.Activity().Record(models.IstioConfigEvent{
+		Cluster: cluster, Name: "other", Namespace: "bookinfo", ObjectGVK: kubernetes.VirtualServices,
+		ResourceVersion: "16", Timestamp: time.Now(), Type: models.IstioConfigMutationDelete,
+	})
+	criteria.ChangedSince = 7
+	_, err = service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.True(api_errors.IsResourceExpired(err))
+}
@@ -0,0 +1,202 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// istioConfigSpecKey identifies an object on both sides of a diff.
+type istioConfigSpecKey struct {
+	gvk  schema.GroupVersionKind
+	name string
+}
+
+// DiffIstioConfig compares the Istio config of the source and target namespaces, which can belong to different clusters.
+// Objects are matched by kind and name and their specs are compared field by field, so formatting and the order
+// of the map keys don't produce differences. Metadata and status are ignored.
+func (in *IstioConfigService) DiffIstioConfig(ctx context.Context, source, target models.IstioConfigLocation, criteria IstioConfigCriteria) (*models.IstioConfigDiff, error) {
+	sourceSpecs, err := in.getIstioConfigSpecs(ctx, source, criteria)
+	if err != nil {
+		return nil, err
+	}
+	targetSpecs, err := in.getIstioConfigSpecs(ctx, target, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &models.IstioConfigDiff{Source: source, Target: target, Objects: []models.IstioConfigObjectDiff{}}
+	for key, sourceSpec := range sourceSpecs {
+		objectDiff := models.IstioConfigObjectDiff{ObjectGVK: key.gvk, Name: key.name}
+		targetSpec, found := targetSpecs[key]
+		switch {
+		case !found:
+			objectDiff.Status = models.IstioConfigDiffSourceOnly
+		default:
+			objectDiff.Fields = diffSpecFields("spec", sourceSpec, targetSpec, nil)
+			if len(objectDiff.Fields) > 0 {
+				objectDiff.Status = models.IstioConfigDiffChanged
+			} else {
+				objectDiff.Status = models.IstioConfigDiffUnchanged
+			}
+		}
+		diff.Objects = append(diff.Objects, objectDiff)
+	}
+	for key := range targetSpecs {
+		if _, found := sourceSpecs[key]; !found {
+			diff.Objects = append(diff.Objects, models.IstioConfigObjectDiff{ObjectGVK: key.gvk, Name: key.name, Status: models.IstioConfigDiffTargetOnly})
+		}
+	}
+
+	sort.Slice(diff.Objects, func(i, j int) bool {
+		if diff.Objects[i].ObjectGVK.String() != diff.Objects[j].ObjectGVK.String() {
+			return diff.Objects[i].ObjectGVK.String() < diff.Objects[j].ObjectGVK.String()
+		}
+		return diff.Objects[i].Name < diff.Objects[j].Name
+	})
+
+	return diff, nil
+}
+
+// getIstioConfigSpecs returns the spec of every Istio object of the location, in its generic JSON form.
+func (in *IstioConfigService) getIstioConfigSpecs(ctx context.Context, location models.IstioConfigLocation, criteria IstioConfigCriteria) (map[istioConfigSpecKey]interface{}, error) {
+	configList, err := in.GetIstioConfigListForNamespace(ctx, location.Cluster, location.Namespace, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	specs := map[istioConfigSpecKey]interface{}{}
+	for _, o := range istioConfigObjects(configList) {
+		raw, err := json.Marshal(o.object)
+		if err != nil {
+			return nil, err
+		}
+		var object struct {
+			Spec interface{} `json:"spec"`
+		}
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return nil, err
+		}
+		specs[istioConfigSpecKey{gvk: o.gvk, name: o.object.GetName()}] = object.Spec
+	}
+	return specs, nil
+}
+
+// istioConfigObject is an Istio object of any kind.
+type istioConfigObject struct {
+	gvk    schema.GroupVersionKind
+	object metav1.Object
+}
+
+// istioConfigObjects returns every object of the list, with its kind.
+func istioConfigObjects(configList *models.IstioConfigList) []istioConfigObject {
+	objects := []istioConfigObject{}
+	objects = appendIstioConfigObjects(objects, kubernetes.DestinationRules, configList.DestinationRules)
+	objects = appendIstioConfigObjects(objects, kubernetes.EnvoyFilters, configList.EnvoyFilters)
+	objects = appendIstioConfigObjects(objects, kubernetes.Gateways, configList.Gateways)
+	objects = appendIstioConfigObjects(objects, kubernetes.ProxyConfigs, configList.ProxyConfigs)
+	objects = appendIstioConfigObjects(objects, kubernetes.ServiceEntries, configList.ServiceEntries)
+	objects = appendIstioConfigObjects(objects, kubernetes.Sidecars, configList.Sidecars)
+	objects = appendIstioConfigObjects(objects, kubernetes.VirtualServices, configList.VirtualServices)
+	objects = appendIstioConfigObjects(objects, kubernetes.WorkloadEntries, configList.WorkloadEntries)
+	objects = appendIstioConfigObjects(objects, kubernetes.WorkloadGroups, configList.WorkloadGroups)
+	objects = appendIstioConfigObjects(objects, kubernetes.WasmPlugins, configList.WasmPlugins)
+	objects = appendIstioConfigObjects(objects, kubernetes.Telemetries, configList.Telemetries)
+	objects = appendIstioConfigObjects(objects, kubernetes.K8sGateways, configList.K8sGateways)
+	objects = appendIstioConfigObjects(objects, kubernetes.K8sGRPCRoutes, configList.K8sGRPCRoutes)
+	objects = appendIstioConfigObjects(objects, kubernetes.K8sHTTPRoutes, configList.K8sHTTPRoutes)
+	objects = appendIstioConfigObjects(objects, kubernetes.K8sReferenceGrants, configList.K8sReferenceGrants)
+	objects = appendIstioConfigObjects(objects, kubernetes.K8sTCPRoutes, configList.K8sTCPRoutes)
+	objects = appendIstioConfigObjects(objects, kubernetes.K8sTLSRoutes, configList.K8sTLSRoutes)
+	objects = appendIstioConfigObjects(objects, kubernetes.AuthorizationPolicies, configList.AuthorizationPolicies)
+	objects = appendIstioConfigObjects(objects, kubernetes.PeerAuthentications, configList.PeerAuthentications)
+	objects = appendIstioConfigObjects(objects, kubernetes.RequestAuthentications, configList.RequestAuthentications)
+	return objects
+}
+
+func appendIstioConfigObjects[T metav1.Object](objects []istioConfigObject, gvk schema.GroupVersionKind, list []T) []istioConfigObject {
+	for _, o := range list {
+		objects = append(objects, istioConfigObject{gvk: gvk, object: o})
+	}
+	return objects
+}
+
+// diffObjectSpecs compares the specs of two versions of an object, the current one being nil when the object does
+// not exist yet.
+func diffObjectSpecs(current, updated interface{}) ([]models.IstioConfigFieldDiff, error) {
+	toGeneric := func(o interface{}) (interface{}, error) {
+		if o == nil {
+			return nil, nil
+		}
+		raw, err := json.Marshal(o)
+		if err != nil {
+			return nil, err
+		}
+		var object struct {
+			Spec interface{} `json:"spec"`
+		}
+		err = json.Unmarshal(raw, &object)
+		return object.Spec, err
+	}
+	currentSpec, err := toGeneric(current)
+	if err != nil {
+		return nil, err
+	}
+	updatedSpec, err := toGeneric(updated)
+	if err != nil {
+		return nil, err
+	}
+	return diffSpecFields("spec", currentSpec, updatedSpec, []models.IstioConfigFieldDiff{}), nil
+}
+
+// diffSpecFields compares two values in their generic JSON form and appends the fields with different values.
+// Maps are compared key by key and lists item by item, so only the innermost fields that differ are reported.
+func diffSpecFields(path string, source, target interface{}, fields []models.IstioConfigFieldDiff) []models.IstioConfigFieldDiff {
+	sourceMap, sourceIsMap := source.(map[string]interface{})
+	targetMap, targetIsMap := target.(map[string]interface{})
+	if sourceIsMap && targetIsMap {
+		keys := make([]string, 0, len(sourceMap)+len(targetMap))
+		for key := range sourceMap {
+			keys = append(keys, key)
+		}
+		for key := range targetMap {
+			if _, found := sourceMap[key]; !found {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fields = diffSpecFields(path+"."+key, sourceMap[key], targetMap[key], fields)
+		}
+		return fields
+	}
+
+	sourceList, sourceIsList := source.([]interface{})
+	targetList, targetIsList := target.([]interface{})
+	if sourceIsList && targetIsList {
+		for i := 0; i < len(sourceList) || i < len(targetList); i++ {
+			var sourceItem, targetItem interface{}
+			if i < len(sourceList) {
+				sourceItem = sourceList[i]
+			}
+			if i < len(targetList) {
+				targetItem = targetList[i]
+			}
+			fields = diffSpecFields(fmt.Sprintf("%s[%d]", path, i), sourceItem, targetItem, fields)
+		}
+		return fields
+	}
+
+	if !reflect.DeepEqual(source, target) {
+		fields = append(fields, models.IstioConfigFieldDiff{Path: path, Source: source, Target: target})
+	}
+	return fields
+}
@@ -0,0 +1,86 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestDiffIstioConfig(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	objects := []runtime.Object{
+		kubetest.FakeNamespace("staging"),
+		kubetest.FakeNamespace("prod"),
+		data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v2", 100),
+			data.CreateEmptyVirtualService("reviews", "staging", []string{"reviews"})),
+		data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 100),
+			data.CreateEmptyVirtualService("reviews", "prod", []string{"reviews"})),
+		data.CreateEmptyDestinationRule("staging", "reviews", "reviews"),
+		data.CreateEmptyDestinationRule("prod", "reviews", "reviews"),
+		data.CreateEmptyDestinationRule("staging", "ratings", "ratings"),
+		data.CreateEmptyDestinationRule("prod", "details", "details"),
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	configService := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, businessLayer: NewWithBackends(k8sclients, k8sclients, nil, nil)}
+
+	cluster := conf.KubernetesConfig.ClusterName
+	diff, err := configService.DiffIstioConfig(context.TODO(),
+		models.IstioConfigLocation{Cluster: cluster, Namespace: "staging"},
+		models.IstioConfigLocation{Cluster: cluster, Namespace: "prod"},
+		ParseIstioConfigCriteria("", "", ""))
+	require.NoError(err)
+
+	statuses := map[string]string{}
+	for _, o := range diff.Objects {
+		statuses[o.ObjectGVK.Kind+"/"+o.Name] = o.Status
+	}
+	require.Equal(map[string]string{
+		"DestinationRule/details": models.IstioConfigDiffTargetOnly,
+		"DestinationRule/ratings": models.IstioConfigDiffSourceOnly,
+		"DestinationRule/reviews": models.IstioConfigDiffUnchanged,
+		"VirtualService/reviews":  models.IstioConfigDiffChanged,
+	}, statuses)
+
+	for _, o := range diff.Objects {
+		if o.Status == models.IstioConfigDiffChanged {
+			require.Equal([]models.IstioConfigFieldDiff{{Path: "spec.http[0].route[0].destination.subset", Source: "v2", Target: "v1"}}, o.Fields)
+		}
+	}
+}
+
+func TestDiffSpecFields(t *testing.T) {
+	require := require.New(t)
+
+	source := map[string]interface{}{
+		"hosts": []interface{}{"reviews", "ratings"},
+		"tls":   map[string]interface{}{"mode": "ISTIO_MUTUAL"},
+	}
+	target := map[string]interface{}{
+		"hosts": []interface{}{"reviews"},
+		"tls":   map[string]interface{}{"mode": "ISTIO_MUTUAL"},
+		"port":  float64(80),
+	}
+
+	fields := diffSpecFields("spec", source, target, nil)
+	require.Equal([]models.IstioConfigFieldDiff{
+		{Path: "spec.hosts[1]", Source: "ratings"},
+		{Path: "spec.port", Target: float64(80)},
+	}, fields)
+
+	require.Empty(diffSpecFields("spec", source, source, nil))
+}
@@ -0,0 +1,48 @@
+package business
+
+import (
+	"context"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/models"
+)
+
+// DryRunCreateIstioConfigDetail creates the object with a Kubernetes dry-run, so that it is checked by the API server
+// without being persisted, and validates the object returned by the API server against the rest of the Istio config.
+func (in *IstioConfigService) DryRunCreateIstioConfigDetail(ctx context.Context, cluster, namespace string, resourceType schema.GroupVersionKind, body []byte) (models.IstioConfigDetails, error) {
+	istioConfigDetail, err := in.createIstioConfigDetail(ctx, cluster, namespace, resourceType, body, meta_v1.CreateOptions{DryRun: []string{meta_v1.DryRunAll}})
+	if err != nil {
+		return istioConfigDetail, err
+	}
+	return istioConfigDetail, in.validateDryRun(ctx, cluster, &istioConfigDetail)
+}
+
+// DryRunUpdateIstioConfigDetail patches the object with a Kubernetes dry-run, so that it is checked by the API server
+// without being persisted, and validates the patched object against the rest of the Istio config.
+func (in *IstioConfigService) DryRunUpdateIstioConfigDetail(ctx context.Context, cluster, namespace string, resourceType schema.GroupVersionKind, name, jsonPatch string) (models.IstioConfigDetails, error) {
+	istioConfigDetail, err := in.updateIstioConfigDetail(ctx, cluster, namespace, resourceType, name, jsonPatch, meta_v1.PatchOptions{DryRun: []string{meta_v1.DryRunAll}})
+	if err != nil {
+		return istioConfigDetail, err
+	}
+	return istioConfigDetail, in.validateDryRun(ctx, cluster, &istioConfigDetail)
+}
+
+// validateDryRun sets the validation of the object of the dry-run. It is left empty when no checker validates the type
+// of the object.
+func (in *IstioConfigService) validateDryRun(ctx context.Context, cluster string, istioConfigDetail *models.IstioConfigDetails) error {
+	candidate := istioConfigDetail.Resource()
+	if candidate == nil || !in.config.ExternalServices.Istio.IstioAPIEnabled {
+		return nil
+	}
+	validations, err := in.businessLayer.Validations.ValidateIstioObjectCandidate(ctx, cluster, istioConfigDetail.ObjectGVK, candidate)
+	if err != nil {
+		return err
+	}
+	key := models.IstioValidationKey{ObjectGVK: istioConfigDetail.ObjectGVK, Namespace: candidate.GetNamespace(), Name: candidate.GetName(), Cluster: cluster}
+	if validation, found := validations[key]; found {
+		istioConfigDetail.IstioValidation = validation
+	}
+	return nil
+}
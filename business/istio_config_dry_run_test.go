@@ -0,0 +1,61 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeclienttesting "k8s.io/client-go/testing"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestDryRunIstioConfigDetail(t *testing.T) {
+	require := require.New(t)
+
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("test"),
+		data.CreateEmptyVirtualService("reviews", "test", []string{"reviews"}),
+	)
+	// The fake clients drop the options of the calls, so the dry-run of the creation is emulated by not tracking the
+	// object. The patched objects are left to the tracker.
+	k8s.IstioClientset.(*istiofake.Clientset).PrependReactor("create", "virtualservices", func(action kubeclienttesting.Action) (bool, runtime.Object, error) {
+		return true, action.(kubeclienttesting.CreateActionImpl).GetObject(), nil
+	})
+
+	conf := config.NewConfig()
+	conf.KubernetesConfig.ClusterName = config.DefaultClusterID
+	config.Set(conf)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+	configService := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, controlPlaneMonitor: poller, businessLayer: layer}
+
+	// Another VirtualService for the same host
+	body := `{"metadata":{"name":"reviews-v2","namespace":"test"},"spec":{"hosts":["reviews"]}}`
+	created, err := configService.DryRunCreateIstioConfigDetail(context.TODO(), conf.KubernetesConfig.ClusterName, "test", kubernetes.VirtualServices, []byte(body))
+	require.NoError(err)
+	require.Equal("reviews-v2", created.VirtualService.Name)
+	require.NotNil(created.IstioValidation)
+	require.Len(created.IstioValidation.Checks, 1)
+	require.Equal("spec/hosts", created.IstioValidation.Checks[0].Path)
+
+	// A single destination with a weight
+	patch := `{"spec":{"http":[{"route":[{"destination":{"host":"reviews"},"weight":50}]}]}}`
+	updated, err := configService.DryRunUpdateIstioConfigDetail(context.TODO(), conf.KubernetesConfig.ClusterName, "test", kubernetes.VirtualServices, "reviews", patch)
+	require.NoError(err)
+	require.Equal(int32(50), updated.VirtualService.Spec.Http[0].Route[0].Weight)
+	require.NotNil(updated.IstioValidation)
+	require.Len(updated.IstioValidation.Checks, 1)
+	require.Equal("spec/http[0]/route[0]/weight", updated.IstioValidation.Checks[0].Path)
+
+	// The validations of the candidates are not cached
+	_, found := cache.Validations().Get(models.IstioValidationKey{ObjectGVK: kubernetes.VirtualServices, Namespace: "test", Name: "reviews", Cluster: conf.KubernetesConfig.ClusterName})
+	require.False(found)
+}
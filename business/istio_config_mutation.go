@@ -0,0 +1,173 @@
+package business
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/httputil"
+)
+
+// ReviewMutation sends the change of the Istio config to the mutation webhook, which decides whether it can proceed.
+// The payload is the object on creation and the JSON merge patch on update. A Forbidden error is returned when the
+// webhook rejects the change. It does nothing when no webhook is configured.
+func (in *IstioConfigService) ReviewMutation(ctx context.Context, mutation models.IstioConfigMutation, payload []byte) error {
+	webhook := in.config.MutationWebhook
+	if webhook.URL == "" {
+		return nil
+	}
+
+	oldObject, newObject, err := in.getMutationObjects(ctx, mutation, payload)
+	if err != nil {
+		return err
+	}
+	if oldObject != nil {
+		mutation.OldObject = oldObject
+	}
+	if newObject != nil {
+		mutation.Object = newObject
+	}
+	mutation.Diff = []models.IstioConfigFieldDiff{}
+	for _, field := range diffSpecFields("", oldObject, newObject, nil) {
+		field.Path = strings.TrimPrefix(field.Path, ".")
+		mutation.Diff = append(mutation.Diff, field)
+	}
+
+	review, err := postMutationReview(ctx, webhook, mutation)
+	if err != nil {
+		if webhook.FailOpen {
+			log.Warningf("Allowing the %s of %s %s/%s: %s", mutation.Operation, mutation.ObjectGVK.Kind, mutation.Namespace, mutation.Name, err)
+			return nil
+		}
+		return api_errors.NewServiceUnavailable(err.Error())
+	}
+	if !review.Allowed {
+		resource := schema.GroupResource{Group: mutation.ObjectGVK.Group, Resource: mutation.ObjectGVK.Kind}
+		return api_errors.NewForbidden(resource, mutation.Name, fmt.Errorf("change rejected by the mutation webhook: %s", review.Reason))
+	}
+	return nil
+}
+
+// getMutationObjects returns the object before and after the change, in their generic JSON form and without
+// status nor the metadata set by the cluster. The old object is nil on creation and the new one on deletion.
+func (in *IstioConfigService) getMutationObjects(ctx context.Context, mutation models.IstioConfigMutation, payload []byte) (map[string]interface{}, map[string]interface{}, error) {
+	var oldObject map[string]interface{}
+	if mutation.Operation != models.IstioConfigMutationCreate {
+		kubeCache, err := in.kialiCache.GetKubeCache(mutation.Cluster)
+		if err != nil {
+			return nil, nil, err
+		}
+		obj, err := getCachedIstioObject(ctx, kubeCache, mutation.Namespace, mutation.ObjectGVK, mutation.Name)
+		switch {
+		// Let the write operation report objects that don't exist.
+		case api_errors.IsNotFound(err):
+		case err != nil:
+			return nil, nil, err
+		default:
+			if oldObject, err = genericIstioObject(obj); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	var newObject map[string]interface{}
+	switch mutation.Operation {
+	case models.IstioConfigMutationCreate, models.IstioConfigMutationUpdate:
+		var generic interface{}
+		if err := json.Unmarshal(payload, &generic); err != nil {
+			return nil, nil, api_errors.NewBadRequest(err.Error())
+		}
+		if mutation.Operation == models.IstioConfigMutationUpdate {
+			generic = mergePatch(oldObject, generic)
+		}
+		var err error
+		if newObject, err = genericIstioObject(generic); err != nil {
+			return nil, nil, err
+		}
+	}
+	return oldObject, newObject, nil
+}
+
+// genericIstioObject returns the object in its generic JSON form, without status nor the metadata set by the cluster.
+func genericIstioObject(object interface{}) (map[string]interface{}, error) {
+	raw, err := snapshotObject(object)
+	if err != nil {
+		return nil, err
+	}
+	generic := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+// mergePatch applies a JSON merge patch (RFC 7386) to the target, which is left unchanged.
+func mergePatch(target, patch interface{}) interface{} {
+	patchMap, isMap := patch.(map[string]interface{})
+	if !isMap {
+		return patch
+	}
+	merged := map[string]interface{}{}
+	if targetMap, isMap := target.(map[string]interface{}); isMap {
+		for key, value := range targetMap {
+			merged[key] = value
+		}
+	}
+	for key, value := range patchMap {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = mergePatch(merged[key], value)
+	}
+	return merged
+}
+
+// postMutationReview sends the change to the mutation webhook and returns its review. The request is cancelled with
+// the context, i.e. when the client of the change leaves.
+func postMutationReview(ctx context.Context, webhook config.MutationWebhook, mutation models.IstioConfigMutation) (*models.IstioConfigMutationReview, error) {
+	body, err := json.Marshal(mutation)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	timeout := time.Duration(webhook.TimeoutSeconds) * time.Second
+	transport, err := httputil.CreateTransport(&webhook.Auth, &http.Transport{}, timeout, webhook.CustomHeaders)
+	if err != nil {
+		return nil, err
+	}
+	client := http.Client{Transport: transport, Timeout: timeout}
+	response, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode >= 300 {
+		return nil, fmt.Errorf("mutation webhook [%s] responded with status code [%d]", webhook.URL, response.StatusCode)
+	}
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	review := &models.IstioConfigMutationReview{}
+	if err := json.Unmarshal(content, review); err != nil {
+		return nil, fmt.Errorf("invalid response of the mutation webhook [%s]: %s", webhook.URL, err)
+	}
+	return review, nil
+}
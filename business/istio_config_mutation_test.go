@@ -0,0 +1,118 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+func TestReviewMutation(t *testing.T) {
+	require := require.New(t)
+
+	var received models.IstioConfigMutation
+	allowed := true
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = models.IstioConfigMutation{}
+		require.NoError(json.NewDecoder(r.Body).Decode(&received))
+		_ = json.NewEncoder(w).Encode(models.IstioConfigMutationReview{Allowed: allowed, Reason: "missing change ticket"})
+	}))
+	t.Cleanup(webhook.Close)
+
+	conf := ownershipConfig()
+	conf.MutationWebhook.URL = webhook.URL
+	service := newOwnershipIstioConfigService(t, conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	mutation := models.IstioConfigMutation{
+		Operation: models.IstioConfigMutationUpdate,
+		Cluster:   cluster,
+		Namespace: "bookinfo",
+		ObjectGVK: kubernetes.VirtualServices,
+		Name:      "unowned",
+		User:      "alice",
+	}
+	require.NoError(service.ReviewMutation(context.TODO(), mutation, []byte(`{"spec":{"hosts":["details"]}}`)))
+	require.Equal("alice", received.User)
+	require.NotNil(received.OldObject)
+	require.Equal([]models.IstioConfigFieldDiff{{Path: "spec.hosts[0]", Source: "ratings", Target: "details"}}, received.Diff)
+
+	// The deletion of a missing object is left to the write operation
+	mutation.Operation = models.IstioConfigMutationDelete
+	mutation.Name = "missing"
+	require.NoError(service.ReviewMutation(context.TODO(), mutation, nil))
+	require.Nil(received.OldObject)
+	require.Empty(received.Diff)
+
+	allowed = false
+	err := service.ReviewMutation(context.TODO(), mutation, nil)
+	require.True(api_errors.IsForbidden(err))
+	require.Contains(err.Error(), "missing change ticket")
+}
+
+func TestReviewMutationFailurePolicy(t *testing.T) {
+	require := require.New(t)
+
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(webhook.Close)
+
+	conf := ownershipConfig()
+	conf.MutationWebhook.URL = webhook.URL
+	service := newOwnershipIstioConfigService(t, conf)
+
+	mutation := models.IstioConfigMutation{
+		Operation: models.IstioConfigMutationCreate,
+		Cluster:   conf.KubernetesConfig.ClusterName,
+		Namespace: "bookinfo",
+		ObjectGVK: kubernetes.VirtualServices,
+		Name:      "new",
+	}
+	payload := []byte(`{"metadata":{"name":"new"},"spec":{"hosts":["reviews"]}}`)
+	require.True(api_errors.IsServiceUnavailable(service.ReviewMutation(context.TODO(), mutation, payload)))
+
+	service.config.MutationWebhook.FailOpen = true
+	require.NoError(service.ReviewMutation(context.TODO(), mutation, payload))
+}
+
+func TestReviewMutationIsCancelledWithTheContext(t *testing.T) {
+	require := require.New(t)
+
+	release := make(chan struct{})
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	t.Cleanup(webhook.Close)
+	t.Cleanup(func() { close(release) })
+
+	conf := ownershipConfig()
+	conf.MutationWebhook.URL = webhook.URL
+	conf.MutationWebhook.TimeoutSeconds = 60
+	service := newOwnershipIstioConfigService(t, conf)
+
+	mutation := models.IstioConfigMutation{
+		Operation: models.IstioConfigMutationCreate,
+		Cluster:   conf.KubernetesConfig.ClusterName,
+		Namespace: "bookinfo",
+		ObjectGVK: kubernetes.VirtualServices,
+		Name:      "new",
+	}
+	payload := []byte(`{"metadata":{"name":"new"},"spec":{"hosts":["reviews"]}}`)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	require.Error(service.ReviewMutation(ctx, mutation, payload))
+	require.Less(time.Since(start), 10*time.Second)
+}
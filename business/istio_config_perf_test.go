@@ -0,0 +1,48 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+// BenchmarkGetIstioConfigList lists the Istio config of 50 namespaces with 20 VirtualServices
+// and 20 DestinationRules each. Run it with -benchmem to compare the allocations.
+func BenchmarkGetIstioConfigList(b *testing.B) {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	objects := []runtime.Object{}
+	for i := 0; i < 50; i++ {
+		ns := fmt.Sprintf("bookinfo-%d", i)
+		objects = append(objects, kubetest.FakeNamespace(ns))
+		for j := 0; j < 20; j++ {
+			host := fmt.Sprintf("reviews-%d", j)
+			objects = append(objects,
+				data.CreateEmptyVirtualService(host, ns, []string{host}),
+				data.CreateEmptyDestinationRule(ns, host, host),
+			)
+		}
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	cache := SetupBusinessLayer(b, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	configService := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, businessLayer: NewWithBackends(k8sclients, k8sclients, nil, nil)}
+	criteria := IstioConfigCriteria{IncludeDestinationRules: true, IncludeVirtualServices: true}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := configService.GetIstioConfigList(context.TODO(), conf.KubernetesConfig.ClusterName, criteria); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package business
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// snapshotIDRegexp matches the snapshot IDs, which are also file names.
+var snapshotIDRegexp = regexp.MustCompile(`^[0-9]+-[0-9a-f]{8}$`)
+
+// snapshotResource is the resource reported by the not found errors.
+var snapshotResource = schema.GroupResource{Group: "kiali.io", Resource: "snapshots"}
+
+// SnapshotStore persists the snapshots of the Istio config.
+type SnapshotStore interface {
+	Save(snapshot *models.IstioConfigSnapshot) error
+	// List returns the summary of every snapshot, in no particular order.
+	List() ([]models.IstioConfigSnapshotSummary, error)
+	// Get returns a not found error when the snapshot doesn't exist.
+	Get(id string) (*models.IstioConfigSnapshot, error)
+	Delete(id string) error
+}
+
+// FileSnapshotStore stores each snapshot as a JSON file of a directory.
+type FileSnapshotStore struct {
+	directory string
+}
+
+// NewFileSnapshotStore creates a new FileSnapshotStore. The directory is created on the first save.
+func NewFileSnapshotStore(directory string) *FileSnapshotStore {
+	return &FileSnapshotStore{directory: directory}
+}
+
+func (in *FileSnapshotStore) path(id string) (string, error) {
+	if !snapshotIDRegexp.MatchString(id) {
+		return "", api_errors.NewNotFound(snapshotResource, id)
+	}
+	return filepath.Join(in.directory, id+".json"), nil
+}
+
+func (in *FileSnapshotStore) Save(snapshot *models.IstioConfigSnapshot) error {
+	path, err := in.path(snapshot.ID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(in.directory, 0o750); err != nil {
+		return err
+	}
+	content, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	// Written aside and renamed so that a partial snapshot is never listed
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o640); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func (in *FileSnapshotStore) List() ([]models.IstioConfigSnapshotSummary, error) {
+	entries, err := os.ReadDir(in.directory)
+	if os.IsNotExist(err) {
+		return []models.IstioConfigSnapshotSummary{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := []models.IstioConfigSnapshotSummary{}
+	for _, entry := range entries {
+		id, isSnapshot := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !isSnapshot || !snapshotIDRegexp.MatchString(id) {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(in.directory, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		summary := models.IstioConfigSnapshotSummary{}
+		if err := json.Unmarshal(content, &summary); err != nil {
+			log.Errorf("Skipping invalid snapshot file [%s]: %s", entry.Name(), err)
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+func (in *FileSnapshotStore) Get(id string) (*models.IstioConfigSnapshot, error) {
+	path, err := in.path(id)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, api_errors.NewNotFound(snapshotResource, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &models.IstioConfigSnapshot{}
+	if err := json.Unmarshal(content, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func (in *FileSnapshotStore) Delete(id string) error {
+	path, err := in.path(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// SnapshotService saves the Istio config of the configured namespaces, so that it can be restored,
+// for instance after an accidental bulk deletion.
+type SnapshotService struct {
+	businessLayer *Layer
+	conf          *config.Config
+	store         SnapshotStore
+}
+
+// NewSnapshotService creates a new SnapshotService storing the snapshots in the configured directory.
+func NewSnapshotService(businessLayer *Layer, conf *config.Config) SnapshotService {
+	return SnapshotService{
+		businessLayer: businessLayer,
+		conf:          conf,
+		store:         NewFileSnapshotStore(conf.IstioConfigSnapshots.Directory),
+	}
+}
+
+// TakeSnapshots saves the Istio config of the configured namespaces of every cluster and removes the
+// snapshots beyond the retention limits. Namespaces not found in a cluster are skipped.
+func (in *SnapshotService) TakeSnapshots(ctx context.Context, clusters []string) error {
+	for _, cluster := range clusters {
+		for _, namespace := range in.conf.IstioConfigSnapshots.Namespaces {
+			if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+				log.Debugf("Skipping the snapshot of namespace [%s] of cluster [%s]: %s", namespace, cluster, err)
+				continue
+			}
+			if _, err := in.TakeSnapshot(ctx, cluster, namespace, time.Now()); err != nil {
+				return err
+			}
+		}
+	}
+	return in.applyRetention(time.Now())
+}
+
+// TakeSnapshot saves the Istio config of the namespace.
+func (in *SnapshotService) TakeSnapshot(ctx context.Context, cluster, namespace string, now time.Time) (*models.IstioConfigSnapshotSummary, error) {
+	configList, err := in.businessLayer.IstioConfig.GetIstioConfigListForNamespace(ctx, cluster, namespace, ParseIstioConfigCriteria("", "", ""))
+	if err != nil {
+		return nil, err
+	}
+
+	locationHash := sha256.Sum256([]byte(cluster + "/" + namespace))
+	snapshot := &models.IstioConfigSnapshot{
+		IstioConfigSnapshotSummary: models.IstioConfigSnapshotSummary{
+			ID:        fmt.Sprintf("%d-%s", now.UnixNano(), hex.EncodeToString(locationHash[:4])),
+			Cluster:   cluster,
+			Namespace: namespace,
+			CreatedAt: now,
+		},
+		Objects: []models.IstioConfigSnapshotObject{},
+	}
+	for _, o := range istioConfigObjects(configList) {
+		object, err := snapshotObject(o.object)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Objects = append(snapshot.Objects, models.IstioConfigSnapshotObject{ObjectGVK: o.gvk, Name: o.object.GetName(), Object: object})
+	}
+	snapshot.ObjectCount = len(snapshot.Objects)
+
+	if err := in.store.Save(snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot.IstioConfigSnapshotSummary, nil
+}
+
+// snapshotObject returns the object without its status and the metadata set by the cluster.
+func snapshotObject(object interface{}) (json.RawMessage, error) {
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	generic := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	delete(generic, "status")
+	if metadata, ok := generic["metadata"].(map[string]interface{}); ok {
+		kept := map[string]interface{}{}
+		for _, field := range []string{"name", "labels", "annotations"} {
+			if value, found := metadata[field]; found {
+				kept[field] = value
+			}
+		}
+		generic["metadata"] = kept
+	}
+	return json.Marshal(generic)
+}
+
+// applyRetention removes the snapshots older than the max age and the oldest snapshots of each namespace beyond the max count.
+func (in *SnapshotService) applyRetention(now time.Time) error {
+	var maxAge time.Duration
+	if in.conf.IstioConfigSnapshots.MaxAge != "" {
+		duration, err := model.ParseDuration(in.conf.IstioConfigSnapshots.MaxAge)
+		if err != nil {
+			return err
+		}
+		maxAge = time.Duration(duration)
+	}
+	maxCount := in.conf.IstioConfigSnapshots.MaxCount
+
+	summaries, err := in.store.List()
+	if err != nil {
+		return err
+	}
+	sortSnapshotsNewestFirst(summaries)
+
+	kept := map[string]int{}
+	for _, summary := range summaries {
+		location := summary.Cluster + "/" + summary.Namespace
+		expired := maxAge > 0 && now.Sub(summary.CreatedAt) > maxAge
+		if expired || (maxCount > 0 && kept[location] >= maxCount) {
+			if err := in.store.Delete(summary.ID); err != nil {
+				return err
+			}
+			continue
+		}
+		kept[location]++
+	}
+	return nil
+}
+
+// ListSnapshots returns the snapshots of the namespace, or of every namespace accessible by the user when the namespace
+// is empty, the newest first.
+func (in *SnapshotService) ListSnapshots(ctx context.Context, cluster, namespace string) ([]models.IstioConfigSnapshotSummary, error) {
+	if namespace != "" {
+		if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+			return nil, err
+		}
+	}
+
+	summaries, err := in.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	accessible := map[string]bool{}
+	filtered := []models.IstioConfigSnapshotSummary{}
+	for _, summary := range summaries {
+		if summary.Cluster != cluster || (namespace != "" && summary.Namespace != namespace) {
+			continue
+		}
+		allowed, checked := accessible[summary.Namespace]
+		if !checked {
+			_, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, summary.Namespace, cluster)
+			allowed = err == nil
+			accessible[summary.Namespace] = allowed
+		}
+		if allowed {
+			filtered = append(filtered, summary)
+		}
+	}
+	sortSnapshotsNewestFirst(filtered)
+	return filtered, nil
+}
+
+// GetSnapshot returns the snapshot, when the user can access its namespace.
+func (in *SnapshotService) GetSnapshot(ctx context.Context, id string) (*models.IstioConfigSnapshot, error) {
+	snapshot, err := in.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, snapshot.Namespace, snapshot.Cluster); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// RestoreSnapshot creates again the objects of the snapshot that don't exist anymore, on behalf of the user.
+// Existing objects are left untouched, even when they changed since the snapshot.
+func (in *SnapshotService) RestoreSnapshot(ctx context.Context, id, user string) (*models.IstioConfigSnapshotRestore, error) {
+	snapshot, err := in.GetSnapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := in.businessLayer.IstioConfig.ApplyIstioObjects(ctx, snapshot.Cluster, snapshot.Namespace, snapshot.Objects, user)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.IstioConfigSnapshotRestore{
+		Snapshot: snapshot.IstioConfigSnapshotSummary,
+		Restored: applied.Applied,
+		Skipped:  applied.Skipped,
+	}
+	return result, nil
+}
+
+func sortSnapshotsNewestFirst(summaries []models.IstioConfigSnapshotSummary) {
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].CreatedAt.After(summaries[j].CreatedAt)
+	})
+}
@@ -0,0 +1,137 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.IstioConfigSnapshots.Directory = t.TempDir()
+	conf.IstioConfigSnapshots.Namespaces = []string{"bookinfo", "missing"}
+	config.Set(conf)
+
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+		data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+	)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	ctx := context.TODO()
+	cluster := conf.KubernetesConfig.ClusterName
+	require.NoError(layer.Snapshot.TakeSnapshots(ctx, []string{cluster}))
+
+	snapshots, err := layer.Snapshot.ListSnapshots(ctx, cluster, "")
+	require.NoError(err)
+	require.Len(snapshots, 1)
+	require.Equal("bookinfo", snapshots[0].Namespace)
+	require.Equal(2, snapshots[0].ObjectCount)
+
+	snapshot, err := layer.Snapshot.GetSnapshot(ctx, snapshots[0].ID)
+	require.NoError(err)
+	require.NotContains(string(snapshot.Objects[0].Object), "resourceVersion")
+
+	require.NoError(k8s.Istio().NetworkingV1().DestinationRules("bookinfo").Delete(ctx, "reviews", metav1.DeleteOptions{}))
+
+	result, err := layer.Snapshot.RestoreSnapshot(ctx, snapshots[0].ID, "")
+	require.NoError(err)
+	require.Equal([]models.IstioReference{{ObjectGVK: kubernetes.DestinationRules, Name: "reviews", Namespace: "bookinfo"}}, result.Restored)
+	require.Equal([]models.IstioReference{{ObjectGVK: kubernetes.VirtualServices, Name: "reviews", Namespace: "bookinfo"}}, result.Skipped)
+
+	_, err = k8s.Istio().NetworkingV1().DestinationRules("bookinfo").Get(ctx, "reviews", metav1.GetOptions{})
+	require.NoError(err)
+
+	_, err = layer.Snapshot.GetSnapshot(ctx, "../../etc/passwd")
+	require.Error(err)
+}
+
+func TestSnapshotRetention(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.IstioConfigSnapshots.Directory = t.TempDir()
+	conf.IstioConfigSnapshots.MaxCount = 2
+	conf.IstioConfigSnapshots.MaxAge = "1d"
+	config.Set(conf)
+
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("bookinfo"), kubetest.FakeNamespace("travel"))
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	ctx := context.TODO()
+	cluster := conf.KubernetesConfig.ClusterName
+	now := time.Now()
+	for _, age := range []time.Duration{48 * time.Hour, 3 * time.Hour, 2 * time.Hour, time.Hour} {
+		_, err := layer.Snapshot.TakeSnapshot(ctx, cluster, "bookinfo", now.Add(-age))
+		require.NoError(err)
+	}
+	_, err := layer.Snapshot.TakeSnapshot(ctx, cluster, "travel", now.Add(-3*time.Hour))
+	require.NoError(err)
+
+	require.NoError(layer.Snapshot.applyRetention(now))
+
+	snapshots, err := layer.Snapshot.ListSnapshots(ctx, cluster, "bookinfo")
+	require.NoError(err)
+	require.Len(snapshots, 2)
+	require.True(snapshots[0].CreatedAt.Equal(now.Add(-time.Hour)))
+	require.True(snapshots[1].CreatedAt.Equal(now.Add(-2 * time.Hour)))
+
+	snapshots, err = layer.Snapshot.ListSnapshots(ctx, cluster, "travel")
+	require.NoError(err)
+	require.Len(snapshots, 1)
+}
+
+func TestSnapshotRestoreChecksTeamOwnership(t *testing.T) {
+	require := require.New(t)
+
+	conf := ownershipConfig()
+	conf.IstioConfigSnapshots.Directory = t.TempDir()
+	conf.IstioConfigSnapshots.Namespaces = []string{"bookinfo"}
+	config.Set(conf)
+
+	owned := data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")
+	owned.Labels = map[string]string{conf.Ownership.TeamLabel: "payments"}
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("bookinfo"), owned)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	ctx := context.TODO()
+	cluster := conf.KubernetesConfig.ClusterName
+	require.NoError(layer.Snapshot.TakeSnapshots(ctx, []string{cluster}))
+	snapshots, err := layer.Snapshot.ListSnapshots(ctx, cluster, "bookinfo")
+	require.NoError(err)
+	require.Len(snapshots, 1)
+
+	require.NoError(k8s.Istio().NetworkingV1().DestinationRules("bookinfo").Delete(ctx, "reviews", metav1.DeleteOptions{}))
+
+	// The object is owned by a team bob is not a member of
+	_, err = layer.Snapshot.RestoreSnapshot(ctx, snapshots[0].ID, "bob")
+	require.True(api_errors.IsForbidden(err))
+	_, err = k8s.Istio().NetworkingV1().DestinationRules("bookinfo").Get(ctx, "reviews", metav1.GetOptions{})
+	require.True(api_errors.IsNotFound(err))
+
+	result, err := layer.Snapshot.RestoreSnapshot(ctx, snapshots[0].ID, "alice")
+	require.NoError(err)
+	require.Equal([]models.IstioReference{{ObjectGVK: kubernetes.DestinationRules, Name: "reviews", Namespace: "bookinfo"}}, result.Restored)
+}
@@ -0,0 +1,264 @@
+package business
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// deletedIstioConfigResource is the resource reported by the not found errors.
+var deletedIstioConfigResource = schema.GroupResource{Group: "kiali.io", Resource: "deletedistioconfigs"}
+
+// DeletedIstioConfigStore persists the Istio objects deleted from Kiali while their deletion can be undone.
+type DeletedIstioConfigStore interface {
+	Save(deleted *models.DeletedIstioConfig) error
+	// List returns every deleted object, expired or not, in no particular order.
+	List() ([]models.DeletedIstioConfig, error)
+	// Get returns a not found error when the deleted object doesn't exist.
+	Get(id string) (*models.DeletedIstioConfig, error)
+	Delete(id string) error
+}
+
+// FileDeletedIstioConfigStore stores each deleted object as a JSON file of a directory.
+type FileDeletedIstioConfigStore struct {
+	directory string
+}
+
+// NewFileDeletedIstioConfigStore creates a new FileDeletedIstioConfigStore. The directory is created on the first save.
+func NewFileDeletedIstioConfigStore(directory string) *FileDeletedIstioConfigStore {
+	return &FileDeletedIstioConfigStore{directory: directory}
+}
+
+func (in *FileDeletedIstioConfigStore) path(id string) (string, error) {
+	// The IDs have the format of the snapshot IDs
+	if !snapshotIDRegexp.MatchString(id) {
+		return "", api_errors.NewNotFound(deletedIstioConfigResource, id)
+	}
+	return filepath.Join(in.directory, id+".json"), nil
+}
+
+func (in *FileDeletedIstioConfigStore) Save(deleted *models.DeletedIstioConfig) error {
+	path, err := in.path(deleted.ID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(in.directory, 0o750); err != nil {
+		return err
+	}
+	content, err := json.Marshal(deleted)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o640); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func (in *FileDeletedIstioConfigStore) List() ([]models.DeletedIstioConfig, error) {
+	entries, err := os.ReadDir(in.directory)
+	if os.IsNotExist(err) {
+		return []models.DeletedIstioConfig{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := []models.DeletedIstioConfig{}
+	for _, entry := range entries {
+		id, isDeleted := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !isDeleted || !snapshotIDRegexp.MatchString(id) {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(in.directory, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		d := models.DeletedIstioConfig{}
+		if err := json.Unmarshal(content, &d); err != nil {
+			log.Errorf("Skipping invalid deleted Istio object file [%s]: %s", entry.Name(), err)
+			continue
+		}
+		deleted = append(deleted, d)
+	}
+	return deleted, nil
+}
+
+func (in *FileDeletedIstioConfigStore) Get(id string) (*models.DeletedIstioConfig, error) {
+	path, err := in.path(id)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, api_errors.NewNotFound(deletedIstioConfigResource, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	deleted := &models.DeletedIstioConfig{}
+	if err := json.Unmarshal(content, deleted); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+func (in *FileDeletedIstioConfigStore) Delete(id string) error {
+	path, err := in.path(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (in *IstioConfigService) deletedIstioConfigStore() DeletedIstioConfigStore {
+	return NewFileDeletedIstioConfigStore(in.config.IstioConfigSoftDelete.Directory)
+}
+
+// saveDeletedIstioConfig saves the object about to be deleted, so that its deletion can be undone during the undo window.
+// It returns nil when the object is not found: the deletion reports it.
+func (in *IstioConfigService) saveDeletedIstioConfig(ctx context.Context, kubeCache cache.KubeCache, cluster, namespace string, resourceType schema.GroupVersionKind, name string, now time.Time) (*models.DeletedIstioConfig, error) {
+	undoWindow, err := model.ParseDuration(in.config.IstioConfigSoftDelete.UndoWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	cached, err := getCachedIstioObject(ctx, kubeCache, namespace, resourceType, name)
+	if api_errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	object, err := snapshotObject(cached)
+	if err != nil {
+		return nil, err
+	}
+
+	store := in.deletedIstioConfigStore()
+	if err := removeExpiredDeletedIstioConfig(store, now); err != nil {
+		log.Errorf("Error removing the expired deleted Istio objects: %s", err)
+	}
+
+	objectHash := sha256.Sum256([]byte(cluster + "/" + namespace + "/" + resourceType.String() + "/" + name))
+	deleted := &models.DeletedIstioConfig{
+		IstioConfigSnapshotObject: models.IstioConfigSnapshotObject{ObjectGVK: resourceType, Name: name, Object: object},
+		ID:                        fmt.Sprintf("%d-%s", now.UnixNano(), hex.EncodeToString(objectHash[:4])),
+		Cluster:                   cluster,
+		Namespace:                 namespace,
+		DeletedAt:                 now,
+		ExpiresAt:                 now.Add(time.Duration(undoWindow)),
+	}
+	if err := store.Save(deleted); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// removeExpiredDeletedIstioConfig removes the deleted objects whose undo window expired.
+func removeExpiredDeletedIstioConfig(store DeletedIstioConfigStore, now time.Time) error {
+	deleted, err := store.List()
+	if err != nil {
+		return err
+	}
+	for _, d := range deleted {
+		if now.After(d.ExpiresAt) {
+			if err := store.Delete(d.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ListDeletedIstioConfig returns the objects of the namespace, or of every namespace accessible by the user when the
+// namespace is empty, whose deletion can still be undone, the most recently deleted first.
+func (in *IstioConfigService) ListDeletedIstioConfig(ctx context.Context, cluster, namespace string) ([]models.DeletedIstioConfig, error) {
+	if namespace != "" {
+		if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+			return nil, err
+		}
+	}
+
+	store := in.deletedIstioConfigStore()
+	if err := removeExpiredDeletedIstioConfig(store, time.Now()); err != nil {
+		return nil, err
+	}
+	deleted, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	accessible := map[string]bool{}
+	filtered := []models.DeletedIstioConfig{}
+	for _, d := range deleted {
+		if d.Cluster != cluster || (namespace != "" && d.Namespace != namespace) {
+			continue
+		}
+		allowed, checked := accessible[d.Namespace]
+		if !checked {
+			_, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, d.Namespace, cluster)
+			allowed = err == nil
+			accessible[d.Namespace] = allowed
+		}
+		if allowed {
+			filtered = append(filtered, d)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].DeletedAt.After(filtered[j].DeletedAt)
+	})
+	return filtered, nil
+}
+
+// UndoIstioConfigDelete creates again a deleted object on behalf of the user, while its undo window is not expired.
+// The object must not be owned by a team the user is not a member of. An object created with the same name since the
+// deletion is left untouched.
+func (in *IstioConfigService) UndoIstioConfigDelete(ctx context.Context, id, user string) (*models.DeletedIstioConfigRestore, error) {
+	store := in.deletedIstioConfigStore()
+	deleted, err := store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(deleted.ExpiresAt) {
+		if err := store.Delete(id); err != nil {
+			log.Errorf("Error removing the expired deleted Istio object [%s]: %s", id, err)
+		}
+		return nil, api_errors.NewNotFound(deletedIstioConfigResource, id)
+	}
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, deleted.Namespace, deleted.Cluster); err != nil {
+		return nil, err
+	}
+
+	applied, err := in.ApplyIstioObjects(ctx, deleted.Cluster, deleted.Namespace, []models.IstioConfigSnapshotObject{deleted.IstioConfigSnapshotObject}, user)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.DeletedIstioConfigRestore{Deleted: *deleted, Restored: len(applied.Applied) > 0}
+	// Kept when skipped, so that the deletion can be undone once the new object is removed
+	if result.Restored {
+		if err := store.Delete(id); err != nil {
+			log.Errorf("Error removing the restored Istio object [%s]: %s", id, err)
+		}
+	}
+	return result, nil
+}
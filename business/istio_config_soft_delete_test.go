@@ -0,0 +1,131 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestUndoIstioConfigDelete(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.IstioConfigSoftDelete.Enabled = true
+	conf.IstioConfigSoftDelete.Directory = t.TempDir()
+	config.Set(conf)
+
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+	)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	ctx := context.TODO()
+	cluster := conf.KubernetesConfig.ClusterName
+	require.NoError(layer.IstioConfig.DeleteIstioConfigDetail(ctx, cluster, "bookinfo", kubernetes.VirtualServices, "reviews"))
+	_, err := k8s.Istio().NetworkingV1().VirtualServices("bookinfo").Get(ctx, "reviews", metav1.GetOptions{})
+	require.True(api_errors.IsNotFound(err))
+
+	deleted, err := layer.IstioConfig.ListDeletedIstioConfig(ctx, cluster, "bookinfo")
+	require.NoError(err)
+	require.Len(deleted, 1)
+	require.Equal(kubernetes.VirtualServices, deleted[0].ObjectGVK)
+	require.Equal("reviews", deleted[0].Name)
+	require.Equal(30*time.Minute, deleted[0].ExpiresAt.Sub(deleted[0].DeletedAt))
+	require.NotContains(string(deleted[0].Object), "resourceVersion")
+
+	result, err := layer.IstioConfig.UndoIstioConfigDelete(ctx, deleted[0].ID, "")
+	require.NoError(err)
+	require.True(result.Restored)
+	_, err = k8s.Istio().NetworkingV1().VirtualServices("bookinfo").Get(ctx, "reviews", metav1.GetOptions{})
+	require.NoError(err)
+
+	// A deletion is undone once
+	_, err = layer.IstioConfig.UndoIstioConfigDelete(ctx, deleted[0].ID, "")
+	require.True(api_errors.IsNotFound(err))
+}
+
+func TestUndoIstioConfigDeleteExpired(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.IstioConfigSoftDelete.Enabled = true
+	conf.IstioConfigSoftDelete.Directory = t.TempDir()
+	config.Set(conf)
+
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("bookinfo"))
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	ctx := context.TODO()
+	cluster := conf.KubernetesConfig.ClusterName
+	deletedAt := time.Now().Add(-time.Hour)
+	store := NewFileDeletedIstioConfigStore(conf.IstioConfigSoftDelete.Directory)
+	require.NoError(store.Save(&models.DeletedIstioConfig{
+		IstioConfigSnapshotObject: models.IstioConfigSnapshotObject{ObjectGVK: kubernetes.VirtualServices, Name: "reviews", Object: []byte(`{}`)},
+		ID:                        "1-0a0b0c0d",
+		Cluster:                   cluster,
+		Namespace:                 "bookinfo",
+		DeletedAt:                 deletedAt,
+		ExpiresAt:                 deletedAt.Add(30 * time.Minute),
+	}))
+
+	_, err := layer.IstioConfig.UndoIstioConfigDelete(ctx, "1-0a0b0c0d", "")
+	require.True(api_errors.IsNotFound(err))
+
+	deleted, err := layer.IstioConfig.ListDeletedIstioConfig(ctx, cluster, "")
+	require.NoError(err)
+	require.Empty(deleted)
+
+	_, err = layer.IstioConfig.UndoIstioConfigDelete(ctx, "../../etc/passwd", "")
+	require.Error(err)
+}
+
+func TestUndoIstioConfigDeleteChecksTeamOwnership(t *testing.T) {
+	require := require.New(t)
+
+	conf := ownershipConfig()
+	conf.IstioConfigSoftDelete.Enabled = true
+	conf.IstioConfigSoftDelete.Directory = t.TempDir()
+	config.Set(conf)
+
+	owned := data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})
+	owned.Labels = map[string]string{conf.Ownership.TeamLabel: "payments"}
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("bookinfo"), owned)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	ctx := context.TODO()
+	cluster := conf.KubernetesConfig.ClusterName
+	require.NoError(layer.IstioConfig.DeleteIstioConfigDetail(ctx, cluster, "bookinfo", kubernetes.VirtualServices, "reviews"))
+	deleted, err := layer.IstioConfig.ListDeletedIstioConfig(ctx, cluster, "bookinfo")
+	require.NoError(err)
+	require.Len(deleted, 1)
+
+	// The object is owned by a team bob is not a member of
+	_, err = layer.IstioConfig.UndoIstioConfigDelete(ctx, deleted[0].ID, "bob")
+	require.True(api_errors.IsForbidden(err))
+	_, err = k8s.Istio().NetworkingV1().VirtualServices("bookinfo").Get(ctx, "reviews", metav1.GetOptions{})
+	require.True(api_errors.IsNotFound(err))
+
+	result, err := layer.IstioConfig.UndoIstioConfigDelete(ctx, deleted[0].ID, "alice")
+	require.NoError(err)
+	require.True(result.Restored)
+}
@@ -0,0 +1,136 @@
+package business
+
+import (
+	"context"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// IstioConfigStreamFunc receives the objects of a type of an Istio config list as soon as they are fetched.
+// It is called concurrently for the different types.
+type IstioConfigStreamFunc func(gvk schema.GroupVersionKind, objects []runtime.Object) error
+
+// StreamIstioConfigList is like GetIstioConfigListForNamespace, or like GetIstioConfigList when the namespace is
+// empty, but the objects are passed to emit type by type as the parallel fetches complete, instead of being
+// gathered in a list. The objects of a type are released once emitted.
+func (in *IstioConfigService) StreamIstioConfigList(ctx context.Context, cluster, namespace string, criteria IstioConfigCriteria, emit IstioConfigStreamFunc) error {
+	var namespaceSet map[string]bool
+	if namespace != meta_v1.NamespaceAll {
+		// Same access check as GetIstioConfigListForNamespace
+		if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+			if (api_errors.IsNotFound(err) || api_errors.IsForbidden(err)) && len(in.userClients) > 1 {
+				return nil
+			}
+			return err
+		}
+	} else {
+		namespaces, err := in.businessLayer.Namespace.GetClusterNamespaces(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		namespaceSet = make(map[string]bool, len(namespaces))
+		for _, ns := range namespaces {
+			namespaceSet[ns.Name] = true
+		}
+	}
+
+	var teamSet map[string]bool
+	if criteria.FilterByTeams && in.config.Ownership.Enabled {
+		teamSet = make(map[string]bool, len(criteria.Teams))
+		for _, team := range criteria.Teams {
+			teamSet[team] = true
+		}
+	}
+	teamLabel := in.config.Ownership.TeamLabel
+
+	_, err := in.getIstioConfigList(ctx, cluster, namespace, criteria, func(gvk schema.GroupVersionKind, list *models.IstioConfigList) error {
+		objects := takeIstioObjects(list, gvk)
+		filtered := objects[:0]
+		for _, obj := range objects {
+			o, err := meta.Accessor(obj)
+			if err != nil {
+				return err
+			}
+			if namespaceSet != nil && !namespaceSet[o.GetNamespace()] {
+				continue
+			}
+			if teamSet != nil {
+				if team, ok := o.GetLabels()[teamLabel]; !ok || !teamSet[team] {
+					continue
+				}
+			}
+			if criteria.HelmRelease != "" && o.GetAnnotations()[models.HelmReleaseNameAnnotation] != criteria.HelmRelease {
+				continue
+			}
+			filtered = append(filtered, obj)
+		}
+		if len(filtered) == 0 {
+			return nil
+		}
+		return emit(gvk, filtered)
+	})
+	return err
+}
+
+// takeIstioObjects returns the objects of the type set in the list, and removes them from the list.
+func takeIstioObjects(list *models.IstioConfigList, gvk schema.GroupVersionKind) []runtime.Object {
+	switch gvk {
+	case kubernetes.AuthorizationPolicies:
+		return takeObjects(&list.AuthorizationPolicies)
+	case kubernetes.DestinationRules:
+		return takeObjects(&list.DestinationRules)
+	case kubernetes.EnvoyFilters:
+		return takeObjects(&list.EnvoyFilters)
+	case kubernetes.Gateways:
+		return takeObjects(&list.Gateways)
+	case kubernetes.K8sGateways:
+		return takeObjects(&list.K8sGateways)
+	case kubernetes.K8sGRPCRoutes:
+		return takeObjects(&list.K8sGRPCRoutes)
+	case kubernetes.K8sHTTPRoutes:
+		return takeObjects(&list.K8sHTTPRoutes)
+	case kubernetes.K8sReferenceGrants:
+		return takeObjects(&list.K8sReferenceGrants)
+	case kubernetes.K8sTCPRoutes:
+		return takeObjects(&list.K8sTCPRoutes)
+	case kubernetes.K8sTLSRoutes:
+		return takeObjects(&list.K8sTLSRoutes)
+	case kubernetes.PeerAuthentications:
+		return takeObjects(&list.PeerAuthentications)
+	case kubernetes.ProxyConfigs:
+		return takeObjects(&list.ProxyConfigs)
+	case kubernetes.RequestAuthentications:
+		return takeObjects(&list.RequestAuthentications)
+	case kubernetes.ServiceEntries:
+		return takeObjects(&list.ServiceEntries)
+	case kubernetes.Sidecars:
+		return takeObjects(&list.Sidecars)
+	case kubernetes.Telemetries:
+		return takeObjects(&list.Telemetries)
+	case kubernetes.VirtualServices:
+		return takeObjects(&list.VirtualServices)
+	case kubernetes.WasmPlugins:
+		return takeObjects(&list.WasmPlugins)
+	case kubernetes.WorkloadEntries:
+		return takeObjects(&list.WorkloadEntries)
+	case kubernetes.WorkloadGroups:
+		return takeObjects(&list.WorkloadGroups)
+	}
+	return []runtime.Object{}
+}
+
+func takeObjects[T runtime.Object](objects *[]T) []runtime.Object {
+	taken := make([]runtime.Object, 0, len(*objects))
+	for _, o := range *objects {
+		taken = append(taken, o)
+	}
+	*objects = nil
+	return taken
+}
@@ -0,0 +1,67 @@
+package business
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+)
+
+func TestStreamIstioConfigList(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	criteria := IstioConfigCriteria{
+		IncludeGateways:         true,
+		IncludeVirtualServices:  true,
+		IncludeDestinationRules: true,
+		IncludeServiceEntries:   true,
+	}
+
+	for _, namespace := range []string{meta_v1.NamespaceAll, "test"} {
+		configService := mockGetIstioConfigList(t)
+
+		var mu sync.Mutex
+		names := map[schema.GroupVersionKind][]string{}
+		err := configService.StreamIstioConfigList(context.TODO(), cluster, namespace, criteria, func(gvk schema.GroupVersionKind, objects []runtime.Object) error {
+			mu.Lock()
+			defer mu.Unlock()
+			require.NotContains(names, gvk, "a type is emitted once")
+			for _, o := range objects {
+				names[gvk] = append(names[gvk], o.(meta_v1.Object).GetName())
+			}
+			return nil
+		})
+		require.NoError(err)
+		require.Len(names, 4)
+		require.ElementsMatch([]string{"gw-1", "gw-2"}, names[kubernetes.Gateways])
+		require.Len(names[kubernetes.VirtualServices], 2)
+		require.Len(names[kubernetes.DestinationRules], 2)
+		require.Len(names[kubernetes.ServiceEntries], 1)
+	}
+}
+
+func TestStreamIstioConfigListError(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	configService := mockGetIstioConfigList(t)
+	criteria := IstioConfigCriteria{IncludeGateways: true, IncludeVirtualServices: true}
+	emitErr := errors.New("client gone")
+
+	err := configService.StreamIstioConfigList(context.TODO(), conf.KubernetesConfig.ClusterName, "test", criteria, func(gvk schema.GroupVersionKind, objects []runtime.Object) error {
+		return emitErr
+	})
+	require.ErrorIs(err, emitErr)
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	api_networking_v1 "istio.io/api/networking/v1"
+	api_networking_v1beta1 "istio.io/api/networking/v1beta1"
+	api_type_v1beta1 "istio.io/api/type/v1beta1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	auth_v1 "k8s.io/api/authorization/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -161,6 +164,39 @@ func TestGetIstioConfigList(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestGetIstioConfigListParallelism(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	criteria := IstioConfigCriteria{
+		IncludeGateways:         true,
+		IncludeVirtualServices:  true,
+		IncludeDestinationRules: true,
+		IncludeServiceEntries:   true,
+	}
+
+	for _, parallelism := range []int{0, 1, 4} {
+		configService := mockGetIstioConfigList(t)
+		configService.config.KubernetesConfig.ListParallelism = parallelism
+
+		istioconfigList, err := configService.GetIstioConfigList(context.TODO(), cluster, criteria)
+		require.NoError(err)
+		require.Len(istioconfigList.Gateways, 2)
+		require.Len(istioconfigList.VirtualServices, 2)
+		require.Len(istioconfigList.DestinationRules, 2)
+		require.Len(istioconfigList.ServiceEntries, 1)
+	}
+
+	// A cancelled request doesn't fetch anything
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	configService := mockGetIstioConfigList(t)
+	_, err := configService.getIstioConfigList(ctx, cluster, meta_v1.NamespaceAll, criteria, nil)
+	require.ErrorIs(err, context.Canceled)
+}
+
 func TestGetIstioConfigDetails(t *testing.T) {
 	assert := assert.New(t)
 
@@ -518,6 +554,50 @@ func TestDeleteIstioConfigDetails(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestProxyConfigDetails(t *testing.T) {
+	require := require.New(t)
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("test"),
+		&networking_v1beta1.ProxyConfig{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "mesh-concurrency", Namespace: "test"},
+			Spec:       api_networking_v1beta1.ProxyConfig{Concurrency: &wrappers.Int32Value{Value: 2}},
+		},
+		&networking_v1beta1.ProxyConfig{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "reviews-concurrency", Namespace: "test"},
+			Spec: api_networking_v1beta1.ProxyConfig{
+				Selector:    &api_type_v1beta1.WorkloadSelector{MatchLabels: map[string]string{"app": "reviews"}},
+				Concurrency: &wrappers.Int32Value{Value: 0},
+			},
+		},
+	)
+	conf := config.NewConfig()
+	config.Set(conf)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := make(map[string]kubernetes.ClientInterface)
+	k8sclients[conf.KubernetesConfig.ClusterName] = k8s
+
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+	configService := IstioConfigService{userClients: k8sclients, kialiCache: cache, controlPlaneMonitor: poller, businessLayer: layer}
+
+	istioConfigList, err := configService.GetIstioConfigList(context.TODO(), conf.KubernetesConfig.ClusterName, IstioConfigCriteria{IncludeProxyConfigs: true})
+	require.NoError(err)
+	require.Len(istioConfigList.ProxyConfigs, 2)
+
+	istioConfigList, err = configService.GetIstioConfigList(context.TODO(), conf.KubernetesConfig.ClusterName, IstioConfigCriteria{IncludeProxyConfigs: true, WorkloadSelector: "app=details"})
+	require.NoError(err)
+	require.Len(istioConfigList.ProxyConfigs, 1)
+	require.Equal("mesh-concurrency", istioConfigList.ProxyConfigs[0].Name)
+
+	istioConfigDetails, err := configService.GetIstioConfigDetails(context.TODO(), conf.KubernetesConfig.ClusterName, "test", kubernetes.ProxyConfigs, "reviews-concurrency")
+	require.NoError(err)
+	require.Equal("reviews-concurrency", istioConfigDetails.ProxyConfig.Name)
+	require.Equal("ProxyConfig", istioConfigDetails.ProxyConfig.Kind)
+	require.Equal("networking.istio.io/v1beta1", istioConfigDetails.ProxyConfig.APIVersion)
+
+	require.NoError(configService.DeleteIstioConfigDetail(context.TODO(), conf.KubernetesConfig.ClusterName, "test", kubernetes.ProxyConfigs, "reviews-concurrency"))
+}
+
 func TestUpdateIstioConfigDetails(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -561,6 +641,51 @@ func TestCreateIstioConfigDetails(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestSidecarIstioConfig(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.KubernetesConfig.ClusterName = config.DefaultClusterID
+	config.Set(conf)
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("test"),
+		data.CreateSidecar("default", "test"),
+	)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	k8sclients := map[string]kubernetes.ClientInterface{cluster: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+	configService := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, controlPlaneMonitor: poller, businessLayer: layer}
+
+	criteria := ParseIstioConfigCriteria(kubernetes.Sidecars.String(), "", "")
+	require.True(criteria.IncludeSidecars)
+	require.False(criteria.IncludeVirtualServices)
+
+	istioConfigList, err := configService.GetIstioConfigListForNamespace(context.TODO(), cluster, "test", criteria)
+	require.NoError(err)
+	require.Len(istioConfigList.Sidecars, 1)
+	require.Empty(istioConfigList.VirtualServices)
+
+	details, err := configService.GetIstioConfigDetails(context.TODO(), cluster, "test", kubernetes.Sidecars, "default")
+	require.NoError(err)
+	require.Equal("default", details.Sidecar.Name)
+	require.Equal("Sidecar", details.Sidecar.Kind)
+	require.Equal("networking.istio.io/v1", details.Sidecar.APIVersion)
+
+	created, err := configService.CreateIstioConfigDetail(context.TODO(), cluster, "test", kubernetes.Sidecars, []byte(`{"metadata":{"name":"other","namespace":"test"}}`))
+	require.NoError(err)
+	require.Equal("other", created.Sidecar.Name)
+
+	// Both Sidecars without workload selector apply to every workload of the namespace
+	validations, _, err := layer.Validations.GetIstioObjectValidations(context.TODO(), cluster, "test", kubernetes.Sidecars, "other")
+	require.NoError(err)
+	validation := validations[models.IstioValidationKey{ObjectGVK: kubernetes.Sidecars, Namespace: "test", Name: "other", Cluster: cluster}]
+	require.NotNil(validation)
+	require.Len(validation.Checks, 1)
+	require.Equal("spec/workloadSelector", validation.Checks[0].Path)
+}
+
 func TestFilterIstioObjectsForWorkloadSelector(t *testing.T) {
 	assert := assert.New(t)
 
@@ -621,7 +746,7 @@ func TestListWithAllNamespacesButNoAccessReturnsEmpty(t *testing.T) {
 	// the token namespace cache will be used but will not have the "test" namespace
 	// in it so the list should return empty.
 	k8s.Token = "test"
-	cache.SetNamespaces("test", []models.Namespace{{Name: "nottest", Cluster: "Kubernetes"}})
+	cache.SetNamespaces(k8s.GetIdentity(), []models.Namespace{{Name: "nottest", Cluster: "Kubernetes"}})
 	k8sclients := make(map[string]kubernetes.ClientInterface)
 	k8sclients[conf.KubernetesConfig.ClusterName] = k8s
 	configService := NewWithBackends(k8sclients, k8sclients, nil, nil).IstioConfig
@@ -686,3 +811,82 @@ deployment:
 
 	assert.Len(istioConfigList.Gateways, 4)
 }
+
+func TestGetIstioConfigListForNamespaces(t *testing.T) {
+	require := require.New(t)
+
+	conf := testutils.GetConfigFromYaml(t, `
+kubernetes_config:
+  cache_token_namespace_duration: 10000
+  list_parallelism: 1
+deployment:
+  cluster_wide_access: false
+  discovery_selectors:
+    default:
+    - matchLabels: {"kubernetes.io/metadata.name": "test" }
+    - matchLabels: {"kubernetes.io/metadata.name": "test-b" }
+`)
+	kubernetes.SetConfig(t, *conf)
+	objects := []runtime.Object{
+		kubetest.FakeNamespace("test"),
+		kubetest.FakeNamespace("test-b"),
+		kubetest.FakeNamespace("test-c"),
+	}
+	objects = append(objects, kubernetes.ToRuntimeObjects(fakeGetGateways())...)
+	for _, namespace := range []string{"test-b", "test-c"} {
+		for _, gateway := range fakeGetGateways()[:1] {
+			gateway.Namespace = namespace
+			objects = append(objects, gateway)
+		}
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	configService := NewWithBackends(k8sclients, k8sclients, nil, nil).IstioConfig
+	criteria := IstioConfigCriteria{IncludeGateways: true}
+
+	istioConfigs, err := configService.GetIstioConfigListForNamespaces(context.Background(), conf.KubernetesConfig.ClusterName, nil, criteria)
+	require.NoError(err)
+	require.Len(istioConfigs, 2)
+	require.Len(istioConfigs["test"].List.Gateways, 2)
+	require.Len(istioConfigs["test-b"].List.Gateways, 1)
+
+	istioConfigs, err = configService.GetIstioConfigListForNamespaces(context.Background(), conf.KubernetesConfig.ClusterName, []string{"test-b"}, criteria)
+	require.NoError(err)
+	require.Len(istioConfigs, 1)
+	require.Len(istioConfigs["test-b"].List.Gateways, 1)
+
+	// An inaccessible namespace holds its error, the others their list
+	istioConfigs, err = configService.GetIstioConfigListForNamespaces(context.Background(), conf.KubernetesConfig.ClusterName, []string{"test", "test-c"}, criteria)
+	require.NoError(err)
+	require.Len(istioConfigs, 2)
+	require.Empty(istioConfigs["test"].Error)
+	require.Len(istioConfigs["test"].List.Gateways, 2)
+	require.NotEmpty(istioConfigs["test-c"].Error)
+	require.Nil(istioConfigs["test-c"].List)
+}
+
+func TestIstioConfigPermissionsAreCached(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("bookinfo"))
+	k8s.Token = "token"
+	cache := SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	permissions := layer.IstioConfig.GetIstioConfigPermissions(context.TODO(), []string{"bookinfo"}, conf.KubernetesConfig.ClusterName)
+	require.Contains(permissions, "bookinfo")
+
+	cached, found := cache.GetPermissions(conf.KubernetesConfig.ClusterName, k8s.GetIdentity(), "bookinfo")
+	require.True(found)
+	require.Equal(permissions["bookinfo"], cached)
+
+	cache.RefreshTokenPermissions(conf.KubernetesConfig.ClusterName)
+	_, found = cache.GetPermissions(conf.KubernetesConfig.ClusterName, k8s.GetIdentity(), "bookinfo")
+	require.False(found)
+}
@@ -0,0 +1,175 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// kialiWizardLabel is the label set by the Kiali wizards on the objects they create, its value is the wizard scenario.
+const kialiWizardLabel = "kiali_wizard"
+
+// GetWizardGroup returns the objects created together with the given object by the same Kiali wizard: the
+// VirtualServices and DestinationRules of the same service hosts labeled with the same scenario and the labeled
+// Gateways those VirtualServices are bound to. An object not created by a wizard is returned alone.
+// VirtualServices are returned first, as they reference the DestinationRules and the Gateways of the group.
+func (in *IstioConfigService) GetWizardGroup(ctx context.Context, cluster, namespace string, resourceType schema.GroupVersionKind, name string) ([]models.IstioReference, error) {
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := getCachedIstioObject(ctx, kubeCache, namespace, resourceType, name)
+	if err != nil {
+		return nil, err
+	}
+	self := models.IstioReference{ObjectGVK: resourceType, Name: name, Namespace: namespace}
+
+	scenario, ok := object.GetLabels()[kialiWizardLabel]
+	if !ok || (resourceType != kubernetes.VirtualServices && resourceType != kubernetes.DestinationRules && resourceType != kubernetes.Gateways) {
+		return []models.IstioReference{self}, nil
+	}
+
+	selector := kialiWizardLabel + "=" + scenario
+	vss, err := kubeCache.GetVirtualServices(ctx, namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	drs, err := kubeCache.GetDestinationRules(ctx, namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+	gws, err := kubeCache.GetGateways(ctx, namespace, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	// The service hosts the group is built around
+	hosts := map[string]bool{}
+	switch resourceType {
+	case kubernetes.VirtualServices:
+		for _, vs := range vss {
+			if vs.Name == name {
+				for _, host := range vs.Spec.Hosts {
+					hosts[resolveHostFQDN(host, namespace)] = true
+				}
+			}
+		}
+	case kubernetes.DestinationRules:
+		for _, dr := range drs {
+			if dr.Name == name {
+				hosts[resolveHostFQDN(dr.Spec.Host, namespace)] = true
+			}
+		}
+	case kubernetes.Gateways:
+		for _, vs := range vss {
+			if vsBoundToGateway(vs.Spec.Gateways, vs.Namespace, namespace, name) {
+				for _, host := range vs.Spec.Hosts {
+					hosts[resolveHostFQDN(host, namespace)] = true
+				}
+			}
+		}
+	}
+
+	group := []models.IstioReference{}
+	boundGateways := map[string]bool{}
+	for _, vs := range vss {
+		matches := false
+		for _, host := range vs.Spec.Hosts {
+			if hosts[resolveHostFQDN(host, namespace)] {
+				matches = true
+			}
+		}
+		if !matches {
+			continue
+		}
+		group = append(group, models.IstioReference{ObjectGVK: kubernetes.VirtualServices, Name: vs.Name, Namespace: namespace})
+		for _, gw := range gws {
+			if vsBoundToGateway(vs.Spec.Gateways, vs.Namespace, gw.Namespace, gw.Name) {
+				boundGateways[gw.Name] = true
+			}
+		}
+	}
+	for _, dr := range drs {
+		if hosts[resolveHostFQDN(dr.Spec.Host, namespace)] {
+			group = append(group, models.IstioReference{ObjectGVK: kubernetes.DestinationRules, Name: dr.Name, Namespace: namespace})
+		}
+	}
+	for _, gw := range gws {
+		if boundGateways[gw.Name] || (resourceType == kubernetes.Gateways && gw.Name == name) {
+			group = append(group, models.IstioReference{ObjectGVK: kubernetes.Gateways, Name: gw.Name, Namespace: namespace})
+		}
+	}
+
+	for _, ref := range group {
+		if ref == self {
+			return group, nil
+		}
+	}
+	return append(group, self), nil
+}
+
+// vsBoundToGateway returns true when the VirtualService gateways reference the given Gateway.
+func vsBoundToGateway(gateways []string, vsNamespace, gwNamespace, gwName string) bool {
+	for _, gateway := range gateways {
+		gwRef := gateway
+		if !strings.Contains(gwRef, "/") {
+			gwRef = vsNamespace + "/" + gwRef
+		}
+		if gwRef == gwNamespace+"/"+gwName {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteWizardGroup deletes the given objects as a whole. The user must be allowed to delete all of them before any
+// is deleted, and when a delete fails the objects already deleted are created again, so no remnant of the group is left.
+func (in *IstioConfigService) DeleteWizardGroup(ctx context.Context, cluster string, group []models.IstioReference) error {
+	userClient, ok := in.userClients[cluster]
+	if !ok {
+		return fmt.Errorf("K8s Client [%s] is not found or is not accessible for Kiali", cluster)
+	}
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return err
+	}
+
+	backups := make([][]byte, len(group))
+	for i, ref := range group {
+		if _, _, canDelete := getPermissions(ctx, userClient, cluster, ref.Namespace, ref.ObjectGVK); !canDelete {
+			return api_errors.NewForbidden(schema.GroupResource{Group: ref.ObjectGVK.Group, Resource: ref.ObjectGVK.Kind}, ref.Name, fmt.Errorf("user is not allowed to delete the objects of the group"))
+		}
+		object, err := getCachedIstioObject(ctx, kubeCache, ref.Namespace, ref.ObjectGVK, ref.Name)
+		if err != nil {
+			return err
+		}
+		if backups[i], err = snapshotObject(object); err != nil {
+			return err
+		}
+	}
+
+	for i, ref := range group {
+		if err := in.DeleteIstioConfigDetail(ctx, cluster, ref.Namespace, ref.ObjectGVK, ref.Name); err != nil {
+			in.restoreWizardGroup(ctx, cluster, group[:i], backups[:i])
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreWizardGroup creates again the objects of a group whose delete failed halfway.
+func (in *IstioConfigService) restoreWizardGroup(ctx context.Context, cluster string, deleted []models.IstioReference, backups [][]byte) {
+	for i, ref := range deleted {
+		if _, err := in.CreateIstioConfigDetail(ctx, cluster, ref.Namespace, ref.ObjectGVK, backups[i]); err != nil {
+			log.Errorf("Error restoring %s [%s/%s] after a failed group delete: %s", ref.ObjectGVK.Kind, ref.Namespace, ref.Name, err)
+		}
+	}
+}
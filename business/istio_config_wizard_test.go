@@ -0,0 +1,113 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	auth_v1 "k8s.io/api/authorization/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	kubeclienttesting "k8s.io/client-go/testing"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func fakeWizardObjects() []runtime.Object {
+	labeled := func(object meta_v1.Object) runtime.Object {
+		object.SetLabels(map[string]string{kialiWizardLabel: "request_routing"})
+		return object.(runtime.Object)
+	}
+	return []runtime.Object{
+		kubetest.FakeNamespace("bookinfo"),
+		labeled(data.CreateEmptyGateway("reviews-gateway", "bookinfo", map[string]string{"istio": "ingressgateway"})),
+		labeled(data.AddGatewaysToVirtualService([]string{"reviews-gateway"}, data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}))),
+		labeled(data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews.bookinfo.svc.cluster.local")),
+		labeled(data.CreateEmptyVirtualService("ratings", "bookinfo", []string{"ratings"})),
+		labeled(data.CreateEmptyDestinationRule("bookinfo", "ratings", "ratings")),
+		data.CreateEmptyDestinationRule("bookinfo", "details", "details"),
+	}
+}
+
+func TestGetWizardGroup(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	kubernetes.SetConfig(t, *conf)
+
+	k8s := kubetest.NewFakeK8sClient(fakeWizardObjects()...)
+	SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	reviewsGroup := []models.IstioReference{
+		{ObjectGVK: kubernetes.VirtualServices, Namespace: "bookinfo", Name: "reviews"},
+		{ObjectGVK: kubernetes.DestinationRules, Namespace: "bookinfo", Name: "reviews"},
+		{ObjectGVK: kubernetes.Gateways, Namespace: "bookinfo", Name: "reviews-gateway"},
+	}
+	for _, ref := range reviewsGroup {
+		group, err := layer.IstioConfig.GetWizardGroup(context.TODO(), conf.KubernetesConfig.ClusterName, ref.Namespace, ref.ObjectGVK, ref.Name)
+		require.NoError(err)
+		require.Equal(reviewsGroup, group)
+	}
+
+	group, err := layer.IstioConfig.GetWizardGroup(context.TODO(), conf.KubernetesConfig.ClusterName, "bookinfo", kubernetes.DestinationRules, "ratings")
+	require.NoError(err)
+	require.Equal([]models.IstioReference{
+		{ObjectGVK: kubernetes.VirtualServices, Namespace: "bookinfo", Name: "ratings"},
+		{ObjectGVK: kubernetes.DestinationRules, Namespace: "bookinfo", Name: "ratings"},
+	}, group)
+
+	// Objects not created by a wizard are not grouped
+	group, err = layer.IstioConfig.GetWizardGroup(context.TODO(), conf.KubernetesConfig.ClusterName, "bookinfo", kubernetes.DestinationRules, "details")
+	require.NoError(err)
+	require.Equal([]models.IstioReference{{ObjectGVK: kubernetes.DestinationRules, Namespace: "bookinfo", Name: "details"}}, group)
+}
+
+func TestDeleteWizardGroup(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	kubernetes.SetConfig(t, *conf)
+
+	k8s := kubetest.NewFakeK8sClient(fakeWizardObjects()...)
+	SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	group, err := layer.IstioConfig.GetWizardGroup(context.TODO(), cluster, "bookinfo", kubernetes.VirtualServices, "reviews")
+	require.NoError(err)
+
+	// Nothing is deleted when the user can't delete the whole group
+	err = layer.IstioConfig.DeleteWizardGroup(context.TODO(), cluster, group)
+	require.True(api_errors.IsForbidden(err))
+	_, err = k8s.Istio().NetworkingV1().Gateways("bookinfo").Get(context.TODO(), "reviews-gateway", meta_v1.GetOptions{})
+	require.NoError(err)
+
+	k8s.KubeClientset.(*kubefake.Clientset).PrependReactor("create", "selfsubjectaccessreviews", func(action kubeclienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(kubeclienttesting.CreateAction).GetObject().(*auth_v1.SelfSubjectAccessReview)
+		review.Status.Allowed = true
+		return true, review, nil
+	})
+
+	require.NoError(layer.IstioConfig.DeleteWizardGroup(context.TODO(), cluster, group))
+	_, err = k8s.Istio().NetworkingV1().VirtualServices("bookinfo").Get(context.TODO(), "reviews", meta_v1.GetOptions{})
+	require.True(api_errors.IsNotFound(err))
+	_, err = k8s.Istio().NetworkingV1().DestinationRules("bookinfo").Get(context.TODO(), "reviews", meta_v1.GetOptions{})
+	require.True(api_errors.IsNotFound(err))
+	_, err = k8s.Istio().NetworkingV1().Gateways("bookinfo").Get(context.TODO(), "reviews-gateway", meta_v1.GetOptions{})
+	require.True(api_errors.IsNotFound(err))
+
+	// The objects of other services are kept
+	_, err = k8s.Istio().NetworkingV1().VirtualServices("bookinfo").Get(context.TODO(), "ratings", meta_v1.GetOptions{})
+	require.NoError(err)
+}
@@ -6,11 +6,17 @@ import (
 	"strings"
 	"sync"
 
+	extentions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1 "istio.io/client-go/pkg/apis/security/v1"
+	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
 
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8s_networking_v1 "sigs.k8s.io/gateway-api/apis/v1"
+	k8s_networking_v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/kiali/kiali/business/checkers"
 	"github.com/kiali/kiali/business/references"
@@ -103,7 +109,15 @@ func (in *IstioValidationsService) GetValidationsForService(ctx context.Context,
 		return nil, fmt.Errorf("Service [namespace: %s] [name: %s] doesn't exist for Validations.", namespace, service)
 	}
 
-	return models.IstioValidations(validationsForCluster(in.kialiCache.Validations().Items(), cluster)).FilterBySingleType(schema.GroupVersionKind{Group: "", Version: "", Kind: "service"}, service), nil
+	validations := models.IstioValidations(validationsForCluster(in.kialiCache.Validations().Items(), cluster)).FilterBySingleType(schema.GroupVersionKind{Group: "", Version: "", Kind: "service"}, service)
+
+	// The OpenShift Routes are not part of the Istio config validated in the background
+	routeValidations, err := in.service.getServiceRouteValidations(ctx, cluster, namespace, service)
+	if err != nil {
+		log.Warningf("Error validating the Routes of Service %s: %s", service, err)
+		return validations, nil
+	}
+	return validations.MergeValidations(routeValidations), nil
 }
 
 func (in *IstioValidationsService) GetValidationsForWorkload(ctx context.Context, cluster, namespace, workload string) (models.IstioValidations, error) {
@@ -123,6 +137,12 @@ func (in *IstioValidationsService) GetValidationsForWorkload(ctx context.Context
 // all the enabled checkers. If service is "" then the whole namespace is validated.
 // If service is not empty string, then all of its associated Istio objects are validated.
 func (in *IstioValidationsService) CreateValidations(ctx context.Context, cluster string) (models.IstioValidations, error) {
+	return in.CreateNamespacesValidations(ctx, cluster, nil)
+}
+
+// CreateNamespacesValidations is like CreateValidations, only validating the Istio config of the namespaces
+// included by the filter. The other namespaces are still used by the cross namespace checks.
+func (in *IstioValidationsService) CreateNamespacesValidations(ctx context.Context, cluster string, filter NamespaceFilter) (models.IstioValidations, error) {
 	var end observability.EndFunc
 	ctx, end = observability.StartSpan(ctx, "getValidations",
 		observability.Attribute("package", "business"),
@@ -154,24 +174,39 @@ func (in *IstioValidationsService) CreateValidations(ctx context.Context, cluste
 	validations := models.IstioValidations{}
 
 	for _, namespace := range namespaces {
-		var istioConfigs models.IstioConfigList
-		var mtlsDetails kubernetes.MTLSDetails
-		var rbacDetails kubernetes.RBACDetails
-		err = in.fetchIstioConfigList(ctx, &istioConfigs, &mtlsDetails, &rbacDetails, cluster, namespace.Name)
-		if err != nil {
-			return nil, err
+		if !filter.Includes(cluster, namespace.Name) {
+			continue
 		}
-		if err := in.fetchNonLocalmTLSConfigs(&mtlsDetails, cluster); err != nil {
+		namespaceValidations, err := in.validateNamespace(ctx, cluster, namespace.Name, namespaces, workloadsPerNamespace, registryServices, serviceAccounts)
+		if err != nil {
 			return nil, err
 		}
+		validations.MergeValidations(namespaceValidations)
+	}
 
-		objectCheckers := in.getAllObjectCheckers(istioConfigs, workloadsPerNamespace, mtlsDetails, rbacDetails, namespaces, registryServices, cluster, serviceAccounts)
+	return validations, nil
+}
 
-		// Get group validations for same kind istio objects
-		validations.MergeValidations(runObjectCheckers(objectCheckers))
+// validateNamespace validates the Istio config of a namespace, with the workloads, registry services and service
+// accounts fetched once for all the namespaces.
+func (in *IstioValidationsService) validateNamespace(ctx context.Context, cluster, namespace string, namespaces models.Namespaces, workloadsPerNamespace map[string]models.WorkloadList, registryServices []*kubernetes.RegistryService, serviceAccounts map[string][]string) (models.IstioValidations, error) {
+	var istioConfigs models.IstioConfigList
+	var mtlsDetails kubernetes.MTLSDetails
+	var rbacDetails kubernetes.RBACDetails
+	if err := in.fetchIstioConfigList(ctx, &istioConfigs, &mtlsDetails, &rbacDetails, cluster, namespace); err != nil {
+		return nil, err
+	}
+	if err := in.fetchNonLocalmTLSConfigs(&mtlsDetails, cluster); err != nil {
+		return nil, err
 	}
 
-	return validations, nil
+	objectCheckers := in.getAllObjectCheckers(istioConfigs, workloadsPerNamespace, mtlsDetails, rbacDetails, namespaces, registryServices, cluster, serviceAccounts)
+	if customChecker, found := in.customObjectChecker(cluster, namespace, istioConfigs, mtlsDetails, rbacDetails); found {
+		objectCheckers = append(objectCheckers, customChecker)
+	}
+
+	// Get group validations for same kind istio objects
+	return runObjectCheckers(objectCheckers), nil
 }
 
 func (in *IstioValidationsService) getAllObjectCheckers(istioConfigList models.IstioConfigList, workloadsPerNamespace map[string]models.WorkloadList, mtlsDetails kubernetes.MTLSDetails, rbacDetails kubernetes.RBACDetails, namespaces []models.Namespace, registryServices []*kubernetes.RegistryService, cluster string, serviceAccounts map[string][]string) []checkers.ObjectChecker {
@@ -181,6 +216,7 @@ func (in *IstioValidationsService) getAllObjectCheckers(istioConfigList models.I
 		checkers.DestinationRulesChecker{Namespaces: namespaces, DestinationRules: istioConfigList.DestinationRules, MTLSDetails: mtlsDetails, ServiceEntries: istioConfigList.ServiceEntries, Cluster: cluster},
 		checkers.GatewayChecker{Gateways: istioConfigList.Gateways, WorkloadsPerNamespace: workloadsPerNamespace, IsGatewayToNamespace: in.isGatewayToNamespace(), Cluster: cluster},
 		checkers.PeerAuthenticationChecker{PeerAuthentications: mtlsDetails.PeerAuthentications, MTLSDetails: mtlsDetails, WorkloadsPerNamespace: workloadsPerNamespace, Cluster: cluster},
+		checkers.ProxyConfigChecker{ProxyConfigs: istioConfigList.ProxyConfigs, WorkloadsPerNamespace: workloadsPerNamespace, Cluster: cluster},
 		checkers.ServiceEntryChecker{ServiceEntries: istioConfigList.ServiceEntries, Namespaces: namespaces, WorkloadEntries: istioConfigList.WorkloadEntries, Cluster: cluster},
 		checkers.AuthorizationPolicyChecker{AuthorizationPolicies: rbacDetails.AuthorizationPolicies, Namespaces: namespaces, ServiceEntries: istioConfigList.ServiceEntries, WorkloadsPerNamespace: workloadsPerNamespace, MtlsDetails: mtlsDetails, VirtualServices: istioConfigList.VirtualServices, RegistryServices: registryServices, PolicyAllowAny: in.isPolicyAllowAny(), Cluster: cluster, ServiceAccounts: serviceAccounts},
 		checkers.SidecarChecker{Sidecars: istioConfigList.Sidecars, Namespaces: namespaces, WorkloadsPerNamespace: workloadsPerNamespace, ServiceEntries: istioConfigList.ServiceEntries, RegistryServices: registryServices, Cluster: cluster},
@@ -190,14 +226,27 @@ func (in *IstioValidationsService) getAllObjectCheckers(istioConfigList models.I
 		checkers.K8sGRPCRouteChecker{K8sGRPCRoutes: istioConfigList.K8sGRPCRoutes, K8sGateways: istioConfigList.K8sGateways, K8sReferenceGrants: istioConfigList.K8sReferenceGrants, Namespaces: namespaces, RegistryServices: registryServices, Cluster: cluster},
 		checkers.K8sHTTPRouteChecker{K8sHTTPRoutes: istioConfigList.K8sHTTPRoutes, K8sGateways: istioConfigList.K8sGateways, K8sReferenceGrants: istioConfigList.K8sReferenceGrants, Namespaces: namespaces, RegistryServices: registryServices, Cluster: cluster},
 		checkers.K8sReferenceGrantChecker{K8sReferenceGrants: istioConfigList.K8sReferenceGrants, Namespaces: namespaces, Cluster: cluster},
-		checkers.WasmPluginChecker{WasmPlugins: istioConfigList.WasmPlugins, Namespaces: namespaces},
-		checkers.TelemetryChecker{Telemetries: istioConfigList.Telemetries, Namespaces: namespaces},
+		checkers.WasmPluginChecker{WasmPlugins: istioConfigList.WasmPlugins, Namespaces: namespaces, WorkloadsPerNamespace: workloadsPerNamespace, Cluster: cluster},
+		checkers.TelemetryChecker{Telemetries: istioConfigList.Telemetries, Namespaces: namespaces, WorkloadsPerNamespace: workloadsPerNamespace, Cluster: cluster},
 		checkers.WorkloadGroupsChecker{Cluster: cluster, WorkloadGroups: istioConfigList.WorkloadGroups, ServiceAccounts: serviceAccounts},
 	}
 }
 
 // GetIstioObjectValidations validates a single Istio object of the given type with the given name found in the given namespace.
 func (in *IstioValidationsService) GetIstioObjectValidations(ctx context.Context, cluster, namespace string, objectGVK schema.GroupVersionKind, object string) (models.IstioValidations, models.IstioReferencesMap, error) {
+	return in.validateIstioObject(ctx, cluster, namespace, objectGVK, object, nil)
+}
+
+// ValidateIstioObjectCandidate validates an object that is not persisted, i.e. the result of a dry-run, against the
+// rest of the Istio config. It replaces the object of the same name, if any. The validations are not cached.
+func (in *IstioValidationsService) ValidateIstioObjectCandidate(ctx context.Context, cluster string, objectGVK schema.GroupVersionKind, candidate meta_v1.Object) (models.IstioValidations, error) {
+	validations, _, err := in.validateIstioObject(ctx, cluster, candidate.GetNamespace(), objectGVK, candidate.GetName(), candidate)
+	return validations, err
+}
+
+// validateIstioObject validates the object with the checkers of its type. The candidate, when not nil, is validated
+// in place of the object found in the cache.
+func (in *IstioValidationsService) validateIstioObject(ctx context.Context, cluster, namespace string, objectGVK schema.GroupVersionKind, object string, candidate meta_v1.Object) (models.IstioValidations, models.IstioReferencesMap, error) {
 	var end observability.EndFunc
 	ctx, end = observability.StartSpan(ctx, "GetIstioObjectValidations",
 		observability.Attribute("package", "business"),
@@ -279,6 +328,10 @@ func (in *IstioValidationsService) GetIstioObjectValidations(ctx context.Context
 
 	wg.Wait()
 
+	if candidate != nil {
+		in.addCandidate(&istioConfigList, &mtlsDetails, &rbacDetails, candidate)
+	}
+
 	noServiceChecker := checkers.NoServiceChecker{Cluster: cluster, Namespaces: namespaces, IstioConfigList: &istioConfigList, WorkloadsPerNamespace: workloadsPerNamespace, AuthorizationDetails: &rbacDetails, RegistryServices: registryServices, PolicyAllowAny: in.isPolicyAllowAny()}
 
 	switch objectGVK {
@@ -335,9 +388,14 @@ func (in *IstioValidationsService) GetIstioObjectValidations(ctx context.Context
 	case kubernetes.EnvoyFilters:
 		// Validation on EnvoyFilters are not yet in place
 	case kubernetes.WasmPlugins:
-		// Validation on WasmPlugins is not expected
+		wasmPluginChecker := checkers.WasmPluginChecker{Cluster: cluster, WasmPlugins: istioConfigList.WasmPlugins, Namespaces: namespaces, WorkloadsPerNamespace: workloadsPerNamespace}
+		objectCheckers = []checkers.ObjectChecker{wasmPluginChecker}
+	case kubernetes.ProxyConfigs:
+		proxyConfigChecker := checkers.ProxyConfigChecker{Cluster: cluster, ProxyConfigs: istioConfigList.ProxyConfigs, WorkloadsPerNamespace: workloadsPerNamespace}
+		objectCheckers = []checkers.ObjectChecker{proxyConfigChecker}
 	case kubernetes.Telemetries:
-		// Validation on Telemetries is not expected
+		telemetryChecker := checkers.TelemetryChecker{Cluster: cluster, Telemetries: istioConfigList.Telemetries, Namespaces: namespaces, WorkloadsPerNamespace: workloadsPerNamespace}
+		objectCheckers = []checkers.ObjectChecker{telemetryChecker}
 	case kubernetes.K8sGateways:
 		// Validations on K8sGateways
 		objectCheckers = []checkers.ObjectChecker{
@@ -371,6 +429,10 @@ func (in *IstioValidationsService) GetIstioObjectValidations(ctx context.Context
 		}
 	}
 
+	if customChecker, found := in.customObjectChecker(cluster, namespace, istioConfigList, mtlsDetails, rbacDetails); found && err == nil {
+		objectCheckers = append(objectCheckers, customChecker)
+	}
+
 	if referenceChecker != nil {
 		istioReferences = runObjectReferenceChecker(referenceChecker)
 	}
@@ -380,13 +442,72 @@ func (in *IstioValidationsService) GetIstioObjectValidations(ctx context.Context
 	}
 
 	validations := runObjectCheckers(objectCheckers).FilterByKey(objectGVK, object)
-	for k, v := range validations {
-		in.kialiCache.Validations().Set(k, v)
+	if candidate == nil {
+		for k, v := range validations {
+			in.kialiCache.Validations().Set(k, v)
+		}
 	}
 
 	return validations, istioReferences, nil
 }
 
+// addCandidate adds the candidate to the objects validated together, in place of the object of the same name.
+func (in *IstioValidationsService) addCandidate(istioConfigList *models.IstioConfigList, mtlsDetails *kubernetes.MTLSDetails, rbacDetails *kubernetes.RBACDetails, candidate meta_v1.Object) {
+	switch c := candidate.(type) {
+	case *networking_v1.DestinationRule:
+		istioConfigList.DestinationRules = replaceCandidate(istioConfigList.DestinationRules, c)
+		mtlsDetails.DestinationRules = replaceCandidate(mtlsDetails.DestinationRules, c)
+	case *networking_v1.Gateway:
+		istioConfigList.Gateways = replaceCandidate(istioConfigList.Gateways, c)
+	case *networking_v1.ServiceEntry:
+		istioConfigList.ServiceEntries = replaceCandidate(istioConfigList.ServiceEntries, c)
+	case *networking_v1.Sidecar:
+		istioConfigList.Sidecars = replaceCandidate(istioConfigList.Sidecars, c)
+	case *networking_v1.VirtualService:
+		istioConfigList.VirtualServices = replaceCandidate(istioConfigList.VirtualServices, c)
+	case *networking_v1.WorkloadEntry:
+		istioConfigList.WorkloadEntries = replaceCandidate(istioConfigList.WorkloadEntries, c)
+	case *networking_v1.WorkloadGroup:
+		istioConfigList.WorkloadGroups = replaceCandidate(istioConfigList.WorkloadGroups, c)
+	case *networking_v1alpha3.EnvoyFilter:
+		istioConfigList.EnvoyFilters = replaceCandidate(istioConfigList.EnvoyFilters, c)
+	case *networking_v1beta1.ProxyConfig:
+		istioConfigList.ProxyConfigs = replaceCandidate(istioConfigList.ProxyConfigs, c)
+	case *security_v1.AuthorizationPolicy:
+		rbacDetails.AuthorizationPolicies = replaceCandidate(rbacDetails.AuthorizationPolicies, c)
+	case *security_v1.PeerAuthentication:
+		mtlsDetails.PeerAuthentications = replaceCandidate(mtlsDetails.PeerAuthentications, c)
+		if c.Namespace == config.Get().ExternalServices.Istio.RootNamespace {
+			mtlsDetails.MeshPeerAuthentications = replaceCandidate(mtlsDetails.MeshPeerAuthentications, c)
+		}
+	case *security_v1.RequestAuthentication:
+		istioConfigList.RequestAuthentications = replaceCandidate(istioConfigList.RequestAuthentications, c)
+	case *extentions_v1alpha1.WasmPlugin:
+		istioConfigList.WasmPlugins = replaceCandidate(istioConfigList.WasmPlugins, c)
+	case *telemetry_v1.Telemetry:
+		istioConfigList.Telemetries = replaceCandidate(istioConfigList.Telemetries, c)
+	case *k8s_networking_v1.Gateway:
+		istioConfigList.K8sGateways = replaceCandidate(istioConfigList.K8sGateways, c)
+	case *k8s_networking_v1.GRPCRoute:
+		istioConfigList.K8sGRPCRoutes = replaceCandidate(istioConfigList.K8sGRPCRoutes, c)
+	case *k8s_networking_v1.HTTPRoute:
+		istioConfigList.K8sHTTPRoutes = replaceCandidate(istioConfigList.K8sHTTPRoutes, c)
+	case *k8s_networking_v1beta1.ReferenceGrant:
+		istioConfigList.K8sReferenceGrants = replaceCandidate(istioConfigList.K8sReferenceGrants, c)
+	}
+}
+
+// replaceCandidate returns the objects with the candidate in place of the object of the same name and namespace.
+func replaceCandidate[T meta_v1.Object](objects []T, candidate T) []T {
+	replaced := make([]T, 0, len(objects)+1)
+	for _, o := range objects {
+		if o.GetName() != candidate.GetName() || o.GetNamespace() != candidate.GetNamespace() {
+			replaced = append(replaced, o)
+		}
+	}
+	return append(replaced, candidate)
+}
+
 func runObjectCheckers(objectCheckers []checkers.ObjectChecker) models.IstioValidations {
 	objectTypeValidations := models.IstioValidations{}
 
@@ -500,11 +621,14 @@ func (in *IstioValidationsService) fetchIstioConfigList(
 		IncludeServiceEntries:         true,
 		IncludeVirtualServices:        true,
 		IncludeSidecars:               true,
+		IncludeTelemetry:              true,
 		IncludeRequestAuthentications: true,
 		IncludeWorkloadGroups:         true,
 		IncludeWorkloadEntries:        true,
 		IncludeAuthorizationPolicies:  true,
 		IncludePeerAuthentications:    true,
+		IncludeProxyConfigs:           true,
+		IncludeWasmPlugins:            true,
 		IncludeK8sHTTPRoutes:          true,
 		IncludeK8sGRPCRoutes:          true,
 		IncludeK8sGateways:            true,
@@ -552,6 +676,15 @@ func (in *IstioValidationsService) fetchIstioConfigList(
 	// All Sidecars
 	rValue.Sidecars = append(rValue.Sidecars, istioConfigList.Sidecars...)
 
+	// All ProxyConfigs
+	rValue.ProxyConfigs = append(rValue.ProxyConfigs, istioConfigList.ProxyConfigs...)
+
+	// All WasmPlugins
+	rValue.WasmPlugins = append(rValue.WasmPlugins, istioConfigList.WasmPlugins...)
+
+	// All Telemetries
+	rValue.Telemetries = append(rValue.Telemetries, istioConfigList.Telemetries...)
+
 	// All RequestAuthentications
 	rValue.RequestAuthentications = append(rValue.RequestAuthentications, istioConfigList.RequestAuthentications...)
 
@@ -0,0 +1,95 @@
+package business
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/observability"
+	"github.com/kiali/kiali/prometheus/internalmetrics"
+)
+
+// RunNamespacesValidations validates the Istio config of the namespaces of the cluster, at most workers namespaces
+// at a time, for the audits of large meshes. The workloads, registry services and service accounts are fetched once
+// for all the namespaces. The progress of each namespace is reported as it is validated, with the statuses of the
+// report jobs. A namespace that can't be validated doesn't stop the run: its error is part of the result.
+func (in *IstioValidationsService) RunNamespacesValidations(ctx context.Context, cluster string, namespaces []string, workers int, progress func(namespace, status string, err error)) (*models.ValidationRun, error) {
+	var end observability.EndFunc
+	ctx, end = observability.StartSpan(ctx, "RunNamespacesValidations",
+		observability.Attribute("package", "business"),
+		observability.Attribute("cluster", cluster),
+	)
+	defer end()
+
+	timer := internalmetrics.GetValidationProcessingTimePrometheusTimer("", "")
+	defer timer.ObserveDuration()
+
+	var serviceAccounts map[string][]string
+	var clusterNamespaces models.Namespaces
+	var registryServices []*kubernetes.RegistryService
+	var workloadsPerNamespace map[string]models.WorkloadList
+
+	if err := in.fetchAllWorkloads(ctx, &workloadsPerNamespace, cluster, &clusterNamespaces); err != nil {
+		return nil, err
+	}
+	if err := in.fetchServiceAccounts(ctx, &serviceAccounts); err != nil {
+		return nil, err
+	}
+	if registryStatus := in.kialiCache.GetRegistryStatus(cluster); registryStatus != nil {
+		registryServices = registryStatus.Services
+	}
+
+	sorted := append([]string(nil), namespaces...)
+	sort.Strings(sorted)
+	run := &models.ValidationRun{
+		Cluster:     cluster,
+		Namespaces:  make([]models.NamespaceValidationRun, len(sorted)),
+		Validations: models.IstioValidations{},
+	}
+
+	if workers <= 0 {
+		workers = 1
+	}
+	var mu sync.Mutex
+	var g errgroup.Group
+	g.SetLimit(workers)
+	for i, namespace := range sorted {
+		// Blocks until a worker is available
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			progress(namespace, models.ReportJobRunning, nil)
+			validations, err := in.validateNamespace(ctx, cluster, namespace, clusterNamespaces, workloadsPerNamespace, registryServices, serviceAccounts)
+
+			mu.Lock()
+			if err != nil {
+				run.Namespaces[i] = models.NamespaceValidationRun{
+					IstioValidationSummary: models.IstioValidationSummary{Namespace: namespace, Cluster: cluster},
+					Error:                  err.Error(),
+				}
+			} else {
+				run.Namespaces[i] = models.NamespaceValidationRun{IstioValidationSummary: *validations.SummarizeValidation(namespace, cluster)}
+				run.Validations.MergeValidations(validations)
+			}
+			mu.Unlock()
+
+			if err != nil {
+				progress(namespace, models.ReportJobFailed, err)
+			} else {
+				progress(namespace, models.ReportJobSucceeded, nil)
+			}
+			return nil
+		})
+	}
+	// Only fails when the context is done, i.e. when the run timed out
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
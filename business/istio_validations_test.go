@@ -3,12 +3,14 @@ package business
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
 	security_v1 "istio.io/client-go/pkg/apis/security/v1"
+	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -71,6 +73,57 @@ func TestGetNamespaceValidations(t *testing.T) {
 	assert.True(validations[models.IstioValidationKey{ObjectGVK: kubernetes.VirtualServices, Namespace: "test", Name: "product-vs"}].Valid)
 }
 
+func TestGetValidationsTelemetriesSelectingSameWorkloads(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	vs := fakeValidationMeshService(t, *conf,
+		kubetest.FakeNamespace("test"),
+		&telemetry_v1.Telemetry{ObjectMeta: v1.ObjectMeta{Name: "telemetry1", Namespace: "test"}},
+		&telemetry_v1.Telemetry{ObjectMeta: v1.ObjectMeta{Name: "telemetry2", Namespace: "test"}},
+	)
+
+	validations, err := vs.CreateValidations(context.Background(), conf.KubernetesConfig.ClusterName)
+	require.NoError(err)
+	vs.kialiCache.Validations().Replace(validations)
+
+	validations, err = vs.GetValidations(context.Background(), conf.KubernetesConfig.ClusterName)
+	require.NoError(err)
+	for _, name := range []string{"telemetry1", "telemetry2"} {
+		validation, found := validations[models.IstioValidationKey{ObjectGVK: kubernetes.Telemetries, Namespace: "test", Name: name, Cluster: conf.KubernetesConfig.ClusterName}]
+		require.True(found, name)
+		require.False(validation.Valid, name)
+	}
+}
+
+func TestRunNamespacesValidations(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	vs := mockCombinedValidationService(t, fakeIstioConfigList(),
+		[]string{"details.test.svc.cluster.local", "product.test.svc.cluster.local", "product2.test.svc.cluster.local", "customer.test.svc.cluster.local"})
+
+	var mu sync.Mutex
+	statuses := map[string][]string{}
+	run, err := vs.RunNamespacesValidations(context.TODO(), conf.KubernetesConfig.ClusterName, []string{"wrong", "test"}, 2, func(namespace, status string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		statuses[namespace] = append(statuses[namespace], status)
+	})
+	require.NoError(err)
+
+	require.Len(run.Namespaces, 2)
+	require.Equal("test", run.Namespaces[0].Namespace)
+	require.Equal("wrong", run.Namespaces[1].Namespace)
+	require.Empty(run.Namespaces[0].Error)
+	require.NotZero(run.Namespaces[0].ObjectCount)
+	require.True(run.Validations[models.IstioValidationKey{ObjectGVK: kubernetes.VirtualServices, Namespace: "test", Name: "product-vs", Cluster: conf.KubernetesConfig.ClusterName}].Valid)
+	require.Equal([]string{models.ReportJobRunning, models.ReportJobSucceeded}, statuses["test"])
+	require.Equal([]string{models.ReportJobRunning, models.ReportJobSucceeded}, statuses["wrong"])
+}
+
 func TestGetIstioObjectValidations(t *testing.T) {
 	assert := assert.New(t)
 	conf := config.NewConfig()
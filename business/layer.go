@@ -1,6 +1,8 @@
 package business
 
 import (
+	"context"
+
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/kiali/kiali/config"
@@ -9,6 +11,7 @@ import (
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/kubernetes/cache"
 	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/status"
 	"github.com/kiali/kiali/tracing"
 )
 
@@ -17,17 +20,27 @@ import (
 // needs to be saved across layers is saved in the Kiali Cache.
 type Layer struct {
 	App            AppService
+	Diagnostics    DiagnosticsService
+	Egress         EgressService
 	Health         HealthService
+	Ingress        IngressService
 	IstioConfig    IstioConfigService
 	IstioStatus    IstioStatusService
 	Tracing        TracingService
 	Mesh           MeshService
 	Namespace      NamespaceService
+	Notification   NotificationService
+	Orphans        OrphanService
 	ProxyLogging   ProxyLoggingService
 	ProxyStatus    ProxyStatusService
 	RegistryStatus RegistryStatusService
+	Report         ReportService
+	Search         SearchService
+	SLO            SLOService
+	Snapshot       SnapshotService
 	Svc            SvcService
 	TLS            TLSService
+	Traffic        TrafficBaselineService
 	Validations    IstioValidationsService
 	Workload       WorkloadService
 }
@@ -63,6 +76,15 @@ func Start(
 	tracingClientLoader = traceClientLoader
 }
 
+// kialiURL returns the externally reachable URL of Kiali, for the absolute links to the console. It is empty when the
+// business layer is not started.
+func kialiURL(ctx context.Context) string {
+	if clientFactory == nil || kialiCache == nil {
+		return ""
+	}
+	return status.GetKialiURL(ctx, config.Get(), clientFactory, kialiCache)
+}
+
 // Get the business.Layer
 func Get(authInfos map[string]*api.AuthInfo) (*Layer, error) {
 	// Creates new k8s clients based on the current users token
@@ -113,14 +135,24 @@ func newLayer(
 
 	// TODO: Modify the k8s argument to other services to pass the whole k8s map if needed
 	temporaryLayer.App = NewAppService(temporaryLayer, conf, prom, grafana, userClients)
-	temporaryLayer.Health = HealthService{prom: prom, businessLayer: temporaryLayer, userClients: userClients}
+	temporaryLayer.Diagnostics = NewDiagnosticsService(temporaryLayer, prom, userClients)
+	temporaryLayer.Egress = NewEgressService(temporaryLayer, conf, cache, prom)
+	temporaryLayer.Health = HealthService{prom: prom, businessLayer: temporaryLayer, kialiCache: cache, userClients: userClients}
+	temporaryLayer.Ingress = NewIngressService(temporaryLayer, prom)
 	temporaryLayer.IstioConfig = IstioConfigService{config: *conf, userClients: userClients, kialiCache: cache, businessLayer: temporaryLayer, controlPlaneMonitor: cpm}
 	temporaryLayer.Namespace = NewNamespaceService(userClients, kialiSAClients, cache, conf, discovery)
 	temporaryLayer.Mesh = NewMeshService(kialiSAClients, discovery)
+	temporaryLayer.Notification = NewNotificationService(conf)
+	temporaryLayer.Orphans = NewOrphanService(temporaryLayer, cache, prom)
 	temporaryLayer.ProxyStatus = ProxyStatusService{kialiSAClients: kialiSAClients, kialiCache: cache, businessLayer: temporaryLayer}
 	// Out of order because it relies on ProxyStatus
 	temporaryLayer.ProxyLogging = ProxyLoggingService{userClients: userClients, proxyStatus: &temporaryLayer.ProxyStatus}
 	temporaryLayer.RegistryStatus = RegistryStatusService{kialiCache: cache}
+	temporaryLayer.Report = NewReportService(temporaryLayer)
+	temporaryLayer.Search = NewSearchService(temporaryLayer, conf, cache)
+	temporaryLayer.SLO = NewSLOService(temporaryLayer, conf, cache, prom)
+	temporaryLayer.Snapshot = NewSnapshotService(temporaryLayer, conf)
+	temporaryLayer.Traffic = NewTrafficBaselineService(temporaryLayer, conf, cache, prom)
 	temporaryLayer.TLS = TLSService{discovery: discovery, userClients: userClients, kialiCache: cache, businessLayer: temporaryLayer}
 	temporaryLayer.Svc = SvcService{config: *conf, kialiCache: cache, businessLayer: temporaryLayer, prom: prom, userClients: userClients}
 	temporaryLayer.Workload = *NewWorkloadService(userClients, kialiSAClients, prom, cache, temporaryLayer, conf, grafana)
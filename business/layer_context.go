@@ -0,0 +1,34 @@
+package business
+
+import (
+	"context"
+	"sync"
+)
+
+type layerContextKey struct{}
+
+// layerHolder holds the business layer of a request, created the first time it is needed.
+type layerHolder struct {
+	err   error
+	layer *Layer
+	once  sync.Once
+}
+
+// NewRequestContext returns a context holding the business layer of a request, so that the handlers of the request
+// share a single layer, with its user clients and services, instead of creating one each time they need it.
+func NewRequestContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, layerContextKey{}, &layerHolder{})
+}
+
+// FromContext returns the business layer held by a request context, creating it with the create function the first
+// time. A context without a holder gets a new layer every time.
+func FromContext(ctx context.Context, create func() (*Layer, error)) (*Layer, error) {
+	holder, ok := ctx.Value(layerContextKey{}).(*layerHolder)
+	if !ok {
+		return create()
+	}
+	holder.once.Do(func() {
+		holder.layer, holder.err = create()
+	})
+	return holder.layer, holder.err
+}
@@ -0,0 +1,39 @@
+package business
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContext(t *testing.T) {
+	require := require.New(t)
+
+	created := 0
+	create := func() (*Layer, error) {
+		created++
+		return &Layer{}, nil
+	}
+
+	ctx := NewRequestContext(context.Background())
+	first, err := FromContext(ctx, create)
+	require.NoError(err)
+	second, err := FromContext(ctx, create)
+	require.NoError(err)
+	require.Same(first, second)
+	require.Equal(1, created)
+
+	// Without a holder every call creates a layer
+	_, err = FromContext(context.Background(), create)
+	require.NoError(err)
+	require.Equal(2, created)
+
+	// The error of the creation is returned to every caller of the request
+	ctx = NewRequestContext(context.Background())
+	_, err = FromContext(ctx, func() (*Layer, error) { return nil, errors.New("no clients") })
+	require.Error(err)
+	_, err = FromContext(ctx, create)
+	require.Error(err)
+}
@@ -0,0 +1,240 @@
+package business
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// The sort keys of the list windows.
+const (
+	SortByName      = "name"
+	SortByNamespace = "namespace"
+	SortByType      = "type"
+)
+
+var (
+	// ServiceSortKeys are the sort keys of the windows of services.
+	ServiceSortKeys = []string{SortByName, SortByNamespace}
+	// WorkloadSortKeys are the sort keys of the windows of workloads, the type being the kind of the workload.
+	WorkloadSortKeys = []string{SortByName, SortByNamespace, SortByType}
+	// IstioConfigSortKeys are the sort keys of the windows of Istio config, the type being the kind of the object.
+	IstioConfigSortKeys = []string{SortByName, SortByNamespace, SortByType}
+)
+
+// ListWindowCriteria selects a window of a sorted list. The sort key must be one of the sort keys of the list.
+type ListWindowCriteria struct {
+	Offset     int
+	Limit      int
+	SortBy     string
+	Descending bool
+}
+
+// windowKeys are the values an item of a list is sorted by.
+type windowKeys struct {
+	namespace string
+	name      string
+	kind      string
+}
+
+func (k windowKeys) compare(other windowKeys, sortBy string) int {
+	var c int
+	switch sortBy {
+	case SortByNamespace:
+		c = cmp.Compare(k.namespace, other.namespace)
+	case SortByType:
+		c = cmp.Compare(k.kind, other.kind)
+	default:
+		c = cmp.Compare(k.name, other.name)
+	}
+	// The namespace, the name and the type identify an item, they make the order stable
+	if c == 0 {
+		c = cmp.Or(cmp.Compare(k.namespace, other.namespace), cmp.Compare(k.name, other.name), cmp.Compare(k.kind, other.kind))
+	}
+	return c
+}
+
+// windowList sorts the items by the key of the criteria, and returns the items of the window with its description.
+func windowList[T any](items []T, criteria ListWindowCriteria, keysOf func(T) windowKeys) ([]T, models.ListWindow) {
+	window := models.ListWindow{Offset: criteria.Offset, Limit: criteria.Limit, Total: len(items), SortBy: criteria.SortBy, SortOrder: "asc"}
+	if criteria.Descending {
+		window.SortOrder = "desc"
+	}
+
+	sorted := make([]T, len(items))
+	copy(sorted, items)
+	slices.SortFunc(sorted, func(a, b T) int {
+		c := keysOf(a).compare(keysOf(b), criteria.SortBy)
+		if criteria.Descending {
+			return -c
+		}
+		return c
+	})
+
+	start := min(criteria.Offset, len(sorted))
+	end := min(start+criteria.Limit, len(sorted))
+	return sorted[start:end], window
+}
+
+// windowValidations returns the validations of the items of a window.
+func windowValidations(validations models.IstioValidations, inWindow func(key models.IstioValidationKey) bool) models.IstioValidations {
+	filtered := models.IstioValidations{}
+	for key, validation := range validations {
+		if inWindow(key) {
+			filtered[key] = validation
+		}
+	}
+	return filtered
+}
+
+// WindowServices returns the window of the services of a cluster selected by the criteria, with the validations of
+// its services.
+func WindowServices(services *models.ClusterServices, criteria ListWindowCriteria) models.ServiceWindow {
+	windowed, window := windowList(services.Services, criteria, func(s models.ServiceOverview) windowKeys {
+		return windowKeys{namespace: s.Namespace, name: s.Name}
+	})
+	names := make(map[windowKeys]bool, len(windowed))
+	for _, s := range windowed {
+		names[windowKeys{namespace: s.Namespace, name: s.Name}] = true
+	}
+	return models.ServiceWindow{
+		ListWindow: window,
+		Cluster:    services.Cluster,
+		Services:   windowed,
+		Validations: windowValidations(services.Validations, func(key models.IstioValidationKey) bool {
+			return names[windowKeys{namespace: key.Namespace, name: key.Name}]
+		}),
+	}
+}
+
+// WindowWorkloads returns the window of the workloads of a cluster selected by the criteria, with the validations of
+// its workloads.
+func WindowWorkloads(workloads *models.ClusterWorkloads, criteria ListWindowCriteria) models.WorkloadWindow {
+	windowed, window := windowList(workloads.Workloads, criteria, func(w models.WorkloadListItem) windowKeys {
+		return windowKeys{namespace: w.Namespace, name: w.Name, kind: w.WorkloadGVK.Kind}
+	})
+	names := make(map[windowKeys]bool, len(windowed))
+	for _, w := range windowed {
+		names[windowKeys{namespace: w.Namespace, name: w.Name}] = true
+	}
+	return models.WorkloadWindow{
+		ListWindow: window,
+		Cluster:    workloads.Cluster,
+		Workloads:  windowed,
+		Validations: windowValidations(workloads.Validations, func(key models.IstioValidationKey) bool {
+			return names[windowKeys{namespace: key.Namespace, name: key.Name}]
+		}),
+	}
+}
+
+// AddServicesHealth sets the health of the services of a window, fetched for those services only. The rate interval
+// of the health of each namespace is given by rateIntervals.
+func (in *SvcService) AddServicesHealth(ctx context.Context, services []models.ServiceOverview, rateIntervals map[string]string, queryTime time.Time) {
+	for i, sv := range services {
+		var err error
+		services[i].Health, err = in.businessLayer.Health.GetServiceHealth(ctx, sv.Namespace, sv.Cluster, sv.Name, rateIntervals[sv.Namespace], queryTime, sv.ParseToService())
+		if err != nil {
+			log.Errorf("Error fetching health per service %s: %s", sv.Name, err)
+		}
+	}
+}
+
+// AddWorkloadsHealth sets the health of the workloads of a window, fetched for those workloads only. The rate interval
+// of the health of each namespace is given by rateIntervals.
+func (in *WorkloadService) AddWorkloadsHealth(ctx context.Context, cluster string, workloads []models.WorkloadListItem, rateIntervals map[string]string, queryTime time.Time) error {
+	// the workloads of the namespaces of the window, by namespace and name
+	namespaceWorkloads := map[string]map[string]*models.Workload{}
+	for i, wItem := range workloads {
+		byName, found := namespaceWorkloads[wItem.Namespace]
+		if !found {
+			ws, err := in.fetchWorkloadsFromCluster(ctx, cluster, wItem.Namespace, "")
+			if err != nil {
+				return err
+			}
+			byName = make(map[string]*models.Workload, len(ws))
+			for _, w := range ws {
+				byName[w.Name] = w
+			}
+			namespaceWorkloads[wItem.Namespace] = byName
+		}
+		w, found := byName[wItem.Name]
+		if !found {
+			continue
+		}
+		var err error
+		workloads[i].Health, err = in.businessLayer.Health.GetWorkloadHealth(ctx, wItem.Namespace, cluster, wItem.Name, rateIntervals[wItem.Namespace], queryTime, w)
+		if err != nil {
+			log.Errorf("Error fetching Health in namespace %s for workload %s: %s", wItem.Namespace, wItem.Name, err)
+		}
+	}
+	return nil
+}
+
+// istioConfigWindowItem is an object of the Istio config list being windowed.
+type istioConfigWindowItem struct {
+	gvk    schema.GroupVersionKind
+	object runtime.Object
+	keys   windowKeys
+}
+
+// GetIstioConfigWindow returns the window of the Istio objects of the namespaces, of all the accessible namespaces
+// when empty, selected by the window criteria. The objects of all the types requested by the criteria are sorted
+// together. The validations of the objects of the window are included when requested.
+func (in *IstioConfigService) GetIstioConfigWindow(ctx context.Context, cluster string, namespaces []string, criteria IstioConfigCriteria, windowCriteria ListWindowCriteria, includeValidations bool) (models.IstioConfigWindow, error) {
+	items := []istioConfigWindowItem{}
+	// the types are streamed concurrently
+	var mu sync.Mutex
+	err := in.StreamIstioConfigList(ctx, cluster, "", criteria, func(gvk schema.GroupVersionKind, objects []runtime.Object) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, o := range objects {
+			accessor, err := meta.Accessor(o)
+			if err != nil {
+				return err
+			}
+			if len(namespaces) > 0 && !slices.Contains(namespaces, accessor.GetNamespace()) {
+				continue
+			}
+			keys := windowKeys{namespace: accessor.GetNamespace(), name: accessor.GetName(), kind: gvk.Kind}
+			items = append(items, istioConfigWindowItem{gvk: gvk, object: o, keys: keys})
+		}
+		return nil
+	})
+	if err != nil {
+		return models.IstioConfigWindow{}, err
+	}
+
+	windowed, window := windowList(items, windowCriteria, func(item istioConfigWindowItem) windowKeys {
+		return item.keys
+	})
+	istioConfigWindow := models.IstioConfigWindow{ListWindow: window, Cluster: cluster, Resources: make([]models.IstioConfigWindowItem, 0, len(windowed))}
+	objects := make(map[models.IstioValidationKey]bool, len(windowed))
+	for _, item := range windowed {
+		resource := models.IstioConfigWindowItem{Type: item.gvk.String(), Object: item.object}
+		if accessor, err := meta.Accessor(item.object); err == nil {
+			resource.HelmRelease = models.GetHelmRelease(accessor)
+		}
+		istioConfigWindow.Resources = append(istioConfigWindow.Resources, resource)
+		objects[models.IstioValidationKey{ObjectGVK: item.gvk, Namespace: item.keys.namespace, Name: item.keys.name, Cluster: cluster}] = true
+	}
+
+	if includeValidations {
+		validations, err := in.businessLayer.Validations.GetValidations(ctx, cluster)
+		if err != nil {
+			return models.IstioConfigWindow{}, err
+		}
+		istioConfigWindow.Validations = windowValidations(validations, func(key models.IstioValidationKey) bool {
+			return objects[key]
+		})
+	}
+	return istioConfigWindow, nil
+}
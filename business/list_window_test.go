@@ -0,0 +1,183 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestWindowServices(t *testing.T) {
+	require := require.New(t)
+
+	services := &models.ClusterServices{
+		Cluster: "east",
+		Services: []models.ServiceOverview{
+			{Name: "reviews", Namespace: "bookinfo"},
+			{Name: "details", Namespace: "travels"},
+			{Name: "ratings", Namespace: "bookinfo"},
+			{Name: "details", Namespace: "bookinfo"},
+		},
+		Validations: models.IstioValidations{
+			{ObjectGVK: kubernetes.Services, Namespace: "bookinfo", Name: "details", Cluster: "east"}: &models.IstioValidation{Name: "details"},
+			{ObjectGVK: kubernetes.Services, Namespace: "bookinfo", Name: "reviews", Cluster: "east"}: &models.IstioValidation{Name: "reviews"},
+		},
+	}
+	names := func(window models.ServiceWindow) []string {
+		names := []string{}
+		for _, s := range window.Services {
+			names = append(names, s.Namespace+"/"+s.Name)
+		}
+		return names
+	}
+
+	// The services of the same name are sorted by namespace
+	window := WindowServices(services, ListWindowCriteria{Limit: 2, SortBy: SortByName})
+	require.Equal([]string{"bookinfo/details", "travels/details"}, names(window))
+	require.Equal(models.ListWindow{Offset: 0, Limit: 2, Total: 4, SortBy: SortByName, SortOrder: "asc"}, window.ListWindow)
+	require.Len(window.Validations, 1)
+
+	window = WindowServices(services, ListWindowCriteria{Offset: 2, Limit: 2, SortBy: SortByName})
+	require.Equal([]string{"bookinfo/ratings", "bookinfo/reviews"}, names(window))
+	require.Len(window.Validations, 1)
+
+	window = WindowServices(services, ListWindowCriteria{Limit: 3, SortBy: SortByNamespace, Descending: true})
+	require.Equal([]string{"travels/details", "bookinfo/reviews", "bookinfo/ratings"}, names(window))
+	require.Equal("desc", window.SortOrder)
+
+	window = WindowServices(services, ListWindowCriteria{Offset: 10, Limit: 2, SortBy: SortByName})
+	require.Empty(window.Services)
+	require.Equal(4, window.Total)
+	// The list itself is left unsorted
+	require.Equal("reviews", services.Services[0].Name)
+}
+
+func TestAddServicesHealth(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	cluster := conf.KubernetesConfig.ClusterName
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("bookinfo"))
+	SetupBusinessLayer(t, k8s, *conf)
+
+	prom := new(prometheustest.PromClientMock)
+	prom.MockServiceRequestRates("bookinfo", cluster, "details", serviceRates)
+	k8sclients := map[string]kubernetes.ClientInterface{cluster: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, prom, nil)
+
+	services := &models.ClusterServices{
+		Cluster: cluster,
+		Services: []models.ServiceOverview{
+			{Name: "reviews", Namespace: "bookinfo", Cluster: cluster},
+			{Name: "details", Namespace: "bookinfo", Cluster: cluster},
+		},
+	}
+	window := WindowServices(services, ListWindowCriteria{Limit: 1, SortBy: SortByName})
+	layer.Svc.AddServicesHealth(context.TODO(), window.Services, map[string]string{"bookinfo": "1m"}, time.Now())
+
+	// The health is only fetched for the services of the window
+	prom.AssertNumberOfCalls(t, "GetServiceRequestRates", 1)
+	require.Equal(float64(14), window.Services[0].Health.Requests.Inbound["http"]["200"])
+}
+
+func TestGetIstioConfigWindow(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.KubernetesConfig.ClusterName = config.DefaultClusterID
+	config.Set(conf)
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		kubetest.FakeNamespace("travels"),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+		data.CreateEmptyVirtualService("ratings", "bookinfo", []string{"ratings"}),
+		data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews"),
+		data.CreateEmptyVirtualService("cars", "travels", []string{"cars"}),
+	)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	k8sclients := map[string]kubernetes.ClientInterface{cluster: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+	configService := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, controlPlaneMonitor: poller, businessLayer: layer}
+	drKey := models.IstioValidationKey{ObjectGVK: kubernetes.DestinationRules, Namespace: "bookinfo", Name: "reviews", Cluster: cluster}
+	vsKey := models.IstioValidationKey{ObjectGVK: kubernetes.VirtualServices, Namespace: "bookinfo", Name: "reviews", Cluster: cluster}
+	cache.Validations().Replace(models.IstioValidations{
+		drKey: &models.IstioValidation{Name: "reviews", Valid: true},
+		vsKey: &models.IstioValidation{Name: "reviews", Valid: true},
+	})
+
+	keys := func(window models.IstioConfigWindow) []string {
+		keys := []string{}
+		for _, r := range window.Resources {
+			switch o := r.Object.(type) {
+			case *networking_v1.VirtualService:
+				keys = append(keys, "VirtualService/"+o.Namespace+"/"+o.Name)
+			case *networking_v1.DestinationRule:
+				keys = append(keys, "DestinationRule/"+o.Namespace+"/"+o.Name)
+			}
+		}
+		return keys
+	}
+
+	criteria := ParseIstioConfigCriteria("", "", "")
+	window, err := configService.GetIstioConfigWindow(context.TODO(), cluster, nil, criteria, ListWindowCriteria{Limit: 3, SortBy: SortByName}, true)
+	require.NoError(err)
+	require.Equal(4, window.Total)
+	// The objects of the same name are sorted by namespace, then by type
+	require.Equal([]string{"VirtualService/travels/cars", "VirtualService/bookinfo/ratings", "DestinationRule/bookinfo/reviews"}, keys(window))
+	require.Equal(kubernetes.DestinationRules.String(), window.Resources[2].Type)
+	// Only the validations of the objects of the window
+	require.Equal(models.IstioValidations{drKey: &models.IstioValidation{Name: "reviews", Valid: true}}, window.Validations)
+
+	window, err = configService.GetIstioConfigWindow(context.TODO(), cluster, []string{"bookinfo"}, criteria, ListWindowCriteria{Limit: 10, SortBy: SortByType}, false)
+	require.NoError(err)
+	require.Equal(3, window.Total)
+	require.Equal([]string{"DestinationRule/bookinfo/reviews", "VirtualService/bookinfo/ratings", "VirtualService/bookinfo/reviews"}, keys(window))
+	require.Nil(window.Validations)
+}
+
+func TestGetIstioConfigWindowStreamsTypesConcurrently(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.KubernetesConfig.ClusterName = config.DefaultClusterID
+	conf.KubernetesConfig.ListParallelism = 8
+	config.Set(conf)
+	objects := []runtime.Object{kubetest.FakeNamespace("bookinfo")}
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("reviews-%d", i)
+		objects = append(objects,
+			data.CreateEmptyVirtualService(name, "bookinfo", []string{name}),
+			data.CreateEmptyDestinationRule("bookinfo", name, name),
+			data.CreateEmptyGateway(name, "bookinfo", map[string]string{"app": name}),
+			data.CreateEmptyAuthorizationPolicy(name, "bookinfo"),
+			data.CreateEmptyPeerAuthentication(name, "bookinfo", nil),
+		)
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	k8sclients := map[string]kubernetes.ClientInterface{cluster: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+	configService := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, controlPlaneMonitor: poller, businessLayer: layer}
+
+	// The objects of every type are kept, whatever the order their types are streamed in
+	window, err := configService.GetIstioConfigWindow(context.TODO(), cluster, nil, ParseIstioConfigCriteria("", "", ""), ListWindowCriteria{Limit: 1000, SortBy: SortByType}, false)
+	require.NoError(err)
+	require.Equal(100, window.Total)
+	require.Len(window.Resources, 100)
+}
@@ -24,7 +24,11 @@ func NewMetricsService(prom prometheus.ClientInterface) *MetricsService {
 func (in *MetricsService) GetMetrics(q models.IstioMetricsQuery, scaler func(n string) float64) (models.MetricsMap, error) {
 	lb := createMetricsLabelsBuilder(&q)
 	grouping := strings.Join(q.ByLabels, ",")
-	return in.fetchAllMetrics(q, lb, grouping, scaler)
+	metrics, err := in.fetchAllMetrics(q, lb, grouping, scaler)
+	if err == nil && q.AnomalyMethod != "" {
+		flagAnomalies(metrics, q.AnomalyMethod, q.AnomalyWindow, q.AnomalyThreshold)
+	}
+	return metrics, err
 }
 
 func createMetricsLabelsBuilder(q *models.IstioMetricsQuery) *MetricsLabelsBuilder {
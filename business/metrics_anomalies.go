@@ -0,0 +1,111 @@
+package business
+
+import (
+	"math"
+	"sort"
+
+	"github.com/kiali/kiali/models"
+)
+
+const (
+	// madScale makes the median absolute deviation consistent with the standard deviation of a normal distribution
+	madScale = 0.6745
+	// default thresholds of the absolute scores, per method
+	defaultMADThreshold    = 3.5
+	defaultZScoreThreshold = 3.0
+)
+
+// flagAnomalies scores the rate of change of every series of the metrics against a trailing baseline, and flags the
+// datapoints whose absolute score reaches the threshold.
+func flagAnomalies(metrics models.MetricsMap, method string, window int, threshold float64) {
+	if threshold <= 0 {
+		threshold = defaultZScoreThreshold
+		if method == models.AnomalyMethodMAD {
+			threshold = defaultMADThreshold
+		}
+	}
+	for _, series := range metrics {
+		for i := range series {
+			series[i].Anomalies = scoreAnomalies(series[i].Datapoints, method, window, threshold)
+		}
+	}
+}
+
+// scoreAnomalies scores the change of each datapoint from the previous one against the changes of the window
+// preceding it. Datapoints without a full baseline, and baselines without any variation, are not scored. The NaN
+// datapoints are ignored.
+func scoreAnomalies(datapoints []models.Datapoint, method string, window int, threshold float64) []models.Anomaly {
+	var anomalies []models.Anomaly
+	if window < 2 {
+		return anomalies
+	}
+
+	changes := make([]float64, 0, len(datapoints))
+	previous := math.NaN()
+	for _, dp := range datapoints {
+		if math.IsNaN(dp.Value) {
+			continue
+		}
+		if !math.IsNaN(previous) {
+			change := dp.Value - previous
+			if len(changes) >= window {
+				var score float64
+				if method == models.AnomalyMethodMAD {
+					score = madScore(changes[len(changes)-window:], change)
+				} else {
+					score = zScore(changes[len(changes)-window:], change)
+				}
+				if math.Abs(score) >= threshold {
+					anomalies = append(anomalies, models.Anomaly{Timestamp: dp.Timestamp, Value: dp.Value, Score: score})
+				}
+			}
+			changes = append(changes, change)
+		}
+		previous = dp.Value
+	}
+	return anomalies
+}
+
+// zScore returns the z-score of a value against a baseline, 0 for a baseline without variation.
+func zScore(baseline []float64, value float64) float64 {
+	mean := 0.0
+	for _, v := range baseline {
+		mean += v
+	}
+	mean /= float64(len(baseline))
+	variance := 0.0
+	for _, v := range baseline {
+		variance += (v - mean) * (v - mean)
+	}
+	stdDev := math.Sqrt(variance / float64(len(baseline)))
+	if stdDev == 0 {
+		return 0
+	}
+	return (value - mean) / stdDev
+}
+
+// madScore returns the modified z-score of a value against a baseline, based on the median absolute deviation. It is
+// 0 for a baseline without variation.
+func madScore(baseline []float64, value float64) float64 {
+	med := median(baseline)
+	deviations := make([]float64, len(baseline))
+	for i, v := range baseline {
+		deviations[i] = math.Abs(v - med)
+	}
+	mad := median(deviations)
+	if mad == 0 {
+		return 0
+	}
+	return madScale * (value - med) / mad
+}
+
+// median returns the median of values, which are left unmodified.
+func median(values []float64) float64 {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
@@ -0,0 +1,55 @@
+package business
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/models"
+)
+
+func anomalySeries(values ...float64) []models.Datapoint {
+	datapoints := make([]models.Datapoint, len(values))
+	for i, v := range values {
+		datapoints[i] = models.Datapoint{Timestamp: int64(1000 + 15*i), Value: v}
+	}
+	return datapoints
+}
+
+func TestFlagAnomalies(t *testing.T) {
+	cases := map[string][]int64{
+		// the spike inflates the deviation of the baseline of the drop that follows it
+		models.AnomalyMethodZScore: {1165},
+		// the median absolute deviation is robust to the spike
+		models.AnomalyMethodMAD: {1165, 1180},
+	}
+	for method, expected := range cases {
+		t.Run(method, func(t *testing.T) {
+			metrics := models.MetricsMap{
+				"request_count": {{
+					Name:       "request_count",
+					Datapoints: anomalySeries(10, 11, 10, 12, 11, 13, 11, 12, math.NaN(), 10, 11, 40, 11),
+				}},
+			}
+			flagAnomalies(metrics, method, 5, 0)
+
+			anomalies := metrics["request_count"][0].Anomalies
+			require.Len(t, anomalies, len(expected))
+			for i, timestamp := range expected {
+				assert.Equal(t, timestamp, anomalies[i].Timestamp)
+			}
+			assert.Equal(t, 40.0, anomalies[0].Value)
+			assert.Greater(t, anomalies[0].Score, defaultMADThreshold)
+		})
+	}
+}
+
+func TestScoreAnomaliesWithoutBaseline(t *testing.T) {
+	// not enough changes for a baseline
+	assert.Empty(t, scoreAnomalies(anomalySeries(1, 1, 100), models.AnomalyMethodZScore, 5, 3))
+	// a baseline without variation cannot score the changes
+	assert.Empty(t, scoreAnomalies(anomalySeries(0, 0, 0, 0, 0, 0, 0, 100), models.AnomalyMethodZScore, 5, 3))
+	assert.Empty(t, scoreAnomalies(anomalySeries(0, 0, 0, 0, 0, 0, 0, 100), models.AnomalyMethodMAD, 5, 3.5))
+}
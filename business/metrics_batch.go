@@ -0,0 +1,119 @@
+package business
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/models"
+)
+
+// MaxMetricsBatchSize bounds the number of services and workloads of a metrics batch, as their names are matched by
+// a single regular expression.
+const MaxMetricsBatchSize = 500
+
+// batchTarget is a kind of entity of a metrics batch: the label matching its name and the label of its namespace.
+type batchTarget struct {
+	nameLabel      string
+	namespaceLabel string
+}
+
+var (
+	batchServices  = batchTarget{nameLabel: "destination_service_name", namespaceLabel: "destination_service_namespace"}
+	batchWorkloads = batchTarget{nameLabel: "destination_workload", namespaceLabel: "destination_workload_namespace"}
+)
+
+// GetMetricsBatch returns the inbound request rate, error rate and p95 response time of many services and workloads
+// of a namespace. Each stat is fetched with a single query grouped by name, whatever the number of names.
+func (in *MetricsService) GetMetricsBatch(q models.MetricsBatchQuery) (*models.MetricsBatch, error) {
+	if len(q.Services)+len(q.Workloads) > MaxMetricsBatchSize {
+		return nil, errors.NewBadRequest(fmt.Sprintf("a metrics batch cannot contain more than %d services and workloads", MaxMetricsBatchSize))
+	}
+
+	batch := &models.MetricsBatch{RateInterval: q.RateInterval}
+	var err error
+	if batch.Services, err = in.fetchBatchStats(q, batchServices, q.Services); err != nil {
+		return nil, err
+	}
+	if batch.Workloads, err = in.fetchBatchStats(q, batchWorkloads, q.Workloads); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+func (in *MetricsService) fetchBatchStats(q models.MetricsBatchQuery, target batchTarget, names []string) (map[string]models.BatchStats, error) {
+	stats := make(map[string]models.BatchStats, len(names))
+	if len(names) == 0 {
+		return stats, nil
+	}
+
+	lb := NewMetricsLabelsBuilder("inbound")
+	lb.SelfReporter()
+	lb.AddOp(target.nameLabel, namesRegex(names), "=~")
+	lb.Add(target.namespaceLabel, q.Namespace)
+	if q.Cluster != "" {
+		lb.Cluster(q.Cluster)
+	}
+	labels := lb.Build()
+
+	rates, err := in.prom.FetchRateValues("istio_requests_total", []string{labels}, target.nameLabel, q.RateInterval, q.Time)
+	if err != nil {
+		return nil, err
+	}
+	// The errors label sets are disjoint, their rates are added
+	failures := map[string]float64{}
+	for _, errorLabels := range lb.BuildForErrors() {
+		values, err := in.prom.FetchRateValues("istio_requests_total", []string{errorLabels}, target.nameLabel, q.RateInterval, q.Time)
+		if err != nil {
+			return nil, err
+		}
+		for name, value := range vectorByLabel(values, target.nameLabel) {
+			failures[name] += value
+		}
+	}
+	histogram, err := in.prom.FetchHistogramValues("istio_request_duration_milliseconds", labels, target.nameLabel, q.RateInterval, false, []string{"0.95"}, q.Time)
+	if err != nil {
+		return nil, err
+	}
+	responseTimes := vectorByLabel(histogram["0.95"], target.nameLabel)
+
+	requestRates := vectorByLabel(rates, target.nameLabel)
+	for _, name := range names {
+		s := models.BatchStats{RequestRate: requestRates[name]}
+		if s.RequestRate > 0 {
+			s.ErrorRate = math.Min(failures[name]/s.RequestRate, 1)
+		}
+		if p95, ok := responseTimes[name]; ok {
+			s.ResponseTimeP95 = &p95
+		}
+		stats[name] = s
+	}
+	return stats, nil
+}
+
+// namesRegex returns the PromQL regular expression matching exactly the names.
+func namesRegex(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		// The backslashes of the regular expression are escaped in the PromQL string
+		quoted = append(quoted, strings.ReplaceAll(regexp.QuoteMeta(name), `\`, `\\`))
+	}
+	return strings.Join(quoted, "|")
+}
+
+// vectorByLabel returns the values of the samples by the value of their label, skipping NaN.
+func vectorByLabel(vector model.Vector, label string) map[string]float64 {
+	values := make(map[string]float64, len(vector))
+	for _, sample := range vector {
+		value := float64(sample.Value)
+		if math.IsNaN(value) {
+			continue
+		}
+		values[string(sample.Metric[model.LabelName(label)])] = value
+	}
+	return values
+}
@@ -0,0 +1,67 @@
+package business
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestGetMetricsBatch(t *testing.T) {
+	require := require.New(t)
+
+	queryTime := time.Unix(1700000000, 0)
+	vector := func(label string, values map[string]float64) model.Vector {
+		v := model.Vector{}
+		for name, value := range values {
+			v = append(v, &model.Sample{Metric: model.Metric{model.LabelName(label): model.LabelValue(name)}, Value: model.SampleValue(value)})
+		}
+		return v
+	}
+	isLabels := func(errors bool, labels string) func([]string) bool {
+		return func(l []string) bool {
+			return len(l) == 1 && strings.Contains(l[0], labels) && strings.Contains(l[0], "response_code") == errors
+		}
+	}
+
+	prom := new(prometheustest.PromClientMock)
+	prom.On("FetchRateValues", "istio_requests_total", mock.MatchedBy(isLabels(false, `destination_service_name=~"reviews|ratings|details"`)), "destination_service_name", "5m", queryTime).
+		Return(vector("destination_service_name", map[string]float64{"reviews": 10, "ratings": 4}), nil)
+	prom.On("FetchRateValues", "istio_requests_total", mock.MatchedBy(isLabels(true, `response_code=~`)), "destination_service_name", "5m", queryTime).
+		Return(vector("destination_service_name", map[string]float64{"reviews": 1}), nil)
+	prom.On("FetchRateValues", "istio_requests_total", mock.MatchedBy(isLabels(true, `grpc_response_status=~`)), "destination_service_name", "5m", queryTime).
+		Return(vector("destination_service_name", map[string]float64{"reviews": 0.5}), nil)
+	prom.On("FetchHistogramValues", "istio_request_duration_milliseconds", mock.AnythingOfType("string"), "destination_service_name", "5m", false, []string{"0.95"}, queryTime).
+		Return(map[string]model.Vector{"0.95": vector("destination_service_name", map[string]float64{"reviews": 120, "ratings": 30})}, nil)
+
+	srv := NewMetricsService(prom)
+	batch, err := srv.GetMetricsBatch(models.MetricsBatchQuery{
+		Services:     []string{"reviews", "ratings", "details"},
+		RateInterval: "5m",
+		Namespace:    "bookinfo",
+		Time:         queryTime,
+	})
+	require.NoError(err)
+
+	require.Equal("5m", batch.RateInterval)
+	require.Empty(batch.Workloads)
+	require.Len(batch.Services, 3)
+	require.Equal(10.0, batch.Services["reviews"].RequestRate)
+	require.Equal(0.15, batch.Services["reviews"].ErrorRate)
+	require.Equal(120.0, *batch.Services["reviews"].ResponseTimeP95)
+	require.Equal(0.0, batch.Services["ratings"].ErrorRate)
+	require.Equal(models.BatchStats{}, batch.Services["details"])
+
+	_, err = srv.GetMetricsBatch(models.MetricsBatchQuery{Services: make([]string, MaxMetricsBatchSize+1)})
+	require.Error(err)
+}
+
+func TestNamesRegex(t *testing.T) {
+	require.Equal(t, `reviews|ratings\\.v1`, namesRegex([]string{"reviews", "ratings.v1"}))
+}
@@ -0,0 +1,137 @@
+package business
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	prom_v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+)
+
+const (
+	// MaxTrendRange is the longest range of the trends
+	MaxTrendRange = 90 * 24 * time.Hour
+	// maxTrendPoints bounds the number of points of each trend, so that long ranges are queried with coarse steps
+	maxTrendPoints = 300
+)
+
+// trendSteps are the steps the trends are downsampled to: the finest one keeping the trends under maxTrendPoints.
+var trendSteps = []time.Duration{
+	5 * time.Minute,
+	15 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	3 * time.Hour,
+	6 * time.Hour,
+	12 * time.Hour,
+	24 * time.Hour,
+}
+
+// trendStep returns the step used to downsample a range.
+func trendStep(length time.Duration) time.Duration {
+	for _, step := range trendSteps {
+		if length/step <= maxTrendPoints {
+			return step
+		}
+	}
+	return trendSteps[len(trendSteps)-1]
+}
+
+// trendMaxSourceResolution returns the Thanos downsampled blocks worth reading for a range, following the
+// Thanos compactor that downsamples to 5m the blocks older than 40h and to 1h those older than 10d.
+func trendMaxSourceResolution(length time.Duration) string {
+	if !config.Get().ExternalServices.Prometheus.ThanosProxy.Enabled {
+		return ""
+	}
+	switch {
+	case length > 10*24*time.Hour:
+		return "1h"
+	case length > 40*time.Hour:
+		return "5m"
+	}
+	return ""
+}
+
+// GetTrends returns the inbound traffic and error rate trends of a namespace, app, service or workload in a long
+// range. The range is downsampled to a coarse step so that Prometheus evaluates a bounded number of points, and the
+// Thanos downsampled blocks are read when the Thanos proxy is enabled.
+func (in *MetricsService) GetTrends(q models.TrendQuery) (*models.Trends, error) {
+	length := q.End.Sub(q.Start)
+	if length <= 0 || length > MaxTrendRange {
+		return nil, errors.NewBadRequest(fmt.Sprintf("the trends range must be positive and not longer than %s", MaxTrendRange))
+	}
+
+	step := trendStep(length)
+	// The bounds are aligned to the step, so that the same points are queried by subsequent requests
+	bounds := prom_v1.Range{Start: q.Start.Truncate(step), End: q.End.Truncate(step), Step: step}
+	trends := &models.Trends{
+		Start:               bounds.Start,
+		End:                 bounds.End,
+		Step:                int64(step.Seconds()),
+		MaxSourceResolution: trendMaxSourceResolution(length),
+		Traffic:             []models.TrendPoint{},
+		ErrorRate:           []models.TrendPoint{},
+	}
+
+	lb := createMetricsLabelsBuilder(&models.IstioMetricsQuery{
+		App:       q.App,
+		Cluster:   q.Cluster,
+		Direction: "inbound",
+		Namespace: q.Namespace,
+		Reporter:  "destination",
+		Service:   q.Service,
+		Workload:  q.Workload,
+	})
+	rateInterval := model.Duration(step).String()
+
+	traffic, err := in.fetchTrend(lb.Build(), rateInterval, bounds, trends.MaxSourceResolution)
+	if err != nil {
+		return nil, err
+	}
+	failures := map[model.Time]float64{}
+	for _, labels := range lb.BuildForErrors() {
+		values, err := in.fetchTrend(labels, rateInterval, bounds, trends.MaxSourceResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, pair := range values {
+			failures[pair.Timestamp] += float64(pair.Value)
+		}
+	}
+
+	for _, pair := range traffic {
+		timestamp := pair.Timestamp.Unix()
+		rate := float64(pair.Value)
+		errorRate := 0.0
+		if rate > 0 {
+			errorRate = math.Min(failures[pair.Timestamp]/rate, 1)
+		}
+		trends.Traffic = append(trends.Traffic, models.TrendPoint{Timestamp: timestamp, Value: rate})
+		trends.ErrorRate = append(trends.ErrorRate, models.TrendPoint{Timestamp: timestamp, Value: errorRate})
+	}
+	return trends, nil
+}
+
+// fetchTrend returns the points of the request rate of the given labels.
+func (in *MetricsService) fetchTrend(labels, rateInterval string, bounds prom_v1.Range, maxSourceResolution string) ([]model.SamplePair, error) {
+	query := fmt.Sprintf("sum(rate(istio_requests_total%s[%s]))", labels, rateInterval)
+	metric := in.prom.FetchTrend(query, bounds, maxSourceResolution)
+	if metric.Err != nil {
+		return nil, errors.NewServiceUnavailable(metric.Err.Error())
+	}
+	if len(metric.Matrix) == 0 {
+		return nil, nil
+	}
+	values := []model.SamplePair{}
+	for _, pair := range metric.Matrix[0].Values {
+		if !math.IsNaN(float64(pair.Value)) {
+			values = append(values, pair)
+		}
+	}
+	return values, nil
+}
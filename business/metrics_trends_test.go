@@ -0,0 +1,73 @@
+package business
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestTrendStep(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(5*time.Minute, trendStep(24*time.Hour))
+	require.Equal(time.Hour, trendStep(7*24*time.Hour))
+	require.Equal(12*time.Hour, trendStep(90*24*time.Hour))
+}
+
+func TestGetTrends(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Prometheus.ThanosProxy.Enabled = true
+	config.Set(conf)
+
+	end := time.Unix(1700000000, 0)
+	hour := model.TimeFromUnix(end.Truncate(time.Hour).Unix())
+	matrix := func(values ...float64) prometheus.Metric {
+		stream := &model.SampleStream{}
+		for i, value := range values {
+			stream.Values = append(stream.Values, model.SamplePair{Timestamp: hour.Add(time.Duration(i-len(values)+1) * time.Hour), Value: model.SampleValue(value)})
+		}
+		return prometheus.Metric{Matrix: model.Matrix{stream}}
+	}
+
+	prom := new(prometheustest.PromClientMock)
+	isQuery := func(errors bool, labels string) func(string) bool {
+		return func(query string) bool {
+			return strings.Contains(query, labels) && strings.Contains(query, "response_code") == errors
+		}
+	}
+	prom.On("FetchTrend", mock.MatchedBy(isQuery(false, `destination_service_name="reviews"`)), mock.Anything, "5m").Return(matrix(10, 0, 4))
+	prom.On("FetchTrend", mock.MatchedBy(isQuery(true, `response_code=~`)), mock.Anything, "5m").Return(matrix(1, 0, 0))
+	prom.On("FetchTrend", mock.MatchedBy(isQuery(true, `grpc_response_status=~`)), mock.Anything, "5m").Return(prometheus.Metric{})
+
+	srv := NewMetricsService(prom)
+	trends, err := srv.GetTrends(models.TrendQuery{Namespace: "bookinfo", Service: "reviews", Start: end.Add(-7 * 24 * time.Hour), End: end})
+	require.NoError(err)
+
+	require.Equal(int64(3600), trends.Step)
+	require.Equal("5m", trends.MaxSourceResolution)
+	require.Equal(end.Truncate(time.Hour), trends.End)
+	require.Equal([]float64{10, 0, 4}, trendValues(trends.Traffic))
+	require.Equal([]float64{0.1, 0, 0}, trendValues(trends.ErrorRate))
+
+	_, err = srv.GetTrends(models.TrendQuery{Namespace: "bookinfo", Start: end.Add(-100 * 24 * time.Hour), End: end})
+	require.Error(err)
+}
+
+func trendValues(points []models.TrendPoint) []float64 {
+	values := []float64{}
+	for _, point := range points {
+		values = append(values, point.Value)
+	}
+	return values
+}
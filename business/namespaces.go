@@ -91,7 +91,7 @@ func (in *NamespaceService) GetNamespaces(ctx context.Context) ([]models.Namespa
 	clustersToCheck := make(map[string]kubernetes.ClientInterface)
 	namespaces := []models.Namespace{}
 	for cluster, client := range in.userClients {
-		cachedNamespaces, found := in.kialiCache.GetNamespaces(cluster, client.GetToken())
+		cachedNamespaces, found := in.kialiCache.GetNamespaces(cluster, client.GetIdentity())
 		if !found {
 			clustersToCheck[cluster] = client
 		} else {
@@ -152,7 +152,7 @@ func (in *NamespaceService) GetNamespaces(ctx context.Context) ([]models.Namespa
 		namespacesPerCluster[ns.Cluster] = append(namespacesPerCluster[ns.Cluster], ns)
 	}
 	for cluster, ns := range namespacesPerCluster {
-		in.kialiCache.SetNamespaces(in.userClients[cluster].GetToken(), ns)
+		in.kialiCache.SetNamespaces(in.userClients[cluster].GetIdentity(), ns)
 	}
 
 	return namespaces, nil
@@ -271,7 +271,7 @@ func (in *NamespaceService) GetClusterNamespace(ctx context.Context, namespace s
 	}
 
 	// Cache already has discovery selectors applied
-	if ns, found := in.kialiCache.GetNamespace(cluster, client.GetToken(), namespace); found {
+	if ns, found := in.kialiCache.GetNamespace(cluster, client.GetIdentity(), namespace); found {
 		return &ns, nil
 	}
 
@@ -343,10 +343,10 @@ func (in *NamespaceService) UpdateNamespace(ctx context.Context, namespace strin
 }
 
 func (in *NamespaceService) getNamespacesUsingKialiSA(cluster string, labelSelector string, forwardedError error) ([]core_v1.Namespace, error) {
-	// Check if we already are using the Kiali ServiceAccount token. If we are, no need to do further processing, since
-	// this would just circle back to the same results.
-	kialiToken := in.kialiSAClients[cluster].GetToken()
-	if in.userClients[cluster].GetToken() == kialiToken {
+	// Check if we already are using the Kiali ServiceAccount. If we are, no need to do further processing, since
+	// this would just circle back to the same results. A user impersonated by the Kiali ServiceAccount has its token,
+	// but not its identity.
+	if in.userClients[cluster].GetIdentity() == in.kialiSAClients[cluster].GetIdentity() {
 		return nil, forwardedError
 	}
 
@@ -10,10 +10,12 @@ import (
 	v1 "github.com/openshift/api/project/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	auth_v1 "k8s.io/api/authorization/v1"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	kubeclienttesting "k8s.io/client-go/testing"
 
 	"github.com/kiali/kiali/config"
@@ -309,7 +311,7 @@ func TestGetNamespacesCached(t *testing.T) {
 	SetWithBackends(mockClientFactory, nil)
 	cache := cache.NewTestingCacheWithFactory(t, clientFactory, *conf)
 	cache.SetNamespaces(
-		k8s.GetToken(),
+		k8s.GetIdentity(),
 		// gamma only exists in the cache.
 		[]models.Namespace{{Name: "bookinfo", Cluster: "east"}, {Name: "alpha", Cluster: "east"}, {Name: "beta", Cluster: "east"}, {Name: "gamma", Cluster: "west"}},
 	)
@@ -350,11 +352,11 @@ func TestGetNamespacesDifferentTokens(t *testing.T) {
 	clientFactory.SetClients(clients)
 	cache := cache.NewTestingCacheWithFactory(t, clientFactory, *conf)
 	cache.SetNamespaces(
-		east.GetToken(),
+		east.GetIdentity(),
 		[]models.Namespace{{Name: "bookinfo", Cluster: "east"}, {Name: "alpha", Cluster: "east"}, {Name: "beta", Cluster: "east"}},
 	)
 	cache.SetNamespaces(
-		west.GetToken(),
+		west.GetIdentity(),
 		[]models.Namespace{{Name: "gamma", Cluster: "west"}},
 	)
 
@@ -405,7 +407,7 @@ func TestGetNamespacesForbiddenCached(t *testing.T) {
 	SetWithBackends(mockClientFactory, nil)
 	cache := cache.NewTestingCacheWithFactory(t, clientFactory, *conf)
 	cache.SetNamespaces(
-		k8s.GetToken(),
+		k8s.GetIdentity(),
 		// Bookinfo is cached for the west cluster that the user has access to
 		// but NOT for the east cluster that the user doesn't have access to.
 		[]models.Namespace{{Name: "bookinfo", Cluster: "west"}},
@@ -459,3 +461,66 @@ func TestMixedClustersNoError(t *testing.T) {
 	require.Equal("beta", namespaces[1].Name)
 	require.Equal("vanilla", namespaces[1].Cluster)
 }
+
+// Tests that the users impersonated by the Kiali SA, which share its token, don't share the namespaces and the
+// permissions cached for each of them.
+func TestImpersonatedUsersDontShareCaches(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	newClient := func(impersonate string) *kubetest.FakeK8sClient {
+		client := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("alpha"), kubetest.FakeNamespace("beta"))
+		client.Token = "kiali-token"
+		client.Impersonate = impersonate
+		return client
+	}
+	sa := newClient("")
+	// alice can't list the namespaces and can only get alpha, without any Istio config permission
+	alice := newClient("oidc:alice")
+	alice.KubeClientset.(*kubefake.Clientset).PrependReactor("list", "namespaces", func(action kubeclienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(core_v1.Resource("namespaces"), "", fmt.Errorf("forbidden"))
+	})
+	alice.KubeClientset.(*kubefake.Clientset).PrependReactor("get", "namespaces", func(action kubeclienttesting.Action) (bool, runtime.Object, error) {
+		if action.(kubeclienttesting.GetAction).GetName() == "alpha" {
+			return false, nil, nil
+		}
+		return true, nil, errors.NewForbidden(core_v1.Resource("namespaces"), "beta", fmt.Errorf("forbidden"))
+	})
+	// bob can list every namespace and has every Istio config permission
+	bob := newClient("oidc:bob")
+	bob.KubeClientset.(*kubefake.Clientset).PrependReactor("create", "selfsubjectaccessreviews", func(action kubeclienttesting.Action) (bool, runtime.Object, error) {
+		review := action.(kubeclienttesting.CreateAction).GetObject().(*auth_v1.SelfSubjectAccessReview)
+		review.Status.Allowed = true
+		return true, review, nil
+	})
+
+	SetupBusinessLayer(t, sa, *conf)
+	saClients := map[string]kubernetes.ClientInterface{cluster: sa}
+	bobLayer := NewWithBackends(map[string]kubernetes.ClientInterface{cluster: bob}, saClients, nil, nil)
+	aliceLayer := NewWithBackends(map[string]kubernetes.ClientInterface{cluster: alice}, saClients, nil, nil)
+
+	names := func(namespaces []models.Namespace) []string {
+		names := []string{}
+		for _, ns := range namespaces {
+			names = append(names, ns.Name)
+		}
+		slices.Sort(names)
+		return names
+	}
+	namespaces, err := bobLayer.Namespace.GetNamespaces(context.TODO())
+	require.NoError(err)
+	require.Equal([]string{"alpha", "beta"}, names(namespaces))
+	// The namespaces of alice are the namespaces of the Kiali SA she can get
+	namespaces, err = aliceLayer.Namespace.GetNamespaces(context.TODO())
+	require.NoError(err)
+	require.Equal([]string{"alpha"}, names(namespaces))
+
+	sidecars := kubernetes.Sidecars.String()
+	permissions := bobLayer.IstioConfig.GetIstioConfigPermissions(context.TODO(), []string{"alpha"}, cluster)
+	require.True((*permissions["alpha"])[sidecars].Create)
+	permissions = aliceLayer.IstioConfig.GetIstioConfigPermissions(context.TODO(), []string{"alpha"}, cluster)
+	require.False((*permissions["alpha"])[sidecars].Create)
+}
@@ -0,0 +1,315 @@
+package business
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/httputil"
+)
+
+// notificationResource is the resource reported by the not found errors.
+var notificationResource = schema.GroupResource{Group: "kiali.io", Resource: "notifications"}
+
+// notificationsLock serializes the deliveries and the retries of the dead letters, which all rewrite the queued
+// notifications. The services are created per request, so the lock is shared by all of them.
+var notificationsLock sync.Mutex
+
+// NotificationStore persists the notifications until they are delivered or their dead letter expires.
+type NotificationStore interface {
+	Save(notification *models.Notification) error
+	// List returns every notification, dead or not, in no particular order.
+	List() ([]models.Notification, error)
+	// Get returns a not found error when the notification doesn't exist.
+	Get(id string) (*models.Notification, error)
+	Delete(id string) error
+}
+
+// FileNotificationStore stores each notification as a JSON file of a directory.
+type FileNotificationStore struct {
+	directory string
+}
+
+// NewFileNotificationStore creates a new FileNotificationStore. The directory is created on the first save.
+func NewFileNotificationStore(directory string) *FileNotificationStore {
+	return &FileNotificationStore{directory: directory}
+}
+
+func (in *FileNotificationStore) path(id string) (string, error) {
+	// The IDs have the format of the snapshot IDs
+	if !snapshotIDRegexp.MatchString(id) {
+		return "", api_errors.NewNotFound(notificationResource, id)
+	}
+	return filepath.Join(in.directory, id+".json"), nil
+}
+
+func (in *FileNotificationStore) Save(notification *models.Notification) error {
+	path, err := in.path(notification.ID)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(in.directory, 0o750); err != nil {
+		return err
+	}
+	content, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o640); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func (in *FileNotificationStore) List() ([]models.Notification, error) {
+	entries, err := os.ReadDir(in.directory)
+	if os.IsNotExist(err) {
+		return []models.Notification{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := []models.Notification{}
+	for _, entry := range entries {
+		id, isNotification := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !isNotification || !snapshotIDRegexp.MatchString(id) {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(in.directory, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		n := models.Notification{}
+		if err := json.Unmarshal(content, &n); err != nil {
+			log.Errorf("Skipping invalid notification file [%s]: %s", entry.Name(), err)
+			continue
+		}
+		notifications = append(notifications, n)
+	}
+	return notifications, nil
+}
+
+func (in *FileNotificationStore) Get(id string) (*models.Notification, error) {
+	path, err := in.path(id)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, api_errors.NewNotFound(notificationResource, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	notification := &models.Notification{}
+	if err := json.Unmarshal(content, notification); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+func (in *FileNotificationStore) Delete(id string) error {
+	path, err := in.path(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// NotificationService queues the webhook notifications and delivers them, retrying the failed ones with an
+// exponential backoff. The notifications still failing after the max attempts are kept as dead letters.
+type NotificationService struct {
+	conf  *config.Config
+	store NotificationStore
+}
+
+// NewNotificationService creates a new NotificationService queuing the notifications in the configured directory.
+func NewNotificationService(conf *config.Config) NotificationService {
+	return NotificationService{
+		conf:  conf,
+		store: NewFileNotificationStore(conf.Notifications.Directory),
+	}
+}
+
+// Enqueue queues the payload to be posted to the webhook of the source by the next delivery.
+func (in *NotificationService) Enqueue(source string, payload any, now time.Time) (*models.Notification, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	bodyHash := sha256.Sum256(append([]byte(source+"/"), body...))
+	notification := &models.Notification{
+		ID:            fmt.Sprintf("%d-%s", now.UnixNano(), hex.EncodeToString(bodyHash[:4])),
+		Source:        source,
+		Payload:       body,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}
+	if err := in.store.Save(notification); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+// Deliver posts the notifications due at the given time, oldest first, and removes the expired dead letters. A failed
+// notification is retried after a backoff doubling at each attempt, until it becomes a dead letter.
+func (in *NotificationService) Deliver(ctx context.Context, now time.Time) error {
+	notificationsLock.Lock()
+	defer notificationsLock.Unlock()
+
+	conf := in.conf.Notifications
+	initialBackoff, err := model.ParseDuration(conf.InitialBackoff)
+	if err != nil {
+		return err
+	}
+	maxBackoff, err := model.ParseDuration(conf.MaxBackoff)
+	if err != nil {
+		return err
+	}
+	retention, err := model.ParseDuration(conf.DeadLetterRetention)
+	if err != nil {
+		return err
+	}
+
+	notifications, err := in.store.List()
+	if err != nil {
+		return err
+	}
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].CreatedAt.Before(notifications[j].CreatedAt)
+	})
+
+	for i := range notifications {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		notification := &notifications[i]
+		if notification.DeadAt != nil {
+			if !now.Before(notification.DeadAt.Add(time.Duration(retention))) {
+				if err := in.store.Delete(notification.ID); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if now.Before(notification.NextAttemptAt) {
+			continue
+		}
+
+		postErr := in.post(notification)
+		if postErr == nil {
+			if err := in.store.Delete(notification.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		notification.Attempts++
+		notification.LastError = postErr.Error()
+		if notification.Attempts >= conf.MaxAttempts {
+			deadAt := now
+			notification.DeadAt = &deadAt
+			log.Errorf("Notification [%s] of [%s] failed %d times and is kept as a dead letter: %s", notification.ID, notification.Source, notification.Attempts, postErr)
+		} else {
+			backoff := time.Duration(initialBackoff) << (notification.Attempts - 1)
+			if backoff <= 0 || backoff > time.Duration(maxBackoff) {
+				backoff = time.Duration(maxBackoff)
+			}
+			notification.NextAttemptAt = now.Add(backoff)
+			log.Debugf("Notification [%s] of [%s] failed, retrying in %s: %s", notification.ID, notification.Source, backoff, postErr)
+		}
+		if err := in.store.Save(notification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// post posts the notification to the webhook of its source.
+func (in *NotificationService) post(notification *models.Notification) error {
+	var webhook config.TrafficBaselineWebhook
+	switch notification.Source {
+	case models.NotificationSourceTrafficBaseline:
+		webhook = in.conf.TrafficBaseline.Webhook
+	default:
+		return fmt.Errorf("unknown notification source [%s]", notification.Source)
+	}
+	if webhook.URL == "" {
+		return fmt.Errorf("no webhook is configured for the notifications of [%s]", notification.Source)
+	}
+
+	headers := map[string]string{"Content-Type": "application/json"}
+	for k, v := range webhook.CustomHeaders {
+		headers[k] = v
+	}
+	_, code, _, err := httputil.HttpPost(webhook.URL, &webhook.Auth, bytes.NewReader(notification.Payload), httputil.DefaultTimeout, headers)
+	if err != nil {
+		return err
+	}
+	if code >= 300 {
+		return fmt.Errorf("webhook [%s] responded with status code [%d]", webhook.URL, code)
+	}
+	return nil
+}
+
+// ListDeadLetters returns the notifications that failed all their attempts, the most recent first.
+func (in *NotificationService) ListDeadLetters() ([]models.Notification, error) {
+	notifications, err := in.store.List()
+	if err != nil {
+		return nil, err
+	}
+	deadLetters := []models.Notification{}
+	for _, notification := range notifications {
+		if notification.DeadAt != nil {
+			deadLetters = append(deadLetters, notification)
+		}
+	}
+	sort.Slice(deadLetters, func(i, j int) bool {
+		return deadLetters[i].DeadAt.After(*deadLetters[j].DeadAt)
+	})
+	return deadLetters, nil
+}
+
+// RetryDeadLetter queues a dead letter again for the next delivery. Its attempts are reset: it is delivered up to the
+// max attempts again, with the initial backoff, before becoming a dead letter again. It returns a not found error when
+// the notification doesn't exist or is not a dead letter.
+func (in *NotificationService) RetryDeadLetter(id string, now time.Time) (*models.Notification, error) {
+	notificationsLock.Lock()
+	defer notificationsLock.Unlock()
+
+	notification, err := in.store.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if notification.DeadAt == nil {
+		return nil, api_errors.NewNotFound(notificationResource, id)
+	}
+	notification.Attempts = 0
+	notification.DeadAt = nil
+	notification.NextAttemptAt = now
+	if err := in.store.Save(notification); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
@@ -0,0 +1,165 @@
+package business
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+)
+
+func TestDeliverNotificationsRetriesWithBackoff(t *testing.T) {
+	require := require.New(t)
+
+	failing := true
+	deliveries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveries++
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	conf := config.NewConfig()
+	conf.Notifications.Directory = t.TempDir()
+	conf.Notifications.MaxAttempts = 3
+	conf.Notifications.InitialBackoff = "1m"
+	conf.Notifications.MaxBackoff = "90s"
+	conf.TrafficBaseline.Webhook.URL = server.URL
+	notificationService := NewNotificationService(conf)
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	notification, err := notificationService.Enqueue(models.NotificationSourceTrafficBaseline, map[string]string{"service": "ratings"}, now)
+	require.NoError(err)
+
+	require.NoError(notificationService.Deliver(context.TODO(), now))
+	require.Equal(1, deliveries)
+	queued, err := notificationService.store.Get(notification.ID)
+	require.NoError(err)
+	require.Equal(1, queued.Attempts)
+	require.Equal(now.Add(time.Minute), queued.NextAttemptAt)
+	require.Contains(queued.LastError, "503")
+
+	// Not due yet
+	require.NoError(notificationService.Deliver(context.TODO(), now.Add(30*time.Second)))
+	require.Equal(1, deliveries)
+
+	// The backoff doubles, up to the max backoff
+	require.NoError(notificationService.Deliver(context.TODO(), now.Add(time.Minute)))
+	require.Equal(2, deliveries)
+	queued, err = notificationService.store.Get(notification.ID)
+	require.NoError(err)
+	require.Equal(now.Add(time.Minute+90*time.Second), queued.NextAttemptAt)
+
+	failing = false
+	require.NoError(notificationService.Deliver(context.TODO(), queued.NextAttemptAt))
+	require.Equal(3, deliveries)
+	_, err = notificationService.store.Get(notification.ID)
+	require.True(api_errors.IsNotFound(err))
+}
+
+func TestDeadLetterNotifications(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	conf := config.NewConfig()
+	conf.Notifications.Directory = t.TempDir()
+	conf.Notifications.MaxAttempts = 1
+	conf.Notifications.DeadLetterRetention = "1h"
+	conf.TrafficBaseline.Webhook.URL = server.URL
+	notificationService := NewNotificationService(conf)
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	notification, err := notificationService.Enqueue(models.NotificationSourceTrafficBaseline, []string{}, now)
+	require.NoError(err)
+	require.NoError(notificationService.Deliver(context.TODO(), now))
+
+	deadLetters, err := notificationService.ListDeadLetters()
+	require.NoError(err)
+	require.Len(deadLetters, 1)
+	require.Equal(notification.ID, deadLetters[0].ID)
+	require.Equal(now, *deadLetters[0].DeadAt)
+
+	// Dead letters are not delivered again until retried
+	require.NoError(notificationService.Deliver(context.TODO(), now.Add(time.Minute)))
+	retried, err := notificationService.RetryDeadLetter(notification.ID, now.Add(time.Minute))
+	require.NoError(err)
+	require.Nil(retried.DeadAt)
+	require.Equal(0, retried.Attempts)
+	deadLetters, err = notificationService.ListDeadLetters()
+	require.NoError(err)
+	require.Empty(deadLetters)
+
+	// Only dead letters can be retried
+	_, err = notificationService.RetryDeadLetter(notification.ID, now.Add(time.Minute))
+	require.True(api_errors.IsNotFound(err))
+
+	// Failed again, then expired after the retention
+	require.NoError(notificationService.Deliver(context.TODO(), now.Add(time.Minute)))
+	require.NoError(notificationService.Deliver(context.TODO(), now.Add(time.Hour+time.Minute)))
+	notifications, err := notificationService.store.List()
+	require.NoError(err)
+	require.Empty(notifications)
+}
+
+func TestRetriedDeadLetterGetsMaxAttemptsAgain(t *testing.T) {
+	require := require.New(t)
+
+	deliveries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveries++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	conf := config.NewConfig()
+	conf.Notifications.Directory = t.TempDir()
+	conf.Notifications.MaxAttempts = 2
+	conf.Notifications.InitialBackoff = "1m"
+	conf.TrafficBaseline.Webhook.URL = server.URL
+	notificationService := NewNotificationService(conf)
+
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	notification, err := notificationService.Enqueue(models.NotificationSourceTrafficBaseline, []string{}, now)
+	require.NoError(err)
+	require.NoError(notificationService.Deliver(context.TODO(), now))
+	require.NoError(notificationService.Deliver(context.TODO(), now.Add(time.Minute)))
+	dead, err := notificationService.store.Get(notification.ID)
+	require.NoError(err)
+	require.NotNil(dead.DeadAt)
+	require.Equal(2, dead.Attempts)
+
+	now = now.Add(time.Hour)
+	retried, err := notificationService.RetryDeadLetter(notification.ID, now)
+	require.NoError(err)
+	require.Equal(0, retried.Attempts)
+	require.Equal(now, retried.NextAttemptAt)
+
+	// The first failure after the retry backs off instead of ending as a dead letter
+	require.NoError(notificationService.Deliver(context.TODO(), now))
+	queued, err := notificationService.store.Get(notification.ID)
+	require.NoError(err)
+	require.Nil(queued.DeadAt)
+	require.Equal(1, queued.Attempts)
+	require.Equal(now.Add(time.Minute), queued.NextAttemptAt)
+
+	require.NoError(notificationService.Deliver(context.TODO(), now.Add(time.Minute)))
+	dead, err = notificationService.store.Get(notification.ID)
+	require.NoError(err)
+	require.NotNil(dead.DeadAt)
+	require.Equal(2, dead.Attempts)
+	require.Equal(4, deliveries)
+}
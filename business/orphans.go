@@ -0,0 +1,329 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+)
+
+// DefaultOrphanTrafficWindow is the window used to look for ServiceEntries traffic when none is provided.
+const DefaultOrphanTrafficWindow = "7d"
+
+// OrphanService detects Istio config that is likely not used anymore.
+type OrphanService struct {
+	businessLayer *Layer
+	kialiCache    cache.KialiCache
+	prom          prometheus.ClientInterface
+}
+
+// NewOrphanService creates a new OrphanService.
+func NewOrphanService(businessLayer *Layer, kialiCache cache.KialiCache, prom prometheus.ClientInterface) OrphanService {
+	return OrphanService{
+		businessLayer: businessLayer,
+		kialiCache:    kialiCache,
+		prom:          prom,
+	}
+}
+
+// GetOrphanReport returns the likely orphaned Istio objects of a namespace:
+// VirtualServices whose hosts and route destinations match no service, DestinationRules whose host matches no service,
+// Gateways not bound to any VirtualService and ServiceEntries without traffic during the traffic window.
+func (in *OrphanService) GetOrphanReport(ctx context.Context, cluster, namespace string, trafficWindow time.Duration) (*models.OrphanReport, error) {
+	criteria := IstioConfigCriteria{
+		IncludeDestinationRules: true,
+		IncludeGateways:         true,
+		IncludeServiceEntries:   true,
+		IncludeVirtualServices:  true,
+	}
+	// Checks the user access to the namespace
+	istioConfigList, err := in.businessLayer.IstioConfig.GetIstioConfigListForNamespace(ctx, cluster, namespace, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Objects can reference services and gateways from any namespace,
+	// so the lookups are done against the whole cluster.
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	services, err := kubeCache.GetServices(metav1.NamespaceAll, "")
+	if err != nil {
+		return nil, err
+	}
+	serviceEntries, err := kubeCache.GetServiceEntries(ctx, metav1.NamespaceAll, "")
+	if err != nil {
+		return nil, err
+	}
+	virtualServices, err := kubeCache.GetVirtualServices(ctx, metav1.NamespaceAll, "")
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := newOrphanHostMatcher(services, serviceEntries)
+	report := &models.OrphanReport{
+		Cluster:       cluster,
+		GeneratedAt:   time.Now(),
+		Namespace:     namespace,
+		TrafficWindow: model.Duration(trafficWindow).String(),
+		Objects:       []models.OrphanedObject{},
+	}
+
+	for _, vs := range istioConfigList.VirtualServices {
+		if !matcher.virtualServiceMatches(vs) {
+			report.Objects = append(report.Objects, models.OrphanedObject{
+				ObjectGVK: kubernetes.VirtualServices,
+				Name:      vs.Name,
+				Namespace: vs.Namespace,
+				Reason:    models.OrphanReasonNoHostMatch,
+				Message:   "No service or service entry matches the hosts and route destinations",
+			})
+		}
+	}
+
+	for _, dr := range istioConfigList.DestinationRules {
+		if !matcher.matches(dr.Spec.Host, dr.Namespace) {
+			report.Objects = append(report.Objects, models.OrphanedObject{
+				ObjectGVK: kubernetes.DestinationRules,
+				Name:      dr.Name,
+				Namespace: dr.Namespace,
+				Reason:    models.OrphanReasonNoService,
+				Message:   fmt.Sprintf("No service or service entry matches the host [%s]", dr.Spec.Host),
+			})
+		}
+	}
+
+	boundGateways := kubernetes.FilterGatewaysByVirtualServices(istioConfigList.Gateways, virtualServices)
+	for _, gw := range kubernetes.FilterAutogeneratedGateways(istioConfigList.Gateways) {
+		bound := false
+		for _, bgw := range boundGateways {
+			if bgw.Name == gw.Name && bgw.Namespace == gw.Namespace {
+				bound = true
+				break
+			}
+		}
+		if !bound {
+			report.Objects = append(report.Objects, models.OrphanedObject{
+				ObjectGVK: kubernetes.Gateways,
+				Name:      gw.Name,
+				Namespace: gw.Namespace,
+				Reason:    models.OrphanReasonNoVirtualService,
+				Message:   "No VirtualService is bound to the gateway",
+			})
+		}
+	}
+
+	if len(istioConfigList.ServiceEntries) > 0 {
+		unused, err := in.getServiceEntriesWithoutTraffic(cluster, istioConfigList.ServiceEntries, report.GeneratedAt, trafficWindow)
+		if err != nil {
+			// Reporting fewer orphans is safer than flagging ServiceEntries whose traffic is unknown.
+			log.Warningf("Unable to fetch the ServiceEntries traffic of namespace [%s] in cluster [%s]: %s", namespace, cluster, err)
+		}
+		for _, se := range unused {
+			report.Objects = append(report.Objects, models.OrphanedObject{
+				ObjectGVK: kubernetes.ServiceEntries,
+				Name:      se.Name,
+				Namespace: se.Namespace,
+				Reason:    models.OrphanReasonNoTraffic,
+				Message:   fmt.Sprintf("No traffic observed in the last %s", report.TrafficWindow),
+			})
+		}
+	}
+
+	sort.SliceStable(report.Objects, func(i, j int) bool {
+		if report.Objects[i].ObjectGVK.Kind != report.Objects[j].ObjectGVK.Kind {
+			return report.Objects[i].ObjectGVK.Kind < report.Objects[j].ObjectGVK.Kind
+		}
+		return report.Objects[i].Name < report.Objects[j].Name
+	})
+
+	return report, nil
+}
+
+// DeleteOrphans deletes the requested objects that are still reported as orphaned.
+// Objects that are not orphaned anymore, or that the user is not allowed to delete, are skipped.
+func (in *OrphanService) DeleteOrphans(ctx context.Context, cluster, namespace, user string, trafficWindow time.Duration, objects []models.IstioReference) (*models.OrphanCleanupResult, error) {
+	report, err := in.GetOrphanReport(ctx, cluster, namespace, trafficWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.OrphanCleanupResult{
+		Deleted: []models.IstioReference{},
+		Skipped: map[string]string{},
+	}
+	for _, ref := range objects {
+		key := fmt.Sprintf("%s/%s/%s", ref.ObjectGVK.Kind, ref.Namespace, ref.Name)
+		if !report.Contains(ref) {
+			result.Skipped[key] = "object is not orphaned"
+			continue
+		}
+		if err := in.businessLayer.IstioConfig.CheckTeamOwnership(ctx, cluster, ref.Namespace, ref.ObjectGVK, ref.Name, user); err != nil {
+			result.Skipped[key] = err.Error()
+			continue
+		}
+		mutation := models.IstioConfigMutation{Operation: models.IstioConfigMutationDelete, Cluster: cluster, Namespace: ref.Namespace, ObjectGVK: ref.ObjectGVK, Name: ref.Name, User: user}
+		if err := in.businessLayer.IstioConfig.ReviewMutation(ctx, mutation, nil); err != nil {
+			result.Skipped[key] = err.Error()
+			continue
+		}
+		if err := in.businessLayer.IstioConfig.DeleteIstioConfigDetail(ctx, cluster, ref.Namespace, ref.ObjectGVK, ref.Name); err != nil {
+			result.Skipped[key] = err.Error()
+			continue
+		}
+		in.businessLayer.IstioConfig.RecordConfigChange(cluster, ref.Namespace, ref.ObjectGVK, ref.Name, models.IstioConfigMutationDelete, user)
+		result.Deleted = append(result.Deleted, ref)
+	}
+
+	return result, nil
+}
+
+// getServiceEntriesWithoutTraffic returns the ServiceEntries whose hosts had no HTTP/gRPC requests
+// nor TCP connections from the cluster during the traffic window.
+func (in *OrphanService) getServiceEntriesWithoutTraffic(cluster string, serviceEntries []*networking_v1.ServiceEntry, queryTime time.Time, trafficWindow time.Duration) ([]*networking_v1.ServiceEntry, error) {
+	hostPatterns := []string{}
+	for _, se := range serviceEntries {
+		for _, host := range se.Spec.Hosts {
+			hostPatterns = append(hostPatterns, hostToRegex(host))
+		}
+	}
+	if len(hostPatterns) == 0 {
+		return nil, nil
+	}
+
+	// Raw strings avoid escaping the regex backslashes in PromQL.
+	labels := fmt.Sprintf("{source_cluster=\"%s\",destination_service=~`%s`}", cluster, strings.Join(hostPatterns, "|"))
+	activeHosts := []string{}
+	for _, metricName := range []string{"istio_requests_total", "istio_tcp_connections_opened_total"} {
+		metric := in.prom.FetchDelta(metricName, labels, "", queryTime, trafficWindow)
+		if metric.Err != nil {
+			return nil, metric.Err
+		}
+		for _, stream := range metric.Matrix {
+			for _, value := range stream.Values {
+				if value.Value > 0 {
+					activeHosts = append(activeHosts, string(stream.Metric["destination_service"]))
+					break
+				}
+			}
+		}
+	}
+
+	unused := []*networking_v1.ServiceEntry{}
+	for _, se := range serviceEntries {
+		active := false
+		for _, host := range se.Spec.Hosts {
+			re, err := regexp.Compile("^" + hostToRegex(host) + "$")
+			if err != nil {
+				continue
+			}
+			for _, activeHost := range activeHosts {
+				if re.MatchString(activeHost) {
+					active = true
+					break
+				}
+			}
+			if active {
+				break
+			}
+		}
+		if !active {
+			unused = append(unused, se)
+		}
+	}
+	return unused, nil
+}
+
+// hostToRegex converts an Istio host, possibly with a wildcard prefix, into a regular expression.
+func hostToRegex(host string) string {
+	return strings.ReplaceAll(regexp.QuoteMeta(host), `\*`, ".*")
+}
+
+// orphanHostMatcher checks whether hosts match a cluster service or a ServiceEntry.
+type orphanHostMatcher struct {
+	namespaces        []string
+	services          map[string]bool
+	serviceEntryHosts map[string][]string
+}
+
+func newOrphanHostMatcher(services []core_v1.Service, serviceEntries []*networking_v1.ServiceEntry) orphanHostMatcher {
+	matcher := orphanHostMatcher{
+		services:          make(map[string]bool, len(services)),
+		serviceEntryHosts: make(map[string][]string),
+	}
+	namespaces := map[string]bool{}
+	for _, svc := range services {
+		matcher.services[svc.Name+"."+svc.Namespace] = true
+		namespaces[svc.Namespace] = true
+	}
+	for ns := range namespaces {
+		matcher.namespaces = append(matcher.namespaces, ns)
+	}
+	for _, se := range serviceEntries {
+		for _, host := range se.Spec.Hosts {
+			matcher.serviceEntryHosts[host] = append(matcher.serviceEntryHosts[host], se.Namespace)
+		}
+	}
+	return matcher
+}
+
+// matches returns true when the host, defined in the given namespace, matches a service or a ServiceEntry.
+// Wildcard hosts are always considered a match.
+func (m orphanHostMatcher) matches(host, namespace string) bool {
+	if host == "" || strings.HasPrefix(host, "*") {
+		return true
+	}
+
+	parsedHost := kubernetes.GetHost(host, namespace, m.namespaces)
+	if parsedHost.CompleteInput && m.services[parsedHost.Service+"."+parsedHost.Namespace] {
+		return true
+	}
+
+	return kubernetes.HasMatchingServiceEntries(host, m.serviceEntryHosts)
+}
+
+// virtualServiceMatches returns true when any of the hosts or route destinations of the VirtualService
+// matches a service or a ServiceEntry.
+func (m orphanHostMatcher) virtualServiceMatches(vs *networking_v1.VirtualService) bool {
+	for _, host := range vs.Spec.Hosts {
+		if m.matches(host, vs.Namespace) {
+			return true
+		}
+	}
+	for _, route := range vs.Spec.Http {
+		for _, dest := range route.Route {
+			if dest != nil && dest.Destination != nil && m.matches(dest.Destination.Host, vs.Namespace) {
+				return true
+			}
+		}
+	}
+	for _, route := range vs.Spec.Tcp {
+		for _, dest := range route.Route {
+			if dest != nil && dest.Destination != nil && m.matches(dest.Destination.Host, vs.Namespace) {
+				return true
+			}
+		}
+	}
+	for _, route := range vs.Spec.Tls {
+		for _, dest := range route.Route {
+			if dest != nil && dest.Destination != nil && m.matches(dest.Destination.Host, vs.Namespace) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package business
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func newOrphanTestLayer(t *testing.T, prom prometheus.ClientInterface) *Layer {
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	objects := []runtime.Object{
+		kubetest.FakeNamespace("bookinfo"),
+		&core_v1.Service{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		// Host matches a service
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+		// Host doesn't match but the destination does
+		data.AddGatewaysToVirtualService([]string{"bookinfo-gateway"},
+			data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews.bookinfo.svc.cluster.local", "", -1),
+				data.CreateEmptyVirtualService("ingress", "bookinfo", []string{"bookinfo.example.com"}))),
+		data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("ratings", "", -1),
+			data.CreateEmptyVirtualService("ratings", "bookinfo", []string{"ratings"})),
+		data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews.bookinfo.svc.cluster.local"),
+		data.CreateEmptyDestinationRule("bookinfo", "details", "details"),
+		// Host matches a ServiceEntry
+		data.CreateEmptyDestinationRule("bookinfo", "httpbin", "httpbin.org"),
+		data.CreateEmptyGateway("bookinfo-gateway", "bookinfo", nil),
+		data.CreateEmptyGateway("unused-gateway", "bookinfo", nil),
+		data.CreateEmptyMeshExternalServiceEntry("httpbin", "bookinfo", []string{"httpbin.org"}),
+		data.CreateEmptyMeshExternalServiceEntry("wildcard", "bookinfo", []string{"*.example.org"}),
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	return NewWithBackends(k8sclients, k8sclients, prom, nil)
+}
+
+func TestGetOrphanReport(t *testing.T) {
+	require := require.New(t)
+
+	prom := new(prometheustest.PromClientMock)
+	prom.On("FetchDelta", "istio_requests_total", mock.AnythingOfType("string"), "", mock.AnythingOfType("time.Time"), 7*24*time.Hour).
+		Return(prometheus.Metric{Matrix: model.Matrix{
+			{Metric: model.Metric{"destination_service": "api.example.org"}, Values: []model.SamplePair{{Value: 10}}},
+			{Metric: model.Metric{"destination_service": "httpbin.org"}, Values: []model.SamplePair{{Value: 0}}},
+		}})
+	prom.On("FetchDelta", "istio_tcp_connections_opened_total", mock.AnythingOfType("string"), "", mock.AnythingOfType("time.Time"), 7*24*time.Hour).
+		Return(prometheus.Metric{})
+
+	layer := newOrphanTestLayer(t, prom)
+	report, err := layer.Orphans.GetOrphanReport(context.TODO(), config.Get().KubernetesConfig.ClusterName, "bookinfo", 7*24*time.Hour)
+	require.NoError(err)
+	require.Equal("1w", report.TrafficWindow)
+
+	orphans := map[string]models.OrphanReason{}
+	for _, o := range report.Objects {
+		orphans[o.ObjectGVK.Kind+"/"+o.Name] = o.Reason
+	}
+	require.Equal(map[string]models.OrphanReason{
+		"DestinationRule/details": models.OrphanReasonNoService,
+		"Gateway/unused-gateway":  models.OrphanReasonNoVirtualService,
+		"ServiceEntry/httpbin":    models.OrphanReasonNoTraffic,
+		"VirtualService/ratings":  models.OrphanReasonNoHostMatch,
+	}, orphans)
+}
+
+func TestGetOrphanReportWithoutPrometheus(t *testing.T) {
+	require := require.New(t)
+
+	prom := new(prometheustest.PromClientMock)
+	prom.On("FetchDelta", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(prometheus.Metric{Err: context.DeadlineExceeded})
+
+	layer := newOrphanTestLayer(t, prom)
+	report, err := layer.Orphans.GetOrphanReport(context.TODO(), config.Get().KubernetesConfig.ClusterName, "bookinfo", time.Hour)
+	require.NoError(err)
+	// ServiceEntries are not reported when their traffic is unknown
+	for _, o := range report.Objects {
+		require.NotEqual(models.OrphanReasonNoTraffic, o.Reason)
+	}
+	require.Len(report.Objects, 3)
+}
+
+func TestDeleteOrphans(t *testing.T) {
+	require := require.New(t)
+
+	prom := new(prometheustest.PromClientMock)
+	prom.On("FetchDelta", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(prometheus.Metric{})
+
+	layer := newOrphanTestLayer(t, prom)
+	cluster := config.Get().KubernetesConfig.ClusterName
+	objects := []models.IstioReference{
+		{ObjectGVK: kubernetes.Gateways, Name: "unused-gateway", Namespace: "bookinfo"},
+		{ObjectGVK: kubernetes.Gateways, Name: "bookinfo-gateway", Namespace: "bookinfo"},
+	}
+
+	result, err := layer.Orphans.DeleteOrphans(context.TODO(), cluster, "bookinfo", "", time.Hour, objects)
+	require.NoError(err)
+	require.Equal([]models.IstioReference{objects[0]}, result.Deleted)
+	require.Contains(result.Skipped, "Gateway/bookinfo/bookinfo-gateway")
+}
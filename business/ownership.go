@@ -0,0 +1,147 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/models"
+)
+
+// CheckTeamOwnership returns a Forbidden error when the ownership enforcement is enabled and the
+// object is owned by a team the user is not a member of. Objects without a team can be modified by anyone.
+func (in *IstioConfigService) CheckTeamOwnership(ctx context.Context, cluster, namespace string, resourceType schema.GroupVersionKind, name, user string) error {
+	ownership := in.config.Ownership
+	if !ownership.Enabled || !ownership.EnforceOnWrite {
+		return nil
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return err
+	}
+
+	obj, err := getCachedIstioObject(ctx, kubeCache, namespace, resourceType, name)
+	if err != nil {
+		// Let the write operation report objects that don't exist.
+		if api_errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return checkTeamLabel(ownership, obj.GetLabels(), resourceType, name, user)
+}
+
+// CheckTeamOwnershipForPayload returns a Forbidden error when the ownership enforcement is enabled and the
+// create body or update patch sets the object's team to a team the user is not a member of.
+func (in *IstioConfigService) CheckTeamOwnershipForPayload(resourceType schema.GroupVersionKind, payload []byte, user string) error {
+	ownership := in.config.Ownership
+	if !ownership.Enabled || !ownership.EnforceOnWrite {
+		return nil
+	}
+
+	var obj struct {
+		Metadata meta_v1.ObjectMeta `json:"metadata"`
+	}
+	if err := json.Unmarshal(payload, &obj); err != nil {
+		return api_errors.NewBadRequest(err.Error())
+	}
+
+	return checkTeamLabel(ownership, obj.Metadata.Labels, resourceType, obj.Metadata.Name, user)
+}
+
+func checkTeamLabel(ownership config.Ownership, labels map[string]string, resourceType schema.GroupVersionKind, name, user string) error {
+	team, found := labels[ownership.TeamLabel]
+	if !found || team == "" {
+		return nil
+	}
+
+	for _, userTeam := range ownership.TeamsForUser(user) {
+		if userTeam == team {
+			return nil
+		}
+	}
+
+	resource := schema.GroupResource{Group: resourceType.Group, Resource: resourceType.Kind}
+	return api_errors.NewForbidden(resource, name, fmt.Errorf("object is owned by team [%s] and user [%s] is not a member of it", team, user))
+}
+
+// filterByTeams keeps only the objects of the list owned by one of the given teams.
+func filterByTeams(istioConfigList *models.IstioConfigList, teamLabel string, teams []string) {
+	istioConfigList.AuthorizationPolicies = kubernetes.FilterByLabelValues(istioConfigList.AuthorizationPolicies, teamLabel, teams)
+	istioConfigList.DestinationRules = kubernetes.FilterByLabelValues(istioConfigList.DestinationRules, teamLabel, teams)
+	istioConfigList.EnvoyFilters = kubernetes.FilterByLabelValues(istioConfigList.EnvoyFilters, teamLabel, teams)
+	istioConfigList.Gateways = kubernetes.FilterByLabelValues(istioConfigList.Gateways, teamLabel, teams)
+	istioConfigList.K8sGateways = kubernetes.FilterByLabelValues(istioConfigList.K8sGateways, teamLabel, teams)
+	istioConfigList.K8sGRPCRoutes = kubernetes.FilterByLabelValues(istioConfigList.K8sGRPCRoutes, teamLabel, teams)
+	istioConfigList.K8sHTTPRoutes = kubernetes.FilterByLabelValues(istioConfigList.K8sHTTPRoutes, teamLabel, teams)
+	istioConfigList.K8sReferenceGrants = kubernetes.FilterByLabelValues(istioConfigList.K8sReferenceGrants, teamLabel, teams)
+	istioConfigList.K8sTCPRoutes = kubernetes.FilterByLabelValues(istioConfigList.K8sTCPRoutes, teamLabel, teams)
+	istioConfigList.K8sTLSRoutes = kubernetes.FilterByLabelValues(istioConfigList.K8sTLSRoutes, teamLabel, teams)
+	istioConfigList.PeerAuthentications = kubernetes.FilterByLabelValues(istioConfigList.PeerAuthentications, teamLabel, teams)
+	istioConfigList.ProxyConfigs = kubernetes.FilterByLabelValues(istioConfigList.ProxyConfigs, teamLabel, teams)
+	istioConfigList.RequestAuthentications = kubernetes.FilterByLabelValues(istioConfigList.RequestAuthentications, teamLabel, teams)
+	istioConfigList.ServiceEntries = kubernetes.FilterByLabelValues(istioConfigList.ServiceEntries, teamLabel, teams)
+	istioConfigList.Sidecars = kubernetes.FilterByLabelValues(istioConfigList.Sidecars, teamLabel, teams)
+	istioConfigList.Telemetries = kubernetes.FilterByLabelValues(istioConfigList.Telemetries, teamLabel, teams)
+	istioConfigList.VirtualServices = kubernetes.FilterByLabelValues(istioConfigList.VirtualServices, teamLabel, teams)
+	istioConfigList.WasmPlugins = kubernetes.FilterByLabelValues(istioConfigList.WasmPlugins, teamLabel, teams)
+	istioConfigList.WorkloadEntries = kubernetes.FilterByLabelValues(istioConfigList.WorkloadEntries, teamLabel, teams)
+	istioConfigList.WorkloadGroups = kubernetes.FilterByLabelValues(istioConfigList.WorkloadGroups, teamLabel, teams)
+}
+
+// getCachedIstioObject returns the object of the given type from the kube cache.
+func getCachedIstioObject(ctx context.Context, kubeCache cache.KubeCache, namespace string, resourceType schema.GroupVersionKind, name string) (meta_v1.Object, error) {
+	switch resourceType {
+	case kubernetes.AuthorizationPolicies:
+		return kubeCache.GetAuthorizationPolicy(ctx, namespace, name)
+	case kubernetes.DestinationRules:
+		return kubeCache.GetDestinationRule(ctx, namespace, name)
+	case kubernetes.EnvoyFilters:
+		return kubeCache.GetEnvoyFilter(ctx, namespace, name)
+	case kubernetes.Gateways:
+		return kubeCache.GetGateway(ctx, namespace, name)
+	case kubernetes.K8sGateways:
+		return kubeCache.GetK8sGateway(ctx, namespace, name)
+	case kubernetes.K8sGRPCRoutes:
+		return kubeCache.GetK8sGRPCRoute(ctx, namespace, name)
+	case kubernetes.K8sHTTPRoutes:
+		return kubeCache.GetK8sHTTPRoute(ctx, namespace, name)
+	case kubernetes.K8sReferenceGrants:
+		return kubeCache.GetK8sReferenceGrant(ctx, namespace, name)
+	case kubernetes.K8sTCPRoutes:
+		return kubeCache.GetK8sTCPRoute(ctx, namespace, name)
+	case kubernetes.K8sTLSRoutes:
+		return kubeCache.GetK8sTLSRoute(ctx, namespace, name)
+	case kubernetes.PeerAuthentications:
+		return kubeCache.GetPeerAuthentication(ctx, namespace, name)
+	case kubernetes.ProxyConfigs:
+		return kubeCache.GetProxyConfig(ctx, namespace, name)
+	case kubernetes.RequestAuthentications:
+		return kubeCache.GetRequestAuthentication(ctx, namespace, name)
+	case kubernetes.ServiceEntries:
+		return kubeCache.GetServiceEntry(ctx, namespace, name)
+	case kubernetes.Sidecars:
+		return kubeCache.GetSidecar(ctx, namespace, name)
+	case kubernetes.Telemetries:
+		return kubeCache.GetTelemetry(ctx, namespace, name)
+	case kubernetes.VirtualServices:
+		return kubeCache.GetVirtualService(ctx, namespace, name)
+	case kubernetes.WasmPlugins:
+		return kubeCache.GetWasmPlugin(ctx, namespace, name)
+	case kubernetes.WorkloadEntries:
+		return kubeCache.GetWorkloadEntry(ctx, namespace, name)
+	case kubernetes.WorkloadGroups:
+		return kubeCache.GetWorkloadGroup(ctx, namespace, name)
+	default:
+		return nil, fmt.Errorf("object type not found: %v", resourceType.String())
+	}
+}
@@ -0,0 +1,94 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func ownershipConfig() *config.Config {
+	conf := config.NewConfig()
+	conf.Ownership.Enabled = true
+	conf.Ownership.EnforceOnWrite = true
+	conf.Ownership.Teams = []config.OwnershipTeam{
+		{Name: "payments", Users: []string{"alice"}},
+		{Name: "reviews", Users: []string{"bob"}},
+	}
+	return conf
+}
+
+func newOwnershipIstioConfigService(t *testing.T, conf *config.Config) IstioConfigService {
+	config.Set(conf)
+
+	owned := data.CreateEmptyVirtualService("owned", "bookinfo", []string{"reviews"})
+	owned.Labels = map[string]string{conf.Ownership.TeamLabel: "payments"}
+	unowned := data.CreateEmptyVirtualService("unowned", "bookinfo", []string{"ratings"})
+
+	k8s := kubetest.NewFakeK8sClient([]runtime.Object{kubetest.FakeNamespace("bookinfo"), owned, unowned}...)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	return IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, businessLayer: NewWithBackends(k8sclients, k8sclients, nil, nil)}
+}
+
+func TestCheckTeamOwnership(t *testing.T) {
+	require := require.New(t)
+
+	conf := ownershipConfig()
+	service := newOwnershipIstioConfigService(t, conf)
+	cluster := conf.KubernetesConfig.ClusterName
+
+	require.NoError(service.CheckTeamOwnership(context.TODO(), cluster, "bookinfo", kubernetes.VirtualServices, "owned", "alice"))
+	err := service.CheckTeamOwnership(context.TODO(), cluster, "bookinfo", kubernetes.VirtualServices, "owned", "bob")
+	require.True(api_errors.IsForbidden(err))
+
+	// Objects without a team and missing objects are not protected
+	require.NoError(service.CheckTeamOwnership(context.TODO(), cluster, "bookinfo", kubernetes.VirtualServices, "unowned", "bob"))
+	require.NoError(service.CheckTeamOwnership(context.TODO(), cluster, "bookinfo", kubernetes.VirtualServices, "missing", "bob"))
+
+	// Payloads can't assign the object to another team
+	payload := []byte(`{"metadata":{"name":"owned","labels":{"kiali.io/team":"payments"}}}`)
+	require.NoError(service.CheckTeamOwnershipForPayload(kubernetes.VirtualServices, payload, "alice"))
+	err = service.CheckTeamOwnershipForPayload(kubernetes.VirtualServices, payload, "bob")
+	require.True(api_errors.IsForbidden(err))
+	err = service.CheckTeamOwnershipForPayload(kubernetes.VirtualServices, []byte(`not json`), "bob")
+	require.True(api_errors.IsBadRequest(err))
+
+	conf.Ownership.EnforceOnWrite = false
+	service = newOwnershipIstioConfigService(t, conf)
+	require.NoError(service.CheckTeamOwnership(context.TODO(), cluster, "bookinfo", kubernetes.VirtualServices, "owned", "bob"))
+	require.NoError(service.CheckTeamOwnershipForPayload(kubernetes.VirtualServices, payload, "bob"))
+}
+
+func TestGetIstioConfigListFilteredByTeams(t *testing.T) {
+	require := require.New(t)
+
+	conf := ownershipConfig()
+	service := newOwnershipIstioConfigService(t, conf)
+
+	cluster := conf.KubernetesConfig.ClusterName
+	criteria := IstioConfigCriteria{IncludeVirtualServices: true}
+	list, err := service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Len(list.VirtualServices, 2)
+
+	criteria.FilterByTeams = true
+	criteria.Teams = conf.Ownership.TeamsForUser("alice")
+	list, err = service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Len(list.VirtualServices, 1)
+	require.Equal("owned", list.VirtualServices[0].Name)
+
+	criteria.Teams = conf.Ownership.TeamsForUser("carol")
+	list, err = service.GetIstioConfigListForNamespace(context.TODO(), cluster, "bookinfo", criteria)
+	require.NoError(err)
+	require.Empty(list.VirtualServices)
+}
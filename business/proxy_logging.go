@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
 )
 
 // ValidProxyLogLevels are the application log levels supported by the envoy admin interface.
@@ -40,3 +41,32 @@ func (in *ProxyLoggingService) SetLogLevel(cluster, namespace, pod, level string
 
 	return client.SetProxyLogLevel(namespace, pod, level)
 }
+
+// GetLogLevels returns the log levels of the pod's proxy.
+func (in *ProxyLoggingService) GetLogLevels(cluster, namespace, pod string) (*models.ProxyLogLevels, error) {
+	client, ok := in.userClients[cluster]
+	if !ok {
+		return nil, fmt.Errorf("user client for cluster [%s] not found", cluster)
+	}
+
+	// Ensure pod exists
+	if _, err := client.GetPod(namespace, pod); err != nil {
+		return nil, err
+	}
+
+	loggers, err := client.GetProxyLogLevels(namespace, pod)
+	if err != nil {
+		return nil, err
+	}
+
+	levels := &models.ProxyLogLevels{Loggers: loggers}
+	for _, level := range loggers {
+		if levels.Level == "" {
+			levels.Level = level
+		} else if levels.Level != level {
+			levels.Level = ""
+			break
+		}
+	}
+	return levels, nil
+}
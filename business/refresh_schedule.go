@@ -0,0 +1,97 @@
+package business
+
+import (
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kiali/kiali/config"
+)
+
+// NamespaceFilter tells whether a namespace of a cluster is processed. A nil filter processes all the namespaces.
+type NamespaceFilter func(cluster, namespace string) bool
+
+// Includes tells whether the namespace of the cluster is processed.
+func (f NamespaceFilter) Includes(cluster, namespace string) bool {
+	return f == nil || f(cluster, namespace)
+}
+
+type refreshOverride struct {
+	disabled  bool
+	interval  time.Duration
+	namespace *regexp.Regexp
+}
+
+type refreshKey struct {
+	cluster   string
+	namespace string
+}
+
+// RefreshSchedule decides which namespaces a background controller refreshes on each of its runs, following the
+// background refresh config. Namespaces without a matching entry are refreshed on every run.
+type RefreshSchedule struct {
+	lastRefresh map[refreshKey]time.Time
+	mu          sync.Mutex
+	overrides   []refreshOverride
+}
+
+// NewRefreshSchedule returns the refresh schedule of the namespaces for a background controller.
+func NewRefreshSchedule(conf *config.Config, controller string) *RefreshSchedule {
+	schedule := &RefreshSchedule{lastRefresh: map[refreshKey]time.Time{}}
+	for _, ns := range conf.BackgroundRefresh.Namespaces {
+		if len(ns.Controllers) > 0 && !slices.Contains(ns.Controllers, controller) {
+			continue
+		}
+		// Expressions are validated when the config is loaded.
+		re, err := regexp.Compile(ns.Namespace)
+		if err != nil {
+			continue
+		}
+		schedule.overrides = append(schedule.overrides, refreshOverride{
+			disabled:  ns.Disabled,
+			interval:  time.Duration(ns.IntervalSeconds) * time.Second,
+			namespace: re,
+		})
+	}
+	return schedule
+}
+
+// Run returns the namespaces refreshed by the run of the controller started at now: the ones that are not disabled
+// and whose interval has elapsed since their last refresh. A namespace due is considered refreshed by the run,
+// so the filter gives the same answer for the whole run.
+func (s *RefreshSchedule) Run(now time.Time) NamespaceFilter {
+	if len(s.overrides) == 0 {
+		return nil
+	}
+	decisions := map[refreshKey]bool{}
+	return func(cluster, namespace string) bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		key := refreshKey{cluster: cluster, namespace: namespace}
+		if due, found := decisions[key]; found {
+			return due
+		}
+		due := s.due(key, now)
+		if due {
+			s.lastRefresh[key] = now
+		}
+		decisions[key] = due
+		return due
+	}
+}
+
+func (s *RefreshSchedule) due(key refreshKey, now time.Time) bool {
+	for _, override := range s.overrides {
+		if !override.namespace.MatchString(key.namespace) {
+			continue
+		}
+		if override.disabled {
+			return false
+		}
+		last, found := s.lastRefresh[key]
+		return !found || now.Sub(last) >= override.interval
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package business
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+)
+
+func TestRefreshSchedule(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := config.NewConfig()
+	conf.BackgroundRefresh.Namespaces = []config.BackgroundRefreshNamespace{
+		{Namespace: "^sandbox-", Disabled: true},
+		{Namespace: "^batch$", IntervalSeconds: 300},
+		{Namespace: "^bookinfo$", Controllers: []string{config.BackgroundControllerSLO}, Disabled: true},
+	}
+	schedule := NewRefreshSchedule(conf, config.BackgroundControllerValidations)
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	run := schedule.Run(now)
+	assert.False(run.Includes("east", "sandbox-jdoe"))
+	assert.True(run.Includes("east", "batch"))
+	// The decision holds for the whole run
+	assert.True(run.Includes("east", "batch"))
+	// The entry only applies to the slo controller
+	assert.True(run.Includes("east", "bookinfo"))
+
+	run = schedule.Run(now.Add(time.Minute))
+	assert.False(run.Includes("east", "batch"))
+	assert.True(run.Includes("west", "batch"))
+	assert.True(run.Includes("east", "bookinfo"))
+
+	run = schedule.Run(now.Add(5 * time.Minute))
+	assert.True(run.Includes("east", "batch"))
+	assert.False(run.Includes("west", "batch"))
+
+	// Without entries every namespace is refreshed
+	assert.True(NewRefreshSchedule(config.NewConfig(), config.BackgroundControllerSLO).Run(now).Includes("east", "batch"))
+}
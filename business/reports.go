@@ -0,0 +1,142 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/kiali/kiali/models"
+)
+
+// The error ratios of the inbound requests from which an app is degraded or failing, as the defaults of the UI.
+const (
+	reportDegradedErrorRatio = 0.001
+	reportFailureErrorRatio  = 0.2
+)
+
+// ReportService gathers the mesh status of a namespace for the reports.
+type ReportService struct {
+	businessLayer *Layer
+}
+
+// NewReportService creates a new ReportService.
+func NewReportService(businessLayer *Layer) ReportService {
+	return ReportService{businessLayer: businessLayer}
+}
+
+// GetNamespaceReport returns the health, the validation findings and the Istio config inventory of a namespace. The
+// traffic graph is left to the caller.
+func (in *ReportService) GetNamespaceReport(ctx context.Context, cluster, namespace, duration string, queryTime time.Time) (*models.NamespaceReport, error) {
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	report := &models.NamespaceReport{
+		Cluster:     cluster,
+		Namespace:   namespace,
+		GeneratedAt: queryTime,
+		Duration:    duration,
+		Inventory:   []models.ReportInventoryItem{},
+		Validations: []models.ReportValidation{},
+	}
+	if consoleURL := kialiURL(ctx); consoleURL != "" {
+		report.URL = fmt.Sprintf("%s/console/graph/namespaces?namespaces=%s&clusterName=%s", consoleURL, url.QueryEscape(namespace), url.QueryEscape(cluster))
+	}
+
+	appsHealth, err := in.businessLayer.Health.GetNamespaceAppHealth(ctx, NamespaceHealthCriteria{
+		IncludeMetrics: true,
+		Namespace:      namespace,
+		Cluster:        cluster,
+		QueryTime:      queryTime,
+		RateInterval:   duration,
+	})
+	if err != nil {
+		return nil, err
+	}
+	report.Health = reportHealth(appsHealth)
+
+	validations, err := in.businessLayer.Validations.GetValidationsForNamespace(ctx, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+	for key, validation := range validations {
+		for _, check := range validation.Checks {
+			report.Validations = append(report.Validations, models.ReportValidation{
+				ObjectGVK: key.ObjectGVK,
+				Name:      key.Name,
+				Code:      check.Code,
+				Message:   check.Message,
+				Severity:  check.Severity,
+			})
+		}
+	}
+	// errors first
+	sort.Slice(report.Validations, func(i, j int) bool {
+		vi, vj := report.Validations[i], report.Validations[j]
+		switch {
+		case vi.Severity != vj.Severity:
+			return vi.Severity == models.ErrorSeverity || (vi.Severity == models.WarningSeverity && vj.Severity != models.ErrorSeverity)
+		case vi.ObjectGVK.Kind != vj.ObjectGVK.Kind:
+			return vi.ObjectGVK.Kind < vj.ObjectGVK.Kind
+		case vi.Name != vj.Name:
+			return vi.Name < vj.Name
+		default:
+			return vi.Code < vj.Code
+		}
+	})
+
+	configList, err := in.businessLayer.IstioConfig.GetIstioConfigListForNamespace(ctx, cluster, namespace, ParseIstioConfigCriteria("", "", ""))
+	if err != nil {
+		return nil, err
+	}
+	inventory := map[string]*models.ReportInventoryItem{}
+	for _, o := range istioConfigObjects(configList) {
+		item, found := inventory[o.gvk.String()]
+		if !found {
+			item = &models.ReportInventoryItem{ObjectGVK: o.gvk, Names: []string{}}
+			inventory[o.gvk.String()] = item
+		}
+		item.Names = append(item.Names, o.object.GetName())
+	}
+	for _, item := range inventory {
+		sort.Strings(item.Names)
+		report.Inventory = append(report.Inventory, *item)
+	}
+	sort.Slice(report.Inventory, func(i, j int) bool {
+		return report.Inventory[i].ObjectGVK.Kind < report.Inventory[j].ObjectGVK.Kind
+	})
+
+	return report, nil
+}
+
+// reportHealth evaluates the status of every app, from the availability of its workloads and its inbound error ratio.
+func reportHealth(appsHealth models.NamespaceAppHealth) models.ReportHealth {
+	health := models.ReportHealth{Apps: []models.ReportAppHealth{}, Statuses: map[string]int{}}
+	for name, appHealth := range appsHealth {
+		app := models.ReportAppHealth{Name: name}
+		for _, ws := range appHealth.WorkloadStatuses {
+			app.AvailableReplicas += ws.AvailableReplicas
+			app.DesiredReplicas += ws.DesiredReplicas
+		}
+		app.RequestRate, app.ErrorRatio = appHealth.Requests.ErrorRatio()
+
+		switch {
+		case len(appHealth.WorkloadStatuses) == 0 && app.RequestRate == 0:
+			app.Status = models.ReportHealthNotAvailable
+		case app.ErrorRatio >= reportFailureErrorRatio || (app.DesiredReplicas > 0 && app.AvailableReplicas == 0):
+			app.Status = models.ReportHealthFailure
+		case app.ErrorRatio >= reportDegradedErrorRatio || app.AvailableReplicas < app.DesiredReplicas:
+			app.Status = models.ReportHealthDegraded
+		default:
+			app.Status = models.ReportHealthHealthy
+		}
+		health.Statuses[app.Status]++
+		health.Apps = append(health.Apps, app)
+	}
+	sort.Slice(health.Apps, func(i, j int) bool {
+		return health.Apps[i].Name < health.Apps[j].Name
+	})
+	return health
+}
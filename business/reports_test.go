@@ -0,0 +1,41 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/models"
+)
+
+func TestReportHealth(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := func(ok, failed float64) models.RequestHealth {
+		rh := models.NewEmptyRequestHealth()
+		rh.Inbound["http"] = map[string]float64{"200": ok, "503": failed}
+		return rh
+	}
+	health := reportHealth(models.NamespaceAppHealth{
+		"details":     {WorkloadStatuses: []*models.WorkloadStatus{{DesiredReplicas: 1, AvailableReplicas: 1}}, Requests: requests(10, 0)},
+		"productpage": {WorkloadStatuses: []*models.WorkloadStatus{{DesiredReplicas: 1, AvailableReplicas: 1}}, Requests: requests(9, 1)},
+		"ratings":     {WorkloadStatuses: []*models.WorkloadStatus{{DesiredReplicas: 2, AvailableReplicas: 1}}, Requests: requests(10, 0)},
+		"reviews":     {WorkloadStatuses: []*models.WorkloadStatus{{DesiredReplicas: 1, AvailableReplicas: 0}}, Requests: requests(0, 0)},
+		"unused":      {WorkloadStatuses: []*models.WorkloadStatus{}, Requests: models.NewEmptyRequestHealth()},
+	})
+
+	assert.Len(health.Apps, 5)
+	assert.Equal("details", health.Apps[0].Name)
+	assert.Equal(models.ReportHealthHealthy, health.Apps[0].Status)
+	assert.Equal(models.ReportHealthDegraded, health.Apps[1].Status)
+	assert.InDelta(0.1, health.Apps[1].ErrorRatio, 0.0001)
+	assert.Equal(models.ReportHealthDegraded, health.Apps[2].Status)
+	assert.Equal(models.ReportHealthFailure, health.Apps[3].Status)
+	assert.Equal(models.ReportHealthNotAvailable, health.Apps[4].Status)
+	assert.Equal(map[string]int{
+		models.ReportHealthDegraded:     2,
+		models.ReportHealthFailure:      1,
+		models.ReportHealthHealthy:      1,
+		models.ReportHealthNotAvailable: 1,
+	}, health.Statuses)
+}
@@ -0,0 +1,250 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/models"
+)
+
+// SearchCriteria restricts a search. Empty Clusters, Namespaces or Kinds don't restrict it.
+type SearchCriteria struct {
+	Query      string
+	Clusters   []string
+	Namespaces []string
+	Kinds      []models.SearchKind
+	// Maximum number of results, no limit when 0
+	Limit int
+}
+
+// SearchService looks up the apps, services, workloads and Istio objects of the mesh by name.
+type SearchService struct {
+	businessLayer *Layer
+	conf          *config.Config
+	kialiCache    cache.KialiCache
+}
+
+// NewSearchService creates a new SearchService.
+func NewSearchService(businessLayer *Layer, conf *config.Config, kialiCache cache.KialiCache) SearchService {
+	return SearchService{businessLayer: businessLayer, conf: conf, kialiCache: kialiCache}
+}
+
+// Search returns the entities of the namespaces accessible to the user whose name contains the query, ignoring case.
+// The entities are looked up in a search index per namespace, built from the kube caches and kept in the Kiali cache
+// until it expires.
+func (in *SearchService) Search(ctx context.Context, criteria SearchCriteria) (*models.SearchResults, error) {
+	namespaces, err := in.businessLayer.Namespace.GetNamespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := []*models.SearchIndex{}
+	for _, ns := range namespaces {
+		if len(criteria.Clusters) > 0 && !slices.Contains(criteria.Clusters, ns.Cluster) {
+			continue
+		}
+		if len(criteria.Namespaces) > 0 && !slices.Contains(criteria.Namespaces, ns.Name) {
+			continue
+		}
+		index, err := in.getSearchIndex(ctx, ns.Cluster, ns.Name)
+		if err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, index)
+	}
+
+	return search(indexes, criteria), nil
+}
+
+func (in *SearchService) getSearchIndex(ctx context.Context, cluster, namespace string) (*models.SearchIndex, error) {
+	key := models.SearchIndexKey{Cluster: cluster, Namespace: namespace}
+	if index, found := in.kialiCache.SearchIndexes().Get(key); found {
+		return index, nil
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	sources := searchSources{}
+	if sources.services, err = kubeCache.GetServices(namespace, ""); err != nil {
+		return nil, err
+	}
+	if sources.deployments, err = kubeCache.GetDeployments(namespace); err != nil {
+		return nil, err
+	}
+	if sources.statefulSets, err = kubeCache.GetStatefulSets(namespace); err != nil {
+		return nil, err
+	}
+	if sources.daemonSets, err = kubeCache.GetDaemonSets(namespace); err != nil {
+		return nil, err
+	}
+	if sources.replicaSets, err = kubeCache.GetReplicaSets(namespace); err != nil {
+		return nil, err
+	}
+	criteria := ParseIstioConfigCriteria("", "", "")
+	if sources.istioConfigs, err = in.businessLayer.IstioConfig.getIstioConfigList(ctx, cluster, namespace, criteria, nil); err != nil {
+		return nil, err
+	}
+
+	index := buildSearchIndex(cluster, namespace, in.conf.IstioLabels.AppLabelName, sources)
+	in.kialiCache.SearchIndexes().Set(key, index)
+	return index, nil
+}
+
+// searchSources are the objects of a namespace the search index is built from.
+type searchSources struct {
+	services     []core_v1.Service
+	deployments  []apps_v1.Deployment
+	statefulSets []apps_v1.StatefulSet
+	daemonSets   []apps_v1.DaemonSet
+	replicaSets  []apps_v1.ReplicaSet
+	istioConfigs *models.IstioConfigList
+}
+
+// buildSearchIndex indexes the services, the workloads, the apps labeling the workloads and the Istio objects of a
+// namespace. ReplicaSets are only indexed when they are not owned by another controller, like a Deployment.
+func buildSearchIndex(cluster, namespace, appLabelName string, sources searchSources) *models.SearchIndex {
+	index := &models.SearchIndex{Entries: []models.SearchEntry{}, BuiltAt: time.Now()}
+	newEntry := func(kind models.SearchKind, objectType, name string) models.SearchEntry {
+		return models.SearchEntry{Kind: kind, Type: objectType, Name: name, Namespace: namespace, Cluster: cluster}
+	}
+
+	apps := map[string]bool{}
+	addWorkload := func(objectType, name string, podLabels map[string]string) {
+		index.Entries = append(index.Entries, newEntry(models.SearchKindWorkload, objectType, name))
+		if app, ok := podLabels[appLabelName]; ok && app != "" {
+			apps[app] = true
+		}
+	}
+	for _, d := range sources.deployments {
+		addWorkload(kubernetes.DeploymentType, d.Name, d.Spec.Template.Labels)
+	}
+	for _, s := range sources.statefulSets {
+		addWorkload(kubernetes.StatefulSetType, s.Name, s.Spec.Template.Labels)
+	}
+	for _, d := range sources.daemonSets {
+		addWorkload(kubernetes.DaemonSetType, d.Name, d.Spec.Template.Labels)
+	}
+	for _, rs := range sources.replicaSets {
+		if metav1.GetControllerOf(&rs) == nil {
+			addWorkload(kubernetes.ReplicaSetType, rs.Name, rs.Spec.Template.Labels)
+		}
+	}
+	for app := range apps {
+		index.Entries = append(index.Entries, newEntry(models.SearchKindApp, "App", app))
+	}
+	for _, svc := range sources.services {
+		index.Entries = append(index.Entries, newEntry(models.SearchKindService, "Service", svc.Name))
+	}
+
+	if configs := sources.istioConfigs; configs != nil {
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.AuthorizationPolicies, configs.AuthorizationPolicies)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.DestinationRules, configs.DestinationRules)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.EnvoyFilters, configs.EnvoyFilters)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.Gateways, configs.Gateways)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.K8sGateways, configs.K8sGateways)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.K8sGRPCRoutes, configs.K8sGRPCRoutes)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.K8sHTTPRoutes, configs.K8sHTTPRoutes)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.K8sReferenceGrants, configs.K8sReferenceGrants)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.K8sTCPRoutes, configs.K8sTCPRoutes)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.K8sTLSRoutes, configs.K8sTLSRoutes)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.PeerAuthentications, configs.PeerAuthentications)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.ProxyConfigs, configs.ProxyConfigs)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.RequestAuthentications, configs.RequestAuthentications)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.ServiceEntries, configs.ServiceEntries)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.Sidecars, configs.Sidecars)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.Telemetries, configs.Telemetries)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.VirtualServices, configs.VirtualServices)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.WasmPlugins, configs.WasmPlugins)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.WorkloadEntries, configs.WorkloadEntries)
+		index.Entries = addIstioSearchEntries(index.Entries, cluster, kubernetes.WorkloadGroups, configs.WorkloadGroups)
+	}
+	return index
+}
+
+func addIstioSearchEntries[T metav1.Object](entries []models.SearchEntry, cluster string, gvk schema.GroupVersionKind, objects []T) []models.SearchEntry {
+	for _, o := range objects {
+		objectGVK := gvk
+		entries = append(entries, models.SearchEntry{
+			Kind:      models.SearchKindIstio,
+			Type:      gvk.Kind,
+			Name:      o.GetName(),
+			Namespace: o.GetNamespace(),
+			Cluster:   cluster,
+			ObjectGVK: &objectGVK,
+		})
+	}
+	return entries
+}
+
+// search returns the entries of the indexes matching the criteria. Exact matches come first, then the names starting
+// with the query and then the names containing it. Entries of the same relevance are sorted by kind and name.
+func search(indexes []*models.SearchIndex, criteria SearchCriteria) *models.SearchResults {
+	query := strings.ToLower(strings.TrimSpace(criteria.Query))
+	results := &models.SearchResults{Query: criteria.Query, Results: []models.SearchEntry{}}
+
+	kindRank := map[models.SearchKind]int{}
+	for i, kind := range models.SearchKinds {
+		kindRank[kind] = i
+	}
+	includeKind := map[models.SearchKind]bool{}
+	for _, kind := range criteria.Kinds {
+		includeKind[kind] = true
+	}
+
+	relevance := map[*models.SearchEntry]int{}
+	matches := []*models.SearchEntry{}
+	for _, index := range indexes {
+		for i := range index.Entries {
+			entry := &index.Entries[i]
+			if len(includeKind) > 0 && !includeKind[entry.Kind] {
+				continue
+			}
+			name := strings.ToLower(entry.Name)
+			switch {
+			case name == query:
+				relevance[entry] = 0
+			case strings.HasPrefix(name, query):
+				relevance[entry] = 1
+			case strings.Contains(name, query):
+				relevance[entry] = 2
+			default:
+				continue
+			}
+			matches = append(matches, entry)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if relevance[a] != relevance[b] {
+			return relevance[a] < relevance[b]
+		}
+		if kindRank[a.Kind] != kindRank[b.Kind] {
+			return kindRank[a.Kind] < kindRank[b.Kind]
+		}
+		return fmt.Sprintf("%s/%s/%s/%s", a.Name, a.Type, a.Namespace, a.Cluster) < fmt.Sprintf("%s/%s/%s/%s", b.Name, b.Type, b.Namespace, b.Cluster)
+	})
+
+	results.Total = len(matches)
+	if criteria.Limit > 0 && len(matches) > criteria.Limit {
+		matches = matches[:criteria.Limit]
+	}
+	for _, entry := range matches {
+		results.Results = append(results.Results, *entry)
+	}
+	return results
+}
@@ -0,0 +1,99 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+func TestBuildSearchIndex(t *testing.T) {
+	require := require.New(t)
+
+	meta := func(name string) meta_v1.ObjectMeta {
+		return meta_v1.ObjectMeta{Name: name, Namespace: "bookinfo"}
+	}
+	template := func(app string) core_v1.PodTemplateSpec {
+		return core_v1.PodTemplateSpec{ObjectMeta: meta_v1.ObjectMeta{Labels: map[string]string{"app": app}}}
+	}
+	owned := meta("reviews-v1-abcde")
+	controller := true
+	owned.OwnerReferences = []meta_v1.OwnerReference{{Kind: "Deployment", Name: "reviews-v1", Controller: &controller}}
+
+	sources := searchSources{
+		services: []core_v1.Service{{ObjectMeta: meta("reviews")}},
+		deployments: []apps_v1.Deployment{
+			{ObjectMeta: meta("reviews-v1"), Spec: apps_v1.DeploymentSpec{Template: template("reviews")}},
+			{ObjectMeta: meta("reviews-v2"), Spec: apps_v1.DeploymentSpec{Template: template("reviews")}},
+		},
+		statefulSets: []apps_v1.StatefulSet{{ObjectMeta: meta("mysqldb"), Spec: apps_v1.StatefulSetSpec{Template: template("")}}},
+		replicaSets: []apps_v1.ReplicaSet{
+			{ObjectMeta: owned, Spec: apps_v1.ReplicaSetSpec{Template: template("reviews")}},
+			{ObjectMeta: meta("ratings-v1"), Spec: apps_v1.ReplicaSetSpec{Template: template("ratings")}},
+		},
+		istioConfigs: &models.IstioConfigList{
+			VirtualServices: []*networking_v1.VirtualService{{ObjectMeta: meta("reviews")}},
+		},
+	}
+
+	index := buildSearchIndex("east", "bookinfo", "app", sources)
+	entries := map[string]models.SearchEntry{}
+	for _, entry := range index.Entries {
+		require.Equal("east", entry.Cluster)
+		require.Equal("bookinfo", entry.Namespace)
+		entries[entry.Type+"/"+entry.Name] = entry
+	}
+	require.Len(entries, 8)
+	require.Contains(entries, "Deployment/reviews-v1")
+	require.Contains(entries, "StatefulSet/mysqldb")
+	require.Contains(entries, "ReplicaSet/ratings-v1")
+	require.NotContains(entries, "ReplicaSet/reviews-v1-abcde")
+	require.Equal(models.SearchKindApp, entries["App/reviews"].Kind)
+	require.Equal(models.SearchKindApp, entries["App/ratings"].Kind)
+	require.Equal(models.SearchKindService, entries["Service/reviews"].Kind)
+	require.Equal(models.SearchKindIstio, entries["VirtualService/reviews"].Kind)
+	require.Equal(kubernetes.VirtualServices, *entries["VirtualService/reviews"].ObjectGVK)
+}
+
+func TestSearch(t *testing.T) {
+	require := require.New(t)
+
+	entry := func(kind models.SearchKind, objectType, name, namespace string) models.SearchEntry {
+		return models.SearchEntry{Kind: kind, Type: objectType, Name: name, Namespace: namespace, Cluster: "east"}
+	}
+	indexes := []*models.SearchIndex{
+		{Entries: []models.SearchEntry{
+			entry(models.SearchKindWorkload, "Deployment", "reviews-v1", "bookinfo"),
+			entry(models.SearchKindIstio, "VirtualService", "reviews", "bookinfo"),
+			entry(models.SearchKindService, "Service", "reviews", "bookinfo"),
+			entry(models.SearchKindApp, "App", "reviews", "bookinfo"),
+			entry(models.SearchKindService, "Service", "productpage", "bookinfo"),
+		}},
+		{Entries: []models.SearchEntry{
+			entry(models.SearchKindWorkload, "Deployment", "my-Reviews", "travels"),
+		}},
+	}
+
+	results := search(indexes, SearchCriteria{Query: "Reviews"})
+	require.Equal(5, results.Total)
+	names := []string{}
+	for _, r := range results.Results {
+		names = append(names, r.Type+"/"+r.Name)
+	}
+	require.Equal([]string{"App/reviews", "Service/reviews", "VirtualService/reviews", "Deployment/reviews-v1", "Deployment/my-Reviews"}, names)
+
+	results = search(indexes, SearchCriteria{Query: "rev", Kinds: []models.SearchKind{models.SearchKindWorkload}, Limit: 1})
+	require.Equal(2, results.Total)
+	require.Len(results.Results, 1)
+	require.Equal("reviews-v1", results.Results[0].Name)
+
+	results = search(indexes, SearchCriteria{Query: "details"})
+	require.Zero(results.Total)
+	require.Empty(results.Results)
+}
@@ -0,0 +1,341 @@
+package business
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	api_security_v1 "istio.io/api/security/v1"
+	api_v1beta1 "istio.io/api/type/v1beta1"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// GetServiceEffectiveConfig computes the Istio config applied to the traffic of a service: the routes of the
+// VirtualService and the traffic policy of the DestinationRule that Istio selects for the host, whether the
+// Sidecar of the clients lets them reach it, and the authentication required by its workloads.
+// The outbound config is computed for the clients of clientNamespace, which defaults to the service namespace.
+func (in *IstioConfigService) GetServiceEffectiveConfig(ctx context.Context, cluster, namespace, service, clientNamespace string) (*models.ServiceEffectiveConfig, error) {
+	if clientNamespace == "" {
+		clientNamespace = namespace
+	}
+	// Check the user has access to the namespaces
+	for _, ns := range []string{namespace, clientNamespace} {
+		if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, ns, cluster); err != nil {
+			return nil, err
+		}
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := kubeCache.GetService(namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	var pods []core_v1.Pod
+	if len(svc.Spec.Selector) > 0 {
+		if pods, err = kubeCache.GetPods(namespace, labels.Set(svc.Spec.Selector).String()); err != nil {
+			return nil, err
+		}
+	}
+
+	criteria := IstioConfigCriteria{
+		IncludeDestinationRules:       true,
+		IncludePeerAuthentications:    true,
+		IncludeRequestAuthentications: true,
+		IncludeSidecars:               true,
+		IncludeVirtualServices:        true,
+	}
+	istioConfigList, err := in.GetIstioConfigList(ctx, cluster, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	host := resolveHostFQDN(service, namespace)
+	effective := &models.ServiceEffectiveConfig{
+		Cluster:         cluster,
+		ClientNamespace: clientNamespace,
+		Host:            host,
+		Namespace:       namespace,
+		Service:         service,
+	}
+	effective.Routing = in.effectiveRouting(istioConfigList, host, clientNamespace)
+	effective.TrafficPolicy = in.effectiveTrafficPolicy(istioConfigList, host, namespace, clientNamespace)
+	effective.SidecarScope = in.effectiveSidecarScope(istioConfigList, host, namespace, clientNamespace)
+	effective.Authentication = in.effectiveAuthentication(istioConfigList, namespace, pods)
+	return effective, nil
+}
+
+// effectiveRouting returns the routes of the VirtualService applied by the sidecars of the client namespace.
+func (in *IstioConfigService) effectiveRouting(istioConfigList *models.IstioConfigList, host, clientNamespace string) models.EffectiveRouting {
+	routing := models.EffectiveRouting{Shadowed: []models.IstioReference{}}
+
+	candidates := []hostCandidate{}
+	for _, vs := range istioConfigList.VirtualServices {
+		if !appliesToMesh(vs.Spec.Gateways) || !exportedTo(vs.Spec.ExportTo, vs.Namespace, clientNamespace) {
+			continue
+		}
+		for _, h := range vs.Spec.Hosts {
+			if matches, exact := hostPatternMatches(resolveHostFQDN(h, vs.Namespace), host); matches {
+				candidates = append(candidates, hostCandidate{
+					ref:     &models.HostReference{IstioReference: models.IstioReference{ObjectGVK: kubernetes.VirtualServices, Name: vs.Name, Namespace: vs.Namespace}, Value: h},
+					group:   "VirtualService",
+					exact:   exact,
+					created: vs.CreationTimestamp.Unix(),
+				})
+				break
+			}
+		}
+	}
+
+	refs := rankHostCandidates(candidates)
+	if len(refs) == 0 {
+		return routing
+	}
+	applied := refs[0].IstioReference
+	routing.VirtualService = &applied
+	for _, ref := range refs[1:] {
+		routing.Shadowed = append(routing.Shadowed, ref.IstioReference)
+	}
+
+	for _, vs := range istioConfigList.VirtualServices {
+		if vs.Name != applied.Name || vs.Namespace != applied.Namespace {
+			continue
+		}
+		// Only the routes matching the sidecars are applied to the clients
+		for _, route := range vs.Spec.Http {
+			gateways := [][]string{}
+			for _, match := range route.Match {
+				gateways = append(gateways, match.Gateways)
+			}
+			if routeAppliesToMesh(gateways) {
+				routing.HTTP = append(routing.HTTP, route)
+			}
+		}
+		for _, route := range vs.Spec.Tcp {
+			gateways := [][]string{}
+			for _, match := range route.Match {
+				gateways = append(gateways, match.Gateways)
+			}
+			if routeAppliesToMesh(gateways) {
+				routing.TCP = append(routing.TCP, route)
+			}
+		}
+		for _, route := range vs.Spec.Tls {
+			gateways := [][]string{}
+			for _, match := range route.Match {
+				gateways = append(gateways, match.Gateways)
+			}
+			if routeAppliesToMesh(gateways) {
+				routing.TLS = append(routing.TLS, route)
+			}
+		}
+	}
+	return routing
+}
+
+// effectiveTrafficPolicy returns the traffic policy of the DestinationRule applied by the sidecars of the client
+// namespace, looked up in the client namespace, then in the service namespace and then in the root namespace.
+func (in *IstioConfigService) effectiveTrafficPolicy(istioConfigList *models.IstioConfigList, host, namespace, clientNamespace string) models.EffectiveTrafficPolicy {
+	policy := models.EffectiveTrafficPolicy{Shadowed: []models.IstioReference{}}
+	rootNamespace := in.config.ExternalServices.Istio.RootNamespace
+
+	candidates := []hostCandidate{}
+	for _, dr := range istioConfigList.DestinationRules {
+		if !exportedTo(dr.Spec.ExportTo, dr.Namespace, clientNamespace) {
+			continue
+		}
+		pattern := resolveHostFQDN(dr.Spec.Host, dr.Namespace)
+		if matches, exact := hostPatternMatches(pattern, host); matches {
+			namespaceRank := 3
+			switch dr.Namespace {
+			case clientNamespace:
+				namespaceRank = 0
+			case namespace:
+				namespaceRank = 1
+			case rootNamespace:
+				namespaceRank = 2
+			}
+			candidates = append(candidates, hostCandidate{
+				ref:           &models.HostReference{IstioReference: models.IstioReference{ObjectGVK: kubernetes.DestinationRules, Name: dr.Name, Namespace: dr.Namespace}, Value: pattern},
+				group:         "DestinationRule",
+				namespaceRank: namespaceRank,
+				exact:         exact,
+				created:       dr.CreationTimestamp.Unix(),
+			})
+		}
+	}
+
+	refs := rankHostCandidates(candidates)
+	if len(refs) == 0 {
+		return policy
+	}
+	applied := refs[0]
+	policy.DestinationRule = &applied.IstioReference
+	for _, ref := range refs[1:] {
+		policy.Shadowed = append(policy.Shadowed, ref.IstioReference)
+	}
+
+	switch {
+	case applied.Value == host:
+		policy.Level = models.EffectiveConfigLevelHost
+	case applied.Value == "*."+namespace+"."+in.config.ExternalServices.Istio.IstioIdentityDomain:
+		policy.Level = models.EffectiveConfigLevelNamespace
+	default:
+		policy.Level = models.EffectiveConfigLevelMesh
+	}
+
+	for _, dr := range istioConfigList.DestinationRules {
+		if dr.Name == applied.Name && dr.Namespace == applied.Namespace {
+			policy.Policy = dr.Spec.TrafficPolicy
+			policy.Subsets = dr.Spec.Subsets
+		}
+	}
+	return policy
+}
+
+// effectiveSidecarScope returns whether the egress of the Sidecar of the client namespace includes the host.
+// The namespace wide Sidecar applies, or else the one of the root namespace.
+func (in *IstioConfigService) effectiveSidecarScope(istioConfigList *models.IstioConfigList, host, namespace, clientNamespace string) models.EffectiveSidecarScope {
+	scope := models.EffectiveSidecarScope{Visible: true}
+
+	var sidecars, rootSidecars []int
+	for i, sc := range istioConfigList.Sidecars {
+		if sc.Spec.WorkloadSelector != nil {
+			continue
+		}
+		switch sc.Namespace {
+		case clientNamespace:
+			sidecars = append(sidecars, i)
+		case in.config.ExternalServices.Istio.RootNamespace:
+			rootSidecars = append(rootSidecars, i)
+		}
+	}
+	if len(sidecars) == 0 {
+		sidecars = rootSidecars
+	}
+	if len(sidecars) == 0 {
+		return scope
+	}
+	// Only the oldest one is applied
+	sort.SliceStable(sidecars, func(i, j int) bool {
+		return istioConfigList.Sidecars[sidecars[i]].CreationTimestamp.Before(&istioConfigList.Sidecars[sidecars[j]].CreationTimestamp)
+	})
+	sc := istioConfigList.Sidecars[sidecars[0]]
+	scope.Sidecar = &models.IstioReference{ObjectGVK: kubernetes.Sidecars, Name: sc.Name, Namespace: sc.Namespace}
+	if otp := sc.Spec.OutboundTrafficPolicy; otp != nil {
+		scope.OutboundTrafficPolicy = otp.Mode.String()
+	}
+
+	if len(sc.Spec.Egress) > 0 {
+		scope.Visible = false
+		for _, egress := range sc.Spec.Egress {
+			for _, h := range egress.GetHosts() {
+				if namespacedHostMatches(h, sc.Namespace, host, namespace) {
+					scope.Visible = true
+				}
+			}
+		}
+	}
+	return scope
+}
+
+// effectiveAuthentication returns the authentication required by the pods of the service. The mTLS mode of the
+// workload PeerAuthentication overrides the one of the namespace, which overrides the one of the mesh.
+func (in *IstioConfigService) effectiveAuthentication(istioConfigList *models.IstioConfigList, namespace string, pods []core_v1.Pod) models.EffectiveAuthentication {
+	authentication := models.EffectiveAuthentication{
+		MTLSMode:               api_security_v1.PeerAuthentication_MutualTLS_PERMISSIVE.String(),
+		PeerAuthentications:    []models.IstioReference{},
+		RequestAuthentications: []models.IstioReference{},
+	}
+	rootNamespace := in.config.ExternalServices.Istio.RootNamespace
+
+	// levelOf returns the level of a policy applying to the pods, or false when it doesn't apply
+	levelOf := func(policyNamespace string, selector *api_v1beta1.WorkloadSelector) (string, bool) {
+		switch {
+		case policyNamespace == namespace && len(selector.GetMatchLabels()) > 0:
+			matchLabels := labels.SelectorFromSet(selector.GetMatchLabels())
+			for _, pod := range pods {
+				if matchLabels.Matches(labels.Set(pod.Labels)) {
+					return models.EffectiveConfigLevelWorkload, true
+				}
+			}
+		case len(selector.GetMatchLabels()) > 0:
+		case policyNamespace == namespace:
+			return models.EffectiveConfigLevelNamespace, true
+		case policyNamespace == rootNamespace:
+			return models.EffectiveConfigLevelMesh, true
+		}
+		return "", false
+	}
+	levels := []string{models.EffectiveConfigLevelWorkload, models.EffectiveConfigLevelNamespace, models.EffectiveConfigLevelMesh}
+
+	// The oldest PeerAuthentication of each level is applied
+	peerAuthentications := slices.Clone(istioConfigList.PeerAuthentications)
+	sort.SliceStable(peerAuthentications, func(i, j int) bool {
+		return peerAuthentications[i].CreationTimestamp.Before(&peerAuthentications[j].CreationTimestamp)
+	})
+	for i := len(levels) - 1; i >= 0; i-- {
+		level := levels[i]
+		for _, pa := range peerAuthentications {
+			if l, applies := levelOf(pa.Namespace, pa.Spec.Selector); !applies || l != level {
+				continue
+			}
+			authentication.PeerAuthentications = append([]models.IstioReference{{ObjectGVK: kubernetes.PeerAuthentications, Name: pa.Name, Namespace: pa.Namespace}}, authentication.PeerAuthentications...)
+			if mode := pa.Spec.GetMtls().GetMode(); mode != api_security_v1.PeerAuthentication_MutualTLS_UNSET {
+				authentication.MTLSMode = mode.String()
+				authentication.MTLSLevel = level
+			}
+			if level == models.EffectiveConfigLevelWorkload {
+				authentication.PortLevelMTLS = pa.Spec.PortLevelMtls
+			}
+			break
+		}
+	}
+
+	for _, level := range levels {
+		for _, ra := range istioConfigList.RequestAuthentications {
+			if l, applies := levelOf(ra.Namespace, ra.Spec.Selector); applies && l == level {
+				authentication.RequestAuthentications = append(authentication.RequestAuthentications, models.IstioReference{ObjectGVK: kubernetes.RequestAuthentications, Name: ra.Name, Namespace: ra.Namespace})
+			}
+		}
+	}
+	return authentication
+}
+
+// appliesToMesh returns whether a VirtualService bound to the gateways applies to the sidecars.
+func appliesToMesh(gateways []string) bool {
+	return len(gateways) == 0 || slices.Contains(gateways, "mesh")
+}
+
+// routeAppliesToMesh returns whether a route with matches bound to the gateways applies to the sidecars.
+// The route applies when any of its matches does.
+func routeAppliesToMesh(matchGateways [][]string) bool {
+	if len(matchGateways) == 0 {
+		return true
+	}
+	for _, gateways := range matchGateways {
+		if appliesToMesh(gateways) {
+			return true
+		}
+	}
+	return false
+}
+
+// exportedTo returns whether an object exported to the namespaces of exportTo is visible from the namespace.
+func exportedTo(exportTo []string, objectNamespace, namespace string) bool {
+	if len(exportTo) == 0 {
+		return true
+	}
+	for _, ns := range exportTo {
+		if ns == "*" || ns == namespace || (ns == "." && objectNamespace == namespace) {
+			return true
+		}
+	}
+	return false
+}
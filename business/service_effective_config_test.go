@@ -0,0 +1,86 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestGetServiceEffectiveConfig(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	svc := kubetest.FakeService("bookinfo", "reviews")
+	pod := &core_v1.Pod{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews-v1", Namespace: "bookinfo", Labels: map[string]string{"app": "reviews", "version": "v1"}}}
+	gatewayOnly := data.CreateEmptyVirtualService("gateway-only", "bookinfo", []string{"reviews"})
+	gatewayOnly.Spec.Gateways = []string{"bookinfo-gateway"}
+	hidden := data.CreateEmptyDestinationRule("bookinfo", "hidden", "reviews")
+	hidden.Spec.ExportTo = []string{"."}
+
+	objects := []runtime.Object{
+		kubetest.FakeNamespace("bookinfo"),
+		kubetest.FakeNamespace("istio-system"),
+		kubetest.FakeNamespace("travel"),
+		&svc,
+		pod,
+		data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews.bookinfo.svc.cluster.local", "v1", -1),
+			data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})),
+		data.CreateEmptyVirtualService("wildcard", "bookinfo", []string{"*.bookinfo.svc.cluster.local"}),
+		gatewayOnly,
+		data.CreateEmptyDestinationRule("bookinfo", "namespace-wide", "*.bookinfo.svc.cluster.local"),
+		data.CreateEmptyDestinationRule("istio-system", "mesh-wide", "*.local"),
+		hidden,
+		data.AddHostsToSidecar([]string{"./*", "istio-system/*"}, data.CreateSidecar("default", "travel")),
+		data.CreateEmptyMeshPeerAuthentication("default", data.CreateMTLS("STRICT")),
+		data.CreateEmptyPeerAuthentication("default", "bookinfo", data.CreateMTLS("UNSET")),
+		data.AddSelectorToPeerAuthn(map[string]string{"app": "reviews"}, data.CreateEmptyPeerAuthentication("reviews", "bookinfo", data.CreateMTLS("PERMISSIVE"))),
+		data.AddSelectorToPeerAuthn(map[string]string{"app": "ratings"}, data.CreateEmptyPeerAuthentication("ratings", "bookinfo", data.CreateMTLS("DISABLE"))),
+	}
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	configService := IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, businessLayer: NewWithBackends(k8sclients, k8sclients, nil, nil)}
+
+	effective, err := configService.GetServiceEffectiveConfig(context.TODO(), conf.KubernetesConfig.ClusterName, "bookinfo", "reviews", "travel")
+	require.NoError(err)
+	require.Equal("reviews.bookinfo.svc.cluster.local", effective.Host)
+
+	// The exact host is applied before the wildcard, the gateway only VirtualService doesn't apply to the sidecars
+	require.Equal("reviews", effective.Routing.VirtualService.Name)
+	require.Equal([]models.IstioReference{{ObjectGVK: kubernetes.VirtualServices, Name: "wildcard", Namespace: "bookinfo"}}, effective.Routing.Shadowed)
+	require.Len(effective.Routing.HTTP, 1)
+
+	// The DestinationRule exported to its namespace only is not visible from the client namespace
+	require.Equal("namespace-wide", effective.TrafficPolicy.DestinationRule.Name)
+	require.Equal(models.EffectiveConfigLevelNamespace, effective.TrafficPolicy.Level)
+	require.Len(effective.TrafficPolicy.Shadowed, 1)
+
+	require.Equal("default", effective.SidecarScope.Sidecar.Name)
+	require.False(effective.SidecarScope.Visible)
+
+	// The workload PeerAuthentication overrides the mesh one, the namespace one inherits
+	require.Equal("PERMISSIVE", effective.Authentication.MTLSMode)
+	require.Equal(models.EffectiveConfigLevelWorkload, effective.Authentication.MTLSLevel)
+	require.Len(effective.Authentication.PeerAuthentications, 3)
+
+	effective, err = configService.GetServiceEffectiveConfig(context.TODO(), conf.KubernetesConfig.ClusterName, "bookinfo", "reviews", "")
+	require.NoError(err)
+	require.Equal("bookinfo", effective.ClientNamespace)
+	require.Equal("hidden", effective.TrafficPolicy.DestinationRule.Name)
+	require.Equal(models.EffectiveConfigLevelHost, effective.TrafficPolicy.Level)
+	require.Nil(effective.SidecarScope.Sidecar)
+	require.True(effective.SidecarScope.Visible)
+}
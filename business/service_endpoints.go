@@ -0,0 +1,51 @@
+package business
+
+import (
+	"context"
+	"fmt"
+
+	core_v1 "k8s.io/api/core/v1"
+	discovery_v1 "k8s.io/api/discovery/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// getEndpointSlices returns the EndpointSlices of a service, read with the user client as they are not cached.
+func getEndpointSlices(ctx context.Context, client kubernetes.ClientInterface, namespace, service string) ([]discovery_v1.EndpointSlice, error) {
+	slices, err := client.Kube().DiscoveryV1().EndpointSlices(namespace).List(ctx, meta_v1.ListOptions{
+		LabelSelector: discovery_v1.LabelServiceName + "=" + service,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// not nil, the service has no EndpointSlice
+	return append([]discovery_v1.EndpointSlice{}, slices.Items...), nil
+}
+
+// endpointStatus returns the readiness of the endpoints of a service, from its EndpointSlices when they could be read
+// and from its Endpoints otherwise, explaining why none is ready, if so, from the pods selected by the service.
+func endpointStatus(slices []discovery_v1.EndpointSlice, eps *core_v1.Endpoints, hasSelector bool, pods []core_v1.Pod) *models.EndpointStatus {
+	status := &models.EndpointStatus{}
+	if slices != nil {
+		status.ParseEndpointSlices(slices)
+	} else {
+		status.ParseEndpoints(eps)
+	}
+
+	if status.Ready > 0 {
+		return status
+	}
+	switch {
+	case len(status.Addresses) > 0:
+		status.Issue = fmt.Sprintf("None of the %d endpoints is ready, the requests to the service fail with no healthy upstream", len(status.Addresses))
+	case !hasSelector:
+		status.Issue = "The service has no selector and no endpoint was added to it"
+	case len(pods) == 0:
+		status.Issue = "No pod matches the selector of the service"
+	default:
+		status.Issue = fmt.Sprintf("%d pods match the selector of the service but none is an endpoint: they have no IP yet or do not expose the target ports of the service", len(pods))
+	}
+	return status
+}
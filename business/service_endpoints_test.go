@@ -0,0 +1,79 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	discovery_v1 "k8s.io/api/discovery/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/models"
+)
+
+func TestEndpointStatusFromEndpointSlices(t *testing.T) {
+	require := require.New(t)
+
+	ready, notReady := true, false
+	zone, node := "us-west-1a", "node-a"
+	endpoint := func(ip, pod string, conditions discovery_v1.EndpointConditions) discovery_v1.Endpoint {
+		return discovery_v1.Endpoint{
+			Addresses:  []string{ip},
+			Conditions: conditions,
+			TargetRef:  &core_v1.ObjectReference{Kind: "Pod", Name: pod},
+			NodeName:   &node,
+			Zone:       &zone,
+		}
+	}
+	slices := []discovery_v1.EndpointSlice{
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "reviews-abcde", Namespace: "bookinfo"},
+			Endpoints: []discovery_v1.Endpoint{
+				endpoint("10.0.0.1", "reviews-1", discovery_v1.EndpointConditions{Ready: &ready}),
+				endpoint("10.0.0.2", "reviews-2", discovery_v1.EndpointConditions{Ready: &notReady, Serving: &notReady}),
+				endpoint("10.0.0.3", "reviews-3", discovery_v1.EndpointConditions{Ready: &notReady, Serving: &ready, Terminating: &ready}),
+			},
+		},
+		{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "reviews-fghij", Namespace: "bookinfo"},
+			Endpoints:  []discovery_v1.Endpoint{endpoint("10.0.0.1", "reviews-1", discovery_v1.EndpointConditions{})},
+		},
+	}
+
+	status := endpointStatus(slices, nil, true, nil)
+	require.Equal(models.EndpointSourceEndpointSlices, status.Source)
+	require.Equal(1, status.Ready)
+	require.Equal(1, status.NotReady)
+	require.Equal(1, status.Terminating)
+	require.Empty(status.Issue)
+	require.Len(status.Addresses, 3)
+	require.Equal(models.EndpointAddressStatus{IP: "10.0.0.1", Kind: "Pod", Name: "reviews-1", NodeName: "node-a", Zone: "us-west-1a", Ready: true, Serving: true}, status.Addresses[0])
+	require.True(status.Addresses[2].Serving)
+}
+
+func TestEndpointStatusFromEndpoints(t *testing.T) {
+	require := require.New(t)
+
+	eps := &core_v1.Endpoints{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+		Subsets: []core_v1.EndpointSubset{{
+			NotReadyAddresses: []core_v1.EndpointAddress{{IP: "10.0.0.1", TargetRef: &core_v1.ObjectReference{Kind: "Pod", Name: "reviews-1"}}},
+		}},
+	}
+
+	status := endpointStatus(nil, eps, true, nil)
+	require.Equal(models.EndpointSourceEndpoints, status.Source)
+	require.Equal(0, status.Ready)
+	require.Equal(1, status.NotReady)
+	require.Equal("None of the 1 endpoints is ready, the requests to the service fail with no healthy upstream", status.Issue)
+}
+
+func TestEndpointStatusIssues(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("No pod matches the selector of the service", endpointStatus([]discovery_v1.EndpointSlice{}, nil, true, nil).Issue)
+	require.Equal("The service has no selector and no endpoint was added to it", endpointStatus(nil, nil, false, nil).Issue)
+
+	pods := []core_v1.Pod{{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews-1", Namespace: "bookinfo"}}}
+	require.Contains(endpointStatus([]discovery_v1.EndpointSlice{}, nil, true, pods).Issue, "1 pods match the selector of the service but none is an endpoint")
+}
@@ -0,0 +1,310 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// The labels Istio reads the locality of the endpoints from.
+const (
+	localityPodLabel = "istio-locality"
+	regionNodeLabel  = "topology.kubernetes.io/region"
+	zoneNodeLabel    = "topology.kubernetes.io/zone"
+	subzoneNodeLabel = "topology.istio.io/subzone"
+)
+
+// GetServiceLocality returns the locality load balancing settings of the MeshConfig and of the DestinationRules
+// applying to a service, with the localities of its endpoints, so the settings can be compared with the topology.
+// The locality of an endpoint is the one of the istio-locality label of its pod, or the one of the topology labels of
+// its node.
+func (in *SvcService) GetServiceLocality(ctx context.Context, cluster, namespace, service string) (*models.ServiceLocality, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+	client, ok := in.userClients[cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster [%s] is not found or is not accessible for Kiali", cluster)
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := kubeCache.GetService(namespace, service); err != nil {
+		return nil, err
+	}
+	endpoints, err := kubeCache.GetEndpoints(namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := kubeCache.GetPods(namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	drs, err := kubeCache.GetDestinationRules(ctx, meta_v1.NamespaceAll, "")
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := map[string]*core_v1.Node{}
+	nodesReadable := true
+	for _, subset := range endpoints.Subsets {
+		for _, address := range append(append([]core_v1.EndpointAddress{}, subset.Addresses...), subset.NotReadyAddresses...) {
+			if address.NodeName == nil || !nodesReadable {
+				continue
+			}
+			if _, ok := nodes[*address.NodeName]; ok {
+				continue
+			}
+			node, err := client.Kube().CoreV1().Nodes().Get(ctx, *address.NodeName, meta_v1.GetOptions{})
+			if err != nil {
+				log.Debugf("Unable to read the node [%s] of the endpoints of service [%s]: %s", *address.NodeName, service, err)
+				nodesReadable = false
+				continue
+			}
+			nodes[node.Name] = node
+		}
+	}
+
+	locality := serviceLocality(in.businessLayer.Mesh.GetMeshConfig().LocalityLbSetting, drs, in.config.ExternalServices.Istio.RootNamespace, namespace, service, endpoints, pods, nodes)
+	if !nodesReadable {
+		locality.Warnings = append(locality.Warnings, "The nodes of the endpoints cannot be read, the localities of some endpoints are unknown")
+	}
+	return locality, nil
+}
+
+// serviceLocality computes the locality load balancing configuration of the service from the setting of the mesh, the
+// DestinationRules, and the localities of the endpoints.
+func serviceLocality(meshSetting *models.LocalityLbSetting, drs []*networking_v1.DestinationRule, rootNamespace, namespace, service string, endpoints *core_v1.Endpoints, pods []core_v1.Pod, nodes map[string]*core_v1.Node) *models.ServiceLocality {
+	result := &models.ServiceLocality{
+		MeshSetting:      meshSetting,
+		DestinationRules: []models.LocalityDestinationRule{},
+		Localities:       []models.LocalityEndpoints{},
+		Warnings:         []string{},
+	}
+
+	// The DestinationRule applied is the one of the namespace of the service, then of the root namespace, then from
+	// other namespaces, the exact host being preferred to wildcards and the oldest one to the others
+	host := resolveHostFQDN(service, namespace)
+	type candidate struct {
+		dr    *networking_v1.DestinationRule
+		exact bool
+	}
+	candidates := []candidate{}
+	for _, dr := range drs {
+		if dr.Spec.WorkloadSelector != nil {
+			continue
+		}
+		if matches, exact := hostPatternMatches(resolveHostFQDN(dr.Spec.Host, dr.Namespace), host); matches {
+			candidates = append(candidates, candidate{dr: dr, exact: exact})
+		}
+	}
+	rank := func(c candidate) int {
+		switch c.dr.Namespace {
+		case namespace:
+			return 0
+		case rootNamespace:
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if rank(candidates[i]) != rank(candidates[j]) {
+			return rank(candidates[i]) < rank(candidates[j])
+		}
+		if candidates[i].exact != candidates[j].exact {
+			return candidates[i].exact
+		}
+		return candidates[i].dr.CreationTimestamp.Before(&candidates[j].dr.CreationTimestamp)
+	})
+	for _, c := range candidates {
+		ldr := models.LocalityDestinationRule{DestinationRule: drReference(c.dr)}
+		if tp := c.dr.Spec.TrafficPolicy; tp != nil {
+			ldr.OutlierDetection = tp.OutlierDetection != nil
+			if tp.LoadBalancer != nil && tp.LoadBalancer.LocalityLbSetting != nil {
+				ldr.Setting = toLocalityLbSetting(tp.LoadBalancer.LocalityLbSetting)
+			}
+		}
+		result.DestinationRules = append(result.DestinationRules, ldr)
+	}
+
+	outlierDetection := false
+	switch {
+	case len(result.DestinationRules) > 0 && result.DestinationRules[0].Setting != nil:
+		applied := result.DestinationRules[0]
+		result.Effective = applied.Setting
+		result.EffectiveSource = applied.DestinationRule.Namespace + "/" + applied.DestinationRule.Name
+	case meshSetting != nil:
+		result.Effective = meshSetting
+		result.EffectiveSource = "mesh"
+	}
+	if len(result.DestinationRules) > 0 {
+		outlierDetection = result.DestinationRules[0].OutlierDetection
+	}
+
+	result.Localities = endpointLocalities(endpoints, pods, nodes)
+	result.Warnings = localityWarnings(result.Effective, outlierDetection, result.Localities)
+	return result
+}
+
+func toLocalityLbSetting(setting *api_networking_v1.LocalityLoadBalancerSetting) *models.LocalityLbSetting {
+	result := &models.LocalityLbSetting{FailoverPriority: setting.FailoverPriority}
+	for _, distribute := range setting.Distribute {
+		if distribute != nil {
+			result.Distribute = append(result.Distribute, models.LocalityDistribute{From: distribute.From, To: distribute.To})
+		}
+	}
+	for _, failover := range setting.Failover {
+		if failover != nil {
+			result.Failover = append(result.Failover, models.LocalityFailover{From: failover.From, To: failover.To})
+		}
+	}
+	if setting.Enabled != nil {
+		enabled := setting.Enabled.Value
+		result.Enabled = &enabled
+	}
+	return result
+}
+
+// endpointLocalities groups the endpoints by locality, sorted by locality.
+func endpointLocalities(endpoints *core_v1.Endpoints, pods []core_v1.Pod, nodes map[string]*core_v1.Node) []models.LocalityEndpoints {
+	podLabels := map[string]map[string]string{}
+	for _, pod := range pods {
+		podLabels[pod.Name] = pod.Labels
+	}
+
+	localities := map[string]*models.LocalityEndpoints{}
+	add := func(address core_v1.EndpointAddress, ready bool) {
+		var region, zone, subzone string
+		if address.TargetRef != nil && podLabels[address.TargetRef.Name][localityPodLabel] != "" {
+			// the label value is region.zone.subzone, as / is not allowed in label values
+			parts := strings.SplitN(podLabels[address.TargetRef.Name][localityPodLabel], ".", 3)
+			parts = append(parts, "", "")
+			region, zone, subzone = parts[0], parts[1], parts[2]
+		} else if address.NodeName != nil && nodes[*address.NodeName] != nil {
+			nodeLabels := nodes[*address.NodeName].Labels
+			region, zone, subzone = nodeLabels[regionNodeLabel], nodeLabels[zoneNodeLabel], nodeLabels[subzoneNodeLabel]
+		}
+		key := ""
+		if region != "" {
+			key = region + "/" + zone + "/" + subzone
+		}
+		locality, ok := localities[key]
+		if !ok {
+			locality = &models.LocalityEndpoints{Locality: key, Region: region, Zone: zone, Subzone: subzone, Pods: []string{}}
+			localities[key] = locality
+		}
+		if ready {
+			locality.Ready++
+		} else {
+			locality.NotReady++
+		}
+		if address.TargetRef != nil {
+			locality.Pods = append(locality.Pods, address.TargetRef.Name)
+		}
+	}
+	for _, subset := range endpoints.Subsets {
+		for _, address := range subset.Addresses {
+			add(address, true)
+		}
+		for _, address := range subset.NotReadyAddresses {
+			add(address, false)
+		}
+	}
+
+	result := make([]models.LocalityEndpoints, 0, len(localities))
+	for _, locality := range localities {
+		sort.Strings(locality.Pods)
+		result = append(result, *locality)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Locality < result[j].Locality })
+	return result
+}
+
+// localityWarnings returns the mismatches between the locality load balancing setting and the localities of the
+// endpoints.
+func localityWarnings(setting *models.LocalityLbSetting, outlierDetection bool, localities []models.LocalityEndpoints) []string {
+	warnings := []string{}
+
+	regions := map[string]bool{}
+	for _, locality := range localities {
+		if locality.Locality == "" {
+			warnings = append(warnings, fmt.Sprintf("%d endpoints have no locality, their nodes have no %s label", locality.Ready+locality.NotReady, regionNodeLabel))
+			continue
+		}
+		if locality.Ready > 0 {
+			regions[locality.Region] = true
+		}
+	}
+
+	if setting == nil || !setting.IsEnabled() {
+		return warnings
+	}
+
+	// Without distribution, the endpoints are prioritized by locality and the traffic fails over to the other ones
+	if len(setting.Distribute) == 0 {
+		if !outlierDetection {
+			warnings = append(warnings, "The locality failover is not applied: the DestinationRule of the service has no outlierDetection")
+		}
+		if len(localities) == 1 && localities[0].Locality != "" {
+			warnings = append(warnings, fmt.Sprintf("All the endpoints are in locality %s, there is no other locality to fail over to", localities[0].Locality))
+		}
+	}
+	for _, failover := range setting.Failover {
+		if failover.To != "" && !regions[failover.To] {
+			warnings = append(warnings, fmt.Sprintf("The failover from region %s goes to region %s, which has no ready endpoint", failover.From, failover.To))
+		}
+	}
+	for _, distribute := range setting.Distribute {
+		var total uint32
+		targets := make([]string, 0, len(distribute.To))
+		for target, weight := range distribute.To {
+			total += weight
+			targets = append(targets, target)
+		}
+		sort.Strings(targets)
+		if total != 100 {
+			warnings = append(warnings, fmt.Sprintf("The weights of the distribution from %s sum up to %d instead of 100", distribute.From, total))
+		}
+		for _, target := range targets {
+			if distribute.To[target] > 0 && !hasReadyLocality(localities, target) {
+				warnings = append(warnings, fmt.Sprintf("The distribution from %s sends %d%% of the traffic to %s, which has no ready endpoint", distribute.From, distribute.To[target], target))
+			}
+		}
+	}
+	return warnings
+}
+
+// hasReadyLocality returns whether a locality with ready endpoints matches the pattern, written as
+// region/zone/subzone where any part can be "*".
+func hasReadyLocality(localities []models.LocalityEndpoints, pattern string) bool {
+	patternParts := strings.Split(pattern, "/")
+	for _, locality := range localities {
+		if locality.Ready == 0 || locality.Locality == "" {
+			continue
+		}
+		parts := []string{locality.Region, locality.Zone, locality.Subzone}
+		matches := true
+		for i, part := range patternParts {
+			if i >= len(parts) || (part != "*" && part != parts[i]) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
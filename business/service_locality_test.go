@@ -0,0 +1,94 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func fakeLocalityEndpoints() (*core_v1.Endpoints, []core_v1.Pod, map[string]*core_v1.Node) {
+	nodeName := func(name string) *string { return &name }
+	address := func(pod, node string) core_v1.EndpointAddress {
+		return core_v1.EndpointAddress{NodeName: nodeName(node), TargetRef: &core_v1.ObjectReference{Kind: "Pod", Name: pod}}
+	}
+	endpoints := &core_v1.Endpoints{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+		Subsets: []core_v1.EndpointSubset{{
+			Addresses:         []core_v1.EndpointAddress{address("reviews-1", "node-a"), address("reviews-2", "node-a"), address("reviews-3", "node-b")},
+			NotReadyAddresses: []core_v1.EndpointAddress{address("reviews-4", "node-c")},
+		}},
+	}
+	pods := []core_v1.Pod{
+		{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews-3", Namespace: "bookinfo", Labels: map[string]string{localityPodLabel: "us-east.zone1.rack1"}}},
+	}
+	nodes := map[string]*core_v1.Node{
+		"node-a": {ObjectMeta: meta_v1.ObjectMeta{Name: "node-a", Labels: map[string]string{regionNodeLabel: "us-west", zoneNodeLabel: "zone1"}}},
+		"node-c": {ObjectMeta: meta_v1.ObjectMeta{Name: "node-c", Labels: map[string]string{regionNodeLabel: "eu-central", zoneNodeLabel: "zone1"}}},
+	}
+	return endpoints, pods, nodes
+}
+
+func TestServiceLocality(t *testing.T) {
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	meshDefault := data.CreateEmptyDestinationRule("istio-system", "default", "*.local")
+	meshDefault.Spec.TrafficPolicy = &api_networking_v1.TrafficPolicy{OutlierDetection: &api_networking_v1.OutlierDetection{}}
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")
+	dr.Spec.TrafficPolicy = &api_networking_v1.TrafficPolicy{
+		LoadBalancer: &api_networking_v1.LoadBalancerSettings{
+			LocalityLbSetting: &api_networking_v1.LocalityLoadBalancerSetting{
+				Failover: []*api_networking_v1.LocalityLoadBalancerSetting_Failover{{From: "us-west", To: "eu-central"}},
+				Enabled:  wrapperspb.Bool(true),
+			},
+		},
+	}
+	meshSetting := &models.LocalityLbSetting{FailoverPriority: []string{"topology.kubernetes.io/region"}}
+
+	endpoints, pods, nodes := fakeLocalityEndpoints()
+	locality := serviceLocality(meshSetting, []*networking_v1.DestinationRule{meshDefault, dr}, "istio-system", "bookinfo", "reviews", endpoints, pods, nodes)
+
+	require.Len(locality.DestinationRules, 2)
+	require.Equal("reviews", locality.DestinationRules[0].DestinationRule.Name)
+	require.Equal("bookinfo/reviews", locality.EffectiveSource)
+	require.Equal([]models.LocalityFailover{{From: "us-west", To: "eu-central"}}, locality.Effective.Failover)
+	require.True(*locality.Effective.Enabled)
+
+	require.Len(locality.Localities, 3)
+	require.Equal(models.LocalityEndpoints{Locality: "eu-central/zone1/", Region: "eu-central", Zone: "zone1", NotReady: 1, Pods: []string{"reviews-4"}}, locality.Localities[0])
+	require.Equal(models.LocalityEndpoints{Locality: "us-east/zone1/rack1", Region: "us-east", Zone: "zone1", Subzone: "rack1", Ready: 1, Pods: []string{"reviews-3"}}, locality.Localities[1])
+	require.Equal(models.LocalityEndpoints{Locality: "us-west/zone1/", Region: "us-west", Zone: "zone1", Ready: 2, Pods: []string{"reviews-1", "reviews-2"}}, locality.Localities[2])
+
+	require.Equal([]string{
+		"The locality failover is not applied: the DestinationRule of the service has no outlierDetection",
+		"The failover from region us-west goes to region eu-central, which has no ready endpoint",
+	}, locality.Warnings)
+}
+
+func TestServiceLocalityDistribution(t *testing.T) {
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	meshSetting := &models.LocalityLbSetting{
+		Distribute: []models.LocalityDistribute{{From: "us-west/*", To: map[string]uint32{"us-west/zone1/*": 70, "us-east/*": 20, "ap-south/*": 5}}},
+	}
+
+	endpoints, pods, nodes := fakeLocalityEndpoints()
+	locality := serviceLocality(meshSetting, nil, "istio-system", "bookinfo", "reviews", endpoints, pods, nodes)
+
+	require.Empty(locality.DestinationRules)
+	require.Equal("mesh", locality.EffectiveSource)
+	require.Equal([]string{
+		"The weights of the distribution from us-west/* sum up to 95 instead of 100",
+		"The distribution from us-west/* sends 5% of the traffic to ap-south/*, which has no ready endpoint",
+	}, locality.Warnings)
+}
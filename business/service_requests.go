@@ -0,0 +1,223 @@
+package business
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nitishm/engarde/pkg/parser"
+	"golang.org/x/sync/errgroup"
+	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// DefaultRecentRequestsWindow is how far back the proxy access logs are read when no window is provided.
+const DefaultRecentRequestsWindow = "1m"
+
+// DefaultRecentRequestsTail is the number of proxy log lines read per pod when no tail is provided.
+const DefaultRecentRequestsTail = int64(100)
+
+// RecentRequestsOptions selects the proxy access log entries of the recent requests of a service.
+type RecentRequestsOptions struct {
+	// Follow keeps streaming the requests as they are logged, until the context is done
+	Follow bool
+	// TailLines is the number of proxy log lines read per pod before following them
+	TailLines int64
+	Window    time.Duration
+}
+
+// jsonAccessLog is an access log entry written with the JSON encoding of the Istio access logs.
+type jsonAccessLog struct {
+	Authority       string `json:"authority"`
+	DownstreamPeer  string `json:"downstream_remote_address"`
+	Duration        int    `json:"duration"`
+	Method          string `json:"method"`
+	Path            string `json:"path"`
+	Protocol        string `json:"protocol"`
+	RequestID       string `json:"request_id"`
+	ResponseCode    *int   `json:"response_code"`
+	ResponseFlags   string `json:"response_flags"`
+	StartTime       string `json:"start_time"`
+	UpstreamCluster string `json:"upstream_cluster"`
+}
+
+// StreamRecentRequests reads the access logs of the proxies of the pods of the service and emits the requests
+// received by the service, as they are parsed. The requests of the pods are emitted concurrently, in the order of
+// their logs. When following the logs, it returns when the context is done.
+func (in *SvcService) StreamRecentRequests(ctx context.Context, cluster, namespace, service string, opts RecentRequestsOptions, emit func(models.RecentRequest) error) error {
+	// Checks the user access to the namespace
+	svc, err := in.GetService(ctx, cluster, namespace, service)
+	if err != nil {
+		return err
+	}
+	// Without selector, the pods receiving the requests are unknown
+	if len(svc.Selectors) == 0 {
+		return nil
+	}
+	userClient, found := in.userClients[cluster]
+	if !found {
+		return fmt.Errorf("user client for cluster [%s] not found", cluster)
+	}
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return err
+	}
+	pods, err := kubeCache.GetPods(namespace, labels.Set(svc.Selectors).String())
+	if err != nil {
+		return err
+	}
+
+	// The requests received by the pods on other ports are sent to other services
+	var ports map[int]bool
+	if kSvc, err := kubeCache.GetService(namespace, service); err == nil {
+		ports = targetPorts(kSvc)
+	}
+
+	sinceSeconds := int64(opts.Window.Seconds())
+	tailLines := opts.TailLines
+	// emit is not expected to be safe for concurrent use
+	var mu sync.Mutex
+	g, gctx := errgroup.WithContext(ctx)
+	for _, pod := range pods {
+		mPod := models.Pod{}
+		mPod.Parse(&pod)
+		if !mPod.HasIstioSidecar() {
+			continue
+		}
+		podName := pod.Name
+		g.Go(func() error {
+			logs, err := userClient.StreamPodLogs(namespace, podName, &core_v1.PodLogOptions{
+				Container:    models.IstioProxy,
+				Follow:       opts.Follow,
+				SinceSeconds: &sinceSeconds,
+				TailLines:    &tailLines,
+			})
+			if err != nil {
+				return err
+			}
+			// Closing the logs unblocks the read of a followed stream when the request is done
+			stop := context.AfterFunc(gctx, func() { logs.Close() })
+			defer func() {
+				if stop() {
+					if err := logs.Close(); err != nil {
+						log.Debugf("Error closing the proxy logs of pod [%s/%s]: %s", namespace, podName, err)
+					}
+				}
+			}()
+
+			return readRecentRequests(logs, podName, ports, func(request models.RecentRequest) error {
+				mu.Lock()
+				defer mu.Unlock()
+				return emit(request)
+			})
+		})
+	}
+
+	err = g.Wait()
+	if ctx.Err() != nil {
+		// The client is gone, or the server shuts down: the read errors caused by the closed logs don't matter
+		return nil
+	}
+	return err
+}
+
+// targetPorts returns the numeric target ports of the service, or nil when any of them is named.
+func targetPorts(svc *core_v1.Service) map[int]bool {
+	ports := map[int]bool{}
+	for _, port := range svc.Spec.Ports {
+		switch {
+		case port.TargetPort.StrVal != "":
+			return nil
+		case port.TargetPort.IntVal != 0:
+			ports[int(port.TargetPort.IntVal)] = true
+		default:
+			ports[int(port.Port)] = true
+		}
+	}
+	return ports
+}
+
+// readRecentRequests parses the proxy log lines of the pod and emits the inbound requests on the given ports,
+// or on any port when ports is nil. The access logs can be written in the default text format or in JSON.
+func readRecentRequests(logs io.Reader, pod string, ports map[int]bool, emit func(models.RecentRequest) error) error {
+	engardeParser := parser.New(parser.IstioProxyAccessLogsPattern)
+	scanner := bufio.NewScanner(logs)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		var request *models.RecentRequest
+		var upstreamCluster string
+		switch {
+		case strings.HasPrefix(line, "{"):
+			var entry jsonAccessLog
+			if err := json.Unmarshal([]byte(line), &entry); err != nil || entry.ResponseCode == nil {
+				continue
+			}
+			request = &models.RecentRequest{
+				Timestamp:     entry.StartTime,
+				Authority:     entry.Authority,
+				Method:        entry.Method,
+				Path:          entry.Path,
+				Protocol:      entry.Protocol,
+				StatusCode:    *entry.ResponseCode,
+				ResponseFlags: entry.ResponseFlags,
+				DurationMs:    entry.Duration,
+				Source:        entry.DownstreamPeer,
+				RequestID:     entry.RequestID,
+			}
+			upstreamCluster = entry.UpstreamCluster
+		case strings.HasPrefix(line, "["):
+			al, err := engardeParser.Parse(line)
+			if err != nil || isAccessLogEmpty(al) {
+				continue
+			}
+			request = &models.RecentRequest{
+				Timestamp:     al.Timestamp,
+				Authority:     al.Authority,
+				Method:        al.Method,
+				Path:          al.UriPath + al.UriParam,
+				Protocol:      al.Protocol,
+				ResponseFlags: al.ResponseFlags,
+				Source:        al.DownstreamRemote,
+				RequestID:     al.RequestId,
+			}
+			// The missing values are logged as "-"
+			request.StatusCode, _ = strconv.Atoi(al.StatusCode)
+			request.DurationMs, _ = strconv.Atoi(al.Duration)
+			upstreamCluster = al.UpstreamCluster
+		default:
+			continue
+		}
+
+		if !isInboundOn(upstreamCluster, ports) {
+			continue
+		}
+		request.Pod = pod
+		if err := emit(*request); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// isInboundOn returns whether the Envoy cluster, i.e. "inbound|9080||", receives the requests on one of the ports,
+// or on any port when ports is nil.
+func isInboundOn(upstreamCluster string, ports map[int]bool) bool {
+	parts := strings.Split(upstreamCluster, "|")
+	if len(parts) < 2 || parts[0] != "inbound" {
+		return false
+	}
+	if ports == nil {
+		return true
+	}
+	port, err := strconv.Atoi(parts[1])
+	return err == nil && ports[port]
+}
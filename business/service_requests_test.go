@@ -0,0 +1,80 @@
+package business
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func TestStreamRecentRequests(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	pod := FakePodsSyncedWithDeployments()[0]
+	pod.Labels = FakeRSSyncedWithPods()[0].Spec.Template.Labels
+	svc := &core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "details", Namespace: "Namespace"},
+		Spec: core_v1.ServiceSpec{
+			Selector: map[string]string{conf.IstioLabels.AppLabelName: "details"},
+			Ports:    []core_v1.ServicePort{{Name: "http", Port: 80, TargetPort: intstr.FromInt32(8000)}},
+		},
+	}
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("Namespace"), &pod, svc)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	logs := strings.Join([]string{
+		"2021-02-01T21:34:30.000Z\tinfo\tEnvoy proxy is ready",
+		proxyAccessLog,
+		// Sent by the pod
+		strings.Replace(proxyAccessLog, "inbound|8000||", "outbound|8000||hotels.travel-agency.svc.cluster.local", 1),
+		// Received on a port of another service
+		strings.Replace(proxyAccessLog, "inbound|8000||", "inbound|9090||", 1),
+		`{"start_time":"2021-02-01T21:34:36.000Z","method":"POST","path":"/hotels","protocol":"HTTP/1.1","response_code":503,"response_flags":"UF","duration":3,"authority":"hotels.travel-agency:8000","downstream_remote_address":"10.128.0.79:39880","upstream_cluster":"inbound|8000||","request_id":"abc"}`,
+	}, "\n")
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: &fakeLogsClient{ClientInterface: k8s, logs: logs}}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	requests := []models.RecentRequest{}
+	err := layer.Svc.StreamRecentRequests(context.TODO(), conf.KubernetesConfig.ClusterName, "Namespace", "details", RecentRequestsOptions{TailLines: DefaultRecentRequestsTail}, func(request models.RecentRequest) error {
+		requests = append(requests, request)
+		return nil
+	})
+	require.NoError(err)
+	require.Len(requests, 2)
+
+	require.Equal("details-v1-3618568057-dnkjp", requests[0].Pod)
+	require.Equal("2021-02-01T21:34:35.533Z", requests[0].Timestamp)
+	require.Equal("GET", requests[0].Method)
+	require.Equal("/hotels/Ljubljana", requests[0].Path)
+	require.Equal(200, requests[0].StatusCode)
+	require.Equal(14, requests[0].DurationMs)
+	require.Equal("hotels.travel-agency:8000", requests[0].Authority)
+
+	require.Equal("POST", requests[1].Method)
+	require.Equal(503, requests[1].StatusCode)
+	require.Equal("UF", requests[1].ResponseFlags)
+	require.Equal(3, requests[1].DurationMs)
+	require.Equal("10.128.0.79:39880", requests[1].Source)
+}
+
+func TestIsInboundOn(t *testing.T) {
+	require := require.New(t)
+
+	require.True(isInboundOn("inbound|9080||", map[int]bool{9080: true}))
+	require.False(isInboundOn("inbound|9090||", map[int]bool{9080: true}))
+	require.True(isInboundOn("inbound|9090||", nil))
+	require.False(isInboundOn("outbound|9080||reviews.bookinfo.svc.cluster.local", nil))
+	require.False(isInboundOn("-", nil))
+}
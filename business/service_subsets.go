@@ -0,0 +1,188 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// invalidSubsetNameChars matches the characters not allowed in a subset name, a DNS-1123 label.
+var invalidSubsetNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// GetServiceSubsets generates the DestinationRule subsets of a service from the distinct values of the version label
+// of its workloads, without applying them. The current DestinationRule of the service in its namespace is updated:
+// its subsets selecting a version that is still deployed are kept as is, the missing versions get a new subset and
+// the subsets selecting only a version that is not deployed anymore are removed. Other subsets are left untouched.
+// A new DestinationRule named after the service is generated when there is none.
+func (in *IstioConfigService) GetServiceSubsets(ctx context.Context, cluster, namespace, service string) (*models.ServiceSubsets, error) {
+	// Checks the user access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := kubeCache.GetService(namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("service [%s] has no selector, its workloads are unknown", service))
+	}
+	workloads, err := in.businessLayer.Workload.fetchWorkloadsFromCluster(ctx, cluster, namespace, labels.Set(svc.Spec.Selector).String())
+	if err != nil {
+		return nil, err
+	}
+
+	versionLabel := in.config.IstioLabels.VersionLabelName
+	deployed := map[string]bool{}
+	for _, w := range workloads {
+		if version := w.Labels[versionLabel]; version != "" {
+			deployed[version] = true
+		}
+	}
+	if len(deployed) == 0 {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("no workload of service [%s] has a [%s] label", service, versionLabel))
+	}
+	subsets := &models.ServiceSubsets{Versions: make([]string, 0, len(deployed))}
+	for version := range deployed {
+		subsets.Versions = append(subsets.Versions, version)
+	}
+	sort.Strings(subsets.Versions)
+
+	drs, err := kubeCache.GetDestinationRules(ctx, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	drs = kubernetes.FilterDestinationRulesByService(drs, namespace, service)
+	sort.Slice(drs, func(i, j int) bool {
+		return drs[i].Name < drs[j].Name
+	})
+
+	// The current spec is nil when there is no DestinationRule
+	var current interface{}
+	if len(drs) > 0 {
+		current = drs[0]
+		subsets.Exists = true
+		subsets.DestinationRule = drs[0].DeepCopy()
+	} else {
+		subsets.DestinationRule = &networking_v1.DestinationRule{
+			TypeMeta: meta_v1.TypeMeta{
+				APIVersion: kubernetes.DestinationRules.GroupVersion().String(),
+				Kind:       kubernetes.DestinationRules.Kind,
+			},
+			ObjectMeta: meta_v1.ObjectMeta{Name: service, Namespace: namespace},
+		}
+		subsets.DestinationRule.Spec.Host = fmt.Sprintf("%s.%s.%s", service, namespace, in.config.ExternalServices.Istio.IstioIdentityDomain)
+	}
+	subsets.DestinationRule.Spec.Subsets = versionSubsets(subsets.DestinationRule.Spec.Subsets, versionLabel, subsets.Versions)
+
+	subsets.Changes, err = diffObjectSpecs(current, subsets.DestinationRule)
+	if err != nil {
+		return nil, err
+	}
+	return subsets, nil
+}
+
+// versionSubsets returns the subsets selecting the versions: the existing subsets selecting a deployed version or
+// not only selecting a version are kept in their order, followed by the new subsets of the missing versions.
+func versionSubsets(existing []*api_networking_v1.Subset, versionLabel string, versions []string) []*api_networking_v1.Subset {
+	deployed := map[string]bool{}
+	for _, version := range versions {
+		deployed[version] = true
+	}
+
+	subsets := []*api_networking_v1.Subset{}
+	names := map[string]bool{}
+	covered := map[string]bool{}
+	for _, subset := range existing {
+		version, selectsVersion := subset.Labels[versionLabel]
+		if selectsVersion && len(subset.Labels) == 1 && !deployed[version] {
+			continue
+		}
+		if selectsVersion && len(subset.Labels) == 1 {
+			covered[version] = true
+		}
+		names[subset.Name] = true
+		subsets = append(subsets, subset)
+	}
+
+	for _, version := range versions {
+		if covered[version] {
+			continue
+		}
+		name := subsetName(version)
+		for i := 2; names[name]; i++ {
+			name = fmt.Sprintf("%s-%d", subsetName(version), i)
+		}
+		names[name] = true
+		subsets = append(subsets, &api_networking_v1.Subset{Name: name, Labels: map[string]string{versionLabel: version}})
+	}
+	return subsets
+}
+
+// subsetName returns the name of the subset of a version, a DNS-1123 label derived from the version.
+func subsetName(version string) string {
+	name := strings.Trim(invalidSubsetNameChars.ReplaceAllString(strings.ToLower(version), "-"), "-")
+	if len(name) > validation.DNS1123LabelMaxLength {
+		name = strings.Trim(name[:validation.DNS1123LabelMaxLength], "-")
+	}
+	if name == "" {
+		return "version"
+	}
+	return name
+}
+
+// ApplyServiceSubsets creates or updates the DestinationRule of the generated subsets, unless it is unchanged.
+// Only the subsets of an existing DestinationRule are updated.
+func (in *IstioConfigService) ApplyServiceSubsets(ctx context.Context, cluster, namespace string, subsets *models.ServiceSubsets, user string) error {
+	dr := subsets.DestinationRule
+	if subsets.Exists && len(subsets.Changes) == 0 {
+		return nil
+	}
+
+	payload, err := ServiceSubsetsPayload(subsets)
+	if err != nil {
+		return err
+	}
+	operation := models.IstioConfigMutationCreate
+	if subsets.Exists {
+		operation = models.IstioConfigMutationUpdate
+		_, err = in.UpdateIstioConfigDetail(ctx, cluster, namespace, kubernetes.DestinationRules, dr.Name, string(payload))
+	} else {
+		_, err = in.CreateIstioConfigDetail(ctx, cluster, namespace, kubernetes.DestinationRules, payload)
+	}
+	if err != nil {
+		return err
+	}
+	in.RecordConfigChange(cluster, namespace, kubernetes.DestinationRules, dr.Name, operation, user)
+	subsets.Applied = true
+	return nil
+}
+
+// ServiceSubsetsPayload returns the payload applying the generated subsets: the merge patch of the subsets of an
+// existing DestinationRule, or the new DestinationRule.
+func ServiceSubsetsPayload(subsets *models.ServiceSubsets) ([]byte, error) {
+	if !subsets.Exists {
+		return json.Marshal(subsets.DestinationRule)
+	}
+	// A merge patch replaces the whole list of subsets
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"subsets": subsets.DestinationRule.Spec.Subsets},
+	})
+}
@@ -0,0 +1,122 @@
+package business
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func fakeVersionDeployment(name, app, version string) *apps_v1.Deployment {
+	labels := map[string]string{"app": app, "version": version}
+	return &apps_v1.Deployment{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: "bookinfo", Labels: labels},
+		Spec: apps_v1.DeploymentSpec{
+			Selector: &meta_v1.LabelSelector{MatchLabels: labels},
+			Template: core_v1.PodTemplateSpec{ObjectMeta: meta_v1.ObjectMeta{Labels: labels}},
+		},
+	}
+}
+
+func setupServiceSubsets(t *testing.T, objects ...runtime.Object) (IstioConfigService, kubernetes.ClientInterface) {
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	config.Set(conf)
+
+	reviews := kubetest.FakeService("bookinfo", "reviews")
+	ratings := kubetest.FakeService("bookinfo", "ratings")
+	objects = append(objects,
+		kubetest.FakeNamespace("bookinfo"),
+		&reviews,
+		&ratings,
+		fakeVersionDeployment("reviews-v1", "reviews", "v1"),
+		fakeVersionDeployment("reviews-v2", "reviews", "v2"),
+		fakeVersionDeployment("ratings-v1", "ratings", "1.2.0"),
+	)
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	cache := SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	return IstioConfigService{config: *conf, userClients: k8sclients, kialiCache: cache, businessLayer: NewWithBackends(k8sclients, k8sclients, nil, nil)}, k8s
+}
+
+func TestGetServiceSubsets(t *testing.T) {
+	require := require.New(t)
+
+	dr := data.CreateEmptyDestinationRule("bookinfo", "reviews", "reviews")
+	v1 := data.CreateSubset("v1", "v1")
+	v1.TrafficPolicy = &api_networking_v1.TrafficPolicy{ConnectionPool: &api_networking_v1.ConnectionPoolSettings{}}
+	canary := &api_networking_v1.Subset{Name: "canary", Labels: map[string]string{"version": "v3", "track": "canary"}}
+	dr.Spec.Subsets = []*api_networking_v1.Subset{v1, data.CreateSubset("v3", "v3"), canary}
+
+	configService, k8s := setupServiceSubsets(t, dr)
+	cluster := config.Get().KubernetesConfig.ClusterName
+
+	subsets, err := configService.GetServiceSubsets(context.TODO(), cluster, "bookinfo", "reviews")
+	require.NoError(err)
+	require.Equal([]string{"v1", "v2"}, subsets.Versions)
+	require.True(subsets.Exists)
+	require.Equal("reviews", subsets.DestinationRule.Name)
+
+	// The deployed version is kept with its traffic policy, the version not deployed anymore is removed,
+	// the subset selecting more than the version is kept and the missing version is added
+	generated := subsets.DestinationRule.Spec.Subsets
+	require.Len(generated, 3)
+	require.Equal("v1", generated[0].Name)
+	require.NotNil(generated[0].TrafficPolicy)
+	require.Equal("canary", generated[1].Name)
+	require.Equal("v2", generated[2].Name)
+	require.Equal(map[string]string{"version": "v2"}, generated[2].Labels)
+	require.NotEmpty(subsets.Changes)
+	// The cached DestinationRule is not modified
+	require.Len(dr.Spec.Subsets, 3)
+
+	require.NoError(configService.ApplyServiceSubsets(context.TODO(), cluster, "bookinfo", subsets, "jdoe"))
+	require.True(subsets.Applied)
+	updated, err := k8s.Istio().NetworkingV1().DestinationRules("bookinfo").Get(context.TODO(), "reviews", meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Len(updated.Spec.Subsets, 3)
+	require.Equal("v2", updated.Spec.Subsets[2].Name)
+}
+
+func TestGetServiceSubsetsNewDestinationRule(t *testing.T) {
+	require := require.New(t)
+
+	configService, k8s := setupServiceSubsets(t)
+	cluster := config.Get().KubernetesConfig.ClusterName
+
+	subsets, err := configService.GetServiceSubsets(context.TODO(), cluster, "bookinfo", "ratings")
+	require.NoError(err)
+	require.False(subsets.Exists)
+	require.Equal("ratings.bookinfo.svc.cluster.local", subsets.DestinationRule.Spec.Host)
+	require.Len(subsets.DestinationRule.Spec.Subsets, 1)
+	// The subset name is a valid DNS-1123 label
+	require.Equal("1-2-0", subsets.DestinationRule.Spec.Subsets[0].Name)
+	require.Equal(map[string]string{"version": "1.2.0"}, subsets.DestinationRule.Spec.Subsets[0].Labels)
+
+	require.NoError(configService.ApplyServiceSubsets(context.TODO(), cluster, "bookinfo", subsets, "jdoe"))
+	created, err := k8s.Istio().NetworkingV1().DestinationRules("bookinfo").Get(context.TODO(), "ratings", meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Len(created.Spec.Subsets, 1)
+
+	_, err = configService.GetServiceSubsets(context.TODO(), cluster, "bookinfo", "details")
+	require.Error(err)
+}
+
+func TestSubsetName(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("v1", subsetName("v1"))
+	require.Equal("release-1-2", subsetName("Release_1.2"))
+	require.Equal("version", subsetName("..."))
+}
@@ -6,14 +6,18 @@ import (
 	"sync"
 	"time"
 
+	osroutes_v1 "github.com/openshift/api/route/v1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
+	discovery_v1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/version"
 
 	"github.com/kiali/kiali/business/checkers"
+	"github.com/kiali/kiali/business/checkers/services"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/kubernetes/cache"
@@ -113,6 +117,7 @@ func (in *SvcService) getServiceListForCluster(ctx context.Context, criteria Ser
 		rSvcs           []*kubernetes.RegistryService
 		pods            []core_v1.Pod
 		deployments     []apps_v1.Deployment
+		routes          []osroutes_v1.Route
 		istioConfigList models.IstioConfigList
 		err             error
 		kubeCache       cache.KubeCache
@@ -161,6 +166,7 @@ func (in *SvcService) getServiceListForCluster(ctx context.Context, criteria Ser
 			log.Errorf("Error fetching Deployments per namespace %s: %s", criteria.Namespace, err)
 			return nil, err
 		}
+		routes = in.getRoutes(ctx, cluster, criteria.Namespace)
 	}
 
 	// Cross-namespace query of all Istio Resources to find references
@@ -185,7 +191,7 @@ func (in *SvcService) getServiceListForCluster(ctx context.Context, criteria Ser
 	}
 
 	// Convert to Kiali model
-	services := in.buildServiceList(cluster, criteria.Namespace, svcs, rSvcs, pods, deployments, istioConfigList, criteria)
+	services := in.buildServiceList(cluster, criteria.Namespace, svcs, rSvcs, pods, deployments, routes, istioConfigList, criteria)
 
 	// Check if we need to add health
 
@@ -222,11 +228,11 @@ func getDRKialiScenario(dr []*networking_v1.DestinationRule) string {
 	return scenario
 }
 
-func (in *SvcService) buildServiceList(cluster string, namespace string, svcs []core_v1.Service, rSvcs []*kubernetes.RegistryService, pods []core_v1.Pod, deployments []apps_v1.Deployment, istioConfigList models.IstioConfigList, criteria ServiceCriteria) *models.ServiceList {
+func (in *SvcService) buildServiceList(cluster string, namespace string, svcs []core_v1.Service, rSvcs []*kubernetes.RegistryService, pods []core_v1.Pod, deployments []apps_v1.Deployment, routes []osroutes_v1.Route, istioConfigList models.IstioConfigList, criteria ServiceCriteria) *models.ServiceList {
 	services := []models.ServiceOverview{}
 	validations := models.IstioValidations{}
 	if !criteria.IncludeOnlyDefinitions {
-		validations = in.getServiceValidations(svcs, deployments, pods)
+		validations = in.getServiceValidations(cluster, svcs, deployments, pods, routes)
 	}
 
 	kubernetesServices := in.buildKubernetesServices(svcs, pods, istioConfigList, criteria.IncludeOnlyDefinitions, cluster)
@@ -454,6 +460,7 @@ func (in *SvcService) GetServiceDetails(ctx context.Context, cluster, namespace,
 	}
 
 	var eps *core_v1.Endpoints
+	var slices []discovery_v1.EndpointSlice
 	var pods []core_v1.Pod
 	var hth models.ServiceHealth
 	var istioConfigList *models.IstioConfigList
@@ -510,6 +517,19 @@ func (in *SvcService) GetServiceDetails(ctx context.Context, cluster, namespace,
 		}
 	}(ctx)
 
+	if userClient, ok := in.userClients[cluster]; ok {
+		wg.Add(1)
+		go func(ctx context.Context) {
+			defer wg.Done()
+			var err2 error
+			// The EndpointSlices are optional, the readiness falls back to the Endpoints
+			slices, err2 = getEndpointSlices(ctx, userClient, namespace, service)
+			if err2 != nil {
+				log.Debugf("Unable to fetch the EndpointSlices of namespace %s and service %s: %s", namespace, service, err2)
+			}
+		}(ctx)
+	}
+
 	wg.Add(1)
 	go func(ctx context.Context) {
 		defer wg.Done()
@@ -632,6 +652,9 @@ func (in *SvcService) GetServiceDetails(ctx context.Context, cluster, namespace,
 		s.SetIstioSidecar(wo)
 	}
 	s.SetEndpoints(eps)
+	if s.Service.Type != "External" && s.Service.Type != "Federation" {
+		s.EndpointStatus = endpointStatus(slices, eps, labelsSelector != "", pods)
+	}
 	s.IstioPermissions = models.ResourcePermissions{
 		Create: vsCreate,
 		Update: vsUpdate,
@@ -649,6 +672,12 @@ func (in *SvcService) GetServiceDetails(ctx context.Context, cluster, namespace,
 	if s.IsAmbient && len(waypointWk) > 0 {
 		s.WaypointWorkloads = waypointWk
 	}
+	s.DeepLinks = models.GetDeepLinks(&in.config, models.DeepLinkKindService, cluster, namespace, service)
+	for _, route := range kubernetes.FilterRoutesByService(in.getRoutes(ctx, cluster, namespace), namespace, service) {
+		serviceRoute := models.ServiceRoute{}
+		serviceRoute.Parse(&route)
+		s.Routes = append(s.Routes, serviceRoute)
+	}
 
 	return &s, nil
 }
@@ -674,7 +703,7 @@ func (in *SvcService) isServiceCaptured(svc *models.Service) ([]models.Waypoint,
 	}
 
 	// Is the namespace labeled?
-	ns, foundNS := in.businessLayer.Workload.cache.GetNamespace(svc.Cluster, in.userClients[svc.Cluster].GetToken(), svc.Namespace)
+	ns, foundNS := in.businessLayer.Workload.cache.GetNamespace(svc.Cluster, in.userClients[svc.Cluster].GetIdentity(), svc.Namespace)
 
 	if foundNS {
 		waypointNsName, ok := ns.Labels[config.WaypointUseLabel]
@@ -837,16 +866,38 @@ func (in *SvcService) GetService(ctx context.Context, cluster, namespace, servic
 	return svc, nil
 }
 
-func (in *SvcService) getServiceValidations(services []core_v1.Service, deployments []apps_v1.Deployment, pods []core_v1.Pod) models.IstioValidations {
+func (in *SvcService) getServiceValidations(cluster string, services []core_v1.Service, deployments []apps_v1.Deployment, pods []core_v1.Pod, routes []osroutes_v1.Route) models.IstioValidations {
 	validations := checkers.ServiceChecker{
-		Services:    services,
-		Deployments: deployments,
-		Pods:        pods,
+		Services:             services,
+		Deployments:          deployments,
+		Pods:                 pods,
+		Routes:               routes,
+		AppProtocolSupported: in.isAppProtocolSupported(cluster),
 	}.Check()
 
 	return validations
 }
 
+// isAppProtocolSupported returns whether the appProtocol of the Service ports is enabled in the cluster, by default
+// since Kubernetes 1.19. It is false when the version of the cluster can't be fetched.
+func (in *SvcService) isAppProtocolSupported(cluster string) bool {
+	client, ok := in.userClients[cluster]
+	if !ok {
+		return false
+	}
+	serverVersion, err := client.GetServerVersion()
+	if err != nil {
+		log.Debugf("Unable to get the Kubernetes version of cluster [%s]: %s", cluster, err)
+		return false
+	}
+	parsedVersion, err := version.ParseGeneric(serverVersion.GitVersion)
+	if err != nil {
+		log.Debugf("Unable to parse the Kubernetes version [%s] of cluster [%s]: %s", serverVersion.GitVersion, cluster, err)
+		return false
+	}
+	return parsedVersion.AtLeast(version.MajorMinor(1, 19))
+}
+
 // GetServiceTracingName returns a struct with all the information needed for tracing lookup
 // The "Application" name (app label) that relates to a service
 // This label is taken from the service selector, which means it is assumed that pods are selected using that label
@@ -892,6 +943,53 @@ func (in *SvcService) GetServiceTracingName(ctx context.Context, cluster, namesp
 	return tracingName, nil
 }
 
+// getRoutes returns the OpenShift Routes of the namespace, or of all the namespaces when it is empty.
+// It returns nil for non-OpenShift, or if the Routes can not be fetched.
+func (in *SvcService) getRoutes(ctx context.Context, cluster, namespace string) []osroutes_v1.Route {
+	userClient, found := in.userClients[cluster]
+	if !found || !userClient.IsOpenShift() {
+		return nil
+	}
+
+	routes, err := userClient.GetRoutes(ctx, namespace)
+	if err != nil {
+		log.Debugf("[%s][%s] Routes discovery failed: %v", cluster, namespace, err)
+		return nil
+	}
+	return routes
+}
+
+// getServiceRouteValidations validates the OpenShift Routes exposing the Service, which are not part of the Istio
+// config validated in the background.
+func (in *SvcService) getServiceRouteValidations(ctx context.Context, cluster, namespace, service string) (models.IstioValidations, error) {
+	validations := models.IstioValidations{}
+	routes := in.getRoutes(ctx, cluster, namespace)
+	if len(kubernetes.FilterRoutesByService(routes, namespace, service)) == 0 {
+		return validations, nil
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := kubeCache.GetService(namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	var pods []core_v1.Pod
+	if len(svc.Spec.Selector) > 0 {
+		pods, err = kubeCache.GetPods(namespace, labels.Set(svc.Spec.Selector).String())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key, validation := checkers.EmptyValidValidation(service, namespace, schema.GroupVersionKind{Group: "", Version: "", Kind: checkers.ServiceCheckerType}, cluster)
+	validation.Checks, validation.Valid = services.RouteChecker{Service: *svc, Pods: pods, Routes: routes}.Check()
+	validations[key] = validation
+	return validations, nil
+}
+
 // GetServiceRouteURL returns "" for non-OpenShift, or if the route can not be found
 func (in *SvcService) GetServiceRouteURL(ctx context.Context, cluster, namespace, service string) (url string) {
 	url = ""
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	osproject_v1 "github.com/openshift/api/project/v1"
 	osroutes_v1 "github.com/openshift/api/route/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -271,6 +272,74 @@ func TestMultiClusterGetServiceDetails(t *testing.T) {
 	assert.Equal(s.Service.Name, "ratings-west-cluster")
 }
 
+func TestGetServiceDetailsRoutes(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	config.Set(conf)
+
+	k8s := kubetest.NewFakeK8sClient(
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+		&core_v1.Service{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"},
+			Spec:       core_v1.ServiceSpec{Selector: map[string]string{"app": "ratings"}},
+		},
+		&core_v1.Pod{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:        "ratings-v1",
+				Namespace:   "bookinfo",
+				Labels:      map[string]string{"app": "ratings"},
+				Annotations: map[string]string{conf.ExternalServices.Istio.IstioSidecarAnnotation: `{"containers":["istio-proxy"]}`},
+			},
+		},
+		&osroutes_v1.Route{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"},
+			Spec: osroutes_v1.RouteSpec{
+				Host: "ratings.apps.example.com",
+				TLS:  &osroutes_v1.TLSConfig{Termination: osroutes_v1.TLSTerminationEdge},
+				To:   osroutes_v1.RouteTargetReference{Kind: "Service", Name: "ratings"},
+			},
+		},
+		&osroutes_v1.Route{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "details", Namespace: "bookinfo"},
+			Spec: osroutes_v1.RouteSpec{
+				Host: "details.apps.example.com",
+				To:   osroutes_v1.RouteTargetReference{Kind: "Service", Name: "details"},
+			},
+		},
+	)
+	k8s.OpenShift = true
+	SetupBusinessLayer(t, k8s, *conf)
+	clients := map[string]kubernetes.ClientInterface{
+		conf.KubernetesConfig.ClusterName: k8s,
+	}
+
+	prom, err := prometheus.NewClient()
+	require.NoError(err)
+
+	promMock := new(prometheustest.PromAPIMock)
+	promMock.SpyArgumentsAndReturnEmpty(func(mock.Arguments) {})
+	prom.Inject(promMock)
+	svc := NewWithBackends(clients, clients, prom, nil).Svc
+
+	s, err := svc.GetServiceDetails(context.TODO(), conf.KubernetesConfig.ClusterName, "bookinfo", "ratings", "60s", time.Now())
+	require.NoError(err)
+	require.Len(s.Routes, 1)
+	assert.Equal("ratings", s.Routes[0].Name)
+	assert.Equal("edge", s.Routes[0].TLSTermination)
+	assert.Equal("https://ratings.apps.example.com", s.Routes[0].URL)
+
+	validations, err := svc.getServiceRouteValidations(context.TODO(), conf.KubernetesConfig.ClusterName, "bookinfo", "ratings")
+	require.NoError(err)
+	require.Len(validations, 1)
+	for _, validation := range validations {
+		require.Len(validation.Checks, 1)
+		assert.Equal("KIA0704", validation.Checks[0].Code)
+	}
+}
+
 func TestMultiClusterGetServiceAppName(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
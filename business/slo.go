@@ -0,0 +1,219 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+)
+
+const (
+	defaultSLOLatencyQuantile = "0.99"
+	defaultSLOWindow          = "1h"
+)
+
+// SLOService evaluates the service level objectives defined for services,
+// either through the slo.kiali.io annotations or the SLO objectives in the config.
+type SLOService struct {
+	businessLayer *Layer
+	conf          *config.Config
+	kialiCache    cache.KialiCache
+	prom          prometheus.ClientInterface
+}
+
+// NewSLOService creates a new SLOService.
+func NewSLOService(businessLayer *Layer, conf *config.Config, kialiCache cache.KialiCache, prom prometheus.ClientInterface) SLOService {
+	return SLOService{
+		businessLayer: businessLayer,
+		conf:          conf,
+		kialiCache:    kialiCache,
+		prom:          prom,
+	}
+}
+
+// GetDefinition returns the SLO definition of a service. Annotations take precedence over the config objectives.
+// Returns nil if the service has no SLO.
+func (in *SLOService) GetDefinition(namespace, service string, annotations map[string]string) *models.SLODefinition {
+	def := models.ParseSLOAnnotations(annotations, defaultSLOWindow)
+	if def == nil {
+		for _, objective := range in.conf.SLO.Objectives {
+			if !objective.Matches(namespace, service) {
+				continue
+			}
+			def = &models.SLODefinition{
+				AvailabilityTarget: objective.AvailabilityTarget,
+				LatencyQuantile:    objective.LatencyQuantile,
+				LatencyThreshold:   objective.LatencyThreshold,
+				Source:             models.SLOSourceConfig,
+				Window:             objective.Window,
+			}
+			break
+		}
+	}
+	if def == nil {
+		return nil
+	}
+
+	if def.Window == "" {
+		def.Window = defaultSLOWindow
+	}
+	if _, err := model.ParseDuration(def.Window); err != nil {
+		log.Debugf("Invalid SLO window [%s] for service [%s.%s]. Using default [%s]", def.Window, service, namespace, defaultSLOWindow)
+		def.Window = defaultSLOWindow
+	}
+	if def.LatencyQuantile != "" {
+		quantile, ok := models.ParseSLOQuantile(def.LatencyQuantile)
+		if !ok {
+			log.Debugf("Invalid SLO latency quantile [%s] for service [%s.%s]. Using default [%s]", def.LatencyQuantile, service, namespace, defaultSLOLatencyQuantile)
+		}
+		def.LatencyQuantile = quantile
+	}
+	if def.LatencyThreshold > 0 && def.LatencyQuantile == "" {
+		def.LatencyQuantile = defaultSLOLatencyQuantile
+	}
+	return def
+}
+
+// Evaluate computes the SLO status of a service from its Prometheus telemetry at the given query time.
+func (in *SLOService) Evaluate(cluster, namespace, service string, def models.SLODefinition, queryTime time.Time) (*models.SLOStatus, error) {
+	status := &models.SLOStatus{
+		Cluster:     cluster,
+		Definition:  def,
+		EvaluatedAt: queryTime,
+		Namespace:   namespace,
+		Service:     service,
+	}
+
+	rates, err := in.prom.GetServiceRequestRates(namespace, cluster, service, def.Window, queryTime)
+	if err != nil {
+		return nil, errors.NewServiceUnavailable(err.Error())
+	}
+	rqHealth := models.NewEmptyRequestHealth()
+	for _, sample := range rates {
+		rqHealth.AggregateInbound(sample)
+	}
+	rqHealth.CombineReporters()
+
+	total, errorRatio := rqHealth.ErrorRatio()
+	status.HasTraffic = total > 0
+	status.Availability = (1 - errorRatio) * 100
+	// The target is validated to be lower than 100 so the allowed error ratio is never 0.
+	allowedErrorRatio := 1 - def.AvailabilityTarget/100
+	status.BurnRate = errorRatio / allowedErrorRatio
+	status.ErrorBudgetRemaining = 1 - status.BurnRate
+
+	latencyCompliant := true
+	if def.LatencyThreshold > 0 && status.HasTraffic {
+		labels := fmt.Sprintf(`{reporter="destination",destination_service_name="%s",destination_service_namespace="%s",destination_cluster="%s"}`, service, namespace, cluster)
+		histogram, err := in.prom.FetchHistogramValues("istio_request_duration_milliseconds", labels, "", def.Window, false, []string{def.LatencyQuantile}, queryTime)
+		if err != nil {
+			return nil, err
+		}
+		if vector, ok := histogram[def.LatencyQuantile]; ok && len(vector) > 0 {
+			latency := float64(vector[0].Value)
+			if !math.IsNaN(latency) && !math.IsInf(latency, 0) {
+				status.Latency = latency
+				latencyCompliant = latency <= def.LatencyThreshold
+			}
+		}
+	}
+
+	status.Compliant = status.Availability >= def.AvailabilityTarget && latencyCompliant
+	return status, nil
+}
+
+// EvaluateAll evaluates the SLO of every service with a definition in the given clusters and namespaces.
+// It is meant to be called by the background evaluator with the Kiali SA clients.
+func (in *SLOService) EvaluateAll(ctx context.Context, clusters []string, namespaces NamespaceFilter) (map[models.SLOKey]*models.SLOStatus, error) {
+	queryTime := time.Now()
+	statuses := make(map[models.SLOKey]*models.SLOStatus)
+	for _, cluster := range clusters {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+		if err != nil {
+			return nil, err
+		}
+		services, err := kubeCache.GetServices(metav1.NamespaceAll, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, svc := range services {
+			if !namespaces.Includes(cluster, svc.Namespace) {
+				continue
+			}
+			def := in.GetDefinition(svc.Namespace, svc.Name, svc.Annotations)
+			if def == nil {
+				continue
+			}
+			status, err := in.Evaluate(cluster, svc.Namespace, svc.Name, *def, queryTime)
+			if err != nil {
+				log.Debugf("Unable to evaluate SLO for service [%s.%s] in cluster [%s]: %s", svc.Name, svc.Namespace, cluster, err)
+				continue
+			}
+			statuses[models.SLOKey{Cluster: cluster, Namespace: svc.Namespace, Service: svc.Name}] = status
+		}
+	}
+	return statuses, nil
+}
+
+// GetServiceSLOStatus returns the last SLO evaluation of a service. When the service has not been evaluated
+// yet by the background evaluator, the status is computed on demand.
+// Returns a NotFound error when the service has no SLO definition.
+func (in *SLOService) GetServiceSLOStatus(ctx context.Context, cluster, namespace, service string) (*models.SLOStatus, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	if status, found := in.kialiCache.SLOStatuses().Get(models.SLOKey{Cluster: cluster, Namespace: namespace, Service: service}); found {
+		return status, nil
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := kubeCache.GetService(namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	def := in.GetDefinition(namespace, service, svc.Annotations)
+	if def == nil {
+		return nil, kubernetes.NewNotFound(service, "Kiali", "SLO")
+	}
+	return in.Evaluate(cluster, namespace, service, *def, time.Now())
+}
+
+// GetNamespaceSLOStatuses returns the last SLO evaluation of every service of the namespace, sorted by service name.
+func (in *SLOService) GetNamespaceSLOStatuses(ctx context.Context, cluster, namespace string) (models.SLOStatuses, error) {
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	statuses := models.SLOStatuses{}
+	for key, status := range in.kialiCache.SLOStatuses().Items() {
+		if key.Cluster == cluster && key.Namespace == namespace {
+			statuses = append(statuses, status)
+		}
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Service < statuses[j].Service
+	})
+	return statuses, nil
+}
@@ -0,0 +1,140 @@
+package business
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestSLODefinition(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.SLO.Objectives = []config.SLOObjective{
+		{Namespace: "bookinfo", Name: "^reviews$", AvailabilityTarget: 99, LatencyThreshold: 200, Window: "30d"},
+		{Namespace: "^tutorial$", AvailabilityTarget: 95, Window: "not-a-duration"},
+	}
+	config.Set(conf)
+	sloService := NewSLOService(nil, conf, nil, nil)
+
+	// Annotations take precedence over the config
+	def := sloService.GetDefinition("bookinfo", "reviews", map[string]string{
+		string(models.SLOAvailabilityAnnotation): "99.9",
+		string(models.SLOLatencyAnnotation):      "500",
+	})
+	require.NotNil(def)
+	require.Equal(models.SLOSourceAnnotation, def.Source)
+	require.Equal(99.9, def.AvailabilityTarget)
+	require.Equal(float64(500), def.LatencyThreshold)
+	require.Equal(defaultSLOLatencyQuantile, def.LatencyQuantile)
+	require.Equal(defaultSLOWindow, def.Window)
+
+	def = sloService.GetDefinition("bookinfo", "reviews", nil)
+	require.NotNil(def)
+	require.Equal(models.SLOSourceConfig, def.Source)
+	require.Equal(float64(99), def.AvailabilityTarget)
+	require.Equal("30d", def.Window)
+
+	// Invalid windows fall back to the default one
+	def = sloService.GetDefinition("tutorial", "httpbin", nil)
+	require.NotNil(def)
+	require.Equal(float64(95), def.AvailabilityTarget)
+	require.Equal(defaultSLOWindow, def.Window)
+	require.Empty(def.LatencyQuantile)
+
+	// The quantile is a number between 0 and 1, nothing else goes into the queries
+	def = sloService.GetDefinition("default", "httpbin", map[string]string{
+		string(models.SLOAvailabilityAnnotation):    "99",
+		string(models.SLOLatencyAnnotation):         "300",
+		string(models.SLOLatencyQuantileAnnotation): " 0.95 ",
+	})
+	require.Equal("0.95", def.LatencyQuantile)
+	for _, quantile := range []string{"1.5", "0", "0.9, sum(up)", "NaN"} {
+		def = sloService.GetDefinition("default", "httpbin", map[string]string{
+			string(models.SLOAvailabilityAnnotation):    "99",
+			string(models.SLOLatencyAnnotation):         "300",
+			string(models.SLOLatencyQuantileAnnotation): quantile,
+		})
+		require.Equal(defaultSLOLatencyQuantile, def.LatencyQuantile, quantile)
+	}
+
+	require.Nil(sloService.GetDefinition("bookinfo", "ratings", nil))
+	// Invalid annotations are ignored
+	require.Nil(sloService.GetDefinition("default", "httpbin", map[string]string{string(models.SLOAvailabilityAnnotation): "100"}))
+}
+
+func TestEvaluateSLO(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	queryTime := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	prom := new(prometheustest.PromClientMock)
+	prom.MockServiceRequestRates("bookinfo", "east", "reviews", model.Vector{
+		sloSample("http", "200", 99),
+		sloSample("http", "503", 1),
+	})
+	prom.On("FetchHistogramValues", "istio_request_duration_milliseconds", mock.AnythingOfType("string"), "", "1h", false, []string{"0.99"}, queryTime).
+		Return(map[string]model.Vector{"0.99": {&model.Sample{Value: 150}}}, nil)
+
+	sloService := NewSLOService(nil, conf, nil, prom)
+	def := models.SLODefinition{AvailabilityTarget: 99.5, LatencyQuantile: "0.99", LatencyThreshold: 200, Window: "1h"}
+
+	status, err := sloService.Evaluate("east", "bookinfo", "reviews", def, queryTime)
+	require.NoError(err)
+	require.True(status.HasTraffic)
+	require.InDelta(99, status.Availability, 0.0001)
+	require.InDelta(2, status.BurnRate, 0.0001)
+	require.InDelta(-1, status.ErrorBudgetRemaining, 0.0001)
+	require.Equal(float64(150), status.Latency)
+	require.False(status.Compliant)
+
+	def.AvailabilityTarget = 98
+	status, err = sloService.Evaluate("east", "bookinfo", "reviews", def, queryTime)
+	require.NoError(err)
+	require.InDelta(0.5, status.BurnRate, 0.0001)
+	require.True(status.Compliant)
+
+	// Latency over the threshold breaks the SLO
+	def.LatencyThreshold = 100
+	status, err = sloService.Evaluate("east", "bookinfo", "reviews", def, queryTime)
+	require.NoError(err)
+	require.False(status.Compliant)
+}
+
+func TestEvaluateSLOWithoutTraffic(t *testing.T) {
+	require := require.New(t)
+
+	prom := new(prometheustest.PromClientMock)
+	prom.MockServiceRequestRates("bookinfo", "east", "reviews", model.Vector{})
+
+	sloService := NewSLOService(nil, config.NewConfig(), nil, prom)
+	def := models.SLODefinition{AvailabilityTarget: 99, LatencyQuantile: "0.99", LatencyThreshold: 200, Window: "1h"}
+
+	status, err := sloService.Evaluate("east", "bookinfo", "reviews", def, time.Now())
+	require.NoError(err)
+	require.False(status.HasTraffic)
+	require.Equal(float64(100), status.Availability)
+	require.Equal(float64(1), status.ErrorBudgetRemaining)
+	require.True(status.Compliant)
+	prom.AssertNumberOfCalls(t, "FetchHistogramValues", 0)
+}
+
+func sloSample(protocol, code string, value float64) *model.Sample {
+	return &model.Sample{
+		Metric: model.Metric{
+			"destination_service_name": "reviews",
+			"reporter":                 "destination",
+			"request_protocol":         model.LabelValue(protocol),
+			"response_code":            model.LabelValue(code),
+		},
+		Value: model.SampleValue(value),
+	}
+}
@@ -0,0 +1,392 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	api_errors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/models"
+)
+
+// testRequestMethods are the methods of the test requests.
+var testRequestMethods = map[string]bool{"DELETE": true, "GET": true, "HEAD": true, "OPTIONS": true, "PATCH": true, "POST": true, "PUT": true}
+
+// testRequestHeaderName matches the valid names of the headers of a test request.
+var testRequestHeaderName = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+
+// testRequestWriteOut is the curl write-out printed after the headers of the response: the status and the time taken.
+const testRequestWriteOut = "\n%{http_code} %{time_total}"
+
+// SendTestRequest sends an HTTP request to the service from a test pod of the mesh, with curl, and returns the response
+// with the route of the VirtualServices matched by the request. The test pod is a running pod matching the pod
+// selector of the test requests config: the request goes through its proxy, like the requests of the workloads.
+// The route is matched from the VirtualServices of the namespaces of the service and of the test pod.
+func (in *SvcService) SendTestRequest(ctx context.Context, cluster, namespace, service string, request models.TestRequest) (*models.TestRequestResult, error) {
+	if request.Method == "" {
+		request.Method = "GET"
+	}
+	request.Method = strings.ToUpper(request.Method)
+	if !testRequestMethods[request.Method] {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("invalid method [%s]", request.Method))
+	}
+	if request.Path == "" {
+		request.Path = "/"
+	}
+	if !strings.HasPrefix(request.Path, "/") || strings.ContainsAny(request.Path, " \t\r\n") {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("invalid path [%s]: it must start with / and have no whitespace", request.Path))
+	}
+	for name, value := range request.Headers {
+		if !testRequestHeaderName.MatchString(name) || strings.ContainsAny(value, "\r\n") {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("invalid header [%s]", name))
+		}
+	}
+
+	// Checks the user access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := kubeCache.GetService(namespace, service)
+	if err != nil {
+		return nil, err
+	}
+	if request.Port == 0 {
+		if len(svc.Spec.Ports) == 0 {
+			return nil, api_errors.NewBadRequest(fmt.Sprintf("service [%s] has no port", service))
+		}
+		request.Port = int(svc.Spec.Ports[0].Port)
+	} else if !serviceHasPort(svc, request.Port) {
+		return nil, api_errors.NewBadRequest(fmt.Sprintf("service [%s] has no port [%d]", service, request.Port))
+	}
+
+	pod, err := in.getTestPod(ctx, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+	client, ok := in.userClients[cluster]
+	if !ok {
+		return nil, fmt.Errorf("client for cluster [%s] not found", cluster)
+	}
+
+	conf := in.config.TestRequests
+	authority := fmt.Sprintf("%s.%s.%s:%d", service, namespace, in.config.ExternalServices.Istio.IstioIdentityDomain, request.Port)
+	result := &models.TestRequestResult{
+		SourcePod: pod.Namespace + "/" + pod.Name,
+		URL:       "http://" + authority + request.Path,
+	}
+
+	// The path is sent as is: with globbing, a path like /[1-1000] would make curl send a request per match
+	command := []string{"curl", "-sS", "--globoff", "-o", "/dev/null", "-D", "-", "-w", testRequestWriteOut, "--max-time", strconv.Itoa(conf.TimeoutSeconds), "-X", request.Method}
+	names := make([]string, 0, len(request.Headers))
+	for name := range request.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		command = append(command, "-H", name+": "+request.Headers[name])
+	}
+	command = append(command, result.URL)
+
+	// The exec outlives the curl timeout by the time needed to start and stop the command
+	execCtx, cancel := context.WithTimeout(ctx, time.Duration(conf.TimeoutSeconds+5)*time.Second)
+	defer cancel()
+	stdout, stderr, execErr := client.ExecPod(execCtx, pod.Namespace, pod.Name, conf.Container, command)
+	// curl prints the write-out even when the request fails, without it curl did not run
+	if !parseTestRequestOutput(string(stdout), result) {
+		if execErr != nil {
+			return nil, fmt.Errorf("unable to run curl in the test pod [%s]: %s %s", result.SourcePod, execErr, strings.TrimSpace(string(stderr)))
+		}
+		return nil, fmt.Errorf("unable to parse the output of curl in the test pod [%s]", result.SourcePod)
+	}
+	if result.Status == 0 {
+		result.Error = strings.TrimSpace(string(stderr))
+		if result.Error == "" && execErr != nil {
+			result.Error = execErr.Error()
+		}
+	}
+
+	vss, err := getTestRequestVirtualServices(ctx, kubeCache, namespace, pod.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]string{"host": authority, "user-agent": "curl", "accept": "*/*"}
+	for name, value := range request.Headers {
+		headers[strings.ToLower(name)] = value
+	}
+	result.MatchedRoute = matchTestRequestRoute(vss, pod, namespace, service, request, authority, headers)
+	return result, nil
+}
+
+func serviceHasPort(svc *core_v1.Service, port int) bool {
+	for _, p := range svc.Spec.Ports {
+		if int(p.Port) == port {
+			return true
+		}
+	}
+	return false
+}
+
+// getTestPod returns the first running test pod, by name, in the namespace of the test requests config or in the
+// namespace of the service.
+func (in *SvcService) getTestPod(ctx context.Context, cluster, namespace string) (*core_v1.Pod, error) {
+	conf := in.config.TestRequests
+	podNamespace := namespace
+	if conf.Namespace != "" {
+		podNamespace = conf.Namespace
+		// Checks the user access to the namespace of the test pods
+		if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, podNamespace, cluster); err != nil {
+			return nil, err
+		}
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := kubeCache.GetPods(podNamespace, conf.PodSelector)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	for i := range pods {
+		if pods[i].Status.Phase == core_v1.PodRunning && pods[i].DeletionTimestamp == nil {
+			return &pods[i], nil
+		}
+	}
+	return nil, api_errors.NewBadRequest(fmt.Sprintf("no running test pod [%s] in namespace [%s]", conf.PodSelector, podNamespace))
+}
+
+// parseTestRequestOutput parses the headers of the response and the write-out printed by curl. It returns false when
+// the write-out is missing.
+func parseTestRequestOutput(output string, result *models.TestRequestResult) bool {
+	i := strings.LastIndex(output, "\n")
+	if i < 0 {
+		return false
+	}
+	fields := strings.Fields(output[i+1:])
+	if len(fields) != 2 {
+		return false
+	}
+	status, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return false
+	}
+	seconds, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return false
+	}
+	result.Status = status
+	result.Latency = seconds * 1000
+
+	for _, line := range strings.Split(output[:i], "\n") {
+		line = strings.TrimRight(line, "\r")
+		// Only the headers of the final response are kept, i.e. not those of a 100 Continue
+		if strings.HasPrefix(line, "HTTP/") {
+			result.ResponseHeaders = map[string]string{}
+			continue
+		}
+		if name, value, found := strings.Cut(line, ":"); found && result.ResponseHeaders != nil {
+			result.ResponseHeaders[strings.ToLower(name)] = strings.TrimSpace(value)
+		}
+	}
+	return true
+}
+
+// getTestRequestVirtualServices returns the VirtualServices of the namespaces of the service and of the test pod, in
+// the order they are merged by Istio: the oldest first.
+func getTestRequestVirtualServices(ctx context.Context, kubeCache cache.KubeCache, namespaces ...string) ([]*networking_v1.VirtualService, error) {
+	vss := []*networking_v1.VirtualService{}
+	seen := map[string]bool{}
+	for _, namespace := range namespaces {
+		if seen[namespace] {
+			continue
+		}
+		seen[namespace] = true
+		nsVss, err := kubeCache.GetVirtualServices(ctx, namespace, "")
+		if err != nil {
+			return nil, err
+		}
+		vss = append(vss, nsVss...)
+	}
+	sort.SliceStable(vss, func(i, j int) bool {
+		if !vss[i].CreationTimestamp.Equal(&vss[j].CreationTimestamp) {
+			return vss[i].CreationTimestamp.Before(&vss[j].CreationTimestamp)
+		}
+		return vss[i].Namespace+"/"+vss[i].Name < vss[j].Namespace+"/"+vss[j].Name
+	})
+	return vss, nil
+}
+
+// matchTestRequestRoute returns the first HTTP route of the VirtualServices of the service, applied to the sidecars,
+// matched by the request sent from the pod. Delegate VirtualServices are not followed.
+func matchTestRequestRoute(vss []*networking_v1.VirtualService, pod *core_v1.Pod, namespace, service string, request models.TestRequest, authority string, headers map[string]string) *models.TestRequestRoute {
+	path, rawQuery, _ := strings.Cut(request.Path, "?")
+	query, _ := url.ParseQuery(rawQuery)
+
+	for _, vs := range vss {
+		if !virtualServiceAppliesToMesh(vs.Spec.Gateways) || !virtualServiceHasHost(vs, namespace, service) {
+			continue
+		}
+		for i, route := range vs.Spec.Http {
+			if route == nil || !matchesAnyHTTPRequest(route.Match, pod, request, path, query, authority, headers) {
+				continue
+			}
+			matched := &models.TestRequestRoute{
+				VirtualService: vs.Namespace + "/" + vs.Name,
+				Route:          route.Name,
+				Destinations:   []models.TestRequestDestination{},
+			}
+			if matched.Route == "" {
+				matched.Route = strconv.Itoa(i)
+			}
+			for _, destination := range route.Route {
+				if destination == nil || destination.Destination == nil {
+					continue
+				}
+				d := models.TestRequestDestination{
+					Host:   destination.Destination.Host,
+					Subset: destination.Destination.Subset,
+					Weight: destination.Weight,
+				}
+				if destination.Destination.Port != nil {
+					d.Port = destination.Destination.Port.Number
+				}
+				// A single destination gets all the traffic
+				if len(route.Route) == 1 && d.Weight == 0 {
+					d.Weight = 100
+				}
+				matched.Destinations = append(matched.Destinations, d)
+			}
+			return matched
+		}
+	}
+	return nil
+}
+
+func virtualServiceAppliesToMesh(gateways []string) bool {
+	if len(gateways) == 0 {
+		return true
+	}
+	for _, gateway := range gateways {
+		if gateway == "mesh" {
+			return true
+		}
+	}
+	return false
+}
+
+func virtualServiceHasHost(vs *networking_v1.VirtualService, namespace, service string) bool {
+	for _, host := range vs.Spec.Hosts {
+		if kubernetes.FilterByHost(host, vs.Namespace, service, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyHTTPRequest returns whether the request matches one of the conditions of a route, a route without
+// condition matches any request.
+func matchesAnyHTTPRequest(matches []*api_networking_v1.HTTPMatchRequest, pod *core_v1.Pod, request models.TestRequest, path string, query url.Values, authority string, headers map[string]string) bool {
+	if len(matches) == 0 {
+		return true
+	}
+	for _, match := range matches {
+		if match != nil && matchesHTTPRequest(match, pod, request, path, query, authority, headers) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesHTTPRequest(match *api_networking_v1.HTTPMatchRequest, pod *core_v1.Pod, request models.TestRequest, path string, query url.Values, authority string, headers map[string]string) bool {
+	if len(match.Gateways) > 0 && !virtualServiceAppliesToMesh(match.Gateways) {
+		return false
+	}
+	if match.Port != 0 && int(match.Port) != request.Port {
+		return false
+	}
+	if match.SourceNamespace != "" && match.SourceNamespace != pod.Namespace {
+		return false
+	}
+	for name, value := range match.SourceLabels {
+		if pod.Labels[name] != value {
+			return false
+		}
+	}
+	if match.Uri != nil {
+		uri := path
+		if match.IgnoreUriCase {
+			uri = strings.ToLower(uri)
+		}
+		if !matchesString(match.Uri, uri, match.IgnoreUriCase) {
+			return false
+		}
+	}
+	if match.Scheme != nil && !matchesString(match.Scheme, "http", false) {
+		return false
+	}
+	if match.Method != nil && !matchesString(match.Method, request.Method, false) {
+		return false
+	}
+	if match.Authority != nil && !matchesString(match.Authority, authority, false) {
+		return false
+	}
+	for name, stringMatch := range match.Headers {
+		value, found := headers[strings.ToLower(name)]
+		if !found || !matchesString(stringMatch, value, false) {
+			return false
+		}
+	}
+	for name, stringMatch := range match.WithoutHeaders {
+		if value, found := headers[strings.ToLower(name)]; found && matchesString(stringMatch, value, false) {
+			return false
+		}
+	}
+	for name, stringMatch := range match.QueryParams {
+		if !query.Has(name) || !matchesString(stringMatch, query.Get(name), false) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesString returns whether the value matches the exact, prefix or regex condition. A condition without a match
+// type only checks the presence of the value. Regexes must match the whole value, like in Envoy.
+func matchesString(stringMatch *api_networking_v1.StringMatch, value string, lowerCase bool) bool {
+	switch m := stringMatch.MatchType.(type) {
+	case *api_networking_v1.StringMatch_Exact:
+		expected := m.Exact
+		if lowerCase {
+			expected = strings.ToLower(expected)
+		}
+		return value == expected
+	case *api_networking_v1.StringMatch_Prefix:
+		prefix := m.Prefix
+		if lowerCase {
+			prefix = strings.ToLower(prefix)
+		}
+		return strings.HasPrefix(value, prefix)
+	case *api_networking_v1.StringMatch_Regex:
+		re, err := regexp.Compile("^(?:" + m.Regex + ")$")
+		return err == nil && re.MatchString(value)
+	default:
+		return true
+	}
+}
@@ -0,0 +1,140 @@
+package business
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func setupTestRequests(t *testing.T) (*Layer, *kubetest.FakeK8sClient) {
+	conf := config.NewConfig()
+	conf.TestRequests.Enabled = true
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	config.Set(conf)
+
+	reviews := kubetest.FakeService("bookinfo", "reviews")
+	curl := &core_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "curl-abcde", Namespace: "bookinfo", Labels: map[string]string{"app": "curl"}},
+		Status:     core_v1.PodStatus{Phase: core_v1.PodRunning},
+	}
+	vs := data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"})
+	vs.Spec.Http = []*api_networking_v1.HTTPRoute{
+		{
+			Name: "jason",
+			Match: []*api_networking_v1.HTTPMatchRequest{{
+				Headers: map[string]*api_networking_v1.StringMatch{
+					"end-user": {MatchType: &api_networking_v1.StringMatch_Exact{Exact: "jason"}},
+				},
+			}},
+			Route: []*api_networking_v1.HTTPRouteDestination{data.CreateHttpRouteDestination("reviews", "v2", 0)},
+		},
+		{
+			Match: []*api_networking_v1.HTTPMatchRequest{{
+				Uri:         &api_networking_v1.StringMatch{MatchType: &api_networking_v1.StringMatch_Regex{Regex: "/ratings/[0-9]+"}},
+				QueryParams: map[string]*api_networking_v1.StringMatch{"debug": {MatchType: &api_networking_v1.StringMatch_Exact{Exact: "true"}}},
+			}},
+			Route: []*api_networking_v1.HTTPRouteDestination{data.CreateHttpRouteDestination("reviews", "v3", 0)},
+		},
+		{
+			Route: []*api_networking_v1.HTTPRouteDestination{
+				data.CreateHttpRouteDestination("reviews", "v1", 80),
+				data.CreateHttpRouteDestination("reviews", "v3", 20),
+			},
+		},
+	}
+
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace("bookinfo"), &reviews, curl, vs)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	return NewWithBackends(k8sclients, k8sclients, nil, nil), k8s
+}
+
+func TestSendTestRequest(t *testing.T) {
+	require := require.New(t)
+
+	layer, k8s := setupTestRequests(t)
+	cluster := config.Get().KubernetesConfig.ClusterName
+
+	var command []string
+	k8s.ExecFunc = func(namespace, name, container string, cmd []string) ([]byte, []byte, error) {
+		require.Equal("bookinfo", namespace)
+		require.Equal("curl-abcde", name)
+		require.Equal("curl", container)
+		command = cmd
+		return []byte("HTTP/1.1 200 OK\r\nx-envoy-upstream-service-time: 5\r\n\r\n\n200 0.012500"), nil, nil
+	}
+
+	result, err := layer.Svc.SendTestRequest(context.TODO(), cluster, "bookinfo", "reviews", models.TestRequest{
+		Path:    "/reviews/1",
+		Headers: map[string]string{"End-User": "jason"},
+	})
+	require.NoError(err)
+	require.Equal("bookinfo/curl-abcde", result.SourcePod)
+	require.Equal("http://reviews.bookinfo.svc.cluster.local:3001/reviews/1", result.URL)
+	require.Equal(200, result.Status)
+	require.InDelta(12.5, result.Latency, 0.001)
+	require.Equal("5", result.ResponseHeaders["x-envoy-upstream-service-time"])
+	require.Contains(command, "End-User: jason")
+	require.Equal(result.URL, command[len(command)-1])
+
+	require.NotNil(result.MatchedRoute)
+	require.Equal("bookinfo/reviews", result.MatchedRoute.VirtualService)
+	require.Equal("jason", result.MatchedRoute.Route)
+	require.Equal([]models.TestRequestDestination{{Host: "reviews", Subset: "v2", Weight: 100}}, result.MatchedRoute.Destinations)
+
+	result, err = layer.Svc.SendTestRequest(context.TODO(), cluster, "bookinfo", "reviews", models.TestRequest{Path: "/ratings/1?debug=true"})
+	require.NoError(err)
+	require.Equal("1", result.MatchedRoute.Route)
+
+	result, err = layer.Svc.SendTestRequest(context.TODO(), cluster, "bookinfo", "reviews", models.TestRequest{Path: "/ratings/1"})
+	require.NoError(err)
+	require.Equal("2", result.MatchedRoute.Route)
+	require.Len(result.MatchedRoute.Destinations, 2)
+
+	// The URL globbing of curl is off, so a range or a set sends a single request
+	result, err = layer.Svc.SendTestRequest(context.TODO(), cluster, "bookinfo", "reviews", models.TestRequest{Path: "/reviews/[1-1000]/{a,b,c}"})
+	require.NoError(err)
+	require.Contains(command, "--globoff")
+	require.Equal("http://reviews.bookinfo.svc.cluster.local:3001/reviews/[1-1000]/{a,b,c}", command[len(command)-1])
+	require.Equal(result.URL, command[len(command)-1])
+
+	_, err = layer.Svc.SendTestRequest(context.TODO(), cluster, "bookinfo", "reviews", models.TestRequest{Method: "CONNECT"})
+	require.Error(err)
+	_, err = layer.Svc.SendTestRequest(context.TODO(), cluster, "bookinfo", "reviews", models.TestRequest{Port: 8080})
+	require.Error(err)
+}
+
+func TestSendTestRequestFailure(t *testing.T) {
+	require := require.New(t)
+
+	layer, k8s := setupTestRequests(t)
+	cluster := config.Get().KubernetesConfig.ClusterName
+
+	// curl exits with an error, after printing the write-out
+	k8s.ExecFunc = func(namespace, name, container string, cmd []string) ([]byte, []byte, error) {
+		return []byte("\n000 10.001"), []byte("curl: (28) Operation timed out\n"), errors.New("command terminated with exit code 28")
+	}
+	result, err := layer.Svc.SendTestRequest(context.TODO(), cluster, "bookinfo", "reviews", models.TestRequest{})
+	require.NoError(err)
+	require.Equal(0, result.Status)
+	require.Equal("curl: (28) Operation timed out", result.Error)
+
+	// curl can't run in the test pod
+	k8s.ExecFunc = func(namespace, name, container string, cmd []string) ([]byte, []byte, error) {
+		return nil, nil, errors.New("container not found")
+	}
+	_, err = layer.Svc.SendTestRequest(context.TODO(), cluster, "bookinfo", "reviews", models.TestRequest{})
+	require.Error(err)
+}
@@ -31,6 +31,30 @@ const (
 	MTLSDisabled         = "MTLS_DISABLED"
 )
 
+// Effective mTLS modes
+const (
+	MTLSModeEnabled          = "ENABLED"
+	MTLSModePartiallyEnabled = "PARTIALLY_ENABLED"
+	MTLSModeDisabled         = "DISABLED"
+	MTLSModeAuto             = "AUTO"
+)
+
+// effectiveMTLSMode returns the mTLS mode applied with the given status. When no policy enables or disables mTLS,
+// the traffic between sidecars still uses mTLS if auto mTLS is enabled.
+func effectiveMTLSMode(status string, autoMTLSEnabled bool) string {
+	switch status {
+	case MTLSEnabled:
+		return MTLSModeEnabled
+	case MTLSPartiallyEnabled:
+		return MTLSModePartiallyEnabled
+	case MTLSNotEnabled:
+		if autoMTLSEnabled {
+			return MTLSModeAuto
+		}
+	}
+	return MTLSModeDisabled
+}
+
 func (in *TLSService) MeshWidemTLSStatus(ctx context.Context, cluster string, revision string) (models.MTLSStatus, error) {
 	var end observability.EndFunc
 	ctx, end = observability.StartSpan(ctx, "MeshWidemTLSStatus",
@@ -95,8 +119,10 @@ func (in *TLSService) MeshWidemTLSStatus(ctx context.Context, cluster string, re
 		minTLS = "N/A"
 	}
 
+	status := mtlsStatus.MeshMtlsStatus().OverallStatus
 	return models.MTLSStatus{
-		Status:          mtlsStatus.MeshMtlsStatus().OverallStatus,
+		Status:          status,
+		Mode:            effectiveMTLSMode(status, mtlsStatus.AutoMtlsEnabled),
 		AutoMTLSEnabled: mtlsStatus.AutoMtlsEnabled,
 		MinTLS:          minTLS,
 	}, nil
@@ -144,8 +170,10 @@ func (in *TLSService) NamespaceWidemTLSStatus(ctx context.Context, namespace, cl
 		AllowPermissive:     false,
 	}
 
+	status := mtlsStatus.NamespaceMtlsStatus(namespace).OverallStatus
 	return models.MTLSStatus{
-		Status:          mtlsStatus.NamespaceMtlsStatus(namespace).OverallStatus,
+		Status:          status,
+		Mode:            effectiveMTLSMode(status, mtlsStatus.AutoMtlsEnabled),
 		AutoMTLSEnabled: mtlsStatus.AutoMtlsEnabled,
 		Cluster:         cluster,
 		Namespace:       namespace,
@@ -185,8 +213,10 @@ func (in *TLSService) ClusterWideNSmTLSStatus(ctx context.Context, namespaces []
 			AllowPermissive:     false,
 		}
 
+		status := mtlsStatus.NamespaceMtlsStatus(namespace.Name).OverallStatus
 		result = append(result, models.MTLSStatus{
-			Status:          mtlsStatus.NamespaceMtlsStatus(namespace.Name).OverallStatus,
+			Status:          status,
+			Mode:            effectiveMTLSMode(status, mtlsStatus.AutoMtlsEnabled),
 			AutoMTLSEnabled: mtlsStatus.AutoMtlsEnabled,
 			Cluster:         cluster,
 			Namespace:       namespace.Name,
@@ -196,6 +226,27 @@ func (in *TLSService) ClusterWideNSmTLSStatus(ctx context.Context, namespaces []
 	return result, nil
 }
 
+// MTLSOverview returns the mTLS status of the mesh, for the control plane of the revision, and of every namespace
+// of the cluster accessible by the user.
+func (in *TLSService) MTLSOverview(ctx context.Context, cluster, revision string) (*models.MTLSOverview, error) {
+	meshStatus, err := in.MeshWidemTLSStatus(ctx, cluster, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := in.businessLayer.Namespace.GetClusterNamespaces(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceStatuses, err := in.ClusterWideNSmTLSStatus(ctx, namespaces, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MTLSOverview{Cluster: cluster, Mesh: meshStatus, Namespaces: namespaceStatuses}, nil
+}
+
 func (in *TLSService) hasAutoMTLSEnabled(cluster string, namespace *models.Namespace) bool {
 	mesh, err := in.discovery.Mesh(context.TODO())
 	if err != nil {
@@ -293,6 +293,33 @@ func TestMeshStatusNotEnabledAutoMtls(t *testing.T) {
 
 	assert.NoError(err)
 	assert.Equal(MTLSNotEnabled, status.Status)
+	assert.Equal(MTLSModeAuto, status.Mode)
+
+	overview, err := tlsService.MTLSOverview(context.TODO(), conf.KubernetesConfig.ClusterName, "default")
+	assert.NoError(err)
+	assert.Equal(MTLSModeAuto, overview.Mesh.Mode)
+	assert.Len(overview.Namespaces, 1)
+	assert.Equal("test", overview.Namespaces[0].Namespace)
+	assert.Equal(MTLSModeAuto, overview.Namespaces[0].Mode)
+}
+
+func TestEffectiveMTLSMode(t *testing.T) {
+	cases := map[string]struct {
+		status   string
+		autoMTLS bool
+		expected string
+	}{
+		"enabled":                  {status: MTLSEnabled, expected: MTLSModeEnabled},
+		"partially enabled":        {status: MTLSPartiallyEnabled, autoMTLS: true, expected: MTLSModePartiallyEnabled},
+		"disabled":                 {status: MTLSDisabled, autoMTLS: true, expected: MTLSModeDisabled},
+		"not enabled with auto":    {status: MTLSNotEnabled, autoMTLS: true, expected: MTLSModeAuto},
+		"not enabled without auto": {status: MTLSNotEnabled, expected: MTLSModeDisabled},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, effectiveMTLSMode(tc.status, tc.autoMTLS))
+		})
+	}
 }
 
 func TestNamespaceHasMTLSEnabled(t *testing.T) {
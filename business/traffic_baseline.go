@@ -0,0 +1,189 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus"
+)
+
+// TrafficBaselineService compares the current traffic of the services with their traffic at the same time in the past
+// to detect services that stopped receiving traffic and new destinations.
+type TrafficBaselineService struct {
+	businessLayer *Layer
+	conf          *config.Config
+	kialiCache    cache.KialiCache
+	prom          prometheus.ClientInterface
+}
+
+// NewTrafficBaselineService creates a new TrafficBaselineService.
+func NewTrafficBaselineService(businessLayer *Layer, conf *config.Config, kialiCache cache.KialiCache, prom prometheus.ClientInterface) TrafficBaselineService {
+	return TrafficBaselineService{
+		businessLayer: businessLayer,
+		conf:          conf,
+		kialiCache:    kialiCache,
+		prom:          prom,
+	}
+}
+
+// Analyze returns the services of the namespace whose traffic at queryTime deviates from the baseline.
+func (in *TrafficBaselineService) Analyze(cluster, namespace string, queryTime time.Time) (models.TrafficFindings, error) {
+	baselineConf := in.conf.TrafficBaseline
+	// The offset is validated when the config is loaded.
+	offset, err := model.ParseDuration(baselineConf.BaselineOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := in.prom.GetNamespaceServicesRequestRates(namespace, cluster, baselineConf.RateInterval, queryTime)
+	if err != nil {
+		return nil, errors.NewServiceUnavailable(err.Error())
+	}
+	baseline, err := in.prom.GetNamespaceServicesRequestRates(namespace, cluster, baselineConf.RateInterval, queryTime.Add(-time.Duration(offset)))
+	if err != nil {
+		return nil, errors.NewServiceUnavailable(err.Error())
+	}
+	currentRates := serviceRequestRates(current)
+	baselineRates := serviceRequestRates(baseline)
+
+	findings := models.TrafficFindings{}
+	newFinding := func(service string, findingType models.TrafficFindingType) *models.TrafficFinding {
+		return &models.TrafficFinding{
+			BaselineRate: baselineRates[service],
+			Cluster:      cluster,
+			CurrentRate:  currentRates[service],
+			DetectedAt:   queryTime,
+			Namespace:    namespace,
+			Service:      service,
+			Type:         findingType,
+		}
+	}
+	for service, rate := range baselineRates {
+		if rate >= baselineConf.MinBaselineRate && currentRates[service] == 0 {
+			findings = append(findings, newFinding(service, models.TrafficFindingStopped))
+		}
+	}
+	for service, rate := range currentRates {
+		if rate > 0 && baselineRates[service] == 0 {
+			findings = append(findings, newFinding(service, models.TrafficFindingNewDestination))
+		}
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Service != findings[j].Service {
+			return findings[i].Service < findings[j].Service
+		}
+		return findings[i].Type < findings[j].Type
+	})
+	return findings, nil
+}
+
+// AnalyzeAll analyzes every namespace of the given clusters included by the filter.
+// It is meant to be called by the background analyzer with the Kiali SA clients.
+func (in *TrafficBaselineService) AnalyzeAll(ctx context.Context, clusters []string, filter NamespaceFilter) (map[models.TrafficFindingKey]*models.TrafficFinding, error) {
+	queryTime := time.Now()
+	findings := make(map[models.TrafficFindingKey]*models.TrafficFinding)
+	for _, cluster := range clusters {
+		namespaces, err := in.businessLayer.Namespace.GetClusterNamespaces(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ns := range namespaces {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			if !filter.Includes(cluster, ns.Name) {
+				continue
+			}
+
+			nsFindings, err := in.Analyze(cluster, ns.Name, queryTime)
+			if err != nil {
+				log.Debugf("Unable to analyze the traffic baseline of namespace [%s] in cluster [%s]: %s", ns.Name, cluster, err)
+				continue
+			}
+			for _, finding := range nsFindings {
+				findings[finding.Key()] = finding
+			}
+		}
+	}
+	return findings, nil
+}
+
+// GetNamespaceTrafficFindings returns the current traffic findings of the namespace, sorted by service.
+func (in *TrafficBaselineService) GetNamespaceTrafficFindings(ctx context.Context, cluster, namespace string) (models.TrafficFindings, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	findings := models.TrafficFindings{}
+	for key, finding := range in.kialiCache.TrafficFindings().Items() {
+		if key.Cluster == cluster && key.Namespace == namespace {
+			findings = append(findings, finding)
+		}
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].Service != findings[j].Service {
+			return findings[i].Service < findings[j].Service
+		}
+		return findings[i].Type < findings[j].Type
+	})
+	return findings, nil
+}
+
+// NotifyWebhook queues the findings to be posted to the configured webhook, with a link to their service in the Kiali
+// console. The notification is retried until delivered, see NotificationService. It does nothing when no webhook is
+// configured.
+func (in *TrafficBaselineService) NotifyWebhook(ctx context.Context, findings models.TrafficFindings) error {
+	if in.conf.TrafficBaseline.Webhook.URL == "" || len(findings) == 0 {
+		return nil
+	}
+
+	if consoleURL := kialiURL(ctx); consoleURL != "" {
+		for _, f := range findings {
+			f.URL = fmt.Sprintf("%s/console/namespaces/%s/services/%s?clusterName=%s", consoleURL, url.PathEscape(f.Namespace), url.PathEscape(f.Service), url.QueryEscape(f.Cluster))
+		}
+	}
+
+	notificationService := NewNotificationService(in.conf)
+	_, err := notificationService.Enqueue(models.NotificationSourceTrafficBaseline, findings, time.Now())
+	return err
+}
+
+// serviceRequestRates sums the request rates of each destination service. Requests can be reported by both
+// the source and the destination proxies, so the highest rate among the reporters is kept to avoid counting them twice.
+func serviceRequestRates(vector model.Vector) map[string]float64 {
+	byReporter := map[string]map[string]float64{}
+	for _, sample := range vector {
+		service := string(sample.Metric["destination_service_name"])
+		if service == "" || service == "unknown" {
+			continue
+		}
+		reporter := string(sample.Metric["reporter"])
+		if byReporter[service] == nil {
+			byReporter[service] = map[string]float64{}
+		}
+		byReporter[service][reporter] += float64(sample.Value)
+	}
+
+	rates := make(map[string]float64, len(byReporter))
+	for service, reporters := range byReporter {
+		for _, rate := range reporters {
+			if rate > rates[service] {
+				rates[service] = rate
+			}
+		}
+	}
+	return rates
+}
@@ -0,0 +1,94 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestAnalyzeTrafficBaseline(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.TrafficBaseline.BaselineOffset = "1d"
+	conf.TrafficBaseline.MinBaselineRate = 1
+	queryTime := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	prom := new(prometheustest.PromClientMock)
+	prom.On("GetNamespaceServicesRequestRates", "bookinfo", "east", "10m", queryTime).Return(model.Vector{
+		trafficSample("reviews", "source", 5),
+		trafficSample("reviews", "destination", 5),
+		trafficSample("details", "destination", 2),
+	}, nil)
+	prom.On("GetNamespaceServicesRequestRates", "bookinfo", "east", "10m", queryTime.Add(-24*time.Hour)).Return(model.Vector{
+		trafficSample("reviews", "destination", 4),
+		// Below the min baseline rate
+		trafficSample("productpage", "destination", 0.5),
+		trafficSample("ratings", "source", 3),
+		trafficSample("ratings", "destination", 3),
+	}, nil)
+
+	trafficService := NewTrafficBaselineService(nil, conf, nil, prom)
+	findings, err := trafficService.Analyze("east", "bookinfo", queryTime)
+	require.NoError(err)
+	require.Len(findings, 2)
+
+	require.Equal("details", findings[0].Service)
+	require.Equal(models.TrafficFindingNewDestination, findings[0].Type)
+	require.Equal(float64(2), findings[0].CurrentRate)
+	require.Equal(float64(0), findings[0].BaselineRate)
+
+	require.Equal("ratings", findings[1].Service)
+	require.Equal(models.TrafficFindingStopped, findings[1].Type)
+	require.Equal(float64(3), findings[1].BaselineRate)
+	require.Equal(queryTime, findings[1].DetectedAt)
+}
+
+func TestNotifyTrafficFindings(t *testing.T) {
+	require := require.New(t)
+
+	var received models.TrafficFindings
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("application/json", r.Header.Get("Content-Type"))
+		require.Equal("kiali", r.Header.Get("X-Source"))
+		require.NoError(json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	conf := config.NewConfig()
+	conf.Notifications.Directory = t.TempDir()
+	conf.TrafficBaseline.Webhook.URL = server.URL
+	conf.TrafficBaseline.Webhook.CustomHeaders = map[string]string{"X-Source": "kiali"}
+	trafficService := NewTrafficBaselineService(nil, conf, nil, nil)
+
+	findings := models.TrafficFindings{{Cluster: "east", Namespace: "bookinfo", Service: "ratings", Type: models.TrafficFindingStopped}}
+	require.NoError(trafficService.NotifyWebhook(context.TODO(), findings))
+	// Queued until the next delivery
+	require.Nil(received)
+
+	notificationService := NewNotificationService(conf)
+	require.NoError(notificationService.Deliver(context.TODO(), time.Now()))
+	require.Len(received, 1)
+	require.Equal("ratings", received[0].Service)
+}
+
+func trafficSample(service, reporter string, value float64) *model.Sample {
+	return &model.Sample{
+		Metric: model.Metric{
+			"destination_service_name": model.LabelValue(service),
+			"reporter":                 model.LabelValue(reporter),
+		},
+		Value: model.SampleValue(value),
+	}
+}
@@ -0,0 +1,108 @@
+package business
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kiali/kiali/business/checkers"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/httputil"
+)
+
+const defaultValidationWebhookTimeout = 10 * time.Second
+
+// validationWebhookChecker is a custom checker sending the Istio objects of a namespace to a validation webhook.
+type validationWebhookChecker struct {
+	webhook config.ValidationWebhook
+}
+
+func (c validationWebhookChecker) Name() string {
+	return c.webhook.Name
+}
+
+// Check returns the validations of the objects the webhook replied checks for. The checks of objects that were not
+// sent are ignored.
+func (c validationWebhookChecker) Check(cluster, namespace string, objects []checkers.CustomCheckedObject) (models.IstioValidations, error) {
+	request := models.ValidationWebhookRequest{Cluster: cluster, Namespace: namespace, Objects: []models.ValidationWebhookObject{}}
+	sent := map[models.IstioValidationKey]checkers.CustomCheckedObject{}
+	for _, object := range objects {
+		generic, err := genericIstioObject(object.Object)
+		if err != nil {
+			return nil, err
+		}
+		request.Objects = append(request.Objects, models.ValidationWebhookObject{ObjectGVK: object.ObjectGVK, Object: generic})
+		sent[models.BuildKey(object.ObjectGVK, object.Object.GetName(), object.Object.GetNamespace(), cluster)] = object
+	}
+
+	response, err := postValidationWebhook(c.webhook, request)
+	if err != nil {
+		return nil, err
+	}
+
+	validations := models.IstioValidations{}
+	for _, validation := range response.Validations {
+		object, found := sent[models.BuildKey(validation.ObjectGVK, validation.Name, namespace, cluster)]
+		if !found || len(validation.Checks) == 0 {
+			continue
+		}
+		key, objectValidation := checkers.CustomValidation(cluster, object, validation.Checks)
+		validations.MergeValidations(models.IstioValidations{key: objectValidation})
+	}
+	return validations, nil
+}
+
+// postValidationWebhook sends the Istio objects to the validation webhook and returns its response.
+func postValidationWebhook(webhook config.ValidationWebhook, request models.ValidationWebhookRequest) (*models.ValidationWebhookResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]string{"Content-Type": "application/json"}
+	for k, v := range webhook.CustomHeaders {
+		headers[k] = v
+	}
+	timeout := defaultValidationWebhookTimeout
+	if webhook.TimeoutSeconds > 0 {
+		timeout = time.Duration(webhook.TimeoutSeconds) * time.Second
+	}
+
+	resp, code, _, err := httputil.HttpPost(webhook.URL, &webhook.Auth, bytes.NewReader(body), timeout, headers)
+	if err != nil {
+		return nil, err
+	}
+	if code >= 300 {
+		return nil, fmt.Errorf("validation webhook [%s] responded with status code [%d]", webhook.URL, code)
+	}
+	response := &models.ValidationWebhookResponse{}
+	if err := json.Unmarshal(resp, response); err != nil {
+		return nil, fmt.Errorf("invalid response of the validation webhook [%s]: %s", webhook.URL, err)
+	}
+	return response, nil
+}
+
+// customObjectChecker returns the checker running the registered custom checkers and the validation webhooks on the
+// Istio objects of the namespace, or false when there are none.
+func (in *IstioValidationsService) customObjectChecker(cluster, namespace string, istioConfigList models.IstioConfigList, mtlsDetails kubernetes.MTLSDetails, rbacDetails kubernetes.RBACDetails) (checkers.ObjectChecker, bool) {
+	customCheckers := checkers.RegisteredCustomCheckers()
+	for _, webhook := range config.Get().ValidationWebhooks {
+		customCheckers = append(customCheckers, validationWebhookChecker{webhook: webhook})
+	}
+	if len(customCheckers) == 0 {
+		return nil, false
+	}
+
+	// The list holds the objects of every namespace, but the policies of the namespace only
+	istioConfigList.AuthorizationPolicies = rbacDetails.AuthorizationPolicies
+	istioConfigList.PeerAuthentications = mtlsDetails.PeerAuthentications
+	objects := []checkers.CustomCheckedObject{}
+	for _, o := range istioConfigObjects(&istioConfigList) {
+		if o.object.GetNamespace() == namespace {
+			objects = append(objects, checkers.CustomCheckedObject{ObjectGVK: o.gvk, Object: o.object})
+		}
+	}
+	return checkers.CustomObjectChecker{Checkers: customCheckers, Cluster: cluster, Namespace: namespace, Objects: objects}, true
+}
@@ -0,0 +1,95 @@
+package business
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/business/checkers"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// namingChecker requires the names of the DestinationRules to end with "-dr".
+type namingChecker struct{}
+
+func (namingChecker) Name() string {
+	return "naming"
+}
+
+func (namingChecker) Check(cluster, namespace string, objects []checkers.CustomCheckedObject) (models.IstioValidations, error) {
+	validations := models.IstioValidations{}
+	for _, object := range objects {
+		if object.ObjectGVK == kubernetes.DestinationRules && !strings.HasSuffix(object.Object.GetName(), "-dr") {
+			key, validation := checkers.CustomValidation(cluster, object, []*models.IstioCheck{
+				{Code: "ACME001", Message: "DestinationRule names must end with -dr", Severity: models.WarningSeverity, Path: "metadata/name"},
+			})
+			validations[key] = validation
+		}
+	}
+	return validations, nil
+}
+
+func TestCustomCheckers(t *testing.T) {
+	require := require.New(t)
+
+	var request models.ValidationWebhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(json.NewDecoder(r.Body).Decode(&request))
+		_ = json.NewEncoder(w).Encode(models.ValidationWebhookResponse{Validations: []models.ValidationWebhookValidation{
+			{ObjectGVK: kubernetes.VirtualServices, Name: "product-vs", Checks: []*models.IstioCheck{
+				{Code: "ACME002", Message: "Missing owner annotation", Severity: models.ErrorSeverity, Path: "metadata/annotations"},
+			}},
+			// Not sent, ignored
+			{ObjectGVK: kubernetes.VirtualServices, Name: "other-vs", Checks: []*models.IstioCheck{
+				{Code: "ACME002", Message: "Missing owner annotation", Severity: models.ErrorSeverity},
+			}},
+		}})
+	}))
+	defer server.Close()
+
+	istioConfigList := fakeIstioConfigList()
+	istioConfigList.DestinationRules[1].Name = "customer"
+	vs := mockCombinedValidationService(t, istioConfigList,
+		[]string{"details.test.svc.cluster.local", "product.test.svc.cluster.local", "product2.test.svc.cluster.local", "customer.test.svc.cluster.local"})
+
+	conf := config.NewConfig()
+	conf.ValidationWebhooks = []config.ValidationWebhook{{Name: "acme", URL: server.URL}}
+	config.Set(conf)
+	checkers.RegisterCustomChecker(namingChecker{})
+	defer checkers.UnregisterCustomChecker("naming")
+
+	validations, err := vs.CreateNamespacesValidations(context.TODO(), conf.KubernetesConfig.ClusterName, func(cluster, namespace string) bool {
+		return namespace == "test"
+	})
+	require.NoError(err)
+
+	// The objects of the namespace only are sent to the webhook
+	require.Equal("test", request.Namespace)
+	require.NotEmpty(request.Objects)
+	names := []string{}
+	for _, object := range request.Objects {
+		names = append(names, object.Object["metadata"].(map[string]interface{})["name"].(string))
+	}
+	require.Contains(names, "first")
+	require.NotContains(names, "second")
+
+	cluster := conf.KubernetesConfig.ClusterName
+	drValidation := validations[models.BuildKey(kubernetes.DestinationRules, "customer", "test", cluster)]
+	require.NotNil(drValidation)
+	require.Contains(drValidation.Checks, &models.IstioCheck{Code: "ACME001", Message: "DestinationRule names must end with -dr", Severity: models.WarningSeverity, Path: "metadata/name"})
+	for _, check := range validations[models.BuildKey(kubernetes.DestinationRules, "product-dr", "test", cluster)].Checks {
+		require.NotEqual("ACME001", check.Code)
+	}
+
+	vsValidation := validations[models.BuildKey(kubernetes.VirtualServices, "product-vs", "test", cluster)]
+	require.False(vsValidation.Valid)
+	require.Equal("ACME002", vsValidation.Checks[len(vsValidation.Checks)-1].Code)
+	require.NotContains(validations, models.BuildKey(kubernetes.VirtualServices, "other-vs", "test", cluster))
+}
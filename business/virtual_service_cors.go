@@ -0,0 +1,155 @@
+package business
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// GetVirtualServiceCors computes the effective CORS behavior of the HTTP routes of a VirtualService. When a route is
+// given, only this route is returned. When an origin is given, the behavior of the routes for the requests of this
+// origin and the CORS headers Envoy adds to their responses are computed too.
+func (in *IstioConfigService) GetVirtualServiceCors(ctx context.Context, cluster, namespace, name, route, origin string) (*models.VirtualServiceCors, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	vs, err := kubeCache.GetVirtualService(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	cors := virtualServiceCors(vs, origin)
+	if route == "" {
+		return cors, nil
+	}
+	for _, routeCors := range cors.Routes {
+		if routeCors.Route == route {
+			cors.Routes = []models.RouteCors{routeCors}
+			return cors, nil
+		}
+	}
+	return nil, kubernetes.NewNotFound(route, "Kiali", "VirtualService route")
+}
+
+func virtualServiceCors(vs *networking_v1.VirtualService, origin string) *models.VirtualServiceCors {
+	result := &models.VirtualServiceCors{
+		VirtualService: models.IstioReference{ObjectGVK: kubernetes.VirtualServices, Name: vs.Name, Namespace: vs.Namespace},
+		Origin:         origin,
+		Routes:         []models.RouteCors{},
+	}
+	for i, httpRoute := range vs.Spec.Http {
+		if httpRoute == nil {
+			continue
+		}
+		routeCors := models.RouteCors{Route: httpRoute.Name}
+		if routeCors.Route == "" {
+			routeCors.Route = strconv.Itoa(i)
+		}
+		if policy := httpRoute.CorsPolicy; policy != nil {
+			routeCors.Enabled = true
+			for _, allowOrigin := range policy.AllowOrigin {
+				routeCors.AllowOrigins = append(routeCors.AllowOrigins, "exact:"+allowOrigin)
+			}
+			for _, allowOrigin := range policy.AllowOrigins {
+				if description := describeStringMatch(allowOrigin); description != "" {
+					routeCors.AllowOrigins = append(routeCors.AllowOrigins, description)
+				}
+			}
+			routeCors.AllowMethods = policy.AllowMethods
+			routeCors.AllowHeaders = policy.AllowHeaders
+			routeCors.ExposeHeaders = policy.ExposeHeaders
+			if policy.MaxAge != nil && policy.MaxAge.CheckValid() == nil {
+				maxAge := int64(policy.MaxAge.AsDuration().Seconds())
+				routeCors.MaxAgeSeconds = &maxAge
+			}
+			routeCors.AllowCredentials = policy.AllowCredentials != nil && policy.AllowCredentials.Value
+		}
+		if origin != "" {
+			setCorsBehavior(&routeCors, httpRoute.CorsPolicy, origin)
+		}
+		result.Routes = append(result.Routes, routeCors)
+	}
+	return result
+}
+
+// setCorsBehavior sets the behavior of the route for the requests of the origin and, when the origin is allowed,
+// the CORS headers added by Envoy to the responses.
+func setCorsBehavior(routeCors *models.RouteCors, policy *api_networking_v1.CorsPolicy, origin string) {
+	if policy == nil {
+		routeCors.Behavior = models.CorsBehaviorDisabled
+		return
+	}
+	if !corsAllowsOrigin(policy, origin) {
+		routeCors.Behavior = models.CorsBehaviorDenied
+		return
+	}
+	routeCors.Behavior = models.CorsBehaviorAllowed
+
+	routeCors.PreflightHeaders = map[string]string{"Access-Control-Allow-Origin": origin}
+	routeCors.ResponseHeaders = map[string]string{"Access-Control-Allow-Origin": origin}
+	if len(policy.AllowMethods) > 0 {
+		routeCors.PreflightHeaders["Access-Control-Allow-Methods"] = strings.Join(policy.AllowMethods, ",")
+	}
+	if len(policy.AllowHeaders) > 0 {
+		routeCors.PreflightHeaders["Access-Control-Allow-Headers"] = strings.Join(policy.AllowHeaders, ",")
+	}
+	if routeCors.MaxAgeSeconds != nil {
+		routeCors.PreflightHeaders["Access-Control-Max-Age"] = strconv.FormatInt(*routeCors.MaxAgeSeconds, 10)
+	}
+	if len(policy.ExposeHeaders) > 0 {
+		routeCors.ResponseHeaders["Access-Control-Expose-Headers"] = strings.Join(policy.ExposeHeaders, ",")
+	}
+	if routeCors.AllowCredentials {
+		routeCors.PreflightHeaders["Access-Control-Allow-Credentials"] = "true"
+		routeCors.ResponseHeaders["Access-Control-Allow-Credentials"] = "true"
+	}
+}
+
+// corsAllowsOrigin returns whether the origin matches one of the allowed origins of the CORS policy. Like in Envoy,
+// the deprecated allowOrigin field accepts "*" for any origin.
+func corsAllowsOrigin(policy *api_networking_v1.CorsPolicy, origin string) bool {
+	for _, allowOrigin := range policy.AllowOrigin {
+		if allowOrigin == "*" || allowOrigin == origin {
+			return true
+		}
+	}
+	for _, allowOrigin := range policy.AllowOrigins {
+		if allowOrigin == nil || allowOrigin.MatchType == nil {
+			continue
+		}
+		if exact, ok := allowOrigin.MatchType.(*api_networking_v1.StringMatch_Exact); ok && exact.Exact == "*" {
+			return true
+		}
+		if matchesString(allowOrigin, origin, false) {
+			return true
+		}
+	}
+	return false
+}
+
+func describeStringMatch(stringMatch *api_networking_v1.StringMatch) string {
+	if stringMatch == nil {
+		return ""
+	}
+	switch m := stringMatch.MatchType.(type) {
+	case *api_networking_v1.StringMatch_Exact:
+		return "exact:" + m.Exact
+	case *api_networking_v1.StringMatch_Prefix:
+		return "prefix:" + m.Prefix
+	case *api_networking_v1.StringMatch_Regex:
+		return "regex:" + m.Regex
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package business
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	api_networking_v1 "istio.io/api/networking/v1"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/tests/data"
+)
+
+func TestVirtualServiceCors(t *testing.T) {
+	require := require.New(t)
+
+	vs := data.AddHttpRoutesToVirtualService(data.CreateHttpRouteDestination("reviews", "v1", 100),
+		data.CreateEmptyVirtualService("reviews", "bookinfo", []string{"reviews"}),
+	)
+	vs.Spec.Http = append(vs.Spec.Http, &api_networking_v1.HTTPRoute{
+		Name: "api",
+		CorsPolicy: &api_networking_v1.CorsPolicy{
+			AllowOrigins: []*api_networking_v1.StringMatch{
+				{MatchType: &api_networking_v1.StringMatch_Exact{Exact: "https://bookinfo.example.com"}},
+				{MatchType: &api_networking_v1.StringMatch_Regex{Regex: `https://.*\.test\.com`}},
+			},
+			AllowMethods:     []string{"GET", "POST"},
+			ExposeHeaders:    []string{"x-request-id"},
+			MaxAge:           durationpb.New(86400 * 1e9),
+			AllowCredentials: wrapperspb.Bool(true),
+		},
+	})
+
+	cors := virtualServiceCors(vs, "")
+	require.Len(cors.Routes, 2)
+	require.Equal("0", cors.Routes[0].Route)
+	require.False(cors.Routes[0].Enabled)
+	require.Empty(cors.Routes[0].Behavior)
+	require.Equal("api", cors.Routes[1].Route)
+	require.True(cors.Routes[1].Enabled)
+	require.Equal([]string{"exact:https://bookinfo.example.com", `regex:https://.*\.test\.com`}, cors.Routes[1].AllowOrigins)
+	require.Equal(int64(86400), *cors.Routes[1].MaxAgeSeconds)
+	require.True(cors.Routes[1].AllowCredentials)
+
+	cors = virtualServiceCors(vs, "https://app.test.com")
+	require.Equal(models.CorsBehaviorDisabled, cors.Routes[0].Behavior)
+	require.Equal(models.CorsBehaviorAllowed, cors.Routes[1].Behavior)
+	require.Equal(map[string]string{
+		"Access-Control-Allow-Origin":      "https://app.test.com",
+		"Access-Control-Allow-Methods":     "GET,POST",
+		"Access-Control-Max-Age":           "86400",
+		"Access-Control-Allow-Credentials": "true",
+	}, cors.Routes[1].PreflightHeaders)
+	require.Equal(map[string]string{
+		"Access-Control-Allow-Origin":      "https://app.test.com",
+		"Access-Control-Expose-Headers":    "x-request-id",
+		"Access-Control-Allow-Credentials": "true",
+	}, cors.Routes[1].ResponseHeaders)
+
+	cors = virtualServiceCors(vs, "https://evil.com")
+	require.Equal(models.CorsBehaviorDenied, cors.Routes[1].Behavior)
+	require.Empty(cors.Routes[1].PreflightHeaders)
+}
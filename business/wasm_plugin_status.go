@@ -0,0 +1,180 @@
+package business
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	api_type_v1beta1 "istio.io/api/type/v1beta1"
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+const (
+	// Label set by Istio on the deployments of the Gateway API gateways
+	gatewayNameLabel = "gateway.networking.k8s.io/gateway-name"
+	// Registry of the OCI references without registry, as in the docker CLI
+	defaultOCIRegistry = "index.docker.io"
+)
+
+// wasmModuleClient fetches the Wasm modules to check their reachability. Tests replace it.
+var wasmModuleClient = &http.Client{Timeout: 5 * time.Second}
+
+// ociManifestMediaTypes are the manifests accepted from the OCI registries.
+var ociManifestMediaTypes = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+// GetWasmPluginStatus returns where the module of the WasmPlugin is fetched from, whether Kiali can reach it, and the
+// workloads of the namespace the plugin attaches to: the Gateways of its targetRefs, the workloads of its selector or
+// all the workloads of the namespace.
+func (in *IstioConfigService) GetWasmPluginStatus(ctx context.Context, cluster, namespace, name string) (*models.WasmPluginStatus, error) {
+	// Check the user has access to the namespace
+	if _, err := in.businessLayer.Namespace.GetClusterNamespace(ctx, namespace, cluster); err != nil {
+		return nil, err
+	}
+
+	kubeCache, err := in.kialiCache.GetKubeCache(cluster)
+	if err != nil {
+		return nil, err
+	}
+	wasmPlugin, err := kubeCache.GetWasmPlugin(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := in.businessLayer.Workload.fetchWorkloadsFromCluster(ctx, cluster, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	status := &models.WasmPluginStatus{
+		WasmPlugin: models.IstioReference{ObjectGVK: kubernetes.WasmPlugins, Name: wasmPlugin.Name, Namespace: wasmPlugin.Namespace},
+		URL:        wasmPlugin.Spec.Url,
+		Workloads:  []string{},
+	}
+	status.Scheme, status.Reachability = checkWasmModule(ctx, wasmPlugin.Spec.Url)
+
+	targetRefs := append([]*api_type_v1beta1.PolicyTargetReference{}, wasmPlugin.Spec.TargetRefs...)
+	if wasmPlugin.Spec.TargetRef != nil {
+		targetRefs = append(targetRefs, wasmPlugin.Spec.TargetRef)
+	}
+	selector := labels.Everything()
+	if len(targetRefs) == 0 && wasmPlugin.Spec.Selector != nil && len(wasmPlugin.Spec.Selector.MatchLabels) > 0 {
+		selector = labels.SelectorFromSet(wasmPlugin.Spec.Selector.MatchLabels)
+	} else if len(targetRefs) == 0 {
+		status.MeshWide = namespace == in.config.ExternalServices.Istio.RootNamespace
+	}
+	for _, w := range workloads {
+		if attachesTo(wasmPlugin, targetRefs, selector, w.Labels) {
+			status.Workloads = append(status.Workloads, w.Name)
+		}
+	}
+	sort.Strings(status.Workloads)
+
+	return status, nil
+}
+
+// attachesTo returns whether the WasmPlugin attaches to a workload with the given labels. The plugins with
+// targetRefs only attach to the Gateways referenced.
+func attachesTo(wasmPlugin *extensions_v1alpha1.WasmPlugin, targetRefs []*api_type_v1beta1.PolicyTargetReference, selector labels.Selector, workloadLabels map[string]string) bool {
+	if len(targetRefs) == 0 {
+		return selector.Matches(labels.Set(workloadLabels))
+	}
+	for _, ref := range targetRefs {
+		if ref.Kind == kubernetes.K8sGateways.Kind && (ref.Namespace == "" || ref.Namespace == wasmPlugin.Namespace) && workloadLabels[gatewayNameLabel] == ref.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkWasmModule returns the scheme of the URL of a Wasm module and whether Kiali can fetch it. As Istio does, a URL
+// without scheme is an OCI image reference. The OCI registries are checked with their HTTPS API.
+func checkWasmModule(ctx context.Context, moduleURL string) (string, models.WasmModuleReachability) {
+	parsed, err := url.Parse(moduleURL)
+	if err != nil {
+		return "", models.WasmModuleReachability{Message: fmt.Sprintf("Invalid URL: %s", err)}
+	}
+
+	var request *http.Request
+	switch parsed.Scheme {
+	case "http", "https":
+		if request, err = http.NewRequestWithContext(ctx, http.MethodHead, moduleURL, nil); err != nil {
+			return parsed.Scheme, models.WasmModuleReachability{Message: fmt.Sprintf("Invalid URL: %s", err)}
+		}
+	case "", "oci":
+		manifestURL, err := ociManifestURL(strings.TrimPrefix(moduleURL, "oci://"))
+		if err != nil {
+			return "oci", models.WasmModuleReachability{Message: err.Error()}
+		}
+		if request, err = http.NewRequestWithContext(ctx, http.MethodHead, manifestURL, nil); err != nil {
+			return "oci", models.WasmModuleReachability{Message: fmt.Sprintf("Invalid URL: %s", err)}
+		}
+		request.Header.Set("Accept", strings.Join(ociManifestMediaTypes, ", "))
+	case "file":
+		return parsed.Scheme, models.WasmModuleReachability{Message: "The module is a file local to the proxies"}
+	default:
+		return parsed.Scheme, models.WasmModuleReachability{Message: fmt.Sprintf("Unsupported scheme %q", parsed.Scheme)}
+	}
+
+	scheme := parsed.Scheme
+	if scheme == "" {
+		scheme = "oci"
+	}
+	reachability := models.WasmModuleReachability{Checked: true}
+	response, err := wasmModuleClient.Do(request)
+	if err != nil {
+		reachability.Message = err.Error()
+		return scheme, reachability
+	}
+	defer response.Body.Close()
+
+	reachability.StatusCode = response.StatusCode
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		reachability.Reachable = true
+		reachability.Message = "The module is available"
+	case response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden:
+		// The proxies may be able to fetch the module with the imagePullSecret
+		reachability.Reachable = true
+		reachability.Message = "The server requires credentials to fetch the module"
+	case response.StatusCode == http.StatusNotFound:
+		reachability.Message = "The module is not found"
+	default:
+		reachability.Message = fmt.Sprintf("The server answered %s", response.Status)
+	}
+	return scheme, reachability
+}
+
+// ociManifestURL returns the URL of the manifest of an OCI image reference, i.e.
+// ghcr.io/istio-ecosystem/wasm-extensions/basic_auth:1.12.0, in the API of its registry.
+func ociManifestURL(reference string) (string, error) {
+	registry, repository := defaultOCIRegistry, reference
+	if parts := strings.SplitN(reference, "/", 2); len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		registry, repository = parts[0], parts[1]
+	}
+	if registry == defaultOCIRegistry && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+
+	tag := "latest"
+	if i := strings.Index(repository, "@"); i >= 0 {
+		repository, tag = repository[:i], repository[i+1:]
+	} else if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository, tag = repository[:i], repository[i+1:]
+	}
+	if repository == "" || tag == "" {
+		return "", fmt.Errorf("invalid OCI reference %q", reference)
+	}
+	return fmt.Sprintf("https://%s/v2/%s/manifests/%s", registry, repository, tag), nil
+}
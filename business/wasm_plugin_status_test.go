@@ -0,0 +1,123 @@
+package business
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	api_extensions_v1alpha1 "istio.io/api/extensions/v1alpha1"
+	api_type_v1beta1 "istio.io/api/type/v1beta1"
+	extensions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+func TestOCIManifestURL(t *testing.T) {
+	cases := map[string]string{
+		"ghcr.io/istio-ecosystem/wasm-extensions/basic_auth:1.12.0": "https://ghcr.io/v2/istio-ecosystem/wasm-extensions/basic_auth/manifests/1.12.0",
+		"localhost:5000/basic_auth":                                 "https://localhost:5000/v2/basic_auth/manifests/latest",
+		"registry.example.com/basic_auth@sha256:5c4b2e3a":           "https://registry.example.com/v2/basic_auth/manifests/sha256:5c4b2e3a",
+		"basic_auth:1.0":  "https://index.docker.io/v2/library/basic_auth/manifests/1.0",
+		"acme/basic_auth": "https://index.docker.io/v2/acme/basic_auth/manifests/latest",
+	}
+	for reference, expected := range cases {
+		manifestURL, err := ociManifestURL(reference)
+		require.NoError(t, err, reference)
+		require.Equal(t, expected, manifestURL, reference)
+	}
+
+	_, err := ociManifestURL("registry.example.com/basic_auth:")
+	require.Error(t, err)
+}
+
+func TestCheckWasmModule(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/basic_auth.wasm":
+			w.WriteHeader(http.StatusOK)
+		case "/private.wasm":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	scheme, reachability := checkWasmModule(context.TODO(), server.URL+"/basic_auth.wasm")
+	require.Equal("http", scheme)
+	require.True(reachability.Checked)
+	require.True(reachability.Reachable)
+	require.Equal(http.StatusOK, reachability.StatusCode)
+
+	_, reachability = checkWasmModule(context.TODO(), server.URL+"/private.wasm")
+	require.True(reachability.Reachable)
+	require.Equal(http.StatusUnauthorized, reachability.StatusCode)
+
+	_, reachability = checkWasmModule(context.TODO(), server.URL+"/missing.wasm")
+	require.True(reachability.Checked)
+	require.False(reachability.Reachable)
+	require.Equal(http.StatusNotFound, reachability.StatusCode)
+
+	scheme, reachability = checkWasmModule(context.TODO(), "file:///opt/filters/basic_auth.wasm")
+	require.Equal("file", scheme)
+	require.False(reachability.Checked)
+}
+
+func TestGetWasmPluginStatus(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	pod := FakePodsSyncedWithDeployments()[0]
+	pod.Labels = FakeRSSyncedWithPods()[0].Spec.Template.Labels
+	k8s := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("Namespace"),
+		&FakeDepSyncedWithRS()[0],
+		&FakeRSSyncedWithPods()[0],
+		&pod,
+		&extensions_v1alpha1.WasmPlugin{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "basic-auth", Namespace: "Namespace"},
+			Spec: api_extensions_v1alpha1.WasmPlugin{
+				Url:      server.URL + "/basic_auth.wasm",
+				Selector: &api_type_v1beta1.WorkloadSelector{MatchLabels: map[string]string{"app": "details"}},
+			},
+		},
+		&extensions_v1alpha1.WasmPlugin{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "gateway-auth", Namespace: "Namespace"},
+			Spec: api_extensions_v1alpha1.WasmPlugin{
+				Url:        "file:///opt/filters/basic_auth.wasm",
+				TargetRefs: []*api_type_v1beta1.PolicyTargetReference{{Group: "gateway.networking.k8s.io", Kind: "Gateway", Name: "ingress"}},
+			},
+		},
+	)
+	SetupBusinessLayer(t, k8s, *conf)
+
+	k8sclients := map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: k8s}
+	layer := NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	status, err := layer.IstioConfig.GetWasmPluginStatus(context.TODO(), conf.KubernetesConfig.ClusterName, "Namespace", "basic-auth")
+	require.NoError(err)
+	require.Equal("http", status.Scheme)
+	require.True(status.Reachability.Reachable)
+	require.False(status.MeshWide)
+	require.Equal([]string{"details-v1"}, status.Workloads)
+
+	status, err = layer.IstioConfig.GetWasmPluginStatus(context.TODO(), conf.KubernetesConfig.ClusterName, "Namespace", "gateway-auth")
+	require.NoError(err)
+	require.Equal("file", status.Scheme)
+	require.False(status.Reachability.Checked)
+	require.Empty(status.Workloads)
+}
@@ -83,6 +83,7 @@ type WorkloadCriteria struct {
 	Namespace             string
 	WorkloadName          string
 	WorkloadGVK           schema.GroupVersionKind
+	IncludeEvents         bool
 	IncludeIstioResources bool
 	IncludeServices       bool
 	IncludeHealth         bool
@@ -456,9 +457,8 @@ func (in *WorkloadService) GetWorkload(ctx context.Context, criteria WorkloadCri
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		conf := in.config
-		app := workload.Labels[conf.IstioLabels.AppLabelName]
-		version := workload.Labels[conf.IstioLabels.VersionLabelName]
+		app, _ := models.CanonicalApp(workload.Labels)
+		version, _ := models.CanonicalVersion(workload.Labels)
 		runtimes = NewDashboardsService(in.config, in.grafana, ns, workload).GetCustomDashboardRefs(criteria.Namespace, app, version, workload.Pods)
 	}()
 
@@ -481,8 +481,16 @@ func (in *WorkloadService) GetWorkload(ctx context.Context, criteria WorkloadCri
 		workload.SetServices(services)
 	}
 
+	if criteria.IncludeEvents {
+		workload.Events, err = in.fetchWorkloadEvents(criteria.Cluster, criteria.Namespace, workload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	wg.Wait()
 	workload.Runtimes = runtimes
+	workload.DeepLinks = models.GetDeepLinks(in.config, models.DeepLinkKindWorkload, criteria.Cluster, criteria.Namespace, workload.Name)
 
 	return workload, nil
 }
@@ -521,6 +529,46 @@ func (in *WorkloadService) UpdateWorkload(ctx context.Context, cluster string, n
 	return in.GetWorkload(ctx, WorkloadCriteria{Cluster: cluster, Namespace: namespace, WorkloadName: workloadName, WorkloadGVK: workloadGVK, IncludeServices: includeServices})
 }
 
+// fetchWorkloadEvents returns the Kubernetes events of the workload, its pods and the controllers of its pods,
+// correlated by their involved object, from the most recent to the oldest. The probe failures reported
+// in the events are also counted in the pods.
+func (in *WorkloadService) fetchWorkloadEvents(cluster, namespace string, workload *models.Workload) (models.Events, error) {
+	k8s, ok := in.userClients[cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster [%s] is not found or is not accessible for Kiali", cluster)
+	}
+
+	involved := map[string]bool{workload.WorkloadGVK.Kind + "/" + workload.Name: true}
+	for _, pod := range workload.Pods {
+		involved["Pod/"+pod.Name] = true
+		for _, ref := range pod.CreatedBy {
+			involved[ref.Kind+"/"+ref.Name] = true
+		}
+	}
+
+	k8sEvents, err := k8s.GetEvents(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	events := models.Events{}
+	for i := range k8sEvents {
+		e := &k8sEvents[i]
+		if !involved[e.InvolvedObject.Kind+"/"+e.InvolvedObject.Name] {
+			continue
+		}
+		event := models.Event{}
+		event.Parse(e)
+		events = append(events, event)
+	}
+	events.SortByLastTimestamp()
+
+	workload.Pods.AddProbeFailures(k8sEvents)
+	workload.ProbeFailures = workload.Pods.HasProbeFailures()
+
+	return events, nil
+}
+
 func (in *WorkloadService) GetPod(cluster, namespace, name string) (*models.Pod, error) {
 	k8s, ok := in.userClients[cluster]
 	if !ok {
@@ -956,7 +1004,7 @@ func (in *WorkloadService) fetchWorkloadsFromCluster(ctx context.Context, cluste
 		defer wg.Done()
 		var err error
 		if in.isWorkloadIncluded(kubernetes.WorkloadGroupType) {
-			wgroups, err = kubeCache.GetWorkloadGroups(namespace, labelSelector)
+			wgroups, err = kubeCache.GetWorkloadGroups(ctx, namespace, labelSelector)
 			if err != nil {
 				log.Errorf("Error fetching WorkloadGroups per namespace %s: %s", namespace, err)
 				errChan <- err
@@ -969,7 +1017,7 @@ func (in *WorkloadService) fetchWorkloadsFromCluster(ctx context.Context, cluste
 		defer wg.Done()
 		var err error
 		if in.isWorkloadIncluded(kubernetes.WorkloadEntryType) {
-			wentries, err = kubeCache.GetWorkloadEntries(namespace, labelSelector)
+			wentries, err = kubeCache.GetWorkloadEntries(ctx, namespace, labelSelector)
 			if err != nil {
 				log.Errorf("Error fetching WorkloadEntries per namespace %s: %s", namespace, err)
 				errChan <- err
@@ -982,7 +1030,7 @@ func (in *WorkloadService) fetchWorkloadsFromCluster(ctx context.Context, cluste
 		defer wg.Done()
 		var err error
 		if in.isWorkloadIncluded(kubernetes.SidecarType) {
-			sidecars, err = kubeCache.GetSidecars(namespace, "")
+			sidecars, err = kubeCache.GetSidecars(ctx, namespace, "")
 			if err != nil {
 				log.Errorf("Error fetching Sidecars per namespace %s: %s", namespace, err)
 				errChan <- err
@@ -1439,6 +1487,9 @@ func (in *WorkloadService) fetchWorkloadsFromCluster(ctx context.Context, cluste
 			ws = append(ws, w)
 		}
 	}
+
+	in.setRolloutInfo(userClient, namespace, ws, repset)
+
 	return ws, nil
 }
 
@@ -1512,7 +1563,7 @@ func (in *WorkloadService) fetchWorkload(ctx context.Context, criteria WorkloadC
 		if criteria.WorkloadGVK.Kind != "" && criteria.WorkloadGVK != kubernetes.WorkloadGroups {
 			return
 		}
-		wgroup, err = kialiCache.GetWorkloadGroup(criteria.Namespace, criteria.WorkloadName)
+		wgroup, err = kialiCache.GetWorkloadGroup(ctx, criteria.Namespace, criteria.WorkloadName)
 		if err != nil {
 			if errors.IsNotFound(err) {
 				wgroup = nil
@@ -1531,7 +1582,7 @@ func (in *WorkloadService) fetchWorkload(ctx context.Context, criteria WorkloadC
 		if criteria.WorkloadGVK.Kind != "" && criteria.WorkloadGVK != kubernetes.WorkloadGroups {
 			return
 		}
-		wentries, err = kialiCache.GetWorkloadEntries(criteria.Namespace, "")
+		wentries, err = kialiCache.GetWorkloadEntries(ctx, criteria.Namespace, "")
 		if err != nil {
 			if errors.IsNotFound(err) {
 				wentries = nil
@@ -1550,7 +1601,7 @@ func (in *WorkloadService) fetchWorkload(ctx context.Context, criteria WorkloadC
 		if criteria.WorkloadGVK.Kind != "" && criteria.WorkloadGVK != kubernetes.WorkloadGroups {
 			return
 		}
-		sidecars, err = kialiCache.GetSidecars(criteria.Namespace, "")
+		sidecars, err = kialiCache.GetSidecars(ctx, criteria.Namespace, "")
 		if err != nil {
 			if errors.IsNotFound(err) {
 				sidecars = nil
@@ -1948,6 +1999,7 @@ func (in *WorkloadService) fetchWorkload(ctx context.Context, criteria WorkloadC
 				selector := labels.Set(repset[iFound].Spec.Template.Labels).AsSelector()
 				w.SetPods(kubernetes.FilterPodsBySelector(selector, pods))
 				w.ParseReplicaSet(&repset[iFound])
+				in.setRolloutInfo(client, criteria.Namespace, models.Workloads{&w}, repset[iFound:iFound+1])
 			} else {
 				log.Errorf("Workload %s is not found as ReplicaSet", criteria.WorkloadName)
 				cnFound = false
@@ -2251,7 +2303,7 @@ func (in *WorkloadService) isWorkloadCaptured(ctx context.Context, workload mode
 	}
 
 	// Is the namespace labeled?
-	ns, foundNS := in.cache.GetNamespace(workload.Cluster, in.userClients[workload.Cluster].GetToken(), workload.Namespace)
+	ns, foundNS := in.cache.GetNamespace(workload.Cluster, in.userClients[workload.Cluster].GetIdentity(), workload.Namespace)
 
 	if foundNS && !servicesExcluded {
 		waypointName, ok := ns.Labels[config.WaypointUseLabel]
@@ -2341,7 +2393,7 @@ func (in *WorkloadService) listWaypointWorkloads(ctx context.Context, name, name
 	}
 
 	// Get annotated workloads
-	namespaces, found := in.cache.GetNamespaces(cluster, in.userClients[cluster].GetToken())
+	namespaces, found := in.cache.GetNamespaces(cluster, in.userClients[cluster].GetIdentity())
 	if found {
 		for _, ns := range namespaces {
 			wlist, err := in.fetchWorkloadsFromCluster(ctx, cluster, ns.Name, labelSelector)
@@ -2471,6 +2523,53 @@ func (in *WorkloadService) updateWorkload(ctx context.Context, cluster string, n
 // https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors
 // But Istio only identifies one controller as workload (it doesn't note which one).
 // Kiali can select one on the list of workloads and other in the details and this should be consistent.
+// setRolloutInfo sets the Argo Rollout info of the ReplicaSet workloads owned by a Rollout.
+// The Rollouts are fetched only when one of the workloads is owned by a Rollout.
+func (in *WorkloadService) setRolloutInfo(client kubernetes.ClientInterface, namespace string, ws models.Workloads, repset []apps_v1.ReplicaSet) {
+	if !in.isWorkloadIncluded(kubernetes.RolloutType) {
+		return
+	}
+
+	// Key: name of the ReplicaSet; Value: index of the ReplicaSet
+	rolloutReplicaSets := map[string]int{}
+	for i, rs := range repset {
+		ref := meta_v1.GetControllerOf(&rs)
+		if ref == nil || ref.Kind != kubernetes.RolloutType {
+			continue
+		}
+		if refGV, err := schema.ParseGroupVersion(ref.APIVersion); err == nil && refGV.Group == kubernetes.Rollouts.Group {
+			rolloutReplicaSets[rs.Name] = i
+		}
+	}
+	if len(rolloutReplicaSets) == 0 {
+		return
+	}
+
+	var rollouts []kubernetes.Rollout
+	fetched := false
+	for _, w := range ws {
+		iRs, ok := rolloutReplicaSets[w.Name]
+		if !ok || w.WorkloadGVK != kubernetes.ReplicaSets {
+			continue
+		}
+		if !fetched {
+			var err error
+			fetched = true
+			if rollouts, err = client.GetRollouts(namespace); err != nil {
+				log.Errorf("Error fetching Rollouts per namespace %s: %s", namespace, err)
+				return
+			}
+		}
+		ref := meta_v1.GetControllerOf(&repset[iRs])
+		for i := range rollouts {
+			if rollouts[i].Name == ref.Name {
+				w.Rollout = models.GetRolloutInfo(&rollouts[i], &repset[iRs])
+				break
+			}
+		}
+	}
+}
+
 var controllerOrder = map[string]int{
 	"Deployment":            6,
 	"DeploymentConfig":      5,
@@ -2523,8 +2622,8 @@ func (in *WorkloadService) GetWorkloadTracingName(ctx context.Context, cluster,
 		tracingName.Lookup = workload
 		return tracingName, nil
 	}
-	appLabelName := in.config.IstioLabels.AppLabelName
-	app := wkd.Labels[appLabelName]
+	// The proxies report the canonical service as the tracing service name
+	app := models.CanonicalService(wkd.Labels, workload)
 	tracingName.App = app
 	tracingName.Lookup = app
 
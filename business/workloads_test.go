@@ -489,6 +489,63 @@ func TestGetWorkloadFromDeployment(t *testing.T) {
 	assert.Equal(true, workload.VersionLabel)
 }
 
+func TestGetWorkloadEvents(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	// Disabling CustomDashboards on Workload details testing
+	conf := config.NewConfig()
+	conf.ExternalServices.CustomDashboards.Enabled = false
+	kubernetes.SetConfig(t, *conf)
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	newEvent := func(name, kind, involvedName, reason string, last time.Time) *core_v1.Event {
+		return &core_v1.Event{
+			ObjectMeta:     v1.ObjectMeta{Name: name, Namespace: "Namespace"},
+			InvolvedObject: core_v1.ObjectReference{Kind: kind, Name: involvedName, Namespace: "Namespace"},
+			Type:           core_v1.EventTypeWarning,
+			Reason:         reason,
+			Count:          2,
+			FirstTimestamp: v1.NewTime(last.Add(-time.Minute)),
+			LastTimestamp:  v1.NewTime(last),
+		}
+	}
+
+	kubeObjs := []runtime.Object{
+		&osproject_v1.Project{ObjectMeta: v1.ObjectMeta{Name: "Namespace"}},
+		&FakeDepSyncedWithRS()[0],
+		newEvent("pod-event", "Pod", "details-v1-3618568057-dnkjp", "BackOff", now),
+		newEvent("rs-event", "ReplicaSet", "details-v1-3618568057", "FailedCreate", now.Add(-time.Hour)),
+		newEvent("deployment-event", "Deployment", "details-v1", "ProgressDeadlineExceeded", now.Add(time.Minute)),
+		newEvent("other-event", "Pod", "reviews-v1-1234", "BackOff", now),
+	}
+	for _, o := range FakeRSSyncedWithPods() {
+		kubeObjs = append(kubeObjs, &o)
+	}
+	for _, o := range FakePodsSyncedWithDeployments() {
+		// The pods must match the ReplicaSet template to be bound to the workload
+		o.Labels = FakeRSSyncedWithPods()[0].Spec.Template.Labels
+		kubeObjs = append(kubeObjs, &o)
+	}
+	k8s := kubetest.NewFakeK8sClient(kubeObjs...)
+	k8s.OpenShift = true
+	SetupBusinessLayer(t, k8s, *conf)
+	svc := setupWorkloadService(k8s, conf)
+
+	criteria := WorkloadCriteria{Cluster: conf.KubernetesConfig.ClusterName, Namespace: "Namespace", WorkloadName: "details-v1", WorkloadGVK: schema.GroupVersionKind{}, IncludeEvents: true}
+	workload, err := svc.GetWorkload(context.TODO(), criteria)
+	require.NoError(err)
+
+	require.Len(workload.Events, 3)
+	assert.Equal("ProgressDeadlineExceeded", workload.Events[0].Reason)
+	assert.Equal("Pod", workload.Events[1].Kind)
+	assert.Equal("details-v1-3618568057-dnkjp", workload.Events[1].Name)
+	assert.Equal(int32(2), workload.Events[1].Count)
+	assert.Equal("2024-01-01T12:00:00Z", workload.Events[1].LastTimestamp)
+	assert.Equal("2024-01-01T11:59:00Z", workload.Events[1].FirstTimestamp)
+	assert.Equal("FailedCreate", workload.Events[2].Reason)
+}
+
 func TestGetWorkloadFromWorkloadGroup(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -1126,6 +1183,100 @@ func TestGetWorkloadListRSOwnedByCustom(t *testing.T) {
 	assert.NotNil(workload)
 }
 
+func TestGetWorkloadListRSOwnedByRollout(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.CustomDashboards.Enabled = false
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	config.Set(conf)
+
+	controller := true
+	rolloutRef := v1.OwnerReference{
+		APIVersion: kubernetes.Rollouts.GroupVersion().String(),
+		Kind:       kubernetes.RolloutType,
+		Name:       "reviews",
+		Controller: &controller,
+	}
+	kubeObjs := []runtime.Object{kubetest.FakeNamespace("Namespace")}
+	for _, hash := range []string{"6cf4c7d5b9", "7d8e9f0a1b"} {
+		rsLabels := map[string]string{"app": "reviews", kubernetes.RolloutPodTemplateHashLabel: hash}
+		rs := &apps_v1.ReplicaSet{
+			ObjectMeta: v1.ObjectMeta{
+				Name:            "reviews-" + hash,
+				Namespace:       "Namespace",
+				Labels:          rsLabels,
+				OwnerReferences: []v1.OwnerReference{rolloutRef},
+			},
+			Spec: apps_v1.ReplicaSetSpec{
+				Template: core_v1.PodTemplateSpec{ObjectMeta: v1.ObjectMeta{Labels: rsLabels}},
+			},
+		}
+		pod := &core_v1.Pod{
+			ObjectMeta: v1.ObjectMeta{
+				Name:      rs.Name + "-abcde",
+				Namespace: "Namespace",
+				Labels:    rsLabels,
+				OwnerReferences: []v1.OwnerReference{{
+					APIVersion: kubernetes.ReplicaSets.GroupVersion().String(),
+					Kind:       kubernetes.ReplicaSetType,
+					Name:       rs.Name,
+					Controller: &controller,
+				}},
+			},
+		}
+		kubeObjs = append(kubeObjs, rs, pod)
+	}
+
+	k8s := kubetest.NewFakeK8sClient(kubeObjs...)
+	setWeight := int32(20)
+	currentStep := int32(1)
+	k8s.Rollouts = []kubernetes.Rollout{{
+		ObjectMeta: v1.ObjectMeta{Name: "reviews", Namespace: "Namespace"},
+		Spec: kubernetes.RolloutSpec{
+			Strategy: kubernetes.RolloutStrategy{
+				Canary: &kubernetes.RolloutCanaryStrategy{
+					Steps: []kubernetes.RolloutCanaryStep{{SetWeight: &setWeight}, {}},
+				},
+			},
+		},
+		Status: kubernetes.RolloutStatus{
+			CurrentPodHash:   "7d8e9f0a1b",
+			CurrentStepIndex: &currentStep,
+			StableRS:         "6cf4c7d5b9",
+		},
+	}}
+	SetupBusinessLayer(t, k8s, *conf)
+	svc := setupWorkloadService(k8s, conf)
+
+	criteria := WorkloadCriteria{Cluster: conf.KubernetesConfig.ClusterName, Namespace: "Namespace", IncludeIstioResources: false, IncludeHealth: false}
+	workloadList, err := svc.GetWorkloadList(context.TODO(), criteria)
+	require.NoError(err)
+	workloads := workloadList.Workloads
+	require.Len(workloads, 2)
+
+	stable := workloads[0]
+	assert.Equal("reviews-6cf4c7d5b9", stable.Name)
+	assert.Equal(kubernetes.ReplicaSets, stable.WorkloadGVK)
+	require.NotNil(stable.Rollout)
+	assert.Equal("reviews", stable.Rollout.Name)
+	assert.Equal(models.RolloutRoleStable, stable.Rollout.Role)
+	assert.Equal(int32(80), *stable.Rollout.Weight)
+
+	canary := workloads[1]
+	assert.Equal("reviews-7d8e9f0a1b", canary.Name)
+	require.NotNil(canary.Rollout)
+	assert.Equal(models.RolloutRoleCanary, canary.Rollout.Role)
+	assert.Equal(int32(20), *canary.Rollout.Weight)
+
+	criteria = WorkloadCriteria{Cluster: conf.KubernetesConfig.ClusterName, Namespace: "Namespace", WorkloadName: canary.Name, WorkloadGVK: kubernetes.ReplicaSets}
+	workload, err := svc.GetWorkload(context.TODO(), criteria)
+	require.NoError(err)
+	require.NotNil(workload.Rollout)
+	assert.Equal(models.RolloutRoleCanary, workload.Rollout.Role)
+}
+
 func TestGetPodLogsWithoutAccessLogs(t *testing.T) {
 	assert := assert.New(t)
 
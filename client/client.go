@@ -0,0 +1,326 @@
+// Package client is a typed Go client of the Kiali API, to consume Kiali programmatically.
+//
+// The client authenticates the requests according to the auth strategy of Kiali: with the "token" strategy
+// it logs in with the token and keeps the session cookie, with the "openshift" and "header" strategies it sends
+// the token as a Bearer token. No credentials are needed with the "anonymous" strategy.
+//
+//	c, err := client.New("https://kiali.example.com", client.WithToken(token))
+//	if err != nil {
+//		return err
+//	}
+//	services, err := c.ClustersServices(ctx, url.Values{"namespaces": {"bookinfo"}})
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/kiali/kiali/config"
+)
+
+// Client of the Kiali API. It is safe for concurrent use.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	token      string
+
+	// The auth strategy of Kiali, fetched on the first request
+	mu           sync.Mutex
+	authStrategy string
+	loggedIn     bool
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client sending the requests, i.e. to configure TLS or timeouts.
+// A cookie jar is added to it when it has none, as the "token" strategy relies on a session cookie.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken sets the token of the user, i.e. a service account token, used to authenticate the requests.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithAuthStrategy sets the auth strategy of Kiali instead of fetching it from the server.
+func WithAuthStrategy(strategy string) Option {
+	return func(c *Client) {
+		c.authStrategy = strategy
+	}
+}
+
+// New returns a client of the Kiali API served at kialiURL, i.e. "https://kiali.example.com" or
+// "http://localhost:20001/kiali" when Kiali is served under a web root.
+func New(kialiURL string, opts ...Option) (*Client, error) {
+	baseURL, err := url.Parse(strings.TrimSuffix(kialiURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Kiali URL [%s]: %w", kialiURL, err)
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid Kiali URL [%s]: the scheme must be http or https", kialiURL)
+	}
+
+	c := &Client{baseURL: baseURL, httpClient: &http.Client{}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.httpClient.Jar == nil {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return nil, err
+		}
+		httpClient := *c.httpClient
+		httpClient.Jar = jar
+		c.httpClient = &httpClient
+	}
+	return c, nil
+}
+
+// Error is the error returned by Kiali for a failed request.
+type Error struct {
+	StatusCode int
+	Message    string
+	Detail     string
+}
+
+func (e *Error) Error() string {
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+	if e.Detail != "" {
+		return fmt.Sprintf("kiali responded with status %d: %s: %s", e.StatusCode, message, e.Detail)
+	}
+	return fmt.Sprintf("kiali responded with status %d: %s", e.StatusCode, message)
+}
+
+// IsNotFound returns whether err is an Error of Kiali for a resource not found.
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+// AuthStrategy returns the auth strategy of Kiali, fetching it on the first call.
+func (c *Client) AuthStrategy(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getAuthStrategy(ctx)
+}
+
+func (c *Client) getAuthStrategy(ctx context.Context) (string, error) {
+	if c.authStrategy != "" {
+		return c.authStrategy, nil
+	}
+	info := struct {
+		Strategy string `json:"strategy"`
+	}{}
+	if _, err := c.send(ctx, http.MethodGet, "/api/auth/info", nil, nil, "", &info); err != nil {
+		return "", err
+	}
+	c.authStrategy = info.Strategy
+	return c.authStrategy, nil
+}
+
+// login opens a session with the token when Kiali uses the "token" strategy.
+func (c *Client) login(ctx context.Context) error {
+	form := url.Values{"token": {c.token}}
+	_, err := c.send(ctx, http.MethodPost, "/api/authenticate", nil, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded", nil)
+	if err != nil {
+		return fmt.Errorf("unable to log in to Kiali: %w", err)
+	}
+	c.loggedIn = true
+	return nil
+}
+
+// Logout closes the session of the client, if any.
+func (c *Client) Logout(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loggedIn = false
+	_, err := c.send(ctx, http.MethodGet, "/api/logout", nil, nil, "", nil)
+	return err
+}
+
+// authenticate prepares the authentication of the requests, logging in when needed.
+func (c *Client) authenticate(ctx context.Context) (bearer bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.token == "" {
+		return false, nil
+	}
+	strategy, err := c.getAuthStrategy(ctx)
+	if err != nil {
+		return false, err
+	}
+	switch strategy {
+	case config.AuthStrategyToken:
+		if !c.loggedIn {
+			return false, c.login(ctx)
+		}
+		return false, nil
+	case config.AuthStrategyAnonymous:
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// expireSession forgets the session of the "token" strategy for the client to log in again.
+// It returns false with the other strategies.
+func (c *Client) expireSession() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.authStrategy != config.AuthStrategyToken || c.token == "" {
+		return false
+	}
+	c.loggedIn = false
+	return true
+}
+
+// do sends an authenticated request to Kiali and decodes the JSON response into out, when not nil.
+// The body, when not nil, is sent as JSON unless it is a []byte, sent as it is.
+// With the "token" strategy, the client logs in again once when the session expired.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body interface{}, out interface{}) error {
+	var payload []byte
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		payload = b
+	default:
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	for retry := true; ; retry = false {
+		bearer, err := c.authenticate(ctx)
+		if err != nil {
+			return err
+		}
+		var reader io.Reader
+		if payload != nil {
+			reader = bytes.NewReader(payload)
+		}
+		var header http.Header
+		if bearer {
+			header = http.Header{"Authorization": {"Bearer " + c.token}}
+		}
+		resp, err := c.sendWithHeader(ctx, method, path, query, reader, "application/json", header)
+		if err == nil {
+			defer resp.Body.Close()
+			return decode(resp, out)
+		}
+		if e, ok := err.(*Error); retry && ok && e.StatusCode == http.StatusUnauthorized && c.expireSession() {
+			continue
+		}
+		return err
+	}
+}
+
+// stream sends an authenticated GET request to Kiali and returns the body of the response to be read by the caller.
+func (c *Client) stream(ctx context.Context, path string, query url.Values) (io.ReadCloser, error) {
+	bearer, err := c.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var header http.Header
+	if bearer {
+		header = http.Header{"Authorization": {"Bearer " + c.token}}
+	}
+	resp, err := c.sendWithHeader(ctx, http.MethodGet, path, query, nil, "", header)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// send sends a request without authentication and decodes the JSON response into out, when not nil.
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string, out interface{}) (*http.Response, error) {
+	resp, err := c.sendWithHeader(ctx, method, path, query, body, contentType, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return resp, decode(resp, out)
+}
+
+// sendWithHeader sends a request and returns the response when successful, or else an *Error.
+func (c *Client) sendWithHeader(ctx context.Context, method, path string, query url.Values, body io.Reader, contentType string, header http.Header) (*http.Response, error) {
+	u, err := url.Parse(c.baseURL.String() + path)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil && contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	e := &Error{StatusCode: resp.StatusCode}
+	data, _ := io.ReadAll(resp.Body)
+	response := struct {
+		Error  string `json:"error"`
+		Detail string `json:"detail"`
+	}{}
+	if json.Unmarshal(data, &response) == nil && response.Error != "" {
+		e.Message = response.Error
+		e.Detail = response.Detail
+	} else {
+		e.Message = strings.TrimSpace(string(data))
+	}
+	return nil, e
+}
+
+func decode(resp *http.Response, out interface{}) error {
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return fmt.Errorf("unable to decode the response of Kiali: %w", err)
+	}
+	return nil
+}
+
+// apiPath joins the segments of a path, escaping them as they are filled with parameters, i.e.
+// apiPath("api", "namespaces", namespace) for "/api/namespaces/{namespace}".
+func apiPath(segments ...string) string {
+	var sb strings.Builder
+	for _, s := range segments {
+		sb.WriteString("/")
+		sb.WriteString(url.PathEscape(s))
+	}
+	return sb.String()
+}
@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+func authInfoHandler(strategy string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"strategy": strategy})
+	}
+}
+
+func TestTokenStrategyLogsIn(t *testing.T) {
+	logins := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/auth/info", authInfoHandler(config.AuthStrategyToken))
+	mux.HandleFunc("/api/authenticate", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		if r.PostForm.Get("token") != "my-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		logins++
+		http.SetCookie(w, &http.Cookie{Name: "kiali-token", Value: "session", Path: "/"})
+	})
+	mux.HandleFunc("/api/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie("kiali-token"); err != nil || cookie.Value != "session" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode([]models.Namespace{{Name: "bookinfo"}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := New(server.URL, WithToken("my-token"))
+	require.NoError(t, err)
+
+	namespaces, err := c.Namespaces(context.Background())
+	require.NoError(t, err)
+	require.Len(t, namespaces, 1)
+	assert.Equal(t, "bookinfo", namespaces[0].Name)
+
+	_, err = c.Namespaces(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, logins)
+
+	// The session expired: the client logs in again
+	c.httpClient.Jar.SetCookies(&url.URL{Scheme: "http", Host: server.Listener.Addr().String()}, []*http.Cookie{{Name: "kiali-token", Value: "expired", Path: "/"}})
+	_, err = c.Namespaces(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, logins)
+}
+
+func TestTokenStrategyLoginFails(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/auth/info", authInfoHandler(config.AuthStrategyToken))
+	mux.HandleFunc("/api/authenticate", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"Token is not valid or is expired"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := New(server.URL, WithToken("bad-token"))
+	require.NoError(t, err)
+
+	_, err = c.Namespaces(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Token is not valid or is expired")
+}
+
+func TestBearerStrategies(t *testing.T) {
+	for _, strategy := range []string{config.AuthStrategyOpenshift, config.AuthStrategyHeader} {
+		t.Run(strategy, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/auth/info", authInfoHandler(strategy))
+			mux.HandleFunc("/api/namespaces", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+				_ = json.NewEncoder(w).Encode([]models.Namespace{})
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			c, err := New(server.URL, WithToken("my-token"))
+			require.NoError(t, err)
+
+			_, err = c.Namespaces(context.Background())
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAnonymousStrategy(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/namespaces", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		_ = json.NewEncoder(w).Encode([]models.Namespace{})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := New(server.URL, WithAuthStrategy(config.AuthStrategyAnonymous), WithToken("my-token"))
+	require.NoError(t, err)
+
+	_, err = c.Namespaces(context.Background())
+	require.NoError(t, err)
+}
+
+func TestErrorResponse(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/kiali/api/namespaces/bookinfo/services/reviews", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"Service not found","detail":"services \"reviews\" not found"}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := New(server.URL+"/kiali/", WithAuthStrategy(config.AuthStrategyAnonymous))
+	require.NoError(t, err)
+
+	_, err = c.ServiceDetails(context.Background(), "bookinfo", "reviews", nil)
+	require.Error(t, err)
+	assert.True(t, IsNotFound(err))
+	e := err.(*Error)
+	assert.Equal(t, "Service not found", e.Message)
+	assert.Equal(t, `services "reviews" not found`, e.Detail)
+}
+
+func TestPathAndQuery(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/namespaces/bookinfo/istio/networking.istio.io/v1/VirtualService/a%2Fb", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "east", r.URL.Query().Get("clusterName"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := New(server.URL, WithAuthStrategy(config.AuthStrategyAnonymous))
+	require.NoError(t, err)
+
+	err = c.DeleteIstioConfig(context.Background(), "bookinfo", kubernetes.VirtualServices, "a/b", url.Values{"clusterName": {"east"}})
+	require.NoError(t, err)
+}
+
+func TestMetricsDecoding(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/namespaces/bookinfo/services/reviews/metrics", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"request_count":[{"labels":{"app":"reviews"},"datapoints":[[1700000000,"1.5"],[1700000015.5,"2"]],"name":"request_count"}]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := New(server.URL, WithAuthStrategy(config.AuthStrategyAnonymous))
+	require.NoError(t, err)
+
+	metrics, err := c.ServiceMetrics(context.Background(), "bookinfo", "reviews", url.Values{"filters[]": {"request_count"}})
+	require.NoError(t, err)
+	require.Len(t, metrics["request_count"], 1)
+	datapoints := metrics["request_count"][0].Datapoints
+	require.Len(t, datapoints, 2)
+	assert.Equal(t, models.Datapoint{Timestamp: 1700000000000, Value: 1.5}, datapoints[0])
+	assert.Equal(t, models.Datapoint{Timestamp: 1700000015500, Value: 2}, datapoints[1])
+}
+
+func TestInvalidURL(t *testing.T) {
+	_, err := New("kiali.example.com")
+	require.Error(t, err)
+}
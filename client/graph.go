@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kiali/kiali/graph/config/cytoscape"
+	meshcyto "github.com/kiali/kiali/mesh/config/cytoscape"
+	"github.com/kiali/kiali/models"
+)
+
+// The queries of the graphs support "graphType", "duration", "queryTime", "appenders", "boxBy",
+// "includeIdleEdges", "injectServiceNodes", "responseTime", "throughputType" and "rateGrpc", "rateHttp" and
+// "rateTcp".
+
+// GraphNamespaces returns the graph of the traffic of namespaces. The query requires "namespaces".
+func (c *Client) GraphNamespaces(ctx context.Context, query url.Values) (*cytoscape.Config, error) {
+	return c.graph(ctx, "/api/namespaces/graph", query)
+}
+
+// GraphAggregate returns the graph of the traffic of the workloads of a namespace with a value of an aggregate.
+func (c *Client) GraphAggregate(ctx context.Context, namespace, aggregate, aggregateValue string, query url.Values) (*cytoscape.Config, error) {
+	return c.graph(ctx, apiPath("api", "namespaces", namespace, "aggregates", aggregate, aggregateValue, "graph"), query)
+}
+
+// GraphAggregateByService returns the graph of the traffic of the workloads of a namespace with a value of an
+// aggregate, limited to a service.
+func (c *Client) GraphAggregateByService(ctx context.Context, namespace, aggregate, aggregateValue, service string, query url.Values) (*cytoscape.Config, error) {
+	return c.graph(ctx, apiPath("api", "namespaces", namespace, "aggregates", aggregate, aggregateValue, service, "graph"), query)
+}
+
+// GraphApp returns the graph of the traffic of an application.
+func (c *Client) GraphApp(ctx context.Context, namespace, app string, query url.Values) (*cytoscape.Config, error) {
+	return c.graph(ctx, apiPath("api", "namespaces", namespace, "applications", app, "graph"), query)
+}
+
+// GraphAppVersion returns the graph of the traffic of a version of an application.
+func (c *Client) GraphAppVersion(ctx context.Context, namespace, app, version string, query url.Values) (*cytoscape.Config, error) {
+	return c.graph(ctx, apiPath("api", "namespaces", namespace, "applications", app, "versions", version, "graph"), query)
+}
+
+// GraphService returns the graph of the traffic of a service.
+func (c *Client) GraphService(ctx context.Context, namespace, service string, query url.Values) (*cytoscape.Config, error) {
+	return c.graph(ctx, apiPath("api", "namespaces", namespace, "services", service, "graph"), query)
+}
+
+// GraphWorkload returns the graph of the traffic of a workload.
+func (c *Client) GraphWorkload(ctx context.Context, namespace, workload string, query url.Values) (*cytoscape.Config, error) {
+	return c.graph(ctx, apiPath("api", "namespaces", namespace, "workloads", workload, "graph"), query)
+}
+
+func (c *Client) graph(ctx context.Context, path string, query url.Values) (*cytoscape.Config, error) {
+	graph := &cytoscape.Config{}
+	if err := c.do(ctx, http.MethodGet, path, query, nil, graph); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// MeshGraph returns the graph of the infrastructure of the mesh.
+func (c *Client) MeshGraph(ctx context.Context, query url.Values) (*meshcyto.Config, error) {
+	graph := &meshcyto.Config{}
+	if err := c.do(ctx, http.MethodGet, "/api/mesh/graph", query, nil, graph); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// MeshControlPlanes returns the control planes of the mesh accessible to the user.
+func (c *Client) MeshControlPlanes(ctx context.Context) ([]models.ControlPlane, error) {
+	controlPlanes := []models.ControlPlane{}
+	if err := c.do(ctx, http.MethodGet, "/api/mesh/controlplanes", nil, nil, &controlPlanes); err != nil {
+		return nil, err
+	}
+	return controlPlanes, nil
+}
@@ -0,0 +1,365 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/models"
+)
+
+// IstioConfigList returns the Istio objects of a namespace. The query supports "objects", "validate",
+// "labelSelector", "workloadSelector", "helmRelease", "since" and "clusterName".
+func (c *Client) IstioConfigList(ctx context.Context, namespace string, query url.Values) (*models.IstioConfigList, error) {
+	list := &models.IstioConfigList{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "istio"), query, nil, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// IstioConfigListAll returns the Istio objects of all the namespaces accessible to the user, with the same query
+// as IstioConfigList.
+func (c *Client) IstioConfigListAll(ctx context.Context, query url.Values) (*models.IstioConfigList, error) {
+	list := &models.IstioConfigList{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/config", query, nil, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// IstioConfigListByNamespace returns the Istio objects of each namespace of the query, or of each namespace accessible
+// to the user. The query supports "namespaces", "objects", "labelSelector", "workloadSelector", "validate" and
+// "clusterName". A namespace that cannot be listed holds its error instead of its list.
+func (c *Client) IstioConfigListByNamespace(ctx context.Context, query url.Values) (models.IstioConfigNamespaceMap, error) {
+	lists := models.IstioConfigNamespaceMap{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/config/namespaces", query, nil, &lists); err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+// IstioConfigListWindow returns a window of the sorted Istio objects of all the types, with the number of objects.
+// The query supports "offset", "limit", "sortBy", "sortOrder", "namespaces", "objects", "labelSelector",
+// "workloadSelector", "validate" and "clusterName".
+func (c *Client) IstioConfigListWindow(ctx context.Context, query url.Values) (*models.IstioConfigWindow, error) {
+	window := &models.IstioConfigWindow{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/config/window", query, nil, window); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// StreamIstioConfigList streams the Istio objects of a namespace, or of all the namespaces accessible to the user
+// when it is empty, calling fn for each line as it arrives. It supports the same query as IstioConfigList.
+func (c *Client) StreamIstioConfigList(ctx context.Context, namespace string, query url.Values, fn func(line models.IstioConfigStreamLine) error) error {
+	path := "/api/istio/config"
+	if namespace != "" {
+		path = apiPath("api", "namespaces", namespace, "istio")
+	}
+	q := url.Values{}
+	for name, values := range query {
+		q[name] = values
+	}
+	q.Set("format", "ndjson")
+
+	body, err := c.stream(ctx, path, q)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	decoder := json.NewDecoder(body)
+	for {
+		var line models.IstioConfigStreamLine
+		if err := decoder.Decode(&line); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("unable to decode the response of Kiali: %w", err)
+		}
+		if line.Error != "" {
+			return &Error{StatusCode: http.StatusOK, Message: line.Error}
+		}
+		if err := fn(line); err != nil {
+			return err
+		}
+	}
+}
+
+// IstioConfigDetails returns an Istio object. The query supports "validate", "help" and "clusterName".
+func (c *Client) IstioConfigDetails(ctx context.Context, namespace string, gvk schema.GroupVersionKind, object string, query url.Values) (*models.IstioConfigDetails, error) {
+	details := &models.IstioConfigDetails{}
+	if err := c.do(ctx, http.MethodGet, istioObjectPath(namespace, gvk, object), query, nil, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// CreateIstioConfig creates an Istio object from its JSON definition.
+func (c *Client) CreateIstioConfig(ctx context.Context, namespace string, gvk schema.GroupVersionKind, object []byte, query url.Values) (*models.IstioConfigDetails, error) {
+	details := &models.IstioConfigDetails{}
+	path := apiPath("api", "namespaces", namespace, "istio", gvk.Group, gvk.Version, gvk.Kind)
+	if err := c.do(ctx, http.MethodPost, path, query, object, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// UpdateIstioConfig patches an Istio object with a JSON merge patch.
+func (c *Client) UpdateIstioConfig(ctx context.Context, namespace string, gvk schema.GroupVersionKind, object string, patch []byte, query url.Values) (*models.IstioConfigDetails, error) {
+	details := &models.IstioConfigDetails{}
+	if err := c.do(ctx, http.MethodPatch, istioObjectPath(namespace, gvk, object), query, patch, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// DeleteIstioConfig deletes an Istio object. The query supports "cascade" and "clusterName".
+func (c *Client) DeleteIstioConfig(ctx context.Context, namespace string, gvk schema.GroupVersionKind, object string, query url.Values) error {
+	return c.do(ctx, http.MethodDelete, istioObjectPath(namespace, gvk, object), query, nil, nil)
+}
+
+// IstioConfigPermissions returns the permissions of the user on the Istio objects of the namespaces.
+// The query supports "namespaces" and "clusterName".
+func (c *Client) IstioConfigPermissions(ctx context.Context, query url.Values) (models.IstioConfigPermissions, error) {
+	permissions := models.IstioConfigPermissions{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/permissions", query, nil, &permissions); err != nil {
+		return nil, err
+	}
+	return permissions, nil
+}
+
+// IstioConfigHostReferences returns the Istio objects referencing a host. The query requires "host" and supports
+// "namespace" and "clusterName".
+func (c *Client) IstioConfigHostReferences(ctx context.Context, query url.Values) (*models.HostReferences, error) {
+	references := &models.HostReferences{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/hosts", query, nil, references); err != nil {
+		return nil, err
+	}
+	return references, nil
+}
+
+// Search returns the apps, services, workloads and Istio objects whose name contains a text. The query requires "q"
+// and supports "kinds", "namespaces", "clusterName" and "limit".
+func (c *Client) Search(ctx context.Context, query url.Values) (*models.SearchResults, error) {
+	results := &models.SearchResults{}
+	if err := c.do(ctx, http.MethodGet, "/api/search", query, nil, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// IstioGatewayServers returns the servers of the Istio Gateways by host and port. The query supports "clusterName".
+func (c *Client) IstioGatewayServers(ctx context.Context, query url.Values) (*models.GatewayServerMap, error) {
+	servers := &models.GatewayServerMap{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/gateways/servers", query, nil, servers); err != nil {
+		return nil, err
+	}
+	return servers, nil
+}
+
+// IstioConfigSnapshots returns the snapshots of the Istio objects. The query supports "namespace" and "clusterName".
+func (c *Client) IstioConfigSnapshots(ctx context.Context, query url.Values) ([]models.IstioConfigSnapshotSummary, error) {
+	snapshots := []models.IstioConfigSnapshotSummary{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/snapshots", query, nil, &snapshots); err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// IstioConfigSnapshot returns a snapshot of the Istio objects.
+func (c *Client) IstioConfigSnapshot(ctx context.Context, snapshot string) (*models.IstioConfigSnapshot, error) {
+	result := &models.IstioConfigSnapshot{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "istio", "snapshots", snapshot), nil, nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RestoreIstioConfigSnapshot creates again the objects of a snapshot that don't exist anymore.
+func (c *Client) RestoreIstioConfigSnapshot(ctx context.Context, snapshot string) (*models.IstioConfigSnapshotRestore, error) {
+	result := &models.IstioConfigSnapshotRestore{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "istio", "snapshots", snapshot, "restore"), nil, nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeletedIstioConfig returns the Istio objects whose deletion can still be undone. The query supports "namespace"
+// and "clusterName".
+func (c *Client) DeletedIstioConfig(ctx context.Context, query url.Values) ([]models.DeletedIstioConfig, error) {
+	deleted := []models.DeletedIstioConfig{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/deleted", query, nil, &deleted); err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// UndoIstioConfigDelete creates again a deleted Istio object, while its undo window is not expired.
+func (c *Client) UndoIstioConfigDelete(ctx context.Context, deleted string) (*models.DeletedIstioConfigRestore, error) {
+	result := &models.DeletedIstioConfigRestore{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "istio", "deleted", deleted, "undo"), nil, nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IstioConfigDiff compares the Istio objects of two namespaces. The query requires "sourceCluster",
+// "sourceNamespace", "targetCluster" and "targetNamespace", and supports "objects" and "labelSelector".
+func (c *Client) IstioConfigDiff(ctx context.Context, query url.Values) (*models.IstioConfigDiff, error) {
+	diff := &models.IstioConfigDiff{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/diff", query, nil, diff); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
+// IstioConfigDeleteCheck checks the Istio objects of a cluster about to be deleted.
+func (c *Client) IstioConfigDeleteCheck(ctx context.Context, request models.IstioConfigDeleteCheckRequest, query url.Values) ([]models.IstioConfigDeleteCheck, error) {
+	checks := []models.IstioConfigDeleteCheck{}
+	if err := c.do(ctx, http.MethodPost, "/api/istio/delete/check", query, request, &checks); err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
+
+// ApplyIstioConfigBundle creates the Istio objects of a bundle in a namespace.
+func (c *Client) ApplyIstioConfigBundle(ctx context.Context, namespace string, bundle models.IstioConfigBundle, query url.Values) (*models.IstioConfigBundleApply, error) {
+	result := &models.IstioConfigBundleApply{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "namespaces", namespace, "istio", "bundle"), query, bundle, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IstioConfigActivity returns the recent changes of the Istio objects of a namespace. The query supports
+// "since", "limit" and "clusterName".
+func (c *Client) IstioConfigActivity(ctx context.Context, namespace string, query url.Values) ([]models.IstioConfigEvent, error) {
+	events := []models.IstioConfigEvent{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "istio", "activity"), query, nil, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// IstioConfigChurn returns the rate of the changes of the Istio config of the namespaces of a cluster. The query
+// supports "window" and "clusterName".
+func (c *Client) IstioConfigChurn(ctx context.Context, query url.Values) (*models.IstioConfigChurn, error) {
+	churn := &models.IstioConfigChurn{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "istio", "churn"), query, nil, churn); err != nil {
+		return nil, err
+	}
+	return churn, nil
+}
+
+// StreamIstioConfigActivity follows the changes of the Istio objects of a namespace as server-sent events, calling fn
+// for each change as it arrives, oldest first. The changes after "since" are sent first. The stream lasts until the
+// context is done or the connection is lost: it is resumed with the time of the last change as "since". The query
+// supports "since" and "clusterName".
+func (c *Client) StreamIstioConfigActivity(ctx context.Context, namespace string, query url.Values, fn func(event models.IstioConfigEvent) error) error {
+	q := url.Values{}
+	for name, values := range query {
+		q[name] = values
+	}
+	q.Set("format", "sse")
+
+	body, err := c.stream(ctx, apiPath("api", "namespaces", namespace, "istio", "activity"), q)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		// The other fields and the comments are not needed
+		data, found := strings.CutPrefix(scanner.Text(), "data: ")
+		if !found {
+			continue
+		}
+		var event models.IstioConfigEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return fmt.Errorf("unable to decode the response of Kiali: %w", err)
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// IstioConfigOrphans returns the orphaned Istio objects of a namespace. The query supports "trafficWindow"
+// and "clusterName".
+func (c *Client) IstioConfigOrphans(ctx context.Context, namespace string, query url.Values) (*models.OrphanReport, error) {
+	report := &models.OrphanReport{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "istio", "orphans"), query, nil, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// DeleteIstioConfigOrphans deletes the requested Istio objects of a namespace that are still orphaned.
+func (c *Client) DeleteIstioConfigOrphans(ctx context.Context, namespace string, request models.OrphanCleanupRequest, query url.Values) (*models.OrphanCleanupResult, error) {
+	result := &models.OrphanCleanupResult{}
+	if err := c.do(ctx, http.MethodDelete, apiPath("api", "namespaces", namespace, "istio", "orphans"), query, request, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// NamespaceTelemetryDiagnostics returns the diagnostics of the telemetry of a namespace.
+func (c *Client) NamespaceTelemetryDiagnostics(ctx context.Context, namespace string, query url.Values) (*models.TelemetryDiagnostics, error) {
+	diagnostics := &models.TelemetryDiagnostics{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "diagnostics", "telemetry"), query, nil, diagnostics); err != nil {
+		return nil, err
+	}
+	return diagnostics, nil
+}
+
+// GatewayTraffic returns the traffic entering the mesh through a Gateway.
+func (c *Client) GatewayTraffic(ctx context.Context, namespace, gateway string, query url.Values) (*models.IngressTraffic, error) {
+	traffic := &models.IngressTraffic{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "istio", "gateways", gateway, "traffic"), query, nil, traffic); err != nil {
+		return nil, err
+	}
+	return traffic, nil
+}
+
+// DestinationRuleTrafficPolicies returns how the traffic policy of a DestinationRule combines with the defaults
+// of its namespace and of the mesh.
+func (c *Client) DestinationRuleTrafficPolicies(ctx context.Context, namespace, destinationRule string, query url.Values) (*models.DestinationRuleTrafficPolicies, error) {
+	policies := &models.DestinationRuleTrafficPolicies{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "istio", "destinationrules", destinationRule, "trafficpolicies"), query, nil, policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// VirtualServiceCors returns the effective CORS behavior of the routes of a VirtualService. The query supports
+// "route", "origin" and "clusterName".
+func (c *Client) VirtualServiceCors(ctx context.Context, namespace, virtualService string, query url.Values) (*models.VirtualServiceCors, error) {
+	cors := &models.VirtualServiceCors{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "istio", "virtualservices", virtualService, "cors"), query, nil, cors); err != nil {
+		return nil, err
+	}
+	return cors, nil
+}
+
+// WasmPluginStatus returns whether the module of a WasmPlugin is reachable and the workloads it attaches to.
+func (c *Client) WasmPluginStatus(ctx context.Context, namespace, wasmPlugin string, query url.Values) (*models.WasmPluginStatus, error) {
+	status := &models.WasmPluginStatus{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "istio", "wasmplugins", wasmPlugin, "status"), query, nil, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func istioObjectPath(namespace string, gvk schema.GroupVersionKind, object string) string {
+	return apiPath("api", "namespaces", namespace, "istio", gvk.Group, gvk.Version, gvk.Kind, object)
+}
@@ -0,0 +1,279 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kiali/kiali/models"
+)
+
+// The queries of the metrics and dashboards support "rateInterval", "step", "duration", "queryTime", "filters[]",
+// "byLabels[]", "quantiles[]", "direction", "reporter", "requestProtocol" and "clusterName".
+
+// ServiceMetrics returns the metrics of a service.
+func (c *Client) ServiceMetrics(ctx context.Context, namespace, service string, query url.Values) (models.MetricsMap, error) {
+	return c.metrics(ctx, apiPath("api", "namespaces", namespace, "services", service, "metrics"), query)
+}
+
+// AppMetrics returns the metrics of an application.
+func (c *Client) AppMetrics(ctx context.Context, namespace, app string, query url.Values) (models.MetricsMap, error) {
+	return c.metrics(ctx, apiPath("api", "namespaces", namespace, "apps", app, "metrics"), query)
+}
+
+// WorkloadMetrics returns the metrics of a workload.
+func (c *Client) WorkloadMetrics(ctx context.Context, namespace, workload string, query url.Values) (models.MetricsMap, error) {
+	return c.metrics(ctx, apiPath("api", "namespaces", namespace, "workloads", workload, "metrics"), query)
+}
+
+// AggregateMetrics returns the metrics of the workloads of a namespace with a value of an aggregate, i.e. a label.
+func (c *Client) AggregateMetrics(ctx context.Context, namespace, aggregate, aggregateValue string, query url.Values) (models.MetricsMap, error) {
+	return c.metrics(ctx, apiPath("api", "namespaces", namespace, "aggregates", aggregate, aggregateValue, "metrics"), query)
+}
+
+// ControlPlaneMetrics returns the metrics of a control plane.
+func (c *Client) ControlPlaneMetrics(ctx context.Context, namespace, controlPlane string, query url.Values) (models.MetricsMap, error) {
+	return c.metrics(ctx, apiPath("api", "namespaces", namespace, "controlplanes", controlPlane, "metrics"), query)
+}
+
+// NamespaceMetrics returns the metrics of a namespace.
+func (c *Client) NamespaceMetrics(ctx context.Context, namespace string, query url.Values) (models.MetricsMap, error) {
+	return c.metrics(ctx, apiPath("api", "namespaces", namespace, "metrics"), query)
+}
+
+// ClustersMetrics returns the metrics of the namespaces, by namespace. The query requires "namespaces".
+func (c *Client) ClustersMetrics(ctx context.Context, query url.Values) (models.MetricsPerNamespace, error) {
+	metrics := models.MetricsPerNamespace{}
+	if err := c.do(ctx, http.MethodGet, "/api/clusters/metrics", query, nil, &metrics); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// MetricsStats computes statistics on the metrics of the targets of the queries.
+func (c *Client) MetricsStats(ctx context.Context, queries models.MetricsStatsQueries) (*models.MetricsStatsResult, error) {
+	result := &models.MetricsStatsResult{}
+	if err := c.do(ctx, http.MethodPost, "/api/stats/metrics", nil, queries, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// MetricsBatch returns the key inbound stats of many services and workloads of a namespace. The query supports
+// "clusterName".
+func (c *Client) MetricsBatch(ctx context.Context, namespace string, batch models.MetricsBatchQuery, query url.Values) (*models.MetricsBatch, error) {
+	result := &models.MetricsBatch{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "namespaces", namespace, "metrics:batch"), query, batch, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *Client) metrics(ctx context.Context, path string, query url.Values) (models.MetricsMap, error) {
+	metrics := models.MetricsMap{}
+	if err := c.do(ctx, http.MethodGet, path, query, nil, &metrics); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// ServiceDashboard returns the dashboard of the metrics of a service.
+func (c *Client) ServiceDashboard(ctx context.Context, namespace, service string, query url.Values) (*models.MonitoringDashboard, error) {
+	return c.dashboard(ctx, apiPath("api", "namespaces", namespace, "services", service, "dashboard"), query)
+}
+
+// AppDashboard returns the dashboard of the metrics of an application.
+func (c *Client) AppDashboard(ctx context.Context, namespace, app string, query url.Values) (*models.MonitoringDashboard, error) {
+	return c.dashboard(ctx, apiPath("api", "namespaces", namespace, "apps", app, "dashboard"), query)
+}
+
+// WorkloadDashboard returns the dashboard of the metrics of a workload.
+func (c *Client) WorkloadDashboard(ctx context.Context, namespace, workload string, query url.Values) (*models.MonitoringDashboard, error) {
+	return c.dashboard(ctx, apiPath("api", "namespaces", namespace, "workloads", workload, "dashboard"), query)
+}
+
+// CustomDashboard returns a custom dashboard for the pods of a namespace. The query supports "labelsFilters",
+// "additionalLabels" and the ones of the metrics.
+func (c *Client) CustomDashboard(ctx context.Context, namespace, dashboard string, query url.Values) (*models.MonitoringDashboard, error) {
+	return c.dashboard(ctx, apiPath("api", "namespaces", namespace, "customdashboard", dashboard), query)
+}
+
+func (c *Client) dashboard(ctx context.Context, path string, query url.Values) (*models.MonitoringDashboard, error) {
+	dashboard := &models.MonitoringDashboard{}
+	if err := c.do(ctx, http.MethodGet, path, query, nil, dashboard); err != nil {
+		return nil, err
+	}
+	return dashboard, nil
+}
+
+// NamespaceTrends returns the trends of the traffic of a namespace.
+func (c *Client) NamespaceTrends(ctx context.Context, namespace string, query url.Values) (*models.Trends, error) {
+	trends := &models.Trends{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "trends"), query, nil, trends); err != nil {
+		return nil, err
+	}
+	return trends, nil
+}
+
+// ClustersHealth returns the health of the apps, services or workloads of the namespaces. The query supports
+// "namespaces", "type", "rateInterval", "queryTime" and "clusterName".
+func (c *Client) ClustersHealth(ctx context.Context, query url.Values) (*models.ClustersNamespaceHealth, error) {
+	health := &models.ClustersNamespaceHealth{}
+	if err := c.do(ctx, http.MethodGet, "/api/clusters/health", query, nil, health); err != nil {
+		return nil, err
+	}
+	return health, nil
+}
+
+// StreamNamespaceHealthEvents follows the transitions of the health status of the apps of a namespace as server-sent
+// events, calling fn for each transition as it arrives. The first transitions report the current status of every app.
+// The query supports "interval", "rateInterval" and "clusterName".
+func (c *Client) StreamNamespaceHealthEvents(ctx context.Context, namespace string, query url.Values, fn func(transition models.AppHealthTransition) error) error {
+	body, err := c.stream(ctx, apiPath("api", "namespaces", namespace, "health", "events"), query)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	scanner := bufio.NewScanner(body)
+	for scanner.Scan() {
+		// The other fields and the comments are not needed
+		data, found := strings.CutPrefix(scanner.Text(), "data: ")
+		if !found {
+			continue
+		}
+		var transition models.AppHealthTransition
+		if err := json.Unmarshal([]byte(data), &transition); err != nil {
+			return fmt.Errorf("unable to decode the response of Kiali: %w", err)
+		}
+		if err := fn(transition); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// NamespaceSLO returns the status of the SLOs of the services of a namespace.
+func (c *Client) NamespaceSLO(ctx context.Context, namespace string, query url.Values) (models.SLOStatuses, error) {
+	statuses := models.SLOStatuses{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "slo"), query, nil, &statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// ServiceSLO returns the status of the SLO of a service.
+func (c *Client) ServiceSLO(ctx context.Context, namespace, service string, query url.Values) (*models.SLOStatus, error) {
+	status := &models.SLOStatus{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "services", service, "slo"), query, nil, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// NamespaceTrafficFindings returns the deviations of the traffic of a namespace from its baseline.
+func (c *Client) NamespaceTrafficFindings(ctx context.Context, namespace string, query url.Values) (models.TrafficFindings, error) {
+	findings := models.TrafficFindings{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "traffic", "findings"), query, nil, &findings); err != nil {
+		return nil, err
+	}
+	return findings, nil
+}
+
+// NamespaceEgressReport returns the traffic leaving the mesh from a namespace.
+func (c *Client) NamespaceEgressReport(ctx context.Context, namespace string, query url.Values) (*models.EgressReport, error) {
+	report := &models.EgressReport{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "traffic", "egress"), query, nil, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// NamespaceDNSCapture returns the DNS capture of the workloads of a namespace and the addresses resolved by their
+// sidecars for the hosts of the ServiceEntries.
+func (c *Client) NamespaceDNSCapture(ctx context.Context, namespace string, query url.Values) (*models.DNSCaptureReport, error) {
+	report := &models.DNSCaptureReport{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "traffic", "egress", "dns"), query, nil, report); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// GenerateSidecar returns the namespace-wide Sidecar restricting the egress of a namespace to the destinations its
+// workloads sent traffic to. The query supports "trafficWindow" and "clusterName".
+func (c *Client) GenerateSidecar(ctx context.Context, namespace string, query url.Values) (*models.GeneratedSidecar, error) {
+	generated := &models.GeneratedSidecar{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "traffic", "egress", "sidecar"), query, nil, generated); err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+// ApplyGeneratedSidecar creates or updates the namespace-wide Sidecar restricting the egress of a namespace to the
+// destinations its workloads sent traffic to. The query supports "trafficWindow" and "clusterName".
+func (c *Client) ApplyGeneratedSidecar(ctx context.Context, namespace string, query url.Values) (*models.GeneratedSidecar, error) {
+	generated := &models.GeneratedSidecar{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "namespaces", namespace, "traffic", "egress", "sidecar"), query, nil, generated); err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+// NamespaceValidationSummary returns the summary of the validations of the Istio objects of a namespace.
+func (c *Client) NamespaceValidationSummary(ctx context.Context, namespace string, query url.Values) (*models.IstioValidationSummary, error) {
+	summary := &models.IstioValidationSummary{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "validations"), query, nil, summary); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// ConfigValidationSummary returns the summaries of the validations of the Istio objects of the namespaces.
+// The query requires "namespaces" and supports "clusterName".
+func (c *Client) ConfigValidationSummary(ctx context.Context, query url.Values) ([]models.IstioValidationSummary, error) {
+	summaries := []models.IstioValidationSummary{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/validations", query, nil, &summaries); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// MeshTls returns the mTLS status of the mesh.
+func (c *Client) MeshTls(ctx context.Context, query url.Values) (*models.MTLSStatus, error) {
+	status := &models.MTLSStatus{}
+	if err := c.do(ctx, http.MethodGet, "/api/mesh/tls", query, nil, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// MeshTlsOverview returns the overview of the mTLS of the mesh.
+func (c *Client) MeshTlsOverview(ctx context.Context, query url.Values) (*models.MTLSOverview, error) {
+	overview := &models.MTLSOverview{}
+	if err := c.do(ctx, http.MethodGet, "/api/mesh/tls/overview", query, nil, overview); err != nil {
+		return nil, err
+	}
+	return overview, nil
+}
+
+// NamespaceTls returns the mTLS status of a namespace.
+func (c *Client) NamespaceTls(ctx context.Context, namespace string, query url.Values) (*models.MTLSStatus, error) {
+	status := &models.MTLSStatus{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "tls"), query, nil, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// ClustersTls returns the mTLS status of the namespaces. The query supports "namespaces" and "clusterName".
+func (c *Client) ClustersTls(ctx context.Context, query url.Values) ([]models.MTLSStatus, error) {
+	statuses := []models.MTLSStatus{}
+	if err := c.do(ctx, http.MethodGet, "/api/clusters/tls", query, nil, &statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
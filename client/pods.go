@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// PodDetails returns a pod. The query supports "clusterName".
+func (c *Client) PodDetails(ctx context.Context, namespace, pod string, query url.Values) (*models.Pod, error) {
+	details := &models.Pod{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "pods", pod), query, nil, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// PodLogs returns the logs of a container of a pod, to be read and closed by the caller. The query requires
+// "container" and supports "sinceTime", "maxLines", "duration", "logType" and "clusterName".
+func (c *Client) PodLogs(ctx context.Context, namespace, pod string, query url.Values) (io.ReadCloser, error) {
+	return c.stream(ctx, apiPath("api", "namespaces", namespace, "pods", pod, "logs"), query)
+}
+
+// PodConfigDump returns the config dump of the proxy of a pod. The query supports "clusterName".
+func (c *Client) PodConfigDump(ctx context.Context, namespace, pod string, query url.Values) (*models.EnvoyProxyDump, error) {
+	return c.configDump(ctx, apiPath("api", "namespaces", namespace, "pods", pod, "config_dump"), query)
+}
+
+// PodConfigDumpResource returns a resource of the config dump of the proxy of a pod, i.e. "clusters", "listeners"
+// or "routes". The query supports "clusterName".
+func (c *Client) PodConfigDumpResource(ctx context.Context, namespace, pod, resource string, query url.Values) (*models.EnvoyProxyDump, error) {
+	return c.configDump(ctx, apiPath("api", "namespaces", namespace, "pods", pod, "config_dump", resource), query)
+}
+
+func (c *Client) configDump(ctx context.Context, path string, query url.Values) (*models.EnvoyProxyDump, error) {
+	dump := &models.EnvoyProxyDump{}
+	if err := c.do(ctx, http.MethodGet, path, query, nil, dump); err != nil {
+		return nil, err
+	}
+	return dump, nil
+}
+
+// ZtunnelConfigDump returns the config dump of a ztunnel pod. The query supports "clusterName".
+func (c *Client) ZtunnelConfigDump(ctx context.Context, namespace, pod string, query url.Values) (*kubernetes.ZtunnelConfigDump, error) {
+	dump := &kubernetes.ZtunnelConfigDump{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "pods", pod, "config_dump_ztunnel"), query, nil, dump); err != nil {
+		return nil, err
+	}
+	return dump, nil
+}
+
+// VerifyPodRoutes checks whether the last change of a VirtualService is live in the proxy of a pod. The query
+// requires "virtualService" and "virtualServiceNamespace", and supports "clusterName".
+func (c *Client) VerifyPodRoutes(ctx context.Context, namespace, pod string, query url.Values) (*models.EnvoyRouteVerification, error) {
+	verification := &models.EnvoyRouteVerification{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "pods", pod, "routes", "verify"), query, nil, verification); err != nil {
+		return nil, err
+	}
+	return verification, nil
+}
+
+// PodProxyLogLevels returns the log levels of the proxy of a pod. The query supports "clusterName".
+func (c *Client) PodProxyLogLevels(ctx context.Context, namespace, pod string, query url.Values) (*models.ProxyLogLevels, error) {
+	levels := &models.ProxyLogLevels{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "pods", pod, "logging"), query, nil, levels); err != nil {
+		return nil, err
+	}
+	return levels, nil
+}
+
+// SetPodProxyLogLevel sets the log level of the proxy of a pod, i.e. "debug". The query supports "clusterName".
+func (c *Client) SetPodProxyLogLevel(ctx context.Context, namespace, pod, level string, query url.Values) error {
+	q := url.Values{}
+	for name, values := range query {
+		q[name] = values
+	}
+	q.Set("level", level)
+	return c.do(ctx, http.MethodPost, apiPath("api", "namespaces", namespace, "pods", pod, "logging"), q, nil, nil)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/kiali/kiali/models"
+)
+
+// CreateNamespaceReport starts the generation of the report of the mesh status of a namespace. The query supports
+// "format" (html, json or pdf), "duration" and "clusterName". The report is downloaded with ReportContent once the job
+// succeeded.
+func (c *Client) CreateNamespaceReport(ctx context.Context, namespace string, query url.Values) (*models.ReportJob, error) {
+	job := &models.ReportJob{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "namespaces", namespace, "reports"), query, nil, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// CreateValidationsRun starts the validation of the Istio config of the namespaces of a cluster. The query supports
+// "namespaces" and "clusterName". The progress of each namespace is part of the job, and the validations are
+// downloaded as JSON with ReportContent once the job succeeded.
+func (c *Client) CreateValidationsRun(ctx context.Context, query url.Values) (*models.ReportJob, error) {
+	job := &models.ReportJob{}
+	if err := c.do(ctx, http.MethodPost, "/api/istio/validations/runs", query, nil, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// ReportJobs returns the report jobs of the user, the newest first.
+func (c *Client) ReportJobs(ctx context.Context) ([]models.ReportJob, error) {
+	jobs := []models.ReportJob{}
+	if err := c.do(ctx, http.MethodGet, "/api/reports", nil, nil, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// ReportJob returns a report job of the user.
+func (c *Client) ReportJob(ctx context.Context, report string) (*models.ReportJob, error) {
+	job := &models.ReportJob{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "reports", report), nil, nil, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// ReportContent returns the report generated by a job of the user, to be closed by the caller.
+func (c *Client) ReportContent(ctx context.Context, report string) (io.ReadCloser, error) {
+	return c.stream(ctx, apiPath("api", "reports", report, "content"), nil)
+}
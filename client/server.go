@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kiali/kiali/handlers"
+	"github.com/kiali/kiali/handlers/authentication"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/status"
+)
+
+// Status returns the status of Kiali and of the external services it uses.
+func (c *Client) Status(ctx context.Context) (*status.StatusInfo, error) {
+	info := &status.StatusInfo{}
+	if err := c.do(ctx, http.MethodGet, "/api/status", nil, nil, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// Config returns the configuration of Kiali exposed to its clients.
+func (c *Client) Config(ctx context.Context) (*handlers.PublicConfig, error) {
+	conf := &handlers.PublicConfig{}
+	if err := c.do(ctx, http.MethodGet, "/api/config", nil, nil, conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// CrippledFeatures returns the features of Kiali that are unavailable, i.e. because of missing metrics.
+func (c *Client) CrippledFeatures(ctx context.Context) (*handlers.KialiCrippledFeatures, error) {
+	features := &handlers.KialiCrippledFeatures{}
+	if err := c.do(ctx, http.MethodGet, "/api/crippled", nil, nil, features); err != nil {
+		return nil, err
+	}
+	return features, nil
+}
+
+// AuthInfo returns the auth strategy of Kiali and the session of the client.
+func (c *Client) AuthInfo(ctx context.Context) (*handlers.AuthInfo, error) {
+	info := &handlers.AuthInfo{}
+	if err := c.do(ctx, http.MethodGet, "/api/auth/info", nil, nil, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// Sessions returns the active sessions of the user. The query supports "all" for the session admins.
+func (c *Client) Sessions(ctx context.Context, query url.Values) ([]authentication.SessionInfo, error) {
+	sessions := []authentication.SessionInfo{}
+	if err := c.do(ctx, http.MethodGet, "/api/sessions", query, nil, &sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// RevokeSession revokes a session of the user.
+func (c *Client) RevokeSession(ctx context.Context, session string) error {
+	return c.do(ctx, http.MethodDelete, apiPath("api", "sessions", session), nil, nil, nil)
+}
+
+// RevokeAllSessions revokes the sessions of all the users. Only the session admins can do it.
+func (c *Client) RevokeAllSessions(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, "/api/sessions", nil, nil, nil)
+}
+
+// TimePin returns the query time pinned for the session of the client.
+func (c *Client) TimePin(ctx context.Context) (*authentication.TimePin, error) {
+	pin := &authentication.TimePin{}
+	if err := c.do(ctx, http.MethodGet, "/api/sessions/current/pin", nil, nil, pin); err != nil {
+		return nil, err
+	}
+	return pin, nil
+}
+
+// PinTime pins the query time of the session of the client. The query supports "queryTime" and "ttl".
+func (c *Client) PinTime(ctx context.Context, query url.Values) (*authentication.TimePin, error) {
+	pin := &authentication.TimePin{}
+	if err := c.do(ctx, http.MethodPut, "/api/sessions/current/pin", query, nil, pin); err != nil {
+		return nil, err
+	}
+	return pin, nil
+}
+
+// UnpinTime removes the query time pinned for the session of the client.
+func (c *Client) UnpinTime(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, "/api/sessions/current/pin", nil, nil, nil)
+}
+
+// RefreshTokenCaches clears the namespaces and permissions cached for all the users. Only the cache admins can do it.
+func (c *Client) RefreshTokenCaches(ctx context.Context) error {
+	return c.do(ctx, http.MethodPost, "/api/cache/refresh", nil, nil, nil)
+}
+
+// CacheStats returns the state of the informers of the Kiali cache of every cluster. Only the cache admins can get it.
+func (c *Client) CacheStats(ctx context.Context) ([]models.KubeCacheStats, error) {
+	stats := []models.KubeCacheStats{}
+	if err := c.do(ctx, http.MethodGet, "/api/cache/stats", nil, nil, &stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// NotificationDeadLetters returns the notifications that failed all their delivery attempts, the most recent first.
+// Only the notification admins can list them.
+func (c *Client) NotificationDeadLetters(ctx context.Context) ([]models.Notification, error) {
+	deadLetters := []models.Notification{}
+	if err := c.do(ctx, http.MethodGet, "/api/notifications/deadletters", nil, nil, &deadLetters); err != nil {
+		return nil, err
+	}
+	return deadLetters, nil
+}
+
+// RetryNotificationDeadLetter queues a dead letter again for the next delivery. Only the notification admins can do it.
+func (c *Client) RetryNotificationDeadLetter(ctx context.Context, id string) (*models.Notification, error) {
+	notification := &models.Notification{}
+	if err := c.do(ctx, http.MethodPost, "/api/notifications/deadletters/"+url.PathEscape(id)+"/retry", nil, nil, notification); err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+// ExternalServicesConnections checks the connections to the external services, or to the given one when not empty.
+// Only the external service admins can do it.
+func (c *Client) ExternalServicesConnections(ctx context.Context, service string) ([]models.ExternalServiceConnection, error) {
+	path := "/api/status/connections"
+	if service != "" {
+		path += "/" + url.PathEscape(service)
+	}
+	connections := []models.ExternalServiceConnection{}
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &connections); err != nil {
+		return nil, err
+	}
+	return connections, nil
+}
+
+// IstioStatus returns the status of the Istio components.
+func (c *Client) IstioStatus(ctx context.Context, query url.Values) (kubernetes.IstioComponentStatus, error) {
+	components := kubernetes.IstioComponentStatus{}
+	if err := c.do(ctx, http.MethodGet, "/api/istio/status", query, nil, &components); err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// GrafanaInfo returns the links to the Grafana dashboards.
+func (c *Client) GrafanaInfo(ctx context.Context) (*models.GrafanaInfo, error) {
+	info := &models.GrafanaInfo{}
+	if err := c.do(ctx, http.MethodGet, "/api/grafana", nil, nil, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// TracingInfo returns the configuration of the tracing service.
+func (c *Client) TracingInfo(ctx context.Context) (*models.TracingInfo, error) {
+	info := &models.TracingInfo{}
+	if err := c.do(ctx, http.MethodGet, "/api/tracing", nil, nil, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
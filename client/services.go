@@ -0,0 +1,228 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/kiali/kiali/models"
+)
+
+// Namespaces returns the namespaces accessible to the user.
+func (c *Client) Namespaces(ctx context.Context) ([]models.Namespace, error) {
+	namespaces := []models.Namespace{}
+	if err := c.do(ctx, http.MethodGet, "/api/namespaces", nil, nil, &namespaces); err != nil {
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// NamespaceInfo returns a namespace. The query supports "clusterName".
+func (c *Client) NamespaceInfo(ctx context.Context, namespace string, query url.Values) (*models.Namespace, error) {
+	ns := &models.Namespace{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "info"), query, nil, ns); err != nil {
+		return nil, err
+	}
+	return ns, nil
+}
+
+// UpdateNamespace patches a namespace with a JSON merge patch. The query supports "clusterName".
+func (c *Client) UpdateNamespace(ctx context.Context, namespace string, patch []byte, query url.Values) (*models.Namespace, error) {
+	ns := &models.Namespace{}
+	if err := c.do(ctx, http.MethodPatch, apiPath("api", "namespaces", namespace), query, patch, ns); err != nil {
+		return nil, err
+	}
+	return ns, nil
+}
+
+// ClustersServices returns the services of the namespaces. The query supports "namespaces", "health",
+// "istioResources", "onlyDefinitions", "rateInterval", "queryTime" and "clusterName".
+func (c *Client) ClustersServices(ctx context.Context, query url.Values) (*models.ClusterServices, error) {
+	services := &models.ClusterServices{}
+	if err := c.do(ctx, http.MethodGet, "/api/clusters/services", query, nil, services); err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+// ClustersServicesWindow returns a window of the sorted services of the namespaces, with the number of services. The
+// query supports "offset", "limit", "sortBy", "sortOrder" and the query of ClustersServices.
+func (c *Client) ClustersServicesWindow(ctx context.Context, query url.Values) (*models.ServiceWindow, error) {
+	window := &models.ServiceWindow{}
+	if err := c.do(ctx, http.MethodGet, "/api/clusters/services/window", query, nil, window); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// ServiceDetails returns a service. The query supports "validate", "rateInterval", "queryTime" and "clusterName".
+func (c *Client) ServiceDetails(ctx context.Context, namespace, service string, query url.Values) (*models.ServiceDetails, error) {
+	details := &models.ServiceDetails{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "services", service), query, nil, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// UpdateService patches a service. The query supports "patchType", "rateInterval", "queryTime" and "clusterName".
+func (c *Client) UpdateService(ctx context.Context, namespace, service string, patch []byte, query url.Values) (*models.ServiceDetails, error) {
+	details := &models.ServiceDetails{}
+	if err := c.do(ctx, http.MethodPatch, apiPath("api", "namespaces", namespace, "services", service), query, patch, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// ServiceEffectiveConfig returns the Istio config applied to the traffic of a service. The query supports
+// "clientNamespace" and "clusterName".
+func (c *Client) ServiceEffectiveConfig(ctx context.Context, namespace, service string, query url.Values) (*models.ServiceEffectiveConfig, error) {
+	effective := &models.ServiceEffectiveConfig{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "services", service, "effectiveconfig"), query, nil, effective); err != nil {
+		return nil, err
+	}
+	return effective, nil
+}
+
+// ServiceSubsets previews the DestinationRule subsets generated for the versions of the workloads of a service.
+// The query supports "clusterName".
+func (c *Client) ServiceSubsets(ctx context.Context, namespace, service string, query url.Values) (*models.ServiceSubsets, error) {
+	subsets := &models.ServiceSubsets{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "services", service, "subsets"), query, nil, subsets); err != nil {
+		return nil, err
+	}
+	return subsets, nil
+}
+
+// ApplyServiceSubsets creates or updates the DestinationRule of a service with a subset for every version of its
+// workloads. The query supports "clusterName".
+func (c *Client) ApplyServiceSubsets(ctx context.Context, namespace, service string, query url.Values) (*models.ServiceSubsets, error) {
+	subsets := &models.ServiceSubsets{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "namespaces", namespace, "services", service, "subsets"), query, nil, subsets); err != nil {
+		return nil, err
+	}
+	return subsets, nil
+}
+
+// GenerateAuthorizationPolicy returns the AuthorizationPolicy allowing only the sources observed calling a service.
+// The query supports "trafficWindow", "granularity" and "clusterName".
+func (c *Client) GenerateAuthorizationPolicy(ctx context.Context, namespace, service string, query url.Values) (*models.GeneratedAuthorizationPolicy, error) {
+	generated := &models.GeneratedAuthorizationPolicy{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "services", service, "authorizationpolicy"), query, nil, generated); err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+// ApplyGeneratedAuthorizationPolicy creates or updates the AuthorizationPolicy allowing only the sources observed
+// calling a service. The query supports "trafficWindow", "granularity" and "clusterName".
+func (c *Client) ApplyGeneratedAuthorizationPolicy(ctx context.Context, namespace, service string, query url.Values) (*models.GeneratedAuthorizationPolicy, error) {
+	generated := &models.GeneratedAuthorizationPolicy{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "namespaces", namespace, "services", service, "authorizationpolicy"), query, nil, generated); err != nil {
+		return nil, err
+	}
+	return generated, nil
+}
+
+// SendTestRequest sends an HTTP request to a service from a test pod of the mesh and returns the response with the
+// route it matched. The query supports "clusterName".
+func (c *Client) SendTestRequest(ctx context.Context, namespace, service string, request models.TestRequest, query url.Values) (*models.TestRequestResult, error) {
+	result := &models.TestRequestResult{}
+	if err := c.do(ctx, http.MethodPost, apiPath("api", "namespaces", namespace, "services", service, "testrequest"), query, request, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ServiceLocality returns the locality load balancing settings applying to a service and the localities of its
+// endpoints. The query supports "clusterName".
+func (c *Client) ServiceLocality(ctx context.Context, namespace, service string, query url.Values) (*models.ServiceLocality, error) {
+	locality := &models.ServiceLocality{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "services", service, "locality"), query, nil, locality); err != nil {
+		return nil, err
+	}
+	return locality, nil
+}
+
+// StreamServiceRecentRequests streams the requests recently received by a service, calling fn for each request as it
+// arrives. The query supports "window", "tail", "follow" and "clusterName".
+func (c *Client) StreamServiceRecentRequests(ctx context.Context, namespace, service string, query url.Values, fn func(request models.RecentRequest) error) error {
+	body, err := c.stream(ctx, apiPath("api", "namespaces", namespace, "services", service, "requests"), query)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	decoder := json.NewDecoder(body)
+	for {
+		var request models.RecentRequest
+		if err := decoder.Decode(&request); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("unable to decode the response of Kiali: %w", err)
+		}
+		if err := fn(request); err != nil {
+			return err
+		}
+	}
+}
+
+// ClustersWorkloads returns the workloads of the namespaces. The query supports "namespaces", "health",
+// "istioResources", "rateInterval", "queryTime" and "clusterName".
+func (c *Client) ClustersWorkloads(ctx context.Context, query url.Values) (*models.ClusterWorkloads, error) {
+	workloads := &models.ClusterWorkloads{}
+	if err := c.do(ctx, http.MethodGet, "/api/clusters/workloads", query, nil, workloads); err != nil {
+		return nil, err
+	}
+	return workloads, nil
+}
+
+// ClustersWorkloadsWindow returns a window of the sorted workloads of the namespaces, with the number of workloads.
+// The query supports "offset", "limit", "sortBy", "sortOrder" and the query of ClustersWorkloads.
+func (c *Client) ClustersWorkloadsWindow(ctx context.Context, query url.Values) (*models.WorkloadWindow, error) {
+	window := &models.WorkloadWindow{}
+	if err := c.do(ctx, http.MethodGet, "/api/clusters/workloads/window", query, nil, window); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// WorkloadDetails returns a workload. The query supports "validate", "health", "rateInterval", "queryTime"
+// and "clusterName".
+func (c *Client) WorkloadDetails(ctx context.Context, namespace, workload string, query url.Values) (*models.Workload, error) {
+	details := &models.Workload{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "workloads", workload), query, nil, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// UpdateWorkload patches a workload. The query requires "workloadGVK" and supports "patchType" and "clusterName".
+func (c *Client) UpdateWorkload(ctx context.Context, namespace, workload string, patch []byte, query url.Values) (*models.Workload, error) {
+	details := &models.Workload{}
+	if err := c.do(ctx, http.MethodPatch, apiPath("api", "namespaces", namespace, "workloads", workload), query, patch, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// ClustersApps returns the applications of the namespaces. The query supports "namespaces", "health",
+// "istioResources", "rateInterval", "queryTime" and "clusterName".
+func (c *Client) ClustersApps(ctx context.Context, query url.Values) (*models.ClusterApps, error) {
+	apps := &models.ClusterApps{}
+	if err := c.do(ctx, http.MethodGet, "/api/clusters/apps", query, nil, apps); err != nil {
+		return nil, err
+	}
+	return apps, nil
+}
+
+// AppDetails returns an application. The query supports "health", "rateInterval", "queryTime" and "clusterName".
+func (c *Client) AppDetails(ctx context.Context, namespace, app string, query url.Values) (*models.App, error) {
+	details := &models.App{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "apps", app), query, nil, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
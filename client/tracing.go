@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kiali/kiali/tracing/jaeger/model"
+)
+
+// The queries of the traces and spans support "startMicros", "endMicros", "tags", "minDuration", "limit" and
+// "clusterName".
+
+// AppTraces returns the traces of an application.
+func (c *Client) AppTraces(ctx context.Context, namespace, app string, query url.Values) (*model.TracingResponse, error) {
+	return c.traces(ctx, apiPath("api", "namespaces", namespace, "apps", app, "traces"), query)
+}
+
+// ServiceTraces returns the traces of a service.
+func (c *Client) ServiceTraces(ctx context.Context, namespace, service string, query url.Values) (*model.TracingResponse, error) {
+	return c.traces(ctx, apiPath("api", "namespaces", namespace, "services", service, "traces"), query)
+}
+
+// WorkloadTraces returns the traces of a workload.
+func (c *Client) WorkloadTraces(ctx context.Context, namespace, workload string, query url.Values) (*model.TracingResponse, error) {
+	return c.traces(ctx, apiPath("api", "namespaces", namespace, "workloads", workload, "traces"), query)
+}
+
+func (c *Client) traces(ctx context.Context, path string, query url.Values) (*model.TracingResponse, error) {
+	traces := &model.TracingResponse{}
+	if err := c.do(ctx, http.MethodGet, path, query, nil, traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// TraceDetails returns a trace.
+func (c *Client) TraceDetails(ctx context.Context, traceID string) (*model.TracingSingleTrace, error) {
+	trace := &model.TracingSingleTrace{}
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "traces", traceID), nil, nil, trace); err != nil {
+		return nil, err
+	}
+	return trace, nil
+}
+
+// ErrorTraces returns the number of traces in error of an application. The query requires "duration", in seconds.
+func (c *Client) ErrorTraces(ctx context.Context, namespace, app string, query url.Values) (int, error) {
+	var count int
+	if err := c.do(ctx, http.MethodGet, apiPath("api", "namespaces", namespace, "apps", app, "errortraces"), query, nil, &count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// AppSpans returns the spans of an application.
+func (c *Client) AppSpans(ctx context.Context, namespace, app string, query url.Values) ([]model.TracingSpan, error) {
+	return c.spans(ctx, apiPath("api", "namespaces", namespace, "apps", app, "spans"), query)
+}
+
+// ServiceSpans returns the spans of a service.
+func (c *Client) ServiceSpans(ctx context.Context, namespace, service string, query url.Values) ([]model.TracingSpan, error) {
+	return c.spans(ctx, apiPath("api", "namespaces", namespace, "services", service, "spans"), query)
+}
+
+// WorkloadSpans returns the spans of a workload.
+func (c *Client) WorkloadSpans(ctx context.Context, namespace, workload string, query url.Values) ([]model.TracingSpan, error) {
+	return c.spans(ctx, apiPath("api", "namespaces", namespace, "workloads", workload, "spans"), query)
+}
+
+func (c *Client) spans(ctx context.Context, path string, query url.Values) ([]model.TracingSpan, error) {
+	spans := []model.TracingSpan{}
+	if err := c.do(ctx, http.MethodGet, path, query, nil, &spans); err != nil {
+		return nil, err
+	}
+	return spans, nil
+}
@@ -3,13 +3,16 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/prometheus/common/model"
 	"gopkg.in/yaml.v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -173,13 +176,21 @@ type Observability struct {
 type Server struct {
 	Address                    string        `yaml:",omitempty"`
 	AuditLog                   bool          `yaml:"audit_log,omitempty"` // When true, allows additional audit logging on Write operations
-	CORSAllowAll               bool          `yaml:"cors_allow_all,omitempty"`
+	CORS                       CORS          `yaml:"cors,omitempty"`
+	CORSAllowAll               bool          `yaml:"cors_allow_all,omitempty"` // same as allowing the "*" origin in CORS, kept for compatibility
+	Compression                Compression   `yaml:"compression,omitempty"`
 	GzipEnabled                bool          `yaml:"gzip_enabled,omitempty"`
 	Observability              Observability `yaml:"observability,omitempty"`
 	Port                       int           `yaml:",omitempty"`
 	Profiler                   Profiler      `yaml:"profiler,omitempty"`
 	StaticContentRootDirectory string        `yaml:"static_content_root_directory,omitempty"`
+	RequestLimits              RequestLimits `yaml:"request_limits,omitempty"`
 	RequireAuth                bool          `yaml:"require_auth,omitempty"` // when true, unauthenticated access to api/ endpoint is not allowed
+	Security                   Security      `yaml:"security,omitempty"`
+	// ShutdownGracePeriodSeconds is the time given on termination to the in-flight requests and the background
+	// controllers to complete. The remaining connections are closed after it. It should be lower than the termination
+	// grace period of the Kiali pod.
+	ShutdownGracePeriodSeconds int           `yaml:"shutdown_grace_period_seconds,omitempty"`
 	WebFQDN                    string        `yaml:"web_fqdn,omitempty"`
 	WebPort                    string        `yaml:"web_port,omitempty"`
 	WebRoot                    string        `yaml:"web_root,omitempty"`
@@ -188,10 +199,17 @@ type Server struct {
 	WriteTimeout               time.Duration `yaml:"write_timeout,omitempty"`
 }
 
-// Auth provides authentication data for external services
+// Auth provides authentication data for external services, along with the TLS and proxy settings to connect to them
 type Auth struct {
-	CAFile             string `yaml:"ca_file"`
+	CAFile string `yaml:"ca_file"`
+	// CertFile and KeyFile are the client certificate presented to the external service, for mutual TLS.
+	CertFile string `yaml:"cert_file,omitempty"`
+	// HTTPProxy and HTTPSProxy are the proxies used to reach the http and https URLs of the external service.
+	// When they are not set, the proxy of the environment is used. They are not used by the gRPC clients.
+	HTTPProxy          string `yaml:"http_proxy,omitempty"`
+	HTTPSProxy         string `yaml:"https_proxy,omitempty"`
 	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+	KeyFile            string `yaml:"key_file,omitempty"`
 	Password           string `yaml:"password"`
 	Token              string `yaml:"token"`
 	Type               string `yaml:"type"`
@@ -204,6 +222,8 @@ func (a *Auth) Obfuscate() {
 	a.Password = "xxx"
 	a.Username = "xxx"
 	a.CAFile = "xxx"
+	a.CertFile = "xxx"
+	a.KeyFile = "xxx"
 }
 
 // ThanosProxy describes configuration of the Thanos proxy component
@@ -222,9 +242,34 @@ type PrometheusConfig struct {
 	CustomHeaders   map[string]string `yaml:"custom_headers,omitempty"`
 	HealthCheckUrl  string            `yaml:"health_check_url,omitempty"`
 	IsCore          bool              `yaml:"is_core,omitempty"`
-	QueryScope      map[string]string `yaml:"query_scope,omitempty"`
-	ThanosProxy     ThanosProxy       `yaml:"thanos_proxy,omitempty"`
-	URL             string            `yaml:"url,omitempty"`
+	// QueryCardinality limits the number of series returned by the metrics queries grouped by labels
+	QueryCardinality QueryCardinalityConfig `yaml:"query_cardinality,omitempty"`
+	QueryScope       map[string]string      `yaml:"query_scope,omitempty"`
+	ThanosProxy      ThanosProxy            `yaml:"thanos_proxy,omitempty"`
+	URL              string                 `yaml:"url,omitempty"`
+}
+
+// The actions taken on the metrics queries grouped by labels exceeding the cardinality limit
+const (
+	QueryCardinalityDowngrade = "downgrade"
+	QueryCardinalityReject    = "reject"
+)
+
+// QueryCardinalityConfig describes the limit of the number of series returned by the metrics queries grouped by
+// labels. The number of series is estimated before running the query, which is rejected when it exceeds the limit,
+// or downgraded to a query without grouping.
+type QueryCardinalityConfig struct {
+	Action string `yaml:"action,omitempty"`
+	// MaxSeries is the limit of series of a query. There is no limit when it is 0.
+	MaxSeries int `yaml:"max_series,omitempty"`
+}
+
+// AlertmanagerConfig describes the Alertmanager receiving the alerts of the Prometheus used by Kiali
+type AlertmanagerConfig struct {
+	Auth          Auth              `yaml:"auth,omitempty"`
+	CustomHeaders map[string]string `yaml:"custom_headers,omitempty"`
+	Enabled       bool              `yaml:"enabled,omitempty"`
+	URL           string            `yaml:"url,omitempty"`
 }
 
 // CustomDashboardsConfig describes configuration specific to Custom Dashboards
@@ -340,6 +385,7 @@ type GatewayAPIClass struct {
 
 // ExternalServices holds configurations for other systems that Kiali depends on
 type ExternalServices struct {
+	Alertmanager     AlertmanagerConfig     `yaml:"alertmanager,omitempty"`
 	Grafana          GrafanaConfig          `yaml:"grafana,omitempty"`
 	Istio            IstioConfig            `yaml:"istio,omitempty"`
 	Prometheus       PrometheusConfig       `yaml:"prometheus,omitempty"`
@@ -388,22 +434,50 @@ type KubernetesConfig struct {
 	// Kiali cache list of namespaces per user, this is typically short lived cache compared with the duration of the
 	// namespace cache defined by previous CacheDuration parameter
 	CacheTokenNamespaceDuration int `yaml:"cache_token_namespace_duration,omitempty"`
+	// CacheTokenRBACWatch watches the RoleBindings and ClusterRoleBindings of the home cluster to clear the cached
+	// namespaces and permissions of the users when they change. Kiali needs to be allowed to list and watch them.
+	CacheTokenRBACWatch bool `yaml:"cache_token_rbac_watch,omitempty"`
 	// ClusterName is the name of the kubernetes cluster that Kiali is running in.
 	// If empty, then it will default to 'Kubernetes'.
 	ClusterName string `yaml:"cluster_name,omitempty"`
+	// ConfigActivitySize is the number of the latest changes of the Istio config kept for each cluster, reported by the
+	// activity feed.
+	ConfigActivitySize int `yaml:"config_activity_size,omitempty"`
 	// List of controllers that won't be used for Workload calculation
 	// Kiali queries Deployment,ReplicaSet,ReplicationController,DeploymentConfig,StatefulSet,Job and CronJob controllers
 	// Deployment and ReplicaSet will be always queried, but ReplicationController,DeploymentConfig,StatefulSet,Job and CronJobs
 	// can be skipped from Kiali workloads query if they are present in this list
 	ExcludeWorkloads []string `yaml:"excluded_workloads,omitempty"`
-	QPS              float32  `yaml:"qps,omitempty"`
+	// ListParallelism is the maximum number of object types, and of namespaces, fetched concurrently when listing Istio
+	// config.
+	// There is no limit when it is 0 or less.
+	ListParallelism int     `yaml:"list_parallelism,omitempty"`
+	QPS             float32 `yaml:"qps,omitempty"`
+	// UncachedIstioConfig are the Istio config types read from the Kubernetes API on every request instead of being
+	// kept in memory by informers, as <Kind>.<group>, i.e. EnvoyFilter.networking.istio.io. Every type is cached by
+	// default. The changes of the uncached types are not reported by the config activity.
+	UncachedIstioConfig []string `yaml:"uncached_istio_config,omitempty"`
 }
 
 // AuthConfig provides details on how users are to authenticate
 type AuthConfig struct {
-	OpenId    OpenIdConfig    `yaml:"openid,omitempty"`
-	OpenShift OpenShiftConfig `yaml:"openshift,omitempty"`
-	Strategy  string          `yaml:"strategy,omitempty"`
+	// Admins are the users allowed to administer Kiali: refresh the caches of the users, see the masked values of the
+	// Istio config, test the external services, retry the notifications and revoke the sessions of every user. The
+	// per-feature lists below, when set, replace it for their feature: an empty list allows no one.
+	Admins []string `yaml:"admins,omitempty"`
+	// CacheAdmins are the users allowed to refresh the cached namespaces and permissions of every user.
+	CacheAdmins []string `yaml:"cache_admins,omitempty"`
+	// DataMaskingAdmins are the users allowed to see the sensitive values of the Istio config, see DataMasking.
+	DataMaskingAdmins []string `yaml:"data_masking_admins,omitempty"`
+	// ExternalServiceAdmins are the users allowed to test the connections to the external services.
+	ExternalServiceAdmins []string        `yaml:"external_service_admins,omitempty"`
+	OpenId                OpenIdConfig    `yaml:"openid,omitempty"`
+	OpenShift             OpenShiftConfig `yaml:"openshift,omitempty"`
+	// NotificationAdmins are the users allowed to list and retry the dead letters of the notifications.
+	NotificationAdmins []string `yaml:"notification_admins,omitempty"`
+	// SessionAdmins are the users allowed to revoke the sessions of every user.
+	SessionAdmins []string `yaml:"session_admins,omitempty"`
+	Strategy      string   `yaml:"strategy,omitempty"`
 }
 
 // OpenShiftConfig contains specific configuration for authentication when on OpenShift
@@ -414,22 +488,42 @@ type OpenShiftConfig struct {
 
 // OpenIdConfig contains specific configuration for authentication using an OpenID provider
 type OpenIdConfig struct {
-	AdditionalRequestParams map[string]string `yaml:"additional_request_params,omitempty"`
-	AllowedDomains          []string          `yaml:"allowed_domains,omitempty"`
-	ApiProxy                string            `yaml:"api_proxy,omitempty"`
-	ApiProxyCAData          string            `yaml:"api_proxy_ca_data,omitempty"`
-	ApiToken                string            `yaml:"api_token,omitempty"`
-	AuthenticationTimeout   int               `yaml:"authentication_timeout,omitempty"`
-	AuthorizationEndpoint   string            `yaml:"authorization_endpoint,omitempty"`
-	ClientId                string            `yaml:"client_id,omitempty"`
-	ClientSecret            string            `yaml:"client_secret,omitempty"`
-	DisableRBAC             bool              `yaml:"disable_rbac,omitempty"`
-	HTTPProxy               string            `yaml:"http_proxy,omitempty"`
-	HTTPSProxy              string            `yaml:"https_proxy,omitempty"`
-	InsecureSkipVerifyTLS   bool              `yaml:"insecure_skip_verify_tls,omitempty"`
-	IssuerUri               string            `yaml:"issuer_uri,omitempty"`
-	Scopes                  []string          `yaml:"scopes,omitempty"`
-	UsernameClaim           string            `yaml:"username_claim,omitempty"`
+	AdditionalRequestParams map[string]string   `yaml:"additional_request_params,omitempty"`
+	AllowedDomains          []string            `yaml:"allowed_domains,omitempty"`
+	ApiProxy                string              `yaml:"api_proxy,omitempty"`
+	ApiProxyCAData          string              `yaml:"api_proxy_ca_data,omitempty"`
+	ApiToken                string              `yaml:"api_token,omitempty"`
+	AuthenticationTimeout   int                 `yaml:"authentication_timeout,omitempty"`
+	AuthorizationEndpoint   string              `yaml:"authorization_endpoint,omitempty"`
+	ClientId                string              `yaml:"client_id,omitempty"`
+	ClientSecret            string              `yaml:"client_secret,omitempty"`
+	DisableRBAC             bool                `yaml:"disable_rbac,omitempty"`
+	HTTPProxy               string              `yaml:"http_proxy,omitempty"`
+	HTTPSProxy              string              `yaml:"https_proxy,omitempty"`
+	Impersonation           OpenIdImpersonation `yaml:"impersonation,omitempty"`
+	InsecureSkipVerifyTLS   bool                `yaml:"insecure_skip_verify_tls,omitempty"`
+	IssuerUri               string              `yaml:"issuer_uri,omitempty"`
+	Scopes                  []string            `yaml:"scopes,omitempty"`
+	UsernameClaim           string              `yaml:"username_claim,omitempty"`
+}
+
+// OpenIdImpersonation makes Kiali act as the logged in user when the cluster doesn't accept the OpenId tokens.
+// Requests to the clusters use the Kiali service account, which needs the RBAC permissions to impersonate users
+// and groups, so the permissions of the user are still enforced by the clusters.
+type OpenIdImpersonation struct {
+	Enabled bool `yaml:"enabled"`
+	// GroupsClaim is the claim of the id token holding the groups of the user.
+	GroupsClaim string `yaml:"groups_claim,omitempty"`
+	// GroupsPrefix and UsernamePrefix are prepended to the impersonated groups and username,
+	// matching the prefixes of the OIDC configuration of the API server.
+	GroupsPrefix   string `yaml:"groups_prefix,omitempty"`
+	UsernamePrefix string `yaml:"username_prefix,omitempty"`
+}
+
+// DemoConfig enables the demo mode: Kiali serves a synthetic mesh, with its Istio config and metrics, without a
+// cluster nor a Prometheus server.
+type DemoConfig struct {
+	Enabled bool `yaml:"enabled,omitempty"`
 }
 
 // DeploymentConfig provides details on how Kiali was deployed.
@@ -580,8 +674,10 @@ type UIDefaults struct {
 
 // Validations defines default settings configured for the Validations subsystem
 type Validations struct {
-	Ignore                   []string `yaml:"ignore,omitempty" json:"ignore,omitempty"`
-	SkipWildcardGatewayHosts bool     `yaml:"skip_wildcard_gateway_hosts,omitempty"`
+	Ignore []string `yaml:"ignore,omitempty" json:"ignore,omitempty"`
+	// RunWorkers is the number of namespaces validated concurrently by a validation run of the mesh.
+	RunWorkers               int  `yaml:"run_workers,omitempty" json:"runWorkers,omitempty"`
+	SkipWildcardGatewayHosts bool `yaml:"skip_wildcard_gateway_hosts,omitempty"`
 }
 
 // Clustering defines configuration around multi-cluster functionality.
@@ -645,6 +741,365 @@ type HealthConfig struct {
 	Rate []Rate `yaml:"rate,omitempty" json:"rate,omitempty"`
 }
 
+// The variables of the URL templates of the deep links.
+const (
+	DeepLinkVariableCluster   = "cluster"
+	DeepLinkVariableKind      = "kind"
+	DeepLinkVariableName      = "name"
+	DeepLinkVariableNamespace = "namespace"
+)
+
+// deepLinkVariable matches the variables of the URL templates of the deep links, i.e. ${namespace}.
+var deepLinkVariable = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// DeepLink defines a link to an external system, i.e. a Git repository or a logging system, generated for
+// the objects shown by Kiali. The variables ${cluster}, ${namespace}, ${name} and ${kind} of the URL template are
+// replaced by the values of the object, escaped for a URL path.
+type DeepLink struct {
+	// Kinds of the objects the link is generated for: app, service, workload or the kind of an Istio object,
+	// i.e. VirtualService. The link is generated for the objects of all kinds when empty.
+	Kinds []string `yaml:"kinds,omitempty" json:"kinds,omitempty"`
+	Name  string   `yaml:"name" json:"name"`
+	// Namespace is a regular expression of the namespaces of the objects the link is generated for. All
+	// namespaces when empty.
+	Namespace   string `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	URLTemplate string `yaml:"url_template" json:"urlTemplate"`
+}
+
+// AppliesTo returns whether the link is generated for the objects of the kind in the namespace.
+func (dl DeepLink) AppliesTo(kind, namespace string) bool {
+	if dl.Namespace != "" {
+		if matched, err := regexp.MatchString("^(?:"+dl.Namespace+")$", namespace); err != nil || !matched {
+			return false
+		}
+	}
+	if len(dl.Kinds) == 0 {
+		return true
+	}
+	for _, k := range dl.Kinds {
+		if strings.EqualFold(k, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// URL returns the URL of the link for an object, replacing the variables of the URL template.
+func (dl DeepLink) URL(cluster, kind, namespace, name string) string {
+	values := map[string]string{
+		DeepLinkVariableCluster:   cluster,
+		DeepLinkVariableKind:      kind,
+		DeepLinkVariableName:      name,
+		DeepLinkVariableNamespace: namespace,
+	}
+	return deepLinkVariable.ReplaceAllStringFunc(dl.URLTemplate, func(variable string) string {
+		return url.PathEscape(values[deepLinkVariable.FindStringSubmatch(variable)[1]])
+	})
+}
+
+// OwnershipTeam maps a team to the users that are members of it.
+type OwnershipTeam struct {
+	Name  string   `yaml:"name" json:"name"`
+	Users []string `yaml:"users,omitempty" json:"users,omitempty"`
+}
+
+// Ownership defines the team ownership model of the Istio config objects.
+// An object is owned by the team set in its TeamLabel label.
+type Ownership struct {
+	Enabled bool `yaml:"enabled,omitempty" json:"enabled"`
+	// EnforceOnWrite prevents users from creating, updating or deleting objects owned by other teams.
+	EnforceOnWrite bool            `yaml:"enforce_on_write,omitempty" json:"enforceOnWrite"`
+	TeamLabel      string          `yaml:"team_label,omitempty" json:"teamLabel,omitempty"`
+	Teams          []OwnershipTeam `yaml:"teams,omitempty" json:"teams,omitempty"`
+}
+
+// TeamsForUser returns the name of the teams the user is a member of.
+func (o Ownership) TeamsForUser(user string) []string {
+	teams := []string{}
+	for _, team := range o.Teams {
+		for _, u := range team.Users {
+			if u == user {
+				teams = append(teams, team.Name)
+				break
+			}
+		}
+	}
+	return teams
+}
+
+// The background controllers whose refresh can be tuned per namespace.
+const (
+	BackgroundControllerSLO             = "slo"
+	BackgroundControllerTrafficBaseline = "traffic_baseline"
+	BackgroundControllerValidations     = "validations"
+)
+
+// BackgroundRefreshNamespace tunes the refresh of the namespaces whose name matches the Namespace regular expression.
+// The first matching entry applies.
+type BackgroundRefreshNamespace struct {
+	Namespace string `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	// Controllers the entry applies to, i.e. validations, slo or traffic_baseline. Empty means all of them.
+	Controllers []string `yaml:"controllers,omitempty" json:"controllers,omitempty"`
+	// Disabled stops the background refresh of the namespaces. Their last results are kept.
+	Disabled bool `yaml:"disabled,omitempty" json:"disabled,omitempty"`
+	// IntervalSeconds is the minimum time between two refreshes of the namespaces. 0 keeps the interval of the controller.
+	IntervalSeconds int `yaml:"interval_seconds,omitempty" json:"intervalSeconds,omitempty"`
+}
+
+// BackgroundRefresh defines how often the background controllers refresh the namespaces, to save the load of the
+// Prometheus and API servers on quiet namespaces.
+type BackgroundRefresh struct {
+	// ValidationsIntervalSeconds is how often the validations of the Istio config are recomputed
+	ValidationsIntervalSeconds int                          `yaml:"validations_interval_seconds,omitempty" json:"validationsIntervalSeconds,omitempty"`
+	Namespaces                 []BackgroundRefreshNamespace `yaml:"namespaces,omitempty" json:"namespaces,omitempty"`
+}
+
+// SLOObjective defines a service level objective that applies to every service
+// whose namespace and name match the given regular expressions.
+type SLOObjective struct {
+	Namespace string `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	Name      string `yaml:"name,omitempty" json:"name,omitempty"`
+	// AvailabilityTarget is the percentage of successful requests, i.e. 99.9
+	AvailabilityTarget float64 `yaml:"availability_target,omitempty" json:"availabilityTarget,omitempty"`
+	// LatencyQuantile is the quantile compared against the LatencyThreshold, i.e. 0.99
+	LatencyQuantile string `yaml:"latency_quantile,omitempty" json:"latencyQuantile,omitempty"`
+	// LatencyThreshold expressed in milliseconds. Latency is not tracked when it is 0.
+	LatencyThreshold float64 `yaml:"latency_threshold,omitempty" json:"latencyThreshold,omitempty"`
+	// Window is a Prometheus duration, i.e. 1h or 30d
+	Window string `yaml:"window,omitempty" json:"window,omitempty"`
+
+	// The expressions compiled when the config is loaded
+	nameRegexp      *regexp.Regexp
+	namespaceRegexp *regexp.Regexp
+}
+
+// Matches returns true if the objective applies to the service. An empty expression matches everything.
+func (o SLOObjective) Matches(namespace, name string) bool {
+	return matchesSLOExpression(o.Namespace, o.namespaceRegexp, namespace) && matchesSLOExpression(o.Name, o.nameRegexp, name)
+}
+
+func matchesSLOExpression(expression string, re *regexp.Regexp, value string) bool {
+	if expression == "" {
+		return true
+	}
+	// Invalid expressions are not compiled, the config validation rejects them
+	return re != nil && re.MatchString(value)
+}
+
+// SLOConfig defines the settings of the SLO tracking subsystem.
+// Objectives defined here can be overridden per service with the slo.kiali.io annotations.
+type SLOConfig struct {
+	Enabled                   bool           `yaml:"enabled,omitempty" json:"enabled"`
+	EvaluationIntervalSeconds int            `yaml:"evaluation_interval_seconds,omitempty" json:"evaluationIntervalSeconds,omitempty"`
+	Objectives                []SLOObjective `yaml:"objectives,omitempty" json:"objectives,omitempty"`
+}
+
+// TrafficBaselineWebhook defines the endpoint notified of new traffic baseline findings.
+// Findings are POSTed as a JSON array. Notifications are disabled when the URL is empty.
+type TrafficBaselineWebhook struct {
+	Auth          Auth              `yaml:"auth,omitempty" json:"-"`
+	CustomHeaders map[string]string `yaml:"custom_headers,omitempty" json:"-"`
+	URL           string            `yaml:"url,omitempty" json:"url,omitempty"`
+}
+
+// TrafficBaselineConfig defines the settings of the traffic baseline analyzer, which compares the current
+// service traffic with the traffic at the same time in the past, to detect services that stopped receiving
+// traffic and new destinations.
+type TrafficBaselineConfig struct {
+	Enabled                   bool `yaml:"enabled,omitempty" json:"enabled"`
+	EvaluationIntervalSeconds int  `yaml:"evaluation_interval_seconds,omitempty" json:"evaluationIntervalSeconds,omitempty"`
+	// BaselineOffset is how far back the baseline is taken, as a Prometheus duration, i.e. 1d or 1w
+	BaselineOffset string `yaml:"baseline_offset,omitempty" json:"baselineOffset,omitempty"`
+	// MinBaselineRate is the minimum baseline rate, in requests per second, for a service to be flagged when its traffic stops
+	MinBaselineRate float64 `yaml:"min_baseline_rate,omitempty" json:"minBaselineRate,omitempty"`
+	// RateInterval is the Prometheus duration used to compute both the current and the baseline rates, i.e. 10m
+	RateInterval string                 `yaml:"rate_interval,omitempty" json:"rateInterval,omitempty"`
+	Webhook      TrafficBaselineWebhook `yaml:"webhook,omitempty" json:"webhook,omitempty"`
+}
+
+// Notifications defines the delivery of the webhook notifications, i.e. of the traffic baseline findings. The
+// notifications are queued to Directory, which should be backed by a persistent volume to survive the restarts of
+// Kiali, and retried with an exponential backoff. The ones still failing after MaxAttempts are kept as dead letters
+// until the DeadLetterRetention expires.
+type Notifications struct {
+	Directory string `yaml:"directory,omitempty" json:"-"`
+	// DeliveryIntervalSeconds is how often the queued notifications are delivered
+	DeliveryIntervalSeconds int `yaml:"delivery_interval_seconds,omitempty" json:"deliveryIntervalSeconds,omitempty"`
+	MaxAttempts             int `yaml:"max_attempts,omitempty" json:"maxAttempts,omitempty"`
+	// InitialBackoff is the delay before the first retry, as a Prometheus duration, i.e. 30s. It doubles after each
+	// failed attempt, up to MaxBackoff.
+	InitialBackoff string `yaml:"initial_backoff,omitempty" json:"initialBackoff,omitempty"`
+	MaxBackoff     string `yaml:"max_backoff,omitempty" json:"maxBackoff,omitempty"`
+	// DeadLetterRetention is how long the dead letters are kept, as a Prometheus duration, i.e. 7d.
+	DeadLetterRetention string `yaml:"dead_letter_retention,omitempty" json:"deadLetterRetention,omitempty"`
+}
+
+// DataMasking defines the sensitive values redacted from the Istio config returned to the users who are not data
+// masking admins, i.e. the secrets referenced by the gateways or the audiences of the JWT rules. The objects are
+// redacted in the lists, the details and the exports, i.e. the snapshots, as well as where the services and the
+// workloads embed them, i.e. in their details and in the effective config of the services.
+type DataMasking struct {
+	Enabled bool `yaml:"enabled,omitempty"`
+	// Fields are the paths of the redacted fields, i.e. "credentialName" or "jwtRules.audiences". A path matches the
+	// fields ending with it, at any depth and whatever the lists they are in.
+	Fields []string `yaml:"fields,omitempty"`
+	// AnnotationPatterns are the regular expressions matching the keys of the redacted annotations.
+	AnnotationPatterns []string `yaml:"annotation_patterns,omitempty"`
+}
+
+// IstioConfigSnapshots defines the settings of the scheduled snapshots of the Istio config of some namespaces.
+// Snapshots are written to Directory, which should be backed by a persistent volume to survive the restarts of Kiali.
+type IstioConfigSnapshots struct {
+	Enabled         bool `yaml:"enabled,omitempty" json:"enabled"`
+	IntervalSeconds int  `yaml:"interval_seconds,omitempty" json:"intervalSeconds,omitempty"`
+	// Namespaces whose Istio config is saved, in every cluster where they exist
+	Namespaces []string `yaml:"namespaces,omitempty" json:"namespaces,omitempty"`
+	Directory  string   `yaml:"directory,omitempty" json:"-"`
+	// MaxCount is the maximum number of snapshots kept per namespace, the oldest being removed first. 0 means no limit.
+	MaxCount int `yaml:"max_count,omitempty" json:"maxCount,omitempty"`
+	// MaxAge is how long the snapshots are kept, as a Prometheus duration, i.e. 7d. Empty means no limit.
+	MaxAge string `yaml:"max_age,omitempty" json:"maxAge,omitempty"`
+}
+
+// The modes of the delete protection of the Istio objects referenced by others.
+const (
+	DeleteProtectionBlock    = "block"
+	DeleteProtectionDisabled = "disabled"
+	DeleteProtectionWarn     = "warn"
+)
+
+// IstioConfigDeleteProtection defines how the deletion of the Gateways, DestinationRules and ServiceEntries still
+// referenced by other Istio objects is handled: blocked, allowed with a warning, or not checked.
+type IstioConfigDeleteProtection struct {
+	Mode string `yaml:"mode,omitempty" json:"mode"`
+}
+
+// IstioConfigSoftDelete defines the settings of the soft deletion of the Istio objects. The objects deleted from Kiali
+// are saved to Directory, and their deletion can be undone until the UndoWindow expires.
+type IstioConfigSoftDelete struct {
+	Enabled   bool   `yaml:"enabled,omitempty" json:"enabled"`
+	Directory string `yaml:"directory,omitempty" json:"-"`
+	// UndoWindow is how long the deleted objects are kept, as a Prometheus duration, i.e. 30m.
+	UndoWindow string `yaml:"undo_window,omitempty" json:"undoWindow,omitempty"`
+}
+
+// Reports defines the settings of the namespace reports. The reports are generated by asynchronous jobs and kept in
+// memory, at most MaxJobs of them, until their Retention expires.
+type Reports struct {
+	Enabled bool `yaml:"enabled,omitempty" json:"enabled"`
+	MaxJobs int  `yaml:"max_jobs,omitempty" json:"maxJobs,omitempty"`
+	// Retention is how long the reports are kept once generated, as a Prometheus duration, i.e. 1h.
+	Retention string `yaml:"retention,omitempty" json:"retention,omitempty"`
+	// TimeoutSeconds bounds the generation of a report.
+	TimeoutSeconds int `yaml:"timeout_seconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// TestRequests defines the settings of the test requests sent to the services of the mesh, to verify the routing
+// rules. The requests are sent with curl, executed in the Container of a running pod matching the PodSelector, in
+// Namespace or, when empty, in the namespace of the service. The users need the permission to exec into the pod.
+type TestRequests struct {
+	Enabled   bool   `yaml:"enabled,omitempty" json:"enabled"`
+	Container string `yaml:"container,omitempty" json:"container,omitempty"`
+	Namespace string `yaml:"namespace,omitempty" json:"namespace,omitempty"`
+	// PodSelector is the label selector of the test pods, i.e. app=curl.
+	PodSelector string `yaml:"pod_selector,omitempty" json:"podSelector,omitempty"`
+	// TimeoutSeconds bounds the time of a test request.
+	TimeoutSeconds int `yaml:"timeout_seconds,omitempty" json:"timeoutSeconds,omitempty"`
+}
+
+// MutationWebhook defines the endpoint authorizing the changes of the Istio config before they are applied.
+// The change, with the user and the diff of the object, is POSTed as JSON and the webhook replies whether
+// it is allowed. The webhook is disabled when the URL is empty.
+type MutationWebhook struct {
+	Auth          Auth              `yaml:"auth,omitempty"`
+	CustomHeaders map[string]string `yaml:"custom_headers,omitempty"`
+	// FailOpen allows the changes when the webhook can't be reached or fails. By default they are rejected.
+	FailOpen bool `yaml:"fail_open,omitempty"`
+	// ForwardHeaders are the headers of the Kiali requests sent along with the change, i.e. a change ticket number.
+	ForwardHeaders []string `yaml:"forward_headers,omitempty"`
+	TimeoutSeconds int      `yaml:"timeout_seconds,omitempty"`
+	URL            string   `yaml:"url,omitempty"`
+}
+
+// GraphAppenderWebhook defines an endpoint decorating the graph with the data of an organization, i.e. ownership,
+// cost or compliance data. It runs as a graph appender, when requested by name in the appenders of a graph: the
+// nodes and edges of the graph are POSTed as JSON and the webhook replies with the metadata to merge into them.
+// The timeout defaults to 10 seconds.
+type GraphAppenderWebhook struct {
+	Auth          Auth              `yaml:"auth,omitempty"`
+	CustomHeaders map[string]string `yaml:"custom_headers,omitempty"`
+	// Name is the name of the appender, requested in the appenders of a graph.
+	Name           string `yaml:"name,omitempty"`
+	TimeoutSeconds int    `yaml:"timeout_seconds,omitempty"`
+	URL            string `yaml:"url,omitempty"`
+}
+
+// ValidationWebhook defines an endpoint validating the Istio config with the rules of an organization, i.e. naming
+// conventions or mandatory annotations. The Istio objects of a namespace are POSTed as JSON and the webhook replies
+// with their checks, which are shown along with the checks of Kiali. The timeout defaults to 10 seconds.
+type ValidationWebhook struct {
+	Auth          Auth              `yaml:"auth,omitempty"`
+	CustomHeaders map[string]string `yaml:"custom_headers,omitempty"`
+	// Name identifies the webhook in the logs.
+	Name           string `yaml:"name,omitempty"`
+	TimeoutSeconds int    `yaml:"timeout_seconds,omitempty"`
+	URL            string `yaml:"url,omitempty"`
+}
+
+// Compression provides settings about the compression of the responses. Compression is enabled with Server.GzipEnabled.
+type Compression struct {
+	// MinSize is the minimum size, in bytes, of the responses to compress.
+	MinSize int `yaml:"min_size,omitempty"`
+	// RouteMinSizes overrides the minimum size for the given route names. A negative size disables the compression of the route.
+	RouteMinSizes map[string]int `yaml:"route_min_sizes,omitempty"`
+}
+
+// RequestLimits bounds the requests accepted by the API.
+type RequestLimits struct {
+	// MaxBodySize is the maximum size, in bytes, of the request bodies.
+	MaxBodySize int64 `yaml:"max_body_size,omitempty"`
+	// RouteMaxBodySizes overrides the maximum size for the given route names. A negative size disables the limit of the route.
+	RouteMaxBodySizes map[string]int64 `yaml:"route_max_body_sizes,omitempty"`
+	// MutationsPerMinute is the number of mutating requests (POST, PUT, PATCH and DELETE) accepted per minute and per
+	// user. The requests beyond it are rejected with a 429 status until the quota is refilled. 0 disables the limit.
+	MutationsPerMinute int `yaml:"mutations_per_minute,omitempty"`
+}
+
+// Security configures the protections of the server against cross-site attacks.
+type Security struct {
+	// CSRF enables the verification of a CSRF token on the mutating API requests. The token is issued in the
+	// kiali-csrf-token cookie and must be sent back in the X-Kiali-CSRF-Token header.
+	CSRF bool `yaml:"csrf,omitempty"`
+	// Headers are the security headers added to the responses.
+	Headers SecurityHeaders `yaml:"headers,omitempty"`
+}
+
+// SecurityHeaders are the security headers added to the responses. Empty headers are not sent.
+type SecurityHeaders struct {
+	ContentSecurityPolicy string `yaml:"content_security_policy,omitempty"`
+	ContentTypeOptions    string `yaml:"content_type_options,omitempty"`
+	// FrameOptions is not set by default, as Kiali can be embedded in other consoles (i.e. kiosk mode).
+	FrameOptions   string `yaml:"frame_options,omitempty"`
+	ReferrerPolicy string `yaml:"referrer_policy,omitempty"`
+	// StrictTransportSecurity is only sent when Kiali is served over https.
+	StrictTransportSecurity string `yaml:"strict_transport_security,omitempty"`
+}
+
+// CORS configures the Cross-Origin Resource Sharing of the Kiali API, allowing external frontends (i.e. dashboards or
+// portals) to call it from the browser. It is disabled when no origin is allowed.
+type CORS struct {
+	// AllowCredentials allows the browser to send the cookies of the Kiali session. It is not honored for the origins
+	// only allowed by "*": the allowed origins must be listed.
+	AllowCredentials bool     `yaml:"allow_credentials,omitempty"`
+	AllowedHeaders   []string `yaml:"allowed_headers,omitempty"`
+	AllowedMethods   []string `yaml:"allowed_methods,omitempty"`
+	// AllowedOrigins are the origins allowed to call the API, as "scheme://host[:port]". "*" allows any origin and
+	// "https://*.example.com" any subdomain of example.com.
+	AllowedOrigins []string `yaml:"allowed_origins,omitempty"`
+	// MaxAge is the number of seconds the browsers can cache the response of a preflight request.
+	MaxAge int `yaml:"max_age,omitempty"`
+}
+
 // Profiler provides settings about the profiler that can be used to debug the Kiali server internals.
 type Profiler struct {
 	Enabled bool `yaml:"enabled,omitempty"`
@@ -652,23 +1107,39 @@ type Profiler struct {
 
 // Config defines full YAML configuration.
 type Config struct {
-	AdditionalDisplayDetails []AdditionalDisplayItem             `yaml:"additional_display_details,omitempty"`
-	Auth                     AuthConfig                          `yaml:"auth,omitempty"`
-	Clustering               Clustering                          `yaml:"clustering,omitempty"`
-	CustomDashboards         dashboards.MonitoringDashboardsList `yaml:"custom_dashboards,omitempty"`
-	Deployment               DeploymentConfig                    `yaml:"deployment,omitempty"`
-	Extensions               []ExtensionConfig                   `yaml:"extensions,omitempty"`
-	ExternalServices         ExternalServices                    `yaml:"external_services,omitempty"`
-	HealthConfig             HealthConfig                        `yaml:"health_config,omitempty" json:"healthConfig,omitempty"`
-	Identity                 security.Identity                   `yaml:",omitempty"`
-	InCluster                bool                                `yaml:"in_cluster,omitempty"`
-	InstallationTag          string                              `yaml:"installation_tag,omitempty"`
-	IstioLabels              IstioLabels                         `yaml:"istio_labels,omitempty"`
-	IstioNamespace           string                              `yaml:"istio_namespace,omitempty"` // default component namespace
-	KialiFeatureFlags        KialiFeatureFlags                   `yaml:"kiali_feature_flags,omitempty"`
-	KubernetesConfig         KubernetesConfig                    `yaml:"kubernetes_config,omitempty"`
-	LoginToken               LoginToken                          `yaml:"login_token,omitempty"`
-	Server                   Server                              `yaml:",omitempty"`
+	AdditionalDisplayDetails    []AdditionalDisplayItem             `yaml:"additional_display_details,omitempty"`
+	Auth                        AuthConfig                          `yaml:"auth,omitempty"`
+	BackgroundRefresh           BackgroundRefresh                   `yaml:"background_refresh,omitempty"`
+	Clustering                  Clustering                          `yaml:"clustering,omitempty"`
+	CustomDashboards            dashboards.MonitoringDashboardsList `yaml:"custom_dashboards,omitempty"`
+	DataMasking                 DataMasking                         `yaml:"data_masking,omitempty"`
+	Demo                        DemoConfig                          `yaml:"demo,omitempty"`
+	DeepLinks                   []DeepLink                          `yaml:"deep_links,omitempty"`
+	Deployment                  DeploymentConfig                    `yaml:"deployment,omitempty"`
+	Extensions                  []ExtensionConfig                   `yaml:"extensions,omitempty"`
+	ExternalServices            ExternalServices                    `yaml:"external_services,omitempty"`
+	GraphAppenderWebhooks       []GraphAppenderWebhook              `yaml:"graph_appender_webhooks,omitempty"`
+	HealthConfig                HealthConfig                        `yaml:"health_config,omitempty" json:"healthConfig,omitempty"`
+	Identity                    security.Identity                   `yaml:",omitempty"`
+	InCluster                   bool                                `yaml:"in_cluster,omitempty"`
+	InstallationTag             string                              `yaml:"installation_tag,omitempty"`
+	IstioConfigSnapshots        IstioConfigSnapshots                `yaml:"istio_config_snapshots,omitempty"`
+	IstioConfigDeleteProtection IstioConfigDeleteProtection         `yaml:"istio_config_delete_protection,omitempty"`
+	IstioConfigSoftDelete       IstioConfigSoftDelete               `yaml:"istio_config_soft_delete,omitempty"`
+	IstioLabels                 IstioLabels                         `yaml:"istio_labels,omitempty"`
+	IstioNamespace              string                              `yaml:"istio_namespace,omitempty"` // default component namespace
+	KialiFeatureFlags           KialiFeatureFlags                   `yaml:"kiali_feature_flags,omitempty"`
+	KubernetesConfig            KubernetesConfig                    `yaml:"kubernetes_config,omitempty"`
+	LoginToken                  LoginToken                          `yaml:"login_token,omitempty"`
+	MutationWebhook             MutationWebhook                     `yaml:"mutation_webhook,omitempty"`
+	Notifications               Notifications                       `yaml:"notifications,omitempty"`
+	Ownership                   Ownership                           `yaml:"ownership,omitempty"`
+	Reports                     Reports                             `yaml:"reports,omitempty"`
+	Server                      Server                              `yaml:",omitempty"`
+	SLO                         SLOConfig                           `yaml:"slo,omitempty"`
+	TestRequests                TestRequests                        `yaml:"test_requests,omitempty"`
+	TrafficBaseline             TrafficBaselineConfig               `yaml:"traffic_baseline,omitempty"`
+	ValidationWebhooks          []ValidationWebhook                 `yaml:"validation_webhooks,omitempty"`
 }
 
 // NewConfig creates a default Config struct
@@ -689,16 +1160,26 @@ func NewConfig() (c *Config) {
 				ClientId:                "",
 				ClientSecret:            "",
 				DisableRBAC:             false,
-				InsecureSkipVerifyTLS:   false,
-				IssuerUri:               "",
-				Scopes:                  []string{"openid", "profile", "email"},
-				UsernameClaim:           "sub",
+				Impersonation: OpenIdImpersonation{
+					Enabled:     false,
+					GroupsClaim: "groups",
+				},
+				InsecureSkipVerifyTLS: false,
+				IssuerUri:             "",
+				Scopes:                []string{"openid", "profile", "email"},
+				UsernameClaim:         "sub",
 			},
 			OpenShift: OpenShiftConfig{
 				InsecureSkipVerifyTLS: false,
 			},
 		},
 		CustomDashboards: dashboards.GetBuiltInMonitoringDashboards(),
+		DataMasking: DataMasking{
+			Enabled:            false,
+			Fields:             []string{"credentialName", "jwtRules.audiences"},
+			AnnotationPatterns: []string{"(?i)(secret|token|password)"},
+		},
+		DeepLinks: []DeepLink{},
 		Deployment: DeploymentConfig{
 			ClusterWideAccess:  true,
 			DiscoverySelectors: DiscoverySelectorsConfig{Default: nil, Overrides: nil},
@@ -760,7 +1241,10 @@ func NewConfig() (c *Config) {
 				// Prom Cache expires and it forces to repopulate cache
 				CacheExpiration: 300,
 				CustomHeaders:   map[string]string{},
-				QueryScope:      map[string]string{},
+				QueryCardinality: QueryCardinalityConfig{
+					Action: QueryCardinalityReject,
+				},
+				QueryScope: map[string]string{},
 				ThanosProxy: ThanosProxy{
 					Enabled:         false,
 					RetentionPeriod: "7d",
@@ -889,7 +1373,8 @@ func NewConfig() (c *Config) {
 				RefreshInterval:   "60s",
 			},
 			Validations: Validations{
-				Ignore: make([]string, 0),
+				Ignore:     make([]string, 0),
+				RunWorkers: 4,
 			},
 		},
 		KubernetesConfig: KubernetesConfig{
@@ -897,16 +1382,51 @@ func NewConfig() (c *Config) {
 			CacheDuration:               5 * 60,
 			CacheTokenNamespaceDuration: 10,
 			ClusterName:                 "", // leave this unset as a flag that we need to fetch the information
+			ConfigActivitySize:          1000,
 			ExcludeWorkloads:            []string{"CronJob", "DeploymentConfig", "Job", "ReplicationController"},
+			ListParallelism:             4,
 			QPS:                         175,
 		},
 		LoginToken: LoginToken{
 			ExpirationSeconds: 24 * 3600,
 			SigningKey:        "kiali",
 		},
+		MutationWebhook: MutationWebhook{
+			FailOpen:       false,
+			ForwardHeaders: []string{},
+			TimeoutSeconds: 10,
+		},
+		Ownership: Ownership{
+			Enabled:        false,
+			EnforceOnWrite: false,
+			TeamLabel:      "kiali.io/team",
+			Teams:          []OwnershipTeam{},
+		},
 		Server: Server{
-			AuditLog:    true,
+			AuditLog: true,
+			Compression: Compression{
+				MinSize:       1400,
+				RouteMinSizes: map[string]int{},
+			},
 			GzipEnabled: true,
+			RequestLimits: RequestLimits{
+				MaxBodySize:       1024 * 1024,
+				RouteMaxBodySizes: map[string]int64{},
+			},
+			CORS: CORS{
+				AllowedHeaders: []string{"Accept", "Authorization", "Content-Type", "Origin", "X-Kiali-CSRF-Token", "X-Requested-With"},
+				AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+				AllowedOrigins: []string{},
+				MaxAge:         600,
+			},
+			ShutdownGracePeriodSeconds: 20,
+			Security: Security{
+				CSRF: false,
+				Headers: SecurityHeaders{
+					ContentTypeOptions: "nosniff",
+					ReferrerPolicy:     "same-origin",
+				},
+			},
 			Observability: Observability{
 				Metrics: Metrics{
 					Enabled: true,
@@ -935,6 +1455,60 @@ func NewConfig() (c *Config) {
 			WebSchema:                  "",
 			WriteTimeout:               30,
 		},
+		BackgroundRefresh: BackgroundRefresh{
+			ValidationsIntervalSeconds: 10,
+			Namespaces:                 []BackgroundRefreshNamespace{},
+		},
+		SLO: SLOConfig{
+			Enabled:                   false,
+			EvaluationIntervalSeconds: 60,
+			Objectives:                []SLOObjective{},
+		},
+		IstioConfigSnapshots: IstioConfigSnapshots{
+			Enabled:         false,
+			IntervalSeconds: 3600,
+			Namespaces:      []string{},
+			Directory:       "/tmp/kiali/snapshots",
+			MaxCount:        24,
+			MaxAge:          "7d",
+		},
+		IstioConfigDeleteProtection: IstioConfigDeleteProtection{
+			Mode: DeleteProtectionWarn,
+		},
+		IstioConfigSoftDelete: IstioConfigSoftDelete{
+			Enabled:    false,
+			Directory:  "/tmp/kiali/deleted",
+			UndoWindow: "30m",
+		},
+		Notifications: Notifications{
+			Directory:               "/tmp/kiali/notifications",
+			DeliveryIntervalSeconds: 15,
+			MaxAttempts:             5,
+			InitialBackoff:          "30s",
+			MaxBackoff:              "30m",
+			DeadLetterRetention:     "7d",
+		},
+		Reports: Reports{
+			Enabled:        true,
+			MaxJobs:        20,
+			Retention:      "1h",
+			TimeoutSeconds: 300,
+		},
+		TestRequests: TestRequests{
+			Enabled:        false,
+			Container:      "curl",
+			PodSelector:    "app=curl",
+			TimeoutSeconds: 10,
+		},
+		TrafficBaseline: TrafficBaselineConfig{
+			Enabled:                   false,
+			EvaluationIntervalSeconds: 300,
+			BaselineOffset:            "1d",
+			MinBaselineRate:           0.1,
+			RateInterval:              "10m",
+		},
+		GraphAppenderWebhooks: []GraphAppenderWebhook{},
+		ValidationWebhooks:    []ValidationWebhook{},
 	}
 
 	return
@@ -991,7 +1565,13 @@ func (conf *Config) IsServerHTTPS() bool {
 
 func (conf *Config) IsRBACDisabled() bool {
 	return conf.Auth.Strategy == AuthStrategyAnonymous ||
-		(conf.Auth.Strategy == AuthStrategyOpenId && conf.Auth.OpenId.DisableRBAC)
+		(conf.Auth.Strategy == AuthStrategyOpenId && conf.Auth.OpenId.DisableRBAC && !conf.Auth.OpenId.Impersonation.Enabled)
+}
+
+// IsImpersonationEnabled returns true if the requests to the clusters impersonate the logged in user.
+func (conf *Config) IsImpersonationEnabled() bool {
+	return conf.Auth.Strategy == AuthStrategyHeader ||
+		(conf.Auth.Strategy == AuthStrategyOpenId && conf.Auth.OpenId.Impersonation.Enabled)
 }
 
 // Get the global Config
@@ -1009,17 +1589,30 @@ func Set(conf *Config) {
 	rwMutex.Lock()
 	defer rwMutex.Unlock()
 	conf.AddHealthDefault()
+	conf.prepareSLOObjectives()
 	configuration = *conf
 }
 
 func (conf Config) Obfuscate() (obf Config) {
 	obf = conf
+	obf.ExternalServices.Alertmanager.Auth.Obfuscate()
 	obf.ExternalServices.Grafana.Auth.Obfuscate()
 	obf.ExternalServices.Prometheus.Auth.Obfuscate()
 	obf.ExternalServices.Tracing.Auth.Obfuscate()
 	obf.ExternalServices.CustomDashboards.Prometheus.Auth.Obfuscate()
 	obf.Identity.Obfuscate()
 	obf.LoginToken.Obfuscate()
+	obf.TrafficBaseline.Webhook.Auth.Obfuscate()
+	obf.MutationWebhook.Auth.Obfuscate()
+	// Not to obfuscate the webhooks of the config
+	obf.GraphAppenderWebhooks = append([]GraphAppenderWebhook(nil), conf.GraphAppenderWebhooks...)
+	for i := range obf.GraphAppenderWebhooks {
+		obf.GraphAppenderWebhooks[i].Auth.Obfuscate()
+	}
+	obf.ValidationWebhooks = append([]ValidationWebhook(nil), conf.ValidationWebhooks...)
+	for i := range obf.ValidationWebhooks {
+		obf.ValidationWebhooks[i].Auth.Obfuscate()
+	}
 	obf.Auth.OpenId.ClientSecret = "xxx"
 	return
 }
@@ -1060,6 +1653,15 @@ func (conf *Config) prepareDashboards() {
 	}
 }
 
+// prepareSLOObjectives compiles the expressions of the SLO objectives, once for all the evaluations.
+func (conf *Config) prepareSLOObjectives() {
+	for i := range conf.SLO.Objectives {
+		objective := &conf.SLO.Objectives[i]
+		objective.namespaceRegexp, _ = regexp.Compile(objective.Namespace)
+		objective.nameRegexp, _ = regexp.Compile(objective.Name)
+	}
+}
+
 // Unmarshal parses the given YAML string and returns its Config object representation.
 func Unmarshal(yamlString string) (conf *Config, err error) {
 	conf = NewConfig()
@@ -1082,6 +1684,7 @@ func Unmarshal(yamlString string) (conf *Config, err error) {
 	}
 
 	conf.prepareDashboards()
+	conf.prepareSLOObjectives()
 
 	// TODO: Still support deprecated settings, but remove this support in future versions
 	if conf.ExternalServices.Grafana.XInClusterURL != "" {
@@ -1274,6 +1877,10 @@ func Validate(cfg Config) error {
 		return fmt.Errorf("server port is negative: %v", cfg.Server.Port)
 	}
 
+	if cfg.Server.ShutdownGracePeriodSeconds < 0 {
+		return fmt.Errorf("server shutdown grace period is negative: %v", cfg.Server.ShutdownGracePeriodSeconds)
+	}
+
 	if strings.Contains(cfg.Server.StaticContentRootDirectory, "..") {
 		return fmt.Errorf("server static content root directory must not contain '..': %v", cfg.Server.StaticContentRootDirectory)
 	}
@@ -1292,6 +1899,12 @@ func Validate(cfg Config) error {
 		return fmt.Errorf("for security purposes, web root must not contain '/../': %v", webRoot)
 	}
 
+	for _, origin := range cfg.Server.CORS.AllowedOrigins {
+		if origin != "*" && !strings.Contains(origin, "://") {
+			return fmt.Errorf("CORS allowed origin must be * or scheme://host[:port]: %v", origin)
+		}
+	}
+
 	// log some messages to let the administrator know when credentials are configured certain ways
 	auth := cfg.Auth
 	log.Infof("Using authentication strategy [%v]", auth.Strategy)
@@ -1304,6 +1917,11 @@ func Validate(cfg Config) error {
 		return fmt.Errorf("Invalid authentication strategy [%v]", auth.Strategy)
 	}
 
+	// The OpenId tokens are not passed to the clusters when impersonating the users
+	if auth.Strategy == AuthStrategyOpenId && auth.OpenId.Impersonation.Enabled && !auth.OpenId.DisableRBAC {
+		return fmt.Errorf("OpenId impersonation requires disable_rbac to be true")
+	}
+
 	// Check the ciphering key for sessions
 	signingKey := cfg.LoginToken.SigningKey
 	if err := validateSigningKey(signingKey, auth.Strategy); err != nil {
@@ -1332,12 +1950,209 @@ func Validate(cfg Config) error {
 		return fmt.Errorf("error in configuration options getting the observability exporter. Invalid collector type [%s]", observTracing.CollectorType)
 	}
 
+	// Check the compression section
+	if cfg.Server.GzipEnabled && cfg.Server.Compression.MinSize < 0 {
+		return fmt.Errorf("compression min size must not be negative: %v", cfg.Server.Compression.MinSize)
+	}
+
+	// Check the query cardinality section
+	if cardinality := cfg.ExternalServices.Prometheus.QueryCardinality; cardinality.MaxSeries < 0 {
+		return fmt.Errorf("prometheus query cardinality max series must not be negative: %v", cardinality.MaxSeries)
+	} else if cardinality.Action != QueryCardinalityDowngrade && cardinality.Action != QueryCardinalityReject {
+		return fmt.Errorf("prometheus query cardinality action is invalid: %s", cardinality.Action)
+	}
+
 	// Check the tracing section
 	cfgTracing := cfg.ExternalServices.Tracing
 	if cfgTracing.Enabled && cfgTracing.Provider != JaegerProvider && cfgTracing.Provider != TempoProvider {
 		return fmt.Errorf("error in configuration options for the external services tracing provider. Invalid provider type [%s]", cfgTracing.Provider)
 	}
 
+	// Check the background refresh section
+	if cfg.BackgroundRefresh.ValidationsIntervalSeconds <= 0 {
+		return fmt.Errorf("background refresh validations interval must be greater than 0: %v", cfg.BackgroundRefresh.ValidationsIntervalSeconds)
+	}
+	for _, ns := range cfg.BackgroundRefresh.Namespaces {
+		if _, err := regexp.Compile(ns.Namespace); err != nil {
+			return fmt.Errorf("background refresh namespace is not a valid regular expression [%s]: %s", ns.Namespace, err)
+		}
+		if ns.IntervalSeconds < 0 {
+			return fmt.Errorf("background refresh interval of namespace [%s] must not be negative: %v", ns.Namespace, ns.IntervalSeconds)
+		}
+		for _, controller := range ns.Controllers {
+			switch controller {
+			case BackgroundControllerSLO, BackgroundControllerTrafficBaseline, BackgroundControllerValidations:
+			default:
+				return fmt.Errorf("background refresh controller of namespace [%s] is invalid: %s", ns.Namespace, controller)
+			}
+		}
+	}
+
+	// Check the SLO section
+	if cfg.SLO.Enabled && cfg.SLO.EvaluationIntervalSeconds <= 0 {
+		return fmt.Errorf("slo evaluation interval must be greater than 0: %v", cfg.SLO.EvaluationIntervalSeconds)
+	}
+	for _, objective := range cfg.SLO.Objectives {
+		if objective.AvailabilityTarget <= 0 || objective.AvailabilityTarget >= 100 {
+			return fmt.Errorf("slo availability target must be greater than 0 and less than 100: %v", objective.AvailabilityTarget)
+		}
+		if objective.LatencyQuantile != "" {
+			if quantile, err := strconv.ParseFloat(objective.LatencyQuantile, 64); err != nil || !(quantile > 0 && quantile < 1) {
+				return fmt.Errorf("slo latency quantile must be a number greater than 0 and less than 1: %s", objective.LatencyQuantile)
+			}
+		}
+		if _, err := regexp.Compile(objective.Namespace); err != nil {
+			return fmt.Errorf("slo namespace is not a valid regular expression [%s]: %s", objective.Namespace, err)
+		}
+		if _, err := regexp.Compile(objective.Name); err != nil {
+			return fmt.Errorf("slo name is not a valid regular expression [%s]: %s", objective.Name, err)
+		}
+	}
+
+	// Check the traffic baseline section
+	if trafficBaseline := cfg.TrafficBaseline; trafficBaseline.Enabled {
+		if trafficBaseline.EvaluationIntervalSeconds <= 0 {
+			return fmt.Errorf("traffic baseline evaluation interval must be greater than 0: %v", trafficBaseline.EvaluationIntervalSeconds)
+		}
+		if _, err := model.ParseDuration(trafficBaseline.BaselineOffset); err != nil {
+			return fmt.Errorf("traffic baseline offset is not a valid duration [%s]: %s", trafficBaseline.BaselineOffset, err)
+		}
+		if _, err := model.ParseDuration(trafficBaseline.RateInterval); err != nil {
+			return fmt.Errorf("traffic baseline rate interval is not a valid duration [%s]: %s", trafficBaseline.RateInterval, err)
+		}
+	}
+
+	// Check the data masking section
+	if dataMasking := cfg.DataMasking; dataMasking.Enabled {
+		for _, pattern := range dataMasking.AnnotationPatterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("data masking annotation pattern [%s] is not a valid regular expression: %s", pattern, err)
+			}
+		}
+	}
+
+	// Check the notifications section
+	if notifications := cfg.Notifications; cfg.TrafficBaseline.Webhook.URL != "" {
+		if notifications.Directory == "" {
+			return errors.New("notifications directory must be set")
+		}
+		if notifications.DeliveryIntervalSeconds <= 0 {
+			return fmt.Errorf("notifications delivery interval must be greater than 0: %v", notifications.DeliveryIntervalSeconds)
+		}
+		if notifications.MaxAttempts <= 0 {
+			return fmt.Errorf("notifications max attempts must be greater than 0: %v", notifications.MaxAttempts)
+		}
+		for name, value := range map[string]string{"initial backoff": notifications.InitialBackoff, "max backoff": notifications.MaxBackoff, "dead letter retention": notifications.DeadLetterRetention} {
+			if duration, err := model.ParseDuration(value); err != nil || duration <= 0 {
+				return fmt.Errorf("notifications %s is not a valid duration [%s]", name, value)
+			}
+		}
+	}
+
+	// Check the test requests section
+	if testRequests := cfg.TestRequests; testRequests.Enabled {
+		if testRequests.PodSelector == "" {
+			return fmt.Errorf("test requests pod selector must not be empty")
+		}
+		if testRequests.TimeoutSeconds <= 0 {
+			return fmt.Errorf("test requests timeout must be greater than 0: %v", testRequests.TimeoutSeconds)
+		}
+	}
+
+	// Check the mutation webhook section
+	if webhook := cfg.MutationWebhook; webhook.URL != "" && webhook.TimeoutSeconds <= 0 {
+		return fmt.Errorf("mutation webhook timeout must be greater than 0: %v", webhook.TimeoutSeconds)
+	}
+
+	// Check the graph appender webhooks section
+	for _, webhook := range cfg.GraphAppenderWebhooks {
+		if webhook.Name == "" || webhook.URL == "" {
+			return fmt.Errorf("graph appender webhook [%s] must have a name and a url", webhook.Name)
+		}
+		if webhook.TimeoutSeconds < 0 {
+			return fmt.Errorf("graph appender webhook [%s] timeout must not be negative: %v", webhook.Name, webhook.TimeoutSeconds)
+		}
+	}
+
+	// Check the validation webhooks section
+	for _, webhook := range cfg.ValidationWebhooks {
+		if webhook.Name == "" || webhook.URL == "" {
+			return fmt.Errorf("validation webhook [%s] must have a name and a url", webhook.Name)
+		}
+		if webhook.TimeoutSeconds < 0 {
+			return fmt.Errorf("validation webhook [%s] timeout must not be negative: %v", webhook.Name, webhook.TimeoutSeconds)
+		}
+	}
+
+	// Check the Istio config snapshots section
+	if snapshots := cfg.IstioConfigSnapshots; snapshots.Enabled {
+		if snapshots.IntervalSeconds <= 0 {
+			return fmt.Errorf("istio config snapshots interval must be greater than 0: %v", snapshots.IntervalSeconds)
+		}
+		if snapshots.Directory == "" {
+			return errors.New("istio config snapshots directory must be set")
+		}
+		if snapshots.MaxCount < 0 {
+			return fmt.Errorf("istio config snapshots max count must not be negative: %v", snapshots.MaxCount)
+		}
+		if snapshots.MaxAge != "" {
+			if _, err := model.ParseDuration(snapshots.MaxAge); err != nil {
+				return fmt.Errorf("istio config snapshots max age is not a valid duration [%s]: %s", snapshots.MaxAge, err)
+			}
+		}
+	}
+
+	// Check the Istio config delete protection section
+	switch mode := cfg.IstioConfigDeleteProtection.Mode; mode {
+	case DeleteProtectionBlock, DeleteProtectionDisabled, DeleteProtectionWarn:
+	default:
+		return fmt.Errorf("istio config delete protection mode is invalid: %s", mode)
+	}
+
+	// Check the Istio config soft delete section
+	if softDelete := cfg.IstioConfigSoftDelete; softDelete.Enabled {
+		if softDelete.Directory == "" {
+			return errors.New("istio config soft delete directory must be set")
+		}
+		if duration, err := model.ParseDuration(softDelete.UndoWindow); err != nil || duration <= 0 {
+			return fmt.Errorf("istio config soft delete undo window is not a valid duration [%s]", softDelete.UndoWindow)
+		}
+	}
+
+	// Check the deep links section
+	for _, link := range cfg.DeepLinks {
+		if link.Name == "" || link.URLTemplate == "" {
+			return fmt.Errorf("deep link must have a name and a url template: %+v", link)
+		}
+		if _, err := regexp.Compile(link.Namespace); err != nil {
+			return fmt.Errorf("deep link [%s] namespace is not a valid regular expression [%s]: %s", link.Name, link.Namespace, err)
+		}
+		for _, variable := range deepLinkVariable.FindAllStringSubmatch(link.URLTemplate, -1) {
+			switch variable[1] {
+			case DeepLinkVariableCluster, DeepLinkVariableKind, DeepLinkVariableName, DeepLinkVariableNamespace:
+			default:
+				return fmt.Errorf("deep link [%s] url template has an unknown variable: %s", link.Name, variable[0])
+			}
+		}
+	}
+
+	if cfg.KialiFeatureFlags.Validations.RunWorkers <= 0 {
+		return fmt.Errorf("validations run workers must be greater than 0: %v", cfg.KialiFeatureFlags.Validations.RunWorkers)
+	}
+
+	// Check the reports section
+	if reports := cfg.Reports; reports.Enabled {
+		if reports.MaxJobs <= 0 {
+			return fmt.Errorf("reports max jobs must be greater than 0: %v", reports.MaxJobs)
+		}
+		if duration, err := model.ParseDuration(reports.Retention); err != nil || duration <= 0 {
+			return fmt.Errorf("reports retention is not a valid duration [%s]", reports.Retention)
+		}
+		if reports.TimeoutSeconds <= 0 {
+			return fmt.Errorf("reports timeout must be greater than 0: %v", reports.TimeoutSeconds)
+		}
+	}
+
 	return nil
 }
 
@@ -419,6 +419,136 @@ func TestValidateAuthStrategy(t *testing.T) {
 	}
 }
 
+func TestValidateOpenIdImpersonation(t *testing.T) {
+	conf := NewConfig()
+	conf.LoginToken.SigningKey = util.RandomString(16)
+	conf.Server.StaticContentRootDirectory = "."
+	conf.Auth.Strategy = AuthStrategyOpenId
+	conf.Auth.OpenId.Impersonation.Enabled = true
+
+	require.Error(t, Validate(*conf))
+
+	conf.Auth.OpenId.DisableRBAC = true
+	require.NoError(t, Validate(*conf))
+	require.True(t, conf.IsImpersonationEnabled())
+}
+
+func TestValidateDeepLinks(t *testing.T) {
+	conf := NewConfig()
+	conf.LoginToken.SigningKey = util.RandomString(16)
+	conf.Server.StaticContentRootDirectory = "."
+	conf.Auth.Strategy = AuthStrategyAnonymous
+
+	conf.DeepLinks = []DeepLink{{Name: "Source", Namespace: "bookinfo|travel-.*", URLTemplate: "https://git.example.com/${cluster}/${namespace}/${kind}/${name}"}}
+	require.NoError(t, Validate(*conf))
+
+	invalidLinks := []DeepLink{
+		{URLTemplate: "https://git.example.com/${name}"},
+		{Name: "Source"},
+		{Name: "Source", Namespace: "bookinfo(", URLTemplate: "https://git.example.com/${name}"},
+		{Name: "Source", URLTemplate: "https://git.example.com/${app}"},
+	}
+	for _, link := range invalidLinks {
+		conf.DeepLinks = []DeepLink{link}
+		require.Error(t, Validate(*conf), link)
+	}
+}
+
+func TestValidateSLOLatencyQuantile(t *testing.T) {
+	conf := NewConfig()
+	conf.LoginToken.SigningKey = util.RandomString(16)
+	conf.Server.StaticContentRootDirectory = "."
+	conf.Auth.Strategy = AuthStrategyAnonymous
+
+	for _, quantile := range []string{"", "0.5", "0.999"} {
+		conf.SLO.Objectives = []SLOObjective{{AvailabilityTarget: 99, LatencyQuantile: quantile}}
+		require.NoError(t, Validate(*conf), quantile)
+	}
+	for _, quantile := range []string{"0", "1", "99", "0.99, sum(up)", "NaN"} {
+		conf.SLO.Objectives = []SLOObjective{{AvailabilityTarget: 99, LatencyQuantile: quantile}}
+		require.Error(t, Validate(*conf), quantile)
+	}
+}
+
+func TestSLOObjectiveMatches(t *testing.T) {
+	conf, err := Unmarshal(`
+slo:
+  objectives:
+  - namespace: "^bookinfo$"
+    name: "reviews|ratings"
+    availability_target: 99
+  - availability_target: 95
+  - namespace: "("
+    availability_target: 90
+`)
+	require.NoError(t, err)
+	objectives := conf.SLO.Objectives
+
+	require.True(t, objectives[0].Matches("bookinfo", "reviews"))
+	require.False(t, objectives[0].Matches("bookinfo-test", "reviews"))
+	require.False(t, objectives[0].Matches("bookinfo", "details"))
+	// Empty expressions match everything
+	require.True(t, objectives[1].Matches("tutorial", "httpbin"))
+	// Invalid expressions match nothing
+	require.False(t, objectives[2].Matches("tutorial", "httpbin"))
+}
+
+func TestValidateIstioConfigDeleteProtection(t *testing.T) {
+	conf := NewConfig()
+	conf.LoginToken.SigningKey = util.RandomString(16)
+	conf.Server.StaticContentRootDirectory = "."
+	conf.Auth.Strategy = AuthStrategyAnonymous
+
+	for _, mode := range []string{DeleteProtectionBlock, DeleteProtectionDisabled, DeleteProtectionWarn} {
+		conf.IstioConfigDeleteProtection.Mode = mode
+		require.NoError(t, Validate(*conf), mode)
+	}
+
+	conf.IstioConfigDeleteProtection.Mode = "ask"
+	require.Error(t, Validate(*conf))
+}
+
+func TestValidatePrometheusQueryCardinality(t *testing.T) {
+	conf := NewConfig()
+	conf.LoginToken.SigningKey = util.RandomString(16)
+	conf.Server.StaticContentRootDirectory = "."
+	conf.Auth.Strategy = AuthStrategyAnonymous
+
+	conf.ExternalServices.Prometheus.QueryCardinality = QueryCardinalityConfig{Action: QueryCardinalityDowngrade, MaxSeries: 500}
+	require.NoError(t, Validate(*conf))
+
+	conf.ExternalServices.Prometheus.QueryCardinality = QueryCardinalityConfig{Action: "truncate", MaxSeries: 500}
+	require.Error(t, Validate(*conf))
+
+	conf.ExternalServices.Prometheus.QueryCardinality = QueryCardinalityConfig{Action: QueryCardinalityReject, MaxSeries: -1}
+	require.Error(t, Validate(*conf))
+}
+
+func TestValidationWebhooks(t *testing.T) {
+	conf := NewConfig()
+	conf.LoginToken.SigningKey = util.RandomString(16)
+	conf.Server.StaticContentRootDirectory = "."
+	conf.Auth.Strategy = AuthStrategyAnonymous
+
+	conf.ValidationWebhooks = []ValidationWebhook{{Name: "acme", URL: "http://acme:8080/validate", Auth: Auth{Token: "secret"}}}
+	require.NoError(t, Validate(*conf))
+	require.Equal(t, "xxx", conf.Obfuscate().ValidationWebhooks[0].Auth.Token)
+	require.Equal(t, "secret", conf.ValidationWebhooks[0].Auth.Token)
+
+	conf.ValidationWebhooks = []ValidationWebhook{{Name: "acme"}}
+	require.Error(t, Validate(*conf))
+}
+
+func TestDeepLink(t *testing.T) {
+	link := DeepLink{Name: "Logs", Kinds: []string{"workload", "VirtualService"}, Namespace: "bookinfo", URLTemplate: "https://logs.example.com/?q=${namespace}/${name}&cluster=${cluster}"}
+
+	require.True(t, link.AppliesTo("workload", "bookinfo"))
+	require.True(t, link.AppliesTo("virtualservice", "bookinfo"))
+	require.False(t, link.AppliesTo("service", "bookinfo"))
+	require.False(t, link.AppliesTo("workload", "bookinfo-dev"))
+	require.Equal(t, "https://logs.example.com/?q=bookinfo/reviews%20v1&cluster=east", link.URL("east", "workload", "bookinfo", "reviews v1"))
+}
+
 func TestIsRBACDisabled(t *testing.T) {
 	cases := map[string]struct {
 		authConfig         AuthConfig
@@ -439,6 +569,16 @@ func TestIsRBACDisabled(t *testing.T) {
 			},
 			expectRBACDisabled: true,
 		},
+		"openid impersonating the users should have RBAC enabled": {
+			authConfig: AuthConfig{
+				Strategy: AuthStrategyOpenId,
+				OpenId: OpenIdConfig{
+					DisableRBAC:   true,
+					Impersonation: OpenIdImpersonation{Enabled: true},
+				},
+			},
+			expectRBACDisabled: false,
+		},
 		"openid with rbac enabled should have RBAC enabled": {
 			authConfig: AuthConfig{
 				Strategy: AuthStrategyOpenId,
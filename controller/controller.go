@@ -11,6 +11,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"time"
 
 	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -20,6 +21,7 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/kubernetes/cache"
 	"github.com/kiali/kiali/log"
@@ -42,7 +44,8 @@ func NewScheme() (*runtime.Scheme, error) {
 }
 
 // Start creates and starts all the controllers. They'll get cancelled when the context is cancelled.
-func Start(ctx context.Context, cf kubernetes.ClientFactory, kialiCache cache.KialiCache, validationsService *business.IstioValidationsService) error {
+// The returned channel is closed once they are stopped.
+func Start(ctx context.Context, cf kubernetes.ClientFactory, kialiCache cache.KialiCache, validationsService *business.IstioValidationsService, sloService *business.SLOService, trafficService *business.TrafficBaselineService, snapshotService *business.SnapshotService, notificationService *business.NotificationService) (<-chan struct{}, error) {
 	// TODO: Replace with kiali logging but if this isn't set some errors are thrown.
 	ctrl.SetLogger(zap.New())
 
@@ -50,7 +53,7 @@ func Start(ctx context.Context, cf kubernetes.ClientFactory, kialiCache cache.Ki
 	log.Debug("Setting up Validations Contoller")
 	scheme, err := NewScheme()
 	if err != nil {
-		return fmt.Errorf("error setting up ValidationsController when creating scheme: %s", err)
+		return nil, fmt.Errorf("error setting up ValidationsController when creating scheme: %s", err)
 	}
 
 	// In the future this could be any cluster and not just home cluster.
@@ -63,7 +66,7 @@ func Start(ctx context.Context, cf kubernetes.ClientFactory, kialiCache cache.Ki
 		Scheme:  scheme,
 	})
 	if err != nil {
-		return fmt.Errorf("error setting up ValidationsController when creating manager: %s", err)
+		return nil, fmt.Errorf("error setting up ValidationsController when creating manager: %s", err)
 	}
 
 	var clusters []string
@@ -72,16 +75,59 @@ func Start(ctx context.Context, cf kubernetes.ClientFactory, kialiCache cache.Ki
 		clusters = append(clusters, client.ClusterInfo().Name)
 	}
 
-	if err := NewValidationsController(ctx, clusters, kialiCache, validationsService, mgr, nil); err != nil {
-		return fmt.Errorf("error setting up ValidationsController: %s", err)
+	validationsInterval := time.Duration(config.Get().BackgroundRefresh.ValidationsIntervalSeconds) * time.Second
+	if err := NewValidationsController(ctx, clusters, kialiCache, validationsService, mgr, &validationsInterval); err != nil {
+		return nil, fmt.Errorf("error setting up ValidationsController: %s", err)
 	}
 
+	if sloConf := config.Get().SLO; sloConf.Enabled {
+		log.Debug("Setting up SLO Controller")
+		evaluationInterval := time.Duration(sloConf.EvaluationIntervalSeconds) * time.Second
+		if err := NewSLOController(ctx, clusters, kialiCache, sloService, mgr, evaluationInterval); err != nil {
+			return nil, fmt.Errorf("error setting up SLOController: %s", err)
+		}
+	}
+
+	if trafficConf := config.Get().TrafficBaseline; trafficConf.Enabled {
+		log.Debug("Setting up Traffic Baseline Controller")
+		evaluationInterval := time.Duration(trafficConf.EvaluationIntervalSeconds) * time.Second
+		if err := NewTrafficBaselineController(ctx, clusters, kialiCache, trafficService, mgr, evaluationInterval); err != nil {
+			return nil, fmt.Errorf("error setting up TrafficBaselineController: %s", err)
+		}
+	}
+
+	// The notifications are only sent to the webhook of the traffic baseline for now
+	if notificationsConf := config.Get().Notifications; config.Get().TrafficBaseline.Webhook.URL != "" {
+		log.Debug("Setting up Notification Controller")
+		interval := time.Duration(notificationsConf.DeliveryIntervalSeconds) * time.Second
+		if err := NewNotificationController(ctx, notificationService, mgr, interval); err != nil {
+			return nil, fmt.Errorf("error setting up NotificationController: %s", err)
+		}
+	}
+
+	if snapshotsConf := config.Get().IstioConfigSnapshots; snapshotsConf.Enabled {
+		log.Debug("Setting up Istio Config Snapshots Controller")
+		interval := time.Duration(snapshotsConf.IntervalSeconds) * time.Second
+		if err := NewSnapshotController(ctx, clusters, snapshotService, mgr, interval); err != nil {
+			return nil, fmt.Errorf("error setting up SnapshotController: %s", err)
+		}
+	}
+
+	if config.Get().KubernetesConfig.CacheTokenRBACWatch {
+		log.Debug("Setting up RBAC Controller")
+		if err := NewRBACController(ctx, homeClusterInfo.Name, kialiCache, mgr); err != nil {
+			return nil, fmt.Errorf("error setting up RBACController: %s", err)
+		}
+	}
+
+	stopped := make(chan struct{})
 	go func() {
+		defer close(stopped)
 		if err := mgr.Start(ctx); err != nil {
 			log.Errorf("error starting Validations Controller: %s", err)
 		}
 		log.Debug("Stopped Validations Controller")
 	}()
 
-	return nil
+	return stopped, nil
 }
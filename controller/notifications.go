@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlsource "sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/log"
+)
+
+// NewNotificationController creates and starts a new controller that periodically delivers the queued
+// notifications. It stops when the ctx is cancelled.
+func NewNotificationController(
+	ctx context.Context,
+	notificationService *business.NotificationService,
+	mgr ctrl.Manager,
+	interval time.Duration,
+) error {
+	reconciler := &NotificationReconciler{notificationService: notificationService}
+
+	notificationController, err := controller.New("notification-controller", mgr, controller.Options{
+		Reconciler: reconciler,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting up NotificationController when creating controller: %s", err)
+	}
+
+	events := make(chan event.GenericEvent)
+	ticker := time.NewTicker(interval)
+	// A single dummy object is used so that only one delivery is queued at a time.
+	emptyObject := &networkingv1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "notification", Namespace: "queue"}}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				events <- event.GenericEvent{Object: emptyObject}
+			}
+		}
+	}()
+
+	if err := notificationController.Watch(ctrlsource.Channel(events, &handler.EnqueueRequestForObject{})); err != nil {
+		return fmt.Errorf("error setting up NotificationController when creating controller watch: %s", err)
+	}
+
+	return nil
+}
+
+// NotificationReconciler delivers the queued notifications.
+type NotificationReconciler struct {
+	notificationService *business.NotificationService
+}
+
+// Reconcile delivers the notifications that are due and removes the expired dead letters.
+func (r *NotificationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log.Debug("[NotificationReconciler] Started reconciling")
+	startTime := time.Now()
+	defer func() {
+		log.Debugf("[NotificationReconciler] Finished reconciling in %dms", time.Since(startTime).Milliseconds())
+	}()
+
+	if err := r.notificationService.Deliver(ctx, startTime); err != nil {
+		log.Errorf("[NotificationReconciler] Error delivering notifications: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	rbac_v1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	ctrlsource "sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/log"
+)
+
+// rbacRequest is the single work item of the RBAC controller, so that a burst of binding changes
+// clears the caches once.
+var rbacRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: "rbac", Namespace: "queue"}}
+
+// NewRBACController creates and starts a new controller that clears the namespaces and permissions cached
+// for the users when the RoleBindings or ClusterRoleBindings of the cluster change. It stops when the ctx is cancelled.
+func NewRBACController(
+	ctx context.Context,
+	cluster string,
+	kialiCache cache.KialiCache,
+	mgr ctrl.Manager,
+) error {
+	reconciler := NewRBACReconciler(cluster, kialiCache)
+
+	rbacController, err := controller.New("rbac-controller", mgr, controller.Options{
+		Reconciler: reconciler,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting up RBACController when creating controller: %s", err)
+	}
+
+	enqueue := handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return []reconcile.Request{rbacRequest}
+	})
+	for _, obj := range []client.Object{&rbac_v1.RoleBinding{}, &rbac_v1.ClusterRoleBinding{}} {
+		if err := rbacController.Watch(ctrlsource.Kind(mgr.GetCache(), obj, enqueue)); err != nil {
+			return fmt.Errorf("error setting up RBACController when creating controller watch: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func NewRBACReconciler(cluster string, kialiCache cache.KialiCache) *RBACReconciler {
+	return &RBACReconciler{
+		cluster:    cluster,
+		kialiCache: kialiCache,
+	}
+}
+
+// RBACReconciler clears the namespaces and permissions cached for the users of a cluster.
+type RBACReconciler struct {
+	cluster    string
+	kialiCache cache.KialiCache
+}
+
+// Reconcile clears the caches so that the next requests of the users see the newly granted or revoked access.
+func (r *RBACReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log.Debugf("[RBACReconciler] Bindings changed in cluster [%s], clearing the cached namespaces and permissions", r.cluster)
+	r.kialiCache.RefreshTokenNamespaces(r.cluster)
+	r.kialiCache.RefreshTokenPermissions(r.cluster)
+	return ctrl.Result{}, nil
+}
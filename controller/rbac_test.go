@@ -0,0 +1,41 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/controller"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func TestRBACReconcilerClearsTokenCaches(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	conf.KubernetesConfig.CacheTokenNamespaceDuration = 10000
+	client := kubetest.NewFakeK8sClient(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "istio-system"}},
+	)
+	cache := business.SetupBusinessLayer(t, client, *conf)
+	cluster := conf.KubernetesConfig.ClusterName
+	cache.SetNamespaces("token", []models.Namespace{{Name: "bookinfo", Cluster: cluster}})
+	cache.SetPermissions(cluster, "token", "bookinfo", &models.ResourcesPermissions{})
+
+	reconciler := controller.NewRBACReconciler(cluster, cache)
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: "rbac", Namespace: "queue"}}
+	_, err := reconciler.Reconcile(context.Background(), req)
+	require.NoError(err)
+
+	_, found := cache.GetNamespaces(cluster, "token")
+	require.False(found)
+	_, found = cache.GetPermissions(cluster, "token", "bookinfo")
+	require.False(found)
+}
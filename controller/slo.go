@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlsource "sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/log"
+)
+
+// NewSLOController creates and starts a new controller that periodically evaluates the SLOs of all services.
+// It stops when the ctx is cancelled.
+func NewSLOController(
+	ctx context.Context,
+	clusters []string,
+	kialiCache cache.KialiCache,
+	sloService *business.SLOService,
+	mgr ctrl.Manager,
+	evaluationInterval time.Duration,
+) error {
+	reconciler := NewSLOReconciler(clusters, kialiCache, sloService, evaluationInterval)
+
+	sloController, err := controller.New("slo-controller", mgr, controller.Options{
+		Reconciler: reconciler,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting up SLOController when creating controller: %s", err)
+	}
+
+	events := make(chan event.GenericEvent)
+	ticker := time.NewTicker(reconciler.evaluationInterval)
+	// Same as the validations controller, a single dummy object is used so that
+	// only one evaluation is queued at a time.
+	emptyObject := &networkingv1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "slo", Namespace: "queue"}}
+	go func() {
+		select {
+		case <-ctx.Done():
+			ticker.Stop()
+			return
+		default:
+			events <- event.GenericEvent{Object: emptyObject}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				events <- event.GenericEvent{Object: emptyObject}
+			}
+		}
+	}()
+
+	if err := sloController.Watch(ctrlsource.Channel(events, &handler.EnqueueRequestForObject{})); err != nil {
+		return fmt.Errorf("error setting up SLOController when creating controller watch: %s", err)
+	}
+
+	return nil
+}
+
+func NewSLOReconciler(
+	clusters []string,
+	kialiCache cache.KialiCache,
+	sloService *business.SLOService,
+	evaluationInterval time.Duration,
+) *SLOReconciler {
+	return &SLOReconciler{
+		clusters:           clusters,
+		evaluationInterval: evaluationInterval,
+		kialiCache:         kialiCache,
+		refreshSchedule:    business.NewRefreshSchedule(config.Get(), config.BackgroundControllerSLO),
+		sloService:         sloService,
+	}
+}
+
+// SLOReconciler evaluates the SLOs of all services and stores the results in the Kiali cache.
+type SLOReconciler struct {
+	clusters           []string
+	evaluationInterval time.Duration
+	kialiCache         cache.KialiCache
+	refreshSchedule    *business.RefreshSchedule
+	sloService         *business.SLOService
+}
+
+// Reconcile evaluates the SLOs and replaces the cached statuses.
+func (r *SLOReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log.Debug("[SLOReconciler] Started reconciling")
+	startTime := time.Now()
+	defer func() {
+		log.Debugf("[SLOReconciler] Finished reconciling in %dms", time.Since(startTime).Milliseconds())
+	}()
+
+	namespaces := r.refreshSchedule.Run(startTime)
+	statuses, err := r.sloService.EvaluateAll(ctx, r.clusters, namespaces)
+	if err != nil {
+		log.Errorf("[SLOReconciler] Error evaluating SLOs: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	// The namespaces that are not refreshed keep their last statuses
+	for key, status := range r.kialiCache.SLOStatuses().Items() {
+		if !namespaces.Includes(key.Cluster, key.Namespace) {
+			statuses[key] = status
+		}
+	}
+
+	r.kialiCache.SLOStatuses().Replace(statuses)
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlsource "sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/log"
+)
+
+// NewSnapshotController creates and starts a new controller that periodically saves the Istio config
+// of the configured namespaces. It stops when the ctx is cancelled.
+func NewSnapshotController(
+	ctx context.Context,
+	clusters []string,
+	snapshotService *business.SnapshotService,
+	mgr ctrl.Manager,
+	interval time.Duration,
+) error {
+	reconciler := &SnapshotReconciler{clusters: clusters, snapshotService: snapshotService}
+
+	snapshotController, err := controller.New("istio-config-snapshot-controller", mgr, controller.Options{
+		Reconciler: reconciler,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting up SnapshotController when creating controller: %s", err)
+	}
+
+	events := make(chan event.GenericEvent)
+	ticker := time.NewTicker(interval)
+	// A single dummy object is used so that only one snapshot is queued at a time.
+	emptyObject := &networkingv1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "istio-config-snapshot", Namespace: "queue"}}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				events <- event.GenericEvent{Object: emptyObject}
+			}
+		}
+	}()
+
+	if err := snapshotController.Watch(ctrlsource.Channel(events, &handler.EnqueueRequestForObject{})); err != nil {
+		return fmt.Errorf("error setting up SnapshotController when creating controller watch: %s", err)
+	}
+
+	return nil
+}
+
+// SnapshotReconciler saves the Istio config of the configured namespaces of all clusters.
+type SnapshotReconciler struct {
+	clusters        []string
+	snapshotService *business.SnapshotService
+}
+
+// Reconcile takes the snapshots and removes the ones beyond the retention limits.
+func (r *SnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log.Debug("[SnapshotReconciler] Started reconciling")
+	startTime := time.Now()
+	defer func() {
+		log.Debugf("[SnapshotReconciler] Finished reconciling in %dms", time.Since(startTime).Milliseconds())
+	}()
+
+	if err := r.snapshotService.TakeSnapshots(ctx, r.clusters); err != nil {
+		log.Errorf("[SnapshotReconciler] Error taking snapshots: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlsource "sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// NewTrafficBaselineController creates and starts a new controller that periodically compares the services traffic
+// with the traffic baseline. It stops when the ctx is cancelled.
+func NewTrafficBaselineController(
+	ctx context.Context,
+	clusters []string,
+	kialiCache cache.KialiCache,
+	trafficService *business.TrafficBaselineService,
+	mgr ctrl.Manager,
+	evaluationInterval time.Duration,
+) error {
+	reconciler := NewTrafficBaselineReconciler(clusters, kialiCache, trafficService)
+
+	trafficController, err := controller.New("traffic-baseline-controller", mgr, controller.Options{
+		Reconciler: reconciler,
+	})
+	if err != nil {
+		return fmt.Errorf("error setting up TrafficBaselineController when creating controller: %s", err)
+	}
+
+	events := make(chan event.GenericEvent)
+	ticker := time.NewTicker(evaluationInterval)
+	// A single dummy object is used so that only one analysis is queued at a time.
+	emptyObject := &networkingv1beta1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "traffic-baseline", Namespace: "queue"}}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				ticker.Stop()
+				return
+			case <-ticker.C:
+				events <- event.GenericEvent{Object: emptyObject}
+			}
+		}
+	}()
+
+	if err := trafficController.Watch(ctrlsource.Channel(events, &handler.EnqueueRequestForObject{})); err != nil {
+		return fmt.Errorf("error setting up TrafficBaselineController when creating controller watch: %s", err)
+	}
+
+	return nil
+}
+
+func NewTrafficBaselineReconciler(
+	clusters []string,
+	kialiCache cache.KialiCache,
+	trafficService *business.TrafficBaselineService,
+) *TrafficBaselineReconciler {
+	return &TrafficBaselineReconciler{
+		clusters:        clusters,
+		kialiCache:      kialiCache,
+		refreshSchedule: business.NewRefreshSchedule(config.Get(), config.BackgroundControllerTrafficBaseline),
+		trafficService:  trafficService,
+	}
+}
+
+// TrafficBaselineReconciler analyzes the traffic of all namespaces, stores the findings in the Kiali cache
+// and notifies the new ones.
+type TrafficBaselineReconciler struct {
+	clusters        []string
+	kialiCache      cache.KialiCache
+	refreshSchedule *business.RefreshSchedule
+	trafficService  *business.TrafficBaselineService
+}
+
+// Reconcile analyzes the traffic and replaces the cached findings. Findings that were already
+// detected keep their original detection time and are not notified again.
+func (r *TrafficBaselineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log.Debug("[TrafficBaselineReconciler] Started reconciling")
+	startTime := time.Now()
+	defer func() {
+		log.Debugf("[TrafficBaselineReconciler] Finished reconciling in %dms", time.Since(startTime).Milliseconds())
+	}()
+
+	namespaces := r.refreshSchedule.Run(startTime)
+	findings, err := r.trafficService.AnalyzeAll(ctx, r.clusters, namespaces)
+	if err != nil {
+		log.Errorf("[TrafficBaselineReconciler] Error analyzing traffic: %s", err)
+		return ctrl.Result{}, err
+	}
+
+	newFindings := models.TrafficFindings{}
+	for key, finding := range findings {
+		if previous, found := r.kialiCache.TrafficFindings().Get(key); found {
+			finding.DetectedAt = previous.DetectedAt
+		} else {
+			newFindings = append(newFindings, finding)
+		}
+	}
+	// The namespaces that are not refreshed keep their last findings
+	for key, finding := range r.kialiCache.TrafficFindings().Items() {
+		if !namespaces.Includes(key.Cluster, key.Namespace) {
+			findings[key] = finding
+		}
+	}
+	r.kialiCache.TrafficFindings().Replace(findings)
+
+	if err := r.trafficService.NotifyWebhook(ctx, newFindings); err != nil {
+		// The findings could not be queued, so they are not notified.
+		log.Errorf("[TrafficBaselineReconciler] Error queuing the notification of the findings: %s", err)
+	}
+
+	return ctrl.Result{}, nil
+}
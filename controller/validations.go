@@ -14,6 +14,7 @@ import (
 	ctrlsource "sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes/cache"
 	"github.com/kiali/kiali/log"
 	"github.com/kiali/kiali/models"
@@ -90,6 +91,7 @@ func NewValidationsReconciler(
 		clusters:           clusters,
 		kialiCache:         kialiCache,
 		reconcileInterval:  reconcileInterval,
+		refreshSchedule:    business.NewRefreshSchedule(config.Get(), config.BackgroundControllerValidations),
 		validationsService: validationsService,
 	}
 }
@@ -99,6 +101,7 @@ type ValidationsReconciler struct {
 	clusters           []string
 	kialiCache         cache.KialiCache
 	reconcileInterval  time.Duration
+	refreshSchedule    *business.RefreshSchedule
 	validationsService *business.IstioValidationsService
 }
 
@@ -119,9 +122,10 @@ func (r *ValidationsReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	// Check version before performing replace.
 	version := r.kialiCache.Validations().Version()
+	namespaces := r.refreshSchedule.Run(startTime)
 	allClusterValidations := make(models.IstioValidations)
 	for _, cluster := range r.clusters {
-		clusterValidations, err := r.validationsService.CreateValidations(ctx, cluster)
+		clusterValidations, err := r.validationsService.CreateNamespacesValidations(ctx, cluster, namespaces)
 		if err != nil {
 			log.Errorf("[ValidationsReconciler] Error creating validations for cluster %s: %s", cluster, err)
 			return ctrl.Result{}, err
@@ -130,6 +134,13 @@ func (r *ValidationsReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		allClusterValidations = allClusterValidations.MergeValidations(clusterValidations)
 	}
 
+	// The namespaces that are not refreshed keep their last validations
+	for key, validation := range r.kialiCache.Validations().Items() {
+		if !namespaces.Includes(key.Cluster, key.Namespace) {
+			allClusterValidations[key] = validation
+		}
+	}
+
 	if r.kialiCache.Validations().Version() != version {
 		return ctrl.Result{}, fmt.Errorf("validations have been updated since reconciling started. Requeing to revalidate")
 	}
@@ -0,0 +1,46 @@
+// Package demo provides the data of the demo mode: a synthetic mesh, with its Kubernetes and Istio objects and its
+// Istio metrics, served without a cluster nor a Prometheus server. It allows self-contained demos and developing the
+// frontend against the real API.
+package demo
+
+import (
+	prom_v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/prometheus"
+)
+
+// ClusterName is the name of the demo cluster, unless one is configured.
+const ClusterName = "demo"
+
+// Configure adapts the config to the demo mode: there is no cluster to authenticate the users against nor any
+// external service, and the synthetic metrics are served instead of the ones of the Prometheus server.
+func Configure(conf *config.Config) {
+	if conf.KubernetesConfig.ClusterName == "" {
+		conf.KubernetesConfig.ClusterName = ClusterName
+	}
+	conf.Auth.Strategy = config.AuthStrategyAnonymous
+	conf.Deployment.ClusterWideAccess = true
+	conf.ExternalServices.Grafana.Enabled = false
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	conf.ExternalServices.Prometheus.ThanosProxy.Enabled = false
+	conf.ExternalServices.Tracing.Enabled = false
+	conf.InCluster = false
+
+	cluster := conf.KubernetesConfig.ClusterName
+	prometheus.SetAPIProvider(func() prom_v1.API {
+		return NewPrometheusAPI(cluster)
+	})
+	log.Infof("Demo mode: serving a synthetic mesh in cluster [%s]", cluster)
+}
+
+// NewClientFactory returns a client factory whose clients serve the objects of the demo mesh, kept in memory: the
+// changes done by the users are lost on restart.
+func NewClientFactory(conf *config.Config) kubernetes.ClientFactory {
+	client := kubetest.NewFakeK8sClient(Objects(conf.KubernetesConfig.ClusterName)...)
+	client.KubeClusterInfo = kubernetes.ClusterInfo{Name: conf.KubernetesConfig.ClusterName}
+	return kubetest.NewFakeClientFactory(conf, map[string]kubernetes.ClientInterface{conf.KubernetesConfig.ClusterName: client})
+}
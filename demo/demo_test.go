@@ -0,0 +1,80 @@
+package demo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/prometheus"
+)
+
+func TestPrometheusAPI(t *testing.T) {
+	require := require.New(t)
+
+	api := NewPrometheusAPI("east")
+	now := time.Unix(1700000000, 0)
+
+	value, _, err := api.Query(context.Background(), `sum(rate(istio_requests_total{reporter="destination",destination_workload_namespace="bookinfo",destination_canonical_service=~"reviews|ratings"}[1m])) by (destination_workload)`, now)
+	require.NoError(err)
+	rates := map[string]float64{}
+	for _, sample := range value.(model.Vector) {
+		rates[string(sample.Metric["destination_workload"])] = float64(sample.Value)
+	}
+	require.Len(rates, 4)
+	require.InDelta(3.3*variation(now), rates["reviews-v1"], 0.0001)
+	require.InDelta(6.7*variation(now), rates["ratings-v1"], 0.0001)
+
+	value, _, err = api.Query(context.Background(), `sum(rate(istio_requests_total{reporter="destination",source_workload="reviews-v3",response_code=~"5.*"}[1m]))`, now)
+	require.NoError(err)
+	require.Len(value.(model.Vector), 1)
+	require.InDelta(3.4*0.05*variation(now), float64(value.(model.Vector)[0].Value), 0.0001)
+
+	value, _, err = api.Query(context.Background(), `histogram_quantile(0.5, sum(rate(istio_request_duration_milliseconds_bucket{reporter="source",destination_workload="details-v1"}[1m])) by (le,source_workload))`, now)
+	require.NoError(err)
+	require.Len(value.(model.Vector), 1)
+	require.Equal(model.LabelValue("productpage-v1"), value.(model.Vector)[0].Metric["source_workload"])
+	require.InDelta(5*variation(now), float64(value.(model.Vector)[0].Value), 0.0001)
+
+	value, _, err = api.Query(context.Background(), `sum(rate(istio_tcp_sent_bytes_total{reporter="source"}[1m]))`, now)
+	require.NoError(err)
+	require.Empty(value.(model.Vector))
+}
+
+func TestDemoMode(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.Demo.Enabled = true
+	Configure(conf)
+	t.Cleanup(func() { prometheus.SetAPIProvider(nil) })
+	config.Set(conf)
+	require.Equal(config.AuthStrategyAnonymous, conf.Auth.Strategy)
+
+	prom, err := prometheus.NewClient()
+	require.NoError(err)
+	rates, err := prom.GetNamespaceServicesRequestRates(AppNamespace, ClusterName, "1m", time.Now())
+	require.NoError(err)
+	require.NotEmpty(rates)
+
+	factory := NewClientFactory(conf)
+	business.SetupBusinessLayer(t, factory.GetSAHomeClusterClient(), *conf)
+	layer := business.NewWithBackends(factory.GetSAClients(), factory.GetSAClients(), prom, nil)
+
+	workloads, err := layer.Workload.GetWorkloadList(context.Background(), business.WorkloadCriteria{Cluster: ClusterName, Namespace: AppNamespace})
+	require.NoError(err)
+	require.Len(workloads.Workloads, 6)
+	for _, workload := range workloads.Workloads {
+		require.True(workload.IstioSidecar, workload.Name)
+	}
+
+	istioConfig, err := layer.IstioConfig.GetIstioConfigListForNamespace(context.Background(), ClusterName, AppNamespace, business.IstioConfigCriteria{IncludeVirtualServices: true, IncludeDestinationRules: true, IncludeGateways: true})
+	require.NoError(err)
+	require.Len(istioConfig.VirtualServices, 2)
+	require.Len(istioConfig.DestinationRules, 1)
+	require.Len(istioConfig.Gateways, 1)
+}
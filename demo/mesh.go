@@ -0,0 +1,227 @@
+package demo
+
+import (
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kiali/kiali/models"
+)
+
+const (
+	// AppNamespace is the namespace of the demo application.
+	AppNamespace = "bookinfo"
+	// ControlPlaneNamespace is the namespace of the demo control plane and ingress gateway.
+	ControlPlaneNamespace = "istio-system"
+	// unknown is the value of the source labels of the requests coming from outside the mesh.
+	unknown = "unknown"
+)
+
+// workload is a versioned workload of the demo mesh, with the service exposing it.
+type workload struct {
+	App       string
+	Namespace string
+	Port      int32
+	Sidecar   bool
+	Version   string
+}
+
+func (w workload) Name() string {
+	if w.Version == "" {
+		return w.App
+	}
+	return w.App + "-" + w.Version
+}
+
+// edge is a flow of requests between two workloads of the demo mesh.
+type edge struct {
+	Source      *workload // nil for the requests coming from outside the mesh
+	Destination workload
+	// ErrorRatio is the ratio of the requests failing with a 500
+	ErrorRatio float64
+	// Latency is the average duration of the requests, in milliseconds
+	Latency float64
+	// Rate is the number of requests per second
+	Rate float64
+}
+
+var (
+	ingressGateway = workload{App: "istio-ingressgateway", Namespace: ControlPlaneNamespace, Port: 80}
+	istiod         = workload{App: "istiod", Namespace: ControlPlaneNamespace, Port: 15010}
+	productpage    = workload{App: "productpage", Namespace: AppNamespace, Port: 9080, Sidecar: true, Version: "v1"}
+	details        = workload{App: "details", Namespace: AppNamespace, Port: 9080, Sidecar: true, Version: "v1"}
+	reviewsV1      = workload{App: "reviews", Namespace: AppNamespace, Port: 9080, Sidecar: true, Version: "v1"}
+	reviewsV2      = workload{App: "reviews", Namespace: AppNamespace, Port: 9080, Sidecar: true, Version: "v2"}
+	reviewsV3      = workload{App: "reviews", Namespace: AppNamespace, Port: 9080, Sidecar: true, Version: "v3"}
+	ratings        = workload{App: "ratings", Namespace: AppNamespace, Port: 9080, Sidecar: true, Version: "v1"}
+
+	workloads = []workload{istiod, ingressGateway, productpage, details, reviewsV1, reviewsV2, reviewsV3, ratings}
+
+	edges = []edge{
+		{Source: nil, Destination: ingressGateway, Rate: 10, Latency: 40},
+		{Source: &ingressGateway, Destination: productpage, Rate: 10, ErrorRatio: 0.005, Latency: 35},
+		{Source: &productpage, Destination: details, Rate: 10, ErrorRatio: 0.01, Latency: 5},
+		{Source: &productpage, Destination: reviewsV1, Rate: 3.3, Latency: 8},
+		{Source: &productpage, Destination: reviewsV2, Rate: 3.3, Latency: 15},
+		{Source: &productpage, Destination: reviewsV3, Rate: 3.4, Latency: 18},
+		{Source: &reviewsV2, Destination: ratings, Rate: 3.3, Latency: 4},
+		{Source: &reviewsV3, Destination: ratings, Rate: 3.4, ErrorRatio: 0.05, Latency: 4},
+	}
+)
+
+// Objects returns the Kubernetes and Istio objects of the demo mesh: a control plane and a bookinfo application
+// exposed by an ingress gateway.
+func Objects(cluster string) []runtime.Object {
+	objects := []runtime.Object{
+		&core_v1.Namespace{ObjectMeta: meta_v1.ObjectMeta{Name: ControlPlaneNamespace}},
+		&core_v1.Namespace{ObjectMeta: meta_v1.ObjectMeta{Name: AppNamespace, Labels: map[string]string{"istio-injection": "enabled"}}},
+		&core_v1.ConfigMap{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      "istio",
+				Namespace: ControlPlaneNamespace,
+				Labels:    map[string]string{models.IstioRevisionLabel: "default"},
+			},
+			Data: map[string]string{"mesh": "accessLogFile: /dev/stdout\nenableAutoMtls: true\nrootNamespace: istio-system\ntrustDomain: cluster.local\n"},
+		},
+	}
+
+	services := map[string]bool{}
+	for _, w := range workloads {
+		objects = append(objects, deployment(w, cluster), pod(w))
+		if !services[w.Namespace+"/"+w.App] {
+			services[w.Namespace+"/"+w.App] = true
+			objects = append(objects, service(w))
+		}
+	}
+	return append(objects, istioConfig()...)
+}
+
+func labels(w workload) map[string]string {
+	labels := map[string]string{"app": w.App}
+	if w.Version != "" {
+		labels["version"] = w.Version
+	}
+	if w == ingressGateway {
+		labels["istio"] = "ingressgateway"
+	}
+	if w == istiod {
+		labels[models.IstioRevisionLabel] = "default"
+	}
+	return labels
+}
+
+func deployment(w workload, cluster string) *apps_v1.Deployment {
+	replicas := int32(1)
+	container := core_v1.Container{Name: w.App, Image: "docker.io/istio/examples-bookinfo-" + w.Name() + ":1.20.2"}
+	if w == istiod {
+		container = core_v1.Container{
+			Name:  "discovery",
+			Image: "docker.io/istio/pilot:1.23.0",
+			Env:   []core_v1.EnvVar{{Name: "CLUSTER_ID", Value: cluster}},
+		}
+	}
+	return &apps_v1.Deployment{
+		ObjectMeta: meta_v1.ObjectMeta{Name: w.Name(), Namespace: w.Namespace, Labels: labels(w)},
+		Spec: apps_v1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &meta_v1.LabelSelector{MatchLabels: labels(w)},
+			Template: core_v1.PodTemplateSpec{
+				ObjectMeta: meta_v1.ObjectMeta{Labels: labels(w)},
+				Spec:       core_v1.PodSpec{Containers: []core_v1.Container{container}},
+			},
+		},
+		Status: apps_v1.DeploymentStatus{Replicas: replicas, AvailableReplicas: replicas, ReadyReplicas: replicas, UpdatedReplicas: replicas},
+	}
+}
+
+func pod(w workload) *core_v1.Pod {
+	pod := &core_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      w.Name() + "-5d4f8c7b9-x2k4p",
+			Namespace: w.Namespace,
+			Labels:    labels(w),
+			OwnerReferences: []meta_v1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: w.Name() + "-5d4f8c7b9"},
+			},
+		},
+		Spec: core_v1.PodSpec{Containers: []core_v1.Container{{Name: w.App}}},
+		Status: core_v1.PodStatus{
+			Phase:             core_v1.PodRunning,
+			ContainerStatuses: []core_v1.ContainerStatus{{Name: w.App, Ready: true}},
+		},
+	}
+	if w.Sidecar {
+		pod.Annotations = map[string]string{"sidecar.istio.io/status": `{"containers":["istio-proxy"]}`}
+		pod.Spec.Containers = append(pod.Spec.Containers, core_v1.Container{Name: "istio-proxy", Image: "docker.io/istio/proxyv2:1.23.0"})
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, core_v1.ContainerStatus{Name: "istio-proxy", Ready: true})
+	}
+	return pod
+}
+
+func service(w workload) *core_v1.Service {
+	portName := "http"
+	if w == istiod {
+		portName = "grpc-xds"
+	}
+	return &core_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{Name: w.App, Namespace: w.Namespace, Labels: map[string]string{"app": w.App}},
+		Spec: core_v1.ServiceSpec{
+			Selector: map[string]string{"app": w.App},
+			Ports:    []core_v1.ServicePort{{Name: portName, Port: w.Port, TargetPort: intstr.FromInt32(w.Port), Protocol: core_v1.ProtocolTCP}},
+		},
+	}
+}
+
+func istioConfig() []runtime.Object {
+	return []runtime.Object{
+		&networking_v1.Gateway{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo-gateway", Namespace: AppNamespace},
+			Spec: api_networking_v1.Gateway{
+				Selector: map[string]string{"istio": "ingressgateway"},
+				Servers: []*api_networking_v1.Server{
+					{Port: &api_networking_v1.Port{Number: 80, Name: "http", Protocol: "HTTP"}, Hosts: []string{"*"}},
+				},
+			},
+		},
+		&networking_v1.VirtualService{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo", Namespace: AppNamespace},
+			Spec: api_networking_v1.VirtualService{
+				Hosts:    []string{"*"},
+				Gateways: []string{"bookinfo-gateway"},
+				Http: []*api_networking_v1.HTTPRoute{
+					{Route: []*api_networking_v1.HTTPRouteDestination{
+						{Destination: &api_networking_v1.Destination{Host: "productpage", Port: &api_networking_v1.PortSelector{Number: 9080}}},
+					}},
+				},
+			},
+		},
+		&networking_v1.VirtualService{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: AppNamespace},
+			Spec: api_networking_v1.VirtualService{
+				Hosts: []string{"reviews"},
+				Http: []*api_networking_v1.HTTPRoute{
+					{Route: []*api_networking_v1.HTTPRouteDestination{
+						{Destination: &api_networking_v1.Destination{Host: "reviews", Subset: "v1"}, Weight: 33},
+						{Destination: &api_networking_v1.Destination{Host: "reviews", Subset: "v2"}, Weight: 33},
+						{Destination: &api_networking_v1.Destination{Host: "reviews", Subset: "v3"}, Weight: 34},
+					}},
+				},
+			},
+		},
+		&networking_v1.DestinationRule{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: AppNamespace},
+			Spec: api_networking_v1.DestinationRule{
+				Host: "reviews",
+				Subsets: []*api_networking_v1.Subset{
+					{Name: "v1", Labels: map[string]string{"version": "v1"}},
+					{Name: "v2", Labels: map[string]string{"version": "v2"}},
+					{Name: "v3", Labels: map[string]string{"version": "v3"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package demo
+
+import (
+	"math"
+	"time"
+
+	prom_v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+// quantiles are the quantiles of the histograms of the demo metrics, with their ratio to the average.
+var quantiles = map[string]float64{"0.5": 1, "0.95": 2.8, "0.99": 3}
+
+// variation makes the values of the series change during the day, so that the charts are not flat.
+func variation(t time.Time) float64 {
+	return 1 + 0.2*math.Sin(2*math.Pi*float64(t.Unix()%86400)/86400)
+}
+
+// NewPrometheusAPI returns a Prometheus API serving the synthetic Istio metrics of the demo mesh of a cluster.
+func NewPrometheusAPI(cluster string) prom_v1.API {
+	return prometheustest.NewFakePromAPI(Series(cluster)...)
+}
+
+// Series returns the Istio metrics of the demo mesh: the requests of the edges are reported by both of their sides,
+// except by the sources outside the mesh, with the failed requests in their own series.
+func Series(cluster string) []prometheustest.Series {
+	result := []prometheustest.Series{}
+	for _, e := range edges {
+		e := e
+		reporters := []string{"destination"}
+		if e.Source != nil {
+			reporters = append(reporters, "source")
+		}
+		for _, reporter := range reporters {
+			for _, code := range []string{"200", "500"} {
+				ratio := 1 - e.ErrorRatio
+				if code == "500" {
+					ratio = e.ErrorRatio
+				}
+				if ratio == 0 {
+					continue
+				}
+				labels := edgeLabels(cluster, e, reporter, code)
+				rate := func(t time.Time) float64 { return e.Rate * ratio * variation(t) }
+				latency := func(t time.Time) float64 { return e.Latency * variation(t) }
+
+				result = append(result,
+					prometheustest.Series{Metric: "istio_requests_total", Labels: labels, ValueFunc: rate},
+					prometheustest.Series{Metric: "istio_request_duration_milliseconds_count", Labels: labels, ValueFunc: rate},
+					// The sum of the durations is only queried divided by their count: the average latency
+					prometheustest.Series{Metric: "istio_request_duration_milliseconds_sum", Labels: labels, ValueFunc: latency},
+				)
+				for _, metric := range []string{"istio_request_bytes", "istio_response_bytes"} {
+					size := 512.0
+					if metric == "istio_response_bytes" {
+						size = 4096
+					}
+					result = append(result,
+						prometheustest.Series{Metric: metric + "_count", Labels: labels, ValueFunc: rate},
+						// The sum of the sizes is queried alone: the throughput
+						prometheustest.Series{Metric: metric + "_sum", Labels: labels, ValueFunc: func(t time.Time) float64 { return size * rate(t) }},
+					)
+				}
+				for quantile, factor := range quantiles {
+					factor := factor
+					quantileLabels := withLabel(labels, prometheustest.QuantileLabel, quantile)
+					result = append(result,
+						prometheustest.Series{Metric: "istio_request_duration_milliseconds_bucket", Labels: quantileLabels, ValueFunc: func(t time.Time) float64 { return factor * latency(t) }},
+						prometheustest.Series{Metric: "istio_request_bytes_bucket", Labels: quantileLabels, Value: factor * 512},
+						prometheustest.Series{Metric: "istio_response_bytes_bucket", Labels: quantileLabels, Value: factor * 4096},
+					)
+				}
+			}
+		}
+	}
+	return result
+}
+
+func withLabel(labels map[string]string, name, value string) map[string]string {
+	result := map[string]string{name: value}
+	for k, v := range labels {
+		result[k] = v
+	}
+	return result
+}
+
+func edgeLabels(cluster string, e edge, reporter, code string) map[string]string {
+	d := e.Destination
+	labels := map[string]string{
+		"reporter":                       reporter,
+		"request_protocol":               "http",
+		"response_code":                  code,
+		"response_flags":                 "-",
+		"connection_security_policy":     unknown,
+		"source_cluster":                 unknown,
+		"source_workload_namespace":      unknown,
+		"source_workload":                unknown,
+		"source_canonical_service":       unknown,
+		"source_canonical_revision":      unknown,
+		"source_app":                     unknown,
+		"source_version":                 unknown,
+		"source_principal":               unknown,
+		"destination_cluster":            cluster,
+		"destination_service":            d.App + "." + d.Namespace + ".svc.cluster.local",
+		"destination_service_name":       d.App,
+		"destination_service_namespace":  d.Namespace,
+		"destination_workload":           d.Name(),
+		"destination_workload_namespace": d.Namespace,
+		"destination_canonical_service":  d.App,
+		"destination_canonical_revision": revision(d),
+		"destination_app":                d.App,
+		"destination_version":            revision(d),
+		"destination_principal":          principal(d),
+	}
+	if s := e.Source; s != nil {
+		labels["source_cluster"] = cluster
+		labels["source_workload_namespace"] = s.Namespace
+		labels["source_workload"] = s.Name()
+		labels["source_canonical_service"] = s.App
+		labels["source_canonical_revision"] = revision(*s)
+		labels["source_app"] = s.App
+		labels["source_version"] = revision(*s)
+		labels["source_principal"] = principal(*s)
+		if reporter == "destination" {
+			labels["connection_security_policy"] = "mutual_tls"
+		}
+	}
+	return labels
+}
+
+func revision(w workload) string {
+	if w.Version == "" {
+		return "latest"
+	}
+	return w.Version
+}
+
+func principal(w workload) string {
+	return "spiffe://cluster.local/ns/" + w.Namespace + "/sa/" + w.App
+}
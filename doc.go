@@ -78,7 +78,7 @@ type LoggingParam struct {
 	Level ProxyLogLevel `json:"level"`
 }
 
-// swagger:parameters istioConfigList workloadDetails workloadUpdate serviceDetails serviceUpdate appSpans serviceSpans workloadSpans appTraces serviceTraces workloadTraces errorTraces workloadValidations serviceMetrics aggregateMetrics appMetrics workloadMetrics istioConfigDetails istioConfigDetailsSubtype istioConfigDelete istioConfigDeleteSubtype istioConfigUpdate istioConfigUpdateSubtype appDetails graphAggregate graphAggregateByService graphApp graphAppVersion graphNamespace graphService graphWorkload namespaceMetrics customDashboard appDashboard serviceDashboard workloadDashboard istioConfigCreate istioConfigCreateSubtype namespaceUpdate namespaceTls podDetails podLogs namespaceValidations podProxyDump podProxyResource podProxyLogging namespaceInfo
+// swagger:parameters istioConfigList workloadDetails workloadUpdate serviceDetails serviceUpdate appSpans serviceSpans workloadSpans appTraces serviceTraces workloadTraces errorTraces workloadValidations serviceMetrics aggregateMetrics appMetrics workloadMetrics istioConfigDetails istioConfigDetailsSubtype istioConfigDelete istioConfigDeleteSubtype istioConfigUpdate istioConfigUpdateSubtype appDetails graphAggregate graphAggregateByService graphApp graphAppVersion graphNamespace graphService graphWorkload namespaceMetrics customDashboard appDashboard serviceDashboard workloadDashboard istioConfigCreate istioConfigCreateSubtype namespaceUpdate namespaceTls podDetails podLogs namespaceValidations podProxyDump podRoutesVerify podProxyResource podProxyLogging podProxyLoggingLevels namespaceInfo namespaceSLO serviceSLO serviceEffectiveConfig istioConfigOrphans istioConfigOrphansDelete istioConfigActivity namespaceHealthEvents namespaceTelemetryDiagnostics namespaceTrafficFindings gatewayTraffic destinationRuleTrafficPolicies virtualServiceCors wasmPluginStatus namespaceEgressReport namespaceSidecarGenerate namespaceSidecarApply namespaceDNSCapture istioConfigBundleApply namespaceTrends metricsBatch namespaceReportCreate serviceRecentRequests serviceSubsets serviceSubsetsApply serviceAuthorizationPolicyGenerate serviceAuthorizationPolicyApply serviceTestRequest serviceLocality
 type NamespacePathParam struct {
 	// The namespace name.
 	//
@@ -87,6 +87,335 @@ type NamespacePathParam struct {
 	Name string `json:"namespace"`
 }
 
+// swagger:parameters istioConfigOrphans istioConfigOrphansDelete
+type OrphanTrafficWindowParam struct {
+	// The window in which ServiceEntries without traffic are reported as orphaned. Defaults to 7d.
+	//
+	// in: query
+	// required: false
+	Name string `json:"trafficWindow"`
+}
+
+// swagger:parameters namespaceTelemetryDiagnostics
+type TelemetryWindowParam struct {
+	// The window in which the Istio metrics and the proxy access logs are compared. Defaults to 10m.
+	//
+	// in: query
+	// required: false
+	Name string `json:"window"`
+}
+
+// swagger:parameters serviceRecentRequests
+type RecentRequestsParams struct {
+	// How far back the proxy access logs are read. Defaults to 1m.
+	//
+	// in: query
+	// required: false
+	Window string `json:"window"`
+	// The number of proxy log lines read per pod. Defaults to 100.
+	//
+	// in: query
+	// required: false
+	Tail int64 `json:"tail"`
+	// Keep streaming the requests as they are logged.
+	//
+	// in: query
+	// required: false
+	// default: false
+	Follow bool `json:"follow"`
+}
+
+// swagger:parameters istioConfigHostReferences
+type HostReferencesParams struct {
+	// The host to look up. Short service names are resolved in the namespace.
+	//
+	// in: query
+	// required: true
+	Host string `json:"host"`
+	// The namespace of the client, used to resolve short service names and to compute the DestinationRules precedence.
+	//
+	// in: query
+	// required: false
+	Namespace string `json:"namespace"`
+}
+
+// swagger:parameters search
+type SearchParams struct {
+	// The text the names of the entities must contain, ignoring case.
+	//
+	// in: query
+	// required: true
+	Query string `json:"q"`
+	// Comma separated list of the kinds of entities to look up: app, service, workload and istio. All kinds by default.
+	//
+	// in: query
+	// required: false
+	Kinds string `json:"kinds"`
+	// Comma separated list of the namespaces to look up. All accessible namespaces by default.
+	//
+	// in: query
+	// required: false
+	Namespaces string `json:"namespaces"`
+	// The cluster to look up. All clusters by default.
+	//
+	// in: query
+	// required: false
+	ClusterName string `json:"clusterName"`
+	// The maximum number of results, 0 for no limit.
+	//
+	// in: query
+	// required: false
+	// default: 20
+	Limit int `json:"limit"`
+}
+
+// swagger:parameters serviceListWindow workloadListWindow istioConfigListWindow
+type ListWindowParams struct {
+	// The offset of the first item of the window in the sorted list.
+	//
+	// in: query
+	// required: false
+	// default: 0
+	Offset int `json:"offset"`
+	// The maximum number of items of the window, up to 1000.
+	//
+	// in: query
+	// required: false
+	// default: 100
+	Limit int `json:"limit"`
+	// The sort key: name or namespace, or type for the workloads and the Istio config. The items of the same key
+	// are sorted by namespace, name and type, so that the order is stable.
+	//
+	// in: query
+	// required: false
+	// default: name
+	SortBy string `json:"sortBy"`
+	// The sort order: asc or desc.
+	//
+	// in: query
+	// required: false
+	// default: asc
+	SortOrder string `json:"sortOrder"`
+	// Comma separated list of the namespaces. All the namespaces accessible to the user by default.
+	//
+	// in: query
+	// required: false
+	Namespaces string `json:"namespaces"`
+}
+
+// swagger:parameters istioConfigSnapshots
+type IstioConfigSnapshotsParams struct {
+	// The namespace whose snapshots are listed. Snapshots of all accessible namespaces are listed by default.
+	//
+	// in: query
+	// required: false
+	Namespace string `json:"namespace"`
+}
+
+// swagger:parameters istioConfigSnapshot istioConfigSnapshotRestore
+type SnapshotParam struct {
+	// The snapshot id.
+	//
+	// in: path
+	// required: true
+	Name string `json:"snapshot"`
+}
+
+// swagger:parameters notificationDeadLetterRetry
+type NotificationParam struct {
+	// The notification id.
+	//
+	// in: path
+	// required: true
+	Name string `json:"notification"`
+}
+
+// swagger:parameters istioConfigDeleted
+type IstioConfigDeletedParams struct {
+	// The namespace whose deleted objects are listed. The deleted objects of all accessible namespaces are listed by default.
+	//
+	// in: query
+	// required: false
+	Namespace string `json:"namespace"`
+}
+
+// swagger:parameters istioConfigUndoDelete
+type DeletedIstioConfigParam struct {
+	// The id of the deleted object.
+	//
+	// in: path
+	// required: true
+	Name string `json:"deleted"`
+}
+
+// swagger:parameters istioConfigDiff
+type IstioConfigDiffParams struct {
+	// The namespace compared.
+	//
+	// in: query
+	// required: true
+	SourceNamespace string `json:"sourceNamespace"`
+	// The cluster of the source namespace. Defaults to the home cluster.
+	//
+	// in: query
+	// required: false
+	SourceCluster string `json:"sourceCluster"`
+	// The namespace compared against.
+	//
+	// in: query
+	// required: true
+	TargetNamespace string `json:"targetNamespace"`
+	// The cluster of the target namespace. Defaults to the source cluster.
+	//
+	// in: query
+	// required: false
+	TargetCluster string `json:"targetCluster"`
+	// The Istio object kinds compared, separated by ";". All kinds are compared by default.
+	//
+	// in: query
+	// required: false
+	Objects string `json:"objects"`
+	// Label selector of the objects compared.
+	//
+	// in: query
+	// required: false
+	LabelSelector string `json:"labelSelector"`
+}
+
+// swagger:parameters namespaceSidecarGenerate namespaceSidecarApply
+type GeneratedSidecarParams struct {
+	// The window in which the outbound traffic is looked for. Defaults to 1d.
+	//
+	// in: query
+	// required: false
+	TrafficWindow string `json:"trafficWindow"`
+}
+
+// swagger:parameters serviceAuthorizationPolicyGenerate serviceAuthorizationPolicyApply
+type GeneratedAuthorizationPolicyParams struct {
+	// The window in which the traffic to the service is looked for. Defaults to 1d.
+	//
+	// in: query
+	// required: false
+	TrafficWindow string `json:"trafficWindow"`
+	// Whether the sources are allowed by "principal" or by "namespace". Defaults to principal.
+	//
+	// in: query
+	// required: false
+	Granularity string `json:"granularity"`
+}
+
+// swagger:parameters namespaceEgressReport
+type EgressReportParams struct {
+	// The window in which the outbound traffic is looked for. Defaults to 1d.
+	//
+	// in: query
+	// required: false
+	TrafficWindow string `json:"trafficWindow"`
+	// The maximum number of destinations returned, the most used first. All are returned by default.
+	//
+	// in: query
+	// required: false
+	Limit int `json:"limit"`
+}
+
+// swagger:parameters serviceTestRequest
+type TestRequestParams struct {
+	// The request sent to the service.
+	//
+	// in: body
+	// required: true
+	Body models.TestRequest
+}
+
+// swagger:parameters metricsBatch
+type MetricsBatchParams struct {
+	// The services and workloads whose stats are returned.
+	//
+	// in: body
+	// required: true
+	Body models.MetricsBatchQuery
+}
+
+// swagger:parameters namespaceTrends
+type TrendsParams struct {
+	// The range of the trends, in seconds. Defaults to 7 days, up to 90 days.
+	//
+	// in: query
+	// required: false
+	Duration int64 `json:"duration"`
+	// The Unix time (seconds) of the end of the range. Defaults to now.
+	//
+	// in: query
+	// required: false
+	QueryTime int64 `json:"queryTime"`
+	// The app whose trends are returned.
+	//
+	// in: query
+	// required: false
+	App string `json:"app"`
+	// The service whose trends are returned.
+	//
+	// in: query
+	// required: false
+	Service string `json:"service"`
+	// The workload whose trends are returned.
+	//
+	// in: query
+	// required: false
+	Workload string `json:"workload"`
+}
+
+// swagger:parameters namespaceReportCreate
+type ReportCreateParams struct {
+	// The format of the report: html, json or pdf. Defaults to html.
+	//
+	// in: query
+	// required: false
+	Format string `json:"format"`
+	// The time range of the telemetry of the report (seconds or Prometheus duration). Defaults to 10m.
+	//
+	// in: query
+	// required: false
+	Duration string `json:"duration"`
+}
+
+// swagger:parameters validationsRunCreate
+type ValidationsRunParams struct {
+	// Comma-separated list of the namespaces to validate. Defaults to all the namespaces of the user.
+	//
+	// in: query
+	// required: false
+	Namespaces string `json:"namespaces"`
+}
+
+// swagger:parameters reportJob reportContent
+type ReportParam struct {
+	// The id of the report job.
+	//
+	// in: path
+	// required: true
+	Name string `json:"report"`
+}
+
+// swagger:parameters gatewayTraffic
+type GatewayTrafficParams struct {
+	// The Gateway name.
+	//
+	// in: path
+	// required: true
+	Gateway string `json:"gateway"`
+	// The rate interval used for fetching the rates and latencies.
+	//
+	// in: query
+	// default: 10m
+	RateInterval string `json:"rateInterval"`
+	// The Unix time (seconds) of the query. Defaults to now.
+	//
+	// in: query
+	QueryTime string `json:"queryTime"`
+}
+
 // swagger:parameters serviceList appList workloadList
 type NamespaceQueryParam struct {
 	// The namespace name.
@@ -115,7 +444,61 @@ type ObjectTypeParam struct {
 	Name string `json:"object_type"`
 }
 
-// swagger:parameters istioConfigList istioConfigDetails serviceDetails serviceUpdate
+// swagger:parameters istioConfigDelete
+type CascadeParam struct {
+	// Delete as a whole the VirtualServices, DestinationRules and Gateways created together by a Kiali wizard.
+	//
+	// in: query
+	// required: false
+	// default: false
+	Name bool `json:"cascade"`
+}
+
+// swagger:parameters istioConfigCreate istioConfigUpdate
+type DryRunParam struct {
+	// Run the change with a Kubernetes dry-run and return the validations of the resulting object, without persisting it.
+	//
+	// in: query
+	// required: false
+	// default: false
+	Name bool `json:"dryRun"`
+}
+
+// swagger:parameters externalServiceConnection
+type ExternalServiceParam struct {
+	// The external service.
+	//
+	// in: path
+	// required: true
+	// pattern: ^(alertmanager|grafana|prometheus|tracing)$
+	Name string `json:"service"`
+}
+
+// swagger:parameters sessionTimePinSet
+type TimePinParams struct {
+	// The unix time in seconds to pin. Defaults to now.
+	//
+	// in: query
+	// required: false
+	QueryTime string `json:"queryTime"`
+	// How long the pin lasts, up to 24h and never beyond the session expiration.
+	//
+	// in: query
+	// required: false
+	// default: 15m
+	TTL string `json:"ttl"`
+}
+
+// swagger:parameters sessionRevoke
+type SessionParam struct {
+	// The id of the session.
+	//
+	// in: path
+	// required: true
+	Name string `json:"session"`
+}
+
+// swagger:parameters istioConfigList istioConfigListWindow istioConfigDetails serviceDetails serviceUpdate
 type ValidateParam struct {
 	// Enable validation or not
 	//
@@ -124,7 +507,76 @@ type ValidateParam struct {
 	Name string `json:"validate"`
 }
 
-// swagger:parameters podDetails podLogs podProxyDump podProxyResource podProxyLogging
+// swagger:parameters istioConfigList istioConfigListAll
+type IstioConfigListFormatParam struct {
+	// The format of the response: json (default) or ndjson, to stream one object per line as the objects of
+	// each type are fetched. The ndjson format is also used when the Accept header is application/x-ndjson.
+	//
+	// in: query
+	// required: false
+	Format string `json:"format"`
+}
+
+// swagger:parameters istioConfigList istioConfigListAll
+type IstioConfigListSinceParam struct {
+	// The resourceVersion bookmark of a list held by the client. Only the objects changed after it are returned,
+	// with the deletions since then. A 410 Gone response means the changes are not known anymore: a full list is
+	// required.
+	//
+	// in: query
+	// required: false
+	Since string `json:"since"`
+}
+
+// swagger:parameters istioConfigListByNamespace
+type IstioConfigListByNamespaceParams struct {
+	// Comma separated list of the namespaces. All the namespaces accessible to the user by default.
+	//
+	// in: query
+	// required: false
+	Namespaces string `json:"namespaces"`
+	// Semicolon separated list of the Istio config types, all of them by default.
+	//
+	// in: query
+	// required: false
+	Objects string `json:"objects"`
+	// The label selector of the objects.
+	//
+	// in: query
+	// required: false
+	LabelSelector string `json:"labelSelector"`
+	// The cluster name. Defaults to the home cluster.
+	//
+	// in: query
+	// required: false
+	ClusterName string `json:"clusterName"`
+	// Include the validations of the objects of each namespace.
+	//
+	// in: query
+	// required: false
+	Validate bool `json:"validate"`
+}
+
+// swagger:parameters istioConfigListWindow
+type IstioConfigListWindowParams struct {
+	// Semicolon separated list of the Istio config types, all of them by default.
+	//
+	// in: query
+	// required: false
+	Objects string `json:"objects"`
+	// The label selector of the objects.
+	//
+	// in: query
+	// required: false
+	LabelSelector string `json:"labelSelector"`
+	// The cluster name. Defaults to the home cluster.
+	//
+	// in: query
+	// required: false
+	ClusterName string `json:"clusterName"`
+}
+
+// swagger:parameters podDetails podLogs podProxyDump podRoutesVerify podProxyResource podProxyLogging podProxyLoggingLevels
 type PodParam struct {
 	// The pod name.
 	//
@@ -142,13 +594,81 @@ type ResourceParam struct {
 	Name string `json:"resource"`
 }
 
-// swagger:parameters serviceDetails serviceUpdate serviceMetrics graphService graphAggregateByService serviceDashboard serviceSpans serviceTraces
+// swagger:parameters serviceDetails serviceUpdate serviceMetrics graphService graphAggregateByService serviceDashboard serviceSpans serviceTraces serviceSLO serviceEffectiveConfig serviceRecentRequests serviceSubsets serviceSubsetsApply serviceAuthorizationPolicyGenerate serviceAuthorizationPolicyApply serviceTestRequest serviceLocality
 type ServiceParam struct {
 	// The service name.
 	//
 	// in: path
 	// required: true
-	Name string `json:"service"`
+	Name string `json:"service"`
+}
+
+// swagger:parameters serviceEffectiveConfig
+type ClientNamespaceParam struct {
+	// The namespace of the clients the outbound config is computed for. Defaults to the service namespace.
+	//
+	// in: query
+	// required: false
+	Name string `json:"clientNamespace"`
+}
+
+// swagger:parameters podRoutesVerify
+type VirtualServiceParam struct {
+	// The name of the VirtualService to verify.
+	//
+	// in: query
+	// required: true
+	Name string `json:"virtualService"`
+}
+
+// swagger:parameters podRoutesVerify
+type VirtualServiceNamespaceParam struct {
+	// The namespace of the VirtualService to verify. Defaults to the pod namespace.
+	//
+	// in: query
+	// required: false
+	Name string `json:"virtualServiceNamespace"`
+}
+
+// swagger:parameters destinationRuleTrafficPolicies
+type DestinationRuleParam struct {
+	// The DestinationRule name.
+	//
+	// in: path
+	// required: true
+	Name string `json:"destinationrule"`
+}
+
+// swagger:parameters virtualServiceCors
+type VirtualServicePathParam struct {
+	// The VirtualService name.
+	//
+	// in: path
+	// required: true
+	Name string `json:"virtualservice"`
+}
+
+// swagger:parameters virtualServiceCors
+type VirtualServiceCorsParams struct {
+	// The name of the route, or its index when it has no name. Defaults to all the HTTP routes.
+	//
+	// in: query
+	// required: false
+	Route string `json:"route"`
+	// The origin of the requests to compute the behavior and the CORS headers for, i.e. https://example.com.
+	//
+	// in: query
+	// required: false
+	Origin string `json:"origin"`
+}
+
+// swagger:parameters wasmPluginStatus
+type WasmPluginParam struct {
+	// The WasmPlugin name.
+	//
+	// in: path
+	// required: true
+	Name string `json:"wasmplugin"`
 }
 
 // swagger:parameters podLogs
@@ -204,7 +724,7 @@ type WorkloadParam struct {
 
 // swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
 type AppendersParam struct {
-	// Comma-separated list of Appenders to run. Available appenders: [aggregateNode, deadNode, healthConfig, idleNode, istio, responseTime, securityPolicy, serviceEntry, sidecarsCheck, throughput].
+	// Comma-separated list of Appenders to run. Available appenders: [aggregateNode, deadNode, healthConfig, idleNode, istio, responseTime, retryStorm, securityPolicy, serviceEntry, sidecarsCheck, slo, throughput, timeoutBudget], plus the custom appenders and the configured graph appender webhooks.
 	//
 	// in: query
 	// required: false
@@ -270,6 +790,16 @@ type InjectServiceNodes struct {
 	Name string `json:"injectServiceNodes"`
 }
 
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+type LayoutParam struct {
+	// Server-side layout of the nodes, setting their position. Available layouts: [hierarchical, none].
+	//
+	// in: query
+	// required: false
+	// default: none
+	Name string `json:"layout"`
+}
+
 // swagger:parameters graphNamespaces
 type NamespacesParam struct {
 	// Comma-separated list of namespaces to include in the graph. The namespaces must be accessible to the client.
@@ -329,6 +859,16 @@ type ResponseTimeParam struct {
 	Name string `json:"responseTime"`
 }
 
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+type RetryStormThresholdParam struct {
+	// Used only with retryStorm appender. The amplification of the load of a node by the retries of a call chain to flag, greater than 1.
+	//
+	// in: query
+	// required: false
+	// default: 10
+	Name string `json:"retryStormThreshold"`
+}
+
 // swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
 type ThroughputParam struct {
 	// Used only with throughput appender. One of: request | response.
@@ -339,6 +879,24 @@ type ThroughputParam struct {
 	Name string `json:"throughput"`
 }
 
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+type TimeoutBudgetSourceParam struct {
+	// Used only with timeoutBudget appender, required by it. The ID of the node starting the path.
+	//
+	// in: query
+	// required: false
+	Name string `json:"timeoutBudgetSource"`
+}
+
+// swagger:parameters graphApp graphAppVersion graphNamespaces graphService graphWorkload
+type TimeoutBudgetTargetParam struct {
+	// Used only with timeoutBudget appender, required by it. The ID of the node ending the path.
+	//
+	// in: query
+	// required: false
+	Name string `json:"timeoutBudgetTarget"`
+}
+
 /////////////////////
 // SWAGGER PARAMETERS - METRICS
 // - keep this alphabetized
@@ -353,6 +911,36 @@ type AdditionalLabelsParam struct {
 	Name string `json:"additionalLabels"`
 }
 
+// swagger:parameters serviceMetrics aggregateMetrics appMetrics workloadMetrics
+type AnomaliesParam struct {
+	// Anomaly scoring of the rate of change of the series: 'zscore' or 'mad' (median absolute deviation). The flagged
+	// datapoints are returned in the anomalies of each series.
+	//
+	// in: query
+	// required: false
+	Name string `json:"anomalies"`
+}
+
+// swagger:parameters serviceMetrics aggregateMetrics appMetrics workloadMetrics
+type AnomalyThresholdParam struct {
+	// Absolute score from which a datapoint is flagged as an anomaly.
+	//
+	// in: query
+	// required: false
+	// default: 3 for zscore, 3.5 for mad
+	Name float64 `json:"anomalyThreshold"`
+}
+
+// swagger:parameters serviceMetrics aggregateMetrics appMetrics workloadMetrics
+type AnomalyWindowParam struct {
+	// Number of trailing datapoints of the baseline the anomalies are scored against.
+	//
+	// in: query
+	// required: false
+	// default: 20
+	Name int `json:"anomalyWindow"`
+}
+
 // swagger:parameters serviceMetrics aggregateMetrics appMetrics workloadMetrics customDashboard appDashboard serviceDashboard workloadDashboard
 type AvgParam struct {
 	// Flag for fetching histogram average. Default is true.
@@ -541,6 +1129,34 @@ type NotAcceptableError struct {
 	} `json:"body"`
 }
 
+// A ConflictError is the error message that means the request conflicts with the state of the objects
+//
+// swagger:response conflictError
+type ConflictError struct {
+	// in: body
+	Body struct {
+		// HTTP status code
+		// example: 409
+		// default: 409
+		Code    int32 `json:"code"`
+		Message error `json:"message"`
+	} `json:"body"`
+}
+
+// A GoneError is the error message that means the requested state is not available anymore
+//
+// swagger:response goneError
+type GoneError struct {
+	// in: body
+	Body struct {
+		// HTTP status code
+		// example: 410
+		// default: 410
+		Code    int32 `json:"code"`
+		Message error `json:"message"`
+	} `json:"body"`
+}
+
 // A Internal is the error message that means something has gone wrong
 //
 // swagger:response internalError
@@ -576,6 +1192,13 @@ type SwaggStatusInfoResp struct {
 	Body status.StatusInfo
 }
 
+// HTTP status code 200 and the outcome of the connection checks of the external services
+// swagger:response externalServicesConnectionsResponse
+type SwaggExternalServicesConnectionsResp struct {
+	// in:body
+	Body []models.ExternalServiceConnection
+}
+
 // HTTP status code 200 and userGenerated model in data
 // swagger:response userSessionData
 type SwaggTokenGeneratedResp struct {
@@ -583,6 +1206,20 @@ type SwaggTokenGeneratedResp struct {
 	Body authentication.UserSessionData
 }
 
+// Return the query time pinned for the session
+// swagger:response timePinResponse
+type TimePinResponse struct {
+	// in:body
+	Body authentication.TimePin
+}
+
+// HTTP status code 200 and the active sessions of the user in data
+// swagger:response sessionsResponse
+type SessionsResponse struct {
+	// in:body
+	Body []authentication.SessionInfo
+}
+
 // HTTP status code 200 and cytoscapejs Config in data
 // swagger:response graphResponse
 type GraphResponse struct {
@@ -597,6 +1234,13 @@ type IstioConfigResponse struct {
 	Body models.IstioConfigList
 }
 
+// HTTP status code 200 and the IstioConfigList, or the error, of each namespace in data
+// swagger:response istioConfigNamespaceMap
+type IstioConfigNamespaceMapResponse struct {
+	// in:body
+	Body models.IstioConfigNamespaceMap
+}
+
 // Listing all services in the namespace
 // swagger:response serviceListResponse
 type ServiceListResponse struct {
@@ -611,6 +1255,27 @@ type WorkloadListResponse struct {
 	Body models.WorkloadList
 }
 
+// A window of the sorted list of services of a cluster
+// swagger:response serviceWindowResponse
+type ServiceWindowResponse struct {
+	// in:body
+	Body models.ServiceWindow
+}
+
+// A window of the sorted list of workloads of a cluster
+// swagger:response workloadWindowResponse
+type WorkloadWindowResponse struct {
+	// in:body
+	Body models.WorkloadWindow
+}
+
+// A window of the sorted list of Istio objects of a cluster
+// swagger:response istioConfigWindowResponse
+type IstioConfigWindowResponse struct {
+	// in:body
+	Body models.IstioConfigWindow
+}
+
 // Listing all apps in the namespace
 // swagger:response appListResponse
 type AppListResponse struct {
@@ -639,6 +1304,13 @@ type ServiceDetailsResponse struct {
 	Body models.ServiceDetails
 }
 
+// The requests recently received by a service, one per line of a NDJSON stream
+// swagger:response serviceRecentRequestsResponse
+type ServiceRecentRequestsResponse struct {
+	// in:body
+	Body []models.RecentRequest
+}
+
 // Listing all the information related to a Trace
 // swagger:response traceDetailsResponse
 type TraceDetailsResponse struct {
@@ -674,6 +1346,20 @@ type MetricsResponse struct {
 	Body models.MetricsMap
 }
 
+// Metrics batch response model
+// swagger:response metricsBatchResponse
+type MetricsBatchResponse struct {
+	// in:body
+	Body models.MetricsBatch
+}
+
+// Trends response model
+// swagger:response trendsResponse
+type TrendsResponse struct {
+	// in:body
+	Body models.Trends
+}
+
 // Dashboard response model
 // swagger:response dashboardResponse
 type DashboardResponse struct {
@@ -730,6 +1416,13 @@ type MeshTlsResponse struct {
 	Body models.MTLSStatus
 }
 
+// Return the mTLS status of the whole Mesh and of each Namespace
+// swagger:response meshTlsOverviewResponse
+type MeshTlsOverviewResponse struct {
+	// in:body
+	Body models.MTLSOverview
+}
+
 // Return the mTLS status of a specific Namespace
 // swagger:response namespaceTlsResponse
 type NamespaceTlsResponse struct {
@@ -737,6 +1430,202 @@ type NamespaceTlsResponse struct {
 	Body models.MTLSStatus
 }
 
+// Return the SLO status of the services of a specific Namespace
+// swagger:response namespaceSLOResponse
+type NamespaceSLOResponse struct {
+	// in:body
+	Body models.SLOStatuses
+}
+
+// Return the traffic baseline findings of the services of a specific Namespace
+// swagger:response namespaceTrafficFindingsResponse
+type NamespaceTrafficFindingsResponse struct {
+	// in:body
+	Body models.TrafficFindings
+}
+
+// Return the Istio objects referencing a host
+// swagger:response istioConfigHostReferencesResponse
+type IstioConfigHostReferencesResponse struct {
+	// in:body
+	Body models.HostReferences
+}
+
+// Return the entities matching a search
+// swagger:response searchResponse
+type SearchResponse struct {
+	// in:body
+	Body models.SearchResults
+}
+
+// Return the servers of the Istio Gateways by host and port
+// swagger:response istioGatewayServersResponse
+type IstioGatewayServersResponse struct {
+	// in:body
+	Body models.GatewayServerMap
+}
+
+// Return the snapshots of the Istio config
+// swagger:response istioConfigSnapshotsResponse
+type IstioConfigSnapshotsResponse struct {
+	// in:body
+	Body []models.IstioConfigSnapshotSummary
+}
+
+// Return a snapshot of the Istio config of a namespace
+// swagger:response istioConfigSnapshotResponse
+type IstioConfigSnapshotResponse struct {
+	// in:body
+	Body models.IstioConfigSnapshot
+}
+
+// Return the result of restoring a snapshot
+// swagger:response istioConfigSnapshotRestoreResponse
+type IstioConfigSnapshotRestoreResponse struct {
+	// in:body
+	Body models.IstioConfigSnapshotRestore
+}
+
+// Return a report job
+// swagger:response reportJobResponse
+type ReportJobResponse struct {
+	// in:body
+	Body models.ReportJob
+}
+
+// Return the report jobs of the user
+// swagger:response reportJobsResponse
+type ReportJobsResponse struct {
+	// in:body
+	Body []models.ReportJob
+}
+
+// Return the report, as HTML, JSON or PDF
+// swagger:response reportContentResponse
+type ReportContentResponse struct {
+	// in:body
+	Body []byte
+}
+
+// Return the Istio objects whose deletion can be undone
+// swagger:response istioConfigDeletedResponse
+type IstioConfigDeletedResponse struct {
+	// in:body
+	Body []models.DeletedIstioConfig
+}
+
+// Return the result of undoing the deletion of an Istio object
+// swagger:response istioConfigUndoDeleteResponse
+type IstioConfigUndoDeleteResponse struct {
+	// in:body
+	Body models.DeletedIstioConfigRestore
+}
+
+// Return the comparison of the Istio config of two namespaces
+// swagger:response istioConfigDiffResponse
+type IstioConfigDiffResponse struct {
+	// in:body
+	Body models.IstioConfigDiff
+}
+
+// Return the outbound traffic of a namespace to destinations not covered by any ServiceEntry
+// swagger:response namespaceEgressReportResponse
+type NamespaceEgressReportResponse struct {
+	// in:body
+	Body models.EgressReport
+}
+
+// Return the namespace-wide Sidecar restricting the egress of a namespace to the destinations of its traffic
+// swagger:response generatedSidecarResponse
+type GeneratedSidecarResponse struct {
+	// in:body
+	Body models.GeneratedSidecar
+}
+
+// Return the DNS capture of the workloads of a namespace and the addresses resolved by their sidecars
+// swagger:response namespaceDNSCaptureResponse
+type NamespaceDNSCaptureResponse struct {
+	// in:body
+	Body models.DNSCaptureReport
+}
+
+// Return the traffic going through a Gateway, broken down by VirtualService route
+// swagger:response gatewayTrafficResponse
+type GatewayTrafficResponse struct {
+	// in:body
+	Body models.IngressTraffic
+}
+
+// Return the SLO status of a specific Service
+// swagger:response serviceSLOResponse
+type ServiceSLOResponse struct {
+	// in:body
+	Body models.SLOStatus
+}
+
+// Return the Istio config applied to the traffic of a specific Service
+// swagger:response serviceEffectiveConfigResponse
+type ServiceEffectiveConfigResponse struct {
+	// in:body
+	Body models.ServiceEffectiveConfig
+}
+
+// Return the DestinationRule subsets generated for the versions of the workloads of a Service
+// swagger:response serviceSubsetsResponse
+type ServiceSubsetsResponse struct {
+	// in:body
+	Body models.ServiceSubsets
+}
+
+// Return the locality load balancing settings applying to a Service and the localities of its endpoints
+// swagger:response serviceLocalityResponse
+type ServiceLocalityResponse struct {
+	// in:body
+	Body models.ServiceLocality
+}
+
+// Return the AuthorizationPolicy allowing only the sources observed calling a Service
+// swagger:response generatedAuthorizationPolicyResponse
+type GeneratedAuthorizationPolicyResponse struct {
+	// in:body
+	Body models.GeneratedAuthorizationPolicy
+}
+
+// Return the response to a test request sent to a Service and the route it matched
+// swagger:response testRequestResponse
+type TestRequestResponse struct {
+	// in:body
+	Body models.TestRequestResult
+}
+
+// Return whether the last change of a VirtualService is live in the proxy of a pod
+// swagger:response envoyRouteVerificationResponse
+type EnvoyRouteVerificationResponse struct {
+	// in:body
+	Body models.EnvoyRouteVerification
+}
+
+// Return how the traffic policy of a DestinationRule combines with its defaults, port level settings and subsets
+// swagger:response destinationRuleTrafficPoliciesResponse
+type DestinationRuleTrafficPoliciesResponse struct {
+	// in:body
+	Body models.DestinationRuleTrafficPolicies
+}
+
+// Return the effective CORS behavior of the routes of a VirtualService
+// swagger:response virtualServiceCorsResponse
+type VirtualServiceCorsResponse struct {
+	// in:body
+	Body models.VirtualServiceCors
+}
+
+// Return the rollout of a WasmPlugin: the reachability of its module and the workloads it attaches to
+// swagger:response wasmPluginStatusResponse
+type WasmPluginStatusResponse struct {
+	// in:body
+	Body models.WasmPluginStatus
+}
+
 // Return the validation status of a specific Namespace
 // swagger:response namespaceValidationSummaryResponse
 type NamespaceValidationSummaryResponse struct {
@@ -751,6 +1640,13 @@ type ConfigDumpResponse struct {
 	Body models.EnvoyProxyDump
 }
 
+// Return the log levels of a given envoy proxy
+// swagger:response proxyLogLevelsResponse
+type ProxyLogLevelsResponse struct {
+	// in:body
+	Body models.ProxyLogLevels
+}
+
 // Return a dump of the configuration of a given envoy proxy
 // swagger:response configDumpResource
 type ConfigDumpResourceResponse struct {
@@ -784,6 +1680,161 @@ type IstioStatusResponse struct {
 	Body kubernetes.IstioComponentStatus
 }
 
+// Return the state of the informers of the Kiali cache of every cluster
+// swagger:response cacheStatsResponse
+type CacheStatsResponse struct {
+	// in: body
+	Body []models.KubeCacheStats
+}
+
+// Return the notifications that failed all their delivery attempts
+// swagger:response notificationDeadLettersResponse
+type NotificationDeadLettersResponse struct {
+	// in: body
+	Body []models.Notification
+}
+
+// Return a notification queued for delivery
+// swagger:response notificationResponse
+type NotificationResponse struct {
+	// in: body
+	Body models.Notification
+}
+
+// Return the latest changes of the Istio config of a namespace
+// swagger:response istioConfigActivityResponse
+type IstioConfigActivityResponse struct {
+	// in: body
+	Body []models.IstioConfigEvent
+}
+
+// The transitions of the health status of the apps of a namespace, as server-sent events
+// swagger:response namespaceHealthEventsResponse
+type NamespaceHealthEventsResponse struct {
+	// in: body
+	Body []models.AppHealthTransition
+}
+
+// swagger:parameters namespaceHealthEvents
+type NamespaceHealthEventsParams struct {
+	// The interval between the evaluations of the health, as a duration of at least 5s. Defaults to 30s.
+	//
+	// in: query
+	// required: false
+	Interval string `json:"interval"`
+	// The rate interval used for fetching the error rate. Defaults to 10m.
+	//
+	// in: query
+	// required: false
+	RateInterval string `json:"rateInterval"`
+	// The cluster name. Defaults to the home cluster.
+	//
+	// in: query
+	// required: false
+	ClusterName string `json:"clusterName"`
+}
+
+// swagger:parameters istioConfigActivity
+type IstioConfigActivityParams struct {
+	// Only the changes after this time (RFC3339) are returned.
+	//
+	// in: query
+	// required: false
+	Since string `json:"since"`
+	// The maximum number of changes returned. All of them when it is 0.
+	//
+	// in: query
+	// required: false
+	Limit int `json:"limit"`
+	// The format of the response: json (default), rss, or sse to follow the changes as server-sent events.
+	//
+	// in: query
+	// required: false
+	Format string `json:"format"`
+	// The id of the last server-sent event received: the stream resumes after it.
+	//
+	// in: header
+	// required: false
+	LastEventID string `json:"Last-Event-ID"`
+}
+
+// Return the rate of the changes of the Istio config of the namespaces of a cluster
+// swagger:response istioConfigChurnResponse
+type IstioConfigChurnResponse struct {
+	// in: body
+	Body models.IstioConfigChurn
+}
+
+// swagger:parameters istioConfigChurn
+type IstioConfigChurnParams struct {
+	// The window of the changes, up to 1h. Defaults to 10m.
+	//
+	// in: query
+	// required: false
+	Window string `json:"window"`
+	// The cluster name. Defaults to the home cluster.
+	//
+	// in: query
+	// required: false
+	ClusterName string `json:"clusterName"`
+}
+
+// Return the likely orphaned Istio objects of a namespace
+// swagger:response istioConfigOrphansResponse
+type IstioConfigOrphansResponse struct {
+	// in: body
+	Body models.OrphanReport
+}
+
+// Return the workloads of a namespace missing telemetry
+// swagger:response namespaceTelemetryDiagnosticsResponse
+type NamespaceTelemetryDiagnosticsResponse struct {
+	// in: body
+	Body models.TelemetryDiagnostics
+}
+
+// Posted objects of an orphaned Istio objects cleanup
+// swagger:parameters istioConfigOrphansDelete
+type IstioConfigOrphansDeleteBody struct {
+	// in: body
+	Body models.OrphanCleanupRequest
+}
+
+// Return the result of an orphaned Istio objects cleanup
+// swagger:response istioConfigOrphansDeleteResponse
+type IstioConfigOrphansDeleteResponse struct {
+	// in: body
+	Body models.OrphanCleanupResult
+}
+
+// Posted Istio objects about to be deleted
+// swagger:parameters istioConfigDeleteCheck
+type IstioConfigDeleteCheckBody struct {
+	// in: body
+	Body models.IstioConfigDeleteCheckRequest
+}
+
+// Posted bundle of Istio object templates
+// swagger:parameters istioConfigBundleApply
+type IstioConfigBundleBody struct {
+	// in: body
+	Body models.IstioConfigBundle
+}
+
+// Return the objects applied from a bundle
+// swagger:response istioConfigBundleApplyResponse
+type IstioConfigBundleApplyResponse struct {
+	// in: body
+	Body models.IstioConfigBundleApply
+}
+
+// Return the state of the Istio objects about to be deleted
+// swagger:response istioConfigDeleteCheckResponse
+type IstioConfigDeleteCheckResponse struct {
+	// in: body
+	Body []models.IstioConfigDeleteCheck
+}
+
 // Return a list of certificates information
 // swagger:response certsInfoResponse
 type CertsInfoResponse struct {
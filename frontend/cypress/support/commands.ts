@@ -78,7 +78,7 @@ function ensureMulticlusterApplicationsAreHealthy(startTime: number): void {
   }
 
   cy.request(
-    'api/namespaces/graph?duration=60s&graphType=versionedApp&appenders=deadNode,istio,serviceEntry,meshCheck,workloadEntry,health&rateGrpc=requests&rateHttp=requests&rateTcp=sent&namespaces=bookinfo'
+    'api/namespaces/graph?duration=60s&graphType=versionedApp&appenders=deadNode,istio,serviceEntry,meshCheck,workloadEntry,rollout,health&rateGrpc=requests&rateHttp=requests&rateTcp=sent&namespaces=bookinfo'
   ).then(resp => {
     const has_http_200 = resp.body.elements.nodes.some(
       node =>
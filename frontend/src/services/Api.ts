@@ -3,7 +3,7 @@ import { config } from '../config';
 import { LoginSession } from '../store/Store';
 import { App, AppQuery } from '../types/App';
 import { AppList, AppListQuery } from '../types/AppList';
-import { AuthInfo, getCSRFToken } from '../types/Auth';
+import { AuthInfo, getCSRFToken, getKialiCSRFToken } from '../types/Auth';
 import { DurationInSeconds, HTTP_VERBS, Password, TimeInSeconds, UserName } from '../types/Common';
 import { DashboardModel } from 'types/Dashboards';
 import { GrafanaInfo } from '../types/GrafanaInfo';
@@ -124,11 +124,19 @@ const getHeaders = (method: HTTP_VERBS, urlEncoded: boolean): Partial<AxiosHeade
     }
 
     return headers;
-  } else if (urlEncoded) {
-    return { 'Content-Type': 'application/x-www-form-urlencoded', ...loginHeaders };
-  } else {
-    return { 'Content-Type': 'application/json', ...loginHeaders };
   }
+
+  const headers = urlEncoded
+    ? { 'Content-Type': 'application/x-www-form-urlencoded', ...loginHeaders }
+    : { 'Content-Type': 'application/json', ...loginHeaders };
+
+  // X-Kiali-CSRF-Token is used only for non-GET requests
+  const kialiCSRFToken = getKialiCSRFToken();
+  if (method !== HTTP_VERBS.GET && kialiCSRFToken) {
+    headers['X-Kiali-CSRF-Token'] = kialiCSRFToken;
+  }
+
+  return headers;
 };
 
 const basicAuth = (username: UserName, password: Password): BasicAuth => {
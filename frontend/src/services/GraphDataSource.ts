@@ -183,7 +183,7 @@ export class GraphDataSource {
     }
 
     // Some appenders are expensive so only specify an appender if needed.
-    let appenders: AppenderString = 'deadNode,istio,serviceEntry,meshCheck,workloadEntry';
+    let appenders: AppenderString = 'deadNode,istio,serviceEntry,meshCheck,workloadEntry,rollout';
 
     if (fetchParams.includeHealth) {
       appenders += ',health';
@@ -3,6 +3,7 @@ import { Runtime, WaypointInfo } from './Workload';
 import { InstanceType } from 'types/Common';
 import { AppHealthResponse } from '../types/Health';
 import { GroupVersionKind } from './IstioObjects';
+import { RolloutInfo } from './Graph';
 
 export type AppId = {
   app: string;
@@ -17,6 +18,7 @@ export interface AppWorkload {
   istioSidecar: boolean;
   labels: { [key: string]: string };
   namespace: string;
+  rollout?: RolloutInfo;
   serviceAccountNames: string[];
   waypointWorkloads?: WaypointInfo[];
   workloadGVK: GroupVersionKind;
@@ -40,8 +40,8 @@ export interface SessionInfo {
   username?: string;
 }
 
-export const getCSRFToken = (): string | undefined => {
-  const cookiePrefix = 'csrf-token=';
+const getCookie = (name: string): string | undefined => {
+  const cookiePrefix = `${name}=`;
 
   return (
     document &&
@@ -54,3 +54,12 @@ export const getCSRFToken = (): string | undefined => {
       .pop()
   );
 };
+
+export const getCSRFToken = (): string | undefined => {
+  return getCookie('csrf-token');
+};
+
+// Kiali CSRF token, verified by the Kiali server on the mutating requests when the CSRF protection is enabled
+export const getKialiCSRFToken = (): string | undefined => {
+  return getCookie('kiali-csrf-token');
+};
@@ -362,6 +362,27 @@ export interface WEInfo {
   name: string;
 }
 
+export interface RetryStormInfo {
+  amplification: number;
+  chain: string[];
+  virtualServices: string[];
+}
+
+export interface RolloutInfo {
+  name: string;
+  role?: string;
+  strategy: string;
+  weight?: number;
+}
+
+export interface TimeoutBudgetInfo {
+  budget?: number;
+  exceeded: boolean;
+  hop: number;
+  responseTime?: number;
+  timeout?: number;
+}
+
 export interface GraphRequestsHealth {
   healthAnnotations: { [idx: string]: string };
   inbound: { [idx: string]: { [idx: string]: number } };
@@ -441,6 +462,8 @@ export interface GraphNodeData {
   namespace: string;
   nodeType: NodeType;
   parent?: string;
+  retryStorm?: RetryStormInfo;
+  rollout?: RolloutInfo;
   service?: string;
   traffic?: ProtocolTraffic[];
   version?: string;
@@ -453,9 +476,11 @@ export interface GraphEdgeData {
   id: string;
   isMTLS?: number;
   responseTime?: number;
+  rolloutWeight?: number;
   source: string;
   sourcePrincipal?: string;
   target: string;
+  timeoutBudget?: TimeoutBudgetInfo;
   traffic?: ProtocolTraffic;
 }
 
@@ -52,6 +52,7 @@ export interface WorkloadStatus {
   currentReplicas: number;
   desiredReplicas: number;
   name: string;
+  scaledToZero?: boolean;
   syncedProxies: number;
 }
 
@@ -169,14 +170,16 @@ export const ratioCheck = (
   availableReplicas: number,
   currentReplicas: number,
   desiredReplicas: number,
-  syncedProxies: number
+  syncedProxies: number,
+  scaledToZero?: boolean
 ): Status => {
   /*
     NOT READY STATE
  */
   // User has scaled down a workload, then desired replicas will be 0 and it's not an error condition
+  // A serverless workload scaled to zero is just idle, waiting for requests
   if (desiredReplicas === 0) {
-    return NOT_READY;
+    return scaledToZero ? HEALTHY : NOT_READY;
   }
 
   /*
@@ -433,7 +436,13 @@ export class AppHealth extends Health {
     {
       // Pods
       const children: HealthSubItem[] = workloadStatuses.map(d => {
-        const status = ratioCheck(d.availableReplicas, d.currentReplicas, d.desiredReplicas, d.syncedProxies);
+        const status = ratioCheck(
+          d.availableReplicas,
+          d.currentReplicas,
+          d.desiredReplicas,
+          d.syncedProxies,
+          d.scaledToZero
+        );
         let proxyMessage = '';
 
         if (d.syncedProxies >= 0) {
@@ -516,7 +525,8 @@ export class WorkloadHealth extends Health {
         workloadStatus.availableReplicas,
         workloadStatus.currentReplicas,
         workloadStatus.desiredReplicas,
-        workloadStatus.syncedProxies
+        workloadStatus.syncedProxies,
+        workloadStatus.scaledToZero
       );
 
       const item: HealthItem = {
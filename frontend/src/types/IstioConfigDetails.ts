@@ -2,6 +2,7 @@ import { Namespace } from './Namespace';
 import { ResourcePermissions } from './Permissions';
 import { ObjectValidation, IstioObject, References, HelpMessage, K8sResource } from './IstioObjects';
 import { AceOptions } from 'react-ace/types';
+import { HelmRelease } from './IstioConfigList';
 
 export type IstioConfigId = {
   namespace: string;
@@ -14,6 +15,7 @@ export type IstioConfigId = {
 export interface IstioConfigDetails {
   cluster?: string;
   help?: HelpMessage[];
+  helmRelease?: HelmRelease;
   namespace: Namespace;
   permissions: ResourcePermissions;
   references?: References;
@@ -27,15 +27,37 @@ export interface IstioConfigItem extends TypeMeta {
   validation?: ObjectValidation;
 }
 
+export interface HelmRelease {
+  chart?: string;
+  name: string;
+  namespace?: string;
+  version?: string;
+}
+
+export interface IstioConfigDeletion {
+  cluster: string;
+  gvk: GroupVersionKind;
+  name: string;
+  namespace: string;
+  resourceVersion?: string;
+  timestamp: string;
+}
+
 export interface IstioConfigList {
+  deleted?: IstioConfigDeletion[]; // deletions since the bookmark of a delta list
+  helmReleases?: { [key: string]: { [key: string]: HelmRelease } }; // map of gvk to the releases of the managed objects by name.namespace
   permissions: { [key: string]: ResourcePermissions };
+  requestedTypes?: string[]; // gvks of the resources, the types not requested are not in the resources
+  resourceVersion?: string; // bookmark of the list, to get the later changes with the since query param
   resources: { [key: string]: any[] }; // map of gvk to resource array
   validations: Validations;
 }
 
 export interface IstioConfigListQuery {
+  helmRelease?: string;
   labelSelector?: string;
   objects?: string;
+  since?: string;
   validate?: boolean;
   workloadSelector?: string;
 }
@@ -52,6 +52,27 @@ interface EndpointAddress {
   tlsMode?: string;
 }
 
+export interface EndpointAddressStatus {
+  hostname?: string;
+  ip: string;
+  kind?: string;
+  name?: string;
+  nodeName?: string;
+  ready: boolean;
+  serving: boolean;
+  terminating: boolean;
+  zone?: string;
+}
+
+export interface EndpointStatus {
+  addresses: EndpointAddressStatus[];
+  issue?: string;
+  notReady: number;
+  ready: number;
+  source: 'EndpointSlices' | 'Endpoints';
+  terminating: number;
+}
+
 export interface WorkloadOverview {
   ambient?: string;
   createdAt: string;
@@ -87,6 +108,7 @@ export interface Service {
 
 export interface ServiceDetailsInfo {
   destinationRules: DestinationRule[];
+  endpointStatus?: EndpointStatus;
   endpoints?: Endpoints[];
   health?: ServiceHealth;
   isAmbient: boolean;
@@ -26,6 +26,9 @@ describe('Health', () => {
   it('should check ratio with no item', () => {
     expect(H.ratioCheck(0, 0, 0, 0)).toEqual(H.NOT_READY);
   });
+  it('should check ratio with a serverless workload scaled to zero', () => {
+    expect(H.ratioCheck(0, 0, 0, 0, true)).toEqual(H.HEALTHY);
+  });
   it('should check ratio pending Pods', () => {
     // 3 Pods with problems
     expect(H.ratioCheck(3, 6, 3, 3)).toEqual(H.FAILURE);
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"context"
+	"sync"
 
 	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/prometheus"
@@ -56,3 +57,51 @@ type Appender interface {
 	// Name returns a unique appender name and which is the name used to identify the appender (e.g in 'appenders' query param)
 	Name() string
 }
+
+var customAppenders = struct {
+	sync.RWMutex
+	appenders map[string]Appender
+}{appenders: map[string]Appender{}}
+
+// RegisterCustomAppender adds an appender of an organization, i.e. decorating the nodes with ownership or cost data
+// under the Custom metadata. It runs when requested by name in the appenders of a graph, after the built-in
+// appenders, and replaces the custom appender of the same name. The appender is shared by the graph requests, so
+// it must not keep request state. It is meant to be called from the init function of a package compiled into Kiali.
+func RegisterCustomAppender(appender Appender) {
+	customAppenders.Lock()
+	defer customAppenders.Unlock()
+
+	customAppenders.appenders[appender.Name()] = appender
+}
+
+// UnregisterCustomAppender removes the custom appender of the name.
+func UnregisterCustomAppender(name string) {
+	customAppenders.Lock()
+	defer customAppenders.Unlock()
+
+	delete(customAppenders.appenders, name)
+}
+
+// GetCustomAppender returns the custom appender of the name, registered with RegisterCustomAppender.
+func GetCustomAppender(name string) (Appender, bool) {
+	customAppenders.RLock()
+	defer customAppenders.RUnlock()
+
+	appender, found := customAppenders.appenders[name]
+	return appender, found
+}
+
+// MergeCustomMetadata merges the values into the Custom metadata of a node or an edge.
+func MergeCustomMetadata(metadata Metadata, values map[string]interface{}) {
+	if len(values) == 0 {
+		return
+	}
+	custom, ok := metadata[Custom].(CustomMetadata)
+	if !ok {
+		custom = CustomMetadata{}
+		metadata[Custom] = custom
+	}
+	for k, v := range values {
+		custom[k] = v
+	}
+}
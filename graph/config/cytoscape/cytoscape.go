@@ -88,42 +88,47 @@ type NodeData struct {
 	Parent string `json:"parent,omitempty"` // Compound Node parent ID
 
 	// App Fields (not required by Cytoscape)
-	NodeType              string              `json:"nodeType"`
-	Cluster               string              `json:"cluster"`
-	Namespace             string              `json:"namespace"`
-	Workload              string              `json:"workload,omitempty"`
-	App                   string              `json:"app,omitempty"`
-	Version               string              `json:"version,omitempty"`
-	Service               string              `json:"service,omitempty"`               // requested service for NodeTypeService
-	Aggregate             string              `json:"aggregate,omitempty"`             // set like "<aggregate>=<aggregateVal>"
-	DestServices          []graph.ServiceName `json:"destServices,omitempty"`          // requested services for [dest] node
-	Labels                map[string]string   `json:"labels,omitempty"`                // k8s labels associated with the node
-	Traffic               []ProtocolTraffic   `json:"traffic,omitempty"`               // traffic rates for all detected protocols
-	HealthData            interface{}         `json:"healthData"`                      // data to calculate health status from configurations
-	HealthDataApp         interface{}         `json:"-"`                               // for local use to generate appBox health
-	HasCB                 bool                `json:"hasCB,omitempty"`                 // true (has circuit breaker) | false
-	HasFaultInjection     bool                `json:"hasFaultInjection,omitempty"`     // true (vs has fault injection) | false
-	HasHealthConfig       HealthConfig        `json:"hasHealthConfig,omitempty"`       // set to the health config override
-	HasMirroring          bool                `json:"hasMirroring,omitempty"`          // true (has mirroring) | false
-	HasRequestRouting     bool                `json:"hasRequestRouting,omitempty"`     // true (vs has request routing) | false
-	HasRequestTimeout     bool                `json:"hasRequestTimeout,omitempty"`     // true (vs has request timeout) | false
-	HasTCPTrafficShifting bool                `json:"hasTCPTrafficShifting,omitempty"` // true (vs has tcp traffic shifting) | false
-	HasTrafficShifting    bool                `json:"hasTrafficShifting,omitempty"`    // true (vs has traffic shifting) | false
-	HasVS                 *VSInfo             `json:"hasVS,omitempty"`                 // it can be empty if there is a VS without hostnames
-	HasWorkloadEntry      []graph.WEInfo      `json:"hasWorkloadEntry,omitempty"`      // static workload entry information | empty if there are no workload entries
-	IsAmbient             bool                `json:"isAmbient,omitempty"`             // true (captured by ambient) | false
-	IsBox                 string              `json:"isBox,omitempty"`                 // set for NodeTypeBox, current values: [ 'app', 'cluster', 'namespace' ]
-	IsDead                bool                `json:"isDead,omitempty"`                // true (has no pods) | false
-	IsExtension           *graph.ExtInfo      `json:"isExtension,omitempty"`           // set for Extension nodes, with extension info
-	IsGateway             *GWInfo             `json:"isGateway,omitempty"`             // Istio ingress/egress gateway information
-	IsIdle                bool                `json:"isIdle,omitempty"`                // true | false
-	IsInaccessible        bool                `json:"isInaccessible,omitempty"`        // true if the node exists in an inaccessible namespace
-	IsK8sGatewayAPI       bool                `json:"isK8sGatewayAPI,omitempty"`       // true (object is auto-generated from K8s API Gateway) | false
-	IsOutOfMesh           bool                `json:"isOutOfMesh,omitempty"`           // true (has missing sidecar) | false
-	IsOutside             bool                `json:"isOutside,omitempty"`             // true | false
-	IsRoot                bool                `json:"isRoot,omitempty"`                // true | false
-	IsServiceEntry        *graph.SEInfo       `json:"isServiceEntry,omitempty"`        // set static service entry information
-	IsWaypoint            bool                `json:"isWaypoint,omitempty"`            // true | false
+	NodeType              string                `json:"nodeType"`
+	Cluster               string                `json:"cluster"`
+	Namespace             string                `json:"namespace"`
+	Workload              string                `json:"workload,omitempty"`
+	App                   string                `json:"app,omitempty"`
+	Version               string                `json:"version,omitempty"`
+	Service               string                `json:"service,omitempty"`               // requested service for NodeTypeService
+	Aggregate             string                `json:"aggregate,omitempty"`             // set like "<aggregate>=<aggregateVal>"
+	Custom                graph.CustomMetadata  `json:"custom,omitempty"`                // metadata set by the custom appenders
+	DestServices          []graph.ServiceName   `json:"destServices,omitempty"`          // requested services for [dest] node
+	Labels                map[string]string     `json:"labels,omitempty"`                // k8s labels associated with the node
+	Scores                *graph.NodeScores     `json:"scores,omitempty"`                // normalized traffic scores of the node
+	Traffic               []ProtocolTraffic     `json:"traffic,omitempty"`               // traffic rates for all detected protocols
+	HealthData            interface{}           `json:"healthData"`                      // data to calculate health status from configurations
+	HealthDataApp         interface{}           `json:"-"`                               // for local use to generate appBox health
+	HasCB                 bool                  `json:"hasCB,omitempty"`                 // true (has circuit breaker) | false
+	HasFaultInjection     bool                  `json:"hasFaultInjection,omitempty"`     // true (vs has fault injection) | false
+	HasHealthConfig       HealthConfig          `json:"hasHealthConfig,omitempty"`       // set to the health config override
+	HasMirroring          bool                  `json:"hasMirroring,omitempty"`          // true (has mirroring) | false
+	HasRequestRouting     bool                  `json:"hasRequestRouting,omitempty"`     // true (vs has request routing) | false
+	HasRequestTimeout     bool                  `json:"hasRequestTimeout,omitempty"`     // true (vs has request timeout) | false
+	HasTCPTrafficShifting bool                  `json:"hasTCPTrafficShifting,omitempty"` // true (vs has tcp traffic shifting) | false
+	HasTrafficShifting    bool                  `json:"hasTrafficShifting,omitempty"`    // true (vs has traffic shifting) | false
+	HasVS                 *VSInfo               `json:"hasVS,omitempty"`                 // it can be empty if there is a VS without hostnames
+	HasWorkloadEntry      []graph.WEInfo        `json:"hasWorkloadEntry,omitempty"`      // static workload entry information | empty if there are no workload entries
+	IsAmbient             bool                  `json:"isAmbient,omitempty"`             // true (captured by ambient) | false
+	IsBox                 string                `json:"isBox,omitempty"`                 // set for NodeTypeBox, current values: [ 'app', 'cluster', 'namespace' ]
+	IsDead                bool                  `json:"isDead,omitempty"`                // true (has no pods) | false
+	IsExtension           *graph.ExtInfo        `json:"isExtension,omitempty"`           // set for Extension nodes, with extension info
+	IsGateway             *GWInfo               `json:"isGateway,omitempty"`             // Istio ingress/egress gateway information
+	IsIdle                bool                  `json:"isIdle,omitempty"`                // true | false
+	IsInaccessible        bool                  `json:"isInaccessible,omitempty"`        // true if the node exists in an inaccessible namespace
+	IsK8sGatewayAPI       bool                  `json:"isK8sGatewayAPI,omitempty"`       // true (object is auto-generated from K8s API Gateway) | false
+	IsOutOfMesh           bool                  `json:"isOutOfMesh,omitempty"`           // true (has missing sidecar) | false
+	IsOutside             bool                  `json:"isOutside,omitempty"`             // true | false
+	IsRoot                bool                  `json:"isRoot,omitempty"`                // true | false
+	IsServiceEntry        *graph.SEInfo         `json:"isServiceEntry,omitempty"`        // set static service entry information
+	IsWaypoint            bool                  `json:"isWaypoint,omitempty"`            // true | false
+	RetryStorm            *graph.RetryStormInfo `json:"retryStorm,omitempty"`            // set when the retries of a call chain amplify the load of the node
+	Rollout               *graph.RolloutInfo    `json:"rollout,omitempty"`               // set for workloads owned by an Argo Rollout
+	SLO                   *graph.SLOInfo        `json:"slo,omitempty"`                   // set for services with an SLO evaluated in the background
 }
 
 type WaypointEdge struct {
@@ -138,17 +143,28 @@ type EdgeData struct {
 	Target string `json:"target"` // child node ID
 
 	// App Fields (not required by Cytoscape)
-	DestPrincipal   string          `json:"destPrincipal,omitempty"`   // principal used for the edge destination
-	IsMTLS          string          `json:"isMTLS,omitempty"`          // set to the percentage of traffic using a mutual TLS connection
-	ResponseTime    string          `json:"responseTime,omitempty"`    // in millis
-	SourcePrincipal string          `json:"sourcePrincipal,omitempty"` // principal used for the edge source
-	Throughput      string          `json:"throughput,omitempty"`      // in bytes/sec (request or response, depends on client request)
-	Traffic         ProtocolTraffic `json:"traffic,omitempty"`         // traffic rates for the edge protocol
-	Waypoint        *WaypointEdge   `json:"waypoint,omitempty"`        // Biderectional edges for waypoint nodes
+	Custom          graph.CustomMetadata     `json:"custom,omitempty"`          // metadata set by the custom appenders
+	DestPrincipal   string                   `json:"destPrincipal,omitempty"`   // principal used for the edge destination
+	IsMTLS          string                   `json:"isMTLS,omitempty"`          // set to the percentage of traffic using a mutual TLS connection
+	ResponseTime    string                   `json:"responseTime,omitempty"`    // in millis
+	RolloutWeight   *int32                   `json:"rolloutWeight,omitempty"`   // traffic weight intended by the Argo Rollout for the target
+	SourcePrincipal string                   `json:"sourcePrincipal,omitempty"` // principal used for the edge source
+	Throughput      string                   `json:"throughput,omitempty"`      // in bytes/sec (request or response, depends on client request)
+	TimeoutBudget   *graph.TimeoutBudgetInfo `json:"timeoutBudget,omitempty"`   // set for the edges of the path of the timeout budget
+	Traffic         ProtocolTraffic          `json:"traffic,omitempty"`         // traffic rates for the edge protocol
+	Waypoint        *WaypointEdge            `json:"waypoint,omitempty"`        // Biderectional edges for waypoint nodes
+}
+
+// Position is the model position of a node, set when the graph is laid out server-side. The position of a box is
+// the center of its members.
+type Position struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
 }
 
 type NodeWrapper struct {
-	Data *NodeData `json:"data"`
+	Data     *NodeData `json:"data"`
+	Position *Position `json:"position,omitempty"`
 }
 
 type EdgeWrapper struct {
@@ -237,6 +253,10 @@ func NewConfig(trafficMap graph.TrafficMap, o graph.ConfigOptions) (result Confi
 		}
 	})
 
+	if o.Layout == graph.LayoutHierarchical {
+		layoutHierarchical(nodes, edges)
+	}
+
 	elements := Elements{nodes, edges}
 	result = Config{
 		Duration:  int64(o.Duration.Seconds()),
@@ -276,6 +296,11 @@ func buildConfig(trafficMap graph.TrafficMap, nodes *[]*NodeWrapper, edges *[]*E
 			nd.Labels = val.(graph.LabelsMetadata)
 		}
 
+		// set the metadata of the custom appenders, if any
+		if val, ok := n.Metadata[graph.Custom]; ok {
+			nd.Custom = val.(graph.CustomMetadata)
+		}
+
 		// set annotations, if available
 		if val, ok := n.Metadata[graph.HasHealthConfig]; ok {
 			nd.HasHealthConfig = val.(map[string]string)
@@ -330,6 +355,12 @@ func buildConfig(trafficMap graph.TrafficMap, nodes *[]*NodeWrapper, edges *[]*E
 			nd.IsK8sGatewayAPI = true
 		}
 
+		// node may have traffic scores
+		if val, ok := n.Metadata[graph.Scores]; ok {
+			scores := val.(graph.NodeScores)
+			nd.Scores = &scores
+		}
+
 		// node may be idle
 		if val, ok := n.Metadata[graph.IsIdle]; ok {
 			nd.IsIdle = val.(bool)
@@ -438,6 +469,27 @@ func buildConfig(trafficMap graph.TrafficMap, nodes *[]*NodeWrapper, edges *[]*E
 			}
 		}
 
+		// node may have its load amplified by the retries of a call chain
+		if val, ok := n.Metadata[graph.RetryStorm]; ok {
+			retryStorm := *val.(*graph.RetryStormInfo)
+			// the chain references the nodes by their cytoscape IDs
+			retryStorm.Chain = make([]string, len(retryStorm.Chain))
+			for i, id := range val.(*graph.RetryStormInfo).Chain {
+				retryStorm.Chain[i] = nodeHash(id)
+			}
+			nd.RetryStorm = &retryStorm
+		}
+
+		// node may be owned by an Argo Rollout
+		if val, ok := n.Metadata[graph.Rollout]; ok {
+			nd.Rollout = val.(*graph.RolloutInfo)
+		}
+
+		// node may have service level objectives
+		if val, ok := n.Metadata[graph.SLO]; ok {
+			nd.SLO = val.(*graph.SLOInfo)
+		}
+
 		// node may be an aggregate
 		if n.NodeType == graph.NodeTypeAggregate {
 			nd.Aggregate = fmt.Sprintf("%s=%s", n.Metadata[graph.Aggregate].(string), n.Metadata[graph.AggregateValue].(string))
@@ -475,12 +527,22 @@ func convertEdge(e graph.Edge, nodeID string) EdgeData {
 			Protocol: protocol,
 		},
 	}
+	if e.Metadata[graph.Custom] != nil {
+		ed.Custom = e.Metadata[graph.Custom].(graph.CustomMetadata)
+	}
 	if e.Metadata[graph.DestPrincipal] != nil {
 		ed.DestPrincipal = e.Metadata[graph.DestPrincipal].(string)
 	}
 	if e.Metadata[graph.SourcePrincipal] != nil {
 		ed.SourcePrincipal = e.Metadata[graph.SourcePrincipal].(string)
 	}
+	if e.Metadata[graph.RolloutWeight] != nil {
+		weight := e.Metadata[graph.RolloutWeight].(int32)
+		ed.RolloutWeight = &weight
+	}
+	if e.Metadata[graph.TimeoutBudget] != nil {
+		ed.TimeoutBudget = e.Metadata[graph.TimeoutBudget].(*graph.TimeoutBudgetInfo)
+	}
 	if e.Metadata[graph.Waypoint] != nil {
 		waypointEdgeInfo := e.Metadata[graph.Waypoint].(*graph.WaypointEdgeInfo)
 		waypointEdge := WaypointEdge{
@@ -141,3 +141,36 @@ func TestHTTPToTrafficRate(t *testing.T) {
 	assert.NotNil(cytoNode.Data.Traffic)
 	assert.NotNil(cytoNode.Data.Traffic.Rates)
 }
+
+func TestLayoutHierarchical(t *testing.T) {
+	assert := assert.New(t)
+
+	traffic := graph.NewTrafficMap()
+	productpage, _ := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeVersionedApp)
+	reviews, _ := graph.NewNode("testCluster", "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeVersionedApp)
+	ratings, _ := graph.NewNode("testCluster", "ratings", "", "ratings", "ratings-v1", "ratings", "v1", graph.GraphTypeVersionedApp)
+	traffic[productpage.ID] = productpage
+	traffic[reviews.ID] = reviews
+	traffic[ratings.ID] = ratings
+	productpage.AddEdge(reviews)
+	reviews.AddEdge(ratings)
+	// the cycle is broken
+	ratings.AddEdge(productpage)
+
+	cytoConfig := NewConfig(traffic, graph.ConfigOptions{BoxBy: graph.BoxByNamespace, Layout: graph.LayoutHierarchical})
+
+	positions := map[string]*Position{}
+	for _, n := range cytoConfig.Elements.Nodes {
+		assert.NotNil(n.Position)
+		positions[n.Data.IsBox+n.Data.Namespace+n.Data.App] = n.Position
+	}
+	assert.Equal(Position{X: 0, Y: 0}, *positions["bookinfoproductpage"])
+	assert.Equal(Position{X: 200, Y: 0}, *positions["bookinforeviews"])
+	assert.Equal(Position{X: 400, Y: 200}, *positions["ratingsratings"])
+	assert.Equal(Position{X: 100, Y: 0}, *positions["namespacebookinfo"])
+
+	cytoConfig = NewConfig(traffic, graph.ConfigOptions{})
+	for _, n := range cytoConfig.Elements.Nodes {
+		assert.Nil(n.Position)
+	}
+}
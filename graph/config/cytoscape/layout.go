@@ -0,0 +1,121 @@
+package cytoscape
+
+// Layout.go computes a server-side layout of the graph, for the consumers not running a Cytoscape layout (i.e. exports
+// and reports).
+//
+// Algorithm: The nodes are ranked by traffic direction, the rank of a node being the length of the longest path of
+//            edges reaching it (cycles are broken by ignoring the edges closing them). The rank sets the column of the
+//            node. The nodes of each namespace are laid out in their own horizontal band, so that the namespace boxes
+//            never overlap, and the boxes are centered on their members.
+
+const (
+	layoutBandSpacing   float64 = 100 // extra vertical space between the namespace bands
+	layoutColumnSpacing float64 = 200
+	layoutRowSpacing    float64 = 100
+)
+
+// layoutHierarchical sets the position of every node. The nodes are expected in their presentation order, the boxes
+// first.
+func layoutHierarchical(nodes []*NodeWrapper, edges []*EdgeWrapper) {
+	ranks := rankNodes(nodes, edges)
+
+	// lay out the namespace bands, top-down in the node order
+	bandY := 0.0
+	for i := 0; i < len(nodes); {
+		if nodes[i].Data.IsBox != "" {
+			i++
+			continue
+		}
+		cluster, namespace := nodes[i].Data.Cluster, nodes[i].Data.Namespace
+		rows := map[int]int{}
+		maxRows := 0
+		for ; i < len(nodes) && nodes[i].Data.Cluster == cluster && nodes[i].Data.Namespace == namespace; i++ {
+			rank := ranks[nodes[i].Data.ID]
+			nodes[i].Position = &Position{
+				X: float64(rank) * layoutColumnSpacing,
+				Y: bandY + float64(rows[rank])*layoutRowSpacing,
+			}
+			rows[rank]++
+			if rows[rank] > maxRows {
+				maxRows = rows[rank]
+			}
+		}
+		bandY += float64(maxRows)*layoutRowSpacing + layoutBandSpacing
+	}
+
+	// center the boxes on their members, inner boxes first as they are members of the outer boxes
+	members := map[string][]*NodeWrapper{}
+	for _, n := range nodes {
+		if n.Data.Parent != "" {
+			members[n.Data.Parent] = append(members[n.Data.Parent], n)
+		}
+	}
+	for i := len(nodes) - 1; i >= 0; i-- {
+		box := nodes[i]
+		if box.Data.IsBox == "" || len(members[box.Data.ID]) == 0 {
+			continue
+		}
+		first := members[box.Data.ID][0].Position
+		minX, maxX, minY, maxY := first.X, first.X, first.Y, first.Y
+		for _, m := range members[box.Data.ID][1:] {
+			minX, maxX = min(minX, m.Position.X), max(maxX, m.Position.X)
+			minY, maxY = min(minY, m.Position.Y), max(maxY, m.Position.Y)
+		}
+		box.Position = &Position{X: (minX + maxX) / 2, Y: (minY + maxY) / 2}
+	}
+}
+
+// rankNodes returns the rank of every node not being a box, by node ID. The sources of the traffic have rank 0.
+func rankNodes(nodes []*NodeWrapper, edges []*EdgeWrapper) map[string]int {
+	targets := map[string][]string{}
+	hasSource := map[string]bool{}
+	for _, e := range edges {
+		if e.Data.Source == e.Data.Target {
+			continue
+		}
+		targets[e.Data.Source] = append(targets[e.Data.Source], e.Data.Target)
+		hasSource[e.Data.Target] = true
+	}
+
+	// depth-first post-order, visiting the traffic sources first so that the cycles are broken as late as possible
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	isBackEdge := map[[2]string]bool{}
+	order := []string{}
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		for _, target := range targets[id] {
+			switch state[target] {
+			case visiting:
+				isBackEdge[[2]string{id, target}] = true
+			case 0:
+				visit(target)
+			}
+		}
+		state[id] = visited
+		order = append(order, id)
+	}
+	for _, sourcesFirst := range []bool{true, false} {
+		for _, n := range nodes {
+			if n.Data.IsBox == "" && state[n.Data.ID] == 0 && (!sourcesFirst || !hasSource[n.Data.ID]) {
+				visit(n.Data.ID)
+			}
+		}
+	}
+
+	// the reverse post-order is a topological order once the back edges are ignored
+	ranks := map[string]int{}
+	for i := len(order) - 1; i >= 0; i-- {
+		source := order[i]
+		for _, target := range targets[source] {
+			if !isBackEdge[[2]string{source, target}] && ranks[target] < ranks[source]+1 {
+				ranks[target] = ranks[source] + 1
+			}
+		}
+	}
+	return ranks
+}
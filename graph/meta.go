@@ -15,6 +15,7 @@ func NewMetadata() Metadata {
 const (
 	Aggregate             MetadataKey = "aggregate" // the prom attribute used for aggregation
 	AggregateValue        MetadataKey = "aggregateValue"
+	Custom                MetadataKey = "custom" // metadata set by the custom appenders
 	DestPrincipal         MetadataKey = "destPrincipal"
 	DestServices          MetadataKey = "destServices"
 	HealthData            MetadataKey = "healthData"
@@ -49,9 +50,15 @@ const (
 	Labels                MetadataKey = "labels"
 	ProtocolKey           MetadataKey = "protocol"
 	ResponseTime          MetadataKey = "responseTime"
+	RetryStorm            MetadataKey = "retryStorm"    // retries amplifying the load of a node along a call chain
+	Rollout               MetadataKey = "rollout"       // Argo Rollout info of a workload node
+	RolloutWeight         MetadataKey = "rolloutWeight" // traffic weight intended by the Argo Rollout for the edge destination
+	Scores                MetadataKey = "scores"        // normalized traffic scores of a node
+	SLO                   MetadataKey = "slo"           // last SLO evaluation of a service node
 	SourcePrincipal       MetadataKey = "sourcePrincipal"
 	Throughput            MetadataKey = "throughput"
-	Waypoint              MetadataKey = "waypoint" // Information for edges to or from a waypoint
+	TimeoutBudget         MetadataKey = "timeoutBudget" // timeout budget of an edge of the requested path
+	Waypoint              MetadataKey = "waypoint"      // Information for edges to or from a waypoint
 )
 
 // DestServicesMetadata key=Service.Key()
@@ -68,6 +75,43 @@ func (dsm DestServicesMetadata) Add(key string, service ServiceName) DestService
 	return dsm
 }
 
+// RetryStormInfo is the call chain whose configured retries amplify the most the load of a node: in the worst case,
+// every request entering the chain is tried Amplification times on the node.
+type RetryStormInfo struct {
+	// Amplification is the product of the tries of the retrying hops of the chain, capped to one million
+	Amplification int `json:"amplification"`
+	// Chain is the IDs of the nodes of the chain, from its entry to the node
+	Chain []string `json:"chain"`
+	// VirtualServices are the VirtualServices configuring the retries of the chain, as namespace/name
+	VirtualServices []string `json:"virtualServices"`
+}
+
+// TimeoutBudgetInfo is the timeout budget of a hop of a path between two nodes, in milliseconds.
+type TimeoutBudgetInfo struct {
+	// Hop is the position of the edge in the path, starting at 1
+	Hop int `json:"hop"`
+	// Timeout is the timeout configured by the VirtualServices for the destination service, 0 when it has none
+	Timeout float64 `json:"timeout,omitempty"`
+	// Budget is the shortest timeout of the hops of the path up to this one, 0 when none times out
+	Budget float64 `json:"budget,omitempty"`
+	// ResponseTime is the response time of the edge, 0 when unknown
+	ResponseTime float64 `json:"responseTime,omitempty"`
+	// Exceeded is true when the response time of the edge is higher than the budget
+	Exceeded bool `json:"exceeded"`
+}
+
+// NodeScores are the traffic scores of a node, normalized between 0 and 1 over the nodes of the graph,
+// to size and color the nodes consistently.
+type NodeScores struct {
+	// Throughput is the request rate of the node relative to the highest request rate of the graph
+	Throughput float64 `json:"throughput"`
+	// ErrorRate is the ratio of the requests to the node that failed
+	ErrorRate float64 `json:"errorRate"`
+}
+
+// CustomMetadata is the metadata set on a node or an edge by the custom appenders, i.e. ownership or cost data.
+type CustomMetadata map[string]interface{}
+
 type GatewaysMetadata map[string][]string
 type LabelsMetadata map[string]string
 type VirtualServicesMetadata map[string][]string
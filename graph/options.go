@@ -36,6 +36,8 @@ const (
 	BoxByCluster              string = "cluster"
 	BoxByNamespace            string = "namespace"
 	BoxByNone                 string = "none"
+	LayoutHierarchical        string = "hierarchical"
+	LayoutNone                string = "none"
 	RateNone                  string = "none"
 	RateReceived              string = "received" // tcp bytes received, grpc response messages, etc
 	RateRequests              string = "requests" // request count
@@ -47,6 +49,7 @@ const (
 	defaultGraphType          string = GraphTypeWorkload
 	defaultIncludeIdleEdges   bool   = false
 	defaultInjectServiceNodes bool   = false
+	defaultLayout             string = LayoutNone
 	defaultRateGrpc           string = RateRequests
 	defaultRateHttp           string = RateRequests
 	defaultRateTcp            string = RateSent
@@ -79,7 +82,8 @@ type CommonOptions struct {
 
 // ConfigOptions are those supplied to Config Vendors
 type ConfigOptions struct {
-	BoxBy string
+	BoxBy  string
+	Layout string // server-side layout of the nodes, LayoutNone leaves the layout to the client
 	CommonOptions
 }
 
@@ -160,6 +164,7 @@ func NewOptions(r *net_http.Request, namespacesService *business.NamespaceServic
 	graphType := params.Get("graphType")
 	includeIdleEdgesString := params.Get("includeIdleEdges")
 	injectServiceNodesString := params.Get("injectServiceNodes")
+	layout := params.Get("layout")
 	namespaces := params.Get("namespaces") // csl of namespaces
 	queryTimeString := params.Get("queryTime")
 	rateGrpc := params.Get("rateGrpc")
@@ -239,6 +244,11 @@ func NewOptions(r *net_http.Request, namespacesService *business.NamespaceServic
 			BadRequest(fmt.Sprintf("Invalid injectServiceNodes [%s]", injectServiceNodesString))
 		}
 	}
+	if layout == "" {
+		layout = defaultLayout
+	} else if layout != LayoutHierarchical && layout != LayoutNone {
+		BadRequest(fmt.Sprintf("Invalid layout [%s]", layout))
+	}
 	if queryTimeString == "" {
 		queryTime = time.Now().Unix()
 	} else {
@@ -369,7 +379,8 @@ func NewOptions(r *net_http.Request, namespacesService *business.NamespaceServic
 		ConfigVendor:    configVendor,
 		TelemetryVendor: telemetryVendor,
 		ConfigOptions: ConfigOptions{
-			BoxBy: boxBy,
+			BoxBy:  boxBy,
+			Layout: layout,
 			CommonOptions: CommonOptions{
 				Duration:  time.Duration(duration),
 				GraphType: graphType,
@@ -6,7 +6,6 @@ import (
 	"strconv"
 
 	"github.com/kiali/kiali/business"
-	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/graph"
 	"github.com/kiali/kiali/models"
 	"github.com/kiali/kiali/util/sliceutil"
@@ -23,6 +22,7 @@ const (
 func ParseAppenders(o graph.TelemetryOptions) (appenders []graph.Appender, finalizers []graph.Appender) {
 	requestedAppenders := map[string]bool{}
 	requestedFinalizers := map[string]bool{}
+	customAppenders := []graph.Appender{}
 
 	if !o.Appenders.All {
 		for _, appenderName := range o.Appenders.AppenderNames {
@@ -41,10 +41,14 @@ func ParseAppenders(o graph.TelemetryOptions) (appenders []graph.Appender, final
 				requestedAppenders[MeshCheckAppenderName] = true
 			case ResponseTimeAppenderName:
 				requestedAppenders[ResponseTimeAppenderName] = true
+			case RolloutAppenderName:
+				requestedAppenders[RolloutAppenderName] = true
 			case SecurityPolicyAppenderName:
 				requestedAppenders[SecurityPolicyAppenderName] = true
 			case ServiceEntryAppenderName:
 				requestedAppenders[ServiceEntryAppenderName] = true
+			case SLOAppenderName:
+				requestedAppenders[SLOAppenderName] = true
 			case ThroughputAppenderName:
 				requestedAppenders[ThroughputAppenderName] = true
 			case WorkloadEntryAppenderName:
@@ -60,12 +64,26 @@ func ParseAppenders(o graph.TelemetryOptions) (appenders []graph.Appender, final
 				requestedFinalizers[HealthAppenderName] = true
 			case LabelerAppenderName:
 				requestedFinalizers[LabelerAppenderName] = true
+			case NodeScoreAppenderName:
+				requestedFinalizers[NodeScoreAppenderName] = true
+			case RetryStormAppenderName:
+				requestedFinalizers[RetryStormAppenderName] = true
+			case TimeoutBudgetAppenderName:
+				// the budget is compared with the response times of the edges
+				requestedAppenders[ResponseTimeAppenderName] = true
+				requestedFinalizers[TimeoutBudgetAppenderName] = true
 			case OutsiderAppenderName, TrafficGeneratorAppenderName:
 				// skip - these are always run, ignore if specified
 			case "":
 				// skip
 			default:
-				graph.BadRequest(fmt.Sprintf("Invalid appender [%s]", appenderName))
+				if appender, ok := graph.GetCustomAppender(appenderName); ok {
+					customAppenders = append(customAppenders, appender)
+				} else if webhook, ok := getGraphAppenderWebhook(appenderName); ok {
+					customAppenders = append(customAppenders, &WebhookAppender{Webhook: webhook})
+				} else {
+					graph.BadRequest(fmt.Sprintf("Invalid appender [%s]", appenderName))
+				}
 			}
 		}
 	}
@@ -94,8 +112,24 @@ func ParseAppenders(o graph.TelemetryOptions) (appenders []graph.Appender, final
 		}
 		appenders = append(appenders, a)
 	}
+	if _, ok := requestedAppenders[RolloutAppenderName]; ok || o.Appenders.All {
+		a := RolloutAppender{
+			AccessibleNamespaces: o.AccessibleNamespaces,
+		}
+		appenders = append(appenders, a)
+	}
+	if _, ok := requestedAppenders[SLOAppenderName]; ok || o.Appenders.All {
+		a := SLOAppender{
+			AccessibleNamespaces: o.AccessibleNamespaces,
+		}
+		appenders = append(appenders, a)
+	}
 	if _, ok := requestedAppenders[ResponseTimeAppenderName]; ok || o.Appenders.All {
 		quantile := defaultQuantile
+		if _, ok := requestedFinalizers[TimeoutBudgetAppenderName]; ok {
+			// the typical latency a timeout must allow for
+			quantile = 0.99
+		}
 		responseTimeString := o.Params.Get("responseTime")
 		if responseTimeString != "" {
 			switch responseTimeString {
@@ -191,6 +225,13 @@ func ParseAppenders(o graph.TelemetryOptions) (appenders []graph.Appender, final
 		appenders = append(appenders, a)
 	}
 
+	// the custom appenders run after the built-in ones, to be able to use their metadata
+	for _, a := range customAppenders {
+		if !a.IsFinalizer() {
+			appenders = append(appenders, a)
+		}
+	}
+
 	// The finalizer order is important
 
 	// always run the extensions finalizer first, it can add additional nodes and edges
@@ -240,6 +281,47 @@ func ParseAppenders(o graph.TelemetryOptions) (appenders []graph.Appender, final
 		finalizers = append(finalizers, &LabelerAppender{})
 	}
 
+	// if node score finalizer is to be run, do it after the outsider finalizer
+	if _, ok := requestedFinalizers[NodeScoreAppenderName]; ok {
+		finalizers = append(finalizers, &NodeScoreAppender{})
+	}
+
+	// if retry storm finalizer is to be run, do it after the outsider finalizer
+	if _, ok := requestedFinalizers[RetryStormAppenderName]; ok {
+		threshold := defaultRetryStormThreshold
+		if thresholdString := o.Params.Get("retryStormThreshold"); thresholdString != "" {
+			var thresholdErr error
+			threshold, thresholdErr = strconv.Atoi(thresholdString)
+			if thresholdErr != nil || threshold < 2 {
+				graph.BadRequest(fmt.Sprintf("Invalid retryStormThreshold, must be an integer greater than 1: [%s]", thresholdString))
+			}
+		}
+		finalizers = append(finalizers, &RetryStormAppender{
+			AccessibleNamespaces: o.AccessibleNamespaces,
+			Threshold:            threshold,
+		})
+	}
+
+	// if timeout budget finalizer is to be run, do it after the outsider finalizer
+	if _, ok := requestedFinalizers[TimeoutBudgetAppenderName]; ok {
+		source, target := o.Params.Get("timeoutBudgetSource"), o.Params.Get("timeoutBudgetTarget")
+		if source == "" || target == "" {
+			graph.BadRequest("The timeoutBudget appender requires the timeoutBudgetSource and timeoutBudgetTarget params")
+		}
+		finalizers = append(finalizers, &TimeoutBudgetAppender{
+			AccessibleNamespaces: o.AccessibleNamespaces,
+			Source:               source,
+			Target:               target,
+		})
+	}
+
+	// run the custom finalizers after the built-in ones, to be able to use their metadata
+	for _, a := range customAppenders {
+		if a.IsFinalizer() {
+			finalizers = append(finalizers, a)
+		}
+	}
+
 	// always run the traffic generator finalizer
 	finalizers = append(finalizers, &TrafficGeneratorAppender{})
 
@@ -255,12 +337,13 @@ const (
 )
 
 type serviceEntry struct {
-	cluster   string
-	exportTo  []string
-	hosts     []string
-	location  string
-	name      string // serviceEntry name
-	namespace string // namespace in which the service entry is defined
+	cluster    string
+	exportTo   []string
+	hosts      []string
+	location   string
+	name       string // serviceEntry name
+	namespace  string // namespace in which the service entry is defined
+	resolution string
 }
 
 type serviceEntryHosts map[string][]*serviceEntry
@@ -404,17 +487,13 @@ func getWorkload(cluster, namespace, workloadName string, gi *graph.GlobalInfo)
 }
 
 func getAppWorkloads(cluster, namespace, app, version string, gi *graph.GlobalInfo) []models.WorkloadListItem {
-	cfg := config.Get()
-	appLabel := cfg.IstioLabels.AppLabelName
-	versionLabel := cfg.IstioLabels.VersionLabelName
-
 	result := []models.WorkloadListItem{}
 	versionOk := graph.IsOKVersion(version)
 	for _, workload := range getWorkloadList(cluster, namespace, gi).Workloads {
-		if appVal, ok := workload.Labels[appLabel]; ok && app == appVal {
+		if appVal, ok := models.CanonicalApp(workload.Labels); ok && app == appVal {
 			if !versionOk {
 				result = append(result, workload)
-			} else if versionVal, ok := workload.Labels[versionLabel]; ok && version == versionVal {
+			} else if versionVal, ok := models.CanonicalVersion(workload.Labels); ok && version == versionVal {
 				result = append(result, workload)
 			}
 		}
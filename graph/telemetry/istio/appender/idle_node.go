@@ -1,7 +1,6 @@
 package appender
 
 import (
-	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/graph"
 	"github.com/kiali/kiali/log"
 	"github.com/kiali/kiali/models"
@@ -74,18 +73,15 @@ func (a IdleNodeAppender) buildIdleNodeTrafficMap(trafficMap graph.TrafficMap, n
 			}
 		}
 
-		cfg := config.Get()
-		appLabel := cfg.IstioLabels.AppLabelName
-		versionLabel := cfg.IstioLabels.VersionLabelName
 		if workloadList, ok := workloadLists[cluster]; ok {
 			for _, w := range workloadList.Workloads {
 				labels := w.Labels
 				app := graph.Unknown
 				version := graph.Unknown
-				if v, ok := labels[appLabel]; ok {
+				if v, ok := models.CanonicalApp(labels); ok {
 					app = v
 				}
-				if v, ok := labels[versionLabel]; ok {
+				if v, ok := models.CanonicalVersion(labels); ok {
 					version = v
 				}
 				id, nodeType, _ := graph.Id(cluster, "", "", namespace, w.Name, app, version, a.GraphType)
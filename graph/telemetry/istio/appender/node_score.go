@@ -0,0 +1,80 @@
+package appender
+
+import (
+	"math"
+
+	"github.com/kiali/kiali/graph"
+)
+
+const NodeScoreAppenderName = "nodeScore"
+
+// requestProtocols are the protocols whose rates are requests per second, unlike TCP rates in bytes per second.
+var requestProtocols = []graph.Protocol{graph.GRPC, graph.HTTP}
+
+// NodeScoreAppender is responsible for adding the normalized throughput and error rate scores of the nodes, so that
+// the clients can size and color the nodes consistently without deriving statistics from the traffic.
+// The throughput of a node is the highest of its inbound and outbound request rates, so that root nodes are also scored.
+// Name: nodeScore
+type NodeScoreAppender struct{}
+
+// Name implements Appender
+func (a NodeScoreAppender) Name() string {
+	return NodeScoreAppenderName
+}
+
+// IsFinalizer implements Appender
+func (a NodeScoreAppender) IsFinalizer() bool {
+	return true
+}
+
+// AppendGraph implements Appender
+func (a NodeScoreAppender) AppendGraph(trafficMap graph.TrafficMap, globalInfo *graph.GlobalInfo, _namespaceInfo *graph.AppenderNamespaceInfo) {
+	if len(trafficMap) == 0 {
+		return
+	}
+
+	throughputs := map[string]float64{}
+	maxThroughput := 0.0
+	for id, n := range trafficMap {
+		in, out, _ := nodeRequestRates(n)
+		throughputs[id] = math.Max(in, out)
+		maxThroughput = math.Max(maxThroughput, throughputs[id])
+	}
+
+	for id, n := range trafficMap {
+		in, _, errs := nodeRequestRates(n)
+		scores := graph.NodeScores{}
+		if maxThroughput > 0 {
+			scores.Throughput = roundScore(throughputs[id] / maxThroughput)
+		}
+		if in > 0 {
+			scores.ErrorRate = roundScore(math.Min(errs/in, 1))
+		}
+		n.Metadata[graph.Scores] = scores
+	}
+}
+
+// nodeRequestRates returns the inbound, outbound and failed inbound request rates of the node.
+func nodeRequestRates(n *graph.Node) (in, out, errs float64) {
+	for _, protocol := range requestProtocols {
+		for _, rate := range protocol.NodeRates {
+			val, ok := n.Metadata[rate.Name]
+			if !ok {
+				continue
+			}
+			switch {
+			case rate.IsIn:
+				in += val.(float64)
+			case rate.IsOut:
+				out += val.(float64)
+			case rate.IsErr:
+				errs += val.(float64)
+			}
+		}
+	}
+	return in, out, errs
+}
+
+func roundScore(score float64) float64 {
+	return math.Round(score*1000) / 1000
+}
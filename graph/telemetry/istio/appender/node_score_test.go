@@ -0,0 +1,50 @@
+package appender
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+)
+
+func TestNodeScores(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap := graph.NewTrafficMap()
+
+	ingressNode, _ := graph.NewNode(config.DefaultClusterID, "istio-system", "", "istio-system", "istio-ingressgateway", "istio-ingressgateway", graph.Unknown, graph.GraphTypeVersionedApp)
+	ingressNode.Metadata[graph.MetadataKey("httpOut")] = 100.0
+	trafficMap[ingressNode.ID] = ingressNode
+
+	appNode, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeVersionedApp)
+	appNode.Metadata[graph.MetadataKey("httpIn")] = 40.0
+	appNode.Metadata[graph.MetadataKey("httpIn5xx")] = 8.0
+	appNode.Metadata[graph.MetadataKey("grpcIn")] = 10.0
+	appNode.Metadata[graph.MetadataKey("grpcInErr")] = 2.0
+	trafficMap[appNode.ID] = appNode
+
+	tcpNode, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", "", "bookinfo", "mysqldb-v1", "mysqldb", "v1", graph.GraphTypeVersionedApp)
+	tcpNode.Metadata[graph.MetadataKey("tcpIn")] = 5000.0
+	trafficMap[tcpNode.ID] = tcpNode
+
+	a := NodeScoreAppender{}
+	a.AppendGraph(trafficMap, nil, nil)
+
+	assert.Equal(graph.NodeScores{Throughput: 1, ErrorRate: 0}, ingressNode.Metadata[graph.Scores])
+	assert.Equal(graph.NodeScores{Throughput: 0.5, ErrorRate: 0.2}, appNode.Metadata[graph.Scores])
+	assert.Equal(graph.NodeScores{}, tcpNode.Metadata[graph.Scores])
+}
+
+func TestNodeScoresWithoutRequests(t *testing.T) {
+	trafficMap := graph.NewTrafficMap()
+
+	node, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeVersionedApp)
+	trafficMap[node.ID] = node
+
+	a := NodeScoreAppender{}
+	a.AppendGraph(trafficMap, nil, nil)
+
+	assert.Equal(t, graph.NodeScores{}, node.Metadata[graph.Scores])
+}
@@ -0,0 +1,243 @@
+package appender
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
+)
+
+const (
+	RetryStormAppenderName = "retryStorm"
+
+	// defaultRetryStormThreshold flags the chains trying a request 10 times or more on a node, i.e. 2 retries at each
+	// of 3 hops try it 27 times.
+	defaultRetryStormThreshold = 10
+
+	// maxRetryStormAmplification caps the amplification of the chains, so that the product of the tries of a long
+	// chain, or of a huge number of retry attempts, doesn't overflow. It is far above any sensible threshold.
+	maxRetryStormAmplification = 1_000_000
+)
+
+// serviceRetries are the worst case tries of a request to a service, with the VirtualServices configuring them.
+type serviceRetries struct {
+	tries           int
+	virtualServices []string
+}
+
+// RetryStormAppender is responsible for flagging the nodes whose load can be amplified by the retries configured along
+// a call chain: when every hop of the chain retries its failed requests, the tries multiply from hop to hop. The tries
+// of a hop are the retry attempts, plus the first try, of the VirtualServices routing the requests to the destination
+// service, bounded by the route timeout. The chain with the highest amplification is reported on the nodes reaching
+// the threshold: n.Metadata[RetryStorm] = *RetryStormInfo
+// Only the retries configured by VirtualServices are considered, not the default retry policy of the mesh, and only
+// on the hops to service nodes, so the graph needs the service nodes injected.
+// Name: retryStorm
+type RetryStormAppender struct {
+	AccessibleNamespaces graph.AccessibleNamespaces
+	Threshold            int
+}
+
+// Name implements Appender
+func (a RetryStormAppender) Name() string {
+	return RetryStormAppenderName
+}
+
+// IsFinalizer implements Appender
+func (a RetryStormAppender) IsFinalizer() bool {
+	return true
+}
+
+// AppendGraph implements Appender
+func (a RetryStormAppender) AppendGraph(trafficMap graph.TrafficMap, globalInfo *graph.GlobalInfo, _namespaceInfo *graph.AppenderNamespaceInfo) {
+	if len(trafficMap) == 0 {
+		return
+	}
+
+	log.Trace("Running retryStorm appender")
+
+	clusters := map[string]bool{}
+	for _, n := range trafficMap {
+		if n.NodeType == graph.NodeTypeService {
+			clusters[n.Cluster] = true
+		}
+	}
+	virtualServices := map[string][]*networking_v1.VirtualService{}
+	for cluster := range clusters {
+		istioConfigList, err := globalInfo.Business.IstioConfig.GetIstioConfigList(context.TODO(), cluster, business.IstioConfigCriteria{
+			IncludeVirtualServices: true,
+		})
+		graph.CheckError(err)
+		virtualServices[cluster] = istioConfigList.VirtualServices
+	}
+
+	retries := map[string]serviceRetries{}
+	for _, n := range trafficMap {
+		if n.NodeType != graph.NodeTypeService || n.Service == "" || n.Service == graph.Unknown {
+			continue
+		}
+		if _, ok := a.AccessibleNamespaces[graph.GetClusterSensitiveKey(n.Cluster, n.Namespace)]; !ok {
+			continue
+		}
+		if r, ok := getServiceRetries(virtualServices[n.Cluster], n.Namespace, n.Service); ok {
+			retries[n.ID] = r
+		}
+	}
+
+	applyRetryStorms(trafficMap, retries, a.Threshold)
+}
+
+// getServiceRetries returns the highest tries configured by the routes of the VirtualServices of the mesh for the
+// service, and whether any route retries.
+func getServiceRetries(virtualServices []*networking_v1.VirtualService, namespace, service string) (serviceRetries, bool) {
+	result := serviceRetries{tries: 1}
+	for _, vs := range virtualServices {
+		if !appliesToMesh(vs) || !hasServiceHost(vs, namespace, service) {
+			continue
+		}
+		vsTries := 1
+		for _, httpRoute := range vs.Spec.Http {
+			if httpRoute == nil {
+				continue
+			}
+			vsTries = max(vsTries, routeTries(httpRoute))
+		}
+		if vsTries > 1 {
+			result.tries = max(result.tries, vsTries)
+			result.virtualServices = append(result.virtualServices, vs.Namespace+"/"+vs.Name)
+		}
+	}
+	return result, result.tries > 1
+}
+
+// routeTries returns the number of times a request can be tried by the route: the first try and the retry attempts,
+// as long as the tries fit in the timeout of the route.
+func routeTries(httpRoute *api_networking_v1.HTTPRoute) int {
+	if httpRoute.Retries == nil || httpRoute.Retries.Attempts <= 0 {
+		return 1
+	}
+	tries := int(httpRoute.Retries.Attempts) + 1
+	if httpRoute.Timeout != nil && httpRoute.Retries.PerTryTimeout != nil {
+		timeout := httpRoute.Timeout.AsDuration()
+		perTryTimeout := httpRoute.Retries.PerTryTimeout.AsDuration()
+		if timeout > 0 && perTryTimeout > 0 {
+			tries = min(tries, int(math.Ceil(float64(timeout)/float64(perTryTimeout))))
+		}
+	}
+	return tries
+}
+
+func appliesToMesh(vs *networking_v1.VirtualService) bool {
+	if len(vs.Spec.Gateways) == 0 {
+		return true
+	}
+	for _, gateway := range vs.Spec.Gateways {
+		if gateway == "mesh" {
+			return true
+		}
+	}
+	return false
+}
+
+func hasServiceHost(vs *networking_v1.VirtualService, namespace, service string) bool {
+	for _, host := range vs.Spec.Hosts {
+		if kubernetes.FilterByHost(host, vs.Namespace, service, namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyRetryStorms walks the call chains of the graph, multiplying the tries of the hops to the retrying services, and
+// flags the nodes whose highest amplification reaches the threshold.
+func applyRetryStorms(trafficMap graph.TrafficMap, retries map[string]serviceRetries, threshold int) {
+	if len(retries) == 0 {
+		return
+	}
+
+	best := map[string]*graph.RetryStormInfo{}
+
+	var walk func(n *graph.Node, info *graph.RetryStormInfo, onChain map[string]bool)
+	walk = func(n *graph.Node, info *graph.RetryStormInfo, onChain map[string]bool) {
+		// a chain reaching the node with less tries can't amplify more the load downstream
+		if previous, ok := best[n.ID]; ok && previous.Amplification >= info.Amplification {
+			return
+		}
+		best[n.ID] = info
+		onChain[n.ID] = true
+		defer delete(onChain, n.ID)
+
+		for _, e := range n.Edges {
+			if onChain[e.Dest.ID] {
+				continue
+			}
+			next := &graph.RetryStormInfo{
+				Amplification:   info.Amplification,
+				Chain:           append(append([]string{}, info.Chain...), e.Dest.ID),
+				VirtualServices: info.VirtualServices,
+			}
+			if r, ok := retries[e.Dest.ID]; ok {
+				next.Amplification = amplify(info.Amplification, r.tries)
+				next.VirtualServices = append(append([]string{}, info.VirtualServices...), r.virtualServices...)
+			}
+			walk(e.Dest, next, onChain)
+		}
+	}
+
+	// walk from the entries of the chains first, and then from the nodes only reachable through cycles
+	ids := make([]string, 0, len(trafficMap))
+	hasInbound := map[string]bool{}
+	for id, n := range trafficMap {
+		ids = append(ids, id)
+		for _, e := range n.Edges {
+			hasInbound[e.Dest.ID] = true
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if hasInbound[ids[i]] != hasInbound[ids[j]] {
+			return !hasInbound[ids[i]]
+		}
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids {
+		if _, ok := best[id]; !ok {
+			walk(trafficMap[id], &graph.RetryStormInfo{Amplification: 1, Chain: []string{id}, VirtualServices: []string{}}, map[string]bool{})
+		}
+	}
+
+	for id, info := range best {
+		if info.Amplification >= threshold {
+			info.VirtualServices = uniqueSorted(info.VirtualServices)
+			trafficMap[id].Metadata[graph.RetryStorm] = info
+		}
+	}
+}
+
+// amplify returns the amplification of a chain multiplied by the tries of its next hop, capped to
+// maxRetryStormAmplification.
+func amplify(amplification, tries int) int {
+	if amplification > maxRetryStormAmplification/tries {
+		return maxRetryStormAmplification
+	}
+	return min(amplification*tries, maxRetryStormAmplification)
+}
+
+func uniqueSorted(values []string) []string {
+	unique := []string{}
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
@@ -0,0 +1,139 @@
+package appender
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+)
+
+func retryVirtualService(name string, attempts int32, gateways ...string) *networking_v1.VirtualService {
+	return &networking_v1.VirtualService{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: "bookinfo"},
+		Spec: api_networking_v1.VirtualService{
+			Hosts:    []string{name},
+			Gateways: gateways,
+			Http: []*api_networking_v1.HTTPRoute{
+				{Retries: &api_networking_v1.HTTPRetry{Attempts: attempts}},
+			},
+		},
+	}
+}
+
+func TestRouteTries(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(1, routeTries(&api_networking_v1.HTTPRoute{}))
+	assert.Equal(1, routeTries(&api_networking_v1.HTTPRoute{Retries: &api_networking_v1.HTTPRetry{Attempts: 0}}))
+	assert.Equal(4, routeTries(&api_networking_v1.HTTPRoute{Retries: &api_networking_v1.HTTPRetry{Attempts: 3}}))
+	// 3 tries of 2s fit in a 5s timeout
+	assert.Equal(3, routeTries(&api_networking_v1.HTTPRoute{
+		Timeout: durationpb.New(5 * time.Second),
+		Retries: &api_networking_v1.HTTPRetry{Attempts: 5, PerTryTimeout: durationpb.New(2 * time.Second)},
+	}))
+}
+
+func TestGetServiceRetries(t *testing.T) {
+	assert := assert.New(t)
+
+	vss := []*networking_v1.VirtualService{
+		retryVirtualService("reviews", 2),
+		retryVirtualService("reviews", 4, "bookinfo-gateway"),
+		retryVirtualService("ratings", 3, "mesh"),
+	}
+
+	retries, ok := getServiceRetries(vss, "bookinfo", "reviews")
+	assert.True(ok)
+	assert.Equal(serviceRetries{tries: 3, virtualServices: []string{"bookinfo/reviews"}}, retries)
+
+	retries, ok = getServiceRetries(vss, "bookinfo", "ratings")
+	assert.True(ok)
+	assert.Equal(4, retries.tries)
+
+	_, ok = getServiceRetries(vss, "bookinfo", "details")
+	assert.False(ok)
+}
+
+func TestApplyRetryStorms(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap := graph.NewTrafficMap()
+	newService := func(service string) *graph.Node {
+		n, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", service, "bookinfo", "", "", "", graph.GraphTypeVersionedApp)
+		trafficMap[n.ID] = n
+		return n
+	}
+	newApp := func(app string) *graph.Node {
+		n, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", "", "bookinfo", app+"-v1", app, "v1", graph.GraphTypeVersionedApp)
+		trafficMap[n.ID] = n
+		return n
+	}
+
+	// productpage -> reviews -> ratings -> mongodb, with a cycle back from ratings to reviews
+	ingress, _ := graph.NewNode(config.DefaultClusterID, "istio-system", "", "istio-system", "istio-ingressgateway", "istio-ingressgateway", graph.Unknown, graph.GraphTypeVersionedApp)
+	trafficMap[ingress.ID] = ingress
+	productpageSvc, productpage := newService("productpage"), newApp("productpage")
+	reviewsSvc, reviews := newService("reviews"), newApp("reviews")
+	ratingsSvc, ratings := newService("ratings"), newApp("ratings")
+	mongodbSvc, mongodb := newService("mongodb"), newApp("mongodb")
+	ingress.AddEdge(productpageSvc)
+	productpageSvc.AddEdge(productpage)
+	productpage.AddEdge(reviewsSvc)
+	reviewsSvc.AddEdge(reviews)
+	reviews.AddEdge(ratingsSvc)
+	ratingsSvc.AddEdge(ratings)
+	ratings.AddEdge(reviewsSvc)
+	ratings.AddEdge(mongodbSvc)
+	mongodbSvc.AddEdge(mongodb)
+
+	retries := map[string]serviceRetries{
+		productpageSvc.ID: {tries: 3, virtualServices: []string{"bookinfo/productpage"}},
+		reviewsSvc.ID:     {tries: 3, virtualServices: []string{"bookinfo/reviews"}},
+		ratingsSvc.ID:     {tries: 3, virtualServices: []string{"bookinfo/ratings"}},
+	}
+	applyRetryStorms(trafficMap, retries, 10)
+
+	assert.NotContains(productpage.Metadata, graph.RetryStorm)
+	assert.NotContains(reviews.Metadata, graph.RetryStorm)
+
+	info := ratings.Metadata[graph.RetryStorm].(*graph.RetryStormInfo)
+	assert.Equal(27, info.Amplification)
+	assert.Equal([]string{ingress.ID, productpageSvc.ID, productpage.ID, reviewsSvc.ID, reviews.ID, ratingsSvc.ID, ratings.ID}, info.Chain)
+	assert.Equal([]string{"bookinfo/productpage", "bookinfo/ratings", "bookinfo/reviews"}, info.VirtualServices)
+
+	info = mongodb.Metadata[graph.RetryStorm].(*graph.RetryStormInfo)
+	assert.Equal(27, info.Amplification)
+	assert.Equal(mongodb.ID, info.Chain[len(info.Chain)-1])
+}
+
+func TestApplyRetryStormsCapsAmplification(t *testing.T) {
+	assert := assert.New(t)
+
+	// A chain of 5 services, each trying the requests as many times as the VirtualServices allow: the product
+	// overflows without the cap
+	trafficMap := graph.NewTrafficMap()
+	retries := map[string]serviceRetries{}
+	var previous *graph.Node
+	for _, service := range []string{"a", "b", "c", "d", "e"} {
+		n, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", service, "bookinfo", "", "", "", graph.GraphTypeVersionedApp)
+		trafficMap[n.ID] = n
+		retries[n.ID] = serviceRetries{tries: routeTries(&api_networking_v1.HTTPRoute{Retries: &api_networking_v1.HTTPRetry{Attempts: math.MaxInt32}})}
+		if previous != nil {
+			previous.AddEdge(n)
+		}
+		previous = n
+	}
+	applyRetryStorms(trafficMap, retries, 10)
+
+	info := previous.Metadata[graph.RetryStorm].(*graph.RetryStormInfo)
+	assert.Equal(maxRetryStormAmplification, info.Amplification)
+	assert.Len(info.Chain, 5)
+}
@@ -0,0 +1,82 @@
+package appender
+
+import (
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/log"
+)
+
+const RolloutAppenderName = "rollout"
+
+// RolloutAppender decorates the workload nodes of the ReplicaSets owned by an Argo Rollout with the rollout
+// info, and the edges to them with the traffic weight intended by the rollout controller, so the traffic split
+// reported by the telemetry can be compared with the current step of the rollout.
+// Name: rollout
+type RolloutAppender struct {
+	AccessibleNamespaces graph.AccessibleNamespaces
+}
+
+// Name implements Appender
+func (a RolloutAppender) Name() string {
+	return RolloutAppenderName
+}
+
+// IsFinalizer implements Appender
+func (a RolloutAppender) IsFinalizer() bool {
+	return false
+}
+
+// AppendGraph implements Appender
+func (a RolloutAppender) AppendGraph(trafficMap graph.TrafficMap, globalInfo *graph.GlobalInfo, namespaceInfo *graph.AppenderNamespaceInfo) {
+	if len(trafficMap) == 0 {
+		return
+	}
+
+	log.Trace("Running rollout appender")
+
+	a.applyRollouts(trafficMap, globalInfo, namespaceInfo)
+}
+
+func (a RolloutAppender) applyRollouts(trafficMap graph.TrafficMap, globalInfo *graph.GlobalInfo, namespaceInfo *graph.AppenderNamespaceInfo) {
+	rolloutNodes := map[string]*graph.RolloutInfo{}
+	for _, n := range trafficMap {
+		// Skip the check if this node is outside the requested namespace, we limit badging to the requested namespaces
+		if n.Namespace != namespaceInfo.Namespace {
+			continue
+		}
+
+		// Only a workload node is a single ReplicaSet of a Rollout
+		if n.NodeType != graph.NodeTypeWorkload {
+			continue
+		}
+
+		// Skip if the node is not accessible to the user, because we can't query for the workload
+		if _, ok := a.AccessibleNamespaces[graph.GetClusterSensitiveKey(n.Cluster, n.Namespace)]; !ok {
+			continue
+		}
+
+		workload, found := getWorkload(n.Cluster, n.Namespace, n.Workload, globalInfo)
+		if !found || workload.Rollout == nil {
+			continue
+		}
+		info := &graph.RolloutInfo{
+			Name:     workload.Rollout.Name,
+			Strategy: workload.Rollout.Strategy,
+			Role:     workload.Rollout.Role,
+			Weight:   workload.Rollout.Weight,
+		}
+		n.Metadata[graph.Rollout] = info
+		rolloutNodes[n.ID] = info
+	}
+
+	if len(rolloutNodes) == 0 {
+		return
+	}
+
+	for _, n := range trafficMap {
+		for _, e := range n.Edges {
+			if info, ok := rolloutNodes[e.Dest.ID]; ok && info.Weight != nil {
+				e.Metadata[graph.RolloutWeight] = *info.Weight
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package appender_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apps_v1 "k8s.io/api/apps/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/telemetry/istio/appender"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+func setupRollouts(t *testing.T) *business.Layer {
+	controller := true
+	objects := []runtime.Object{kubetest.FakeNamespace(appNamespace)}
+	for _, hash := range []string{"stable", "canary"} {
+		rsLabels := map[string]string{"app": appName, kubernetes.RolloutPodTemplateHashLabel: hash}
+		rs := &apps_v1.ReplicaSet{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      appName + "-" + hash,
+				Namespace: appNamespace,
+				Labels:    rsLabels,
+				OwnerReferences: []meta_v1.OwnerReference{{
+					APIVersion: kubernetes.Rollouts.GroupVersion().String(),
+					Kind:       kubernetes.RolloutType,
+					Name:       appName,
+					Controller: &controller,
+				}},
+			},
+			Spec: apps_v1.ReplicaSetSpec{
+				Template: core_v1.PodTemplateSpec{ObjectMeta: meta_v1.ObjectMeta{Labels: rsLabels}},
+			},
+		}
+		pod := &core_v1.Pod{
+			ObjectMeta: meta_v1.ObjectMeta{
+				Name:      rs.Name + "-abcde",
+				Namespace: appNamespace,
+				Labels:    rsLabels,
+				OwnerReferences: []meta_v1.OwnerReference{{
+					APIVersion: kubernetes.ReplicaSets.GroupVersion().String(),
+					Kind:       kubernetes.ReplicaSetType,
+					Name:       rs.Name,
+					Controller: &controller,
+				}},
+			},
+		}
+		objects = append(objects, rs, pod)
+	}
+
+	k8s := kubetest.NewFakeK8sClient(objects...)
+	k8s.Rollouts = []kubernetes.Rollout{{
+		ObjectMeta: meta_v1.ObjectMeta{Name: appName, Namespace: appNamespace},
+		Spec: kubernetes.RolloutSpec{
+			Strategy: kubernetes.RolloutStrategy{Canary: &kubernetes.RolloutCanaryStrategy{}},
+		},
+		Status: kubernetes.RolloutStatus{
+			Canary: kubernetes.RolloutCanaryStatus{
+				Weights: &kubernetes.RolloutTrafficWeights{
+					Canary: kubernetes.RolloutWeightDestination{Weight: 10, PodTemplateHash: "canary"},
+					Stable: kubernetes.RolloutWeightDestination{Weight: 90, PodTemplateHash: "stable"},
+				},
+			},
+			CurrentPodHash: "canary",
+			StableRS:       "stable",
+		},
+	}}
+
+	conf := config.NewConfig()
+	conf.KubernetesConfig.ClusterName = testCluster
+	conf.ExternalServices.Istio.IstioAPIEnabled = false
+	config.Set(conf)
+
+	business.SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{testCluster: k8s}
+	return business.NewWithBackends(k8sclients, k8sclients, nil, nil)
+}
+
+func TestRollout(t *testing.T) {
+	assert := require.New(t)
+
+	businessLayer := setupRollouts(t)
+
+	// Workload graph
+	trafficMap := graph.NewTrafficMap()
+	svcNode, _ := graph.NewNode(testCluster, appNamespace, appName, "", "", "", "", graph.GraphTypeWorkload)
+	stableNode, _ := graph.NewNode(testCluster, appNamespace, "", appNamespace, appName+"-stable", appName, graph.Unknown, graph.GraphTypeWorkload)
+	canaryNode, _ := graph.NewNode(testCluster, appNamespace, "", appNamespace, appName+"-canary", appName, graph.Unknown, graph.GraphTypeWorkload)
+	otherNode, _ := graph.NewNode(testCluster, appNamespace, "", appNamespace, "other-v1", "other", "v1", graph.GraphTypeWorkload)
+	trafficMap[svcNode.ID] = svcNode
+	trafficMap[stableNode.ID] = stableNode
+	trafficMap[canaryNode.ID] = canaryNode
+	trafficMap[otherNode.ID] = otherNode
+	svcNode.AddEdge(stableNode)
+	svcNode.AddEdge(canaryNode)
+	svcNode.AddEdge(otherNode)
+
+	globalInfo := graph.NewGlobalInfo()
+	globalInfo.Business = businessLayer
+	namespaceInfo := graph.NewAppenderNamespaceInfo(appNamespace)
+	key := graph.GetClusterSensitiveKey(testCluster, appNamespace)
+
+	a := appender.RolloutAppender{
+		AccessibleNamespaces: graph.AccessibleNamespaces{
+			key: &graph.AccessibleNamespace{
+				Cluster:           testCluster,
+				CreationTimestamp: time.Now(),
+				Name:              appNamespace,
+			}},
+	}
+	a.AppendGraph(trafficMap, globalInfo, namespaceInfo)
+
+	stableInfo, ok := stableNode.Metadata[graph.Rollout].(*graph.RolloutInfo)
+	assert.True(ok)
+	assert.Equal(appName, stableInfo.Name)
+	assert.Equal("stable", stableInfo.Role)
+
+	canaryInfo, ok := canaryNode.Metadata[graph.Rollout].(*graph.RolloutInfo)
+	assert.True(ok)
+	assert.Equal("canary", canaryInfo.Role)
+
+	assert.NotContains(otherNode.Metadata, graph.Rollout)
+
+	for _, e := range svcNode.Edges {
+		switch e.Dest.ID {
+		case stableNode.ID:
+			assert.Equal(int32(90), e.Metadata[graph.RolloutWeight])
+		case canaryNode.ID:
+			assert.Equal(int32(10), e.Metadata[graph.RolloutWeight])
+		default:
+			assert.NotContains(e.Metadata, graph.RolloutWeight)
+		}
+	}
+}
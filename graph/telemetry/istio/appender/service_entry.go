@@ -128,11 +128,12 @@ func (a ServiceEntryAppender) loadServiceEntryHosts(cluster, namespace string, g
 					location = "MESH_INTERNAL"
 				}
 				se := serviceEntry{
-					cluster:   cluster,
-					exportTo:  entry.Spec.ExportTo,
-					location:  location,
-					name:      entry.Name,
-					namespace: namespace,
+					cluster:    cluster,
+					exportTo:   entry.Spec.ExportTo,
+					location:   location,
+					name:       entry.Name,
+					namespace:  namespace,
+					resolution: entry.Spec.Resolution.String(),
 				}
 				for _, host := range entry.Spec.Hosts {
 					serviceEntryHosts.addHost(host, &se)
@@ -193,9 +194,10 @@ func (a ServiceEntryAppender) applyServiceEntries(trafficMap graph.TrafficMap, c
 			continue
 		}
 		serviceEntryNode.Metadata[graph.IsServiceEntry] = &graph.SEInfo{
-			Hosts:     se.hosts,
-			Location:  se.location,
-			Namespace: se.namespace,
+			Hosts:      se.hosts,
+			Location:   se.location,
+			Namespace:  se.namespace,
+			Resolution: se.resolution,
 		}
 		serviceEntryNode.Metadata[graph.DestServices] = graph.NewDestServicesMetadata()
 
@@ -75,6 +75,7 @@ func setupServiceEntries(t *testing.T, namespace string, exportTo []string, mesh
 		"host2.external.com",
 	}
 	externalSE.Spec.Location = api_networking_v1.ServiceEntry_MESH_EXTERNAL
+	externalSE.Spec.Resolution = api_networking_v1.ServiceEntry_DNS
 
 	internalSE := &networking_v1.ServiceEntry{}
 	internalSE.Name = "internalSE"
@@ -254,6 +255,7 @@ func TestServiceEntry(t *testing.T) {
 	assert.Equal(true, found3)
 	assert.Equal(0, len(externalSEServiceEntryNode.Edges))
 	assert.Equal("MESH_EXTERNAL", externalSEServiceEntryNode.Metadata[graph.IsServiceEntry].(*graph.SEInfo).Location)
+	assert.Equal("DNS", externalSEServiceEntryNode.Metadata[graph.IsServiceEntry].(*graph.SEInfo).Resolution)
 	externalHosts := externalSEServiceEntryNode.Metadata[graph.IsServiceEntry].(*graph.SEInfo).Hosts
 	assert.Equal("host1.external.com", externalHosts[0])
 	assert.Equal("host2.external.com", externalHosts[1])
@@ -270,6 +272,7 @@ func TestServiceEntry(t *testing.T) {
 	assert.Equal(true, found5)
 	assert.Equal(0, len(internalSEServiceEntryNode.Edges))
 	assert.Equal("MESH_INTERNAL", internalSEServiceEntryNode.Metadata[graph.IsServiceEntry].(*graph.SEInfo).Location)
+	assert.Equal("NONE", internalSEServiceEntryNode.Metadata[graph.IsServiceEntry].(*graph.SEInfo).Resolution)
 	internalHosts := externalSEServiceEntryNode.Metadata[graph.IsServiceEntry].(*graph.SEInfo).Hosts
 	assert.Equal("host1.external.com", internalHosts[0])
 	assert.Equal("host2.external.com", internalHosts[1])
@@ -0,0 +1,82 @@
+package appender
+
+import (
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+const SLOAppenderName = "slo"
+
+// SLOAppender decorates the service nodes with the last evaluation of their service level objectives, made by the
+// background SLO evaluator: n.Metadata[SLO] = *SLOInfo
+// Services not evaluated yet are left undecorated, the appender doesn't query Prometheus.
+// Name: slo
+type SLOAppender struct {
+	AccessibleNamespaces graph.AccessibleNamespaces
+}
+
+// Name implements Appender
+func (a SLOAppender) Name() string {
+	return SLOAppenderName
+}
+
+// IsFinalizer implements Appender
+func (a SLOAppender) IsFinalizer() bool {
+	return false
+}
+
+// AppendGraph implements Appender
+func (a SLOAppender) AppendGraph(trafficMap graph.TrafficMap, globalInfo *graph.GlobalInfo, namespaceInfo *graph.AppenderNamespaceInfo) {
+	if len(trafficMap) == 0 {
+		return
+	}
+
+	log.Trace("Running slo appender")
+
+	a.applySLOs(trafficMap, globalInfo, namespaceInfo)
+}
+
+func (a SLOAppender) applySLOs(trafficMap graph.TrafficMap, globalInfo *graph.GlobalInfo, namespaceInfo *graph.AppenderNamespaceInfo) {
+	// the statuses of the services of the namespace, per cluster
+	clusterStatuses := map[string]map[string]*models.SLOStatus{}
+	for _, n := range trafficMap {
+		// Skip the check if this node is outside the requested namespace, we limit badging to the requested namespaces
+		if n.Namespace != namespaceInfo.Namespace {
+			continue
+		}
+
+		if n.NodeType != graph.NodeTypeService {
+			continue
+		}
+
+		// Skip if the node is not accessible to the user
+		if _, ok := a.AccessibleNamespaces[graph.GetClusterSensitiveKey(n.Cluster, n.Namespace)]; !ok {
+			continue
+		}
+
+		statuses, found := clusterStatuses[n.Cluster]
+		if !found {
+			statuses = map[string]*models.SLOStatus{}
+			namespaceStatuses, err := globalInfo.Business.SLO.GetNamespaceSLOStatuses(globalInfo.Context, n.Cluster, n.Namespace)
+			if err != nil {
+				log.Debugf("Unable to get the SLO statuses of namespace [%s] in cluster [%s]: %s", n.Namespace, n.Cluster, err)
+			}
+			for _, status := range namespaceStatuses {
+				statuses[status.Service] = status
+			}
+			clusterStatuses[n.Cluster] = statuses
+		}
+
+		if status, ok := statuses[n.Service]; ok {
+			n.Metadata[graph.SLO] = &graph.SLOInfo{
+				Availability:       status.Availability,
+				AvailabilityTarget: status.Definition.AvailabilityTarget,
+				BurnRate:           status.BurnRate,
+				Compliant:          status.Compliant,
+				HasTraffic:         status.HasTraffic,
+				Window:             status.Definition.Window,
+			}
+		}
+	}
+}
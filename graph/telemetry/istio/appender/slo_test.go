@@ -0,0 +1,76 @@
+package appender_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/telemetry/istio/appender"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func TestSLO(t *testing.T) {
+	assert := require.New(t)
+
+	conf := config.NewConfig()
+	conf.KubernetesConfig.ClusterName = testCluster
+	config.Set(conf)
+
+	k8s := kubetest.NewFakeK8sClient(kubetest.FakeNamespace(appNamespace))
+	cache := business.SetupBusinessLayer(t, k8s, *conf)
+	k8sclients := map[string]kubernetes.ClientInterface{testCluster: k8s}
+	businessLayer := business.NewWithBackends(k8sclients, k8sclients, nil, nil)
+
+	cache.SLOStatuses().Set(models.SLOKey{Cluster: testCluster, Namespace: appNamespace, Service: appName}, &models.SLOStatus{
+		Cluster:      testCluster,
+		Definition:   models.SLODefinition{AvailabilityTarget: 99.9, Window: "1h"},
+		Namespace:    appNamespace,
+		Service:      appName,
+		Availability: 99.5,
+		BurnRate:     5,
+		HasTraffic:   true,
+	})
+
+	trafficMap := graph.NewTrafficMap()
+	svcNode, _ := graph.NewNode(testCluster, appNamespace, appName, "", "", "", "", graph.GraphTypeVersionedApp)
+	otherSvcNode, _ := graph.NewNode(testCluster, appNamespace, "details", "", "", "", "", graph.GraphTypeVersionedApp)
+	appNode, _ := graph.NewNode(testCluster, appNamespace, "", appNamespace, appName+"-v1", appName, "v1", graph.GraphTypeVersionedApp)
+	trafficMap[svcNode.ID] = svcNode
+	trafficMap[otherSvcNode.ID] = otherSvcNode
+	trafficMap[appNode.ID] = appNode
+
+	globalInfo := graph.NewGlobalInfo()
+	globalInfo.Business = businessLayer
+	globalInfo.Context = context.TODO()
+	namespaceInfo := graph.NewAppenderNamespaceInfo(appNamespace)
+	key := graph.GetClusterSensitiveKey(testCluster, appNamespace)
+
+	a := appender.SLOAppender{
+		AccessibleNamespaces: graph.AccessibleNamespaces{
+			key: &graph.AccessibleNamespace{
+				Cluster:           testCluster,
+				CreationTimestamp: time.Now(),
+				Name:              appNamespace,
+			}},
+	}
+	a.AppendGraph(trafficMap, globalInfo, namespaceInfo)
+
+	sloInfo, ok := svcNode.Metadata[graph.SLO].(*graph.SLOInfo)
+	assert.True(ok)
+	assert.False(sloInfo.Compliant)
+	assert.Equal(99.5, sloInfo.Availability)
+	assert.Equal(99.9, sloInfo.AvailabilityTarget)
+	assert.Equal(float64(5), sloInfo.BurnRate)
+	assert.Equal("1h", sloInfo.Window)
+
+	// Services not evaluated and the other nodes are not decorated
+	assert.NotContains(otherSvcNode.Metadata, graph.SLO)
+	assert.NotContains(appNode.Metadata, graph.SLO)
+}
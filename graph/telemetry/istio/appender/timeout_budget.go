@@ -0,0 +1,165 @@
+package appender
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/log"
+)
+
+const TimeoutBudgetAppenderName = "timeoutBudget"
+
+// TimeoutBudgetAppender is responsible for computing the timeout budget of the requests along the shortest path
+// between two nodes of the graph. The timeout of a hop to a service is the one of the routes of its VirtualServices,
+// and the budget of a hop is the shortest timeout of the hops up to it, as the callers give up on their requests at
+// their own timeout. A hop is flagged when its budget is shorter than the response time of the edge, reported by the
+// responseTime appender, i.e. when its typical requests time out: e.Metadata[TimeoutBudget] = *TimeoutBudgetInfo
+// Name: timeoutBudget
+type TimeoutBudgetAppender struct {
+	AccessibleNamespaces graph.AccessibleNamespaces
+	// Source and Target are the IDs of the nodes of the path, as returned to the clients
+	Source string
+	Target string
+}
+
+// Name implements Appender
+func (a TimeoutBudgetAppender) Name() string {
+	return TimeoutBudgetAppenderName
+}
+
+// IsFinalizer implements Appender
+func (a TimeoutBudgetAppender) IsFinalizer() bool {
+	return true
+}
+
+// AppendGraph implements Appender
+func (a TimeoutBudgetAppender) AppendGraph(trafficMap graph.TrafficMap, globalInfo *graph.GlobalInfo, _namespaceInfo *graph.AppenderNamespaceInfo) {
+	if len(trafficMap) == 0 {
+		return
+	}
+
+	log.Trace("Running timeoutBudget appender")
+
+	path := shortestPath(trafficMap, a.Source, a.Target)
+	if len(path) == 0 {
+		return
+	}
+
+	virtualServices := map[string][]*networking_v1.VirtualService{}
+	timeouts := map[string]float64{}
+	for _, e := range path {
+		n := e.Dest
+		if n.NodeType != graph.NodeTypeService || n.Service == "" || n.Service == graph.Unknown {
+			continue
+		}
+		if _, ok := a.AccessibleNamespaces[graph.GetClusterSensitiveKey(n.Cluster, n.Namespace)]; !ok {
+			continue
+		}
+		if _, ok := virtualServices[n.Cluster]; !ok {
+			istioConfigList, err := globalInfo.Business.IstioConfig.GetIstioConfigList(context.TODO(), n.Cluster, business.IstioConfigCriteria{
+				IncludeVirtualServices: true,
+			})
+			graph.CheckError(err)
+			virtualServices[n.Cluster] = istioConfigList.VirtualServices
+		}
+		if timeout, ok := getServiceTimeout(virtualServices[n.Cluster], n.Namespace, n.Service); ok {
+			timeouts[n.ID] = timeout
+		}
+	}
+
+	applyTimeoutBudget(path, timeouts)
+}
+
+// getServiceTimeout returns the highest timeout, in milliseconds, of the routes of the VirtualServices of the mesh for
+// the service, and whether all of them time out.
+func getServiceTimeout(virtualServices []*networking_v1.VirtualService, namespace, service string) (float64, bool) {
+	timeout := 0.0
+	for _, vs := range virtualServices {
+		if !appliesToMesh(vs) || !hasServiceHost(vs, namespace, service) {
+			continue
+		}
+		for _, httpRoute := range vs.Spec.Http {
+			if httpRoute == nil {
+				continue
+			}
+			if httpRoute.Timeout == nil || httpRoute.Timeout.AsDuration() <= 0 {
+				return 0, false
+			}
+			timeout = max(timeout, float64(httpRoute.Timeout.AsDuration().Milliseconds()))
+		}
+	}
+	return timeout, timeout > 0
+}
+
+// shortestPath returns the edges of the shortest path from the source node to the target node, nil when there is
+// none. The nodes are found by their ID or by the ID returned to the clients.
+func shortestPath(trafficMap graph.TrafficMap, source, target string) []*graph.Edge {
+	var sourceNode, targetNode *graph.Node
+	for _, n := range trafficMap {
+		if matchesNodeID(n, source) {
+			sourceNode = n
+		}
+		if matchesNodeID(n, target) {
+			targetNode = n
+		}
+	}
+	if sourceNode == nil || targetNode == nil || sourceNode == targetNode {
+		return nil
+	}
+
+	// breadth first, following the edges in order of their destination for a deterministic path
+	previous := map[string]*graph.Edge{sourceNode.ID: nil}
+	queue := []*graph.Node{sourceNode}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		edges := append([]*graph.Edge{}, n.Edges...)
+		sort.Slice(edges, func(i, j int) bool { return edges[i].Dest.ID < edges[j].Dest.ID })
+		for _, e := range edges {
+			if _, ok := previous[e.Dest.ID]; ok {
+				continue
+			}
+			previous[e.Dest.ID] = e
+			if e.Dest == targetNode {
+				path := []*graph.Edge{}
+				for edge := e; edge != nil; edge = previous[edge.Source.ID] {
+					path = append([]*graph.Edge{edge}, path...)
+				}
+				return path
+			}
+			queue = append(queue, e.Dest)
+		}
+	}
+	return nil
+}
+
+// matchesNodeID returns whether the node has the ID, or the ID hashed as returned to the clients.
+func matchesNodeID(n *graph.Node, id string) bool {
+	return n.ID == id || fmt.Sprintf("%x", sha256.Sum256([]byte(n.ID))) == id
+}
+
+// applyTimeoutBudget sets the timeout budget of the edges of the path, given the timeouts of the services.
+func applyTimeoutBudget(path []*graph.Edge, timeouts map[string]float64) {
+	budget := 0.0
+	for i, e := range path {
+		info := &graph.TimeoutBudgetInfo{Hop: i + 1}
+		if timeout, ok := timeouts[e.Dest.ID]; ok {
+			info.Timeout = timeout
+			if budget == 0 || timeout < budget {
+				budget = timeout
+			}
+		}
+		info.Budget = budget
+		if val, ok := e.Metadata[graph.ResponseTime]; ok {
+			info.ResponseTime = val.(float64)
+		}
+		info.Exceeded = info.Budget > 0 && info.ResponseTime > info.Budget
+		e.Metadata[graph.TimeoutBudget] = info
+	}
+}
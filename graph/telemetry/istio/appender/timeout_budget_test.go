@@ -0,0 +1,102 @@
+package appender
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+)
+
+func timeoutVirtualService(name string, timeouts ...time.Duration) *networking_v1.VirtualService {
+	vs := &networking_v1.VirtualService{
+		ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: "bookinfo"},
+		Spec:       api_networking_v1.VirtualService{Hosts: []string{name}},
+	}
+	for _, timeout := range timeouts {
+		route := &api_networking_v1.HTTPRoute{}
+		if timeout > 0 {
+			route.Timeout = durationpb.New(timeout)
+		}
+		vs.Spec.Http = append(vs.Spec.Http, route)
+	}
+	return vs
+}
+
+func TestGetServiceTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	vss := []*networking_v1.VirtualService{
+		timeoutVirtualService("reviews", time.Second, 3*time.Second),
+		timeoutVirtualService("ratings", time.Second, 0),
+	}
+
+	timeout, ok := getServiceTimeout(vss, "bookinfo", "reviews")
+	assert.True(ok)
+	assert.Equal(3000.0, timeout)
+
+	// a route without timeout lets its requests run
+	_, ok = getServiceTimeout(vss, "bookinfo", "ratings")
+	assert.False(ok)
+
+	_, ok = getServiceTimeout(vss, "bookinfo", "details")
+	assert.False(ok)
+}
+
+func TestTimeoutBudget(t *testing.T) {
+	assert := assert.New(t)
+
+	trafficMap := graph.NewTrafficMap()
+	newService := func(service string) *graph.Node {
+		n, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", service, "bookinfo", "", "", "", graph.GraphTypeVersionedApp)
+		trafficMap[n.ID] = n
+		return n
+	}
+	newApp := func(app string) *graph.Node {
+		n, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", "", "bookinfo", app+"-v1", app, "v1", graph.GraphTypeVersionedApp)
+		trafficMap[n.ID] = n
+		return n
+	}
+	addEdge := func(source, dest *graph.Node, responseTime float64) {
+		e := source.AddEdge(dest)
+		e.Metadata[graph.ResponseTime] = responseTime
+	}
+
+	productpage := newApp("productpage")
+	reviewsSvc, reviews := newService("reviews"), newApp("reviews")
+	ratingsSvc, ratings := newService("ratings"), newApp("ratings")
+	detailsSvc, details := newService("details"), newApp("details")
+	addEdge(productpage, reviewsSvc, 900)
+	addEdge(reviewsSvc, reviews, 900)
+	addEdge(reviews, ratingsSvc, 1200)
+	addEdge(ratingsSvc, ratings, 1200)
+	addEdge(productpage, detailsSvc, 10)
+	addEdge(detailsSvc, details, 10)
+
+	// the clients know the nodes by their hashed ID
+	path := shortestPath(trafficMap, productpage.ID, fmt.Sprintf("%x", sha256.Sum256([]byte(ratings.ID))))
+	assert.Len(path, 4)
+	assert.Nil(shortestPath(trafficMap, ratings.ID, productpage.ID))
+	assert.Nil(shortestPath(trafficMap, productpage.ID, "unknown"))
+
+	// reviews times out at 1s, ratings at 2s but the callers of ratings give up at 1s
+	applyTimeoutBudget(path, map[string]float64{reviewsSvc.ID: 1000, ratingsSvc.ID: 2000})
+
+	budgets := []*graph.TimeoutBudgetInfo{}
+	for _, e := range path {
+		budgets = append(budgets, e.Metadata[graph.TimeoutBudget].(*graph.TimeoutBudgetInfo))
+	}
+	assert.Equal(&graph.TimeoutBudgetInfo{Hop: 1, Timeout: 1000, Budget: 1000, ResponseTime: 900}, budgets[0])
+	assert.Equal(&graph.TimeoutBudgetInfo{Hop: 2, Budget: 1000, ResponseTime: 900}, budgets[1])
+	assert.Equal(&graph.TimeoutBudgetInfo{Hop: 3, Timeout: 2000, Budget: 1000, ResponseTime: 1200, Exceeded: true}, budgets[2])
+	assert.True(budgets[3].Exceeded)
+	assert.NotContains(productpage.Edges[1].Metadata, graph.TimeoutBudget)
+}
@@ -0,0 +1,153 @@
+package appender
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/util/httputil"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookRequest is the graph sent to a graph appender webhook.
+type WebhookRequest struct {
+	Nodes []WebhookNode `json:"nodes"`
+	Edges []WebhookEdge `json:"edges"`
+}
+
+// WebhookNode is a node of the graph sent to a graph appender webhook, or its metadata in the response.
+type WebhookNode struct {
+	ID        string                 `json:"id"`
+	NodeType  string                 `json:"nodeType,omitempty"`
+	Cluster   string                 `json:"cluster,omitempty"`
+	Namespace string                 `json:"namespace,omitempty"`
+	Workload  string                 `json:"workload,omitempty"`
+	App       string                 `json:"app,omitempty"`
+	Version   string                 `json:"version,omitempty"`
+	Service   string                 `json:"service,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// WebhookEdge is an edge of the graph sent to a graph appender webhook, or its metadata in the response.
+type WebhookEdge struct {
+	Source   string                 `json:"source"`
+	Dest     string                 `json:"dest"`
+	Protocol string                 `json:"protocol"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// WebhookResponse is the reply of a graph appender webhook, with the metadata to merge into the nodes and edges.
+type WebhookResponse struct {
+	Nodes []WebhookNode `json:"nodes"`
+	Edges []WebhookEdge `json:"edges"`
+}
+
+// WebhookAppender sends the graph to a graph appender webhook and merges the metadata it replies into the Custom
+// metadata of the nodes and edges. The graph is left undecorated when the webhook fails.
+// Name: the name of the webhook
+type WebhookAppender struct {
+	Webhook config.GraphAppenderWebhook
+}
+
+// Name implements Appender
+func (a *WebhookAppender) Name() string {
+	return a.Webhook.Name
+}
+
+// IsFinalizer implements Appender
+func (a *WebhookAppender) IsFinalizer() bool {
+	return true
+}
+
+// AppendGraph implements Appender
+func (a *WebhookAppender) AppendGraph(trafficMap graph.TrafficMap, globalInfo *graph.GlobalInfo, _namespaceInfo *graph.AppenderNamespaceInfo) {
+	if len(trafficMap) == 0 {
+		return
+	}
+
+	request := WebhookRequest{Nodes: []WebhookNode{}, Edges: []WebhookEdge{}}
+	edges := map[string]*graph.Edge{}
+	for _, n := range trafficMap {
+		request.Nodes = append(request.Nodes, WebhookNode{
+			ID:        n.ID,
+			NodeType:  n.NodeType,
+			Cluster:   n.Cluster,
+			Namespace: n.Namespace,
+			Workload:  n.Workload,
+			App:       n.App,
+			Version:   n.Version,
+			Service:   n.Service,
+		})
+		for _, e := range n.Edges {
+			edge := WebhookEdge{Source: e.Source.ID, Dest: e.Dest.ID}
+			edge.Protocol, _ = e.Metadata[graph.ProtocolKey].(string)
+			request.Edges = append(request.Edges, edge)
+			edges[webhookEdgeKey(edge)] = e
+		}
+	}
+
+	response, err := postGraphAppenderWebhook(a.Webhook, request)
+	if err != nil {
+		log.Warningf("Graph appender webhook [%s] failed, the graph is not decorated: %s", a.Webhook.Name, err)
+		return
+	}
+
+	for _, node := range response.Nodes {
+		if n, found := trafficMap[node.ID]; found {
+			graph.MergeCustomMetadata(n.Metadata, node.Metadata)
+		}
+	}
+	for _, edge := range response.Edges {
+		if e, found := edges[webhookEdgeKey(edge)]; found {
+			graph.MergeCustomMetadata(e.Metadata, edge.Metadata)
+		}
+	}
+}
+
+func webhookEdgeKey(edge WebhookEdge) string {
+	return fmt.Sprintf("%s %s %s", edge.Source, edge.Dest, edge.Protocol)
+}
+
+// getGraphAppenderWebhook returns the configured graph appender webhook of the name.
+func getGraphAppenderWebhook(name string) (config.GraphAppenderWebhook, bool) {
+	for _, webhook := range config.Get().GraphAppenderWebhooks {
+		if webhook.Name == name {
+			return webhook, true
+		}
+	}
+	return config.GraphAppenderWebhook{}, false
+}
+
+// postGraphAppenderWebhook sends the graph to the graph appender webhook and returns its response.
+func postGraphAppenderWebhook(webhook config.GraphAppenderWebhook, request WebhookRequest) (*WebhookResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	headers := map[string]string{"Content-Type": "application/json"}
+	for k, v := range webhook.CustomHeaders {
+		headers[k] = v
+	}
+	timeout := defaultWebhookTimeout
+	if webhook.TimeoutSeconds > 0 {
+		timeout = time.Duration(webhook.TimeoutSeconds) * time.Second
+	}
+
+	resp, code, _, err := httputil.HttpPost(webhook.URL, &webhook.Auth, bytes.NewReader(body), timeout, headers)
+	if err != nil {
+		return nil, err
+	}
+	if code >= 300 {
+		return nil, fmt.Errorf("graph appender webhook [%s] responded with status code [%d]", webhook.URL, code)
+	}
+	response := &WebhookResponse{}
+	if err := json.Unmarshal(resp, response); err != nil {
+		return nil, fmt.Errorf("invalid response of the graph appender webhook [%s]: %s", webhook.URL, err)
+	}
+	return response, nil
+}
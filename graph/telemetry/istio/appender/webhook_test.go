@@ -0,0 +1,102 @@
+package appender
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/graph"
+)
+
+func TestWebhookAppender(t *testing.T) {
+	require := require.New(t)
+
+	trafficMap := graph.NewTrafficMap()
+	productpage, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", "", "bookinfo", "productpage-v1", "productpage", "v1", graph.GraphTypeVersionedApp)
+	trafficMap[productpage.ID] = productpage
+	reviews, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeVersionedApp)
+	trafficMap[reviews.ID] = reviews
+	edge := productpage.AddEdge(reviews)
+	edge.Metadata[graph.ProtocolKey] = "http"
+
+	var request WebhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(json.NewDecoder(r.Body).Decode(&request))
+		_ = json.NewEncoder(w).Encode(WebhookResponse{
+			Nodes: []WebhookNode{
+				{ID: reviews.ID, Metadata: map[string]interface{}{"owner": "team-reviews"}},
+				{ID: "unknown", Metadata: map[string]interface{}{"owner": "nobody"}},
+			},
+			Edges: []WebhookEdge{
+				{Source: productpage.ID, Dest: reviews.ID, Protocol: "http", Metadata: map[string]interface{}{"cost": 0.5}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	a := WebhookAppender{Webhook: config.GraphAppenderWebhook{Name: "ownership", URL: server.URL}}
+	a.AppendGraph(trafficMap, graph.NewGlobalInfo(), nil)
+
+	require.Len(request.Nodes, 2)
+	require.Equal([]WebhookEdge{{Source: productpage.ID, Dest: reviews.ID, Protocol: "http"}}, request.Edges)
+
+	require.Equal(graph.CustomMetadata{"owner": "team-reviews"}, reviews.Metadata[graph.Custom])
+	require.NotContains(productpage.Metadata, graph.Custom)
+	require.Equal(graph.CustomMetadata{"cost": 0.5}, edge.Metadata[graph.Custom])
+}
+
+func TestWebhookAppenderFailure(t *testing.T) {
+	trafficMap := graph.NewTrafficMap()
+	node, _ := graph.NewNode(config.DefaultClusterID, "bookinfo", "", "bookinfo", "reviews-v1", "reviews", "v1", graph.GraphTypeVersionedApp)
+	trafficMap[node.ID] = node
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	// The graph is not decorated, but not failed either
+	a := WebhookAppender{Webhook: config.GraphAppenderWebhook{Name: "ownership", URL: server.URL}}
+	a.AppendGraph(trafficMap, graph.NewGlobalInfo(), nil)
+	require.NotContains(t, node.Metadata, graph.Custom)
+}
+
+type costAppender struct{}
+
+func (costAppender) Name() string {
+	return "cost"
+}
+
+func (costAppender) IsFinalizer() bool {
+	return false
+}
+
+func (costAppender) AppendGraph(trafficMap graph.TrafficMap, _ *graph.GlobalInfo, _ *graph.AppenderNamespaceInfo) {
+}
+
+func TestParseCustomAppenders(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.GraphAppenderWebhooks = []config.GraphAppenderWebhook{{Name: "ownership", URL: "http://ownership:8080"}}
+	config.Set(conf)
+	graph.RegisterCustomAppender(costAppender{})
+	defer graph.UnregisterCustomAppender("cost")
+
+	appenders, finalizers := ParseAppenders(graph.TelemetryOptions{Appenders: graph.RequestedAppenders{AppenderNames: []string{"ownership", "cost", LabelerAppenderName}}})
+	require.Len(appenders, 1)
+	require.Equal("cost", appenders[0].Name())
+	names := []string{}
+	for _, f := range finalizers {
+		names = append(names, f.Name())
+	}
+	require.Equal([]string{ExtensionsAppenderName, OutsiderAppenderName, LabelerAppenderName, "ownership", TrafficGeneratorAppenderName}, names)
+
+	require.Panics(func() {
+		ParseAppenders(graph.TelemetryOptions{Appenders: graph.RequestedAppenders{AppenderNames: []string{"billing"}}})
+	})
+}
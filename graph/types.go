@@ -79,11 +79,30 @@ type WEInfo struct {
 	Name string `json:"name"`
 }
 
+// RolloutInfo provides information about the Argo Rollout owning a workload node
+type RolloutInfo struct {
+	Name     string `json:"name"`             // name of the Argo Rollout
+	Strategy string `json:"strategy"`         // canary | blueGreen
+	Role     string `json:"role,omitempty"`   // stable | canary | active | preview, empty for older revisions
+	Weight   *int32 `json:"weight,omitempty"` // percentage of the traffic intended for the workload
+}
+
+// SLOInfo provides the last evaluation of the service level objectives of a service node
+type SLOInfo struct {
+	Availability       float64 `json:"availability"`       // percentage of successful requests in the window
+	AvailabilityTarget float64 `json:"availabilityTarget"` // percentage of successful requests expected
+	BurnRate           float64 `json:"burnRate"`           // ratio between the observed and the allowed error rates
+	Compliant          bool    `json:"compliant"`          // true when the availability and latency objectives are met
+	HasTraffic         bool    `json:"hasTraffic"`         // false when no requests were observed in the window
+	Window             string  `json:"window"`             // Prometheus duration of the evaluation window
+}
+
 // SEInfo provides static information about the service entry
 type SEInfo struct {
-	Hosts     []string `json:"hosts"`     // configured list of hosts
-	Location  string   `json:"location"`  // e.g. MESH_EXTERNAL, MESH_INTERNAL
-	Namespace string   `json:"namespace"` // the definition namespace
+	Hosts      []string `json:"hosts"`      // configured list of hosts
+	Location   string   `json:"location"`   // e.g. MESH_EXTERNAL, MESH_INTERNAL
+	Namespace  string   `json:"namespace"`  // the definition namespace
+	Resolution string   `json:"resolution"` // e.g. NONE, STATIC, DNS, DNS_ROUND_ROBIN
 }
 
 func (s *ServiceName) Key() string {
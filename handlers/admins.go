@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	"slices"
+
+	"github.com/kiali/kiali/config"
+)
+
+// isAdmin returns whether the user is an admin of a feature: one of the users of its admin list, or of the Kiali
+// admins when the feature doesn't set its own list.
+func isAdmin(conf *config.Config, user string, admins []string) bool {
+	if admins == nil {
+		admins = conf.Auth.Admins
+	}
+	return user != "" && slices.Contains(admins, user)
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+)
+
+func TestIsAdmin(t *testing.T) {
+	conf := config.NewConfig()
+	conf.Auth.Admins = []string{"alice"}
+	conf.Auth.CacheAdmins = []string{"bob"}
+	conf.Auth.SessionAdmins = []string{}
+
+	cases := map[string]struct {
+		user     string
+		admins   []string
+		expected bool
+	}{
+		"Kiali admin without feature list":  {user: "alice", admins: conf.Auth.NotificationAdmins, expected: true},
+		"Other user without feature list":   {user: "bob", admins: conf.Auth.NotificationAdmins, expected: false},
+		"Feature admin":                     {user: "bob", admins: conf.Auth.CacheAdmins, expected: true},
+		"Kiali admin overridden by feature": {user: "alice", admins: conf.Auth.CacheAdmins, expected: false},
+		"Empty feature list allows no one":  {user: "alice", admins: conf.Auth.SessionAdmins, expected: false},
+		"Anonymous user is never an admin":  {user: "", admins: []string{""}, expected: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, isAdmin(conf, tc.user, tc.admins))
+		})
+	}
+}
@@ -69,6 +69,8 @@ func (aHandler *AuthenticationHandler) Handle(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		statusCode := http.StatusOK
 		userSessions := make(authentication.UserSessions)
+		// The auth controllers add the subject of the session, a header sent by the client must not be taken for it
+		r.Header.Del("Kiali-User")
 
 		switch authStrategy := aHandler.conf.Auth.Strategy; authStrategy {
 		case config.AuthStrategyToken, config.AuthStrategyOpenId, config.AuthStrategyOpenshift, config.AuthStrategyHeader:
@@ -100,8 +102,18 @@ func (aHandler *AuthenticationHandler) Handle(next http.Handler) http.Handler {
 				log.Errorf("No active user session: %v", http.StatusBadRequest)
 				return
 			}
+			for _, session := range userSessions {
+				authentication.Sessions.Track(authentication.SessionInfo{
+					ID:        session.SessionID,
+					CreatedOn: session.SessionCreatedOn,
+					ExpiresOn: session.ExpiresOn,
+					Strategy:  aHandler.conf.Auth.Strategy,
+					User:      session.Username,
+				})
+			}
 			ctx := authentication.SetAuthInfoContext(r.Context(), userSessions.GetAuthInfos())
-			next.ServeHTTP(w, r.WithContext(ctx))
+			ctx = authentication.SetUserSessionsContext(ctx, userSessions)
+			next.ServeHTTP(w, applyTimePin(r.WithContext(ctx)))
 		case http.StatusUnauthorized:
 			err := aHandler.authController.TerminateSession(r, w)
 			if err != nil {
@@ -212,3 +224,14 @@ func Logout(conf *config.Config, authController authentication.AuthController) h
 		}
 	}
 }
+
+// sessionUser returns the user of the sessions of the request, set by the authentication handler. The Kiali-User
+// header is not trusted for this: it can be sent by the client.
+func sessionUser(r *http.Request) string {
+	for _, session := range authentication.GetUserSessionsContext(r.Context()) {
+		if session.Username != "" {
+			return session.Username
+		}
+	}
+	return ""
+}
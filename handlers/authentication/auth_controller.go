@@ -84,6 +84,12 @@ type UserSessionData struct {
 	//
 	// required: true
 	AuthInfo *api.AuthInfo `json:"-"`
+
+	// The identifier of the session persisting the credentials, if any
+	SessionID string `json:"-"`
+
+	// The time when the session persisting the credentials was created
+	SessionCreatedOn time.Time `json:"-"`
 }
 
 // AuthenticationFailureError is a helper Error to assist callers of the TokenAuthController.Authenticate
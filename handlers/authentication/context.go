@@ -15,3 +15,16 @@ func SetAuthInfoContext(ctx context.Context, value interface{}) context.Context
 func GetAuthInfoContext(ctx context.Context) interface{} {
 	return ctx.Value(ContextKeyAuthInfo)
 }
+
+var ContextKeyUserSessions contextKey = "userSessions"
+
+// SetUserSessionsContext stores the sessions of the user of the request.
+func SetUserSessionsContext(ctx context.Context, sessions UserSessions) context.Context {
+	return context.WithValue(ctx, ContextKeyUserSessions, sessions)
+}
+
+// GetUserSessionsContext returns the sessions of the user of the request, if any.
+func GetUserSessionsContext(ctx context.Context) UserSessions {
+	sessions, _ := ctx.Value(ContextKeyUserSessions).(UserSessions)
+	return sessions
+}
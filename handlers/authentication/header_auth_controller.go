@@ -127,22 +127,19 @@ func (c headerAuthController) ValidateSession(r *http.Request, w http.ResponseWr
 	// expired. So, if we have cookies, we can recover the subject. Else, send empty subject.
 	// Expiration time is probably irrelevant for this auth strategy, but to keep the so-so same behavior
 	// before the auth refactor, we set expiration time to "now" if we don't have cookies.
-	var expiration time.Time
-	var subject string
+	session := &UserSessionData{AuthInfo: authInfo}
 	if sData == nil {
-		expiration = util.Clock.Now()
-		subject = ""
+		session.ExpiresOn = util.Clock.Now()
+		session.Username = ""
 	} else {
-		expiration = sData.ExpiresOn
-		subject = sData.Payload.Subject
+		session.ExpiresOn = sData.ExpiresOn
+		session.Username = sData.Payload.Subject
+		session.SessionID = sData.ID
+		session.SessionCreatedOn = sData.CreatedOn
 	}
 
 	return UserSessions{
-		config.Get().KubernetesConfig.ClusterName: &UserSessionData{
-			ExpiresOn: expiration,
-			Username:  subject,
-			AuthInfo:  authInfo,
-		},
+		config.Get().KubernetesConfig.ClusterName: session,
 	}, nil
 }
 
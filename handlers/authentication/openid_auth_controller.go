@@ -78,6 +78,9 @@ type oidcSessionPayload struct {
 	// Subject is the resolved name of the user that logged into Kiali.
 	Subject string `json:"subject,omitempty"`
 
+	// Groups are the groups of the user, read from the id_token when impersonation is enabled.
+	Groups []string `json:"groups,omitempty"`
+
 	// Token is the string provided by the OpenId server. It can be the id_token or
 	// the access_token, depending on the Kiali configuration. If RBAC is enabled,
 	// this is the token that can be used against the Kubernetes API.
@@ -226,9 +229,11 @@ func (c OpenIdAuthController) ValidateSession(r *http.Request, w http.ResponseWr
 		// If RBAC is ENABLED, check that the user has privileges on the cluster.
 		for cluster := range c.clientFactory.GetSAClients() {
 			userSessions[cluster] = &UserSessionData{
-				ExpiresOn: sData.ExpiresOn,
-				Username:  sData.Payload.Subject,
-				AuthInfo:  &api.AuthInfo{Token: sData.Payload.Token},
+				ExpiresOn:        sData.ExpiresOn,
+				Username:         sData.Payload.Subject,
+				AuthInfo:         &api.AuthInfo{Token: sData.Payload.Token},
+				SessionID:        sData.ID,
+				SessionCreatedOn: sData.CreatedOn,
 			}
 		}
 		userClients, err := c.clientFactory.GetClients(userSessions.GetAuthInfos())
@@ -246,13 +251,20 @@ func (c OpenIdAuthController) ValidateSession(r *http.Request, w http.ResponseWr
 	} else {
 		// If RBAC is off, it's assumed that the kubernetes cluster will reject the OpenId token.
 		// Instead, we use the Kiali token and this has the side effect that all users will share the
-		// same privileges.
+		// same privileges, unless the Kiali SA impersonates the user.
 		token := c.clientFactory.GetSAHomeClusterClient().GetToken()
 		for cluster := range c.clientFactory.GetSAClients() {
+			authInfo := &api.AuthInfo{Token: token}
+			if c.conf.Auth.OpenId.Impersonation.Enabled {
+				authInfo.Impersonate = c.conf.Auth.OpenId.Impersonation.UsernamePrefix + sData.Payload.Subject
+				authInfo.ImpersonateGroups = sData.Payload.Groups
+			}
 			userSessions[cluster] = &UserSessionData{
-				ExpiresOn: sData.ExpiresOn,
-				Username:  sData.Payload.Subject,
-				AuthInfo:  &api.AuthInfo{Token: token},
+				ExpiresOn:        sData.ExpiresOn,
+				Username:         sData.Payload.Subject,
+				AuthInfo:         authInfo,
+				SessionID:        sData.ID,
+				SessionCreatedOn: sData.CreatedOn,
 			}
 		}
 	}
@@ -869,10 +881,32 @@ func buildSessionPayload(openIdParams *openidFlowHelper) *oidcSessionPayload {
 		token = openIdParams.AccessToken
 	}
 
-	return &oidcSessionPayload{
+	payload := &oidcSessionPayload{
 		Token:   token,
 		Subject: openIdParams.Subject,
 	}
+	if impersonation := openIdParams.conf.Auth.OpenId.Impersonation; impersonation.Enabled {
+		payload.Groups = parseGroupsClaim(openIdParams.IdTokenPayload[impersonation.GroupsClaim], impersonation.GroupsPrefix)
+	}
+	return payload
+}
+
+// parseGroupsClaim returns the groups of the claim, which can be a list or a single group, with the prefix prepended.
+func parseGroupsClaim(claim interface{}, prefix string) []string {
+	groups := []string{}
+	switch value := claim.(type) {
+	case string:
+		if value != "" {
+			groups = append(groups, prefix+value)
+		}
+	case []interface{}:
+		for _, group := range value {
+			if name, ok := group.(string); ok && name != "" {
+				groups = append(groups, prefix+name)
+			}
+		}
+	}
+	return groups
 }
 
 // checkDomain verifies that the "hd" or the "email" claims in tokenClaims contain a domain
@@ -1156,3 +1156,9 @@ func TestOpenIdCodeFlowShouldRejectMissingNonceInToken(t *testing.T) {
 	assert.Equal(t, "/kiali-test/?"+q.Encode(), response.Header.Get("Location"))
 	assert.Equal(t, http.StatusFound, response.StatusCode)
 }
+
+func TestParseGroupsClaim(t *testing.T) {
+	assert.Equal(t, []string{"oidc:admins", "oidc:devs"}, parseGroupsClaim([]interface{}{"admins", "devs", 3, ""}, "oidc:"))
+	assert.Equal(t, []string{"admins"}, parseGroupsClaim("admins", ""))
+	assert.Empty(t, parseGroupsClaim(nil, ""))
+}
@@ -243,9 +243,11 @@ func (o *OpenshiftAuthController) ValidateSession(r *http.Request, w http.Respon
 				return nil, err
 			}
 			userSessions[session.Key] = &UserSessionData{
-				ExpiresOn: session.ExpiresOn,
-				Username:  user.Name,
-				AuthInfo:  &api.AuthInfo{Token: session.Payload.AccessToken},
+				ExpiresOn:        session.ExpiresOn,
+				Username:         user.Name,
+				AuthInfo:         &api.AuthInfo{Token: session.Payload.AccessToken},
+				SessionID:        session.ID,
+				SessionCreatedOn: session.CreatedOn,
 			}
 		}
 	}
@@ -72,8 +72,15 @@ func NewSessionData[T any](key string, strategy string, expiresOn time.Time, pay
 		return nil, errors.New("the expiration time of a session cannot be in the past")
 	}
 
+	id, err := util.CryptoRandomBytes(16)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the session id: %w", err)
+	}
+
 	return &SessionData[T]{
+		CreatedOn: util.Clock.Now(),
 		ExpiresOn: expiresOn,
+		ID:        base64.RawURLEncoding.EncodeToString(id),
 		Key:       key,
 		Strategy:  strategy,
 		Payload:   payload,
@@ -113,9 +120,15 @@ type cookieSessionPersistor[T any] struct {
 // SessionData holds the data for a session and will be encrypted and stored in browser cookies.
 // If the data is too large to fit in a browser cookie, it will be chunked and split over multiple cookies.
 type SessionData[T any] struct {
+	// CreatedOn is the time when the session was created.
+	CreatedOn time.Time `json:"createdOn,omitempty"`
+
 	// ExpiresOn is the time when the session expires. This can be zero meaning the session never expires.
 	ExpiresOn time.Time `json:"expiresOn"`
 
+	// ID is the random identifier of the session, used to list and revoke the sessions.
+	ID string `json:"id,omitempty"`
+
 	// Key should be a unique identifier for the session.
 	// For now this is just the cluster name.
 	Key string `json:"key,omitempty"`
@@ -132,7 +145,15 @@ type SessionData[T any] struct {
 // For improved security, the data of the session is encrypted using the AES-GCM algorithm and
 // the encrypted data is what is sent in cookies. The strategy, expiresOn and payload arguments
 // are all required.
+// A session replacing a previous one of the browser (i.e. a new login, maybe with different privileges) revokes the
+// previous session, so that its cookies can't be replayed.
 func (p *cookieSessionPersistor[T]) CreateSession(r *http.Request, w http.ResponseWriter, s SessionData[T]) error {
+	if r != nil {
+		if previous, err := p.readKialiCookie(sessionCookieName(SessionCookieName, s.Key), r); err == nil && previous.ID != s.ID {
+			Sessions.revokeSession(previous.ID, previous.ExpiresOn)
+		}
+	}
+
 	// Serialize this structure. The resulting string
 	// is what will be encrypted and stored in cookies.
 	sDataJson, err := json.Marshal(s)
@@ -324,6 +345,14 @@ func (p *cookieSessionPersistor[T]) ReadSession(r *http.Request, w http.Response
 		return nil, fmt.Errorf("session expired on %s", sData.ExpiresOn.Format(time.RFC822))
 	}
 
+	// Check that the session has not been revoked.
+	if Sessions.IsRevoked(sData.ID, sData.CreatedOn) {
+		log.Debugf("Session is invalid because it was revoked")
+		p.TerminateSession(r, w, key) // Clean the revoked session
+
+		return nil, fmt.Errorf("session %w: the session was revoked", ErrSessionNotFound)
+	}
+
 	return sData, nil
 }
 
@@ -346,6 +375,11 @@ func (p *cookieSessionPersistor[T]) ReadAllSessions(r *http.Request, w http.Resp
 				}
 				continue
 			}
+			if Sessions.IsRevoked(sData.ID, sData.CreatedOn) {
+				log.Debugf("Session cookie %s is of a revoked session", cookie.Name)
+				p.dropCookie(r, w, cookie.Name)
+				continue
+			}
 			sessions = append(sessions, sData)
 		}
 	}
@@ -376,8 +410,12 @@ func (p *cookieSessionPersistor[T]) dropCookie(r *http.Request, w http.ResponseW
 
 // TerminateSession destroys any persisted data of a session created by the CreateSession function.
 // The session is terminated unconditionally (that is, there is no validation of the session), allowing
-// clearing any stale cookies/session.
+// clearing any stale cookies/session. The session is revoked too, so that its cookies can't be replayed after a logout.
 func (p *cookieSessionPersistor[T]) TerminateSession(r *http.Request, w http.ResponseWriter, key string) {
+	if sData, err := p.readKialiCookie(sessionCookieName(SessionCookieName, key), r); err == nil {
+		Sessions.revokeSession(sData.ID, sData.ExpiresOn)
+	}
+
 	for _, cookie := range r.Cookies() {
 		// Drop all cookies that are related to the session:
 		// - Session cookie
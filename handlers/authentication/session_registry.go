@@ -0,0 +1,278 @@
+package authentication
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kiali/kiali/util"
+)
+
+// SessionInfo describes an active session of a user.
+// swagger:model SessionInfo
+type SessionInfo struct {
+	// The identifier of the session
+	ID string `json:"id"`
+
+	// The time when the session was created
+	CreatedOn time.Time `json:"createdOn"`
+
+	// Current is true for the session of the request listing the sessions
+	Current bool `json:"current"`
+
+	// The time when the session expires
+	ExpiresOn time.Time `json:"expiresOn"`
+
+	// The last time the session was used
+	LastSeen time.Time `json:"lastSeen"`
+
+	// The auth strategy of the session
+	Strategy string `json:"strategy"`
+
+	// The user of the session
+	User string `json:"user"`
+}
+
+// TimePin freezes the time the queries of a session are evaluated at, so that the calls of a drill-down flow (i.e. the
+// graph and then the metrics of one of its edges) use the same window. The pin expires to not freeze a forgotten
+// session forever.
+// swagger:model TimePin
+type TimePin struct {
+	// The time the queries are evaluated at
+	Time time.Time `json:"time"`
+
+	// The time when the pin expires
+	ExpiresOn time.Time `json:"expiresOn"`
+}
+
+const (
+	// trackInterval is how long the last use of a session is kept before being updated, so that the registry is not
+	// written on every request.
+	trackInterval = time.Minute
+	// pruneInterval is the minimum time between two prunes of the expired sessions.
+	pruneInterval = 5 * time.Minute
+)
+
+// SessionRegistry keeps track of the sessions used in this Kiali instance, and of the revoked ones.
+// The sessions are still persisted in the browser cookies: the registry is only a revocation list, so that a
+// session unknown to the registry (i.e. after a restart, or used in a different replica) is valid.
+type SessionRegistry struct {
+	lock sync.RWMutex
+	// revoked maps the revoked sessions to their expiration, after that they don't need to be remembered
+	revoked map[string]time.Time
+	// revokedBefore revokes any session created before it
+	revokedBefore time.Time
+	sessions      map[string]SessionInfo
+	// pins maps the sessions to their pinned query time
+	pins map[string]TimePin
+	// prunedOn is the last time the expired sessions were pruned
+	prunedOn time.Time
+}
+
+// Sessions is the registry of the sessions of the Kiali instance.
+var Sessions = NewSessionRegistry()
+
+// NewSessionRegistry creates an empty SessionRegistry.
+func NewSessionRegistry() *SessionRegistry {
+	return &SessionRegistry{
+		pins:     map[string]TimePin{},
+		revoked:  map[string]time.Time{},
+		sessions: map[string]SessionInfo{},
+	}
+}
+
+// Track records the use of a session. A revoked session is not tracked. The last use of a session is only updated
+// once per trackInterval, or when the expiration of the session changed.
+func (s *SessionRegistry) Track(info SessionInfo) {
+	if info.ID == "" {
+		return
+	}
+	now := util.Clock.Now()
+	s.lock.RLock()
+	tracked, ok := s.sessions[info.ID]
+	s.lock.RUnlock()
+	if ok && tracked.ExpiresOn.Equal(info.ExpiresOn) && now.Sub(tracked.LastSeen) < trackInterval {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.isRevoked(info.ID, info.CreatedOn) {
+		return
+	}
+	s.pruneExpired(now)
+	info.Current = false
+	info.LastSeen = now
+	s.sessions[info.ID] = info
+}
+
+// List returns the tracked sessions of a user, the oldest first.
+func (s *SessionRegistry) List(user string) []SessionInfo {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	now := util.Clock.Now()
+	sessions := []SessionInfo{}
+	for _, session := range s.sessions {
+		if session.User == user && now.Before(session.ExpiresOn) {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].CreatedOn.Equal(sessions[j].CreatedOn) {
+			return sessions[i].ID < sessions[j].ID
+		}
+		return sessions[i].CreatedOn.Before(sessions[j].CreatedOn)
+	})
+	return sessions
+}
+
+// Get returns a tracked session.
+func (s *SessionRegistry) Get(id string) (SessionInfo, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	session, ok := s.sessions[id]
+	return session, ok
+}
+
+// Revoke revokes a tracked session. It returns false when the session is not tracked.
+func (s *SessionRegistry) Revoke(id string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return false
+	}
+	s.revoke(id, session.ExpiresOn)
+	return true
+}
+
+// RevokeUser revokes the tracked sessions of a user and returns how many were revoked.
+func (s *SessionRegistry) RevokeUser(user string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	count := 0
+	for id, session := range s.sessions {
+		if session.User == user {
+			s.revoke(id, session.ExpiresOn)
+			count++
+		}
+	}
+	return count
+}
+
+// RevokeAll revokes every session created until now, tracked or not, and returns how many tracked sessions were
+// revoked.
+func (s *SessionRegistry) RevokeAll() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	count := len(s.sessions)
+	s.revokedBefore = util.Clock.Now()
+	s.pins = map[string]TimePin{}
+	s.revoked = map[string]time.Time{}
+	s.sessions = map[string]SessionInfo{}
+	return count
+}
+
+// Pin pins the query time of a session. A revoked session can't be pinned.
+func (s *SessionRegistry) Pin(id string, pin TimePin) {
+	if id == "" {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, revoked := s.revoked[id]; revoked {
+		return
+	}
+	s.pruneExpired(util.Clock.Now())
+	s.pins[id] = pin
+}
+
+// Unpin removes the pinned query time of a session. It returns false when the session has none.
+func (s *SessionRegistry) Unpin(id string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.pins[id]
+	delete(s.pins, id)
+	return ok
+}
+
+// Pinned returns the pinned query time of a session, if it has not expired.
+func (s *SessionRegistry) Pinned(id string) (TimePin, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	pin, ok := s.pins[id]
+	if !ok || !util.Clock.Now().Before(pin.ExpiresOn) {
+		return TimePin{}, false
+	}
+	return pin, true
+}
+
+// IsRevoked returns true when a session was revoked.
+func (s *SessionRegistry) IsRevoked(id string, createdOn time.Time) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.isRevoked(id, createdOn)
+}
+
+// revokeSession revokes a session, tracked or not, until its expiration.
+func (s *SessionRegistry) revokeSession(id string, expiresOn time.Time) {
+	if id == "" {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.revoke(id, expiresOn)
+}
+
+func (s *SessionRegistry) revoke(id string, expiresOn time.Time) {
+	delete(s.pins, id)
+	delete(s.sessions, id)
+	s.revoked[id] = expiresOn
+}
+
+func (s *SessionRegistry) isRevoked(id string, createdOn time.Time) bool {
+	if !s.revokedBefore.IsZero() && !createdOn.After(s.revokedBefore) {
+		return true
+	}
+	_, revoked := s.revoked[id]
+	return revoked
+}
+
+// pruneExpired prunes the expired sessions when they were not pruned during the last pruneInterval.
+func (s *SessionRegistry) pruneExpired(now time.Time) {
+	if now.Sub(s.prunedOn) < pruneInterval {
+		return
+	}
+	s.prune(now)
+	s.prunedOn = now
+}
+
+// prune forgets the expired sessions, that the session persistor rejects anyway.
+func (s *SessionRegistry) prune(now time.Time) {
+	for id, session := range s.sessions {
+		if !now.Before(session.ExpiresOn) {
+			delete(s.sessions, id)
+		}
+	}
+	for id, pin := range s.pins {
+		if !now.Before(pin.ExpiresOn) {
+			delete(s.pins, id)
+		}
+	}
+	for id, expiresOn := range s.revoked {
+		if !now.Before(expiresOn) {
+			delete(s.revoked, id)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package authentication
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/util"
+)
+
+func TestSessionRegistry(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	util.Clock = util.ClockMock{Time: now}
+
+	registry := NewSessionRegistry()
+	registry.Track(SessionInfo{ID: "a", User: "alice", CreatedOn: now.Add(-time.Minute), ExpiresOn: now.Add(time.Hour)})
+	registry.Track(SessionInfo{ID: "b", User: "alice", CreatedOn: now.Add(-2 * time.Minute), ExpiresOn: now.Add(time.Hour)})
+	registry.Track(SessionInfo{ID: "c", User: "bob", CreatedOn: now.Add(-time.Minute), ExpiresOn: now.Add(time.Hour)})
+
+	sessions := registry.List("alice")
+	require.Len(sessions, 2)
+	require.Equal("b", sessions[0].ID)
+	require.Equal(now, sessions[0].LastSeen)
+
+	require.True(registry.Revoke("a"))
+	require.False(registry.Revoke("unknown"))
+	require.True(registry.IsRevoked("a", now.Add(-time.Minute)))
+	require.False(registry.IsRevoked("b", now.Add(-2*time.Minute)))
+
+	// A revoked session is not tracked again
+	registry.Track(SessionInfo{ID: "a", User: "alice", CreatedOn: now.Add(-time.Minute), ExpiresOn: now.Add(time.Hour)})
+	require.Len(registry.List("alice"), 1)
+
+	require.Equal(1, registry.RevokeUser("bob"))
+	require.Empty(registry.List("bob"))
+
+	require.Equal(1, registry.RevokeAll())
+	require.True(registry.IsRevoked("untracked", now.Add(-time.Hour)))
+	require.False(registry.IsRevoked("new", now.Add(time.Second)))
+}
+
+func TestSessionRegistryTrackInterval(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	util.Clock = util.ClockMock{Time: now}
+
+	registry := NewSessionRegistry()
+	registry.Track(SessionInfo{ID: "a", User: "alice", CreatedOn: now, ExpiresOn: now.Add(time.Hour)})
+	registry.Track(SessionInfo{ID: "b", User: "alice", CreatedOn: now, ExpiresOn: now.Add(2 * time.Minute)})
+
+	// The last use is not updated more than once per interval
+	util.Clock = util.ClockMock{Time: now.Add(trackInterval / 2)}
+	registry.Track(SessionInfo{ID: "a", User: "alice", CreatedOn: now, ExpiresOn: now.Add(time.Hour)})
+	session, _ := registry.Get("a")
+	require.Equal(now, session.LastSeen)
+
+	// Unless the session was extended
+	registry.Track(SessionInfo{ID: "a", User: "alice", CreatedOn: now, ExpiresOn: now.Add(2 * time.Hour)})
+	session, _ = registry.Get("a")
+	require.Equal(now.Add(trackInterval/2), session.LastSeen)
+	require.Equal(now.Add(2*time.Hour), session.ExpiresOn)
+
+	util.Clock = util.ClockMock{Time: now.Add(2 * trackInterval)}
+	registry.Track(SessionInfo{ID: "a", User: "alice", CreatedOn: now, ExpiresOn: now.Add(2 * time.Hour)})
+	session, _ = registry.Get("a")
+	require.Equal(now.Add(2*trackInterval), session.LastSeen)
+
+	// The expired sessions are pruned once per interval, they are not listed meanwhile
+	_, ok := registry.Get("b")
+	require.True(ok)
+	require.Len(registry.List("alice"), 1)
+	util.Clock = util.ClockMock{Time: now.Add(pruneInterval)}
+	registry.Track(SessionInfo{ID: "a", User: "alice", CreatedOn: now, ExpiresOn: now.Add(2 * time.Hour)})
+	_, ok = registry.Get("b")
+	require.False(ok)
+}
+
+func TestSessionRegistryTimePins(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	util.Clock = util.ClockMock{Time: now}
+
+	registry := NewSessionRegistry()
+	registry.Track(SessionInfo{ID: "a", User: "alice", CreatedOn: now, ExpiresOn: now.Add(time.Hour)})
+	registry.Pin("a", TimePin{Time: now.Add(-time.Minute), ExpiresOn: now.Add(10 * time.Minute)})
+	registry.Pin("", TimePin{Time: now, ExpiresOn: now.Add(10 * time.Minute)})
+
+	pin, ok := registry.Pinned("a")
+	require.True(ok)
+	require.Equal(now.Add(-time.Minute), pin.Time)
+	_, ok = registry.Pinned("")
+	require.False(ok)
+
+	// Expired pins are ignored
+	util.Clock = util.ClockMock{Time: now.Add(10 * time.Minute)}
+	_, ok = registry.Pinned("a")
+	require.False(ok)
+
+	registry.Pin("a", TimePin{Time: now, ExpiresOn: now.Add(20 * time.Minute)})
+	require.True(registry.Unpin("a"))
+	require.False(registry.Unpin("a"))
+
+	// Revoking a session drops its pin and it can't be pinned again
+	registry.Pin("a", TimePin{Time: now, ExpiresOn: now.Add(20 * time.Minute)})
+	require.True(registry.Revoke("a"))
+	registry.Pin("a", TimePin{Time: now, ExpiresOn: now.Add(20 * time.Minute)})
+	_, ok = registry.Pinned("a")
+	require.False(ok)
+}
+
+func TestRevokedSessionIsRejected(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.NewConfig()
+	cfg.LoginToken.SigningKey = "kiali67890123456"
+	config.Set(cfg)
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	util.Clock = util.ClockMock{Time: now}
+	previousSessions := Sessions
+	t.Cleanup(func() { Sessions = previousSessions })
+	Sessions = NewSessionRegistry()
+
+	persistor, err := NewCookieSessionPersistor[testSessionPayload](cfg)
+	require.NoError(err)
+
+	login := func(r *httptest.ResponseRecorder) *SessionData[testSessionPayload] {
+		session, err := NewSessionData("test", cfg.Auth.Strategy, now.Add(time.Hour), &testSessionPayload{FirstField: "Foo"})
+		require.NoError(err)
+		require.NotEmpty(session.ID)
+		require.NoError(persistor.CreateSession(httptest.NewRequest("GET", "/api/authenticate", nil), r, *session))
+		return session
+	}
+	rr := httptest.NewRecorder()
+	session := login(rr)
+
+	request := httptest.NewRequest("GET", "/api/namespaces", nil)
+	for _, cookie := range rr.Result().Cookies() {
+		request.AddCookie(cookie)
+	}
+	sData, err := persistor.ReadSession(request, httptest.NewRecorder(), "test")
+	require.NoError(err)
+	require.Equal(session.ID, sData.ID)
+	Sessions.Track(SessionInfo{ID: sData.ID, User: "alice", CreatedOn: sData.CreatedOn, ExpiresOn: sData.ExpiresOn})
+
+	require.True(Sessions.Revoke(session.ID))
+	_, err = persistor.ReadSession(request, httptest.NewRecorder(), "test")
+	require.True(errors.Is(err, ErrSessionNotFound))
+
+	// A new login over a previous session revokes the previous one
+	Sessions = NewSessionRegistry()
+	relogin := httptest.NewRecorder()
+	loginRequest := httptest.NewRequest("GET", "/api/authenticate", nil)
+	for _, cookie := range rr.Result().Cookies() {
+		loginRequest.AddCookie(cookie)
+	}
+	newSession, err := NewSessionData("test", cfg.Auth.Strategy, now.Add(time.Hour), &testSessionPayload{FirstField: "Bar"})
+	require.NoError(err)
+	require.NoError(persistor.CreateSession(loginRequest, relogin, *newSession))
+	require.True(Sessions.IsRevoked(session.ID, session.CreatedOn))
+	require.False(Sessions.IsRevoked(newSession.ID, newSession.CreatedOn))
+}
@@ -459,3 +459,26 @@ type rejectClient struct{ kubernetes.ClientInterface }
 func (r *rejectClient) GetProjects(ctx context.Context, labelSelector string) ([]osproject_v1.Project, error) {
 	return nil, fmt.Errorf("Rejecting")
 }
+
+// TestAuthenticationHandlerDropsForgedUserHeader checks that the user of a request is taken from its sessions, not
+// from a Kiali-User header sent by the client.
+func TestAuthenticationHandlerDropsForgedUserHeader(t *testing.T) {
+	cfg := config.NewConfig()
+	cfg.Auth.Strategy = config.AuthStrategyAnonymous
+	config.Set(cfg)
+
+	k8s := kubetest.NewFakeK8sClient()
+	handler := NewAuthenticationHandler(cfg, nil, k8s, nil, map[string]kubernetes.ClientInterface{cfg.KubernetesConfig.ClusterName: k8s})
+
+	var user, header string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user = sessionUser(r)
+		header = r.Header.Get("Kiali-User")
+	})
+	request := httptest.NewRequest(http.MethodGet, "http://kiali/api/namespaces", nil)
+	request.Header.Set("Kiali-User", "alice")
+	handler.Handle(next).ServeHTTP(httptest.NewRecorder(), request)
+
+	assert.Empty(t, user)
+	assert.Empty(t, header)
+}
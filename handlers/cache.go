@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/models"
+)
+
+// TokenCachesRefresh is the API handler to clear the namespaces and permissions cached for every user, i.e. after
+// an RBAC change. Only the cache admins can refresh the caches.
+func TokenCachesRefresh(kialiCache cache.KialiCache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conf := config.Get()
+		user, _ := requestSessions(r)
+		if !isAdmin(conf, user, conf.Auth.CacheAdmins) {
+			RespondWithError(w, http.StatusForbidden, "Only the cache admins can refresh the caches of the users")
+			return
+		}
+
+		clusters := make([]string, 0, len(kialiCache.GetKubeCaches()))
+		for cluster := range kialiCache.GetKubeCaches() {
+			kialiCache.RefreshTokenNamespaces(cluster)
+			kialiCache.RefreshTokenPermissions(cluster)
+			clusters = append(clusters, cluster)
+		}
+		slices.Sort(clusters)
+		audit(r, fmt.Sprintf("REFRESH TOKEN CACHES Clusters: [%s]", strings.Join(clusters, ", ")))
+		RespondWithCode(w, http.StatusNoContent)
+	}
+}
+
+// CacheStats is the API handler reporting the state of the informers of the kube cache of every cluster: the cached
+// objects, the last syncs, the watch errors and the staleness of the namespaces. Only the cache admins can get them.
+func CacheStats(kialiCache cache.KialiCache) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conf := config.Get()
+		user, _ := requestSessions(r)
+		if !isAdmin(conf, user, conf.Auth.CacheAdmins) {
+			RespondWithError(w, http.StatusForbidden, "Only the cache admins can get the stats of the caches")
+			return
+		}
+
+		stats := make([]models.KubeCacheStats, 0, len(kialiCache.GetKubeCaches()))
+		for _, kubeCache := range kialiCache.GetKubeCaches() {
+			stats = append(stats, kubeCache.Stats())
+		}
+		slices.SortFunc(stats, func(a, b models.KubeCacheStats) int {
+			return strings.Compare(a.Cluster, b.Cluster)
+		})
+		RespondWithJSON(w, http.StatusOK, stats)
+	}
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/util"
+)
+
+// NamespaceTelemetryDiagnostics is the API handler to fetch the workloads of a namespace whose proxies logged
+// requests missing from the Istio metrics
+func NamespaceTelemetryDiagnostics(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	window := query.Get("window")
+	if window == "" {
+		window = business.DefaultTelemetryWindow
+	}
+	duration, err := model.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		RespondWithError(w, http.StatusBadRequest, "Invalid window: "+window)
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	diagnostics, err := layer.Diagnostics.GetTelemetryGaps(r.Context(), clusterNameFromQuery(query), params["namespace"], time.Duration(duration), util.Clock.Now())
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, diagnostics)
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+// NamespaceEgressReport is the API handler to fetch the outbound traffic of a namespace to destinations
+// not covered by any ServiceEntry
+func NamespaceEgressReport(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	trafficWindow, err := trafficWindowFromQuery(query, business.DefaultEgressTrafficWindow)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid trafficWindow: "+err.Error())
+		return
+	}
+	limit := 0
+	if l := query.Get("limit"); l != "" {
+		if limit, err = strconv.Atoi(l); err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid limit: "+err.Error())
+			return
+		}
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	report, err := layer.Egress.GetEgressReport(r.Context(), clusterNameFromQuery(query), params["namespace"], trafficWindow, limit)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, report)
+}
+
+// NamespaceSidecarGenerate is the API handler to preview the namespace-wide Sidecar restricting the egress of a
+// namespace to the destinations its workloads sent traffic to
+func NamespaceSidecarGenerate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	trafficWindow, err := trafficWindowFromQuery(query, business.DefaultEgressTrafficWindow)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid trafficWindow: "+err.Error())
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	generated, err := layer.Egress.GenerateSidecar(r.Context(), clusterNameFromQuery(query), params["namespace"], trafficWindow)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, generated)
+}
+
+// NamespaceSidecarApply is the API handler to create or update the namespace-wide Sidecar restricting the egress of a
+// namespace to the destinations its workloads sent traffic to
+func NamespaceSidecarApply(w http.ResponseWriter, r *http.Request) {
+	if config.Get().Deployment.ViewOnlyMode {
+		RespondWithError(w, http.StatusForbidden, "Sidecars cannot be applied in view-only mode")
+		return
+	}
+
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	namespace := params["namespace"]
+	cluster := clusterNameFromQuery(query)
+
+	trafficWindow, err := trafficWindowFromQuery(query, business.DefaultEgressTrafficWindow)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid trafficWindow: "+err.Error())
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	generated, err := layer.Egress.GenerateSidecar(r.Context(), cluster, namespace, trafficWindow)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	payload, err := business.GeneratedSidecarPayload(generated)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	name := generated.Sidecar.Name
+	user := sessionUser(r)
+	operation := models.IstioConfigMutationCreate
+	if generated.Exists {
+		operation = models.IstioConfigMutationUpdate
+		if err := layer.IstioConfig.CheckTeamOwnership(r.Context(), cluster, namespace, kubernetes.Sidecars, name, user); err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+	}
+	if err := layer.IstioConfig.CheckTeamOwnershipForPayload(kubernetes.Sidecars, payload, user); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	mutation := models.IstioConfigMutation{Operation: operation, Cluster: cluster, Namespace: namespace, ObjectGVK: kubernetes.Sidecars, Name: name}
+	if err := reviewMutation(r, layer, mutation, payload); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	if err := layer.Egress.ApplySidecar(r.Context(), generated, user); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	if generated.Applied {
+		audit(r, operation+" on Namespace: "+namespace+" Type: "+kubernetes.Sidecars.String()+" Name: "+name+" Payload: "+string(payload))
+	}
+	RespondWithJSON(w, http.StatusOK, generated)
+}
+
+// NamespaceDNSCapture is the API handler to fetch the DNS capture of the workloads of a namespace and the addresses
+// resolved by their sidecars for the hosts of the ServiceEntries
+func NamespaceDNSCapture(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	report, err := layer.Egress.GetDNSCapture(r.Context(), clusterNameFromQuery(query), params["namespace"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, report)
+}
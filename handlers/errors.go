@@ -19,12 +19,16 @@ func handleErrorResponse(w http.ResponseWriter, err error, extraMesg ...string)
 		errorMsg = strings.Join(extraMesg, ";")
 	}
 	log.Error(errorMsg)
-	if business.IsAccessibleError(err) {
+	if business.IsAccessibleError(err) || errors.IsForbidden(err) {
 		RespondWithError(w, http.StatusForbidden, errorMsg)
 	} else if errors.IsNotFound(err) {
 		RespondWithError(w, http.StatusNotFound, errorMsg)
+	} else if errors.IsConflict(err) {
+		RespondWithError(w, http.StatusConflict, errorMsg)
 	} else if errors.IsServiceUnavailable(err) {
 		RespondWithError(w, http.StatusServiceUnavailable, errorMsg)
+	} else if errors.IsResourceExpired(err) || errors.IsGone(err) {
+		RespondWithError(w, http.StatusGone, errorMsg)
 	} else if statusError, isStatus := err.(*errors.StatusError); isStatus {
 		errorMsg = statusError.ErrStatus.Message
 		RespondWithError(w, http.StatusInternalServerError, errorMsg)
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// contentETag returns a strong ETag computed from the content.
+func contentETag(content []byte) string {
+	sum := sha256.Sum256(content)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// requestETag returns a strong ETag for the hash of a representation of the request. The query and the user of the
+// request are part of it: the same objects are represented differently for other filters or other users.
+func requestETag(r *http.Request, hash string) string {
+	return contentETag([]byte(hash + "\n" + r.URL.Query().Encode() + "\n" + sessionUser(r)))
+}
+
+// etagMatches reports whether the If-None-Match header of the request matches the etag.
+// Following RFC 7232, the weak comparison is used and "*" matches any etag.
+func etagMatches(r *http.Request, etag string) bool {
+	ifNoneMatch := r.Header.Get("If-None-Match")
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkNotModified sets the ETag header and responds with a 304 when the request already has the
+// current representation. It returns true when the response has been written.
+func checkNotModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	w.Header().Set("ETag", etag)
+	// The representations depend on the user of the request, the caches must not share them
+	w.Header().Set("Vary", "Cookie, Authorization")
+	if etagMatches(r, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
+// RespondWithETag responds with the JSON payload and an ETag computed from its content, or with a 304
+// without body when the If-None-Match header of the request matches it.
+func RespondWithETag(w http.ResponseWriter, r *http.Request, payload interface{}) {
+	response, err := json.Marshal(payload)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	writeWithETag(w, r, response, contentETag(response))
+}
+
+// writeWithETag writes the JSON response with the etag, or responds with a 304 without body when the If-None-Match
+// header of the request matches it.
+func writeWithETag(w http.ResponseWriter, r *http.Request, response []byte, etag string) {
+	if checkNotModified(w, r, etag) {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(response)
+}
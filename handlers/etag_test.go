@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/graph/config/cytoscape"
+	"github.com/kiali/kiali/models"
+)
+
+func TestRespondWithETag(t *testing.T) {
+	require := require.New(t)
+
+	payload := map[string]string{"name": "reviews"}
+	rr := httptest.NewRecorder()
+	RespondWithETag(rr, httptest.NewRequest(http.MethodGet, "/api", nil), payload)
+	require.Equal(http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+	require.NotEmpty(etag)
+	require.JSONEq(`{"name":"reviews"}`, rr.Body.String())
+
+	req := httptest.NewRequest(http.MethodGet, "/api", nil)
+	req.Header.Set("If-None-Match", `"other", W/`+etag)
+	rr = httptest.NewRecorder()
+	RespondWithETag(rr, req, payload)
+	require.Equal(http.StatusNotModified, rr.Code)
+	require.Equal(etag, rr.Header().Get("ETag"))
+	require.Empty(rr.Body.String())
+
+	payload["name"] = "ratings"
+	rr = httptest.NewRecorder()
+	RespondWithETag(rr, req, payload)
+	require.Equal(http.StatusOK, rr.Code)
+	require.NotEqual(etag, rr.Header().Get("ETag"))
+}
+
+func TestIstioConfigListResourceVersionsHash(t *testing.T) {
+	require := require.New(t)
+
+	vs := func(name, resourceVersion string) *networking_v1.VirtualService {
+		return &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "bookinfo", ResourceVersion: resourceVersion}}
+	}
+	list := models.IstioConfigList{VirtualServices: []*networking_v1.VirtualService{vs("reviews", "1"), vs("ratings", "2")}}
+	reordered := models.IstioConfigList{VirtualServices: []*networking_v1.VirtualService{vs("ratings", "2"), vs("reviews", "1")}}
+	updated := models.IstioConfigList{VirtualServices: []*networking_v1.VirtualService{vs("ratings", "3"), vs("reviews", "1")}}
+	removed := models.IstioConfigList{VirtualServices: []*networking_v1.VirtualService{vs("reviews", "1")}}
+
+	require.Equal(list.ResourceVersionsHash(), reordered.ResourceVersionsHash())
+	require.NotEqual(list.ResourceVersionsHash(), updated.ResourceVersionsHash())
+	require.NotEqual(list.ResourceVersionsHash(), removed.ResourceVersionsHash())
+}
+
+func TestGraphETagIgnoresTimestamp(t *testing.T) {
+	require := require.New(t)
+
+	graph := func(timestamp int64, nodes ...*cytoscape.NodeWrapper) cytoscape.Config {
+		return cytoscape.Config{Timestamp: timestamp, GraphType: "workload", Elements: cytoscape.Elements{Nodes: nodes, Edges: []*cytoscape.EdgeWrapper{}}}
+	}
+	rr := httptest.NewRecorder()
+	respond(rr, httptest.NewRequest(http.MethodGet, "/api/namespaces/graph", nil), http.StatusOK, graph(1000))
+	require.Equal(http.StatusOK, rr.Code)
+	etag := rr.Header().Get("ETag")
+	require.Contains(rr.Body.String(), `"timestamp": 1000`)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/namespaces/graph", nil)
+	req.Header.Set("If-None-Match", etag)
+	// Generated later, the same graph
+	rr = httptest.NewRecorder()
+	respond(rr, req, http.StatusOK, graph(1015))
+	require.Equal(http.StatusNotModified, rr.Code)
+
+	rr = httptest.NewRecorder()
+	respond(rr, req, http.StatusOK, graph(1015, &cytoscape.NodeWrapper{Data: &cytoscape.NodeData{ID: "reviews"}}))
+	require.Equal(http.StatusOK, rr.Code)
+	require.NotEqual(etag, rr.Header().Get("ETag"))
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/grafana"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/status"
+)
+
+// ExternalServicesConnections is the API handler to check the connection and the authentication to the external
+// services, or to a single one when the service is given. Only the external service admins can check the connections.
+func ExternalServicesConnections(conf *config.Config, clientFactory kubernetes.ClientFactory, grafana *grafana.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		user, _ := requestSessions(r)
+		if !isAdmin(conf, user, conf.Auth.ExternalServiceAdmins) {
+			RespondWithError(w, http.StatusForbidden, "Only the external service admins can check the connections to the external services")
+			return
+		}
+
+		services := status.ExternalServiceNames
+		if service, found := mux.Vars(r)["service"]; found {
+			if !slices.Contains(status.ExternalServiceNames, service) {
+				RespondWithError(w, http.StatusNotFound, "Unknown external service: "+service)
+				return
+			}
+			services = []string{service}
+		}
+
+		RespondWithJSON(w, http.StatusOK, status.CheckConnections(r.Context(), conf, clientFactory.GetSAHomeClusterClient(), grafana, services))
+	}
+}
@@ -30,6 +30,7 @@ package handlers
 //  Note: vendors may support additional, vendor-specific query parameters.
 //
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"runtime/debug"
@@ -39,10 +40,12 @@ import (
 	"github.com/kiali/kiali/grafana"
 	"github.com/kiali/kiali/graph"
 	"github.com/kiali/kiali/graph/api"
+	"github.com/kiali/kiali/graph/config/cytoscape"
 	"github.com/kiali/kiali/istio"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/kubernetes/cache"
 	"github.com/kiali/kiali/log"
+	meshcyto "github.com/kiali/kiali/mesh/config/cytoscape"
 	"github.com/kiali/kiali/prometheus"
 	"github.com/kiali/kiali/tracing"
 )
@@ -68,7 +71,7 @@ func GraphNamespaces(
 		o := graph.NewOptions(r, &business.Namespace)
 
 		code, payload := api.GraphNamespaces(r.Context(), business, o)
-		respond(w, code, payload)
+		respond(w, r, code, payload)
 	}
 }
 
@@ -92,7 +95,7 @@ func GraphNode(
 		o := graph.NewOptions(r, &business.Namespace)
 
 		code, payload := api.GraphNode(r.Context(), business, o)
-		respond(w, code, payload)
+		respond(w, r, code, payload)
 	}
 }
 
@@ -123,9 +126,19 @@ func handlePanic(w http.ResponseWriter) {
 	}
 }
 
-func respond(w http.ResponseWriter, code int, payload interface{}) {
+func respond(w http.ResponseWriter, r *http.Request, code int, payload interface{}) {
 	if code == http.StatusOK {
-		RespondWithJSONIndent(w, code, payload)
+		response, err := json.MarshalIndent(payload, "", "  ")
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		untimed, err := json.Marshal(untimedGraph(payload))
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeWithETag(w, r, response, contentETag(untimed))
 		return
 	}
 	if code == http.StatusForbidden {
@@ -138,3 +151,17 @@ func respond(w http.ResponseWriter, code int, payload interface{}) {
 	}
 	RespondWithError(w, code, payload.(string))
 }
+
+// untimedGraph returns the graph without the time it was generated at, which changes at every request. The ETag of a
+// graph is computed from it, so that an unchanged graph is not sent again.
+func untimedGraph(payload interface{}) interface{} {
+	switch config := payload.(type) {
+	case cytoscape.Config:
+		config.Timestamp = 0
+		return config
+	case meshcyto.Config:
+		config.Timestamp = 0
+		return config
+	}
+	return payload
+}
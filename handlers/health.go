@@ -166,3 +166,77 @@ func adjustRateInterval(ctx context.Context, business *business.Layer, namespace
 
 	return interval, nil
 }
+
+const (
+	defaultHealthEventsInterval = 30 * time.Second
+	minHealthEventsInterval     = 5 * time.Second
+)
+
+// NamespaceHealthEvents is the API handler to follow the transitions of the health status of the apps of a namespace
+// as server-sent events. The health is evaluated every interval (30s by default), the first events report the current
+// status of every app. The stream lasts until the client disconnects or the request times out, a reconnecting client
+// gets the current statuses again.
+func NamespaceHealthEvents(w http.ResponseWriter, r *http.Request) {
+	p := namespaceHealthParams{}
+	if ok, err := p.extract(r, mux.Vars(r)["namespace"]); !ok {
+		RespondWithError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	interval := defaultHealthEventsInterval
+	if i := r.URL.Query().Get("interval"); i != "" {
+		var err error
+		if interval, err = time.ParseDuration(i); err != nil || interval < minHealthEventsInterval {
+			RespondWithError(w, http.StatusBadRequest, "Invalid interval: "+i+", it must be at least "+minHealthEventsInterval.String())
+			return
+		}
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	rateInterval, err := adjustRateInterval(r.Context(), layer, p.Namespace, p.RateInterval, p.QueryTime, p.ClusterName)
+	if err != nil {
+		handleErrorResponse(w, err, "Adjust rate interval error: "+err.Error())
+		return
+	}
+
+	criteria := business.NamespaceHealthCriteria{Namespace: p.Namespace, Cluster: p.ClusterName, RateInterval: rateInterval, IncludeMetrics: true}
+	ctx, stop := streamContext(r)
+	defer stop()
+	transitions, err := layer.Health.WatchNamespaceAppHealth(ctx, criteria, interval)
+	if err != nil {
+		handleErrorResponse(w, err, "Error while fetching app health: "+err.Error())
+		return
+	}
+
+	sw, err := newSSEWriter(w)
+	if err != nil {
+		log.Debugf("Unable to start the health events stream: %s", err)
+		return
+	}
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if err := sw.keepAlive(); err != nil {
+				return
+			}
+		case transition, ok := <-transitions:
+			if !ok {
+				return
+			}
+			// The statuses are evaluated again on reconnection, there is nothing to resume
+			if err := sw.writeEvent("", "transition", transition); err != nil {
+				return
+			}
+		}
+	}
+}
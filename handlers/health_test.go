@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"bufio"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +14,8 @@ import (
 	osproject_v1 "github.com/openshift/api/project/v1"
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -19,6 +24,7 @@ import (
 	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
 	"github.com/kiali/kiali/prometheus/prometheustest"
 	"github.com/kiali/kiali/util"
 )
@@ -100,12 +106,57 @@ func setupClustersHealthEndpoint(t *testing.T, k8s *kubetest.FakeK8sClient) (*ht
 			ClustersHealth(w, r)
 		})),
 	)
+	mr.HandleFunc("/api/namespaces/{namespace}/health/events", WithAuthInfo(authInfo, NamespaceHealthEvents))
 
 	ts := httptest.NewServer(mr)
 	t.Cleanup(ts.Close)
 	return ts, prom
 }
 
+func TestNamespaceHealthEvents(t *testing.T) {
+	require := require.New(t)
+
+	kubeObjects := []runtime.Object{fakeService("ns", "reviews"), fakeService("ns", "httpbin"), setupMockData()}
+	for _, obj := range kubetest.FakePodList() {
+		o := obj
+		kubeObjects = append(kubeObjects, &o)
+	}
+	k8s := kubetest.NewFakeK8sClient(kubeObjects...)
+	k8s.OpenShift = true
+	ts, prom := setupClustersHealthEndpoint(t, k8s)
+	prom.On("GetAllRequestRates", "ns", config.Get().KubernetesConfig.ClusterName, mock.Anything, mock.Anything).Return(model.Vector{}, nil)
+
+	resp, err := http.Get(ts.URL + "/api/namespaces/ns/health/events?interval=1s")
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Get(ts.URL + "/api/namespaces/ns/health/events")
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusOK, resp.StatusCode)
+	require.Equal(sseContentType, resp.Header.Get("Content-Type"))
+
+	// The first events report the current status of every app
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "event: ") {
+			require.Equal("event: transition", line)
+		}
+		if strings.HasPrefix(line, "data: ") {
+			transition := models.AppHealthTransition{}
+			require.NoError(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &transition))
+			require.Equal("ns", transition.Namespace)
+			require.NotEmpty(transition.App)
+			require.Empty(transition.From)
+			require.NotEmpty(transition.To)
+			return
+		}
+	}
+	require.Fail("the stream ended")
+}
+
 func setupMockData() *osproject_v1.Project {
 	mockClock()
 	return &osproject_v1.Project{
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/util"
+)
+
+// GatewayTraffic is the API handler to fetch the traffic going through a Gateway, broken down by VirtualService route
+func GatewayTraffic(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	rateInterval := defaultHealthRateInterval
+	if interval := query.Get("rateInterval"); interval != "" {
+		if _, err := model.ParseDuration(interval); err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid rateInterval: "+err.Error())
+			return
+		}
+		rateInterval = interval
+	}
+	queryTime := util.Clock.Now()
+	if qt := query.Get("queryTime"); qt != "" {
+		unix, err := strconv.ParseInt(qt, 10, 64)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid queryTime: "+err.Error())
+			return
+		}
+		queryTime = time.Unix(unix, 0)
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	traffic, err := business.Ingress.GetGatewayTraffic(r.Context(), clusterNameFromQuery(query), params["namespace"], params["gateway"], rateInterval, queryTime)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, traffic)
+}
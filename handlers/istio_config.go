@@ -2,8 +2,9 @@ package handlers
 
 import (
 	"context"
-	"io"
+	"encoding/json"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gorilla/mux"
@@ -50,7 +51,16 @@ func IstioConfigList(w http.ResponseWriter, r *http.Request) {
 		includeValidations = false
 	}
 
-	criteria := business.ParseIstioConfigCriteria(objects, labelSelector, workloadSelector)
+	criteria := istioConfigCriteriaFromQuery(r, objects, labelSelector, workloadSelector)
+
+	if since := query.Get("since"); since != "" {
+		resourceVersion, err := strconv.ParseUint(since, 10, 64)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid since resourceVersion: "+since)
+			return
+		}
+		criteria.ChangedSince = resourceVersion
+	}
 
 	// Get business layer
 	business, err := getBusiness(r)
@@ -59,6 +69,12 @@ func IstioConfigList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A delta list is small, and its deletions are not part of the stream
+	if wantsNDJSON(r) && criteria.ChangedSince == 0 {
+		streamIstioConfigList(w, r, business, cluster, namespace, criteria, includeValidations, parsedTypes)
+		return
+	}
+
 	var istioConfig *models.IstioConfigList
 	if namespace != "" {
 		istioConfig, err = business.IstioConfig.GetIstioConfigListForNamespace(r.Context(), cluster, namespace, criteria)
@@ -87,7 +103,105 @@ func IstioConfigList(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	RespondWithAPIResponse(w, http.StatusOK, istioConfig)
+	masker, err := dataMasker(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Data masking error: "+err.Error())
+		return
+	}
+
+	if !includeValidations && masker == nil {
+		// The list only changes when its objects change, so there is no need to serialize it
+		// to know whether the client already has it. The deletions of a delta list are not part of the hash.
+		if criteria.ChangedSince == 0 && checkNotModified(w, r, requestETag(r, istioConfig.ResourceVersionsHash())) {
+			return
+		}
+		RespondWithAPIResponse(w, http.StatusOK, istioConfig)
+		return
+	}
+
+	istioConfig.ConvertToResponse()
+	masked, ok := maskData(w, r, istioConfig)
+	if !ok {
+		return
+	}
+	RespondWithETag(w, r, masked)
+}
+
+// istioConfigCriteriaFromQuery returns the criteria of a list of Istio config, with the ownership and Helm release
+// filters of the query.
+func istioConfigCriteriaFromQuery(r *http.Request, objects, labelSelector, workloadSelector string) business.IstioConfigCriteria {
+	query := r.URL.Query()
+	criteria := business.ParseIstioConfigCriteria(objects, labelSelector, workloadSelector)
+
+	if ownership := config.Get().Ownership; ownership.Enabled {
+		if _, found := query["ownedOnly"]; found {
+			criteria.FilterByTeams = true
+			criteria.Teams = ownership.TeamsForUser(sessionUser(r))
+		}
+	}
+
+	if _, found := query["helmRelease"]; found {
+		criteria.HelmRelease = query.Get("helmRelease")
+	}
+	return criteria
+}
+
+// IstioConfigListByNamespace is the API handler to get the Istio config of several namespaces in one call, as one list
+// per namespace. All the namespaces accessible to the user are listed when the namespaces param is not set. A namespace
+// that cannot be listed holds its error instead of its list. The validations of each namespace are included when the
+// validate param is set.
+func IstioConfigListByNamespace(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	objects := query.Get("objects")
+	criteria := istioConfigCriteriaFromQuery(r, objects, query.Get("labelSelector"), query.Get("workloadSelector"))
+	cluster := clusterNameFromQuery(query)
+
+	var namespaces []string
+	if ns := query.Get("namespaces"); ns != "" {
+		namespaces = strings.Split(ns, ",")
+	}
+
+	includeValidations := false
+	if _, found := query["validate"]; found {
+		includeValidations = config.Get().ExternalServices.Istio.IstioAPIEnabled
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	istioConfigs, err := business.IstioConfig.GetIstioConfigListForNamespaces(r.Context(), cluster, namespaces, criteria)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	if includeValidations {
+		validations, err := business.Validations.GetValidations(r.Context(), cluster)
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, "Error while getting validations: "+err.Error())
+			return
+		}
+
+		// We don't filter by objects when calling validations, because certain validations require fetching all types to get the correct errors
+		if objects != "" {
+			validations = validations.FilterByTypes(strings.Split(objects, ";"))
+		}
+		for namespace, result := range istioConfigs {
+			if result.List != nil {
+				result.List.IstioValidations = validations.FilterByNamespace(namespace)
+			}
+		}
+	}
+
+	istioConfigs.ConvertToResponse()
+	masked, ok := maskData(w, r, istioConfigs)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
 }
 
 func IstioConfigDetails(w http.ResponseWriter, r *http.Request) {
@@ -192,7 +306,13 @@ func IstioConfigDetails(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	RespondWithJSON(w, http.StatusOK, istioConfigDetails)
+	masked, ok := maskData(w, r, istioConfigDetails)
+	if !ok {
+		return
+	}
+	// Permissions, validations and references can change without the object changing,
+	// so the ETag is computed from the whole response.
+	RespondWithETag(w, r, masked)
 }
 
 func IstioConfigDelete(w http.ResponseWriter, r *http.Request) {
@@ -223,12 +343,54 @@ func IstioConfigDelete(w http.ResponseWriter, r *http.Request) {
 		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
 		return
 	}
-	err = business.IstioConfig.DeleteIstioConfigDetail(r.Context(), cluster, namespace, gvk, object)
+
+	// In cascade mode the objects created together by a Kiali wizard are deleted as a whole
+	group := []models.IstioReference{{ObjectGVK: gvk, Name: object, Namespace: namespace}}
+	cascade := query.Get("cascade") == "true"
+	if cascade {
+		group, err = business.IstioConfig.GetWizardGroup(r.Context(), cluster, namespace, gvk, object)
+		if err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+	}
+
+	for _, ref := range group {
+		if err := business.IstioConfig.CheckTeamOwnership(r.Context(), cluster, ref.Namespace, ref.ObjectGVK, ref.Name, sessionUser(r)); err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+
+		mutation := models.IstioConfigMutation{Operation: models.IstioConfigMutationDelete, Cluster: cluster, Namespace: ref.Namespace, ObjectGVK: ref.ObjectGVK, Name: ref.Name}
+		if err := reviewMutation(r, business, mutation, nil); err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+	}
+
+	// The delete protection blocks the deletion of the objects still referenced, or reports their dependents
+	dependents, err := business.IstioConfig.CheckDeleteProtection(r.Context(), cluster, group)
 	if err != nil {
 		handleErrorResponse(w, err)
 		return
+	}
+
+	if cascade {
+		err = business.IstioConfig.DeleteWizardGroup(r.Context(), cluster, group)
 	} else {
-		audit(r, "DELETE on Namespace: "+namespace+" Type: "+gvk.String()+" Name: "+object)
+		err = business.IstioConfig.DeleteIstioConfigDetail(r.Context(), cluster, namespace, gvk, object)
+	}
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	} else {
+		for _, ref := range group {
+			business.IstioConfig.RecordConfigChange(cluster, ref.Namespace, ref.ObjectGVK, ref.Name, models.IstioConfigMutationDelete, sessionUser(r))
+			audit(r, "DELETE on Namespace: "+ref.Namespace+" Type: "+ref.ObjectGVK.String()+" Name: "+ref.Name)
+		}
+		for _, dependent := range dependents {
+			w.Header().Add("Warning", "299 - \""+dependent.ObjectGVK.Kind+" "+dependent.Namespace+"/"+dependent.Name+" references a deleted object\"")
+		}
 		RespondWithCode(w, http.StatusOK)
 	}
 }
@@ -262,19 +424,57 @@ func IstioConfigUpdate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	body, err := io.ReadAll(r.Body)
+	body, err := readJSONBody(r)
 	if err != nil {
 		RespondWithError(w, http.StatusBadRequest, "Update request with bad update patch: "+err.Error())
+		return
 	}
 	jsonPatch := string(body)
+
+	user := sessionUser(r)
+	if err := business.IstioConfig.CheckTeamOwnership(r.Context(), cluster, namespace, gvk, object, user); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	if err := business.IstioConfig.CheckTeamOwnershipForPayload(gvk, body, user); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	mutation := models.IstioConfigMutation{Operation: models.IstioConfigMutationUpdate, Cluster: cluster, Namespace: namespace, ObjectGVK: gvk, Name: object}
+	if err := reviewMutation(r, business, mutation, body); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	if dryRun, _ := strconv.ParseBool(query.Get("dryRun")); dryRun {
+		// Nothing is persisted, so there is no change to record or audit
+		validatedConfigDetails, err := business.IstioConfig.DryRunUpdateIstioConfigDetail(r.Context(), cluster, namespace, gvk, object, jsonPatch)
+		if err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+		masked, ok := maskData(w, r, validatedConfigDetails)
+		if !ok {
+			return
+		}
+		RespondWithJSONOrYAML(w, r, http.StatusOK, masked)
+		return
+	}
+
 	updatedConfigDetails, err := business.IstioConfig.UpdateIstioConfigDetail(r.Context(), cluster, namespace, gvk, object, jsonPatch)
 	if err != nil {
 		handleErrorResponse(w, err)
 		return
 	}
 
+	business.IstioConfig.RecordConfigChange(cluster, namespace, gvk, object, models.IstioConfigMutationUpdate, user)
 	audit(r, "UPDATE on Namespace: "+namespace+" Type: "+gvk.String()+" Name: "+object+" Patch: "+jsonPatch)
-	RespondWithJSON(w, http.StatusOK, updatedConfigDetails)
+	masked, ok := maskData(w, r, updatedConfigDetails)
+	if !ok {
+		return
+	}
+	RespondWithJSONOrYAML(w, r, http.StatusOK, masked)
 }
 
 func IstioConfigCreate(w http.ResponseWriter, r *http.Request) {
@@ -306,9 +506,43 @@ func IstioConfigCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	body, err := io.ReadAll(r.Body)
+	body, err := readJSONBody(r)
 	if err != nil {
 		RespondWithError(w, http.StatusBadRequest, "Create request could not be read: "+err.Error())
+		return
+	}
+
+	if err := business.IstioConfig.CheckTeamOwnershipForPayload(gvk, body, sessionUser(r)); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	var created struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	// Invalid bodies are reported by the creation
+	_ = json.Unmarshal(body, &created)
+	mutation := models.IstioConfigMutation{Operation: models.IstioConfigMutationCreate, Cluster: cluster, Namespace: namespace, ObjectGVK: gvk, Name: created.Metadata.Name}
+	if err := reviewMutation(r, business, mutation, body); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	if dryRun, _ := strconv.ParseBool(query.Get("dryRun")); dryRun {
+		// Nothing is persisted, so there is no change to record or audit
+		validatedConfigDetails, err := business.IstioConfig.DryRunCreateIstioConfigDetail(r.Context(), cluster, namespace, gvk, body)
+		if err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+		masked, ok := maskData(w, r, validatedConfigDetails)
+		if !ok {
+			return
+		}
+		RespondWithJSONOrYAML(w, r, http.StatusOK, masked)
+		return
 	}
 
 	createdConfigDetails, err := business.IstioConfig.CreateIstioConfigDetail(r.Context(), cluster, namespace, gvk, body)
@@ -317,8 +551,25 @@ func IstioConfigCreate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	business.IstioConfig.RecordConfigChange(cluster, namespace, gvk, created.Metadata.Name, models.IstioConfigMutationCreate, sessionUser(r))
 	audit(r, "CREATE on Namespace: "+namespace+" Type: "+gvk.String()+" Object: "+string(body))
-	RespondWithJSON(w, http.StatusOK, createdConfigDetails)
+	masked, ok := maskData(w, r, createdConfigDetails)
+	if !ok {
+		return
+	}
+	RespondWithJSONOrYAML(w, r, http.StatusOK, masked)
+}
+
+// reviewMutation sends the change to the mutation webhook, along with the user and the configured headers of the request.
+func reviewMutation(r *http.Request, layer *business.Layer, mutation models.IstioConfigMutation, payload []byte) error {
+	mutation.User = sessionUser(r)
+	mutation.Headers = map[string]string{}
+	for _, header := range config.Get().MutationWebhook.ForwardHeaders {
+		if value := r.Header.Get(header); value != "" {
+			mutation.Headers[header] = value
+		}
+	}
+	return layer.IstioConfig.ReviewMutation(r.Context(), mutation, payload)
 }
 
 func checkObjectType(gvk schema.GroupVersionKind) bool {
@@ -327,7 +578,7 @@ func checkObjectType(gvk schema.GroupVersionKind) bool {
 
 func audit(r *http.Request, message string) {
 	if config.Get().Server.AuditLog {
-		user := r.Header.Get("Kiali-User")
+		user := sessionUser(r)
 		log.Infof("AUDIT User [%s] Msg [%s]", user, message)
 	}
 }
@@ -356,3 +607,213 @@ func IstioConfigPermissions(w http.ResponseWriter, r *http.Request) {
 	}
 	RespondWithJSON(w, http.StatusOK, istioConfigPermissions)
 }
+
+// IstioConfigHostReferences is the API handler to fetch the Istio objects of a cluster referencing a host
+func IstioConfigHostReferences(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	host := query.Get("host")
+	if host == "" {
+		RespondWithError(w, http.StatusBadRequest, "The host query param is required")
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	references, err := business.IstioConfig.GetHostReferences(r.Context(), clusterNameFromQuery(query), host, query.Get("namespace"))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, references)
+}
+
+// IstioGatewayServers is the API handler to get the servers of the Istio Gateways of a cluster by host and port.
+func IstioGatewayServers(w http.ResponseWriter, r *http.Request) {
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	servers, err := business.IstioConfig.GetGatewayServerMap(r.Context(), clusterNameFromQuery(r.URL.Query()))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, servers)
+}
+
+// IstioConfigDiff compares the Istio config of two namespaces, of the same or of different clusters.
+func IstioConfigDiff(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	source := models.IstioConfigLocation{Cluster: query.Get("sourceCluster"), Namespace: query.Get("sourceNamespace")}
+	target := models.IstioConfigLocation{Cluster: query.Get("targetCluster"), Namespace: query.Get("targetNamespace")}
+	if source.Namespace == "" || target.Namespace == "" {
+		RespondWithError(w, http.StatusBadRequest, "The sourceNamespace and targetNamespace query params are required")
+		return
+	}
+	if source.Cluster == "" {
+		source.Cluster = config.Get().KubernetesConfig.ClusterName
+	}
+	if target.Cluster == "" {
+		target.Cluster = source.Cluster
+	}
+	if source == target {
+		RespondWithError(w, http.StatusBadRequest, "The source and the target must be different")
+		return
+	}
+
+	criteria := business.ParseIstioConfigCriteria(query.Get("objects"), query.Get("labelSelector"), "")
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	diff, err := business.IstioConfig.DiffIstioConfig(r.Context(), source, target, criteria)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	masker, err := dataMasker(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Data masking error: "+err.Error())
+		return
+	}
+	if masker != nil {
+		masker.MaskDiff(diff)
+	}
+	RespondWithJSON(w, http.StatusOK, diff)
+}
+
+// IstioConfigBundleApply is the API handler to apply a bundle of Istio object templates to a namespace. The
+// template variables are replaced and the rendered objects validated before any object is created.
+func IstioConfigBundleApply(w http.ResponseWriter, r *http.Request) {
+	if config.Get().Deployment.ViewOnlyMode {
+		RespondWithError(w, http.StatusForbidden, "Bundles cannot be applied in view-only mode")
+		return
+	}
+
+	namespace := mux.Vars(r)["namespace"]
+	cluster := clusterNameFromQuery(r.URL.Query())
+
+	var bundle models.IstioConfigBundle
+	if err := json.NewDecoder(r.Body).Decode(&bundle); err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Bundle could not be read: "+err.Error())
+		return
+	}
+	objects, err := business.RenderIstioConfigBundle(bundle, namespace)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid bundle: "+err.Error())
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	result, err := business.IstioConfig.ApplyIstioObjects(r.Context(), cluster, namespace, objects, sessionUser(r))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	for _, ref := range result.Applied {
+		audit(r, "CREATE on Namespace: "+ref.Namespace+" Type: "+ref.ObjectGVK.String()+" Name: "+ref.Name)
+	}
+	RespondWithJSON(w, http.StatusOK, result)
+}
+
+// IstioConfigDeleteCheck is the API handler to check the Istio objects of a cluster about to be deleted,
+// reporting for each whether it still exists, whether the user can delete it and the objects related to it.
+func IstioConfigDeleteCheck(w http.ResponseWriter, r *http.Request) {
+	var request models.IstioConfigDeleteCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Delete check request could not be read: "+err.Error())
+		return
+	}
+	for _, object := range request.Objects {
+		if !business.GetIstioAPI(object.ObjectGVK) {
+			RespondWithError(w, http.StatusBadRequest, "Object type not managed: "+object.ObjectGVK.String())
+			return
+		}
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	checks, err := layer.IstioConfig.CheckDelete(r.Context(), clusterNameFromQuery(r.URL.Query()), request.Objects)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, checks)
+}
+
+// DestinationRuleTrafficPolicies is the API handler to compute how the traffic policy of a DestinationRule combines
+// with the defaults of its namespace and of the mesh, its port level settings and its subsets.
+func DestinationRuleTrafficPolicies(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	policies, err := business.IstioConfig.GetDestinationRuleTrafficPolicies(r.Context(), clusterNameFromQuery(r.URL.Query()), params["namespace"], params["destinationrule"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, policies)
+}
+
+// VirtualServiceCors is the API handler to fetch the effective CORS behavior of the routes of a VirtualService,
+// optionally of a single route and for the requests of an origin.
+func VirtualServiceCors(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	cors, err := business.IstioConfig.GetVirtualServiceCors(r.Context(), clusterNameFromQuery(query), params["namespace"], params["virtualservice"], query.Get("route"), query.Get("origin"))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, cors)
+}
+
+// WasmPluginStatus is the API handler to fetch the reachability of the module of a WasmPlugin and the workloads it
+// attaches to.
+func WasmPluginStatus(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	status, err := business.IstioConfig.GetWasmPluginStatus(r.Context(), clusterNameFromQuery(r.URL.Query()), params["namespace"], params["wasmplugin"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, status)
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/httputil"
+)
+
+// rssFeed is an RSS 2.0 feed.
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Link        string    `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	Description string `xml:"description"`
+	GUID        string `xml:"guid"`
+	PubDate     string `xml:"pubDate"`
+}
+
+// IstioConfigActivity is the API handler to get the latest changes of the Istio config of a namespace, newest first,
+// as JSON or as an RSS feed (format=rss), or to follow them as server-sent events (format=sse).
+func IstioConfigActivity(w http.ResponseWriter, r *http.Request) {
+	namespace := mux.Vars(r)["namespace"]
+	query := r.URL.Query()
+	cluster := clusterNameFromQuery(query)
+
+	var since time.Time
+	if s := query.Get("since"); s != "" {
+		var err error
+		if since, err = time.Parse(time.RFC3339, s); err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid since: "+err.Error())
+			return
+		}
+	}
+	limit := 0
+	if l := query.Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit < 0 {
+			RespondWithError(w, http.StatusBadRequest, "Invalid limit: "+l)
+			return
+		}
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	if wantsSSE(r) {
+		streamConfigActivity(w, r, layer, cluster, namespace, since)
+		return
+	}
+
+	events, err := layer.IstioConfig.GetConfigActivity(r.Context(), cluster, namespace, since, limit)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	if query.Get("format") == "rss" {
+		respondWithRSS(w, newActivityFeed(config.Get(), r, cluster, namespace, events))
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, events)
+}
+
+// streamConfigActivity sends the changes of the Istio config of a namespace as server-sent events, oldest first, until
+// the client disconnects or the request times out. The changes since the given time, or since the Last-Event-ID of a
+// reconnecting client, are sent first.
+func streamConfigActivity(w http.ResponseWriter, r *http.Request, layer *business.Layer, cluster, namespace string, since time.Time) {
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		lastEvent, err := time.Parse(time.RFC3339Nano, lastEventID)
+		if err != nil {
+			RespondWithError(w, http.StatusBadRequest, "Invalid Last-Event-ID: "+err.Error())
+			return
+		}
+		since = lastEvent
+	}
+
+	ctx, stop := streamContext(r)
+	defer stop()
+
+	// Subscribes before getting the backlog, so that no change is missed in between
+	events, cancel, err := layer.IstioConfig.SubscribeConfigActivity(ctx, cluster, namespace)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	defer cancel()
+
+	var backlog []models.IstioConfigEvent
+	if !since.IsZero() {
+		if backlog, err = layer.IstioConfig.GetConfigActivity(ctx, cluster, namespace, since, 0); err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+	}
+
+	sw, err := newSSEWriter(w)
+	if err != nil {
+		log.Debugf("Unable to start the Istio config activity stream: %s", err)
+		return
+	}
+
+	// The backlog is newest first
+	last := since
+	for i := len(backlog) - 1; i >= 0; i-- {
+		if err := sw.writeEvent(backlog[i].Timestamp.Format(time.RFC3339Nano), "change", backlog[i]); err != nil {
+			return
+		}
+		last = backlog[i].Timestamp
+	}
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if err := sw.keepAlive(); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			// Skips the other namespaces, and the changes already sent with the backlog
+			if event.Namespace != namespace || !event.Timestamp.After(last) {
+				continue
+			}
+			if err := sw.writeEvent(event.Timestamp.Format(time.RFC3339Nano), "change", event); err != nil {
+				return
+			}
+			last = event.Timestamp
+		}
+	}
+}
+
+// newActivityFeed returns the RSS feed of the changes of the Istio config of a namespace, linking to their objects
+// in the Kiali console.
+func newActivityFeed(conf *config.Config, r *http.Request, cluster, namespace string, events []models.IstioConfigEvent) rssFeed {
+	consoleURL := httputil.GuessKialiURL(conf, r) + "/console"
+	clusterQuery := "?clusterName=" + url.QueryEscape(cluster)
+
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:       fmt.Sprintf("Istio config activity of %s", namespace),
+			Link:        fmt.Sprintf("%s/istio?namespaces=%s&clusterName=%s", consoleURL, url.QueryEscape(namespace), url.QueryEscape(cluster)),
+			Description: fmt.Sprintf("Latest changes of the Istio config of the namespace %s of the cluster %s", namespace, cluster),
+			Items:       []rssItem{},
+		},
+	}
+	for _, e := range events {
+		title := fmt.Sprintf("%s %s %s", e.Type, e.ObjectGVK.Kind, e.Name)
+		description := title
+		if e.Author != "" {
+			description = fmt.Sprintf("%s by %s", description, e.Author)
+		}
+		if e.ViaKiali {
+			description += " through Kiali"
+		}
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       title,
+			Link:        fmt.Sprintf("%s/namespaces/%s/istio/%s/%s/%s/%s%s", consoleURL, e.Namespace, e.ObjectGVK.Group, e.ObjectGVK.Version, e.ObjectGVK.Kind, e.Name, clusterQuery),
+			Description: description,
+			GUID:        fmt.Sprintf("%s/%s/%s/%s/%s/%s", e.Cluster, e.Namespace, e.ObjectGVK.Kind, e.Name, e.Type, e.Timestamp.Format(time.RFC3339Nano)),
+			PubDate:     e.Timestamp.Format(time.RFC1123Z),
+		})
+	}
+	return feed
+}
+
+func respondWithRSS(w http.ResponseWriter, feed rssFeed) {
+	response, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(append([]byte(xml.Header), response...))
+}
+
+// defaultConfigChurnWindow is the window of the churn of the Istio config when none is requested.
+const defaultConfigChurnWindow = "10m"
+
+// IstioConfigChurn is the API handler returning the rate of the changes of the Istio config of the namespaces of a
+// cluster, to spot the controllers or the pipelines thrashing the config.
+func IstioConfigChurn(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	window := query.Get("window")
+	if window == "" {
+		window = defaultConfigChurnWindow
+	}
+	duration, err := model.ParseDuration(window)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid window: "+window)
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	churn, err := layer.IstioConfig.GetConfigChurn(r.Context(), clusterNameFromQuery(query), time.Duration(duration))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, churn)
+}
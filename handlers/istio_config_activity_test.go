@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	osproject_v1 "github.com/openshift/api/project/v1"
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func TestIstioConfigActivitySSE(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+	)
+	k.OpenShift = true
+	kialiCache := business.SetupBusinessLayer(t, k, *conf)
+	kubeCache, err := kialiCache.GetKubeCache(conf.KubernetesConfig.ClusterName)
+	require.NoError(err)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/istio/activity", WithAuthInfo(authInfo, IstioConfigActivity))
+	ts := httptest.NewServer(mr)
+	t.Cleanup(ts.Close)
+
+	start := time.Now().Add(-time.Minute)
+	event := func(name, namespace string, timestamp time.Time) models.IstioConfigEvent {
+		return models.IstioConfigEvent{
+			Cluster:   conf.KubernetesConfig.ClusterName,
+			Name:      name,
+			Namespace: namespace,
+			ObjectGVK: kubernetes.VirtualServices,
+			Timestamp: timestamp,
+			Type:      models.IstioConfigMutationCreate,
+		}
+	}
+	kubeCache.Activity().Record(event("reviews", "bookinfo", start.Add(time.Second)))
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/api/namespaces/bookinfo/istio/activity?since="+start.Format(time.RFC3339), nil)
+	require.NoError(err)
+	req.Header.Set("Accept", sseContentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusOK, resp.StatusCode)
+	require.Equal(sseContentType, resp.Header.Get("Content-Type"))
+
+	scanner := bufio.NewScanner(resp.Body)
+	next := func() (string, models.IstioConfigEvent) {
+		var id string
+		var e models.IstioConfigEvent
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				require.NoError(json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &e))
+				return id, e
+			}
+		}
+		require.NoError(scanner.Err())
+		require.Fail("the stream ended")
+		return id, e
+	}
+
+	// The backlog
+	id, e := next()
+	require.Equal("reviews", e.Name)
+	require.Equal(e.Timestamp.Format(time.RFC3339Nano), id)
+
+	// The live changes, other namespaces are skipped
+	kubeCache.Activity().Record(event("ratings", "travels", time.Now()))
+	kubeCache.Activity().Record(event("details", "bookinfo", time.Now()))
+	_, e = next()
+	require.Equal("details", e.Name)
+}
+
+func TestIstioConfigActivitySSEEndsOnShutdown(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+	)
+	k.OpenShift = true
+	business.SetupBusinessLayer(t, k, *conf)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/istio/activity", WithAuthInfo(authInfo, IstioConfigActivity))
+	ts := httptest.NewUnstartedServer(mr)
+	shutdown := make(chan struct{})
+	ts.Config.BaseContext = func(net.Listener) context.Context {
+		return WithShutdown(context.Background(), shutdown)
+	}
+	ts.Config.RegisterOnShutdown(func() { close(shutdown) })
+	ts.Start()
+	t.Cleanup(ts.Close)
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/api/namespaces/bookinfo/istio/activity", nil)
+	require.NoError(err)
+	req.Header.Set("Accept", sseContentType)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusOK, resp.StatusCode)
+
+	// The client stays connected: the shutdown completes only if the stream ends
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(ts.Config.Shutdown(ctx))
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/config"
+)
+
+// IstioConfigSnapshots lists the snapshots of the Istio config of a cluster, the newest first.
+func IstioConfigSnapshots(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	snapshots, err := business.Snapshot.ListSnapshots(r.Context(), clusterNameFromQuery(query), query.Get("namespace"))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, snapshots)
+}
+
+// IstioConfigSnapshot returns a snapshot with the Istio objects it holds.
+func IstioConfigSnapshot(w http.ResponseWriter, r *http.Request) {
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	snapshot, err := business.Snapshot.GetSnapshot(r.Context(), mux.Vars(r)["snapshot"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	masked, ok := maskData(w, r, snapshot)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
+}
+
+// IstioConfigSnapshotRestore creates again the objects of a snapshot that don't exist anymore.
+func IstioConfigSnapshotRestore(w http.ResponseWriter, r *http.Request) {
+	if config.Get().Deployment.ViewOnlyMode {
+		RespondWithError(w, http.StatusForbidden, "Snapshots cannot be restored in view-only mode")
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	id := mux.Vars(r)["snapshot"]
+	result, err := business.Snapshot.RestoreSnapshot(r.Context(), id, sessionUser(r))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	audit(r, "RESTORE on Snapshot: "+id+" Cluster: "+result.Snapshot.Cluster+" Namespace: "+result.Snapshot.Namespace)
+	RespondWithJSON(w, http.StatusOK, result)
+}
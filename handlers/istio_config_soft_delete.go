@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/config"
+)
+
+// IstioConfigDeleted lists the Istio objects of a cluster whose deletion can still be undone, the most recently
+// deleted first.
+func IstioConfigDeleted(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	deleted, err := business.IstioConfig.ListDeletedIstioConfig(r.Context(), clusterNameFromQuery(query), query.Get("namespace"))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	masked, ok := maskData(w, r, deleted)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
+}
+
+// IstioConfigUndoDelete creates again a deleted Istio object, while its undo window is not expired.
+func IstioConfigUndoDelete(w http.ResponseWriter, r *http.Request) {
+	if config.Get().Deployment.ViewOnlyMode {
+		RespondWithError(w, http.StatusForbidden, "Deletions cannot be undone in view-only mode")
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	result, err := business.IstioConfig.UndoIstioConfigDelete(r.Context(), mux.Vars(r)["deleted"], sessionUser(r))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	if result.Restored {
+		deleted := result.Deleted
+		audit(r, "UNDO DELETE on Namespace: "+deleted.Namespace+" Type: "+deleted.ObjectGVK.String()+" Name: "+deleted.Name)
+	}
+	masked, ok := maskData(w, r, result)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
+}
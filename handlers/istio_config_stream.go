@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+const ndjsonContentType = "application/x-ndjson"
+
+// wantsNDJSON returns whether the request asks for a NDJSON stream, with the "format=ndjson" query param or
+// the Accept header.
+func wantsNDJSON(r *http.Request) bool {
+	return r.URL.Query().Get("format") == "ndjson" || strings.Contains(r.Header.Get("Accept"), ndjsonContentType)
+}
+
+// ndjsonWriter writes the lines of a NDJSON response, from concurrent goroutines. The response starts with the
+// first line, so that an error found before can still be answered with an error status.
+type ndjsonWriter struct {
+	w       http.ResponseWriter
+	mu      sync.Mutex
+	started bool
+}
+
+// writeLines writes the lines and flushes them to the client.
+func (nw *ndjsonWriter) writeLines(lines ...interface{}) error {
+	nw.mu.Lock()
+	defer nw.mu.Unlock()
+	if !nw.started {
+		nw.w.Header().Set("Content-Type", ndjsonContentType)
+		nw.w.WriteHeader(http.StatusOK)
+		nw.started = true
+	}
+	encoder := json.NewEncoder(nw.w)
+	for _, line := range lines {
+		// Encode terminates each value with a newline
+		if err := encoder.Encode(line); err != nil {
+			return err
+		}
+	}
+	if err := http.NewResponseController(nw.w).Flush(); err != nil && err != http.ErrNotSupported {
+		return err
+	}
+	return nil
+}
+
+// streamIstioConfigList responds with the Istio objects as a NDJSON stream, one object per line, each type being
+// written as soon as its fetch completes. The validations, when requested, are written on the last line.
+func streamIstioConfigList(w http.ResponseWriter, r *http.Request, layer *business.Layer, cluster, namespace string, criteria business.IstioConfigCriteria, includeValidations bool, parsedTypes []string) {
+	masker, err := dataMasker(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Data masking error: "+err.Error())
+		return
+	}
+
+	nw := &ndjsonWriter{w: w}
+	err = layer.IstioConfig.StreamIstioConfigList(r.Context(), cluster, namespace, criteria, func(gvk schema.GroupVersionKind, objects []runtime.Object) error {
+		lines := make([]interface{}, 0, len(objects))
+		for _, o := range objects {
+			line := models.IstioConfigStreamLine{Type: gvk.String(), Object: o}
+			if accessor, err := meta.Accessor(o); err == nil {
+				line.HelmRelease = models.GetHelmRelease(accessor)
+			}
+			if masker == nil {
+				lines = append(lines, line)
+				continue
+			}
+			masked, err := masker.Mask(line)
+			if err != nil {
+				return err
+			}
+			lines = append(lines, masked)
+		}
+		return nw.writeLines(lines...)
+	})
+
+	if err == nil && includeValidations {
+		var validations models.IstioValidations
+		if validations, err = layer.Validations.GetValidations(r.Context(), cluster); err == nil {
+			// As for the JSON response, the validations of all the types are computed, then filtered
+			if len(parsedTypes) > 0 {
+				validations = validations.FilterByTypes(parsedTypes)
+			}
+			err = nw.writeLines(models.IstioConfigStreamLine{Validations: &validations})
+		}
+	}
+
+	if err == nil {
+		if !nw.started {
+			// No object: an empty stream
+			_ = nw.writeLines()
+		}
+		return
+	}
+	if !nw.started {
+		handleErrorResponse(w, err)
+		return
+	}
+	// The status is already sent: the error ends the stream
+	log.Errorf("Istio config list stream of cluster [%s] ended with an error: %s", cluster, err)
+	_ = nw.writeLines(models.IstioConfigStreamLine{Error: err.Error()})
+}
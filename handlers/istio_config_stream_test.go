@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+	osproject_v1 "github.com/openshift/api/project/v1"
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+func TestIstioConfigListNDJSON(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+		&networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"}},
+		&networking_v1.Gateway{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo-gateway", Namespace: "bookinfo"}},
+	)
+	k.OpenShift = true
+	business.SetupBusinessLayer(t, k, *conf)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/istio", WithAuthInfo(authInfo, IstioConfigList))
+	ts := httptest.NewServer(mr)
+	t.Cleanup(ts.Close)
+
+	read := func(req *http.Request) map[string][]string {
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(err)
+		defer resp.Body.Close()
+		require.Equal(http.StatusOK, resp.StatusCode)
+		require.Equal(ndjsonContentType, resp.Header.Get("Content-Type"))
+
+		names := map[string][]string{}
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := struct {
+				Type   string `json:"type"`
+				Object struct {
+					Metadata meta_v1.ObjectMeta `json:"metadata"`
+				} `json:"object"`
+			}{}
+			require.NoError(json.Unmarshal(scanner.Bytes(), &line))
+			names[line.Type] = append(names[line.Type], line.Object.Metadata.Name)
+		}
+		require.NoError(scanner.Err())
+		return names
+	}
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"/api/namespaces/bookinfo/istio?"+url.Values{
+		"format":  {"ndjson"},
+		"objects": {kubernetes.VirtualServices.String() + ";" + kubernetes.Gateways.String()},
+	}.Encode(), nil)
+	require.NoError(err)
+	names := read(req)
+	require.Len(names, 2)
+	require.ElementsMatch([]string{"reviews", "ratings"}, names[kubernetes.VirtualServices.String()])
+	require.ElementsMatch([]string{"bookinfo-gateway"}, names[kubernetes.Gateways.String()])
+
+	req, err = http.NewRequest(http.MethodGet, ts.URL+"/api/namespaces/bookinfo/istio?"+url.Values{"objects": {kubernetes.VirtualServices.String()}}.Encode(), nil)
+	require.NoError(err)
+	req.Header.Set("Accept", ndjsonContentType)
+	names = read(req)
+	require.Len(names, 1)
+	require.Len(names[kubernetes.VirtualServices.String()], 2)
+}
@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	osproject_v1 "github.com/openshift/api/project/v1"
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/handlers/authentication"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+func TestIstioConfigListByNamespace(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		kubetest.FakeNamespace("travels"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "travels"}},
+		&networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+	)
+	k.OpenShift = true
+	business.SetupBusinessLayer(t, k, *conf)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/istio/config/namespaces", WithAuthInfo(authInfo, IstioConfigListByNamespace))
+	ts := httptest.NewServer(mr)
+	t.Cleanup(ts.Close)
+
+	resp, err := http.Get(ts.URL + "/api/istio/config/namespaces?" + url.Values{
+		"namespaces": {"bookinfo,travels"},
+		"objects":    {kubernetes.VirtualServices.String() + ";" + kubernetes.Gateways.String()},
+	}.Encode())
+	require.NoError(err)
+	defer resp.Body.Close()
+	require.Equal(http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(err)
+	results := map[string]struct {
+		List struct {
+			Resources map[string]json.RawMessage `json:"resources"`
+		} `json:"list"`
+	}{}
+	require.NoError(json.Unmarshal(body, &results))
+	require.Len(results, 2)
+
+	bookinfo := results["bookinfo"].List.Resources
+	require.Equal("[]", string(bookinfo[kubernetes.Gateways.String()]))
+	require.NotEqual("[]", string(bookinfo[kubernetes.VirtualServices.String()]))
+	// Empty types are serialized as empty arrays, not null
+	travels := results["travels"].List.Resources
+	require.Equal("[]", string(travels[kubernetes.VirtualServices.String()]))
+	require.Equal("[]", string(travels[kubernetes.Gateways.String()]))
+}
+
+func TestIstioConfigCreateAndUpdateYAML(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+	)
+	k.OpenShift = true
+	business.SetupBusinessLayer(t, k, *conf)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/istio/{group}/{version}/{kind}", WithAuthInfo(authInfo, IstioConfigCreate)).Methods(http.MethodPost)
+	mr.HandleFunc("/api/namespaces/{namespace}/istio/{group}/{version}/{kind}/{object}", WithAuthInfo(authInfo, IstioConfigUpdate)).Methods(http.MethodPatch)
+	ts := httptest.NewServer(mr)
+	t.Cleanup(ts.Close)
+
+	vsPath := ts.URL + "/api/namespaces/bookinfo/istio/networking.istio.io/v1/VirtualService"
+	request := func(method, url, body string) *http.Response {
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		require.NoError(err)
+		req.Header.Set("Content-Type", "application/yaml")
+		req.Header.Set("Accept", "application/yaml")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(err)
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	resp := request(http.MethodPost, vsPath, `apiVersion: networking.istio.io/v1
+kind: VirtualService
+metadata:
+  name: reviews
+  namespace: bookinfo
+spec:
+  hosts:
+  - reviews
+`)
+	require.Equal(http.StatusOK, resp.StatusCode)
+	require.Equal("application/yaml", resp.Header.Get("Content-Type"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(err)
+	created := struct {
+		Resource networking_v1.VirtualService `json:"resource"`
+	}{}
+	require.NoError(yaml.Unmarshal(body, &created))
+	require.Equal("reviews", created.Resource.Name)
+	require.Equal([]string{"reviews"}, created.Resource.Spec.Hosts)
+
+	resp = request(http.MethodPatch, vsPath+"/reviews", "spec:\n  hosts:\n  - reviews.bookinfo.svc.cluster.local\n")
+	require.Equal(http.StatusOK, resp.StatusCode)
+	body, err = io.ReadAll(resp.Body)
+	require.NoError(err)
+	updated := struct {
+		Resource networking_v1.VirtualService `json:"resource"`
+	}{}
+	require.NoError(yaml.Unmarshal(body, &updated))
+	require.Equal([]string{"reviews.bookinfo.svc.cluster.local"}, updated.Resource.Spec.Hosts)
+
+	// Invalid YAML is rejected before reaching the cluster
+	resp = request(http.MethodPost, vsPath, "metadata: [")
+	require.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestIstioConfigDetailsMasksSensitiveValues(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.DataMasking.Enabled = true
+	conf.Auth.DataMaskingAdmins = []string{"admin"}
+	config.Set(conf)
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+		&networking_v1.Gateway{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo-gateway", Namespace: "bookinfo"},
+			Spec: api_networking_v1.Gateway{
+				Servers: []*api_networking_v1.Server{{Tls: &api_networking_v1.ServerTLSSettings{CredentialName: "bookinfo-cert"}}},
+			},
+		},
+	)
+	k.OpenShift = true
+	business.SetupBusinessLayer(t, k, *conf)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/istio/{group}/{version}/{kind}/{object}", WithAuthInfo(authInfo, IstioConfigDetails))
+	credentialName := func(user string) string {
+		r := httptest.NewRequest(http.MethodGet, "/api/namespaces/bookinfo/istio/networking.istio.io/v1/Gateway/bookinfo-gateway", nil)
+		ctx := authentication.SetUserSessionsContext(r.Context(), authentication.UserSessions{
+			conf.KubernetesConfig.ClusterName: &authentication.UserSessionData{Username: user},
+		})
+		w := httptest.NewRecorder()
+		mr.ServeHTTP(w, r.WithContext(ctx))
+		require.Equal(http.StatusOK, w.Code)
+
+		details := struct {
+			Resource networking_v1.Gateway `json:"resource"`
+		}{}
+		require.NoError(json.Unmarshal(w.Body.Bytes(), &details))
+		return details.Resource.Spec.Servers[0].Tls.CredentialName
+	}
+
+	require.Equal(business.MaskedValue, credentialName("jdoe"))
+	require.Equal("bookinfo-cert", credentialName("admin"))
+}
+
+func TestIstioConfigListETag(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+		&networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo", ResourceVersion: "1"}},
+	)
+	k.OpenShift = true
+	business.SetupBusinessLayer(t, k, *conf)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/istio", WithAuthInfo(authInfo, IstioConfigList))
+	list := func(query, user, etag string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/api/namespaces/bookinfo/istio?"+query, nil)
+		r.Header.Set("If-None-Match", etag)
+		ctx := authentication.SetUserSessionsContext(r.Context(), authentication.UserSessions{
+			conf.KubernetesConfig.ClusterName: &authentication.UserSessionData{Username: user},
+		})
+		w := httptest.NewRecorder()
+		mr.ServeHTTP(w, r.WithContext(ctx))
+		return w
+	}
+
+	w := list("objects=virtualservices", "alice", "")
+	require.Equal(http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(etag)
+	require.Equal("Cookie, Authorization", w.Header().Get("Vary"))
+
+	require.Equal(http.StatusNotModified, list("objects=virtualservices", "alice", etag).Code)
+	// The same objects, listed for other filters or another user
+	require.Equal(http.StatusOK, list("objects=virtualservices&ownedOnly=true", "alice", etag).Code)
+	require.Equal(http.StatusOK, list("objects=virtualservices", "bob", etag).Code)
+}
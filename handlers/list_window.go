@@ -0,0 +1,192 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+)
+
+const (
+	// defaultWindowLimit is the size of the list windows when the query sets no limit.
+	defaultWindowLimit = 100
+	// maxWindowLimit bounds the size of the list windows.
+	maxWindowLimit = 1000
+)
+
+// listWindowFromQuery returns the window criteria of the offset, limit, sortBy and sortOrder query params. The error
+// response is sent when they are invalid.
+func listWindowFromQuery(w http.ResponseWriter, r *http.Request, sortKeys []string) (business.ListWindowCriteria, bool) {
+	query := r.URL.Query()
+	criteria := business.ListWindowCriteria{Limit: defaultWindowLimit, SortBy: business.SortByName}
+
+	if o := query.Get("offset"); o != "" {
+		var err error
+		if criteria.Offset, err = strconv.Atoi(o); err != nil || criteria.Offset < 0 {
+			RespondWithError(w, http.StatusBadRequest, "Invalid offset: "+o)
+			return criteria, false
+		}
+	}
+	if l := query.Get("limit"); l != "" {
+		var err error
+		if criteria.Limit, err = strconv.Atoi(l); err != nil || criteria.Limit <= 0 || criteria.Limit > maxWindowLimit {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit: %s, it must be between 1 and %d", l, maxWindowLimit))
+			return criteria, false
+		}
+	}
+	if sortBy := query.Get("sortBy"); sortBy != "" {
+		if !slices.Contains(sortKeys, sortBy) {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid sortBy: %s, it must be one of %s", sortBy, strings.Join(sortKeys, ", ")))
+			return criteria, false
+		}
+		criteria.SortBy = sortBy
+	}
+	switch sortOrder := query.Get("sortOrder"); sortOrder {
+	case "", "asc":
+	case "desc":
+		criteria.Descending = true
+	default:
+		RespondWithError(w, http.StatusBadRequest, "Invalid sortOrder: "+sortOrder+", it must be asc or desc")
+		return criteria, false
+	}
+	return criteria, true
+}
+
+// ClustersServicesWindow is the API handler to fetch a window of the sorted list of services of a cluster, with the
+// size of the whole list.
+func ClustersServicesWindow(w http.ResponseWriter, r *http.Request) {
+	windowCriteria, ok := listWindowFromQuery(w, r, business.ServiceSortKeys)
+	if !ok {
+		return
+	}
+	p := serviceListParams{}
+	p.extract(r)
+	// The health is only fetched for the services of the window
+	includeHealth := p.IncludeHealth
+	p.IncludeHealth = false
+
+	clusterServicesList, ok := clusterServices(w, r, p)
+	if !ok {
+		return
+	}
+	servicesWindow := business.WindowServices(clusterServicesList, windowCriteria)
+	if includeHealth {
+		layer, err := getBusiness(r)
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+			return
+		}
+		namespaces := make([]string, 0, len(servicesWindow.Services))
+		for _, s := range servicesWindow.Services {
+			namespaces = append(namespaces, s.Namespace)
+		}
+		rateIntervals, ok := windowRateIntervals(w, r, layer, p.ClusterName, namespaces, p.RateInterval, p.QueryTime)
+		if !ok {
+			return
+		}
+		layer.Svc.AddServicesHealth(r.Context(), servicesWindow.Services, rateIntervals, p.QueryTime)
+	}
+	RespondWithJSON(w, http.StatusOK, servicesWindow)
+}
+
+// ClustersWorkloadsWindow is the API handler to fetch a window of the sorted list of workloads of a cluster, with the
+// size of the whole list.
+func ClustersWorkloadsWindow(w http.ResponseWriter, r *http.Request) {
+	windowCriteria, ok := listWindowFromQuery(w, r, business.WorkloadSortKeys)
+	if !ok {
+		return
+	}
+	p := workloadParams{}
+	if err := p.extract(r); err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Request parsing error: "+err.Error())
+		return
+	}
+
+	// The health is only fetched for the workloads of the window
+	includeHealth := p.IncludeHealth
+	p.IncludeHealth = false
+
+	clusterWorkloadsList, ok := clusterWorkloads(w, r, p)
+	if !ok {
+		return
+	}
+	workloadsWindow := business.WindowWorkloads(clusterWorkloadsList, windowCriteria)
+	if includeHealth {
+		layer, err := getBusiness(r)
+		if err != nil {
+			RespondWithError(w, http.StatusInternalServerError, "Workloads initialization error: "+err.Error())
+			return
+		}
+		namespaces := make([]string, 0, len(workloadsWindow.Workloads))
+		for _, wItem := range workloadsWindow.Workloads {
+			namespaces = append(namespaces, wItem.Namespace)
+		}
+		rateIntervals, ok := windowRateIntervals(w, r, layer, p.ClusterName, namespaces, p.RateInterval, p.QueryTime)
+		if !ok {
+			return
+		}
+		if err := layer.Workload.AddWorkloadsHealth(r.Context(), p.ClusterName, workloadsWindow.Workloads, rateIntervals, p.QueryTime); err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+	}
+	RespondWithJSON(w, http.StatusOK, workloadsWindow)
+}
+
+// windowRateIntervals returns the rate interval of the health of each namespace of a window, adjusted to the age of
+// the namespace. The error response is sent when a namespace can't be fetched.
+func windowRateIntervals(w http.ResponseWriter, r *http.Request, layer *business.Layer, cluster string, namespaces []string, rateInterval string, queryTime time.Time) (map[string]string, bool) {
+	rateIntervals := map[string]string{}
+	for _, ns := range namespaces {
+		if _, found := rateIntervals[ns]; found {
+			continue
+		}
+		adjusted, err := adjustRateInterval(r.Context(), layer, ns, rateInterval, queryTime, cluster)
+		if err != nil {
+			handleErrorResponse(w, err, "Adjust rate interval error: "+err.Error())
+			return nil, false
+		}
+		rateIntervals[ns] = adjusted
+	}
+	return rateIntervals, true
+}
+
+// IstioConfigListWindow is the API handler to fetch a window of the sorted list of Istio objects of a cluster, of all
+// the requested types, with the size of the whole list.
+func IstioConfigListWindow(w http.ResponseWriter, r *http.Request) {
+	windowCriteria, ok := listWindowFromQuery(w, r, business.IstioConfigSortKeys)
+	if !ok {
+		return
+	}
+	query := r.URL.Query()
+	cluster := clusterNameFromQuery(query)
+	criteria := istioConfigCriteriaFromQuery(r, query.Get("objects"), query.Get("labelSelector"), query.Get("workloadSelector"))
+	_, includeValidations := query["validate"]
+	includeValidations = includeValidations && config.Get().ExternalServices.Istio.IstioAPIEnabled
+	var namespaces []string
+	if nss := query.Get("namespaces"); nss != "" {
+		namespaces = strings.Split(nss, ",")
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	istioConfigWindow, err := layer.IstioConfig.GetIstioConfigWindow(r.Context(), cluster, namespaces, criteria, windowCriteria, includeValidations)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	masked, ok := maskData(w, r, istioConfigWindow)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
+}
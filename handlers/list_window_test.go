@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	osproject_v1 "github.com/openshift/api/project/v1"
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+func TestIstioConfigListWindow(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+		&networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"}},
+		&networking_v1.DestinationRule{ObjectMeta: meta_v1.ObjectMeta{Name: "details", Namespace: "bookinfo"}},
+	)
+	k.OpenShift = true
+	business.SetupBusinessLayer(t, k, *conf)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/istio/config/window", WithAuthInfo(authInfo, IstioConfigListWindow))
+	get := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		mr.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/istio/config/window?"+query, nil))
+		return w
+	}
+
+	w := get("offset=1&limit=1&sortOrder=desc")
+	require.Equal(http.StatusOK, w.Code)
+	window := struct {
+		Offset    int    `json:"offset"`
+		Limit     int    `json:"limit"`
+		Total     int    `json:"total"`
+		SortBy    string `json:"sortBy"`
+		SortOrder string `json:"sortOrder"`
+		Resources []struct {
+			Type   string                       `json:"type"`
+			Object networking_v1.VirtualService `json:"object"`
+		} `json:"resources"`
+	}{}
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &window))
+	require.Equal(1, window.Offset)
+	require.Equal(1, window.Limit)
+	require.Equal(3, window.Total)
+	require.Equal("name", window.SortBy)
+	require.Equal("desc", window.SortOrder)
+	require.Len(window.Resources, 1)
+	require.Equal("ratings", window.Resources[0].Object.Name)
+
+	for _, query := range []string{"offset=-1", "limit=0", "limit=1001", "sortBy=health", "sortOrder=up"} {
+		require.Equal(http.StatusBadRequest, get(query).Code, query)
+	}
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+)
+
+// dataMasker returns the masker of the sensitive values of the Istio config returned to the user of the request, or
+// nil when the user can see them.
+func dataMasker(r *http.Request) (*business.DataMasker, error) {
+	conf := config.Get()
+	if !conf.DataMasking.Enabled {
+		return nil, nil
+	}
+	if user, _ := requestSessions(r); isAdmin(conf, user, conf.Auth.DataMaskingAdmins) {
+		return nil, nil
+	}
+	return business.NewDataMasker(conf.DataMasking)
+}
+
+// maskData redacts the sensitive values of the payload when the user of the request cannot see them. It responds with
+// an error and returns false when the payload cannot be masked.
+func maskData(w http.ResponseWriter, r *http.Request, payload interface{}) (interface{}, bool) {
+	masker, err := dataMasker(r)
+	if err == nil && masker != nil {
+		payload, err = masker.Mask(payload)
+	}
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Data masking error: "+err.Error())
+		return nil, false
+	}
+	return payload, true
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	osproject_v1 "github.com/openshift/api/project/v1"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/handlers/authentication"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/prometheus/prometheustest"
+)
+
+func TestServiceRoutesMaskCredentialName(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.DataMasking.Enabled = true
+	conf.Auth.DataMaskingAdmins = []string{"admin"}
+	config.Set(conf)
+	mockClock()
+	k := kubetest.NewFakeK8sClient(
+		kubetest.FakeNamespace("bookinfo"),
+		&osproject_v1.Project{ObjectMeta: meta_v1.ObjectMeta{Name: "bookinfo"}},
+		&core_v1.Service{ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}},
+		&networking_v1.DestinationRule{
+			ObjectMeta: meta_v1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+			Spec: api_networking_v1.DestinationRule{
+				Host: "reviews",
+				TrafficPolicy: &api_networking_v1.TrafficPolicy{
+					Tls: &api_networking_v1.ClientTLSSettings{Mode: api_networking_v1.ClientTLSSettings_SIMPLE, CredentialName: "reviews-cert"},
+				},
+			},
+		},
+	)
+	k.OpenShift = true
+	business.SetupBusinessLayer(t, k, *conf)
+	prom := new(prometheustest.PromClientMock)
+	prom.MockServiceRequestRates("bookinfo", conf.KubernetesConfig.ClusterName, "reviews", model.Vector{})
+	business.WithProm(prom)
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/namespaces/{namespace}/services/{service}", WithAuthInfo(authInfo, ServiceDetails))
+	mr.HandleFunc("/api/namespaces/{namespace}/services/{service}/effective", WithAuthInfo(authInfo, ServiceEffectiveConfig))
+	get := func(path, user string, response interface{}) {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		ctx := authentication.SetUserSessionsContext(r.Context(), authentication.UserSessions{
+			conf.KubernetesConfig.ClusterName: &authentication.UserSessionData{Username: user},
+		})
+		w := httptest.NewRecorder()
+		mr.ServeHTTP(w, r.WithContext(ctx))
+		require.Equal(http.StatusOK, w.Code, w.Body.String())
+		require.NoError(json.Unmarshal(w.Body.Bytes(), response))
+	}
+	detailsCredentialName := func(user string) string {
+		details := struct {
+			DestinationRules []*networking_v1.DestinationRule `json:"destinationRules"`
+		}{}
+		get("/api/namespaces/bookinfo/services/reviews", user, &details)
+		require.Len(details.DestinationRules, 1)
+		return details.DestinationRules[0].Spec.TrafficPolicy.Tls.CredentialName
+	}
+	effectiveCredentialName := func(user string) string {
+		effective := struct {
+			TrafficPolicy struct {
+				Policy *api_networking_v1.TrafficPolicy `json:"policy"`
+			} `json:"trafficPolicy"`
+		}{}
+		get("/api/namespaces/bookinfo/services/reviews/effective", user, &effective)
+		require.NotNil(effective.TrafficPolicy.Policy)
+		return effective.TrafficPolicy.Policy.Tls.CredentialName
+	}
+
+	require.Equal(business.MaskedValue, detailsCredentialName("jdoe"))
+	require.Equal("reviews-cert", detailsCredentialName("admin"))
+	require.Equal(business.MaskedValue, effectiveCredentialName("jdoe"))
+	require.Equal("reviews-cert", effectiveCredentialName("admin"))
+}
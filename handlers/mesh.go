@@ -75,7 +75,7 @@ func MeshGraph(
 		}
 
 		code, payload := api.GraphMesh(r.Context(), business, o, clientFactory, cache, conf, grafana, discovery)
-		respond(w, code, payload)
+		respond(w, r, code, payload)
 	}
 }
 
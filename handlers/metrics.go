@@ -267,6 +267,109 @@ func NamespaceMetrics(promSupplier promClientSupplier) http.HandlerFunc {
 	}
 }
 
+// defaultTrendDuration is the range of the trends when no duration is requested
+const defaultTrendDuration = 7 * 24 * time.Hour
+
+// NamespaceTrends is the API handler to fetch the long-term traffic and error rate trends of a namespace, or of one
+// of its apps, services or workloads
+func NamespaceTrends(promSupplier promClientSupplier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		namespace := mux.Vars(r)["namespace"]
+		queryParams := r.URL.Query()
+
+		q := models.TrendQuery{
+			App:       queryParams.Get("app"),
+			Cluster:   clusterNameFromQuery(queryParams),
+			Namespace: namespace,
+			Service:   queryParams.Get("service"),
+			Workload:  queryParams.Get("workload"),
+			End:       time.Now(),
+		}
+		if queryTime := queryParams.Get("queryTime"); queryTime != "" {
+			num, err := strconv.ParseInt(queryTime, 10, 64)
+			if err != nil {
+				RespondWithError(w, http.StatusBadRequest, "bad request, cannot parse query parameter 'queryTime'")
+				return
+			}
+			q.End = time.Unix(num, 0)
+		}
+		duration := defaultTrendDuration
+		if dur := queryParams.Get("duration"); dur != "" {
+			num, err := strconv.ParseInt(dur, 10, 64)
+			if err != nil || num <= 0 {
+				RespondWithError(w, http.StatusBadRequest, "bad request, cannot parse query parameter 'duration'")
+				return
+			}
+			duration = time.Duration(num) * time.Second
+		}
+		if duration > business.MaxTrendRange {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("bad request, query parameter 'duration' cannot be longer than %d seconds", int64(business.MaxTrendRange.Seconds())))
+			return
+		}
+		q.Start = q.End.Add(-duration)
+
+		metricsService, _ := createMetricsServiceForNamespaceMC(w, r, promSupplier, namespace)
+		if metricsService == nil {
+			// any returned value nil means error & response already written
+			return
+		}
+
+		trends, err := metricsService.GetTrends(q)
+		if err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+		RespondWithJSON(w, http.StatusOK, trends)
+	}
+}
+
+// defaultBatchRateInterval is the rate interval of a metrics batch when none is requested
+const defaultBatchRateInterval = "10m"
+
+// MetricsBatch is the API handler to fetch the key inbound stats of many services and workloads of a namespace in one
+// request, for the list pages
+func MetricsBatch(promSupplier promClientSupplier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		namespace := mux.Vars(r)["namespace"]
+
+		var q models.MetricsBatchQuery
+		if err := json.NewDecoder(r.Body).Decode(&q); err != nil {
+			RespondWithError(w, http.StatusBadRequest, "bad request, cannot parse the metrics batch: "+err.Error())
+			return
+		}
+		q.Cluster = clusterNameFromQuery(r.URL.Query())
+		q.Namespace = namespace
+		q.Time = time.Now()
+		if q.QueryTime > 0 {
+			q.Time = time.Unix(q.QueryTime, 0)
+		}
+		if q.RateInterval == "" {
+			q.RateInterval = defaultBatchRateInterval
+		}
+
+		metricsService, namespaceInfo := createMetricsServiceForNamespaceMC(w, r, promSupplier, namespace)
+		if metricsService == nil {
+			// any returned value nil means error & response already written
+			return
+		}
+		if oldestNs := GetOldestNamespace(namespaceInfo); oldestNs != nil {
+			interval, err := util.AdjustRateInterval(oldestNs.CreationTimestamp, q.Time, q.RateInterval)
+			if err != nil {
+				RespondWithError(w, http.StatusBadRequest, "bad request, cannot adjust the rate interval: "+err.Error())
+				return
+			}
+			q.RateInterval = interval
+		}
+
+		batch, err := metricsService.GetMetricsBatch(q)
+		if err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+		RespondWithJSON(w, http.StatusOK, batch)
+	}
+}
+
 // ClustersMetrics is the API handler to fetch metrics to be displayed, related to all
 // services in provided namespaces of given cluster
 func ClustersMetrics(promSupplier promClientSupplier) http.HandlerFunc {
@@ -364,6 +467,30 @@ func extractIstioMetricsQueryParams(r *http.Request, q *models.IstioMetricsQuery
 		q.RequestProtocol = requestProtocol
 	}
 
+	anomalies := queryParams.Get("anomalies")
+	if anomalies != "" {
+		if anomalies != models.AnomalyMethodZScore && anomalies != models.AnomalyMethodMAD {
+			return errors.New("bad request, query parameter 'anomalies' must be either 'zscore' or 'mad'")
+		}
+		q.AnomalyMethod = anomalies
+	}
+
+	if window := queryParams.Get("anomalyWindow"); window != "" {
+		num, err := strconv.Atoi(window)
+		if err != nil || num < 2 {
+			return errors.New("bad request, query parameter 'anomalyWindow' must be an integer greater than 1")
+		}
+		q.AnomalyWindow = num
+	}
+
+	if threshold := queryParams.Get("anomalyThreshold"); threshold != "" {
+		f, err := strconv.ParseFloat(threshold, 64)
+		if err != nil || f <= 0 {
+			return errors.New("bad request, query parameter 'anomalyThreshold' must be a positive number")
+		}
+		q.AnomalyThreshold = f
+	}
+
 	return extractBaseMetricsQueryParams(queryParams, &q.RangeQuery, namespaceInfo)
 }
 
@@ -44,6 +44,8 @@ func TestExtractMetricsQueryParams(t *testing.T) {
 	q.Add("reporter", "destination")
 	q.Add("direction", "outbound")
 	q.Add("requestProtocol", "http")
+	q.Add("anomalies", "mad")
+	q.Add("anomalyWindow", "30")
 	req.URL.RawQuery = q.Encode()
 
 	mq := models.IstioMetricsQuery{Namespace: "ns"}
@@ -60,6 +62,9 @@ func TestExtractMetricsQueryParams(t *testing.T) {
 	assert.Equal(t, "destination", mq.Reporter)
 	assert.Equal(t, "outbound", mq.Direction)
 	assert.Equal(t, "http", mq.RequestProtocol)
+	assert.Equal(t, "mad", mq.AnomalyMethod)
+	assert.Equal(t, 30, mq.AnomalyWindow)
+	assert.Equal(t, 0.0, mq.AnomalyThreshold)
 
 	// Check that start date is normalized for step
 	// Interval [12:24:21, 12:41:01] should be converted to [12:24:20, 12:41:01]
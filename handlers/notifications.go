@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/config"
+)
+
+// NotificationDeadLetters is the API handler to list the notifications that failed all their delivery attempts, the
+// most recent first. Only the notification admins can list them.
+func NotificationDeadLetters(w http.ResponseWriter, r *http.Request) {
+	conf := config.Get()
+	user, _ := requestSessions(r)
+	if !isAdmin(conf, user, conf.Auth.NotificationAdmins) {
+		RespondWithError(w, http.StatusForbidden, "Only the notification admins can list the dead letters")
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	deadLetters, err := business.Notification.ListDeadLetters()
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, deadLetters)
+}
+
+// NotificationDeadLetterRetry is the API handler to queue a dead letter again for the next delivery. Only the
+// notification admins can retry them.
+func NotificationDeadLetterRetry(w http.ResponseWriter, r *http.Request) {
+	conf := config.Get()
+	user, _ := requestSessions(r)
+	if !isAdmin(conf, user, conf.Auth.NotificationAdmins) {
+		RespondWithError(w, http.StatusForbidden, "Only the notification admins can retry the dead letters")
+		return
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	id := mux.Vars(r)["notification"]
+	notification, err := business.Notification.RetryDeadLetter(id, time.Now())
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	audit(r, "RETRY on Notification: "+id+" Source: "+notification.Source)
+	RespondWithJSON(w, http.StatusOK, notification)
+}
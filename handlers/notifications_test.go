@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/handlers/authentication"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func TestNotificationDeadLetters(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.Auth.NotificationAdmins = []string{"admin"}
+	conf.Notifications.Directory = t.TempDir()
+	conf.Notifications.MaxAttempts = 1
+	// Nothing listens there, so the delivery fails
+	conf.TrafficBaseline.Webhook.URL = "http://127.0.0.1:0"
+	config.Set(conf)
+	business.SetupBusinessLayer(t, kubetest.NewFakeK8sClient(), *conf)
+
+	notificationService := business.NewNotificationService(conf)
+	notification, err := notificationService.Enqueue(models.NotificationSourceTrafficBaseline, []string{}, time.Now())
+	require.NoError(err)
+	require.NoError(notificationService.Deliver(context.TODO(), time.Now()))
+
+	authInfo := map[string]*api.AuthInfo{conf.KubernetesConfig.ClusterName: {Token: "test"}}
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/notifications/deadletters", WithAuthInfo(authInfo, NotificationDeadLetters))
+	mr.HandleFunc("/api/notifications/deadletters/{notification}/retry", WithAuthInfo(authInfo, NotificationDeadLetterRetry))
+	request := func(method, target, user string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, target, nil)
+		ctx := authentication.SetUserSessionsContext(r.Context(), authentication.UserSessions{
+			conf.KubernetesConfig.ClusterName: &authentication.UserSessionData{Username: user},
+		})
+		w := httptest.NewRecorder()
+		mr.ServeHTTP(w, r.WithContext(ctx))
+		return w
+	}
+
+	require.Equal(http.StatusForbidden, request(http.MethodGet, "/api/notifications/deadletters", "jdoe").Code)
+
+	w := request(http.MethodGet, "/api/notifications/deadletters", "admin")
+	require.Equal(http.StatusOK, w.Code)
+	deadLetters := []models.Notification{}
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &deadLetters))
+	require.Len(deadLetters, 1)
+	require.Equal(notification.ID, deadLetters[0].ID)
+	require.NotEmpty(deadLetters[0].LastError)
+
+	retryPath := "/api/notifications/deadletters/" + notification.ID + "/retry"
+	require.Equal(http.StatusForbidden, request(http.MethodPost, retryPath, "jdoe").Code)
+	require.Equal(http.StatusOK, request(http.MethodPost, retryPath, "admin").Code)
+	// Not a dead letter anymore
+	require.Equal(http.StatusNotFound, request(http.MethodPost, retryPath, "admin").Code)
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/models"
+)
+
+// IstioConfigOrphans is the API handler to fetch the report of the likely orphaned Istio objects of a namespace
+func IstioConfigOrphans(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	trafficWindow, err := trafficWindowFromQuery(query, business.DefaultOrphanTrafficWindow)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid trafficWindow: "+err.Error())
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	report, err := layer.Orphans.GetOrphanReport(r.Context(), clusterNameFromQuery(query), params["namespace"], trafficWindow)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, report)
+}
+
+// IstioConfigOrphansDelete is the API handler to bulk delete orphaned Istio objects of a namespace.
+// Only the requested objects that are still reported as orphaned are deleted.
+func IstioConfigOrphansDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	trafficWindow, err := trafficWindowFromQuery(query, business.DefaultOrphanTrafficWindow)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid trafficWindow: "+err.Error())
+		return
+	}
+
+	var cleanup models.OrphanCleanupRequest
+	if err := json.NewDecoder(r.Body).Decode(&cleanup); err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Cleanup request could not be read: "+err.Error())
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	result, err := layer.Orphans.DeleteOrphans(r.Context(), clusterNameFromQuery(query), params["namespace"], sessionUser(r), trafficWindow, cleanup.Objects)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	for _, deleted := range result.Deleted {
+		audit(r, "DELETE orphan on Namespace: "+deleted.Namespace+" Type: "+deleted.ObjectGVK.String()+" Name: "+deleted.Name)
+	}
+
+	RespondWithJSON(w, http.StatusOK, result)
+}
+
+// trafficWindowFromQuery parses the trafficWindow query param, which is a Prometheus duration such as "7d".
+func trafficWindowFromQuery(query url.Values, defaultWindow string) (time.Duration, error) {
+	window := query.Get("trafficWindow")
+	if window == "" {
+		window = defaultWindow
+	}
+	duration, err := model.ParseDuration(window)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(duration), nil
+}
@@ -49,3 +49,23 @@ func LoggingUpdate(w http.ResponseWriter, r *http.Request) {
 	audit(r, "UPDATE Envoy log. Cluster: "+cluster+" Namespace: "+namespace+" Pod: "+pod+" Log level:"+level)
 	RespondWithCode(w, 200)
 }
+
+// LoggingLevels returns the log levels of the pod's proxy.
+func LoggingLevels(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	businessLayer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	cluster := clusterNameFromQuery(r.URL.Query())
+
+	levels, err := businessLayer.ProxyLogging.GetLogLevels(cluster, params["namespace"], params["pod"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, levels)
+}
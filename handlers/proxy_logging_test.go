@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -9,12 +10,14 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/clientcmd/api"
 
 	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
 )
 
 func setupTestLoggingServer(t *testing.T, namespace, pod string) *httptest.Server {
@@ -22,6 +25,10 @@ func setupTestLoggingServer(t *testing.T, namespace, pod string) *httptest.Serve
 	path := "/api/namespaces/{namespace}/pods/{pod}/logging"
 	authInfo := map[string]*api.AuthInfo{config.Get().KubernetesConfig.ClusterName: {Token: "test"}}
 	mr.HandleFunc(path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			WithAuthInfo(authInfo, LoggingLevels)(w, r)
+			return
+		}
 		WithAuthInfo(authInfo, LoggingUpdate)(w, r)
 	}))
 
@@ -32,6 +39,7 @@ func setupTestLoggingServer(t *testing.T, namespace, pod string) *httptest.Serve
 	k8s.On("IsOpenShift").Return(false)
 	k8s.On("IsGatewayAPI").Return(false)
 	k8s.On("SetProxyLogLevel").Return(nil)
+	k8s.On("GetProxyLogLevels", namespace, pod).Return(map[string]string{"admin": "warning", "connection": "warning"}, nil)
 	var fakePod *corev1.Pod
 	k8s.On("GetPod", namespace, pod).Return(fakePod, nil)
 
@@ -97,3 +105,25 @@ func TestIncorrectQueryParamFails(t *testing.T) {
 	body, _ := io.ReadAll(resp.Body)
 	assert.Equalf(400, resp.StatusCode, "response text: %s", string(body))
 }
+
+func TestProxyLoggingLevels(t *testing.T) {
+	const (
+		namespace = "bookinfo"
+		pod       = "details-v1-79f774bdb9-hgcch"
+	)
+	require := require.New(t)
+	ts := setupTestLoggingServer(t, namespace, pod)
+
+	url := ts.URL + fmt.Sprintf("/api/namespaces/%s/pods/%s/logging", namespace, pod)
+	resp, err := ts.Client().Get(url)
+	require.NoError(err)
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	require.Equalf(200, resp.StatusCode, "response text: %s", string(body))
+
+	levels := &models.ProxyLogLevels{}
+	require.NoError(json.Unmarshal(body, levels))
+	require.Equal("warning", levels.Level)
+	require.Len(levels.Loggers, 2)
+}
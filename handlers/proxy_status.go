@@ -50,3 +50,33 @@ func ConfigDumpResourceEntries(w http.ResponseWriter, r *http.Request) {
 
 	RespondWithJSON(w, http.StatusOK, dump)
 }
+
+// VerifyVirtualServiceRoutes is the API handler to check whether the last change of the VirtualService of the
+// virtualService query param, in the virtualServiceNamespace or else the pod namespace, is live in the pod proxy.
+func VerifyVirtualServiceRoutes(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	vsName := query.Get("virtualService")
+	if vsName == "" {
+		RespondWithError(w, http.StatusBadRequest, "The virtualService query param is required")
+		return
+	}
+	vsNamespace := query.Get("virtualServiceNamespace")
+	if vsNamespace == "" {
+		vsNamespace = params["namespace"]
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	verification, err := business.ProxyStatus.VerifyVirtualServiceRoutes(r.Context(), clusterNameFromQuery(query), params["namespace"], params["pod"], vsNamespace, vsName)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, verification)
+}
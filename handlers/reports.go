@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/report"
+)
+
+const defaultReportDuration = "10m"
+
+// reportJobOwner returns the owner of the report jobs of the request: the user of its sessions or, for the strategies
+// without a user name, its session. The Kiali-User header is not trusted for this: it can be sent by the client.
+func reportJobOwner(r *http.Request) string {
+	user, ids := requestSessions(r)
+	if user != "" || len(ids) == 0 {
+		return user
+	}
+	return slices.Min(slices.Collect(maps.Keys(ids)))
+}
+
+// NamespaceReportCreate starts the generation of the report of the mesh status of a namespace. The generation is
+// asynchronous: the report is downloaded once its job succeeded.
+func NamespaceReportCreate(w http.ResponseWriter, r *http.Request) {
+	conf := config.Get().Reports
+	if !conf.Enabled {
+		RespondWithError(w, http.StatusServiceUnavailable, "Reports are disabled in config")
+		return
+	}
+
+	query := r.URL.Query()
+	namespace := mux.Vars(r)["namespace"]
+	cluster := clusterNameFromQuery(query)
+	format := query.Get("format")
+	if format == "" {
+		format = models.ReportFormatHTML
+	}
+	if report.ContentType(format) == "" {
+		RespondWithError(w, http.StatusBadRequest, "Invalid report format: "+format)
+		return
+	}
+	duration := query.Get("duration")
+	if duration == "" {
+		duration = defaultReportDuration
+	} else if _, err := strconv.ParseInt(duration, 10, 64); err == nil {
+		duration += "s"
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+	// Checked now, so that a namespace not accessible is reported by the request rather than by the job
+	if _, err := business.Namespace.GetClusterNamespace(r.Context(), namespace, cluster); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	job, err := report.Jobs.Start(conf, reportJobOwner(r), cluster, namespace, format, func(ctx context.Context) ([]byte, error) {
+		return report.Generate(ctx, business, cluster, namespace, duration, format)
+	})
+	if errors.Is(err, report.ErrTooManyJobs) {
+		RespondWithError(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Report generation error: "+err.Error())
+		return
+	}
+	RespondWithJSON(w, http.StatusAccepted, job)
+}
+
+// ValidationsRunCreate starts the validation of the Istio config of the namespaces of a cluster, all the namespaces
+// of the user by default. The namespaces are validated concurrently by a bounded number of workers, and the progress
+// of each namespace is part of the job. The validations are downloaded as JSON once the job succeeded.
+func ValidationsRunCreate(w http.ResponseWriter, r *http.Request) {
+	conf := config.Get()
+	if !conf.Reports.Enabled {
+		RespondWithError(w, http.StatusServiceUnavailable, "Reports are disabled in config")
+		return
+	}
+
+	query := r.URL.Query()
+	cluster := clusterNameFromQuery(query)
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	namespaces := []string{}
+	if param := query.Get("namespaces"); param != "" {
+		for _, namespace := range strings.Split(param, ",") {
+			// Checked now, so that a namespace not accessible is reported by the request rather than by the job
+			if _, err := business.Namespace.GetClusterNamespace(r.Context(), namespace, cluster); err != nil {
+				handleErrorResponse(w, err)
+				return
+			}
+			namespaces = append(namespaces, namespace)
+		}
+	} else {
+		clusterNamespaces, err := business.Namespace.GetClusterNamespaces(r.Context(), cluster)
+		if err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+		for _, namespace := range clusterNamespaces {
+			namespaces = append(namespaces, namespace.Name)
+		}
+	}
+
+	workers := conf.KialiFeatureFlags.Validations.RunWorkers
+	job, err := report.Jobs.StartWithProgress(conf.Reports, reportJobOwner(r), cluster, "", models.ReportFormatJSON, namespaces, func(ctx context.Context, progress report.ProgressFunc) ([]byte, error) {
+		run, err := business.Validations.RunNamespacesValidations(ctx, cluster, namespaces, workers, progress)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(run)
+	})
+	if errors.Is(err, report.ErrTooManyJobs) {
+		RespondWithError(w, http.StatusTooManyRequests, err.Error())
+		return
+	}
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Validation run error: "+err.Error())
+		return
+	}
+	RespondWithJSON(w, http.StatusAccepted, job)
+}
+
+// ReportJobs lists the report jobs of the user, the newest first.
+func ReportJobs(w http.ResponseWriter, r *http.Request) {
+	RespondWithJSON(w, http.StatusOK, report.Jobs.List(reportJobOwner(r)))
+}
+
+// ReportJob returns a report job of the user.
+func ReportJob(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["report"]
+	job, found := report.Jobs.Get(reportJobOwner(r), id)
+	if !found {
+		RespondWithError(w, http.StatusNotFound, "Report not found: "+id)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, job)
+}
+
+// ReportContent downloads the report generated by a job of the user.
+func ReportContent(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["report"]
+	job, content, found := report.Jobs.Content(reportJobOwner(r), id)
+	if !found {
+		RespondWithError(w, http.StatusNotFound, "Report not found: "+id)
+		return
+	}
+	switch job.Status {
+	case models.ReportJobRunning:
+		RespondWithError(w, http.StatusConflict, "Report is still being generated: "+id)
+		return
+	case models.ReportJobFailed:
+		RespondWithError(w, http.StatusConflict, "Report generation failed: "+job.Error)
+		return
+	}
+
+	// The jobs of several namespaces, i.e. the validation runs, have no namespace
+	name := job.Namespace
+	if name == "" {
+		name = "mesh"
+	}
+	w.Header().Set("Content-Type", report.ContentType(job.Format))
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+name+"-report."+job.Format+"\"")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(content)
+}
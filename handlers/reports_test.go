@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/handlers/authentication"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/report"
+	"github.com/kiali/kiali/util"
+)
+
+func TestReportJobsIgnoreForgedUserHeader(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+	util.Clock = util.RealClock{}
+	jobs := report.Jobs
+	report.Jobs = report.NewJobRegistry()
+	t.Cleanup(func() { report.Jobs = jobs })
+
+	job, err := report.Jobs.Start(conf.Reports, "alice", "east", "bookinfo", models.ReportFormatHTML, func(ctx context.Context) ([]byte, error) {
+		return []byte("<html></html>"), nil
+	})
+	require.NoError(err)
+	require.Eventually(func() bool {
+		j, _ := report.Jobs.Get("alice", job.ID)
+		return j.Status == models.ReportJobSucceeded
+	}, time.Second, 10*time.Millisecond)
+
+	mr := mux.NewRouter()
+	mr.HandleFunc("/api/reports", ReportJobs)
+	mr.HandleFunc("/api/reports/{report}", ReportJob)
+	mr.HandleFunc("/api/reports/{report}/content", ReportContent)
+	get := func(user, url string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, url, nil)
+		// The header is sent by the client, only the session tells the user
+		r.Header.Set("Kiali-User", "alice")
+		ctx := authentication.SetUserSessionsContext(r.Context(), authentication.UserSessions{
+			conf.KubernetesConfig.ClusterName: &authentication.UserSessionData{Username: user},
+		})
+		w := httptest.NewRecorder()
+		mr.ServeHTTP(w, r.WithContext(ctx))
+		return w
+	}
+	list := func(user string) []models.ReportJob {
+		w := get(user, "/api/reports")
+		require.Equal(http.StatusOK, w.Code)
+		jobs := []models.ReportJob{}
+		require.NoError(json.Unmarshal(w.Body.Bytes(), &jobs))
+		return jobs
+	}
+
+	require.Empty(list("bob"))
+	require.Equal(http.StatusNotFound, get("bob", "/api/reports/"+job.ID).Code)
+	require.Equal(http.StatusNotFound, get("bob", "/api/reports/"+job.ID+"/content").Code)
+
+	require.Len(list("alice"), 1)
+	require.Equal(http.StatusOK, get("alice", "/api/reports/"+job.ID).Code)
+	w := get("alice", "/api/reports/"+job.ID+"/content")
+	require.Equal(http.StatusOK, w.Code)
+	require.Equal("<html></html>", w.Body.String())
+}
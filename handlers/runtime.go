@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"bufio"
+	"bytes"
+	"net/http"
+	"runtime"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiali/kiali/models"
+)
+
+// RuntimeSnapshot is the API handler to get the state of the Go runtime of the server: its memory usage and its
+// goroutines, grouped by identical stacks when goroutines=true. It is only served when the profiler is enabled.
+func RuntimeSnapshot(w http.ResponseWriter, r *http.Request) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	snapshot := models.RuntimeSnapshot{
+		GoMaxProcs: runtime.GOMAXPROCS(0),
+		Goroutines: runtime.NumGoroutine(),
+		Memory: models.RuntimeMemory{
+			HeapAlloc:    mem.HeapAlloc,
+			HeapIdle:     mem.HeapIdle,
+			HeapInuse:    mem.HeapInuse,
+			HeapObjects:  mem.HeapObjects,
+			HeapReleased: mem.HeapReleased,
+			NextGC:       mem.NextGC,
+			NumGC:        mem.NumGC,
+			PauseTotal:   time.Duration(mem.PauseTotalNs),
+			StackInuse:   mem.StackInuse,
+			Sys:          mem.Sys,
+			TotalAlloc:   mem.TotalAlloc,
+		},
+		NumCPU:    runtime.NumCPU(),
+		Timestamp: time.Now(),
+	}
+
+	if r.URL.Query().Get("goroutines") == "true" {
+		var profile bytes.Buffer
+		if err := pprof.Lookup("goroutine").WriteTo(&profile, 1); err != nil {
+			RespondWithError(w, http.StatusInternalServerError, "Unable to read the goroutines: "+err.Error())
+			return
+		}
+		snapshot.GoroutineStacks = parseGoroutineProfile(&profile)
+	}
+
+	RespondWithJSON(w, http.StatusOK, snapshot)
+}
+
+// parseGoroutineProfile reads the goroutine profile in its legacy text format (debug=1): every stack starts with
+// "<count> @ <addresses>" followed by one "#\t<address>\t<function>+<offset>\t<file>:<line>" line per frame.
+func parseGoroutineProfile(profile *bytes.Buffer) []models.GoroutineStack {
+	stacks := []models.GoroutineStack{}
+	scanner := bufio.NewScanner(profile)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if count, _, found := strings.Cut(line, " @ "); found {
+			if n, err := strconv.Atoi(count); err == nil {
+				stacks = append(stacks, models.GoroutineStack{Count: n, Frames: []string{}})
+			}
+			continue
+		}
+		if len(stacks) == 0 || !strings.HasPrefix(line, "#\t") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			continue
+		}
+		frame := fields[2]
+		if plus := strings.LastIndex(frame, "+0x"); plus > 0 {
+			frame = frame[:plus]
+		}
+		current := &stacks[len(stacks)-1]
+		current.Frames = append(current.Frames, frame)
+	}
+	sort.SliceStable(stacks, func(i, j int) bool { return stacks[i].Count > stacks[j].Count })
+	return stacks
+}
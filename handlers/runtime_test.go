@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/models"
+)
+
+func TestRuntimeSnapshot(t *testing.T) {
+	blocked := make(chan struct{})
+	defer close(blocked)
+	for i := 0; i < 3; i++ {
+		go func() { <-blocked }()
+	}
+
+	rr := httptest.NewRecorder()
+	RuntimeSnapshot(rr, httptest.NewRequest(http.MethodGet, "/api/debug/runtime?goroutines=true", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var snapshot models.RuntimeSnapshot
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &snapshot))
+	assert.GreaterOrEqual(t, snapshot.Goroutines, 4)
+	assert.NotZero(t, snapshot.Memory.HeapAlloc)
+	require.NotEmpty(t, snapshot.GoroutineStacks)
+
+	// The blocked goroutines share their stack
+	found := false
+	for _, stack := range snapshot.GoroutineStacks {
+		for _, frame := range stack.Frames {
+			if frame == "github.com/kiali/kiali/handlers.TestRuntimeSnapshot.func1" {
+				found = true
+				assert.Equal(t, 3, stack.Count)
+			}
+		}
+	}
+	assert.True(t, found)
+}
@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/models"
+)
+
+const defaultSearchLimit = 20
+
+// Search is the API handler to look up the apps, services, workloads and Istio objects of the accessible namespaces
+// whose name contains a query, for a typeahead.
+func Search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	criteria := business.SearchCriteria{Query: strings.TrimSpace(query.Get("q")), Limit: defaultSearchLimit}
+	if criteria.Query == "" {
+		RespondWithError(w, http.StatusBadRequest, "Missing query: q")
+		return
+	}
+	if l := query.Get("limit"); l != "" {
+		var err error
+		if criteria.Limit, err = strconv.Atoi(l); err != nil || criteria.Limit < 0 {
+			RespondWithError(w, http.StatusBadRequest, "Invalid limit: "+l)
+			return
+		}
+	}
+	if cluster := query.Get("clusterName"); cluster != "" {
+		criteria.Clusters = []string{cluster}
+	}
+	if namespaces := query.Get("namespaces"); namespaces != "" {
+		criteria.Namespaces = strings.Split(namespaces, ",")
+	}
+	if kinds := query.Get("kinds"); kinds != "" {
+		for _, kind := range strings.Split(kinds, ",") {
+			if !slices.Contains(models.SearchKinds, models.SearchKind(kind)) {
+				RespondWithError(w, http.StatusBadRequest, "Invalid kind: "+kind)
+				return
+			}
+			criteria.Kinds = append(criteria.Kinds, models.SearchKind(kind))
+		}
+	}
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	results, err := business.Search.Search(r.Context(), criteria)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, results)
+}
@@ -1,23 +1,29 @@
 package handlers
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/prometheus/common/model"
 
 	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/log"
 	"github.com/kiali/kiali/models"
 	"github.com/kiali/kiali/util"
 )
 
 // serviceListParams holds the path and query parameters for ServiceList
 //
-// swagger:parameters serviceList
+// swagger:parameters serviceList serviceListWindow
 type serviceListParams struct {
 	baseHealthParams
 	// The target workload
@@ -52,16 +58,26 @@ func (p *serviceListParams) extract(r *http.Request) {
 
 // ClustersServices is the API handler to fetch the list of services from a given cluster
 func ClustersServices(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query()
-	namespacesQueryParam := query.Get("namespaces") // csl of namespaces
 	p := serviceListParams{}
 	p.extract(r)
 
+	clusterServicesList, ok := clusterServices(w, r, p)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, clusterServicesList)
+}
+
+// clusterServices returns the services of the namespaces of the query, all the accessible namespaces by default. The
+// error response is sent when they can't be fetched.
+func clusterServices(w http.ResponseWriter, r *http.Request, p serviceListParams) (*models.ClusterServices, bool) {
+	namespacesQueryParam := r.URL.Query().Get("namespaces") // csl of namespaces
+
 	// Get business layer
 	businessLayer, err := getBusiness(r)
 	if err != nil {
 		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
-		return
+		return nil, false
 	}
 
 	nss := []string{}
@@ -100,7 +116,7 @@ func ClustersServices(w http.ResponseWriter, r *http.Request) {
 			rateInterval, err := adjustRateInterval(r.Context(), businessLayer, ns, p.RateInterval, p.QueryTime, p.ClusterName)
 			if err != nil {
 				handleErrorResponse(w, err, "Adjust rate interval error: "+err.Error())
-				return
+				return nil, false
 			}
 			criteria.RateInterval = rateInterval
 		}
@@ -109,13 +125,13 @@ func ClustersServices(w http.ResponseWriter, r *http.Request) {
 		serviceList, err := businessLayer.Svc.GetServiceList(r.Context(), criteria)
 		if err != nil {
 			handleErrorResponse(w, err)
-			return
+			return nil, false
 		}
 		clusterServicesList.Services = append(clusterServicesList.Services, serviceList.Services...)
 		clusterServicesList.Validations = clusterServicesList.Validations.MergeValidations(serviceList.Validations)
 	}
 
-	RespondWithJSON(w, http.StatusOK, clusterServicesList)
+	return clusterServicesList, true
 }
 
 // ServiceDetails is the API handler to fetch full details of an specific service
@@ -175,7 +191,11 @@ func ServiceDetails(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	RespondWithJSON(w, http.StatusOK, serviceDetails)
+	masked, ok := maskData(w, r, serviceDetails)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
 }
 
 func ServiceUpdate(w http.ResponseWriter, r *http.Request) {
@@ -217,6 +237,7 @@ func ServiceUpdate(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		RespondWithError(w, http.StatusBadRequest, "Update request with bad update patch: "+err.Error())
+		return
 	}
 	jsonPatch := string(body)
 	istioConfigValidations := models.IstioValidations{}
@@ -245,5 +266,310 @@ func ServiceUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 
 	audit(r, "UPDATE on Namespace: "+namespace+" Service name: "+service+" Patch: "+jsonPatch)
-	RespondWithJSON(w, http.StatusOK, serviceDetails)
+	masked, ok := maskData(w, r, serviceDetails)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
+}
+
+// ServiceEffectiveConfig is the API handler to compute the Istio config applied to the traffic of a service,
+// as seen by the clients of the clientNamespace query param, which defaults to the service namespace.
+func ServiceEffectiveConfig(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	effectiveConfig, err := business.IstioConfig.GetServiceEffectiveConfig(r.Context(), clusterNameFromQuery(query), params["namespace"], params["service"], query.Get("clientNamespace"))
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	masked, ok := maskData(w, r, effectiveConfig)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
+}
+
+// ServiceSubsets is the API handler to preview the DestinationRule subsets generated for the versions of the workloads
+// of a service, with the changes to the current DestinationRule.
+func ServiceSubsets(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	subsets, err := business.IstioConfig.GetServiceSubsets(r.Context(), clusterNameFromQuery(r.URL.Query()), params["namespace"], params["service"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, subsets)
+}
+
+// ServiceSubsetsApply is the API handler to create or update the DestinationRule of a service with a subset for every
+// version of its workloads.
+func ServiceSubsetsApply(w http.ResponseWriter, r *http.Request) {
+	if config.Get().Deployment.ViewOnlyMode {
+		RespondWithError(w, http.StatusForbidden, "Subsets cannot be applied in view-only mode")
+		return
+	}
+
+	params := mux.Vars(r)
+	namespace := params["namespace"]
+	cluster := clusterNameFromQuery(r.URL.Query())
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	subsets, err := layer.IstioConfig.GetServiceSubsets(r.Context(), cluster, namespace, params["service"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	payload, err := business.ServiceSubsetsPayload(subsets)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	name := subsets.DestinationRule.Name
+	user := sessionUser(r)
+	operation := models.IstioConfigMutationCreate
+	if subsets.Exists {
+		operation = models.IstioConfigMutationUpdate
+		if err := layer.IstioConfig.CheckTeamOwnership(r.Context(), cluster, namespace, kubernetes.DestinationRules, name, user); err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+	}
+	if err := layer.IstioConfig.CheckTeamOwnershipForPayload(kubernetes.DestinationRules, payload, user); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	mutation := models.IstioConfigMutation{Operation: operation, Cluster: cluster, Namespace: namespace, ObjectGVK: kubernetes.DestinationRules, Name: name}
+	if err := reviewMutation(r, layer, mutation, payload); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	if err := layer.IstioConfig.ApplyServiceSubsets(r.Context(), cluster, namespace, subsets, user); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	if subsets.Applied {
+		audit(r, operation+" on Namespace: "+namespace+" Type: "+kubernetes.DestinationRules.String()+" Name: "+name+" Payload: "+string(payload))
+	}
+	RespondWithJSON(w, http.StatusOK, subsets)
+}
+
+// ServiceAuthorizationPolicyGenerate is the API handler to preview the AuthorizationPolicy allowing only the sources
+// observed calling a service.
+func ServiceAuthorizationPolicyGenerate(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	trafficWindow, err := trafficWindowFromQuery(query, business.DefaultAuthorizationPolicyTrafficWindow)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid trafficWindow: "+err.Error())
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	generated, err := layer.Svc.GenerateAuthorizationPolicy(r.Context(), clusterNameFromQuery(query), params["namespace"], params["service"], query.Get("granularity"), trafficWindow)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, generated)
+}
+
+// ServiceAuthorizationPolicyApply is the API handler to create or update the AuthorizationPolicy allowing only the
+// sources observed calling a service.
+func ServiceAuthorizationPolicyApply(w http.ResponseWriter, r *http.Request) {
+	if config.Get().Deployment.ViewOnlyMode {
+		RespondWithError(w, http.StatusForbidden, "AuthorizationPolicies cannot be applied in view-only mode")
+		return
+	}
+
+	params := mux.Vars(r)
+	query := r.URL.Query()
+	namespace := params["namespace"]
+	cluster := clusterNameFromQuery(query)
+
+	trafficWindow, err := trafficWindowFromQuery(query, business.DefaultAuthorizationPolicyTrafficWindow)
+	if err != nil {
+		RespondWithError(w, http.StatusBadRequest, "Invalid trafficWindow: "+err.Error())
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	generated, err := layer.Svc.GenerateAuthorizationPolicy(r.Context(), cluster, namespace, params["service"], query.Get("granularity"), trafficWindow)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	payload, err := business.GeneratedAuthorizationPolicyPayload(generated)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	name := generated.AuthorizationPolicy.Name
+	user := sessionUser(r)
+	operation := models.IstioConfigMutationCreate
+	if generated.Exists {
+		operation = models.IstioConfigMutationUpdate
+		if err := layer.IstioConfig.CheckTeamOwnership(r.Context(), cluster, namespace, kubernetes.AuthorizationPolicies, name, user); err != nil {
+			handleErrorResponse(w, err)
+			return
+		}
+	}
+	if err := layer.IstioConfig.CheckTeamOwnershipForPayload(kubernetes.AuthorizationPolicies, payload, user); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	mutation := models.IstioConfigMutation{Operation: operation, Cluster: cluster, Namespace: namespace, ObjectGVK: kubernetes.AuthorizationPolicies, Name: name}
+	if err := reviewMutation(r, layer, mutation, payload); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	if err := layer.Svc.ApplyAuthorizationPolicy(r.Context(), generated, user); err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	if generated.Applied {
+		audit(r, operation+" on Namespace: "+namespace+" Type: "+kubernetes.AuthorizationPolicies.String()+" Name: "+name+" Payload: "+string(payload))
+	}
+	RespondWithJSON(w, http.StatusOK, generated)
+}
+
+// ServiceTestRequest is the API handler sending a test request to a service from a test pod of the mesh, to verify
+// its routing rules.
+func ServiceTestRequest(w http.ResponseWriter, r *http.Request) {
+	conf := config.Get()
+	if !conf.TestRequests.Enabled {
+		RespondWithError(w, http.StatusServiceUnavailable, "Test requests are disabled in config")
+		return
+	}
+	if conf.Deployment.ViewOnlyMode {
+		RespondWithError(w, http.StatusForbidden, "Test requests cannot be sent in view-only mode")
+		return
+	}
+
+	params := mux.Vars(r)
+	var request models.TestRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		RespondWithError(w, http.StatusBadRequest, "bad request, cannot parse the test request: "+err.Error())
+		return
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	result, err := layer.Svc.SendTestRequest(r.Context(), clusterNameFromQuery(r.URL.Query()), params["namespace"], params["service"], request)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	audit(r, "TEST REQUEST from Pod: "+result.SourcePod+" URL: "+result.URL)
+	RespondWithJSON(w, http.StatusOK, result)
+}
+
+// ServiceRecentRequests is the API handler streaming the requests recently received by a service, parsed from the
+// access logs of the proxies of its pods, as a NDJSON stream of one request per line. With "follow=true" the requests
+// are streamed as they are logged, until the client closes the connection or the request times out.
+func ServiceRecentRequests(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query := r.URL.Query()
+
+	window := query.Get("window")
+	if window == "" {
+		window = business.DefaultRecentRequestsWindow
+	}
+	duration, err := model.ParseDuration(window)
+	if err != nil || duration <= 0 {
+		RespondWithError(w, http.StatusBadRequest, "Invalid window: "+window)
+		return
+	}
+	opts := business.RecentRequestsOptions{
+		Follow:    query.Get("follow") == "true",
+		TailLines: business.DefaultRecentRequestsTail,
+		Window:    time.Duration(duration),
+	}
+	if tail := query.Get("tail"); tail != "" {
+		if opts.TailLines, err = strconv.ParseInt(tail, 10, 64); err != nil || opts.TailLines <= 0 {
+			RespondWithError(w, http.StatusBadRequest, "Invalid tail: "+tail)
+			return
+		}
+	}
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	cluster := clusterNameFromQuery(query)
+	ctx, stop := streamContext(r)
+	defer stop()
+	nw := &ndjsonWriter{w: w}
+	err = layer.Svc.StreamRecentRequests(ctx, cluster, params["namespace"], params["service"], opts, func(request models.RecentRequest) error {
+		return nw.writeLines(request)
+	})
+	switch {
+	case err == nil && !nw.started:
+		// No request: an empty stream
+		_ = nw.writeLines()
+	case err != nil && !nw.started:
+		handleErrorResponse(w, err)
+	case err != nil:
+		// The status is already sent: the error ends the stream
+		log.Errorf("Recent requests stream of service [%s/%s] ended with an error: %s", params["namespace"], params["service"], err)
+	}
+}
+
+// ServiceLocality is the API handler to fetch the locality load balancing settings applying to a service and the
+// localities of its endpoints.
+func ServiceLocality(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+
+	layer, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	locality, err := layer.Svc.GetServiceLocality(r.Context(), clusterNameFromQuery(r.URL.Query()), params["namespace"], params["service"])
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, locality)
 }
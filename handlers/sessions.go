@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/handlers/authentication"
+	"github.com/kiali/kiali/util"
+)
+
+const (
+	defaultTimePinTTL = 15 * time.Minute
+	maxTimePinTTL     = 24 * time.Hour
+)
+
+// timePinnedRoutes are the names of the routes of the graph, health and metrics APIs, the drill-down flows evaluated at
+// the pinned query time of the session.
+var timePinnedRoutes = map[string]bool{
+	"AggregateMetrics":        true,
+	"AppDashboard":            true,
+	"AppMetrics":              true,
+	"ClustersHealth":          true,
+	"ClustersMetrics":         true,
+	"ControlPlaneMetrics":     true,
+	"CustomDashboard":         true,
+	"GatewayTraffic":          true,
+	"GraphAggregate":          true,
+	"GraphAggregateByService": true,
+	"GraphApp":                true,
+	"GraphAppVersion":         true,
+	"GraphNamespaces":         true,
+	"GraphService":            true,
+	"GraphWorkload":           true,
+	"MeshGraph":               true,
+	"MetricsBatch":            true,
+	"NamespaceHealthEvents":   true,
+	"NamespaceMetrics":        true,
+	"NamespaceTrends":         true,
+	"ServiceDashboard":        true,
+	"ServiceMetrics":          true,
+	"WorkloadDashboard":       true,
+	"WorkloadMetrics":         true,
+}
+
+// requestSessions returns the user of the request and the ids of the sessions it is authenticated with.
+func requestSessions(r *http.Request) (string, map[string]bool) {
+	user := ""
+	ids := map[string]bool{}
+	for _, session := range authentication.GetUserSessionsContext(r.Context()) {
+		if session.Username != "" {
+			user = session.Username
+		}
+		if session.SessionID != "" {
+			ids[session.SessionID] = true
+		}
+	}
+	return user, ids
+}
+
+// SessionsList is the API handler to list the active sessions of the user.
+func SessionsList(w http.ResponseWriter, r *http.Request) {
+	user, current := requestSessions(r)
+	sessions := []authentication.SessionInfo{}
+	if user != "" {
+		sessions = authentication.Sessions.List(user)
+	}
+	for i := range sessions {
+		sessions[i].Current = current[sessions[i].ID]
+	}
+	RespondWithJSON(w, http.StatusOK, sessions)
+}
+
+// SessionRevoke is the API handler to revoke a session of the user. The session admins can revoke any session.
+func SessionRevoke(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["session"]
+	conf := config.Get()
+	user, _ := requestSessions(r)
+
+	session, found := authentication.Sessions.Get(id)
+	if !found || user == "" || (session.User != user && !isAdmin(conf, user, conf.Auth.SessionAdmins)) {
+		RespondWithError(w, http.StatusNotFound, fmt.Sprintf("Session [%s] not found", id))
+		return
+	}
+	if !authentication.Sessions.Revoke(id) {
+		RespondWithError(w, http.StatusNotFound, fmt.Sprintf("Session [%s] not found", id))
+		return
+	}
+	audit(r, fmt.Sprintf("REVOKE SESSION User: [%s] Session: [%s]", session.User, id))
+	RespondWithCode(w, http.StatusNoContent)
+}
+
+// SessionsRevokeAll is the API handler to revoke the sessions of every user, including the one of the request.
+// Only the session admins can revoke all the sessions.
+func SessionsRevokeAll(w http.ResponseWriter, r *http.Request) {
+	conf := config.Get()
+	user, _ := requestSessions(r)
+	if !isAdmin(conf, user, conf.Auth.SessionAdmins) {
+		RespondWithError(w, http.StatusForbidden, "Only the session admins can revoke all the sessions")
+		return
+	}
+	count := authentication.Sessions.RevokeAll()
+	audit(r, fmt.Sprintf("REVOKE ALL SESSIONS Tracked sessions: [%d]", count))
+	RespondWithCode(w, http.StatusNoContent)
+}
+
+// requestTimePin returns the pinned query time of the sessions of the request, if any.
+func requestTimePin(r *http.Request) (authentication.TimePin, bool) {
+	for _, session := range authentication.GetUserSessionsContext(r.Context()) {
+		if pin, ok := authentication.Sessions.Pinned(session.SessionID); ok {
+			return pin, true
+		}
+	}
+	return authentication.TimePin{}, false
+}
+
+// applyTimePin sets the queryTime parameter of the request of a time pinned route to the pinned query time of its
+// session, unless the request sets it. The graph, health and metrics APIs are then evaluated at the same time across
+// the calls. The other APIs are left alone, i.e. re-pinning without a queryTime pins the current time. The routes are
+// matched by name, whatever the web root.
+func applyTimePin(r *http.Request) *http.Request {
+	if route := mux.CurrentRoute(r); route == nil || !timePinnedRoutes[route.GetName()] {
+		return r
+	}
+	query := r.URL.Query()
+	if query.Get("queryTime") != "" {
+		return r
+	}
+	pin, ok := requestTimePin(r)
+	if !ok {
+		return r
+	}
+	query.Set("queryTime", strconv.FormatInt(pin.Time.Unix(), 10))
+	r.URL.RawQuery = query.Encode()
+	return r
+}
+
+// SessionTimePin is the API handler to get the pinned query time of the session of the request.
+func SessionTimePin(w http.ResponseWriter, r *http.Request) {
+	pin, ok := requestTimePin(r)
+	if !ok {
+		RespondWithError(w, http.StatusNotFound, "No pinned query time")
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, pin)
+}
+
+// SessionTimePinSet is the API handler to pin the query time of the session of the request, to the given queryTime
+// or to the current time, until the ttl or the session expires.
+func SessionTimePinSet(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	now := util.Clock.Now()
+
+	pin := authentication.TimePin{Time: now}
+	if queryTime := query.Get("queryTime"); queryTime != "" {
+		unix, err := strconv.ParseInt(queryTime, 10, 64)
+		if err != nil || time.Unix(unix, 0).After(now) {
+			RespondWithError(w, http.StatusBadRequest, "Invalid queryTime: "+queryTime)
+			return
+		}
+		pin.Time = time.Unix(unix, 0)
+	}
+	ttl := defaultTimePinTTL
+	if t := query.Get("ttl"); t != "" {
+		var err error
+		if ttl, err = time.ParseDuration(t); err != nil || ttl <= 0 || ttl > maxTimePinTTL {
+			RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid ttl: %s, it must be positive and up to %s", t, maxTimePinTTL))
+			return
+		}
+	}
+	pin.ExpiresOn = now.Add(ttl)
+
+	sessions := authentication.GetUserSessionsContext(r.Context())
+	pinned := false
+	for _, session := range sessions {
+		if session.SessionID == "" {
+			continue
+		}
+		sessionPin := pin
+		if !session.ExpiresOn.IsZero() && session.ExpiresOn.Before(sessionPin.ExpiresOn) {
+			sessionPin.ExpiresOn = session.ExpiresOn
+		}
+		authentication.Sessions.Pin(session.SessionID, sessionPin)
+		pinned = true
+	}
+	if !pinned {
+		RespondWithError(w, http.StatusBadRequest, "The query time can only be pinned for authenticated sessions")
+		return
+	}
+	pin, _ = requestTimePin(r)
+	RespondWithJSON(w, http.StatusOK, pin)
+}
+
+// SessionTimePinDelete is the API handler to remove the pinned query time of the session of the request.
+func SessionTimePinDelete(w http.ResponseWriter, r *http.Request) {
+	for _, session := range authentication.GetUserSessionsContext(r.Context()) {
+		authentication.Sessions.Unpin(session.SessionID)
+	}
+	RespondWithCode(w, http.StatusNoContent)
+}
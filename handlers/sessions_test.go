@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/handlers/authentication"
+	"github.com/kiali/kiali/util"
+)
+
+func TestApplyTimePin(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	previousClock, previousSessions := util.Clock, authentication.Sessions
+	t.Cleanup(func() {
+		util.Clock = previousClock
+		authentication.Sessions = previousSessions
+	})
+	util.Clock = util.ClockMock{Time: now}
+	authentication.Sessions = authentication.NewSessionRegistry()
+	authentication.Sessions.Pin("a", authentication.TimePin{Time: now.Add(-time.Hour), ExpiresOn: now.Add(time.Minute)})
+
+	// Served under a web root, as the routes of the router
+	mr := mux.NewRouter()
+	appRouter := mr.PathPrefix("/kiali").Subrouter()
+	queryTime := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(applyTimePin(r).URL.Query().Get("queryTime")))
+	}
+	appRouter.Path("/api/namespaces/graph").Name("GraphNamespaces").HandlerFunc(queryTime)
+	appRouter.Path("/api/sessions/current/pin").Name("SessionTimePin").HandlerFunc(queryTime)
+	appRouter.Path("/api/namespaces").Name("NamespaceList").HandlerFunc(queryTime)
+	request := func(target, id string) string {
+		r := httptest.NewRequest("GET", target, nil)
+		ctx := authentication.SetUserSessionsContext(r.Context(), authentication.UserSessions{
+			"east": &authentication.UserSessionData{SessionID: id},
+		})
+		w := httptest.NewRecorder()
+		mr.ServeHTTP(w, r.WithContext(ctx))
+		return w.Body.String()
+	}
+
+	require.Equal("1638313200", request("/kiali/api/namespaces/graph?duration=60s", "a"))
+	// An explicit queryTime is kept
+	require.Equal("1000", request("/kiali/api/namespaces/graph?queryTime=1000", "a"))
+	require.Empty(request("/kiali/api/namespaces/graph", "b"))
+	// The routes not time pinned are left alone
+	require.Empty(request("/kiali/api/sessions/current/pin", "a"))
+	require.Empty(request("/kiali/api/namespaces", "a"))
+}
+
+func TestSessionTimePinSetRepins(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	previousClock, previousSessions := util.Clock, authentication.Sessions
+	t.Cleanup(func() {
+		util.Clock = previousClock
+		authentication.Sessions = previousSessions
+	})
+	util.Clock = util.ClockMock{Time: now}
+	authentication.Sessions = authentication.NewSessionRegistry()
+	authentication.Sessions.Pin("a", authentication.TimePin{Time: now.Add(-time.Hour), ExpiresOn: now.Add(time.Minute)})
+
+	r := httptest.NewRequest(http.MethodPut, "/api/sessions/current/pin?ttl=5m", nil)
+	ctx := authentication.SetUserSessionsContext(r.Context(), authentication.UserSessions{
+		"east": &authentication.UserSessionData{SessionID: "a"},
+	})
+	w := httptest.NewRecorder()
+	SessionTimePinSet(w, applyTimePin(r.WithContext(ctx)))
+	require.Equal(http.StatusOK, w.Code)
+
+	// The pin moves to the current time instead of keeping the previous one
+	pin := authentication.TimePin{}
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &pin))
+	require.True(now.Equal(pin.Time))
+	require.True(now.Add(5 * time.Minute).Equal(pin.ExpiresOn))
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+)
+
+type shutdownKey struct{}
+
+// WithShutdown returns the base context of the requests of a server, carrying the channel closed when the server
+// shuts down. The streaming handlers end their streams once it is closed.
+func WithShutdown(ctx context.Context, shutdown <-chan struct{}) context.Context {
+	return context.WithValue(ctx, shutdownKey{}, shutdown)
+}
+
+// streamContext returns the context of a streaming response. Like the context of the request, it is cancelled when
+// the client leaves, but it is also cancelled when the server shuts down: a graceful shutdown doesn't cancel the
+// requests, it waits for them, and the streams otherwise last until the client leaves.
+func streamContext(r *http.Request) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(r.Context())
+	if shutdown, ok := r.Context().Value(shutdownKey{}).(<-chan struct{}); ok {
+		go func() {
+			select {
+			case <-shutdown:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+	}
+	return ctx, cancel
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	sseContentType = "text/event-stream"
+	// sseKeepAlive is the interval of the comments sent on an idle stream, so that the proxies don't close it.
+	sseKeepAlive = 15 * time.Second
+	// sseRetry is the delay, in milliseconds, the clients wait before reconnecting once a stream ends. The clients
+	// resume the streams with the Last-Event-ID header.
+	sseRetry = 1000
+)
+
+// wantsSSE returns whether the request asks for a server-sent events stream, with the "format=sse" query param or
+// the Accept header. Server-sent events are plain HTTP responses, they go through the proxies blocking WebSockets.
+func wantsSSE(r *http.Request) bool {
+	return r.URL.Query().Get("format") == "sse" || strings.Contains(r.Header.Get("Accept"), sseContentType)
+}
+
+// sseWriter writes the events of a server-sent events stream, flushing each of them to the client.
+type sseWriter struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+// newSSEWriter starts the server-sent events stream of the response.
+func newSSEWriter(w http.ResponseWriter) (*sseWriter, error) {
+	w.Header().Set("Content-Type", sseContentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	// Disables the response buffering of nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	sw := &sseWriter{w: w, rc: http.NewResponseController(w)}
+	// The stream lasts until the client leaves, it is not bounded by the write timeout of the server
+	if err := sw.rc.SetWriteDeadline(time.Time{}); err != nil && err != http.ErrNotSupported {
+		return nil, err
+	}
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", sseRetry); err != nil {
+		return nil, err
+	}
+	return sw, sw.flush()
+}
+
+// writeEvent writes an event of the given type, with its data encoded as JSON. The id, when set, is sent back by the
+// client in the Last-Event-ID header when it reconnects.
+func (sw *sseWriter) writeEvent(id, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	if id != "" {
+		fmt.Fprintf(&b, "id: %s\n", id)
+	}
+	// JSON doesn't contain newlines, the data fits in a single line
+	fmt.Fprintf(&b, "event: %s\ndata: %s\n\n", event, payload)
+	if _, err := sw.w.Write([]byte(b.String())); err != nil {
+		return err
+	}
+	return sw.flush()
+}
+
+// keepAlive writes a comment, ignored by the clients.
+func (sw *sseWriter) keepAlive() error {
+	if _, err := sw.w.Write([]byte(": keepalive\n\n")); err != nil {
+		return err
+	}
+	return sw.flush()
+}
+
+func (sw *sseWriter) flush() error {
+	if err := sw.rc.Flush(); err != nil && err != http.ErrNotSupported {
+		return err
+	}
+	return nil
+}
@@ -103,3 +103,26 @@ func MeshTls(w http.ResponseWriter, r *http.Request) {
 
 	RespondWithJSON(w, http.StatusOK, globalmTLSStatus)
 }
+
+// MeshTlsOverview is the API to get the mesh-wide mTLS status along with the status of each namespace
+func MeshTlsOverview(w http.ResponseWriter, r *http.Request) {
+	business, err := getBusiness(r)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, "Services initialization error: "+err.Error())
+		return
+	}
+
+	query := r.URL.Query()
+	revision := query.Get("revision")
+	if revision == "" {
+		revision = "default"
+	}
+
+	overview, err := business.TLS.MTLSOverview(r.Context(), clusterNameFromQuery(query), revision)
+	if err != nil {
+		handleErrorResponse(w, err)
+		return
+	}
+
+	RespondWithJSON(w, http.StatusOK, overview)
+}
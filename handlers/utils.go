@@ -179,7 +179,9 @@ func getBusiness(r *http.Request) (*business.Layer, error) {
 		return nil, err
 	}
 
-	return business.Get(authInfo)
+	return business.FromContext(r.Context(), func() (*business.Layer, error) {
+		return business.Get(authInfo)
+	})
 }
 
 // clusterNameFromQuery extracts the cluster name from the query parameters
@@ -208,10 +210,7 @@ func getLayer(
 		return nil, err
 	}
 
-	layer, err := business.NewLayer(conf, kialiCache, clientFactory, prom, traceClientLoader(), cpm, grafana, discovery, authInfo)
-	if err != nil {
-		return nil, err
-	}
-
-	return layer, nil
+	return business.FromContext(r.Context(), func() (*business.Layer, error) {
+		return business.NewLayer(conf, kialiCache, clientFactory, prom, traceClientLoader(), cpm, grafana, discovery, authInfo)
+	})
 }
@@ -34,6 +34,7 @@ type workloadParams struct {
 	WorkloadGVK schema.GroupVersionKind `json:"workloadGVK"`
 	// Optional
 	ClusterName           string `json:"clusterName,omitempty"`
+	IncludeEvents         bool   `json:"events"`
 	IncludeHealth         bool   `json:"health"`
 	IncludeIstioResources bool   `json:"istioResources"`
 }
@@ -55,6 +56,10 @@ func (p *workloadParams) extract(r *http.Request) error {
 	if err != nil {
 		p.IncludeIstioResources = true
 	}
+	p.IncludeEvents, err = strconv.ParseBool(query.Get("events"))
+	if err != nil {
+		p.IncludeEvents = true
+	}
 
 	p.WorkloadGVK, err = util.StringToGVK(query.Get("workloadGVK"))
 	if err != nil {
@@ -65,8 +70,6 @@ func (p *workloadParams) extract(r *http.Request) error {
 
 // ClustersWorkloads is the API handler to fetch all the workloads to be displayed, related to a single namespace
 func ClustersWorkloads(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query()
-	namespacesQueryParam := query.Get("namespaces") // csl of namespaces
 	p := workloadParams{}
 	errParse := p.extract(r)
 	if errParse != nil {
@@ -74,11 +77,23 @@ func ClustersWorkloads(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	clusterWorkloadsList, ok := clusterWorkloads(w, r, p)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, clusterWorkloadsList)
+}
+
+// clusterWorkloads returns the workloads of the namespaces of the query, all the accessible namespaces by default.
+// The error response is sent when they can't be fetched.
+func clusterWorkloads(w http.ResponseWriter, r *http.Request, p workloadParams) (*models.ClusterWorkloads, bool) {
+	namespacesQueryParam := r.URL.Query().Get("namespaces") // csl of namespaces
+
 	// Get business layer
 	businessLayer, err := getBusiness(r)
 	if err != nil {
 		RespondWithError(w, http.StatusInternalServerError, "Workloads initialization error: "+err.Error())
-		return
+		return nil, false
 	}
 
 	nss := []string{}
@@ -112,7 +127,7 @@ func ClustersWorkloads(w http.ResponseWriter, r *http.Request) {
 			rateInterval, err := adjustRateInterval(r.Context(), businessLayer, ns, p.RateInterval, p.QueryTime, p.ClusterName)
 			if err != nil {
 				handleErrorResponse(w, err, "Adjust rate interval error: "+err.Error())
-				return
+				return nil, false
 			}
 			criteria.RateInterval = rateInterval
 		}
@@ -121,13 +136,13 @@ func ClustersWorkloads(w http.ResponseWriter, r *http.Request) {
 		workloadList, err := businessLayer.Workload.GetWorkloadList(r.Context(), criteria)
 		if err != nil {
 			handleErrorResponse(w, err)
-			return
+			return nil, false
 		}
 		clusterWorkloadsList.Workloads = append(clusterWorkloadsList.Workloads, workloadList.Workloads...)
 		clusterWorkloadsList.Validations = clusterWorkloadsList.Validations.MergeValidations(workloadList.Validations)
 	}
 
-	RespondWithJSON(w, http.StatusOK, clusterWorkloadsList)
+	return clusterWorkloadsList, true
 }
 
 // WorkloadDetails is the API handler to fetch all details to be displayed, related to a single workload
@@ -141,7 +156,7 @@ func WorkloadDetails(w http.ResponseWriter, r *http.Request) {
 
 	criteria := business.WorkloadCriteria{
 		Namespace: p.Namespace, WorkloadName: p.WorkloadName,
-		WorkloadGVK: p.WorkloadGVK, IncludeIstioResources: true, IncludeServices: true, IncludeHealth: p.IncludeHealth, RateInterval: p.RateInterval,
+		WorkloadGVK: p.WorkloadGVK, IncludeEvents: p.IncludeEvents, IncludeIstioResources: true, IncludeServices: true, IncludeHealth: p.IncludeHealth, RateInterval: p.RateInterval,
 		QueryTime: p.QueryTime, Cluster: p.ClusterName,
 	}
 
@@ -189,7 +204,11 @@ func WorkloadDetails(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	RespondWithJSON(w, http.StatusOK, workloadDetails)
+	masked, ok := maskData(w, r, workloadDetails)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
 }
 
 // WorkloadUpdate is the API to perform a patch on a Workload configuration
@@ -227,6 +246,7 @@ func WorkloadUpdate(w http.ResponseWriter, r *http.Request) {
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
 		RespondWithError(w, http.StatusBadRequest, "Update request with bad update patch: "+err.Error())
+		return
 	}
 	jsonPatch := string(body)
 
@@ -254,7 +274,11 @@ func WorkloadUpdate(w http.ResponseWriter, r *http.Request) {
 	}
 	auditMsg := fmt.Sprintf("UPDATE on Cluster: [%s] Namespace: [%s] Workload name: [%s] Type: [%s] Patch: [%s]", cluster, namespace, workload, workloadGVK, jsonPatch)
 	audit(r, auditMsg)
-	RespondWithJSON(w, http.StatusOK, workloadDetails)
+	masked, ok := maskData(w, r, workloadDetails)
+	if !ok {
+		return
+	}
+	RespondWithJSON(w, http.StatusOK, masked)
 }
 
 // PodDetails is the API handler to fetch all details to be displayed, related to a single pod
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const yamlContentType = "application/yaml"
+
+// isYAMLRequest returns whether the body of the request is YAML, according to its Content-Type header.
+func isYAMLRequest(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	switch mediaType {
+	case yamlContentType, "application/x-yaml", "text/yaml":
+		return true
+	}
+	return false
+}
+
+// wantsYAML returns whether the request asks for a YAML response, with the "format=yaml" query param or the Accept
+// header.
+func wantsYAML(r *http.Request) bool {
+	accept := r.Header.Get("Accept")
+	return r.URL.Query().Get("format") == "yaml" || strings.Contains(accept, yamlContentType) || strings.Contains(accept, "application/x-yaml")
+}
+
+// readJSONBody reads the body of the request, converting it to JSON when it is YAML, i.e. copied from the Istio docs.
+func readJSONBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || !isYAMLRequest(r) {
+		return body, err
+	}
+	return yaml.YAMLToJSON(body)
+}
+
+// RespondWithJSONOrYAML responds with the payload as YAML when the request asks for it, as JSON otherwise. The YAML
+// is converted from the JSON, so it has the same fields.
+func RespondWithJSONOrYAML(w http.ResponseWriter, r *http.Request, code int, payload interface{}) {
+	if !wantsYAML(r) {
+		RespondWithJSON(w, code, payload)
+		return
+	}
+	response, err := yaml.Marshal(payload)
+	if err != nil {
+		RespondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", yamlContentType)
+	w.WriteHeader(code)
+	_, _ = w.Write(response)
+}
@@ -34,12 +34,14 @@ import (
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	_ "go.uber.org/automaxprocs"
 
 	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/controller"
+	"github.com/kiali/kiali/demo"
 	"github.com/kiali/kiali/grafana"
 	"github.com/kiali/kiali/istio"
 	"github.com/kiali/kiali/kubernetes"
@@ -94,7 +96,13 @@ func main() {
 		config.Set(config.NewConfig())
 	}
 
-	updateConfigWithIstioInfo()
+	if config.Get().Demo.Enabled {
+		conf := *config.Get()
+		demo.Configure(&conf)
+		config.Set(&conf)
+	} else {
+		updateConfigWithIstioInfo()
+	}
 
 	cfg := config.Get()
 	log.Tracef("Kiali Configuration:\n%s", cfg)
@@ -107,9 +115,14 @@ func main() {
 	internalmetrics.RegisterInternalMetrics()
 
 	// Create the business package dependencies.
-	clientFactory, err := kubernetes.GetClientFactory()
-	if err != nil {
-		log.Fatalf("Failed to create client factory. Err: %s", err)
+	var clientFactory kubernetes.ClientFactory
+	if cfg.Demo.Enabled {
+		clientFactory = demo.NewClientFactory(cfg)
+	} else {
+		var err error
+		if clientFactory, err = kubernetes.GetClientFactory(); err != nil {
+			log.Fatalf("Failed to create client factory. Err: %s", err)
+		}
 	}
 
 	log.Info("Initializing Kiali Cache")
@@ -180,33 +193,42 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error creating business layer: %s", err)
 	}
-	if err := controller.Start(ctx, clientFactory, cache, &layer.Validations); err != nil {
+	controllersStopped, err := controller.Start(ctx, clientFactory, cache, &layer.Validations, &layer.SLO, &layer.Traffic, &layer.Snapshot, &layer.Notification)
+	if err != nil {
 		log.Fatalf("Error creating validations controller: %s", err)
 	}
 
 	// wait forever, or at least until we are told to exit
-	waitForTermination()
+	signals := waitForTermination()
+
+	// Shutdown internal components, letting the in-flight requests and the background controllers complete within the
+	// grace period. The informers of the cache are stopped last, as they are used by both.
+	gracePeriod := time.Duration(cfg.Server.ShutdownGracePeriodSeconds) * time.Second
+	log.Infof("Shutting down internal components within %v", gracePeriod)
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), gracePeriod)
+	defer cancelShutdown()
+	go func() {
+		<-signals
+		log.Warning("Termination Signal Received again, skipping the graceful shutdown")
+		cancelShutdown()
+	}()
 
-	// Shutdown internal components
-	log.Info("Shutting down internal components")
-	server.Stop()
+	server.Shutdown(shutdownCtx)
+	cancel()
+	select {
+	case <-controllersStopped:
+	case <-shutdownCtx.Done():
+		log.Warning("Background controllers did not stop within the shutdown grace period")
+	}
 }
 
-func waitForTermination() {
-	// Channel that is notified when we are done and should exit
-	// TODO: may want to make this a package variable - other things might want to tell us to exit
-	doneChan := make(chan bool)
-
+// waitForTermination waits for a termination signal. The following ones are sent to the returned channel.
+func waitForTermination() <-chan os.Signal {
 	signalChan := make(chan os.Signal, 1)
 	signal.Notify(signalChan, syscall.SIGINT, syscall.SIGTERM)
-	go func() {
-		for range signalChan {
-			log.Info("Termination Signal Received")
-			doneChan <- true
-		}
-	}()
-
-	<-doneChan
+	<-signalChan
+	log.Info("Termination Signal Received")
+	return signalChan
 }
 
 func validateFlags() {
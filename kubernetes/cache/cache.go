@@ -24,6 +24,7 @@ import (
 const (
 	ambientCheckExpirationTime = 10 * time.Minute
 	meshExpirationTime         = 20 * time.Second
+	searchIndexExpirationTime  = 30 * time.Second
 	waypointExpirationTime     = 1 * time.Minute
 )
 
@@ -61,6 +62,9 @@ type KialiCache interface {
 	// GetNamespaces returns all namespaces for the cluster/token from the in memory cache.
 	GetNamespaces(cluster string, token string) ([]models.Namespace, bool)
 
+	// GetPermissions returns the Istio config permissions of the cluster/token in a namespace from the in memory cache.
+	GetPermissions(cluster string, token string, namespace string) (*models.ResourcesPermissions, bool)
+
 	// GetZtunnelPods returns a list of ztunnel pods from the ztunnel daemonset
 	GetZtunnelPods(cluster string) []v1.Pod
 
@@ -76,6 +80,9 @@ type KialiCache interface {
 	// RefreshTokenNamespaces clears the in memory cache of namespaces.
 	RefreshTokenNamespaces(cluster string)
 
+	// RefreshTokenPermissions clears the in memory cache of Istio config permissions.
+	RefreshTokenPermissions(cluster string)
+
 	RegistryStatusCache
 	ProxyStatusCache
 	ZtunnelDumpCache
@@ -83,16 +90,29 @@ type KialiCache interface {
 	// SetValidations caches validations for a cluster/namespace.
 	Validations() store.Store[models.IstioValidationKey, *models.IstioValidation]
 
+	// SLOStatuses caches the last SLO evaluation for each service with an SLO definition.
+	SLOStatuses() store.Store[models.SLOKey, *models.SLOStatus]
+
+	// TrafficFindings caches the current deviations from the traffic baseline.
+	TrafficFindings() store.Store[models.TrafficFindingKey, *models.TrafficFinding]
+
+	// SearchIndexes caches the search index of each namespace. The indexes expire to be rebuilt from the kube caches.
+	SearchIndexes() store.Store[models.SearchIndexKey, *models.SearchIndex]
+
 	// SetClusters sets the list of clusters that the cache knows about.
 	SetClusters([]models.KubeCluster)
 
 	// SetNamespaces sets the in memory cache of namespaces.
-	// We cache all namespaces for cluster + token.
+	// We cache all namespaces for cluster + token. The token is the identity of the user client, so that the users
+	// impersonated with the same token don't share their namespaces.
 	SetNamespaces(token string, namespaces []models.Namespace)
 
 	// SetNamespace caches a specific namespace by cluster + token.
 	SetNamespace(token string, namespace models.Namespace)
 
+	// SetPermissions caches the Istio config permissions of a namespace by cluster + token.
+	SetPermissions(cluster string, token string, namespace string, permissions *models.ResourcesPermissions)
+
 	// Stop stops the cache and all its kube caches.
 	Stop()
 }
@@ -122,6 +142,9 @@ type kialiCacheImpl struct {
 	// that the map returned from the store is threadsafe.
 	namespacesLock sync.RWMutex
 
+	// Store the Istio config permissions per token + cluster + namespace. They expire like the namespaces.
+	permissionStore store.Store[permissionsKey, *models.ResourcesPermissions]
+
 	refreshDuration time.Duration
 	// ProxyStatusStore stores the proxy status and should be key'd off cluster + namespace + pod.
 	proxyStatusStore store.Store[string, *kubernetes.ProxyStatus]
@@ -133,6 +156,12 @@ type kialiCacheImpl struct {
 	waypointList models.WaypointStore
 	// validations key'd by the validation key
 	validations store.Store[models.IstioValidationKey, *models.IstioValidation]
+	// sloStatuses key'd by cluster + namespace + service
+	sloStatuses store.Store[models.SLOKey, *models.SLOStatus]
+	// trafficFindings key'd by cluster + namespace + service + finding type
+	trafficFindings store.Store[models.TrafficFindingKey, *models.TrafficFinding]
+	// searchIndexes key'd by cluster + namespace
+	searchIndexes store.Store[models.SearchIndexKey, *models.SearchIndex]
 
 	// Info about the kube clusters that the cache knows about.
 	clusters    []models.KubeCluster
@@ -149,8 +178,12 @@ func NewKialiCache(kialiSAClients map[string]kubernetes.ClientInterface, cfg con
 		conf:                    cfg,
 		kubeCache:               make(map[string]KubeCache),
 		validations:             store.New[models.IstioValidationKey, *models.IstioValidation](),
+		sloStatuses:             store.New[models.SLOKey, *models.SLOStatus](),
+		trafficFindings:         store.New[models.TrafficFindingKey, *models.TrafficFinding](),
+		searchIndexes:           store.NewExpirationStore(ctx, store.New[models.SearchIndexKey, *models.SearchIndex](), util.AsPtr(searchIndexExpirationTime), nil),
 		meshStore:               store.NewExpirationStore(ctx, store.New[string, *models.Mesh](), util.AsPtr(meshExpirationTime), nil),
 		namespaceStore:          store.NewExpirationStore(ctx, store.New[namespacesKey, map[string]models.Namespace](), &namespaceKeyTTL, nil),
+		permissionStore:         store.NewExpirationStore(ctx, store.New[permissionsKey, *models.ResourcesPermissions](), &namespaceKeyTTL, nil),
 		refreshDuration:         time.Duration(cfg.KubernetesConfig.CacheDuration) * time.Second,
 		proxyStatusStore:        store.New[string, *kubernetes.ProxyStatus](),
 		registryStatusStore:     store.New[string, *kubernetes.RegistryStatus](),
@@ -257,6 +290,18 @@ func (c *kialiCacheImpl) Validations() store.Store[models.IstioValidationKey, *m
 	return c.validations
 }
 
+func (c *kialiCacheImpl) SLOStatuses() store.Store[models.SLOKey, *models.SLOStatus] {
+	return c.sloStatuses
+}
+
+func (c *kialiCacheImpl) TrafficFindings() store.Store[models.TrafficFindingKey, *models.TrafficFinding] {
+	return c.trafficFindings
+}
+
+func (c *kialiCacheImpl) SearchIndexes() store.Store[models.SearchIndexKey, *models.SearchIndex] {
+	return c.searchIndexes
+}
+
 // IsAmbientEnabled checks if the istio Ambient profile was enabled
 // by checking if the ztunnel daemonset exists on the cluster.
 func (in *kialiCacheImpl) IsAmbientEnabled(cluster string) bool {
@@ -425,6 +470,32 @@ func (c *kialiCacheImpl) SetNamespace(token string, namespace models.Namespace)
 	c.namespaceStore.Set(key, ns)
 }
 
+type permissionsKey struct {
+	cluster   string
+	namespace string
+	token     string
+}
+
+func (p permissionsKey) String() string {
+	return fmt.Sprintf("cluster: %s\tnamespace: %s\ttoken: xxx", p.cluster, p.namespace)
+}
+
+func (c *kialiCacheImpl) GetPermissions(cluster string, token string, namespace string) (*models.ResourcesPermissions, bool) {
+	return c.permissionStore.Get(permissionsKey{cluster: cluster, namespace: namespace, token: token})
+}
+
+func (c *kialiCacheImpl) SetPermissions(cluster string, token string, namespace string, permissions *models.ResourcesPermissions) {
+	c.permissionStore.Set(permissionsKey{cluster: cluster, namespace: namespace, token: token}, permissions)
+}
+
+func (c *kialiCacheImpl) RefreshTokenPermissions(cluster string) {
+	for _, key := range c.permissionStore.Keys() {
+		if key.cluster == cluster {
+			c.permissionStore.Remove(key)
+		}
+	}
+}
+
 // deleteNamespace is an error handler that will clean up some internals related to the given namespace
 // if that namespace has been detected to have been deleted. This function is called by the kube cache
 // informers when they encounter an error.
@@ -433,6 +504,7 @@ func (c *kialiCacheImpl) deleteNamespace(kc *kubeCache, namespace string, err er
 		cluster := kc.client.ClusterInfo().Name
 		log.Errorf("Namespace [%v] in cluster [%v] appears to have been deleted or Kiali is forbidden from seeing it [err=%v]. Shutting down namespace cache.", namespace, cluster, err)
 		c.RefreshTokenNamespaces(cluster)
+		c.RefreshTokenPermissions(cluster)
 		kc.StopNamespace(namespace)
 	}
 }
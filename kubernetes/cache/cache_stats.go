@@ -0,0 +1,223 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus/internalmetrics"
+)
+
+// CacheStats tracks the objects, the syncs, the changes and the watch errors of the informers of a kube cache,
+// and reports them as internal metrics. It is safe for concurrent use.
+type CacheStats struct {
+	cluster string
+	lock    sync.RWMutex
+	// lastEvents is the time of the latest change received, by namespace of the objects.
+	lastEvents map[string]time.Time
+	// scopes are the informers of the cache by namespace, or the whole cluster with the empty namespace.
+	scopes map[string]*scopeStats
+}
+
+// scopeStats tracks the informers started together for a scope. A new one replaces it when the informers are restarted.
+type scopeStats struct {
+	lastSync       time.Time
+	lastWatchError string
+	namespace      string
+	// objects is the number of objects held by the informers, by namespace and kind.
+	objects     map[string]map[string]int
+	watchErrors int
+}
+
+// NewCacheStats returns the stats of the kube cache of a cluster.
+func NewCacheStats(cluster string) *CacheStats {
+	return &CacheStats{
+		cluster:    cluster,
+		lastEvents: map[string]time.Time{},
+		scopes:     map[string]*scopeStats{},
+	}
+}
+
+// start resets the stats of a scope before its informers are started. Events from the previous informers are ignored.
+func (s *CacheStats) start(namespace string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.scopes[namespace] = &scopeStats{namespace: namespace, objects: map[string]map[string]int{}}
+	internalmetrics.DeleteCacheObjectsMetrics(s.cluster, namespace)
+}
+
+// stop removes the stats of a scope whose informers are stopped.
+func (s *CacheStats) stop(namespace string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.scopes, namespace)
+	internalmetrics.DeleteCacheObjectsMetrics(s.cluster, namespace)
+}
+
+// synced records the completion of the initial sync of the informers of a scope.
+func (s *CacheStats) synced(namespace string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if scope, ok := s.scopes[namespace]; ok {
+		scope.lastSync = time.Now()
+		internalmetrics.SetCacheLastSyncTime(s.cluster, namespace, scope.lastSync)
+	}
+}
+
+// watch tracks an informer of a scope, it must be called before the informer is started. onWatchError, when set,
+// handles the watch errors once they are recorded, otherwise they are logged.
+func (s *CacheStats) watch(namespace string, kind string, informer cache.SharedIndexInformer, onWatchError func(err error)) {
+	s.lock.RLock()
+	scope := s.scopes[namespace]
+	s.lock.RUnlock()
+	if scope == nil {
+		return
+	}
+
+	if _, err := informer.AddEventHandler(s.eventHandler(scope, kind)); err != nil {
+		log.Errorf("[Kiali Cache] Unable to track the %s objects: %s", kind, err)
+	}
+
+	watchErrorHandler := func(r *cache.Reflector, err error) {
+		s.recordWatchError(scope, err)
+		if onWatchError != nil {
+			onWatchError(err)
+		} else {
+			cache.DefaultWatchErrorHandler(r, err)
+		}
+	}
+	if err := informer.SetWatchErrorHandler(watchErrorHandler); err != nil {
+		log.Errorf("[Kiali Cache] Unable to track the watch errors of the %s objects: %s", kind, err)
+	}
+}
+
+// eventHandler returns the informer handler counting the objects of a kind and recording their changes. The objects
+// listed when the informer starts and the resyncs are not changes.
+func (s *CacheStats) eventHandler(scope *scopeStats, kind string) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			s.record(scope, kind, obj, 1, !isInInitialList)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldObject, oldOk := oldObj.(meta_v1.Object)
+			newObject, newOk := newObj.(meta_v1.Object)
+			if oldOk && newOk && oldObject.GetResourceVersion() == newObject.GetResourceVersion() {
+				return
+			}
+			s.record(scope, kind, newObj, 0, true)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			s.record(scope, kind, obj, -1, true)
+		},
+	}
+}
+
+func (s *CacheStats) record(scope *scopeStats, kind string, obj interface{}, delta int, changed bool) {
+	object, ok := obj.(meta_v1.Object)
+	if !ok {
+		return
+	}
+	namespace := object.GetNamespace()
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	// The informers of the scope have been restarted or stopped
+	if s.scopes[scope.namespace] != scope {
+		return
+	}
+
+	if delta != 0 {
+		if _, ok := scope.objects[namespace]; !ok {
+			scope.objects[namespace] = map[string]int{}
+		}
+		scope.objects[namespace][kind] += delta
+		internalmetrics.GetCacheObjectsMetric(s.cluster, namespace, kind).Add(float64(delta))
+	}
+	if changed {
+		now := time.Now()
+		s.lastEvents[namespace] = now
+		internalmetrics.SetCacheLastEventTime(s.cluster, namespace, now)
+	}
+}
+
+func (s *CacheStats) recordWatchError(scope *scopeStats, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	scope.watchErrors++
+	scope.lastWatchError = err.Error()
+	internalmetrics.GetCacheWatchErrorsMetric(s.cluster, scope.namespace).Inc()
+}
+
+// Stats returns the state of the informers of the cache, and the staleness of every namespace with cached objects.
+func (s *CacheStats) Stats(clusterScoped bool, resyncPeriod time.Duration) models.KubeCacheStats {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	now := time.Now()
+	stats := models.KubeCacheStats{
+		Cluster:       s.cluster,
+		ClusterScoped: clusterScoped,
+		ResyncPeriod:  int64(resyncPeriod.Seconds()),
+		Informers:     []models.CacheInformersStats{},
+		Namespaces:    []models.NamespaceCacheStats{},
+	}
+
+	for _, scope := range s.scopes {
+		informers := models.CacheInformersStats{
+			Namespace:      scope.namespace,
+			Synced:         !scope.lastSync.IsZero(),
+			Objects:        map[string]int{},
+			WatchErrors:    scope.watchErrors,
+			LastWatchError: scope.lastWatchError,
+		}
+		if informers.Synced {
+			lastSync := scope.lastSync
+			informers.LastSync = &lastSync
+		}
+
+		for namespace, kinds := range scope.objects {
+			for kind, count := range kinds {
+				informers.Objects[kind] += count
+			}
+			if namespace == "" {
+				// Cluster-scoped objects
+				continue
+			}
+
+			nsStats := models.NamespaceCacheStats{Namespace: namespace}
+			freshness := scope.lastSync
+			if lastEvent, ok := s.lastEvents[namespace]; ok {
+				nsStats.LastEvent = &lastEvent
+				if lastEvent.After(freshness) {
+					freshness = lastEvent
+				}
+			}
+			if !freshness.IsZero() {
+				nsStats.Staleness = int64(now.Sub(freshness).Seconds())
+			}
+			stats.Namespaces = append(stats.Namespaces, nsStats)
+		}
+		stats.Informers = append(stats.Informers, informers)
+	}
+
+	sort.Slice(stats.Informers, func(i, j int) bool {
+		return stats.Informers[i].Namespace < stats.Informers[j].Namespace
+	})
+	sort.Slice(stats.Namespaces, func(i, j int) bool {
+		return stats.Namespaces[i].Namespace < stats.Namespaces[j].Namespace
+	})
+	return stats
+}
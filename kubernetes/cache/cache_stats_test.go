@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+func TestCacheStats(t *testing.T) {
+	require := require.New(t)
+
+	client := kubetest.NewFakeK8sClient(
+		&core_v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1", Namespace: "bookinfo"}},
+		&networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo", ResourceVersion: "1"}},
+	)
+	client.KubeClusterInfo = kubernetes.ClusterInfo{Name: "east"}
+	kubeCache, err := NewKubeCache(client, *config.NewConfig(), nil)
+	require.NoError(err)
+	t.Cleanup(kubeCache.Stop)
+
+	require.Eventually(func() bool {
+		return kubeCache.Stats().Informers[0].Objects[kubernetes.VirtualServiceType] == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	stats := kubeCache.Stats()
+	require.Equal("east", stats.Cluster)
+	require.True(stats.ClusterScoped)
+	require.Len(stats.Informers, 1)
+	require.True(stats.Informers[0].Synced)
+	require.NotNil(stats.Informers[0].LastSync)
+	require.Equal(1, stats.Informers[0].Objects[kubernetes.PodType])
+	require.Equal(1, stats.Informers[0].Objects[kubernetes.VirtualServiceType])
+	require.Len(stats.Namespaces, 1)
+	require.Equal("bookinfo", stats.Namespaces[0].Namespace)
+	// The objects listed on start are not changes
+	require.Nil(stats.Namespaces[0].LastEvent)
+
+	created := &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"}}
+	_, err = client.Istio().NetworkingV1().VirtualServices("bookinfo").Create(context.TODO(), created, metav1.CreateOptions{})
+	require.NoError(err)
+	require.Eventually(func() bool {
+		return kubeCache.Stats().Informers[0].Objects[kubernetes.VirtualServiceType] == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NotNil(kubeCache.Stats().Namespaces[0].LastEvent)
+
+	// The restarted informers count the objects again
+	kubeCache.Refresh("")
+	require.Eventually(func() bool {
+		objects := kubeCache.Stats().Informers[0].Objects
+		return objects[kubernetes.VirtualServiceType] == 2 && objects[kubernetes.PodType] == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestCacheStatsWatchErrors(t *testing.T) {
+	require := require.New(t)
+
+	stats := NewCacheStats("east")
+	stats.start("bookinfo")
+	scope := stats.scopes["bookinfo"]
+	stats.recordWatchError(scope, errors.New("forbidden"))
+
+	result := stats.Stats(false, time.Minute)
+	require.False(result.ClusterScoped)
+	require.Equal(int64(60), result.ResyncPeriod)
+	require.Len(result.Informers, 1)
+	require.Equal("bookinfo", result.Informers[0].Namespace)
+	require.False(result.Informers[0].Synced)
+	require.Equal(1, result.Informers[0].WatchErrors)
+	require.Equal("forbidden", result.Informers[0].LastWatchError)
+
+	// Events of stopped informers are ignored
+	stats.stop("bookinfo")
+	stats.record(scope, kubernetes.PodType, &core_v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "reviews-v1", Namespace: "bookinfo"}}, 1, true)
+	result = stats.Stats(false, time.Minute)
+	require.Empty(result.Informers)
+	require.Empty(result.Namespaces)
+}
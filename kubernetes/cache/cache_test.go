@@ -249,6 +249,34 @@ func TestRefreshTokenNamespaces(t *testing.T) {
 	require.Equal("test", namespaces[0].Name)
 }
 
+func TestRefreshTokenPermissions(t *testing.T) {
+	require := require.New(t)
+	conf := config.NewConfig()
+	conf.KubernetesConfig.CacheTokenNamespaceDuration = 10000
+	conf.KubernetesConfig.ClusterName = "east"
+	kubernetes.SetConfig(t, *conf)
+
+	client := kubetest.NewFakeK8sClient()
+	cache := cache.NewTestingCache(t, client, *conf)
+	permissions := &models.ResourcesPermissions{"networking.istio.io/v1, Kind=VirtualService": {Create: true}}
+	cache.SetPermissions("east", "token", "test", permissions)
+	cache.SetPermissions("west", "token", "test", permissions)
+
+	cached, found := cache.GetPermissions("east", "token", "test")
+	require.True(found)
+	require.Equal(permissions, cached)
+
+	_, found = cache.GetPermissions("east", "token2", "test")
+	require.False(found)
+
+	// Test refresh doesn't affect other clusters.
+	cache.RefreshTokenPermissions("east")
+	_, found = cache.GetPermissions("east", "token", "test")
+	require.False(found)
+	_, found = cache.GetPermissions("west", "token", "test")
+	require.True(found)
+}
+
 func TestValidationsSetByConstructor(t *testing.T) {
 	require := require.New(t)
 	conf := config.NewConfig()
@@ -0,0 +1,228 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// activityMergeWindow is the delay within which the change of an object made through Kiali and the same change seen by
+// the watch are merged into a single event.
+const activityMergeWindow = time.Minute
+
+// ConfigActivity keeps the latest changes of the Istio config of a cluster, up to its size. It is safe for concurrent use.
+type ConfigActivity struct {
+	// events is a ring buffer: the oldest event is at start.
+	events []models.IstioConfigEvent
+	count  int
+	lock   sync.RWMutex
+	start  int
+	// subscribers receive the new events as they are recorded.
+	subscribers map[chan models.IstioConfigEvent]struct{}
+}
+
+// NewConfigActivity returns a ConfigActivity keeping up to size events.
+func NewConfigActivity(size int) *ConfigActivity {
+	if size <= 0 {
+		size = 1
+	}
+	return &ConfigActivity{
+		events:      make([]models.IstioConfigEvent, size),
+		subscribers: map[chan models.IstioConfigEvent]struct{}{},
+	}
+}
+
+// at returns the i-th oldest event.
+func (a *ConfigActivity) at(i int) *models.IstioConfigEvent {
+	return &a.events[(a.start+i)%len(a.events)]
+}
+
+// Record adds an event. The changes made through Kiali are recorded twice: when they are made, with their Kiali author,
+// and when they are seen by the watch, with their resource version. Both are merged into the first event recorded.
+func (a *ConfigActivity) Record(event models.IstioConfigEvent) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	for i := a.count - 1; i >= 0; i-- {
+		previous := a.at(i)
+		if event.Timestamp.Sub(previous.Timestamp) > activityMergeWindow {
+			break
+		}
+		if !previous.SameObject(event) {
+			continue
+		}
+		// Only the latest change of the object can be merged
+		if previous.Type == event.Type && previous.ViaKiali != event.ViaKiali &&
+			(previous.ResourceVersion == "" || event.ResourceVersion == "" || previous.ResourceVersion == event.ResourceVersion) {
+			if previous.ResourceVersion == "" {
+				previous.ResourceVersion = event.ResourceVersion
+			}
+			if event.ViaKiali {
+				previous.Author = event.Author
+				previous.ViaKiali = true
+			}
+			return
+		}
+		break
+	}
+
+	if a.count < len(a.events) {
+		*a.at(a.count) = event
+		a.count++
+	} else {
+		a.events[a.start] = event
+		a.start = (a.start + 1) % len(a.events)
+	}
+
+	for subscriber := range a.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			// A slow subscriber catches up with List
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the events recorded from now on, up to buffer pending events, and the
+// function to call to stop receiving them, which closes the channel. The merges of the changes made through Kiali
+// with the changes seen by the watch are not sent again.
+func (a *ConfigActivity) Subscribe(buffer int) (<-chan models.IstioConfigEvent, func()) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	subscriber := make(chan models.IstioConfigEvent, buffer)
+	a.subscribers[subscriber] = struct{}{}
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			a.lock.Lock()
+			defer a.lock.Unlock()
+			delete(a.subscribers, subscriber)
+			close(subscriber)
+		})
+	}
+	return subscriber, cancel
+}
+
+// List returns the events of a namespace, all of them when it is empty, that happened after since, newest first.
+// Up to limit events are returned when it is positive.
+func (a *ConfigActivity) List(namespace string, since time.Time, limit int) []models.IstioConfigEvent {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	result := []models.IstioConfigEvent{}
+	for i := a.count - 1; i >= 0 && (limit <= 0 || len(result) < limit); i-- {
+		event := a.at(i)
+		if !event.Timestamp.After(since) {
+			break
+		}
+		if namespace == "" || event.Namespace == namespace {
+			result = append(result, *event)
+		}
+	}
+	return result
+}
+
+// DeletedSince returns the deletions of the objects of a namespace, all of them when it is empty, whose
+// resourceVersion is higher than the given one, newest first. complete is false when older events have been dropped,
+// so that some deletions since the resourceVersion may be missing.
+func (a *ConfigActivity) DeletedSince(namespace string, resourceVersion uint64) (deleted []models.IstioConfigEvent, complete bool) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	// Nothing has been dropped until the buffer is full
+	complete = a.count < len(a.events)
+	deleted = []models.IstioConfigEvent{}
+	for i := a.count - 1; i >= 0; i-- {
+		event := a.at(i)
+		rv, err := strconv.ParseUint(event.ResourceVersion, 10, 64)
+		if err != nil {
+			// Changes made through Kiali not seen by the watch yet
+			continue
+		}
+		if rv <= resourceVersion {
+			complete = true
+			continue
+		}
+		if event.Type == models.IstioConfigMutationDelete && (namespace == "" || event.Namespace == namespace) {
+			deleted = append(deleted, *event)
+		}
+	}
+	return deleted, complete
+}
+
+// eventHandler returns the informer handler recording the changes of the objects of a type.
+func (a *ConfigActivity) eventHandler(cluster string, gvk schema.GroupVersionKind) cache.ResourceEventHandler {
+	return configChangeHandler(gvk, func(object meta_v1.Object, eventType string) {
+		event := models.IstioConfigEvent{
+			Cluster:         cluster,
+			Name:            object.GetName(),
+			Namespace:       object.GetNamespace(),
+			ObjectGVK:       gvk,
+			ResourceVersion: object.GetResourceVersion(),
+			Timestamp:       time.Now(),
+			Type:            eventType,
+		}
+		// The field managers don't tell who deleted the object
+		if eventType != models.IstioConfigMutationDelete {
+			event.Author = lastManager(object)
+		}
+		a.Record(event)
+	})
+}
+
+// configChangeHandler returns the informer handler calling record for every change of the objects of a type. The
+// objects listed when the informer starts and the resyncs are not changes.
+func configChangeHandler(gvk schema.GroupVersionKind, record func(object meta_v1.Object, eventType string)) cache.ResourceEventHandler {
+	handle := func(obj interface{}, eventType string) {
+		object, ok := obj.(meta_v1.Object)
+		if !ok {
+			log.Debugf("[Kiali Cache] Unexpected %s object in the %s watch: %T", eventType, gvk.Kind, obj)
+			return
+		}
+		record(object, eventType)
+	}
+
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				handle(obj, models.IstioConfigMutationCreate)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldObject, oldOk := oldObj.(meta_v1.Object)
+			newObject, newOk := newObj.(meta_v1.Object)
+			if oldOk && newOk && oldObject.GetResourceVersion() == newObject.GetResourceVersion() {
+				return
+			}
+			handle(newObj, models.IstioConfigMutationUpdate)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			handle(obj, models.IstioConfigMutationDelete)
+		},
+	}
+}
+
+// lastManager returns the field manager of the latest change of an object, if any.
+func lastManager(object meta_v1.Object) string {
+	var manager string
+	var last time.Time
+	for _, entry := range object.GetManagedFields() {
+		if entry.Time != nil && !entry.Time.Time.Before(last) {
+			last = entry.Time.Time
+			manager = entry.Manager
+		}
+	}
+	return manager
+}
@@ -0,0 +1,168 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func TestConfigActivityRecord(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	event := func(name, eventType, rv string, offset time.Duration) models.IstioConfigEvent {
+		return models.IstioConfigEvent{
+			Cluster:         "east",
+			Name:            name,
+			Namespace:       "bookinfo",
+			ObjectGVK:       kubernetes.VirtualServices,
+			ResourceVersion: rv,
+			Timestamp:       now.Add(offset),
+			Type:            eventType,
+		}
+	}
+
+	activity := NewConfigActivity(3)
+	activity.Record(event("reviews", models.IstioConfigMutationCreate, "1", 0))
+
+	// The change made through Kiali is merged with the one seen by the watch
+	viaKiali := event("reviews", models.IstioConfigMutationUpdate, "", time.Second)
+	viaKiali.Author = "jdoe"
+	viaKiali.ViaKiali = true
+	watched := event("reviews", models.IstioConfigMutationUpdate, "2", 2*time.Second)
+	watched.Author = "kiali"
+	activity.Record(watched)
+	activity.Record(viaKiali)
+
+	events := activity.List("bookinfo", time.Time{}, 0)
+	require.Len(t, events, 2)
+	assert.Equal(models.IstioConfigMutationUpdate, events[0].Type)
+	assert.Equal("jdoe", events[0].Author)
+	assert.Equal("2", events[0].ResourceVersion)
+	assert.True(events[0].ViaKiali)
+	assert.Equal(models.IstioConfigMutationCreate, events[1].Type)
+
+	// The oldest events are dropped
+	activity.Record(event("ratings", models.IstioConfigMutationCreate, "3", 3*time.Second))
+	activity.Record(event("ratings", models.IstioConfigMutationDelete, "3", 4*time.Second))
+	events = activity.List("", time.Time{}, 0)
+	require.Len(t, events, 3)
+	assert.Equal("ratings", events[0].Name)
+	assert.Equal(models.IstioConfigMutationUpdate, events[2].Type)
+
+	assert.Len(activity.List("", now.Add(3*time.Second), 0), 1)
+	assert.Len(activity.List("", time.Time{}, 2), 2)
+	assert.Empty(activity.List("travels", time.Time{}, 0))
+}
+
+func TestConfigActivityDeletedSince(t *testing.T) {
+	assert := assert.New(t)
+
+	event := func(name, namespace, eventType, rv string) models.IstioConfigEvent {
+		return models.IstioConfigEvent{
+			Cluster:         "east",
+			Name:            name,
+			Namespace:       namespace,
+			ObjectGVK:       kubernetes.VirtualServices,
+			ResourceVersion: rv,
+			Timestamp:       time.Now(),
+			Type:            eventType,
+		}
+	}
+
+	activity := NewConfigActivity(4)
+	activity.Record(event("reviews", "bookinfo", models.IstioConfigMutationDelete, "5"))
+	activity.Record(event("ratings", "bookinfo", models.IstioConfigMutationCreate, "8"))
+	activity.Record(event("details", "bookinfo", models.IstioConfigMutationDelete, "10"))
+
+	deleted, complete := activity.DeletedSince("bookinfo", 6)
+	assert.True(complete)
+	assert.Len(deleted, 1)
+	assert.Equal("details", deleted[0].Name)
+
+	deleted, complete = activity.DeletedSince("travels", 6)
+	assert.True(complete)
+	assert.Empty(deleted)
+
+	// Once the oldest events are dropped, the deletions before the oldest event kept are unknown
+	activity.Record(event("travels", "travels", models.IstioConfigMutationDelete, "12"))
+	activity.Record(event("hotels", "travels", models.IstioConfigMutationDelete, "14"))
+	_, complete = activity.DeletedSince("", 6)
+	assert.False(complete)
+	deleted, complete = activity.DeletedSince("", 9)
+	assert.True(complete)
+	assert.Len(deleted, 3)
+	assert.Equal("hotels", deleted[0].Name)
+}
+
+func TestConfigActivityWatch(t *testing.T) {
+	require := require.New(t)
+
+	existing := &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo", ResourceVersion: "1"}}
+	client := kubetest.NewFakeK8sClient(existing)
+	client.KubeClusterInfo = kubernetes.ClusterInfo{Name: "east"}
+	kubeCache, err := NewKubeCache(client, *config.NewConfig(), nil)
+	require.NoError(err)
+	t.Cleanup(kubeCache.Stop)
+
+	// The objects listed on start are not changes
+	require.Empty(kubeCache.Activity().List("", time.Time{}, 0))
+
+	manager := metav1.ManagedFieldsEntry{Manager: "kubectl-create", Operation: metav1.ManagedFieldsOperationUpdate, Time: &metav1.Time{Time: time.Now()}}
+	created := &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "ratings", Namespace: "bookinfo", ManagedFields: []metav1.ManagedFieldsEntry{manager}}}
+	_, err = client.Istio().NetworkingV1().VirtualServices("bookinfo").Create(context.TODO(), created, metav1.CreateOptions{})
+	require.NoError(err)
+	require.NoError(client.Istio().NetworkingV1().VirtualServices("bookinfo").Delete(context.TODO(), "reviews", metav1.DeleteOptions{}))
+
+	require.Eventually(func() bool { return len(kubeCache.Activity().List("bookinfo", time.Time{}, 0)) == 2 }, 5*time.Second, 10*time.Millisecond)
+	events := kubeCache.Activity().List("bookinfo", time.Time{}, 0)
+	byName := map[string]models.IstioConfigEvent{events[0].Name: events[0], events[1].Name: events[1]}
+	require.Equal(models.IstioConfigMutationCreate, byName["ratings"].Type)
+	require.Equal("kubectl-create", byName["ratings"].Author)
+	require.Equal("east", byName["ratings"].Cluster)
+	require.Equal(kubernetes.VirtualServices, byName["ratings"].ObjectGVK)
+	require.Equal(models.IstioConfigMutationDelete, byName["reviews"].Type)
+	require.Empty(byName["reviews"].Author)
+}
+
+func TestConfigActivitySubscribe(t *testing.T) {
+	require := require.New(t)
+
+	activity := NewConfigActivity(3)
+	events, cancel := activity.Subscribe(1)
+
+	event := models.IstioConfigEvent{
+		Cluster:         "east",
+		Name:            "reviews",
+		Namespace:       "bookinfo",
+		ObjectGVK:       kubernetes.VirtualServices,
+		ResourceVersion: "1",
+		Timestamp:       time.Now(),
+		Type:            models.IstioConfigMutationCreate,
+	}
+	activity.Record(event)
+	require.Equal("reviews", (<-events).Name)
+
+	// The subscriber buffer is full: the second event is dropped instead of blocking the record
+	event.Name = "ratings"
+	activity.Record(event)
+	event.Name = "details"
+	activity.Record(event)
+	require.Equal("ratings", (<-events).Name)
+
+	cancel()
+	cancel()
+	_, ok := <-events
+	require.False(ok)
+	activity.Record(event)
+}
@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/prometheus/internalmetrics"
+)
+
+// ConfigChurnRetention is the longest window of the churn of the Istio config.
+const ConfigChurnRetention = time.Hour
+
+// churnBuckets is the number of buckets of a minute kept for every namespace.
+const churnBuckets = int(ConfigChurnRetention / time.Minute)
+
+// ConfigChurn counts the changes of the Istio config of a cluster by namespace, in buckets of a minute, over the last
+// ConfigChurnRetention, and reports them as internal metrics. It is safe for concurrent use.
+type ConfigChurn struct {
+	cluster string
+	lock    sync.Mutex
+	// namespaces are ring buffers of buckets, indexed by minute.
+	namespaces map[string][]churnBucket
+}
+
+// churnBucket counts the changes of a minute.
+type churnBucket struct {
+	// minute since the epoch of the changes
+	minute  int64
+	kinds   map[string]int
+	authors map[string]int
+}
+
+// NewConfigChurn returns the churn of the Istio config of a cluster.
+func NewConfigChurn(cluster string) *ConfigChurn {
+	return &ConfigChurn{
+		cluster:    cluster,
+		namespaces: map[string][]churnBucket{},
+	}
+}
+
+// Record counts a change of an object of a kind in a namespace. The author is empty when unknown.
+func (c *ConfigChurn) Record(namespace, kind, author string, t time.Time) {
+	internalmetrics.GetIstioConfigChangesMetric(c.cluster, namespace, kind).Inc()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	buckets, ok := c.namespaces[namespace]
+	if !ok {
+		buckets = make([]churnBucket, churnBuckets)
+		c.namespaces[namespace] = buckets
+	}
+	minute := t.Unix() / 60
+	bucket := &buckets[minute%int64(churnBuckets)]
+	if bucket.minute != minute {
+		*bucket = churnBucket{minute: minute, kinds: map[string]int{}, authors: map[string]int{}}
+	}
+	bucket.kinds[kind]++
+	if author != "" {
+		bucket.authors[author]++
+	}
+}
+
+// Churn returns the changes of the namespaces during the window ending now, up to ConfigChurnRetention, the most
+// changed first. The window is rounded up to the minute.
+func (c *ConfigChurn) Churn(window time.Duration, now time.Time) []models.NamespaceConfigChurn {
+	minutes := int64((window + time.Minute - 1) / time.Minute)
+	if minutes > int64(churnBuckets) {
+		minutes = int64(churnBuckets)
+	}
+	if minutes < 1 {
+		minutes = 1
+	}
+	current := now.Unix() / 60
+	oldest := current - int64(churnBuckets) + 1
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	churn := []models.NamespaceConfigChurn{}
+	for namespace, buckets := range c.namespaces {
+		nsChurn := models.NamespaceConfigChurn{Namespace: namespace, Kinds: map[string]int{}, Authors: map[string]int{}}
+		retained := false
+		for _, bucket := range buckets {
+			if bucket.minute < oldest || bucket.minute > current {
+				continue
+			}
+			retained = true
+			if bucket.minute <= current-minutes {
+				continue
+			}
+			for kind, count := range bucket.kinds {
+				nsChurn.Kinds[kind] += count
+				nsChurn.Changes += count
+			}
+			for author, count := range bucket.authors {
+				nsChurn.Authors[author] += count
+			}
+		}
+		if !retained {
+			// No change within the retention
+			delete(c.namespaces, namespace)
+			continue
+		}
+		if nsChurn.Changes > 0 {
+			nsChurn.Rate = float64(nsChurn.Changes) / float64(minutes)
+			churn = append(churn, nsChurn)
+		}
+	}
+
+	sort.Slice(churn, func(i, j int) bool {
+		if churn[i].Changes != churn[j].Changes {
+			return churn[i].Changes > churn[j].Changes
+		}
+		return churn[i].Namespace < churn[j].Namespace
+	})
+	return churn
+}
+
+// eventHandler returns the informer handler counting the changes of the objects of a type.
+func (c *ConfigChurn) eventHandler(gvk schema.GroupVersionKind) cache.ResourceEventHandler {
+	return configChangeHandler(gvk, func(object meta_v1.Object, eventType string) {
+		author := ""
+		// The field managers don't tell who deleted the object
+		if eventType != models.IstioConfigMutationDelete {
+			author = lastManager(object)
+		}
+		c.Record(object.GetNamespace(), gvk.Kind, author, time.Now())
+	})
+}
@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+)
+
+func TestConfigChurn(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+	churn := NewConfigChurn("east")
+	for i := 0; i < 20; i++ {
+		churn.Record("ci", kubernetes.VirtualServices.Kind, "argocd-controller", now.Add(-time.Duration(i)*time.Second))
+	}
+	churn.Record("bookinfo", kubernetes.DestinationRules.Kind, "kubectl-edit", now.Add(-2*time.Minute))
+	churn.Record("bookinfo", kubernetes.VirtualServices.Kind, "", now.Add(-5*time.Minute))
+	// Out of the window, within the retention
+	churn.Record("bookinfo", kubernetes.VirtualServices.Kind, "kubectl-edit", now.Add(-30*time.Minute))
+	// Out of the retention
+	churn.Record("old", kubernetes.Gateways.Kind, "kubectl-edit", now.Add(-2*time.Hour))
+
+	namespaces := churn.Churn(10*time.Minute, now)
+	require.Len(namespaces, 2)
+	require.Equal("ci", namespaces[0].Namespace)
+	require.Equal(20, namespaces[0].Changes)
+	require.Equal(2.0, namespaces[0].Rate)
+	require.Equal(map[string]int{"argocd-controller": 20}, namespaces[0].Authors)
+
+	require.Equal("bookinfo", namespaces[1].Namespace)
+	require.Equal(2, namespaces[1].Changes)
+	require.Equal(map[string]int{kubernetes.DestinationRules.Kind: 1, kubernetes.VirtualServices.Kind: 1}, namespaces[1].Kinds)
+	require.Equal(map[string]int{"kubectl-edit": 1}, namespaces[1].Authors)
+
+	namespaces = churn.Churn(time.Hour, now)
+	require.Equal(3, namespaces[1].Changes)
+	// The namespace without change within the retention is dropped
+	require.NotContains(churn.namespaces, "old")
+
+	// The buckets of the previous hour are reused
+	churn.Record("ci", kubernetes.VirtualServices.Kind, "argocd-controller", now.Add(time.Hour))
+	namespaces = churn.Churn(time.Minute, now.Add(time.Hour))
+	require.Len(namespaces, 1)
+	require.Equal(1, namespaces[0].Changes)
+}
+
+func TestConfigChurnWatch(t *testing.T) {
+	require := require.New(t)
+
+	existing := &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo", ResourceVersion: "1"}}
+	client := kubetest.NewFakeK8sClient(existing)
+	client.KubeClusterInfo = kubernetes.ClusterInfo{Name: "east"}
+	kubeCache, err := NewKubeCache(client, *config.NewConfig(), nil)
+	require.NoError(err)
+	t.Cleanup(kubeCache.Stop)
+
+	// The objects listed on start are not changes
+	require.Empty(kubeCache.Churn().Churn(time.Minute, time.Now()))
+
+	created := &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "ratings", Namespace: "bookinfo"}}
+	_, err = client.Istio().NetworkingV1().VirtualServices("bookinfo").Create(context.TODO(), created, metav1.CreateOptions{})
+	require.NoError(err)
+	require.NoError(client.Istio().NetworkingV1().VirtualServices("bookinfo").Delete(context.TODO(), "reviews", metav1.DeleteOptions{}))
+
+	require.Eventually(func() bool {
+		namespaces := kubeCache.Churn().Churn(2*time.Minute, time.Now())
+		return len(namespaces) == 1 && namespaces[0].Changes == 2
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"slices"
@@ -10,18 +11,21 @@ import (
 	extentions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1 "istio.io/client-go/pkg/apis/security/v1"
 	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
 	istio "istio.io/client-go/pkg/informers/externalversions"
 	istioext_v1alpha1_listers "istio.io/client-go/pkg/listers/extensions/v1alpha1"
 	istionet_v1_listers "istio.io/client-go/pkg/listers/networking/v1"
 	istionet_v1alpha3_listers "istio.io/client-go/pkg/listers/networking/v1alpha3"
+	istionet_v1beta1_listers "istio.io/client-go/pkg/listers/networking/v1beta1"
 	istiosec_v1_listers "istio.io/client-go/pkg/listers/security/v1"
 	istiotelem_v1_listers "istio.io/client-go/pkg/listers/telemetry/v1"
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/informers"
 	apps_v1_listers "k8s.io/client-go/listers/apps/v1"
 	core_v1_listers "k8s.io/client-go/listers/core/v1"
@@ -37,6 +41,7 @@ import (
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
 )
 
 // checkIstioAPIsExist checks if the istio APIs are present in the cluster
@@ -63,6 +68,15 @@ type KubeCache interface {
 	// StopNamespace cleans up the namespace-scoped cache for the given namespace
 	StopNamespace(namespace string)
 
+	// Activity returns the latest changes of the Istio config of the cluster.
+	Activity() *ConfigActivity
+
+	// Churn returns the counts of the recent changes of the Istio config of the cluster by namespace.
+	Churn() *ConfigChurn
+
+	// Stats returns the state of the informers of the cache and the staleness of the cached namespaces.
+	Stats() models.KubeCacheStats
+
 	// Client returns the underlying client for the KubeCache.
 	// This is useful for when you want to talk directly to the kube API
 	// using the Kiali Service Account client.
@@ -85,46 +99,49 @@ type KubeCache interface {
 	GetPods(namespace, labelSelector string) ([]core_v1.Pod, error)
 	GetReplicaSets(namespace string) ([]apps_v1.ReplicaSet, error)
 
-	GetDestinationRule(namespace, name string) (*networking_v1.DestinationRule, error)
-	GetDestinationRules(namespace, labelSelector string) ([]*networking_v1.DestinationRule, error)
-	GetEnvoyFilter(namespace, name string) (*networking_v1alpha3.EnvoyFilter, error)
-	GetEnvoyFilters(namespace, labelSelector string) ([]*networking_v1alpha3.EnvoyFilter, error)
-	GetGateway(namespace, name string) (*networking_v1.Gateway, error)
-	GetGateways(namespace, labelSelector string) ([]*networking_v1.Gateway, error)
-	GetServiceEntry(namespace, name string) (*networking_v1.ServiceEntry, error)
-	GetServiceEntries(namespace, labelSelector string) ([]*networking_v1.ServiceEntry, error)
-	GetSidecar(namespace, name string) (*networking_v1.Sidecar, error)
-	GetSidecars(namespace, labelSelector string) ([]*networking_v1.Sidecar, error)
-	GetVirtualService(namespace, name string) (*networking_v1.VirtualService, error)
-	GetVirtualServices(namespace, labelSelector string) ([]*networking_v1.VirtualService, error)
-	GetWorkloadEntry(namespace, name string) (*networking_v1.WorkloadEntry, error)
-	GetWorkloadEntries(namespace, labelSelector string) ([]*networking_v1.WorkloadEntry, error)
-	GetWorkloadGroup(namespace, name string) (*networking_v1.WorkloadGroup, error)
-	GetWorkloadGroups(namespace, labelSelector string) ([]*networking_v1.WorkloadGroup, error)
-	GetWasmPlugin(namespace, name string) (*extentions_v1alpha1.WasmPlugin, error)
-	GetWasmPlugins(namespace, labelSelector string) ([]*extentions_v1alpha1.WasmPlugin, error)
-	GetTelemetry(namespace, name string) (*telemetry_v1.Telemetry, error)
-	GetTelemetries(namespace, labelSelector string) ([]*telemetry_v1.Telemetry, error)
-
-	GetK8sGateway(namespace, name string) (*gatewayapi_v1.Gateway, error)
-	GetK8sGateways(namespace, labelSelector string) ([]*gatewayapi_v1.Gateway, error)
-	GetK8sGRPCRoute(namespace, name string) (*gatewayapi_v1.GRPCRoute, error)
-	GetK8sGRPCRoutes(namespace, labelSelector string) ([]*gatewayapi_v1.GRPCRoute, error)
-	GetK8sHTTPRoute(namespace, name string) (*gatewayapi_v1.HTTPRoute, error)
-	GetK8sHTTPRoutes(namespace, labelSelector string) ([]*gatewayapi_v1.HTTPRoute, error)
-	GetK8sReferenceGrant(namespace, name string) (*gatewayapi_v1beta1.ReferenceGrant, error)
-	GetK8sReferenceGrants(namespace, labelSelector string) ([]*gatewayapi_v1beta1.ReferenceGrant, error)
-	GetK8sTCPRoute(namespace, name string) (*gatewayapi_v1alpha2.TCPRoute, error)
-	GetK8sTCPRoutes(namespace, labelSelector string) ([]*gatewayapi_v1alpha2.TCPRoute, error)
-	GetK8sTLSRoute(namespace, name string) (*gatewayapi_v1alpha2.TLSRoute, error)
-	GetK8sTLSRoutes(namespace, labelSelector string) ([]*gatewayapi_v1alpha2.TLSRoute, error)
-
-	GetAuthorizationPolicy(namespace, name string) (*security_v1.AuthorizationPolicy, error)
-	GetAuthorizationPolicies(namespace, labelSelector string) ([]*security_v1.AuthorizationPolicy, error)
-	GetPeerAuthentication(namespace, name string) (*security_v1.PeerAuthentication, error)
-	GetPeerAuthentications(namespace, labelSelector string) ([]*security_v1.PeerAuthentication, error)
-	GetRequestAuthentication(namespace, name string) (*security_v1.RequestAuthentication, error)
-	GetRequestAuthentications(namespace, labelSelector string) ([]*security_v1.RequestAuthentication, error)
+	// The Istio config types that are not cached are read from the Kubernetes API with the context of the caller.
+	GetDestinationRule(ctx context.Context, namespace, name string) (*networking_v1.DestinationRule, error)
+	GetDestinationRules(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.DestinationRule, error)
+	GetEnvoyFilter(ctx context.Context, namespace, name string) (*networking_v1alpha3.EnvoyFilter, error)
+	GetEnvoyFilters(ctx context.Context, namespace, labelSelector string) ([]*networking_v1alpha3.EnvoyFilter, error)
+	GetGateway(ctx context.Context, namespace, name string) (*networking_v1.Gateway, error)
+	GetGateways(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.Gateway, error)
+	GetProxyConfig(ctx context.Context, namespace, name string) (*networking_v1beta1.ProxyConfig, error)
+	GetProxyConfigs(ctx context.Context, namespace, labelSelector string) ([]*networking_v1beta1.ProxyConfig, error)
+	GetServiceEntry(ctx context.Context, namespace, name string) (*networking_v1.ServiceEntry, error)
+	GetServiceEntries(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.ServiceEntry, error)
+	GetSidecar(ctx context.Context, namespace, name string) (*networking_v1.Sidecar, error)
+	GetSidecars(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.Sidecar, error)
+	GetVirtualService(ctx context.Context, namespace, name string) (*networking_v1.VirtualService, error)
+	GetVirtualServices(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.VirtualService, error)
+	GetWorkloadEntry(ctx context.Context, namespace, name string) (*networking_v1.WorkloadEntry, error)
+	GetWorkloadEntries(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.WorkloadEntry, error)
+	GetWorkloadGroup(ctx context.Context, namespace, name string) (*networking_v1.WorkloadGroup, error)
+	GetWorkloadGroups(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.WorkloadGroup, error)
+	GetWasmPlugin(ctx context.Context, namespace, name string) (*extentions_v1alpha1.WasmPlugin, error)
+	GetWasmPlugins(ctx context.Context, namespace, labelSelector string) ([]*extentions_v1alpha1.WasmPlugin, error)
+	GetTelemetry(ctx context.Context, namespace, name string) (*telemetry_v1.Telemetry, error)
+	GetTelemetries(ctx context.Context, namespace, labelSelector string) ([]*telemetry_v1.Telemetry, error)
+
+	GetK8sGateway(ctx context.Context, namespace, name string) (*gatewayapi_v1.Gateway, error)
+	GetK8sGateways(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1.Gateway, error)
+	GetK8sGRPCRoute(ctx context.Context, namespace, name string) (*gatewayapi_v1.GRPCRoute, error)
+	GetK8sGRPCRoutes(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1.GRPCRoute, error)
+	GetK8sHTTPRoute(ctx context.Context, namespace, name string) (*gatewayapi_v1.HTTPRoute, error)
+	GetK8sHTTPRoutes(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1.HTTPRoute, error)
+	GetK8sReferenceGrant(ctx context.Context, namespace, name string) (*gatewayapi_v1beta1.ReferenceGrant, error)
+	GetK8sReferenceGrants(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1beta1.ReferenceGrant, error)
+	GetK8sTCPRoute(ctx context.Context, namespace, name string) (*gatewayapi_v1alpha2.TCPRoute, error)
+	GetK8sTCPRoutes(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1alpha2.TCPRoute, error)
+	GetK8sTLSRoute(ctx context.Context, namespace, name string) (*gatewayapi_v1alpha2.TLSRoute, error)
+	GetK8sTLSRoutes(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1alpha2.TLSRoute, error)
+
+	GetAuthorizationPolicy(ctx context.Context, namespace, name string) (*security_v1.AuthorizationPolicy, error)
+	GetAuthorizationPolicies(ctx context.Context, namespace, labelSelector string) ([]*security_v1.AuthorizationPolicy, error)
+	GetPeerAuthentication(ctx context.Context, namespace, name string) (*security_v1.PeerAuthentication, error)
+	GetPeerAuthentications(ctx context.Context, namespace, labelSelector string) ([]*security_v1.PeerAuthentication, error)
+	GetRequestAuthentication(ctx context.Context, namespace, name string) (*security_v1.RequestAuthentication, error)
+	GetRequestAuthentications(ctx context.Context, namespace, labelSelector string) ([]*security_v1.RequestAuthentication, error)
 }
 
 // cacheLister combines a bunch of lister types into one.
@@ -155,6 +172,7 @@ type cacheLister struct {
 	k8stcprouteLister       k8s_v1alpha2_listers.TCPRouteLister
 	k8stlsrouteLister       k8s_v1alpha2_listers.TLSRouteLister
 	peerAuthnLister         istiosec_v1_listers.PeerAuthenticationLister
+	proxyConfigLister       istionet_v1beta1_listers.ProxyConfigLister
 	requestAuthnLister      istiosec_v1_listers.RequestAuthenticationLister
 	serviceEntryLister      istionet_v1_listers.ServiceEntryLister
 	sidecarLister           istionet_v1_listers.SidecarLister
@@ -185,12 +203,18 @@ type kubeCache struct {
 	client             kubernetes.ClientInterface
 	clusterCacheLister *cacheLister
 	clusterScoped      bool
+	// activity keeps the latest changes of the Istio config, captured by the informers.
+	activity *ConfigActivity
+	// churn counts the recent changes of the Istio config by namespace, captured by the informers.
+	churn *ConfigChurn
 	// used in methods before calling Gateway API listers
 	// added because of potential nil issue when CRDs are applied after Kiali pod starts
 	hasExpGatewayAPIStarted bool
 	hasGatewayAPIStarted    bool
 	nsCacheLister           map[string]*cacheLister
 	refreshDuration         time.Duration
+	// stats tracks the objects, syncs and watch errors of the informers.
+	stats *CacheStats
 	// Stops the cluster scoped informers when a refresh is necessary.
 	// Close this channel to stop the cluster-scoped informers.
 	stopClusterScopedChan chan struct{}
@@ -203,6 +227,8 @@ func NewKubeCache(kialiClient kubernetes.ClientInterface, cfg config.Config, err
 	refreshDuration := time.Duration(cfg.KubernetesConfig.CacheDuration) * time.Second
 
 	c := &kubeCache{
+		activity:     NewConfigActivity(cfg.KubernetesConfig.ConfigActivitySize),
+		churn:        NewConfigChurn(kialiClient.ClusterInfo().Name),
 		cfg:          cfg,
 		errorHandler: errorHandler,
 		client:       kialiClient,
@@ -212,6 +238,7 @@ func NewKubeCache(kialiClient kubernetes.ClientInterface, cfg config.Config, err
 		clusterScoped:   cfg.AllNamespacesAccessible(),
 		refreshDuration: refreshDuration,
 		refreshOnce:     &OnceWrapper{once: &sync.Once{}},
+		stats:           NewCacheStats(kialiClient.ClusterInfo().Name),
 	}
 
 	if c.clusterScoped {
@@ -246,6 +273,33 @@ func (c *kubeCache) isCached(namespace string) bool {
 	return false
 }
 
+// Activity returns the latest changes of the Istio config of the cluster.
+func (c *kubeCache) Activity() *ConfigActivity {
+	return c.activity
+}
+
+// Churn returns the counts of the recent changes of the Istio config of the cluster by namespace.
+func (c *kubeCache) Churn() *ConfigChurn {
+	return c.churn
+}
+
+// Stats returns the state of the informers of the cache and the staleness of the cached namespaces.
+func (c *kubeCache) Stats() models.KubeCacheStats {
+	return c.stats.Stats(c.clusterScoped, c.refreshDuration)
+}
+
+// watchIstioConfig records the changes of the objects of an Istio config informer in the config activity and in the
+// config churn, and tracks the informer in the cache stats.
+func (c *kubeCache) watchIstioConfig(namespace string, informer cache.SharedIndexInformer, gvk schema.GroupVersionKind) {
+	if _, err := informer.AddEventHandler(c.activity.eventHandler(c.client.ClusterInfo().Name, gvk)); err != nil {
+		log.Errorf("[Kiali Cache] Unable to watch the changes of %s: %s", gvk.Kind, err)
+	}
+	if _, err := informer.AddEventHandler(c.churn.eventHandler(gvk)); err != nil {
+		log.Errorf("[Kiali Cache] Unable to count the changes of %s: %s", gvk.Kind, err)
+	}
+	c.stats.watch(namespace, gvk.Kind, informer, nil)
+}
+
 // Client returns the underlying client for the KubeCache.
 // This is useful for when you want to talk directly to the kube API
 // using the Kiali Service Account client.
@@ -306,11 +360,13 @@ func (c *kubeCache) StopNamespace(namespace string) {
 func (c *kubeCache) stop(namespace string) {
 	if c.clusterScoped {
 		close(c.stopClusterScopedChan)
+		c.stats.stop("")
 	} else {
 		if nsChan, exist := c.stopNSChans[namespace]; exist {
 			close(nsChan)
 			delete(c.stopNSChans, namespace)
 			delete(c.nsCacheLister, namespace)
+			c.stats.stop(namespace)
 		}
 	}
 }
@@ -351,6 +407,7 @@ type starter interface {
 }
 
 func (c *kubeCache) startInformers(namespace string) error {
+	c.stats.start(namespace)
 	informers := []starter{
 		c.createKubernetesInformers(namespace),
 		c.createIstioInformers(namespace),
@@ -384,6 +441,7 @@ func (c *kubeCache) startInformers(namespace string) error {
 		log.Errorf("[Kiali Cache] Failed to sync %s cache", scope)
 		return errors.New("failed to sync cache")
 	}
+	c.stats.synced(namespace)
 
 	log.Info("[Kiali Cache] Started")
 	return nil
@@ -399,44 +457,201 @@ func (c *kubeCache) createIstioInformers(namespace string) istio.SharedInformerF
 	lister := c.getCacheLister(namespace)
 
 	if c.client.IsIstioAPI() {
-		lister.authzLister = sharedInformers.Security().V1().AuthorizationPolicies().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Security().V1().AuthorizationPolicies().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.AuthorizationPolicies) {
+			lister.authzLister = sharedInformers.Security().V1().AuthorizationPolicies().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Security().V1().AuthorizationPolicies().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Security().V1().AuthorizationPolicies().Informer(), kubernetes.AuthorizationPolicies)
+		} else {
+			lister.authzLister = liveAuthorizationPolicyLister{newLiveLister(namespace, kubernetes.AuthorizationPolicies, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*security_v1.AuthorizationPolicy, error) {
+				list, err := c.client.Istio().SecurityV1().AuthorizationPolicies(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.destinationRuleLister = sharedInformers.Networking().V1().DestinationRules().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().DestinationRules().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.DestinationRules) {
+			lister.destinationRuleLister = sharedInformers.Networking().V1().DestinationRules().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().DestinationRules().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1().DestinationRules().Informer(), kubernetes.DestinationRules)
+		} else {
+			lister.destinationRuleLister = liveDestinationRuleLister{newLiveLister(namespace, kubernetes.DestinationRules, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1.DestinationRule, error) {
+				list, err := c.client.Istio().NetworkingV1().DestinationRules(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.envoyFilterLister = sharedInformers.Networking().V1alpha3().EnvoyFilters().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1alpha3().EnvoyFilters().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.EnvoyFilters) {
+			lister.envoyFilterLister = sharedInformers.Networking().V1alpha3().EnvoyFilters().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1alpha3().EnvoyFilters().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1alpha3().EnvoyFilters().Informer(), kubernetes.EnvoyFilters)
+		} else {
+			lister.envoyFilterLister = liveEnvoyFilterLister{newLiveLister(namespace, kubernetes.EnvoyFilters, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1alpha3.EnvoyFilter, error) {
+				list, err := c.client.Istio().NetworkingV1alpha3().EnvoyFilters(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.gatewayLister = sharedInformers.Networking().V1().Gateways().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().Gateways().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.Gateways) {
+			lister.gatewayLister = sharedInformers.Networking().V1().Gateways().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().Gateways().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1().Gateways().Informer(), kubernetes.Gateways)
+		} else {
+			lister.gatewayLister = liveGatewayLister{newLiveLister(namespace, kubernetes.Gateways, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1.Gateway, error) {
+				list, err := c.client.Istio().NetworkingV1().Gateways(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.peerAuthnLister = sharedInformers.Security().V1().PeerAuthentications().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Security().V1().PeerAuthentications().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.PeerAuthentications) {
+			lister.peerAuthnLister = sharedInformers.Security().V1().PeerAuthentications().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Security().V1().PeerAuthentications().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Security().V1().PeerAuthentications().Informer(), kubernetes.PeerAuthentications)
+		} else {
+			lister.peerAuthnLister = livePeerAuthenticationLister{newLiveLister(namespace, kubernetes.PeerAuthentications, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*security_v1.PeerAuthentication, error) {
+				list, err := c.client.Istio().SecurityV1().PeerAuthentications(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.requestAuthnLister = sharedInformers.Security().V1().RequestAuthentications().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Security().V1().RequestAuthentications().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.ProxyConfigs) {
+			lister.proxyConfigLister = sharedInformers.Networking().V1beta1().ProxyConfigs().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1beta1().ProxyConfigs().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1beta1().ProxyConfigs().Informer(), kubernetes.ProxyConfigs)
+		} else {
+			lister.proxyConfigLister = liveProxyConfigLister{newLiveLister(namespace, kubernetes.ProxyConfigs, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1beta1.ProxyConfig, error) {
+				list, err := c.client.Istio().NetworkingV1beta1().ProxyConfigs(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.serviceEntryLister = sharedInformers.Networking().V1().ServiceEntries().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().ServiceEntries().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.RequestAuthentications) {
+			lister.requestAuthnLister = sharedInformers.Security().V1().RequestAuthentications().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Security().V1().RequestAuthentications().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Security().V1().RequestAuthentications().Informer(), kubernetes.RequestAuthentications)
+		} else {
+			lister.requestAuthnLister = liveRequestAuthenticationLister{newLiveLister(namespace, kubernetes.RequestAuthentications, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*security_v1.RequestAuthentication, error) {
+				list, err := c.client.Istio().SecurityV1().RequestAuthentications(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.sidecarLister = sharedInformers.Networking().V1().Sidecars().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().Sidecars().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.ServiceEntries) {
+			lister.serviceEntryLister = sharedInformers.Networking().V1().ServiceEntries().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().ServiceEntries().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1().ServiceEntries().Informer(), kubernetes.ServiceEntries)
+		} else {
+			lister.serviceEntryLister = liveServiceEntryLister{newLiveLister(namespace, kubernetes.ServiceEntries, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1.ServiceEntry, error) {
+				list, err := c.client.Istio().NetworkingV1().ServiceEntries(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.telemetryLister = sharedInformers.Telemetry().V1().Telemetries().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Telemetry().V1alpha1().Telemetries().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.Sidecars) {
+			lister.sidecarLister = sharedInformers.Networking().V1().Sidecars().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().Sidecars().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1().Sidecars().Informer(), kubernetes.Sidecars)
+		} else {
+			lister.sidecarLister = liveSidecarLister{newLiveLister(namespace, kubernetes.Sidecars, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1.Sidecar, error) {
+				list, err := c.client.Istio().NetworkingV1().Sidecars(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.virtualServiceLister = sharedInformers.Networking().V1().VirtualServices().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().VirtualServices().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.Telemetries) {
+			lister.telemetryLister = sharedInformers.Telemetry().V1().Telemetries().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Telemetry().V1alpha1().Telemetries().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Telemetry().V1().Telemetries().Informer(), kubernetes.Telemetries)
+		} else {
+			lister.telemetryLister = liveTelemetryLister{newLiveLister(namespace, kubernetes.Telemetries, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*telemetry_v1.Telemetry, error) {
+				list, err := c.client.Istio().TelemetryV1().Telemetries(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.wasmPluginLister = sharedInformers.Extensions().V1alpha1().WasmPlugins().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Extensions().V1alpha1().WasmPlugins().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.VirtualServices) {
+			lister.virtualServiceLister = sharedInformers.Networking().V1().VirtualServices().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().VirtualServices().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1().VirtualServices().Informer(), kubernetes.VirtualServices)
+		} else {
+			lister.virtualServiceLister = liveVirtualServiceLister{newLiveLister(namespace, kubernetes.VirtualServices, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1.VirtualService, error) {
+				list, err := c.client.Istio().NetworkingV1().VirtualServices(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
+
+		if c.isTypeCached(kubernetes.WasmPlugins) {
+			lister.wasmPluginLister = sharedInformers.Extensions().V1alpha1().WasmPlugins().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Extensions().V1alpha1().WasmPlugins().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Extensions().V1alpha1().WasmPlugins().Informer(), kubernetes.WasmPlugins)
+		} else {
+			lister.wasmPluginLister = liveWasmPluginLister{newLiveLister(namespace, kubernetes.WasmPlugins, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*extentions_v1alpha1.WasmPlugin, error) {
+				list, err := c.client.Istio().ExtensionsV1alpha1().WasmPlugins(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.workloadEntryLister = sharedInformers.Networking().V1().WorkloadEntries().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().WorkloadEntries().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.WorkloadEntries) {
+			lister.workloadEntryLister = sharedInformers.Networking().V1().WorkloadEntries().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().WorkloadEntries().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1().WorkloadEntries().Informer(), kubernetes.WorkloadEntries)
+		} else {
+			lister.workloadEntryLister = liveWorkloadEntryLister{newLiveLister(namespace, kubernetes.WorkloadEntries, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1.WorkloadEntry, error) {
+				list, err := c.client.Istio().NetworkingV1().WorkloadEntries(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 
-		lister.workloadGroupLister = sharedInformers.Networking().V1().WorkloadGroups().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().WorkloadGroups().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.WorkloadGroups) {
+			lister.workloadGroupLister = sharedInformers.Networking().V1().WorkloadGroups().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Networking().V1().WorkloadGroups().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Networking().V1().WorkloadGroups().Informer(), kubernetes.WorkloadGroups)
+		} else {
+			lister.workloadGroupLister = liveWorkloadGroupLister{newLiveLister(namespace, kubernetes.WorkloadGroups, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*networking_v1.WorkloadGroup, error) {
+				list, err := c.client.Istio().NetworkingV1().WorkloadGroups(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return list.Items, nil
+			})}
+		}
 	}
 
 	return sharedInformers
@@ -452,25 +667,91 @@ func (c *kubeCache) createGatewayInformers(namespace string) gateway.SharedInfor
 	lister := c.getCacheLister(namespace)
 
 	if c.client.IsGatewayAPI() {
-		lister.k8sgatewayLister = sharedInformers.Gateway().V1().Gateways().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1().Gateways().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.K8sGateways) {
+			lister.k8sgatewayLister = sharedInformers.Gateway().V1().Gateways().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1().Gateways().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Gateway().V1().Gateways().Informer(), kubernetes.K8sGateways)
+		} else {
+			lister.k8sgatewayLister = liveK8sGatewayLister{newLiveLister(namespace, kubernetes.K8sGateways, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*gatewayapi_v1.Gateway, error) {
+				list, err := c.client.GatewayAPI().GatewayV1().Gateways(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return livePtrs(list.Items), nil
+			})}
+		}
 
-		lister.k8shttprouteLister = sharedInformers.Gateway().V1().HTTPRoutes().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1().HTTPRoutes().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.K8sHTTPRoutes) {
+			lister.k8shttprouteLister = sharedInformers.Gateway().V1().HTTPRoutes().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1().HTTPRoutes().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Gateway().V1().HTTPRoutes().Informer(), kubernetes.K8sHTTPRoutes)
+		} else {
+			lister.k8shttprouteLister = liveK8sHTTPRouteLister{newLiveLister(namespace, kubernetes.K8sHTTPRoutes, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*gatewayapi_v1.HTTPRoute, error) {
+				list, err := c.client.GatewayAPI().GatewayV1().HTTPRoutes(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return livePtrs(list.Items), nil
+			})}
+		}
 
-		lister.k8sgrpcrouteLister = sharedInformers.Gateway().V1().GRPCRoutes().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1().GRPCRoutes().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.K8sGRPCRoutes) {
+			lister.k8sgrpcrouteLister = sharedInformers.Gateway().V1().GRPCRoutes().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1().GRPCRoutes().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Gateway().V1().GRPCRoutes().Informer(), kubernetes.K8sGRPCRoutes)
+		} else {
+			lister.k8sgrpcrouteLister = liveK8sGRPCRouteLister{newLiveLister(namespace, kubernetes.K8sGRPCRoutes, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*gatewayapi_v1.GRPCRoute, error) {
+				list, err := c.client.GatewayAPI().GatewayV1().GRPCRoutes(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return livePtrs(list.Items), nil
+			})}
+		}
 
-		lister.k8sreferencegrantLister = sharedInformers.Gateway().V1beta1().ReferenceGrants().Lister()
-		lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1beta1().ReferenceGrants().Informer().HasSynced)
+		if c.isTypeCached(kubernetes.K8sReferenceGrants) {
+			lister.k8sreferencegrantLister = sharedInformers.Gateway().V1beta1().ReferenceGrants().Lister()
+			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1beta1().ReferenceGrants().Informer().HasSynced)
+			c.watchIstioConfig(namespace, sharedInformers.Gateway().V1beta1().ReferenceGrants().Informer(), kubernetes.K8sReferenceGrants)
+		} else {
+			lister.k8sreferencegrantLister = liveK8sReferenceGrantLister{newLiveLister(namespace, kubernetes.K8sReferenceGrants, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*gatewayapi_v1beta1.ReferenceGrant, error) {
+				list, err := c.client.GatewayAPI().GatewayV1beta1().ReferenceGrants(ns).List(ctx, opts)
+				if err != nil {
+					return nil, err
+				}
+				return livePtrs(list.Items), nil
+			})}
+		}
 		c.hasGatewayAPIStarted = true
 
 		if c.client.IsExpGatewayAPI() {
-			lister.k8stcprouteLister = sharedInformers.Gateway().V1alpha2().TCPRoutes().Lister()
-			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1alpha2().TCPRoutes().Informer().HasSynced)
+			if c.isTypeCached(kubernetes.K8sTCPRoutes) {
+				lister.k8stcprouteLister = sharedInformers.Gateway().V1alpha2().TCPRoutes().Lister()
+				lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1alpha2().TCPRoutes().Informer().HasSynced)
+				c.watchIstioConfig(namespace, sharedInformers.Gateway().V1alpha2().TCPRoutes().Informer(), kubernetes.K8sTCPRoutes)
+			} else {
+				lister.k8stcprouteLister = liveK8sTCPRouteLister{newLiveLister(namespace, kubernetes.K8sTCPRoutes, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*gatewayapi_v1alpha2.TCPRoute, error) {
+					list, err := c.client.GatewayAPI().GatewayV1alpha2().TCPRoutes(ns).List(ctx, opts)
+					if err != nil {
+						return nil, err
+					}
+					return livePtrs(list.Items), nil
+				})}
+			}
 
-			lister.k8stlsrouteLister = sharedInformers.Gateway().V1alpha2().TLSRoutes().Lister()
-			lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1alpha2().TLSRoutes().Informer().HasSynced)
+			if c.isTypeCached(kubernetes.K8sTLSRoutes) {
+				lister.k8stlsrouteLister = sharedInformers.Gateway().V1alpha2().TLSRoutes().Lister()
+				lister.cachesSynced = append(lister.cachesSynced, sharedInformers.Gateway().V1alpha2().TLSRoutes().Informer().HasSynced)
+				c.watchIstioConfig(namespace, sharedInformers.Gateway().V1alpha2().TLSRoutes().Informer(), kubernetes.K8sTLSRoutes)
+			} else {
+				lister.k8stlsrouteLister = liveK8sTLSRouteLister{newLiveLister(namespace, kubernetes.K8sTLSRoutes, func(ctx context.Context, ns string, opts metav1.ListOptions) ([]*gatewayapi_v1alpha2.TLSRoute, error) {
+					list, err := c.client.GatewayAPI().GatewayV1alpha2().TLSRoutes(ns).List(ctx, opts)
+					if err != nil {
+						return nil, err
+					}
+					return livePtrs(list.Items), nil
+				})}
+			}
 			c.hasExpGatewayAPIStarted = true
 		}
 	}
@@ -498,6 +779,7 @@ func (c *kubeCache) createKubernetesInformers(namespace string) informers.Shared
 						Namespace:       obj.Namespace,
 						Labels:          obj.Labels,
 						Annotations:     obj.Annotations,
+						ResourceVersion: obj.ResourceVersion,
 						OwnerReferences: obj.OwnerReferences,
 					},
 					Spec: core_v1.PodSpec{
@@ -546,37 +828,34 @@ func (c *kubeCache) createKubernetesInformers(namespace string) informers.Shared
 
 	sharedInformers := informers.NewSharedInformerFactoryWithOptions(c.client.Kube(), c.refreshDuration, opts...)
 
-	// If this is a namespace-scoped cache, set a watch error handler on all the things we are watching.
-	// If the error is due to the client being forbidden from seeing the resource, this likely means the namespace
+	// The watch errors of all the things we are watching are tracked in the cache stats. If this is a namespace-scoped
+	// cache and the error is due to the client being forbidden from seeing the resource, this likely means the namespace
 	// has been deleted. In this case, we want to disable this namespace-scoped cache since it will not work for any
 	// resource. We add a watch handler on all informers because we don't know which one will be used first after
 	// the namespace deletion and we want to shut the informers down as quickly as we can.
+	var watchErrorHandler func(err error)
 	if namespace != "" {
-		informersToWatchList := []cache.SharedIndexInformer{
-			sharedInformers.Apps().V1().Deployments().Informer(),
-			sharedInformers.Apps().V1().StatefulSets().Informer(),
-			sharedInformers.Apps().V1().DaemonSets().Informer(),
-			sharedInformers.Core().V1().Services().Informer(),
-			sharedInformers.Core().V1().Endpoints().Informer(),
-			sharedInformers.Core().V1().Pods().Informer(),
-			sharedInformers.Apps().V1().ReplicaSets().Informer(),
-			sharedInformers.Core().V1().ConfigMaps().Informer(),
-		}
-
-		watchErrorHandler := func(r *cache.Reflector, err error) {
+		watchErrorHandler = func(err error) {
 			if c.errorHandler != nil {
 				c.errorHandler(c, namespace, err)
 			} else {
 				log.Errorf("Error detected when watching namespace [%v] in cluster [%v]. error: %v", namespace, c.client.ClusterInfo().Name, err)
 			}
 		}
+	}
 
-		for _, informerToWatch := range informersToWatchList {
-			err := informerToWatch.SetWatchErrorHandler(watchErrorHandler)
-			if err != nil {
-				log.Errorf("Failed to install watch error handler for namespace [%v]; will not detect if it gets deleted", namespace)
-			}
-		}
+	informersToWatch := map[string]cache.SharedIndexInformer{
+		kubernetes.DeploymentType:  sharedInformers.Apps().V1().Deployments().Informer(),
+		kubernetes.StatefulSetType: sharedInformers.Apps().V1().StatefulSets().Informer(),
+		kubernetes.DaemonSetType:   sharedInformers.Apps().V1().DaemonSets().Informer(),
+		kubernetes.ServiceType:     sharedInformers.Core().V1().Services().Informer(),
+		kubernetes.EndpointsType:   sharedInformers.Core().V1().Endpoints().Informer(),
+		kubernetes.PodType:         sharedInformers.Core().V1().Pods().Informer(),
+		kubernetes.ReplicaSetType:  sharedInformers.Apps().V1().ReplicaSets().Informer(),
+		kubernetes.ConfigMapType:   sharedInformers.Core().V1().ConfigMaps().Informer(),
+	}
+	for kind, informerToWatch := range informersToWatch {
+		c.stats.watch(namespace, kind, informerToWatch, watchErrorHandler)
 	}
 
 	lister := &cacheLister{
@@ -1107,7 +1386,7 @@ func (c *kubeCache) GetReplicaSets(namespace string) ([]apps_v1.ReplicaSet, erro
 	return result, nil
 }
 
-func (c *kubeCache) GetDestinationRule(namespace, name string) (*networking_v1.DestinationRule, error) {
+func (c *kubeCache) GetDestinationRule(ctx context.Context, namespace, name string) (*networking_v1.DestinationRule, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1116,7 +1395,7 @@ func (c *kubeCache) GetDestinationRule(namespace, name string) (*networking_v1.D
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	dr, err := c.getCacheLister(namespace).destinationRuleLister.DestinationRules(namespace).Get(name)
+	dr, err := withContext(ctx, c.getCacheLister(namespace).destinationRuleLister).DestinationRules(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1128,7 +1407,7 @@ func (c *kubeCache) GetDestinationRule(namespace, name string) (*networking_v1.D
 	return retDR, nil
 }
 
-func (c *kubeCache) GetDestinationRules(namespace, labelSelector string) ([]*networking_v1.DestinationRule, error) {
+func (c *kubeCache) GetDestinationRules(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.DestinationRule, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1146,13 +1425,13 @@ func (c *kubeCache) GetDestinationRules(namespace, labelSelector string) ([]*net
 	drs := []*networking_v1.DestinationRule{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			drs, err = c.clusterCacheLister.destinationRuleLister.List(selector)
+			drs, err = withContext(ctx, c.clusterCacheLister.destinationRuleLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				drsNS, err := nsCacheLister.destinationRuleLister.List(selector)
+				drsNS, err := withContext(ctx, nsCacheLister.destinationRuleLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1160,7 +1439,7 @@ func (c *kubeCache) GetDestinationRules(namespace, labelSelector string) ([]*net
 			}
 		}
 	} else {
-		drs, err = c.getCacheLister(namespace).destinationRuleLister.DestinationRules(namespace).List(selector)
+		drs, err = withContext(ctx, c.getCacheLister(namespace).destinationRuleLister).DestinationRules(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
@@ -1168,6 +1447,9 @@ func (c *kubeCache) GetDestinationRules(namespace, labelSelector string) ([]*net
 
 	// Do not modify what is returned by the lister since that is shared and will cause data races.
 	var retDRs []*networking_v1.DestinationRule
+	if len(drs) > 0 {
+		retDRs = make([]*networking_v1.DestinationRule, 0, len(drs))
+	}
 	for _, dr := range drs {
 		d := dr.DeepCopy()
 		d.Kind = kubernetes.DestinationRules.Kind
@@ -1177,7 +1459,7 @@ func (c *kubeCache) GetDestinationRules(namespace, labelSelector string) ([]*net
 	return retDRs, nil
 }
 
-func (c *kubeCache) GetEnvoyFilter(namespace, name string) (*networking_v1alpha3.EnvoyFilter, error) {
+func (c *kubeCache) GetEnvoyFilter(ctx context.Context, namespace, name string) (*networking_v1alpha3.EnvoyFilter, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1186,7 +1468,7 @@ func (c *kubeCache) GetEnvoyFilter(namespace, name string) (*networking_v1alpha3
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	ef, err := c.getCacheLister(namespace).envoyFilterLister.EnvoyFilters(namespace).Get(name)
+	ef, err := withContext(ctx, c.getCacheLister(namespace).envoyFilterLister).EnvoyFilters(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1198,7 +1480,7 @@ func (c *kubeCache) GetEnvoyFilter(namespace, name string) (*networking_v1alpha3
 	return retEF, nil
 }
 
-func (c *kubeCache) GetEnvoyFilters(namespace, labelSelector string) ([]*networking_v1alpha3.EnvoyFilter, error) {
+func (c *kubeCache) GetEnvoyFilters(ctx context.Context, namespace, labelSelector string) ([]*networking_v1alpha3.EnvoyFilter, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1216,13 +1498,13 @@ func (c *kubeCache) GetEnvoyFilters(namespace, labelSelector string) ([]*network
 	envoyFilters := []*networking_v1alpha3.EnvoyFilter{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			envoyFilters, err = c.clusterCacheLister.envoyFilterLister.List(selector)
+			envoyFilters, err = withContext(ctx, c.clusterCacheLister.envoyFilterLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				filterNamespaced, err := nsCacheLister.envoyFilterLister.List(selector)
+				filterNamespaced, err := withContext(ctx, nsCacheLister.envoyFilterLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1230,13 +1512,16 @@ func (c *kubeCache) GetEnvoyFilters(namespace, labelSelector string) ([]*network
 			}
 		}
 	} else {
-		envoyFilters, err = c.getCacheLister(namespace).envoyFilterLister.EnvoyFilters(namespace).List(selector)
+		envoyFilters, err = withContext(ctx, c.getCacheLister(namespace).envoyFilterLister).EnvoyFilters(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retEnvoyFilters []*networking_v1alpha3.EnvoyFilter
+	if len(envoyFilters) > 0 {
+		retEnvoyFilters = make([]*networking_v1alpha3.EnvoyFilter, 0, len(envoyFilters))
+	}
 	for _, ef := range envoyFilters {
 		efCopy := ef.DeepCopy()
 		efCopy.Kind = kubernetes.EnvoyFilters.Kind
@@ -1246,7 +1531,7 @@ func (c *kubeCache) GetEnvoyFilters(namespace, labelSelector string) ([]*network
 	return retEnvoyFilters, nil
 }
 
-func (c *kubeCache) GetGateway(namespace, name string) (*networking_v1.Gateway, error) {
+func (c *kubeCache) GetGateway(ctx context.Context, namespace, name string) (*networking_v1.Gateway, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1255,7 +1540,7 @@ func (c *kubeCache) GetGateway(namespace, name string) (*networking_v1.Gateway,
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	gw, err := c.getCacheLister(namespace).gatewayLister.Gateways(namespace).Get(name)
+	gw, err := withContext(ctx, c.getCacheLister(namespace).gatewayLister).Gateways(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1266,7 +1551,7 @@ func (c *kubeCache) GetGateway(namespace, name string) (*networking_v1.Gateway,
 	return retGW, nil
 }
 
-func (c *kubeCache) GetGateways(namespace, labelSelector string) ([]*networking_v1.Gateway, error) {
+func (c *kubeCache) GetGateways(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.Gateway, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1284,13 +1569,13 @@ func (c *kubeCache) GetGateways(namespace, labelSelector string) ([]*networking_
 	gateways := []*networking_v1.Gateway{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			gateways, err = c.clusterCacheLister.gatewayLister.List(selector)
+			gateways, err = withContext(ctx, c.clusterCacheLister.gatewayLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				gNS, err := nsCacheLister.gatewayLister.List(selector)
+				gNS, err := withContext(ctx, nsCacheLister.gatewayLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1298,13 +1583,16 @@ func (c *kubeCache) GetGateways(namespace, labelSelector string) ([]*networking_
 			}
 		}
 	} else {
-		gateways, err = c.getCacheLister(namespace).gatewayLister.Gateways(namespace).List(selector)
+		gateways, err = withContext(ctx, c.getCacheLister(namespace).gatewayLister).Gateways(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retGateways []*networking_v1.Gateway
+	if len(gateways) > 0 {
+		retGateways = make([]*networking_v1.Gateway, 0, len(gateways))
+	}
 	for _, gw := range gateways {
 		g := gw.DeepCopy()
 		g.Kind = kubernetes.Gateways.Kind
@@ -1314,7 +1602,7 @@ func (c *kubeCache) GetGateways(namespace, labelSelector string) ([]*networking_
 	return retGateways, nil
 }
 
-func (c *kubeCache) GetServiceEntry(namespace, name string) (*networking_v1.ServiceEntry, error) {
+func (c *kubeCache) GetProxyConfig(ctx context.Context, namespace, name string) (*networking_v1beta1.ProxyConfig, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1323,7 +1611,79 @@ func (c *kubeCache) GetServiceEntry(namespace, name string) (*networking_v1.Serv
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	se, err := c.getCacheLister(namespace).serviceEntryLister.ServiceEntries(namespace).Get(name)
+	pc, err := withContext(ctx, c.getCacheLister(namespace).proxyConfigLister).ProxyConfigs(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Do not modify what is returned by the lister since that is shared and will cause data races.
+	retPC := pc.DeepCopy()
+	retPC.Kind = kubernetes.ProxyConfigs.Kind
+	retPC.APIVersion = kubernetes.ProxyConfigs.GroupVersion().String()
+	return retPC, nil
+}
+
+func (c *kubeCache) GetProxyConfigs(ctx context.Context, namespace, labelSelector string) ([]*networking_v1beta1.ProxyConfig, error) {
+	if err := checkIstioAPIsExist(c.client); err != nil {
+		return nil, err
+	}
+
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read lock will prevent the cache from being refreshed while we are reading from the lister
+	// but it won't prevent other routines from reading from the lister.
+	defer c.cacheLock.RUnlock()
+	c.cacheLock.RLock()
+
+	proxyConfigs := []*networking_v1beta1.ProxyConfig{}
+	if namespace == metav1.NamespaceAll {
+		if c.clusterScoped {
+			proxyConfigs, err = withContext(ctx, c.clusterCacheLister.proxyConfigLister).List(selector)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			for _, nsCacheLister := range c.nsCacheLister {
+				proxyConfigsNamespaced, err := withContext(ctx, nsCacheLister.proxyConfigLister).List(selector)
+				if err != nil {
+					return nil, err
+				}
+				proxyConfigs = append(proxyConfigs, proxyConfigsNamespaced...)
+			}
+		}
+	} else {
+		proxyConfigs, err = withContext(ctx, c.getCacheLister(namespace).proxyConfigLister).ProxyConfigs(namespace).List(selector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var retProxyConfigs []*networking_v1beta1.ProxyConfig
+	if len(proxyConfigs) > 0 {
+		retProxyConfigs = make([]*networking_v1beta1.ProxyConfig, 0, len(proxyConfigs))
+	}
+	for _, pc := range proxyConfigs {
+		pcCopy := pc.DeepCopy()
+		pcCopy.Kind = kubernetes.ProxyConfigs.Kind
+		pcCopy.APIVersion = kubernetes.ProxyConfigs.GroupVersion().String()
+		retProxyConfigs = append(retProxyConfigs, pcCopy)
+	}
+	return retProxyConfigs, nil
+}
+
+func (c *kubeCache) GetServiceEntry(ctx context.Context, namespace, name string) (*networking_v1.ServiceEntry, error) {
+	if err := checkIstioAPIsExist(c.client); err != nil {
+		return nil, err
+	}
+
+	// Read lock will prevent the cache from being refreshed while we are reading from the lister
+	// but it won't prevent other routines from reading from the lister.
+	defer c.cacheLock.RUnlock()
+	c.cacheLock.RLock()
+	se, err := withContext(ctx, c.getCacheLister(namespace).serviceEntryLister).ServiceEntries(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1334,7 +1694,7 @@ func (c *kubeCache) GetServiceEntry(namespace, name string) (*networking_v1.Serv
 	return retSE, nil
 }
 
-func (c *kubeCache) GetServiceEntries(namespace, labelSelector string) ([]*networking_v1.ServiceEntry, error) {
+func (c *kubeCache) GetServiceEntries(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.ServiceEntry, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1352,13 +1712,13 @@ func (c *kubeCache) GetServiceEntries(namespace, labelSelector string) ([]*netwo
 	serviceEntries := []*networking_v1.ServiceEntry{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			serviceEntries, err = c.clusterCacheLister.serviceEntryLister.List(selector)
+			serviceEntries, err = withContext(ctx, c.clusterCacheLister.serviceEntryLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				serviceEntriesNamespaced, err := nsCacheLister.serviceEntryLister.List(selector)
+				serviceEntriesNamespaced, err := withContext(ctx, nsCacheLister.serviceEntryLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1366,13 +1726,16 @@ func (c *kubeCache) GetServiceEntries(namespace, labelSelector string) ([]*netwo
 			}
 		}
 	} else {
-		serviceEntries, err = c.getCacheLister(namespace).serviceEntryLister.ServiceEntries(namespace).List(selector)
+		serviceEntries, err = withContext(ctx, c.getCacheLister(namespace).serviceEntryLister).ServiceEntries(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retSEs []*networking_v1.ServiceEntry
+	if len(serviceEntries) > 0 {
+		retSEs = make([]*networking_v1.ServiceEntry, 0, len(serviceEntries))
+	}
 	for _, se := range serviceEntries {
 		s := se.DeepCopy()
 		s.Kind = kubernetes.ServiceEntries.Kind
@@ -1382,7 +1745,7 @@ func (c *kubeCache) GetServiceEntries(namespace, labelSelector string) ([]*netwo
 	return retSEs, nil
 }
 
-func (c *kubeCache) GetSidecar(namespace, name string) (*networking_v1.Sidecar, error) {
+func (c *kubeCache) GetSidecar(ctx context.Context, namespace, name string) (*networking_v1.Sidecar, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1391,7 +1754,7 @@ func (c *kubeCache) GetSidecar(namespace, name string) (*networking_v1.Sidecar,
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	sc, err := c.getCacheLister(namespace).sidecarLister.Sidecars(namespace).Get(name)
+	sc, err := withContext(ctx, c.getCacheLister(namespace).sidecarLister).Sidecars(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1402,7 +1765,7 @@ func (c *kubeCache) GetSidecar(namespace, name string) (*networking_v1.Sidecar,
 	return retSC, nil
 }
 
-func (c *kubeCache) GetSidecars(namespace, labelSelector string) ([]*networking_v1.Sidecar, error) {
+func (c *kubeCache) GetSidecars(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.Sidecar, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1420,13 +1783,13 @@ func (c *kubeCache) GetSidecars(namespace, labelSelector string) ([]*networking_
 	sidecars := []*networking_v1.Sidecar{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			sidecars, err = c.clusterCacheLister.sidecarLister.List(selector)
+			sidecars, err = withContext(ctx, c.clusterCacheLister.sidecarLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				sidecarsNamespaced, err := nsCacheLister.sidecarLister.List(selector)
+				sidecarsNamespaced, err := withContext(ctx, nsCacheLister.sidecarLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1434,13 +1797,16 @@ func (c *kubeCache) GetSidecars(namespace, labelSelector string) ([]*networking_
 			}
 		}
 	} else {
-		sidecars, err = c.getCacheLister(namespace).sidecarLister.Sidecars(namespace).List(selector)
+		sidecars, err = withContext(ctx, c.getCacheLister(namespace).sidecarLister).Sidecars(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retSC []*networking_v1.Sidecar
+	if len(sidecars) > 0 {
+		retSC = make([]*networking_v1.Sidecar, 0, len(sidecars))
+	}
 	for _, sc := range sidecars {
 		s := sc.DeepCopy()
 		s.Kind = kubernetes.Sidecars.Kind
@@ -1450,7 +1816,7 @@ func (c *kubeCache) GetSidecars(namespace, labelSelector string) ([]*networking_
 	return retSC, nil
 }
 
-func (c *kubeCache) GetVirtualService(namespace, name string) (*networking_v1.VirtualService, error) {
+func (c *kubeCache) GetVirtualService(ctx context.Context, namespace, name string) (*networking_v1.VirtualService, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1459,7 +1825,7 @@ func (c *kubeCache) GetVirtualService(namespace, name string) (*networking_v1.Vi
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	vs, err := c.getCacheLister(namespace).virtualServiceLister.VirtualServices(namespace).Get(name)
+	vs, err := withContext(ctx, c.getCacheLister(namespace).virtualServiceLister).VirtualServices(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1470,7 +1836,7 @@ func (c *kubeCache) GetVirtualService(namespace, name string) (*networking_v1.Vi
 	return retVS, nil
 }
 
-func (c *kubeCache) GetVirtualServices(namespace, labelSelector string) ([]*networking_v1.VirtualService, error) {
+func (c *kubeCache) GetVirtualServices(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.VirtualService, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1488,13 +1854,13 @@ func (c *kubeCache) GetVirtualServices(namespace, labelSelector string) ([]*netw
 	vs := []*networking_v1.VirtualService{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			vs, err = c.clusterCacheLister.virtualServiceLister.List(selector)
+			vs, err = withContext(ctx, c.clusterCacheLister.virtualServiceLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				vsNS, err := nsCacheLister.virtualServiceLister.List(selector)
+				vsNS, err := withContext(ctx, nsCacheLister.virtualServiceLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1502,13 +1868,16 @@ func (c *kubeCache) GetVirtualServices(namespace, labelSelector string) ([]*netw
 			}
 		}
 	} else {
-		vs, err = c.getCacheLister(namespace).virtualServiceLister.VirtualServices(namespace).List(selector)
+		vs, err = withContext(ctx, c.getCacheLister(namespace).virtualServiceLister).VirtualServices(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retVS []*networking_v1.VirtualService
+	if len(vs) > 0 {
+		retVS = make([]*networking_v1.VirtualService, 0, len(vs))
+	}
 	for _, v := range vs {
 		vv := v.DeepCopy()
 		vv.Kind = kubernetes.VirtualServices.Kind
@@ -1518,7 +1887,7 @@ func (c *kubeCache) GetVirtualServices(namespace, labelSelector string) ([]*netw
 	return retVS, nil
 }
 
-func (c *kubeCache) GetWorkloadEntry(namespace, name string) (*networking_v1.WorkloadEntry, error) {
+func (c *kubeCache) GetWorkloadEntry(ctx context.Context, namespace, name string) (*networking_v1.WorkloadEntry, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1527,7 +1896,7 @@ func (c *kubeCache) GetWorkloadEntry(namespace, name string) (*networking_v1.Wor
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	we, err := c.getCacheLister(namespace).workloadEntryLister.WorkloadEntries(namespace).Get(name)
+	we, err := withContext(ctx, c.getCacheLister(namespace).workloadEntryLister).WorkloadEntries(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1538,7 +1907,7 @@ func (c *kubeCache) GetWorkloadEntry(namespace, name string) (*networking_v1.Wor
 	return retWE, nil
 }
 
-func (c *kubeCache) GetWorkloadEntries(namespace, labelSelector string) ([]*networking_v1.WorkloadEntry, error) {
+func (c *kubeCache) GetWorkloadEntries(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.WorkloadEntry, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1557,13 +1926,13 @@ func (c *kubeCache) GetWorkloadEntries(namespace, labelSelector string) ([]*netw
 	// fetch all WorkloadEntries to filter later, based on WorkloadEntry.Spec.Labels
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			workloadEntries, err = c.clusterCacheLister.workloadEntryLister.List(labels.Everything())
+			workloadEntries, err = withContext(ctx, c.clusterCacheLister.workloadEntryLister).List(labels.Everything())
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				workloadEntriesNamespaced, err := nsCacheLister.workloadEntryLister.List(labels.Everything())
+				workloadEntriesNamespaced, err := withContext(ctx, nsCacheLister.workloadEntryLister).List(labels.Everything())
 				if err != nil {
 					return nil, err
 				}
@@ -1571,13 +1940,16 @@ func (c *kubeCache) GetWorkloadEntries(namespace, labelSelector string) ([]*netw
 			}
 		}
 	} else {
-		workloadEntries, err = c.getCacheLister(namespace).workloadEntryLister.WorkloadEntries(namespace).List(labels.Everything())
+		workloadEntries, err = withContext(ctx, c.getCacheLister(namespace).workloadEntryLister).WorkloadEntries(namespace).List(labels.Everything())
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retWE []*networking_v1.WorkloadEntry
+	if len(workloadEntries) > 0 {
+		retWE = make([]*networking_v1.WorkloadEntry, 0, len(workloadEntries))
+	}
 	for _, w := range workloadEntries {
 		if w.Spec.Labels == nil || selector.Matches(labels.Set(w.Spec.Labels)) {
 			ww := w.DeepCopy()
@@ -1589,7 +1961,7 @@ func (c *kubeCache) GetWorkloadEntries(namespace, labelSelector string) ([]*netw
 	return retWE, nil
 }
 
-func (c *kubeCache) GetWorkloadGroup(namespace, name string) (*networking_v1.WorkloadGroup, error) {
+func (c *kubeCache) GetWorkloadGroup(ctx context.Context, namespace, name string) (*networking_v1.WorkloadGroup, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1598,7 +1970,7 @@ func (c *kubeCache) GetWorkloadGroup(namespace, name string) (*networking_v1.Wor
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	wg, err := c.getCacheLister(namespace).workloadGroupLister.WorkloadGroups(namespace).Get(name)
+	wg, err := withContext(ctx, c.getCacheLister(namespace).workloadGroupLister).WorkloadGroups(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1609,7 +1981,7 @@ func (c *kubeCache) GetWorkloadGroup(namespace, name string) (*networking_v1.Wor
 	return retWG, nil
 }
 
-func (c *kubeCache) GetWorkloadGroups(namespace, labelSelector string) ([]*networking_v1.WorkloadGroup, error) {
+func (c *kubeCache) GetWorkloadGroups(ctx context.Context, namespace, labelSelector string) ([]*networking_v1.WorkloadGroup, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1628,13 +2000,13 @@ func (c *kubeCache) GetWorkloadGroups(namespace, labelSelector string) ([]*netwo
 	// fetch all WorkloadGroups to filter later, based on WorkloadGroup.Spec.Metadata.Labels
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			workloadGroups, err = c.clusterCacheLister.workloadGroupLister.List(labels.Everything())
+			workloadGroups, err = withContext(ctx, c.clusterCacheLister.workloadGroupLister).List(labels.Everything())
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				workloadGroupsNamespaced, err := nsCacheLister.workloadGroupLister.List(labels.Everything())
+				workloadGroupsNamespaced, err := withContext(ctx, nsCacheLister.workloadGroupLister).List(labels.Everything())
 				if err != nil {
 					return nil, err
 				}
@@ -1642,13 +2014,16 @@ func (c *kubeCache) GetWorkloadGroups(namespace, labelSelector string) ([]*netwo
 			}
 		}
 	} else {
-		workloadGroups, err = c.getCacheLister(namespace).workloadGroupLister.WorkloadGroups(namespace).List(labels.Everything())
+		workloadGroups, err = withContext(ctx, c.getCacheLister(namespace).workloadGroupLister).WorkloadGroups(namespace).List(labels.Everything())
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retWG []*networking_v1.WorkloadGroup
+	if len(workloadGroups) > 0 {
+		retWG = make([]*networking_v1.WorkloadGroup, 0, len(workloadGroups))
+	}
 	for _, w := range workloadGroups {
 		if w.Spec.Metadata == nil || w.Spec.Metadata.Labels == nil || selector.Matches(labels.Set(w.Spec.Metadata.Labels)) {
 			ww := w.DeepCopy()
@@ -1660,7 +2035,7 @@ func (c *kubeCache) GetWorkloadGroups(namespace, labelSelector string) ([]*netwo
 	return retWG, nil
 }
 
-func (c *kubeCache) GetWasmPlugin(namespace, name string) (*extentions_v1alpha1.WasmPlugin, error) {
+func (c *kubeCache) GetWasmPlugin(ctx context.Context, namespace, name string) (*extentions_v1alpha1.WasmPlugin, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1669,7 +2044,7 @@ func (c *kubeCache) GetWasmPlugin(namespace, name string) (*extentions_v1alpha1.
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	wp, err := c.getCacheLister(namespace).wasmPluginLister.WasmPlugins(namespace).Get(name)
+	wp, err := withContext(ctx, c.getCacheLister(namespace).wasmPluginLister).WasmPlugins(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1680,7 +2055,7 @@ func (c *kubeCache) GetWasmPlugin(namespace, name string) (*extentions_v1alpha1.
 	return retWP, nil
 }
 
-func (c *kubeCache) GetWasmPlugins(namespace, labelSelector string) ([]*extentions_v1alpha1.WasmPlugin, error) {
+func (c *kubeCache) GetWasmPlugins(ctx context.Context, namespace, labelSelector string) ([]*extentions_v1alpha1.WasmPlugin, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1698,13 +2073,13 @@ func (c *kubeCache) GetWasmPlugins(namespace, labelSelector string) ([]*extentio
 	wasmPlugins := []*extentions_v1alpha1.WasmPlugin{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			wasmPlugins, err = c.clusterCacheLister.wasmPluginLister.List(selector)
+			wasmPlugins, err = withContext(ctx, c.clusterCacheLister.wasmPluginLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				wasmPluginsNamespaced, err := nsCacheLister.wasmPluginLister.List(selector)
+				wasmPluginsNamespaced, err := withContext(ctx, nsCacheLister.wasmPluginLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1712,13 +2087,16 @@ func (c *kubeCache) GetWasmPlugins(namespace, labelSelector string) ([]*extentio
 			}
 		}
 	} else {
-		wasmPlugins, err = c.getCacheLister(namespace).wasmPluginLister.WasmPlugins(namespace).List(selector)
+		wasmPlugins, err = withContext(ctx, c.getCacheLister(namespace).wasmPluginLister).WasmPlugins(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retWP []*extentions_v1alpha1.WasmPlugin
+	if len(wasmPlugins) > 0 {
+		retWP = make([]*extentions_v1alpha1.WasmPlugin, 0, len(wasmPlugins))
+	}
 	for _, wp := range wasmPlugins {
 		ww := wp.DeepCopy()
 		ww.Kind = kubernetes.WasmPlugins.Kind
@@ -1728,7 +2106,7 @@ func (c *kubeCache) GetWasmPlugins(namespace, labelSelector string) ([]*extentio
 	return retWP, nil
 }
 
-func (c *kubeCache) GetTelemetry(namespace, name string) (*telemetry_v1.Telemetry, error) {
+func (c *kubeCache) GetTelemetry(ctx context.Context, namespace, name string) (*telemetry_v1.Telemetry, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1737,7 +2115,7 @@ func (c *kubeCache) GetTelemetry(namespace, name string) (*telemetry_v1.Telemetr
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	t, err := c.getCacheLister(namespace).telemetryLister.Telemetries(namespace).Get(name)
+	t, err := withContext(ctx, c.getCacheLister(namespace).telemetryLister).Telemetries(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1748,7 +2126,7 @@ func (c *kubeCache) GetTelemetry(namespace, name string) (*telemetry_v1.Telemetr
 	return retT, nil
 }
 
-func (c *kubeCache) GetTelemetries(namespace, labelSelector string) ([]*telemetry_v1.Telemetry, error) {
+func (c *kubeCache) GetTelemetries(ctx context.Context, namespace, labelSelector string) ([]*telemetry_v1.Telemetry, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1766,13 +2144,13 @@ func (c *kubeCache) GetTelemetries(namespace, labelSelector string) ([]*telemetr
 	telemetries := []*telemetry_v1.Telemetry{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			telemetries, err = c.clusterCacheLister.telemetryLister.List(selector)
+			telemetries, err = withContext(ctx, c.clusterCacheLister.telemetryLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				telemetriesNamespaced, err := nsCacheLister.telemetryLister.List(selector)
+				telemetriesNamespaced, err := withContext(ctx, nsCacheLister.telemetryLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1780,13 +2158,16 @@ func (c *kubeCache) GetTelemetries(namespace, labelSelector string) ([]*telemetr
 			}
 		}
 	} else {
-		telemetries, err = c.getCacheLister(namespace).telemetryLister.Telemetries(namespace).List(selector)
+		telemetries, err = withContext(ctx, c.getCacheLister(namespace).telemetryLister).Telemetries(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retTelemetries []*telemetry_v1.Telemetry
+	if len(telemetries) > 0 {
+		retTelemetries = make([]*telemetry_v1.Telemetry, 0, len(telemetries))
+	}
 	for _, t := range telemetries {
 		tt := t.DeepCopy()
 		tt.Kind = kubernetes.Telemetries.Kind
@@ -1810,7 +2191,7 @@ func (c *kubeCache) isK8sExpGatewayListerInit(namespace string) bool {
 	return c.hasExpGatewayAPIStarted
 }
 
-func (c *kubeCache) GetK8sGateway(namespace, name string) (*gatewayapi_v1.Gateway, error) {
+func (c *kubeCache) GetK8sGateway(ctx context.Context, namespace, name string) (*gatewayapi_v1.Gateway, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1821,7 +2202,7 @@ func (c *kubeCache) GetK8sGateway(namespace, name string) (*gatewayapi_v1.Gatewa
 	if !c.isK8sGatewayListerInit(namespace) {
 		return nil, errors.New(K8sGatewayAPIMessage)
 	}
-	g, err := c.getCacheLister(namespace).k8sgatewayLister.Gateways(namespace).Get(name)
+	g, err := withContext(ctx, c.getCacheLister(namespace).k8sgatewayLister).Gateways(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1832,7 +2213,7 @@ func (c *kubeCache) GetK8sGateway(namespace, name string) (*gatewayapi_v1.Gatewa
 	return retG, nil
 }
 
-func (c *kubeCache) GetK8sGateways(namespace, labelSelector string) ([]*gatewayapi_v1.Gateway, error) {
+func (c *kubeCache) GetK8sGateways(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1.Gateway, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1852,13 +2233,13 @@ func (c *kubeCache) GetK8sGateways(namespace, labelSelector string) ([]*gatewaya
 	}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			k8sGateways, err = c.clusterCacheLister.k8sgatewayLister.List(selector)
+			k8sGateways, err = withContext(ctx, c.clusterCacheLister.k8sgatewayLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				gatewaysNamespaced, err := nsCacheLister.k8sgatewayLister.List(selector)
+				gatewaysNamespaced, err := withContext(ctx, nsCacheLister.k8sgatewayLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1866,13 +2247,16 @@ func (c *kubeCache) GetK8sGateways(namespace, labelSelector string) ([]*gatewaya
 			}
 		}
 	} else {
-		k8sGateways, err = c.getCacheLister(namespace).k8sgatewayLister.Gateways(namespace).List(selector)
+		k8sGateways, err = withContext(ctx, c.getCacheLister(namespace).k8sgatewayLister).Gateways(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retK8sGateways []*gatewayapi_v1.Gateway
+	if len(k8sGateways) > 0 {
+		retK8sGateways = make([]*gatewayapi_v1.Gateway, 0, len(k8sGateways))
+	}
 	for _, gw := range k8sGateways {
 		ggw := gw.DeepCopy()
 		ggw.Kind = kubernetes.K8sGateways.Kind
@@ -1882,7 +2266,7 @@ func (c *kubeCache) GetK8sGateways(namespace, labelSelector string) ([]*gatewaya
 	return retK8sGateways, nil
 }
 
-func (c *kubeCache) GetK8sGRPCRoute(namespace, name string) (*gatewayapi_v1.GRPCRoute, error) {
+func (c *kubeCache) GetK8sGRPCRoute(ctx context.Context, namespace, name string) (*gatewayapi_v1.GRPCRoute, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1894,7 +2278,7 @@ func (c *kubeCache) GetK8sGRPCRoute(namespace, name string) (*gatewayapi_v1.GRPC
 	if !c.isK8sExpGatewayListerInit(namespace) {
 		return nil, errors.New(K8sExpGatewayAPIMessage)
 	}
-	g, err := c.getCacheLister(namespace).k8sgrpcrouteLister.GRPCRoutes(namespace).Get(name)
+	g, err := withContext(ctx, c.getCacheLister(namespace).k8sgrpcrouteLister).GRPCRoutes(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1905,7 +2289,7 @@ func (c *kubeCache) GetK8sGRPCRoute(namespace, name string) (*gatewayapi_v1.GRPC
 	return retG, nil
 }
 
-func (c *kubeCache) GetK8sGRPCRoutes(namespace, labelSelector string) ([]*gatewayapi_v1.GRPCRoute, error) {
+func (c *kubeCache) GetK8sGRPCRoutes(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1.GRPCRoute, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1924,13 +2308,13 @@ func (c *kubeCache) GetK8sGRPCRoutes(namespace, labelSelector string) ([]*gatewa
 	}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			k8sGRPCRoutes, err = c.clusterCacheLister.k8sgrpcrouteLister.List(selector)
+			k8sGRPCRoutes, err = withContext(ctx, c.clusterCacheLister.k8sgrpcrouteLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				grpcRoutesNamespaced, err := nsCacheLister.k8sgrpcrouteLister.List(selector)
+				grpcRoutesNamespaced, err := withContext(ctx, nsCacheLister.k8sgrpcrouteLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -1938,13 +2322,16 @@ func (c *kubeCache) GetK8sGRPCRoutes(namespace, labelSelector string) ([]*gatewa
 			}
 		}
 	} else {
-		k8sGRPCRoutes, err = c.getCacheLister(namespace).k8sgrpcrouteLister.GRPCRoutes(namespace).List(selector)
+		k8sGRPCRoutes, err = withContext(ctx, c.getCacheLister(namespace).k8sgrpcrouteLister).GRPCRoutes(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retK8sGRPCRoutes []*gatewayapi_v1.GRPCRoute
+	if len(k8sGRPCRoutes) > 0 {
+		retK8sGRPCRoutes = make([]*gatewayapi_v1.GRPCRoute, 0, len(k8sGRPCRoutes))
+	}
 	for _, hr := range k8sGRPCRoutes {
 		hrCopy := hr.DeepCopy()
 		hrCopy.Kind = kubernetes.K8sGRPCRoutes.Kind
@@ -1954,7 +2341,7 @@ func (c *kubeCache) GetK8sGRPCRoutes(namespace, labelSelector string) ([]*gatewa
 	return retK8sGRPCRoutes, nil
 }
 
-func (c *kubeCache) GetK8sHTTPRoute(namespace, name string) (*gatewayapi_v1.HTTPRoute, error) {
+func (c *kubeCache) GetK8sHTTPRoute(ctx context.Context, namespace, name string) (*gatewayapi_v1.HTTPRoute, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1966,7 +2353,7 @@ func (c *kubeCache) GetK8sHTTPRoute(namespace, name string) (*gatewayapi_v1.HTTP
 	if !c.isK8sGatewayListerInit(namespace) {
 		return nil, errors.New(K8sGatewayAPIMessage)
 	}
-	g, err := c.getCacheLister(namespace).k8shttprouteLister.HTTPRoutes(namespace).Get(name)
+	g, err := withContext(ctx, c.getCacheLister(namespace).k8shttprouteLister).HTTPRoutes(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -1977,7 +2364,7 @@ func (c *kubeCache) GetK8sHTTPRoute(namespace, name string) (*gatewayapi_v1.HTTP
 	return retG, nil
 }
 
-func (c *kubeCache) GetK8sHTTPRoutes(namespace, labelSelector string) ([]*gatewayapi_v1.HTTPRoute, error) {
+func (c *kubeCache) GetK8sHTTPRoutes(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1.HTTPRoute, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -1996,13 +2383,13 @@ func (c *kubeCache) GetK8sHTTPRoutes(namespace, labelSelector string) ([]*gatewa
 	}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			k8sHTTPRoutes, err = c.clusterCacheLister.k8shttprouteLister.List(selector)
+			k8sHTTPRoutes, err = withContext(ctx, c.clusterCacheLister.k8shttprouteLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				httpRoutesNamespaced, err := nsCacheLister.k8shttprouteLister.List(selector)
+				httpRoutesNamespaced, err := withContext(ctx, nsCacheLister.k8shttprouteLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -2010,13 +2397,16 @@ func (c *kubeCache) GetK8sHTTPRoutes(namespace, labelSelector string) ([]*gatewa
 			}
 		}
 	} else {
-		k8sHTTPRoutes, err = c.getCacheLister(namespace).k8shttprouteLister.HTTPRoutes(namespace).List(selector)
+		k8sHTTPRoutes, err = withContext(ctx, c.getCacheLister(namespace).k8shttprouteLister).HTTPRoutes(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retK8sHTTPRoutes []*gatewayapi_v1.HTTPRoute
+	if len(k8sHTTPRoutes) > 0 {
+		retK8sHTTPRoutes = make([]*gatewayapi_v1.HTTPRoute, 0, len(k8sHTTPRoutes))
+	}
 	for _, hr := range k8sHTTPRoutes {
 		hrCopy := hr.DeepCopy()
 		hrCopy.Kind = kubernetes.K8sHTTPRoutes.Kind
@@ -2026,7 +2416,7 @@ func (c *kubeCache) GetK8sHTTPRoutes(namespace, labelSelector string) ([]*gatewa
 	return retK8sHTTPRoutes, nil
 }
 
-func (c *kubeCache) GetK8sReferenceGrant(namespace, name string) (*gatewayapi_v1beta1.ReferenceGrant, error) {
+func (c *kubeCache) GetK8sReferenceGrant(ctx context.Context, namespace, name string) (*gatewayapi_v1beta1.ReferenceGrant, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2038,7 +2428,7 @@ func (c *kubeCache) GetK8sReferenceGrant(namespace, name string) (*gatewayapi_v1
 	if !c.isK8sGatewayListerInit(namespace) {
 		return nil, errors.New(K8sGatewayAPIMessage)
 	}
-	g, err := c.getCacheLister(namespace).k8sreferencegrantLister.ReferenceGrants(namespace).Get(name)
+	g, err := withContext(ctx, c.getCacheLister(namespace).k8sreferencegrantLister).ReferenceGrants(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -2049,7 +2439,7 @@ func (c *kubeCache) GetK8sReferenceGrant(namespace, name string) (*gatewayapi_v1
 	return retG, nil
 }
 
-func (c *kubeCache) GetK8sReferenceGrants(namespace, labelSelector string) ([]*gatewayapi_v1beta1.ReferenceGrant, error) {
+func (c *kubeCache) GetK8sReferenceGrants(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1beta1.ReferenceGrant, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2068,13 +2458,13 @@ func (c *kubeCache) GetK8sReferenceGrants(namespace, labelSelector string) ([]*g
 	}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			k8sReferenceGrants, err = c.clusterCacheLister.k8sreferencegrantLister.List(selector)
+			k8sReferenceGrants, err = withContext(ctx, c.clusterCacheLister.k8sreferencegrantLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				referenceGrantsNamespaced, err := nsCacheLister.k8sreferencegrantLister.List(selector)
+				referenceGrantsNamespaced, err := withContext(ctx, nsCacheLister.k8sreferencegrantLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -2082,13 +2472,16 @@ func (c *kubeCache) GetK8sReferenceGrants(namespace, labelSelector string) ([]*g
 			}
 		}
 	} else {
-		k8sReferenceGrants, err = c.getCacheLister(namespace).k8sreferencegrantLister.ReferenceGrants(namespace).List(selector)
+		k8sReferenceGrants, err = withContext(ctx, c.getCacheLister(namespace).k8sreferencegrantLister).ReferenceGrants(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retK8sReferenceGrants []*gatewayapi_v1beta1.ReferenceGrant
+	if len(k8sReferenceGrants) > 0 {
+		retK8sReferenceGrants = make([]*gatewayapi_v1beta1.ReferenceGrant, 0, len(k8sReferenceGrants))
+	}
 	for _, hr := range k8sReferenceGrants {
 		hrCopy := hr.DeepCopy()
 		hrCopy.Kind = kubernetes.K8sReferenceGrants.Kind
@@ -2098,7 +2491,7 @@ func (c *kubeCache) GetK8sReferenceGrants(namespace, labelSelector string) ([]*g
 	return retK8sReferenceGrants, nil
 }
 
-func (c *kubeCache) GetK8sTCPRoute(namespace, name string) (*gatewayapi_v1alpha2.TCPRoute, error) {
+func (c *kubeCache) GetK8sTCPRoute(ctx context.Context, namespace, name string) (*gatewayapi_v1alpha2.TCPRoute, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2110,7 +2503,7 @@ func (c *kubeCache) GetK8sTCPRoute(namespace, name string) (*gatewayapi_v1alpha2
 	if !c.isK8sExpGatewayListerInit(namespace) {
 		return nil, errors.New(K8sExpGatewayAPIMessage)
 	}
-	g, err := c.getCacheLister(namespace).k8stcprouteLister.TCPRoutes(namespace).Get(name)
+	g, err := withContext(ctx, c.getCacheLister(namespace).k8stcprouteLister).TCPRoutes(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -2121,7 +2514,7 @@ func (c *kubeCache) GetK8sTCPRoute(namespace, name string) (*gatewayapi_v1alpha2
 	return retG, nil
 }
 
-func (c *kubeCache) GetK8sTCPRoutes(namespace, labelSelector string) ([]*gatewayapi_v1alpha2.TCPRoute, error) {
+func (c *kubeCache) GetK8sTCPRoutes(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1alpha2.TCPRoute, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2140,13 +2533,13 @@ func (c *kubeCache) GetK8sTCPRoutes(namespace, labelSelector string) ([]*gateway
 	}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			k8sTCPRoutes, err = c.clusterCacheLister.k8stcprouteLister.List(selector)
+			k8sTCPRoutes, err = withContext(ctx, c.clusterCacheLister.k8stcprouteLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				tcpRoutesNamespaced, err := nsCacheLister.k8stcprouteLister.List(selector)
+				tcpRoutesNamespaced, err := withContext(ctx, nsCacheLister.k8stcprouteLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -2154,13 +2547,16 @@ func (c *kubeCache) GetK8sTCPRoutes(namespace, labelSelector string) ([]*gateway
 			}
 		}
 	} else {
-		k8sTCPRoutes, err = c.getCacheLister(namespace).k8stcprouteLister.TCPRoutes(namespace).List(selector)
+		k8sTCPRoutes, err = withContext(ctx, c.getCacheLister(namespace).k8stcprouteLister).TCPRoutes(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retK8sTCPRoutes []*gatewayapi_v1alpha2.TCPRoute
+	if len(k8sTCPRoutes) > 0 {
+		retK8sTCPRoutes = make([]*gatewayapi_v1alpha2.TCPRoute, 0, len(k8sTCPRoutes))
+	}
 	for _, hr := range k8sTCPRoutes {
 		hrCopy := hr.DeepCopy()
 		hrCopy.Kind = kubernetes.K8sTCPRoutes.Kind
@@ -2170,7 +2566,7 @@ func (c *kubeCache) GetK8sTCPRoutes(namespace, labelSelector string) ([]*gateway
 	return retK8sTCPRoutes, nil
 }
 
-func (c *kubeCache) GetK8sTLSRoute(namespace, name string) (*gatewayapi_v1alpha2.TLSRoute, error) {
+func (c *kubeCache) GetK8sTLSRoute(ctx context.Context, namespace, name string) (*gatewayapi_v1alpha2.TLSRoute, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2182,7 +2578,7 @@ func (c *kubeCache) GetK8sTLSRoute(namespace, name string) (*gatewayapi_v1alpha2
 	if !c.isK8sExpGatewayListerInit(namespace) {
 		return nil, errors.New(K8sExpGatewayAPIMessage)
 	}
-	g, err := c.getCacheLister(namespace).k8stlsrouteLister.TLSRoutes(namespace).Get(name)
+	g, err := withContext(ctx, c.getCacheLister(namespace).k8stlsrouteLister).TLSRoutes(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -2193,7 +2589,7 @@ func (c *kubeCache) GetK8sTLSRoute(namespace, name string) (*gatewayapi_v1alpha2
 	return retG, nil
 }
 
-func (c *kubeCache) GetK8sTLSRoutes(namespace, labelSelector string) ([]*gatewayapi_v1alpha2.TLSRoute, error) {
+func (c *kubeCache) GetK8sTLSRoutes(ctx context.Context, namespace, labelSelector string) ([]*gatewayapi_v1alpha2.TLSRoute, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2212,13 +2608,13 @@ func (c *kubeCache) GetK8sTLSRoutes(namespace, labelSelector string) ([]*gateway
 	}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			k8sTLSRoutes, err = c.clusterCacheLister.k8stlsrouteLister.List(selector)
+			k8sTLSRoutes, err = withContext(ctx, c.clusterCacheLister.k8stlsrouteLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				grpcRoutesNamespaced, err := nsCacheLister.k8stlsrouteLister.List(selector)
+				grpcRoutesNamespaced, err := withContext(ctx, nsCacheLister.k8stlsrouteLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -2226,13 +2622,16 @@ func (c *kubeCache) GetK8sTLSRoutes(namespace, labelSelector string) ([]*gateway
 			}
 		}
 	} else {
-		k8sTLSRoutes, err = c.getCacheLister(namespace).k8stlsrouteLister.TLSRoutes(namespace).List(selector)
+		k8sTLSRoutes, err = withContext(ctx, c.getCacheLister(namespace).k8stlsrouteLister).TLSRoutes(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retK8sTLSRoutes []*gatewayapi_v1alpha2.TLSRoute
+	if len(k8sTLSRoutes) > 0 {
+		retK8sTLSRoutes = make([]*gatewayapi_v1alpha2.TLSRoute, 0, len(k8sTLSRoutes))
+	}
 	for _, hr := range k8sTLSRoutes {
 		hrCopy := hr.DeepCopy()
 		hrCopy.Kind = kubernetes.K8sTLSRoutes.Kind
@@ -2242,7 +2641,7 @@ func (c *kubeCache) GetK8sTLSRoutes(namespace, labelSelector string) ([]*gateway
 	return retK8sTLSRoutes, nil
 }
 
-func (c *kubeCache) GetAuthorizationPolicy(namespace, name string) (*security_v1.AuthorizationPolicy, error) {
+func (c *kubeCache) GetAuthorizationPolicy(ctx context.Context, namespace, name string) (*security_v1.AuthorizationPolicy, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2251,7 +2650,7 @@ func (c *kubeCache) GetAuthorizationPolicy(namespace, name string) (*security_v1
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	ap, err := c.getCacheLister(namespace).authzLister.AuthorizationPolicies(namespace).Get(name)
+	ap, err := withContext(ctx, c.getCacheLister(namespace).authzLister).AuthorizationPolicies(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -2262,7 +2661,7 @@ func (c *kubeCache) GetAuthorizationPolicy(namespace, name string) (*security_v1
 	return retAP, nil
 }
 
-func (c *kubeCache) GetAuthorizationPolicies(namespace, labelSelector string) ([]*security_v1.AuthorizationPolicy, error) {
+func (c *kubeCache) GetAuthorizationPolicies(ctx context.Context, namespace, labelSelector string) ([]*security_v1.AuthorizationPolicy, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2280,13 +2679,13 @@ func (c *kubeCache) GetAuthorizationPolicies(namespace, labelSelector string) ([
 	authorizationPolicies := []*security_v1.AuthorizationPolicy{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			authorizationPolicies, err = c.clusterCacheLister.authzLister.List(selector)
+			authorizationPolicies, err = withContext(ctx, c.clusterCacheLister.authzLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				policiesNamespaced, err := nsCacheLister.authzLister.List(selector)
+				policiesNamespaced, err := withContext(ctx, nsCacheLister.authzLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -2294,13 +2693,16 @@ func (c *kubeCache) GetAuthorizationPolicies(namespace, labelSelector string) ([
 			}
 		}
 	} else {
-		authorizationPolicies, err = c.getCacheLister(namespace).authzLister.AuthorizationPolicies(namespace).List(selector)
+		authorizationPolicies, err = withContext(ctx, c.getCacheLister(namespace).authzLister).AuthorizationPolicies(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retAuthorizationPolicies []*security_v1.AuthorizationPolicy
+	if len(authorizationPolicies) > 0 {
+		retAuthorizationPolicies = make([]*security_v1.AuthorizationPolicy, 0, len(authorizationPolicies))
+	}
 	for _, ap := range authorizationPolicies {
 		apCopy := ap.DeepCopy()
 		apCopy.Kind = kubernetes.AuthorizationPolicies.Kind
@@ -2310,7 +2712,7 @@ func (c *kubeCache) GetAuthorizationPolicies(namespace, labelSelector string) ([
 	return retAuthorizationPolicies, nil
 }
 
-func (c *kubeCache) GetPeerAuthentication(namespace, name string) (*security_v1.PeerAuthentication, error) {
+func (c *kubeCache) GetPeerAuthentication(ctx context.Context, namespace, name string) (*security_v1.PeerAuthentication, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2319,7 +2721,7 @@ func (c *kubeCache) GetPeerAuthentication(namespace, name string) (*security_v1.
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	pa, err := c.getCacheLister(namespace).peerAuthnLister.PeerAuthentications(namespace).Get(name)
+	pa, err := withContext(ctx, c.getCacheLister(namespace).peerAuthnLister).PeerAuthentications(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -2330,7 +2732,7 @@ func (c *kubeCache) GetPeerAuthentication(namespace, name string) (*security_v1.
 	return retPA, nil
 }
 
-func (c *kubeCache) GetPeerAuthentications(namespace, labelSelector string) ([]*security_v1.PeerAuthentication, error) {
+func (c *kubeCache) GetPeerAuthentications(ctx context.Context, namespace, labelSelector string) ([]*security_v1.PeerAuthentication, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2348,13 +2750,13 @@ func (c *kubeCache) GetPeerAuthentications(namespace, labelSelector string) ([]*
 	peerAuthentications := []*security_v1.PeerAuthentication{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			peerAuthentications, err = c.clusterCacheLister.peerAuthnLister.List(selector)
+			peerAuthentications, err = withContext(ctx, c.clusterCacheLister.peerAuthnLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				authenticationsNamespaced, err := nsCacheLister.peerAuthnLister.List(selector)
+				authenticationsNamespaced, err := withContext(ctx, nsCacheLister.peerAuthnLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -2362,13 +2764,16 @@ func (c *kubeCache) GetPeerAuthentications(namespace, labelSelector string) ([]*
 			}
 		}
 	} else {
-		peerAuthentications, err = c.getCacheLister(namespace).peerAuthnLister.PeerAuthentications(namespace).List(selector)
+		peerAuthentications, err = withContext(ctx, c.getCacheLister(namespace).peerAuthnLister).PeerAuthentications(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retPeerAuthentications []*security_v1.PeerAuthentication
+	if len(peerAuthentications) > 0 {
+		retPeerAuthentications = make([]*security_v1.PeerAuthentication, 0, len(peerAuthentications))
+	}
 	for _, pa := range peerAuthentications {
 		paCopy := pa.DeepCopy()
 		paCopy.Kind = kubernetes.PeerAuthentications.Kind
@@ -2378,7 +2783,7 @@ func (c *kubeCache) GetPeerAuthentications(namespace, labelSelector string) ([]*
 	return retPeerAuthentications, nil
 }
 
-func (c *kubeCache) GetRequestAuthentication(namespace, name string) (*security_v1.RequestAuthentication, error) {
+func (c *kubeCache) GetRequestAuthentication(ctx context.Context, namespace, name string) (*security_v1.RequestAuthentication, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2387,7 +2792,7 @@ func (c *kubeCache) GetRequestAuthentication(namespace, name string) (*security_
 	// but it won't prevent other routines from reading from the lister.
 	defer c.cacheLock.RUnlock()
 	c.cacheLock.RLock()
-	ra, err := c.getCacheLister(namespace).requestAuthnLister.RequestAuthentications(namespace).Get(name)
+	ra, err := withContext(ctx, c.getCacheLister(namespace).requestAuthnLister).RequestAuthentications(namespace).Get(name)
 	if err != nil {
 		return nil, err
 	}
@@ -2398,7 +2803,7 @@ func (c *kubeCache) GetRequestAuthentication(namespace, name string) (*security_
 	return retRA, nil
 }
 
-func (c *kubeCache) GetRequestAuthentications(namespace, labelSelector string) ([]*security_v1.RequestAuthentication, error) {
+func (c *kubeCache) GetRequestAuthentications(ctx context.Context, namespace, labelSelector string) ([]*security_v1.RequestAuthentication, error) {
 	if err := checkIstioAPIsExist(c.client); err != nil {
 		return nil, err
 	}
@@ -2416,13 +2821,13 @@ func (c *kubeCache) GetRequestAuthentications(namespace, labelSelector string) (
 	requestAuthentications := []*security_v1.RequestAuthentication{}
 	if namespace == metav1.NamespaceAll {
 		if c.clusterScoped {
-			requestAuthentications, err = c.clusterCacheLister.requestAuthnLister.List(selector)
+			requestAuthentications, err = withContext(ctx, c.clusterCacheLister.requestAuthnLister).List(selector)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			for _, nsCacheLister := range c.nsCacheLister {
-				authenticationsNamespaced, err := nsCacheLister.requestAuthnLister.List(selector)
+				authenticationsNamespaced, err := withContext(ctx, nsCacheLister.requestAuthnLister).List(selector)
 				if err != nil {
 					return nil, err
 				}
@@ -2430,13 +2835,16 @@ func (c *kubeCache) GetRequestAuthentications(namespace, labelSelector string) (
 			}
 		}
 	} else {
-		requestAuthentications, err = c.getCacheLister(namespace).requestAuthnLister.RequestAuthentications(namespace).List(selector)
+		requestAuthentications, err = withContext(ctx, c.getCacheLister(namespace).requestAuthnLister).RequestAuthentications(namespace).List(selector)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var retRequestAuthentications []*security_v1.RequestAuthentication
+	if len(requestAuthentications) > 0 {
+		retRequestAuthentications = make([]*security_v1.RequestAuthentication, 0, len(requestAuthentications))
+	}
 	for _, ra := range requestAuthentications {
 		raCopy := ra.DeepCopy()
 		raCopy.Kind = kubernetes.RequestAuthentications.Kind
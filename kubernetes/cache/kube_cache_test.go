@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -9,10 +10,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	security_v1 "istio.io/client-go/pkg/apis/security/v1"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	istiosec_v1_listers "istio.io/client-go/pkg/listers/security/v1"
 	apps_v1 "k8s.io/api/apps/v1"
 	core_v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/kubernetes"
@@ -261,7 +268,7 @@ func TestGetSidecar(t *testing.T) {
 				namespace = tc.namespace
 			}
 
-			objects, err := kubeCache.GetSidecars(namespace, tc.selector)
+			objects, err := kubeCache.GetSidecars(context.TODO(), namespace, tc.selector)
 			if tc.expectedErr != nil {
 				assert.Error(err)
 			} else {
@@ -287,11 +294,11 @@ func TestGetAndListReturnKindInfo(t *testing.T) {
 	cfg := config.NewConfig()
 	kialiCache := newTestingKubeCache(t, cfg, ns, vs)
 
-	vsFromCache, err := kialiCache.GetVirtualService("test", "vs")
+	vsFromCache, err := kialiCache.GetVirtualService(context.TODO(), "test", "vs")
 	require.NoError(err)
 	assert.Equal(kubernetes.VirtualServiceType, vsFromCache.Kind)
 
-	vsListFromCache, err := kialiCache.GetVirtualServices("test", "")
+	vsListFromCache, err := kialiCache.GetVirtualServices(context.TODO(), "test", "")
 	require.NoError(err)
 	for _, vs := range vsListFromCache {
 		assert.Equal(kubernetes.VirtualServiceType, vs.Kind)
@@ -331,7 +338,7 @@ func TestIstioAPIDisabled(t *testing.T) {
 		t.Fatalf("Unable to create kube cache for testing. Err: %s", err)
 	}
 
-	_, err = kubeCache.GetVirtualServices("test", "app=bookinfo")
+	_, err = kubeCache.GetVirtualServices(context.TODO(), "test", "app=bookinfo")
 
 	assert.Error(err)
 }
@@ -364,11 +371,74 @@ deployment:
 
 	kubeCache := newTestingKubeCache(t, cfg, nsAlpha, nsBeta, vsAlpha, vsBeta)
 
-	vsList, err := kubeCache.GetVirtualServices("", "")
+	vsList, err := kubeCache.GetVirtualServices(context.TODO(), "", "")
 	require.NoError(err)
 	assert.Len(vsList, 2)
 }
 
+func TestUncachedIstioConfigIsReadFromTheAPI(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ns := kubetest.FakeNamespace("test")
+	vs := &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "vs", Namespace: "test"}}
+	dr := &networking_v1.DestinationRule{ObjectMeta: metav1.ObjectMeta{Name: "dr", Namespace: "test"}}
+
+	cfg := config.NewConfig()
+	cfg.KubernetesConfig.UncachedIstioConfig = []string{"virtualservice.networking.istio.io"}
+	kubeCache := newTestingKubeCache(t, cfg, ns, vs, dr)
+	assert.False(kubeCache.isTypeCached(kubernetes.VirtualServices))
+	assert.True(kubeCache.isTypeCached(kubernetes.DestinationRules))
+
+	vsFromCache, err := kubeCache.GetVirtualService(context.TODO(), "test", "vs")
+	require.NoError(err)
+	assert.Equal(kubernetes.VirtualServiceType, vsFromCache.Kind)
+
+	_, err = kubeCache.GetVirtualService(context.TODO(), "test", "missing")
+	require.True(errors.IsNotFound(err))
+
+	// Created objects are visible right away since they are not cached.
+	_, err = kubeCache.Client().Istio().NetworkingV1().VirtualServices("test").Create(context.TODO(), &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "vs2", Namespace: "test"}}, metav1.CreateOptions{})
+	require.NoError(err)
+
+	vsList, err := kubeCache.GetVirtualServices(context.TODO(), "test", "")
+	require.NoError(err)
+	assert.Len(vsList, 2)
+
+	vsList, err = kubeCache.GetVirtualServices(context.TODO(), "", "")
+	require.NoError(err)
+	assert.Len(vsList, 2)
+
+	drList, err := kubeCache.GetDestinationRules(context.TODO(), "test", "")
+	require.NoError(err)
+	assert.Len(drList, 1)
+}
+
+func TestUncachedIstioConfigReturnsAPIErrors(t *testing.T) {
+	require := require.New(t)
+
+	ns := kubetest.FakeNamespace("test")
+	vs := &networking_v1.VirtualService{ObjectMeta: metav1.ObjectMeta{Name: "vs", Namespace: "test"}}
+
+	cfg := config.NewConfig()
+	cfg.KubernetesConfig.UncachedIstioConfig = []string{"virtualservice.networking.istio.io"}
+	client := kubetest.NewFakeK8sClient(ns, vs)
+	kubeCache, err := NewKubeCache(client, *cfg, nil)
+	require.NoError(err)
+
+	client.IstioClientset.(*istiofake.Clientset).PrependReactor("list", "virtualservices", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewForbidden(kubernetes.VirtualServices.GroupVersion().WithResource("virtualservices").GroupResource(), "", fmt.Errorf("denied"))
+	})
+
+	// An API error is not reported as an empty list or a missing object
+	_, err = kubeCache.GetVirtualServices(context.TODO(), "test", "")
+	require.True(errors.IsForbidden(err))
+	_, err = kubeCache.GetVirtualServices(context.TODO(), "", "")
+	require.True(errors.IsForbidden(err))
+	_, err = kubeCache.GetVirtualService(context.TODO(), "test", "vs")
+	require.True(errors.IsForbidden(err))
+}
+
 func TestStripUnusedFields(t *testing.T) {
 	tests := []struct {
 		name string
@@ -645,3 +715,31 @@ func TestGetServiceTrimmed(t *testing.T) {
 		t.Errorf("StripUnusedFields: got %v, want %v", services[0], want)
 	}
 }
+
+func TestLiveListerUsesContextOfCaller(t *testing.T) {
+	require := require.New(t)
+
+	var listCtx context.Context
+	var lister istiosec_v1_listers.AuthorizationPolicyLister = liveAuthorizationPolicyLister{liveLister[security_v1.AuthorizationPolicy]{
+		gvk: kubernetes.AuthorizationPolicies,
+		list: func(ctx context.Context, namespace string, opts metav1.ListOptions) ([]*security_v1.AuthorizationPolicy, error) {
+			listCtx = ctx
+			return nil, ctx.Err()
+		},
+	}}
+
+	// Without a context, the lister still bounds the call to the API
+	_, err := lister.AuthorizationPolicies("test").List(labels.Everything())
+	require.NoError(err)
+	_, hasDeadline := listCtx.Deadline()
+	require.True(hasDeadline)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = withContext(ctx, lister).AuthorizationPolicies("test").List(labels.Everything())
+	require.ErrorIs(err, context.Canceled)
+
+	// The context is not kept by the lister itself
+	_, err = lister.AuthorizationPolicies("test").List(labels.Everything())
+	require.NoError(err)
+}
@@ -0,0 +1,399 @@
+package cache
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	extentions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
+	security_v1 "istio.io/client-go/pkg/apis/security/v1"
+	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
+	istioext_v1alpha1_listers "istio.io/client-go/pkg/listers/extensions/v1alpha1"
+	istionet_v1_listers "istio.io/client-go/pkg/listers/networking/v1"
+	istionet_v1alpha3_listers "istio.io/client-go/pkg/listers/networking/v1alpha3"
+	istionet_v1beta1_listers "istio.io/client-go/pkg/listers/networking/v1beta1"
+	istiosec_v1_listers "istio.io/client-go/pkg/listers/security/v1"
+	istiotelem_v1_listers "istio.io/client-go/pkg/listers/telemetry/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayapi_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayapi_v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	k8s_v1_listers "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1"
+	k8s_v1alpha2_listers "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1alpha2"
+	k8s_v1beta1_listers "sigs.k8s.io/gateway-api/pkg/client/listers/apis/v1beta1"
+)
+
+// liveListTimeout bounds each call to the Kubernetes API made by the listers of the types that are not cached, within
+// the context of the caller.
+const liveListTimeout = 30 * time.Second
+
+// liveListFunc lists the objects of a type from the Kubernetes API. The namespace is empty to list every namespace.
+type liveListFunc[T any] func(ctx context.Context, namespace string, opts metav1.ListOptions) ([]*T, error)
+
+// liveLister lists the objects of a type from the Kubernetes API on every call instead of keeping them in memory.
+// It backs the listers of the types that are not cached, so the getters of the cache work the same way for every type.
+// Unlike the listers built on an Indexer, the errors of the API are returned to the getters.
+type liveLister[T any] struct {
+	// ctx is the context of the caller, set by withContext
+	ctx       context.Context
+	gvk       schema.GroupVersionKind
+	namespace string
+	list      liveListFunc[T]
+}
+
+func (l liveLister[T]) List(selector labels.Selector) ([]*T, error) {
+	return l.listObjects(metav1.ListOptions{LabelSelector: selector.String()}, func(metadata metav1.Object) bool {
+		return selector.Matches(labels.Set(metadata.GetLabels()))
+	})
+}
+
+func (l liveLister[T]) Get(name string) (*T, error) {
+	opts := metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", name).String()}
+	objects, err := l.listObjects(opts, func(metadata metav1.Object) bool {
+		return metadata.GetName() == name
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		return nil, errors.NewNotFound(schema.GroupResource{Group: l.gvk.Group, Resource: strings.ToLower(l.gvk.Kind)}, name)
+	}
+	return objects[0], nil
+}
+
+// listObjects lists the objects of the namespace of the lister, keeping the ones matching the filter. The API may not
+// support the selectors of the options, i.e. the fake clients, so they are always checked again by the filter.
+func (l liveLister[T]) listObjects(opts metav1.ListOptions, filter func(metadata metav1.Object) bool) ([]*T, error) {
+	ctx := l.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, liveListTimeout)
+	defer cancel()
+
+	objects, err := l.list(ctx, l.namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+	filtered := make([]*T, 0, len(objects))
+	for _, object := range objects {
+		metadata, err := meta.Accessor(object)
+		if err != nil {
+			return nil, err
+		}
+		if filter(metadata) {
+			filtered = append(filtered, object)
+		}
+	}
+	return filtered, nil
+}
+
+// namespaced returns the lister of a namespace.
+func (l liveLister[T]) namespaced(namespace string) liveLister[T] {
+	l.namespace = namespace
+	return l
+}
+
+// withContext returns the lister of a type reading the Kubernetes API with the context of the caller when the type is
+// not cached. The listers of the cached types are returned as is.
+func withContext[L any](ctx context.Context, lister L) L {
+	if l, ok := any(lister).(interface{ withContext(context.Context) L }); ok {
+		return l.withContext(ctx)
+	}
+	return lister
+}
+
+// newLiveLister returns the lister of a type that is not cached, scoped to the namespace of the informers.
+func newLiveLister[T any](namespace string, gvk schema.GroupVersionKind, list liveListFunc[T]) liveLister[T] {
+	return liveLister[T]{gvk: gvk, namespace: namespace, list: list}
+}
+
+// livePtrs returns pointers to the items of a list, for the clients whose lists don't hold pointers.
+func livePtrs[T any](items []T) []*T {
+	objects := make([]*T, 0, len(items))
+	for i := range items {
+		objects = append(objects, &items[i])
+	}
+	return objects
+}
+
+// The generated lister interfaces only differ by the name of the accessor of the namespaced lister.
+
+type liveAuthorizationPolicyLister struct {
+	liveLister[security_v1.AuthorizationPolicy]
+}
+
+func (l liveAuthorizationPolicyLister) AuthorizationPolicies(namespace string) istiosec_v1_listers.AuthorizationPolicyNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveAuthorizationPolicyLister) withContext(ctx context.Context) istiosec_v1_listers.AuthorizationPolicyLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveDestinationRuleLister struct {
+	liveLister[networking_v1.DestinationRule]
+}
+
+func (l liveDestinationRuleLister) DestinationRules(namespace string) istionet_v1_listers.DestinationRuleNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveDestinationRuleLister) withContext(ctx context.Context) istionet_v1_listers.DestinationRuleLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveEnvoyFilterLister struct {
+	liveLister[networking_v1alpha3.EnvoyFilter]
+}
+
+func (l liveEnvoyFilterLister) EnvoyFilters(namespace string) istionet_v1alpha3_listers.EnvoyFilterNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveEnvoyFilterLister) withContext(ctx context.Context) istionet_v1alpha3_listers.EnvoyFilterLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveGatewayLister struct {
+	liveLister[networking_v1.Gateway]
+}
+
+func (l liveGatewayLister) Gateways(namespace string) istionet_v1_listers.GatewayNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveGatewayLister) withContext(ctx context.Context) istionet_v1_listers.GatewayLister {
+	l.ctx = ctx
+	return l
+}
+
+type livePeerAuthenticationLister struct {
+	liveLister[security_v1.PeerAuthentication]
+}
+
+func (l livePeerAuthenticationLister) PeerAuthentications(namespace string) istiosec_v1_listers.PeerAuthenticationNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l livePeerAuthenticationLister) withContext(ctx context.Context) istiosec_v1_listers.PeerAuthenticationLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveProxyConfigLister struct {
+	liveLister[networking_v1beta1.ProxyConfig]
+}
+
+func (l liveProxyConfigLister) ProxyConfigs(namespace string) istionet_v1beta1_listers.ProxyConfigNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveProxyConfigLister) withContext(ctx context.Context) istionet_v1beta1_listers.ProxyConfigLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveRequestAuthenticationLister struct {
+	liveLister[security_v1.RequestAuthentication]
+}
+
+func (l liveRequestAuthenticationLister) RequestAuthentications(namespace string) istiosec_v1_listers.RequestAuthenticationNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveRequestAuthenticationLister) withContext(ctx context.Context) istiosec_v1_listers.RequestAuthenticationLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveServiceEntryLister struct {
+	liveLister[networking_v1.ServiceEntry]
+}
+
+func (l liveServiceEntryLister) ServiceEntries(namespace string) istionet_v1_listers.ServiceEntryNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveServiceEntryLister) withContext(ctx context.Context) istionet_v1_listers.ServiceEntryLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveSidecarLister struct {
+	liveLister[networking_v1.Sidecar]
+}
+
+func (l liveSidecarLister) Sidecars(namespace string) istionet_v1_listers.SidecarNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveSidecarLister) withContext(ctx context.Context) istionet_v1_listers.SidecarLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveTelemetryLister struct {
+	liveLister[telemetry_v1.Telemetry]
+}
+
+func (l liveTelemetryLister) Telemetries(namespace string) istiotelem_v1_listers.TelemetryNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveTelemetryLister) withContext(ctx context.Context) istiotelem_v1_listers.TelemetryLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveVirtualServiceLister struct {
+	liveLister[networking_v1.VirtualService]
+}
+
+func (l liveVirtualServiceLister) VirtualServices(namespace string) istionet_v1_listers.VirtualServiceNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveVirtualServiceLister) withContext(ctx context.Context) istionet_v1_listers.VirtualServiceLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveWasmPluginLister struct {
+	liveLister[extentions_v1alpha1.WasmPlugin]
+}
+
+func (l liveWasmPluginLister) WasmPlugins(namespace string) istioext_v1alpha1_listers.WasmPluginNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveWasmPluginLister) withContext(ctx context.Context) istioext_v1alpha1_listers.WasmPluginLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveWorkloadEntryLister struct {
+	liveLister[networking_v1.WorkloadEntry]
+}
+
+func (l liveWorkloadEntryLister) WorkloadEntries(namespace string) istionet_v1_listers.WorkloadEntryNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveWorkloadEntryLister) withContext(ctx context.Context) istionet_v1_listers.WorkloadEntryLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveWorkloadGroupLister struct {
+	liveLister[networking_v1.WorkloadGroup]
+}
+
+func (l liveWorkloadGroupLister) WorkloadGroups(namespace string) istionet_v1_listers.WorkloadGroupNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveWorkloadGroupLister) withContext(ctx context.Context) istionet_v1_listers.WorkloadGroupLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveK8sGatewayLister struct {
+	liveLister[gatewayapi_v1.Gateway]
+}
+
+func (l liveK8sGatewayLister) Gateways(namespace string) k8s_v1_listers.GatewayNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveK8sGatewayLister) withContext(ctx context.Context) k8s_v1_listers.GatewayLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveK8sHTTPRouteLister struct {
+	liveLister[gatewayapi_v1.HTTPRoute]
+}
+
+func (l liveK8sHTTPRouteLister) HTTPRoutes(namespace string) k8s_v1_listers.HTTPRouteNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveK8sHTTPRouteLister) withContext(ctx context.Context) k8s_v1_listers.HTTPRouteLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveK8sGRPCRouteLister struct {
+	liveLister[gatewayapi_v1.GRPCRoute]
+}
+
+func (l liveK8sGRPCRouteLister) GRPCRoutes(namespace string) k8s_v1_listers.GRPCRouteNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveK8sGRPCRouteLister) withContext(ctx context.Context) k8s_v1_listers.GRPCRouteLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveK8sReferenceGrantLister struct {
+	liveLister[gatewayapi_v1beta1.ReferenceGrant]
+}
+
+func (l liveK8sReferenceGrantLister) ReferenceGrants(namespace string) k8s_v1beta1_listers.ReferenceGrantNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveK8sReferenceGrantLister) withContext(ctx context.Context) k8s_v1beta1_listers.ReferenceGrantLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveK8sTCPRouteLister struct {
+	liveLister[gatewayapi_v1alpha2.TCPRoute]
+}
+
+func (l liveK8sTCPRouteLister) TCPRoutes(namespace string) k8s_v1alpha2_listers.TCPRouteNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveK8sTCPRouteLister) withContext(ctx context.Context) k8s_v1alpha2_listers.TCPRouteLister {
+	l.ctx = ctx
+	return l
+}
+
+type liveK8sTLSRouteLister struct {
+	liveLister[gatewayapi_v1alpha2.TLSRoute]
+}
+
+func (l liveK8sTLSRouteLister) TLSRoutes(namespace string) k8s_v1alpha2_listers.TLSRouteNamespaceLister {
+	return l.namespaced(namespace)
+}
+
+func (l liveK8sTLSRouteLister) withContext(ctx context.Context) k8s_v1alpha2_listers.TLSRouteLister {
+	l.ctx = ctx
+	return l
+}
+
+// isTypeCached returns whether the objects of the Istio config type are kept in memory by informers. The types listed in
+// the uncached_istio_config setting are read from the Kubernetes API instead.
+func (c *kubeCache) isTypeCached(gvk schema.GroupVersionKind) bool {
+	for _, t := range c.cfg.KubernetesConfig.UncachedIstioConfig {
+		if strings.EqualFold(t, gvk.Kind+"."+gvk.Group) {
+			return false
+		}
+	}
+	return true
+}
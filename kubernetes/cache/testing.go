@@ -12,7 +12,7 @@ import (
 	"github.com/kiali/kiali/kubernetes/kubetest"
 )
 
-func newTestingCache(t *testing.T, cf kubernetes.ClientFactory, conf config.Config) KialiCache {
+func newTestingCache(t testing.TB, cf kubernetes.ClientFactory, conf config.Config) KialiCache {
 	t.Helper()
 	// Disabling Istio API for tests. Otherwise the cache will try and poll the Istio endpoint
 	// when the cache is created.
@@ -36,7 +36,7 @@ func NewTestingCache(t *testing.T, k8s kubernetes.ClientInterface, conf config.C
 }
 
 // NewTestingCacheWithFactory allows you to pass in a custom client factory. Good for testing multicluster.
-func NewTestingCacheWithFactory(t *testing.T, cf kubernetes.ClientFactory, conf config.Config) KialiCache {
+func NewTestingCacheWithFactory(t testing.TB, cf kubernetes.ClientFactory, conf config.Config) KialiCache {
 	t.Helper()
 	return newTestingCache(t, cf, conf)
 }
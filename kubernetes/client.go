@@ -15,6 +15,7 @@ import (
 	kube "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
 	gatewayapiclient "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 
 	kialiconfig "github.com/kiali/kiali/config"
@@ -47,6 +48,9 @@ type ClusterInfo struct {
 type ClientInterface interface {
 	GetServerVersion() (*version.Info, error)
 	GetToken() string
+	// GetIdentity returns a hash of the token and of the impersonated user of the client. Unlike the token, which is
+	// the same for all the users impersonated by the Kiali service account, it identifies the user of the client.
+	GetIdentity() string
 	IsOpenShift() bool
 	IsExpGatewayAPI() bool
 	IsGatewayAPI() bool
@@ -62,8 +66,9 @@ type ClientInterface interface {
 // K8SClient is the client struct for Kubernetes and Istio APIs
 // It hides the way it queries each API
 type K8SClient struct {
-	token string
-	k8s   kube.Interface
+	token    string
+	identity string
+	k8s      kube.Interface
 
 	projectClient projectclient.Interface
 	routeClient   routeclient.Interface
@@ -103,6 +108,11 @@ func (client *K8SClient) GetToken() string {
 	return client.token
 }
 
+// GetIdentity returns the hash of the token and of the impersonated user from the config
+func (client *K8SClient) GetIdentity() string {
+	return client.identity
+}
+
 func (client *K8SClient) ClusterInfo() ClusterInfo {
 	return client.clusterInfo
 }
@@ -165,6 +175,12 @@ func getConfigForLocalCluster() (*rest.Config, error) {
 func newClientFromConfig(config *rest.Config) (*K8SClient, error) {
 	client := K8SClient{
 		token: config.BearerToken,
+		identity: getTokenHash(&api.AuthInfo{
+			Token:                config.BearerToken,
+			Impersonate:          config.Impersonate.UserName,
+			ImpersonateGroups:    config.Impersonate.Groups,
+			ImpersonateUserExtra: config.Impersonate.Extra,
+		}),
 	}
 
 	log.Debugf("Rest perf config QPS: %f Burst: %d", config.QPS, config.Burst)
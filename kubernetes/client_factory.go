@@ -196,11 +196,10 @@ func (cf *clientFactory) newClient(authInfo *api.AuthInfo, expirationTime time.D
 		config.Host = cf.kialiConfig.Auth.OpenId.ApiProxy
 	}
 
-	// Impersonation is valid only for header authentication strategy
-	if cf.kialiConfig.Auth.Strategy == kialiConfig.AuthStrategyHeader && authInfo.Impersonate != "" {
-		config.Impersonate.UserName = authInfo.Impersonate
-		config.Impersonate.Groups = authInfo.ImpersonateGroups
-		config.Impersonate.Extra = authInfo.ImpersonateUserExtra
+	// Impersonation is valid only for header authentication strategy and OpenId impersonation
+	impersonate := cf.kialiConfig.IsImpersonationEnabled() && authInfo.Impersonate != ""
+	if impersonate {
+		setImpersonation(&config, authInfo)
 	}
 
 	var newClient ClientInterface
@@ -230,9 +229,14 @@ func (cf *clientFactory) newClient(authInfo *api.AuthInfo, expirationTime time.D
 			return nil, err
 		}
 
-		// Replace the Kiali SA token with the user's auth token.
-		remoteConfig.BearerToken = authInfo.Token
-		remoteConfig.BearerTokenFile = ""
+		if impersonate && cf.kialiConfig.Auth.Strategy == kialiConfig.AuthStrategyOpenId {
+			// The Kiali SA of the remote cluster impersonates the user, keeping its token.
+			setImpersonation(remoteConfig, authInfo)
+		} else {
+			// Replace the Kiali SA token with the user's auth token.
+			remoteConfig.BearerToken = authInfo.Token
+			remoteConfig.BearerTokenFile = ""
+		}
 
 		newClient, err = NewClientWithRemoteClusterInfo(remoteConfig, &clusterInfo)
 		if err != nil {
@@ -369,6 +373,13 @@ func (cf *clientFactory) deleteClient(token string) {
 	internalmetrics.SetKubernetesClients(len(cf.clientEntries)) // TODO: + 2 dimmension map?
 }
 
+// setImpersonation makes the requests of the config act as the user of the authInfo.
+func setImpersonation(config *rest.Config, authInfo *api.AuthInfo) {
+	config.Impersonate.UserName = authInfo.Impersonate
+	config.Impersonate.Groups = authInfo.ImpersonateGroups
+	config.Impersonate.Extra = authInfo.ImpersonateUserExtra
+}
+
 // getTokenHash get the token hash of a client
 func getTokenHash(authInfo *api.AuthInfo) string {
 	tokenData := authInfo.Token
@@ -262,6 +262,32 @@ func TestClientCreatedWithAuthStrategyOpenIdAndDisableRBACFalse(t *testing.T) {
 	assert.NotEqual(userClients[testClusterName].GetToken(), "token")
 }
 
+func TestClientIdentityWithOpenIdImpersonation(t *testing.T) {
+	// The users impersonated by the Kiali SA share its token, but not their identity.
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.Auth.Strategy = config.AuthStrategyOpenId
+	conf.Auth.OpenId.Impersonation.Enabled = true
+
+	config.Set(conf)
+
+	const testClusterName = "TestRemoteCluster"
+	createTestRemoteClusterSecret(t, testClusterName, remoteClusterYAML)
+	clientFactory := NewTestingClientFactory(t)
+
+	aliceClients, err := clientFactory.GetClients(map[string]*api.AuthInfo{testClusterName: {Token: "token", Impersonate: "oidc:alice", ImpersonateGroups: []string{"payments"}}})
+	require.NoError(err)
+	bobClients, err := clientFactory.GetClients(map[string]*api.AuthInfo{testClusterName: {Token: "token", Impersonate: "oidc:bob"}})
+	require.NoError(err)
+
+	alice := aliceClients[testClusterName]
+	bob := bobClients[testClusterName]
+	require.Equal(alice.GetToken(), bob.GetToken())
+	require.NotEqual(alice.GetIdentity(), bob.GetIdentity())
+	require.NotEqual(clientFactory.GetSAClient(testClusterName).GetIdentity(), alice.GetIdentity())
+}
+
 func TestSAClientCreatedWithExecProvider(t *testing.T) {
 	// by default, ExecProvider support should be disabled
 	cases := map[string]struct {
@@ -36,7 +36,9 @@ type RouteDump struct {
 }
 
 type EnvoyRouteConfig struct {
+	LastUpdated string       `mapstructure:"last_updated,omitempty"`
 	RouteConfig *RouteConfig `mapstructure:"route_config,omitempty"`
+	VersionInfo string       `mapstructure:"version_info,omitempty"`
 }
 
 type ListenerDump struct {
@@ -96,7 +98,12 @@ type VirtualHostFilter struct {
 		Match    map[string]interface{} `mapstructure:"match"`
 		Metadata *EnvoyMetadata         `mapstructure:"metadata,omitempty"`
 		Route    *struct {
-			Cluster string `mapstructure:"cluster,omitempty"`
+			Cluster          string `mapstructure:"cluster,omitempty"`
+			WeightedClusters *struct {
+				Clusters []struct {
+					Name string `mapstructure:"name"`
+				} `mapstructure:"clusters,omitempty"`
+			} `mapstructure:"weighted_clusters,omitempty"`
 		} `mapstructure:"route,omitempty"`
 	} `mapstructure:"routes,omitempty"`
 }
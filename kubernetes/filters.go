@@ -2,10 +2,13 @@ package kubernetes
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
+	osroutes_v1 "github.com/openshift/api/route/v1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1 "istio.io/client-go/pkg/apis/security/v1"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -346,6 +349,25 @@ func FilterPodsByService(s *core_v1.Service, allPods []core_v1.Pod) []core_v1.Po
 }
 
 // Filter Istio registry that are not persent as kubernetes services
+func FilterProxyConfigsBySelector(workloadSelector string, proxyConfigs []*networking_v1beta1.ProxyConfig) []*networking_v1beta1.ProxyConfig {
+	filtered := []*networking_v1beta1.ProxyConfig{}
+	workloadLabels := mapWorkloadSelector(workloadSelector)
+	for _, pc := range proxyConfigs {
+		wkLabelsS := []string{}
+		if pc.Spec.Selector != nil {
+			for k, v := range pc.Spec.Selector.MatchLabels {
+				wkLabelsS = append(wkLabelsS, k+"="+v)
+			}
+		}
+		if resourceSelector, err := labels.Parse(strings.Join(wkLabelsS, ",")); err == nil {
+			if resourceSelector.Matches(labels.Set(workloadLabels)) {
+				filtered = append(filtered, pc)
+			}
+		}
+	}
+	return filtered
+}
+
 func FilterRegistryServicesByServices(registryServices []*RegistryService, services []core_v1.Service) []*RegistryService {
 	filtered := []*RegistryService{}
 	keys := make(map[string]map[string]struct{})
@@ -616,6 +638,25 @@ func FilterK8sGRPCRoutesByService(allRoutes []*k8s_networking_v1.GRPCRoute, refe
 	return filtered
 }
 
+// FilterRoutesByService returns the OpenShift Routes of the namespace sending traffic to the Service, as their
+// main or one of their alternate backends.
+func FilterRoutesByService(allRoutes []osroutes_v1.Route, namespace string, serviceName string) []osroutes_v1.Route {
+	filtered := []osroutes_v1.Route{}
+	for _, route := range allRoutes {
+		if route.Namespace != namespace {
+			continue
+		}
+		backends := append([]osroutes_v1.RouteTargetReference{route.Spec.To}, route.Spec.AlternateBackends...)
+		for _, backend := range backends {
+			if (backend.Kind == "" || backend.Kind == "Service") && backend.Name == serviceName {
+				filtered = append(filtered, route)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 func FilterVirtualServiceByRoute(vs *networking_v1.VirtualService, service string, namespace string) bool {
 	if vs == nil {
 		return false
@@ -690,6 +731,30 @@ func FilterByNamespaceNames[T runtime.Object](objects []T, namespaceNames []stri
 	return filtered
 }
 
+// FilterInPlaceByNamespaceSet is like FilterByNamespaceNames but it reuses the backing array of objects
+// instead of allocating a new slice, so the input slice must not be used afterwards. The entries dropped
+// from the end of the array are cleared so the filtered out objects can be garbage collected.
+// It never returns a nil slice.
+func FilterInPlaceByNamespaceSet[T runtime.Object](objects []T, namespaceSet map[string]bool) []T {
+	if objects == nil {
+		return []T{}
+	}
+
+	filtered := objects[:0]
+	for _, obj := range objects {
+		o, err := meta.Accessor(obj)
+		if err != nil {
+			break
+		}
+
+		if namespaceSet[o.GetNamespace()] {
+			filtered = append(filtered, obj)
+		}
+	}
+	clear(objects[len(filtered):])
+	return filtered
+}
+
 // FilterByNamespaces filters a list of runtime.Objects by the provided namespaces.
 // If the object's namespace is not in the provided list of namespaces, the object
 // is filtered out.
@@ -709,3 +774,59 @@ func FilterByNamespace[T runtime.Object](objects []T, namespace string) []T {
 	}
 	return filtered
 }
+
+// FilterByLabelValues filters a list of runtime.Objects keeping only the objects
+// labeled with the given label and one of the provided values.
+func FilterByLabelValues[T runtime.Object](objects []T, label string, values []string) []T {
+	valueSet := make(map[string]bool)
+	for _, v := range values {
+		valueSet[v] = true
+	}
+
+	filtered := []T{}
+	for _, obj := range objects {
+		o, err := meta.Accessor(obj)
+		if err != nil {
+			return filtered
+		}
+
+		if value, ok := o.GetLabels()[label]; ok && valueSet[value] {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
+// FilterByResourceVersionAfter filters a list of runtime.Objects keeping only the objects changed after the
+// given resourceVersion. The objects whose resourceVersion is not a number are kept.
+func FilterByResourceVersionAfter[T runtime.Object](objects []T, resourceVersion uint64) []T {
+	filtered := []T{}
+	for _, obj := range objects {
+		o, err := meta.Accessor(obj)
+		if err != nil {
+			return filtered
+		}
+
+		if rv, err := strconv.ParseUint(o.GetResourceVersion(), 10, 64); err != nil || rv > resourceVersion {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
+// FilterByAnnotationValue filters a list of runtime.Objects keeping only the objects
+// annotated with the given annotation and value.
+func FilterByAnnotationValue[T runtime.Object](objects []T, annotation string, value string) []T {
+	filtered := []T{}
+	for _, obj := range objects {
+		o, err := meta.Accessor(obj)
+		if err != nil {
+			return filtered
+		}
+
+		if v, ok := o.GetAnnotations()[annotation]; ok && v == value {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
@@ -1,6 +1,7 @@
 package kubernetes
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -258,6 +259,110 @@ func TestFilterByNamespaces(t *testing.T) {
 	assert.Empty(emptyFiltered)
 }
 
+func TestFilterInPlaceByNamespaceSet(t *testing.T) {
+	assert := assert.New(t)
+
+	obj1 := &networking_v1.DestinationRule{ObjectMeta: meta_v1.ObjectMeta{Namespace: "ns1"}}
+	obj2 := &networking_v1.DestinationRule{ObjectMeta: meta_v1.ObjectMeta{Namespace: "ns2"}}
+	obj3 := &networking_v1.DestinationRule{ObjectMeta: meta_v1.ObjectMeta{Namespace: "ns3"}}
+
+	objects := []*networking_v1.DestinationRule{obj1, obj2, obj3}
+	filtered := FilterInPlaceByNamespaceSet(objects, map[string]bool{"ns1": true, "ns3": true})
+	assert.Equal([]*networking_v1.DestinationRule{obj1, obj3}, filtered)
+	// The backing array is reused and the dropped tail is cleared
+	assert.Equal(&objects[0], &filtered[0])
+	assert.Nil(objects[2])
+
+	assert.NotNil(FilterInPlaceByNamespaceSet[*networking_v1.DestinationRule](nil, map[string]bool{"ns1": true}))
+}
+
+func BenchmarkFilterByNamespaceNames(b *testing.B) {
+	objects, namespaces := benchmarkNamespacedObjects()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FilterByNamespaceNames(objects, namespaces)
+	}
+}
+
+func BenchmarkFilterInPlaceByNamespaceSet(b *testing.B) {
+	objects, namespaces := benchmarkNamespacedObjects()
+	namespaceSet := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		namespaceSet[ns] = true
+	}
+	input := make([]*networking_v1.DestinationRule, len(objects))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// The input is consumed by the filter so it is restored on every iteration
+		copy(input, objects)
+		FilterInPlaceByNamespaceSet(input, namespaceSet)
+	}
+}
+
+// benchmarkNamespacedObjects returns 5000 objects spread across 100 namespaces and the names of half of the namespaces.
+func benchmarkNamespacedObjects() ([]*networking_v1.DestinationRule, []string) {
+	objects := make([]*networking_v1.DestinationRule, 0, 5000)
+	namespaces := []string{}
+	for i := 0; i < 100; i++ {
+		ns := fmt.Sprintf("ns-%d", i)
+		if i%2 == 0 {
+			namespaces = append(namespaces, ns)
+		}
+		for j := 0; j < 50; j++ {
+			objects = append(objects, &networking_v1.DestinationRule{ObjectMeta: meta_v1.ObjectMeta{Name: fmt.Sprintf("dr-%d", j), Namespace: ns}})
+		}
+	}
+	return objects, namespaces
+}
+
+func TestFilterByLabelValues(t *testing.T) {
+	assert := assert.New(t)
+
+	obj1 := &networking_v1.DestinationRule{ObjectMeta: meta_v1.ObjectMeta{Name: "dr1", Labels: map[string]string{"kiali.io/team": "payments"}}}
+	obj2 := &networking_v1.DestinationRule{ObjectMeta: meta_v1.ObjectMeta{Name: "dr2", Labels: map[string]string{"kiali.io/team": "checkout"}}}
+	obj3 := &networking_v1.DestinationRule{ObjectMeta: meta_v1.ObjectMeta{Name: "dr3"}}
+
+	objects := []*networking_v1.DestinationRule{obj1, obj2, obj3}
+
+	filtered := FilterByLabelValues(objects, "kiali.io/team", []string{"payments", "search"})
+	assert.EqualValues([]*networking_v1.DestinationRule{obj1}, filtered)
+
+	assert.Empty(FilterByLabelValues(objects, "kiali.io/team", []string{}))
+}
+
+func TestFilterByResourceVersionAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	obj1 := &networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "vs1", ResourceVersion: "5"}}
+	obj2 := &networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "vs2", ResourceVersion: "12"}}
+	obj3 := &networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "vs3"}}
+
+	objects := []*networking_v1.VirtualService{obj1, obj2, obj3}
+
+	// Objects without a numeric resourceVersion are kept
+	filtered := FilterByResourceVersionAfter(objects, 10)
+	assert.EqualValues([]*networking_v1.VirtualService{obj2, obj3}, filtered)
+
+	assert.Len(FilterByResourceVersionAfter(objects, 0), 3)
+}
+
+func TestFilterByAnnotationValue(t *testing.T) {
+	assert := assert.New(t)
+
+	obj1 := &networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "vs1", Annotations: map[string]string{"meta.helm.sh/release-name": "bookinfo"}}}
+	obj2 := &networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "vs2", Annotations: map[string]string{"meta.helm.sh/release-name": "ratings"}}}
+	obj3 := &networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: "vs3"}}
+
+	objects := []*networking_v1.VirtualService{obj1, obj2, obj3}
+
+	filtered := FilterByAnnotationValue(objects, "meta.helm.sh/release-name", "bookinfo")
+	assert.EqualValues([]*networking_v1.VirtualService{obj1}, filtered)
+
+	assert.Empty(FilterByAnnotationValue(objects, "meta.helm.sh/release-name", "reviews"))
+}
+
 func TestFilterK8sHTTPRoutesByService(t *testing.T) {
 	assert := assert.New(t)
 	rt1 := createHTTPRoute("testroute", "default", "details", "bookinfo")
@@ -90,6 +90,22 @@ func GetHost(hostName, namespace string, clusterNamespaces []string) Host {
 	return ParseHost(hostName, namespace)
 }
 
+// NormalizeHost returns the FQDN of a host of an Istio object of the given namespace: a short name is resolved in
+// the namespace of the object, as Istio does, and the <service>.<namespace> and <service>.<namespace>.svc names
+// are completed with the domain. Wildcards and the hosts out of the cluster domain are returned as they are.
+// It is the form used to compare the hosts across the validations, the references and the graph.
+func NormalizeHost(hostName, namespace string, clusterNamespaces []string) string {
+	if strings.HasPrefix(hostName, "*") {
+		return hostName
+	}
+	return GetHost(hostName, namespace, clusterNamespaces).String()
+}
+
+// IsShortHost returns whether the host is a short name, i.e. "reviews", resolved in the namespace of the object.
+func IsShortHost(hostName string) bool {
+	return hostName != "" && !strings.HasPrefix(hostName, "*") && !strings.Contains(hostName, ".")
+}
+
 func includes(nss []string, namespace string) bool {
 	for _, ns := range nss {
 		if ns == namespace {
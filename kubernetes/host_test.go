@@ -27,6 +27,27 @@ func TestGatewayAsHost(t *testing.T) {
 	assert.Equal("mygateway.bookinfo.svc.cluster.local", ParseGatewayAsHost("mygateway.bookinfo.svc.cluster.local", "bookinfo").String())
 }
 
+func TestNormalizeHost(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := config.NewConfig()
+	config.Set(conf)
+
+	assert.Equal("reviews.bookinfo.svc.cluster.local", NormalizeHost("reviews", "bookinfo", nil))
+	assert.Equal("reviews.bookinfo.svc.cluster.local", NormalizeHost("reviews.bookinfo", "bookinfo", nil))
+	assert.Equal("reviews.bookinfo2.svc.cluster.local", NormalizeHost("reviews.bookinfo2", "bookinfo", []string{"bookinfo", "bookinfo2"}))
+	assert.Equal("reviews.bookinfo2.svc.cluster.local", NormalizeHost("reviews.bookinfo2.svc", "bookinfo", nil))
+	assert.Equal("reviews.bookinfo2.svc.cluster.local", NormalizeHost("reviews.bookinfo2.svc.cluster.local", "bookinfo", nil))
+	assert.Equal("wikipedia.org", NormalizeHost("wikipedia.org", "bookinfo", []string{"bookinfo"}))
+	assert.Equal("*.bookinfo.svc.cluster.local", NormalizeHost("*.bookinfo.svc.cluster.local", "bookinfo", nil))
+	assert.Equal("*", NormalizeHost("*", "bookinfo", nil))
+
+	assert.True(IsShortHost("reviews"))
+	assert.False(IsShortHost("reviews.bookinfo"))
+	assert.False(IsShortHost("*"))
+	assert.False(IsShortHost(""))
+}
+
 func TestHasMatchingVirtualServices(t *testing.T) {
 	assert := assert.New(t)
 
@@ -81,6 +81,7 @@ type IstioClientInterface interface {
 
 	GetConfigDump(namespace, podName string) (*ConfigDump, error)
 	GetZtunnelConfigDump(namespace, podName string) (*ZtunnelConfigDump, error)
+	GetProxyLogLevels(namespace, podName string) (map[string]string, error)
 	SetProxyLogLevel(namespace, podName, level string) error
 }
 
@@ -133,18 +134,32 @@ func (in *K8SClient) GetZtunnelConfigDump(namespace, podName string) (*ZtunnelCo
 }
 
 func (in *K8SClient) SetProxyLogLevel(namespace, pod, level string) error {
-	path := fmt.Sprintf("/logging?level=%s", level)
+	_, err := in.postEnvoyAdmin(namespace, pod, fmt.Sprintf("/logging?level=%s", level))
+	return err
+}
+
+// GetProxyLogLevels returns the log level of each logger of the pod's proxy.
+func (in *K8SClient) GetProxyLogLevels(namespace, pod string) (map[string]string, error) {
+	// The envoy admin interface only accepts POST on /logging. Without params, it just lists the loggers.
+	body, err := in.postEnvoyAdmin(namespace, pod, "/logging")
+	if err != nil {
+		return nil, err
+	}
+	return parseProxyLogLevels(string(body)), nil
+}
 
+// postEnvoyAdmin sends a POST request to the envoy admin interface of the pod and returns the response body.
+func (in *K8SClient) postEnvoyAdmin(namespace, pod, path string) ([]byte, error) {
 	localPort := httputil.Pool.GetFreePort()
 	defer httputil.Pool.FreePort(localPort)
 	f, err := in.getPodPortForwarder(namespace, pod, fmt.Sprintf("%d:%d", localPort, envoyAdminPort))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Start the forwarding
 	if err := f.Start(); err != nil {
-		return err
+		return nil, err
 	}
 
 	// Defering the finish of the port-forwarding
@@ -155,10 +170,28 @@ func (in *K8SClient) SetProxyLogLevel(namespace, pod, level string) error {
 	body, code, _, err := httputil.HttpPost(url, nil, nil, time.Second*10, nil)
 	if code >= 400 {
 		log.Errorf("Error whilst posting. Error: %s. Body: %s", err, string(body))
-		return fmt.Errorf("error sending post request %s from %s/%s. Response code: %d", path, namespace, pod, code)
+		return nil, fmt.Errorf("error sending post request %s from %s/%s. Response code: %d", path, namespace, pod, code)
 	}
 
-	return err
+	return body, err
+}
+
+// parseProxyLogLevels parses the list of loggers returned by the envoy admin /logging endpoint:
+//
+//	active loggers:
+//	  admin: info
+//	  alternate_protocols_cache: info
+func parseProxyLogLevels(body string) map[string]string {
+	levels := map[string]string{}
+	for _, line := range strings.Split(body, "\n") {
+		logger, level, found := strings.Cut(strings.TrimSpace(line), ":")
+		level = strings.TrimSpace(level)
+		if !found || logger == "" || level == "" {
+			continue
+		}
+		levels[logger] = level
+	}
+	return levels
 }
 
 // ServiceEntryHostnames returns a list of hostnames defined in the ServiceEntries Specs. Key in the resulting map is the protocol (in lowercase) + hostname
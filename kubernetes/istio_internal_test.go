@@ -157,3 +157,12 @@ func createPeerAuthn(name, namespace string, mtls *api_security_v1.PeerAuthentic
 	pa.Spec.Mtls = mtls
 	return pa
 }
+
+func TestParseProxyLogLevels(t *testing.T) {
+	body := "active loggers:\n  admin: info\n  connection: debug\n  upstream: info\n"
+
+	levels := parseProxyLogLevels(body)
+
+	assert.Equal(t, map[string]string{"admin": "info", "connection": "debug", "upstream": "info"}, levels)
+	assert.Empty(t, parseProxyLogLevels(""))
+}
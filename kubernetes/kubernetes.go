@@ -30,6 +30,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/tools/remotecommand"
 
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/log"
@@ -45,14 +46,17 @@ type K8SClientInterface interface {
 	GetCronJobs(namespace string) ([]batch_v1.CronJob, error)
 	GetDeployment(namespace string, name string) (*apps_v1.Deployment, error)
 	GetDeployments(namespace string, opts meta_v1.ListOptions) ([]apps_v1.Deployment, error)
+	GetEvents(namespace string) ([]core_v1.Event, error)
 	GetJobs(namespace string) ([]batch_v1.Job, error)
 	GetNamespace(namespace string) (*core_v1.Namespace, error)
 	GetNamespaces(labelSelector string) ([]core_v1.Namespace, error)
 	GetPod(namespace, name string) (*core_v1.Pod, error)
 	GetReplicationControllers(namespace string) ([]core_v1.ReplicationController, error)
+	GetRollouts(namespace string) ([]Rollout, error)
 	GetSecret(namespace, name string) (*core_v1.Secret, error)
 	GetSelfSubjectAccessReview(ctx context.Context, namespace, api, resourceType string, verbs []string) ([]*auth_v1.SelfSubjectAccessReview, error)
 	GetTokenSubject(authInfo *api.AuthInfo) (string, error)
+	ExecPod(ctx context.Context, namespace, name, container string, command []string) ([]byte, []byte, error)
 	ForwardGetRequest(namespace, podName string, destinationPort int, path string) ([]byte, error)
 	StreamPodLogs(namespace, name string, opts *core_v1.PodLogOptions) (io.ReadCloser, error)
 	UpdateNamespace(namespace string, jsonPatch string) (*core_v1.Namespace, error)
@@ -68,6 +72,7 @@ type OSClientInterface interface {
 	GetProject(ctx context.Context, project string) (*osproject_v1.Project, error)
 	GetProjects(ctx context.Context, labelSelector string) ([]osproject_v1.Project, error)
 	GetRoute(ctx context.Context, namespace string, name string) (*osroutes_v1.Route, error)
+	GetRoutes(ctx context.Context, namespace string) ([]osroutes_v1.Route, error)
 	GetUser(ctx context.Context, name string) (*osuser_v1.User, error)
 	UpdateProject(ctx context.Context, project string, jsonPatch string) (*osproject_v1.Project, error)
 }
@@ -212,6 +217,15 @@ func (in *K8SClient) GetRoute(ctx context.Context, namespace string, name string
 	return in.routeClient.RouteV1().Routes(namespace).Get(ctx, name, emptyGetOptions)
 }
 
+func (in *K8SClient) GetRoutes(ctx context.Context, namespace string) ([]osroutes_v1.Route, error) {
+	routes, err := in.routeClient.RouteV1().Routes(namespace).List(ctx, emptyListOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return routes.Items, nil
+}
+
 func (in *K8SClient) IsOpenShift() bool {
 	in.rwMutex.Lock()
 	defer in.rwMutex.Unlock()
@@ -382,6 +396,31 @@ func (in *K8SClient) StreamPodLogs(namespace, name string, opts *core_v1.PodLogO
 	return req.Stream(in.ctx)
 }
 
+// ExecPod runs a command in a container of a pod, without a shell nor a stdin, until it exits or the context is done.
+// It returns the stdout and the stderr of the command, and an error when the command can't be run or exits with a
+// non-zero status.
+func (in *K8SClient) ExecPod(ctx context.Context, namespace, name, container string, command []string) ([]byte, []byte, error) {
+	if in.restConfig == nil {
+		return nil, nil, fmt.Errorf("unable to exec into the pod %s/%s: no rest config", namespace, name)
+	}
+
+	req := in.k8s.CoreV1().RESTClient().Post().Namespace(namespace).Name(name).Resource("pods").SubResource("exec").
+		VersionedParams(&core_v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(in.restConfig, "POST", req.URL())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr})
+	return stdout.Bytes(), stderr.Bytes(), err
+}
+
 func (in *K8SClient) GetCronJobs(namespace string) ([]batch_v1.CronJob, error) {
 	if cjList, err := in.k8s.BatchV1().CronJobs(namespace).List(in.ctx, emptyListOptions); err == nil {
 		return cjList.Items, nil
@@ -390,6 +429,15 @@ func (in *K8SClient) GetCronJobs(namespace string) ([]batch_v1.CronJob, error) {
 	}
 }
 
+// GetEvents returns the Kubernetes events of the namespace, for any involved object.
+func (in *K8SClient) GetEvents(namespace string) ([]core_v1.Event, error) {
+	if eList, err := in.k8s.CoreV1().Events(namespace).List(in.ctx, emptyListOptions); err == nil {
+		return eList.Items, nil
+	} else {
+		return []core_v1.Event{}, err
+	}
+}
+
 func (in *K8SClient) GetJobs(namespace string) ([]batch_v1.Job, error) {
 	if jList, err := in.k8s.BatchV1().Jobs(namespace).List(in.ctx, emptyListOptions); err == nil {
 		return jList.Items, nil
@@ -2,6 +2,7 @@ package kubetest
 
 import (
 	"context"
+	"fmt"
 
 	osappsfake "github.com/openshift/client-go/apps/clientset/versioned/fake"
 	osappsscheme "github.com/openshift/client-go/apps/clientset/versioned/scheme"
@@ -154,13 +155,20 @@ type FakeK8sClient struct {
 	// Underlying gateway api clientset.
 	GatewayAPIClientset gatewayapi.Interface
 	// Token is the kiali token this client uses.
-	Token           string
+	Token string
+	// Impersonate is the user impersonated by this client, if any.
+	Impersonate     string
 	KubeClusterInfo kialikube.ClusterInfo
 	ProjectFake     *projectfake.Clientset
 	UserFake        *userfake.Clientset
 	OAuthFake       *oauthfake.Clientset
+	// Argo Rollouts returned by GetRollouts, the fake clientsets do not serve the Argo Rollouts API.
+	Rollouts []kialikube.Rollout
+	// ExecFunc runs the commands of ExecPod, the fake clientsets do not serve the exec of the pods.
+	ExecFunc func(namespace, name, container string, command []string) ([]byte, []byte, error)
 }
 
+func (c *FakeK8sClient) GetIdentity() string                { return c.Token + "/" + c.Impersonate }
 func (c *FakeK8sClient) IsOpenShift() bool                  { return c.OpenShift }
 func (c *FakeK8sClient) IsExpGatewayAPI() bool              { return c.GatewayAPIEnabled }
 func (c *FakeK8sClient) IsGatewayAPI() bool                 { return c.GatewayAPIEnabled }
@@ -168,4 +176,21 @@ func (c *FakeK8sClient) IsIstioAPI() bool                   { return c.IstioAPIE
 func (c *FakeK8sClient) GetToken() string                   { return c.Token }
 func (c *FakeK8sClient) ClusterInfo() kialikube.ClusterInfo { return c.KubeClusterInfo }
 
+func (c *FakeK8sClient) GetRollouts(namespace string) ([]kialikube.Rollout, error) {
+	rollouts := []kialikube.Rollout{}
+	for _, r := range c.Rollouts {
+		if r.Namespace == namespace {
+			rollouts = append(rollouts, r)
+		}
+	}
+	return rollouts, nil
+}
+
+func (c *FakeK8sClient) ExecPod(ctx context.Context, namespace, name, container string, command []string) ([]byte, []byte, error) {
+	if c.ExecFunc == nil {
+		return nil, nil, fmt.Errorf("unable to exec into the pod %s/%s: no exec func", namespace, name)
+	}
+	return c.ExecFunc(namespace, name, container, command)
+}
+
 var _ kialikube.ClientInterface = &FakeK8sClient{}
@@ -1,6 +1,7 @@
 package kubetest
 
 import (
+	"context"
 	"sync"
 
 	"github.com/go-jose/go-jose/jwt"
@@ -167,6 +168,11 @@ func (o *K8SClientMock) GetToken() string {
 	return args.Get(0).(string)
 }
 
+func (o *K8SClientMock) GetIdentity() string {
+	args := o.Called()
+	return args.Get(0).(string)
+}
+
 func (o *K8SClientMock) ClusterInfo() kubernetes.ClusterInfo {
 	args := o.Called()
 	return args.Get(0).(kubernetes.ClusterInfo)
@@ -193,6 +199,11 @@ func (o *K8SClientMock) GetTokenSubject(authInfo *api.AuthInfo) (string, error)
 	return authInfo.Token, nil
 }
 
+func (o *K8SClientMock) ExecPod(ctx context.Context, namespace, name, container string, command []string) ([]byte, []byte, error) {
+	args := o.Called(ctx, namespace, name, container, command)
+	return args.Get(0).([]byte), args.Get(1).([]byte), args.Error(2)
+}
+
 func (o *K8SClientMock) ForwardGetRequest(namespace, podName string, destinationPort int, path string) ([]byte, error) {
 	args := o.Called(namespace, podName, destinationPort, path)
 	return args.Get(0).([]byte), args.Error(1)
@@ -67,6 +67,11 @@ func (o *K8SClientMock) GetRegistryServices() ([]*kubernetes.RegistryService, er
 	return args.Get(0).([]*kubernetes.RegistryService), args.Error(1)
 }
 
+func (o *K8SClientMock) GetProxyLogLevels(namespace, podName string) (map[string]string, error) {
+	args := o.Called(namespace, podName)
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
 func (o *K8SClientMock) SetProxyLogLevel(namespace, podName, level string) error {
 	args := o.Called()
 	return args.Error(0)
@@ -11,6 +11,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
+
+	kialikube "github.com/kiali/kiali/kubernetes"
 )
 
 func (o *K8SClientMock) Kube() kubernetes.Interface {
@@ -62,6 +64,11 @@ func (o *K8SClientMock) GetEndpoints(namespace string, name string) (*core_v1.En
 	return args.Get(0).(*core_v1.Endpoints), args.Error(1)
 }
 
+func (o *K8SClientMock) GetEvents(namespace string) ([]core_v1.Event, error) {
+	args := o.Called(namespace)
+	return args.Get(0).([]core_v1.Event), args.Error(1)
+}
+
 func (o *K8SClientMock) GetJobs(namespace string) ([]batch_v1.Job, error) {
 	args := o.Called(namespace)
 	return args.Get(0).([]batch_v1.Job), args.Error(1)
@@ -107,6 +114,11 @@ func (o *K8SClientMock) GetReplicaSets(namespace string) ([]apps_v1.ReplicaSet,
 	return args.Get(0).([]apps_v1.ReplicaSet), args.Error(1)
 }
 
+func (o *K8SClientMock) GetRollouts(namespace string) ([]kialikube.Rollout, error) {
+	args := o.Called(namespace)
+	return args.Get(0).([]kialikube.Rollout), args.Error(1)
+}
+
 func (o *K8SClientMock) GetSecret(namespace, name string) (*core_v1.Secret, error) {
 	args := o.Called(namespace, name)
 	return args.Get(0).(*core_v1.Secret), args.Error(1)
@@ -15,6 +15,11 @@ func (o *K8SClientMock) GetRoute(ctx context.Context, namespace string, name str
 	return args.Get(0).(*osroutes_v1.Route), args.Error(1)
 }
 
+func (o *K8SClientMock) GetRoutes(ctx context.Context, namespace string) ([]osroutes_v1.Route, error) {
+	args := o.Called(ctx, namespace)
+	return args.Get(0).([]osroutes_v1.Route), args.Error(1)
+}
+
 func (o *K8SClientMock) GetDeploymentConfig(ctx context.Context, namespace string, name string) (*osapps_v1.DeploymentConfig, error) {
 	args := o.Called(namespace, name)
 	return args.Get(0).(*osapps_v1.DeploymentConfig), args.Error(1)
@@ -0,0 +1,92 @@
+package kubetest
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	osappsscheme "github.com/openshift/client-go/apps/clientset/versioned/scheme"
+	oauthscheme "github.com/openshift/client-go/oauth/clientset/versioned/scheme"
+	projectscheme "github.com/openshift/client-go/project/clientset/versioned/scheme"
+	routescheme "github.com/openshift/client-go/route/clientset/versioned/scheme"
+	userscheme "github.com/openshift/client-go/user/clientset/versioned/scheme"
+	istioscheme "istio.io/client-go/pkg/clientset/versioned/scheme"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
+	gatewayapischeme "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/scheme"
+)
+
+// fixtureDecoder decodes the objects supported by the FakeK8sClient.
+var fixtureDecoder = func() runtime.Decoder {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		kubescheme.AddToScheme,
+		istioscheme.AddToScheme,
+		gatewayapischeme.AddToScheme,
+		osappsscheme.AddToScheme,
+		oauthscheme.AddToScheme,
+		projectscheme.AddToScheme,
+		routescheme.AddToScheme,
+		userscheme.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			panic(err)
+		}
+	}
+	return serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}()
+
+// LoadObjects decodes the Kubernetes, Istio, Gateway API and OpenShift objects of a multi-document YAML fixture.
+func LoadObjects(r io.Reader) ([]runtime.Object, error) {
+	objects := []runtime.Object{}
+	reader := yaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the fixture: %w", err)
+		}
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+		object, _, err := fixtureDecoder.Decode(document, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the object %d of the fixture: %w", len(objects)+1, err)
+		}
+		objects = append(objects, object)
+	}
+}
+
+// LoadObjectsFile decodes the objects of a multi-document YAML fixture file.
+func LoadObjectsFile(path string) ([]runtime.Object, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	objects, err := LoadObjects(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return objects, nil
+}
+
+// NewFakeK8sClientFromFiles creates a FakeK8sClient holding the objects of YAML fixture files and extra objects.
+func NewFakeK8sClientFromFiles(paths []string, objects ...runtime.Object) (*FakeK8sClient, error) {
+	for _, path := range paths {
+		loaded, err := LoadObjectsFile(path)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, loaded...)
+	}
+	return NewFakeK8sClient(objects...), nil
+}
@@ -0,0 +1,35 @@
+package kubetest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewFakeK8sClientFromFiles(t *testing.T) {
+	require := require.New(t)
+
+	client, err := NewFakeK8sClientFromFiles([]string{"testdata/bookinfo.yaml"}, FakeNamespace("istio-system"))
+	require.NoError(err)
+
+	namespaces, err := client.Kube().CoreV1().Namespaces().List(context.TODO(), meta_v1.ListOptions{})
+	require.NoError(err)
+	require.Len(namespaces.Items, 2)
+
+	deployment, err := client.Kube().AppsV1().Deployments("bookinfo").Get(context.TODO(), "reviews-v1", meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Equal("v1", deployment.Labels["version"])
+
+	vs, err := client.Istio().NetworkingV1().VirtualServices("bookinfo").Get(context.TODO(), "reviews", meta_v1.GetOptions{})
+	require.NoError(err)
+	require.Equal("v1", vs.Spec.Http[0].Route[0].Destination.Subset)
+
+	_, err = client.Istio().NetworkingV1().Gateways("bookinfo").Get(context.TODO(), "bookinfo-gateway", meta_v1.GetOptions{})
+	require.NoError(err)
+
+	_, err = LoadObjects(strings.NewReader("apiVersion: v1\nkind: Unknown\nmetadata:\n  name: foo\n"))
+	require.Error(err)
+}
@@ -0,0 +1,101 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutPodTemplateHashLabel is the label set by the Argo Rollouts controller on the ReplicaSets of a Rollout
+// and on their pods, to tell apart the stable and the canary pods.
+const RolloutPodTemplateHashLabel = "rollouts-pod-template-hash"
+
+// Rollout is the subset of the Argo Rollouts Rollout resource read by Kiali.
+// Only the fields describing the progressive delivery strategy and its current state are mapped,
+// so Kiali does not depend on the Argo Rollouts API module.
+type Rollout struct {
+	meta_v1.TypeMeta   `json:",inline"`
+	meta_v1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RolloutSpec   `json:"spec,omitempty"`
+	Status RolloutStatus `json:"status,omitempty"`
+}
+
+type RolloutSpec struct {
+	Strategy RolloutStrategy `json:"strategy"`
+}
+
+type RolloutStrategy struct {
+	BlueGreen *RolloutBlueGreenStrategy `json:"blueGreen,omitempty"`
+	Canary    *RolloutCanaryStrategy    `json:"canary,omitempty"`
+}
+
+type RolloutBlueGreenStrategy struct {
+	ActiveService  string `json:"activeService"`
+	PreviewService string `json:"previewService,omitempty"`
+}
+
+type RolloutCanaryStrategy struct {
+	CanaryService string              `json:"canaryService,omitempty"`
+	StableService string              `json:"stableService,omitempty"`
+	Steps         []RolloutCanaryStep `json:"steps,omitempty"`
+}
+
+type RolloutCanaryStep struct {
+	SetWeight *int32 `json:"setWeight,omitempty"`
+}
+
+type RolloutStatus struct {
+	CurrentPodHash   string                 `json:"currentPodHash,omitempty"`
+	CurrentStepIndex *int32                 `json:"currentStepIndex,omitempty"`
+	StableRS         string                 `json:"stableRS,omitempty"`
+	BlueGreen        RolloutBlueGreenStatus `json:"blueGreen,omitempty"`
+	Canary           RolloutCanaryStatus    `json:"canary,omitempty"`
+}
+
+type RolloutBlueGreenStatus struct {
+	ActiveSelector  string `json:"activeSelector,omitempty"`
+	PreviewSelector string `json:"previewSelector,omitempty"`
+}
+
+type RolloutCanaryStatus struct {
+	Weights *RolloutTrafficWeights `json:"weights,omitempty"`
+}
+
+// RolloutTrafficWeights is the traffic split applied by the rollout controller when it manages the traffic routing
+// through a service mesh or an ingress.
+type RolloutTrafficWeights struct {
+	Canary RolloutWeightDestination `json:"canary"`
+	Stable RolloutWeightDestination `json:"stable"`
+}
+
+type RolloutWeightDestination struct {
+	Weight          int32  `json:"weight"`
+	ServiceName     string `json:"serviceName,omitempty"`
+	PodTemplateHash string `json:"podTemplateHash,omitempty"`
+}
+
+type rolloutList struct {
+	Items []Rollout `json:"items"`
+}
+
+// GetRollouts returns the Argo Rollouts of a namespace.
+// An empty list is returned when the Argo Rollouts API is not installed in the cluster.
+func (in *K8SClient) GetRollouts(namespace string) ([]Rollout, error) {
+	path := fmt.Sprintf("/apis/%s/namespaces/%s/rollouts", ArgoRolloutsGroupVersionV1Alpha1.String(), namespace)
+	result, err := in.k8s.Discovery().RESTClient().Get().AbsPath(path).Do(in.ctx).Raw()
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return []Rollout{}, nil
+		}
+		return []Rollout{}, err
+	}
+
+	var list rolloutList
+	if err := json.Unmarshal(result, &list); err != nil {
+		return []Rollout{}, err
+	}
+	return list.Items, nil
+}
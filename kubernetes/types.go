@@ -12,6 +12,7 @@ const (
 	DestinationRuleType = "DestinationRule"
 	GatewayType         = "Gateway"
 	EnvoyFilterType     = "EnvoyFilter"
+	ProxyConfigType     = "ProxyConfig"
 	SidecarType         = "Sidecar"
 	ServiceEntryType    = "ServiceEntry"
 	VirtualServiceType  = "VirtualService"
@@ -49,6 +50,7 @@ const (
 	PodType                   = "Pod"
 	ReplicationControllerType = "ReplicationController"
 	ReplicaSetType            = "ReplicaSet"
+	RolloutType               = "Rollout"
 	ServiceType               = "Service"
 	StatefulSetType           = "StatefulSet"
 )
@@ -58,6 +60,7 @@ var (
 	DestinationRules = NetworkingGroupVersionV1.WithKind(DestinationRuleType)
 	Gateways         = NetworkingGroupVersionV1.WithKind(GatewayType)
 	EnvoyFilters     = NetworkingGroupVersionV1Alpha3.WithKind(EnvoyFilterType)
+	ProxyConfigs     = NetworkingGroupVersionV1Beta1.WithKind(ProxyConfigType)
 	Sidecars         = NetworkingGroupVersionV1.WithKind(SidecarType)
 	ServiceEntries   = NetworkingGroupVersionV1.WithKind(ServiceEntryType)
 	VirtualServices  = NetworkingGroupVersionV1.WithKind(VirtualServiceType)
@@ -95,6 +98,7 @@ var (
 	Pods                   = CoreGroupVersionV1.WithKind(PodType)
 	ReplicationControllers = CoreGroupVersionV1.WithKind(ReplicationControllerType)
 	ReplicaSets            = AppsGroupVersionV1.WithKind(ReplicaSetType)
+	Rollouts               = ArgoRolloutsGroupVersionV1Alpha1.WithKind(RolloutType)
 	Services               = CoreGroupVersionV1.WithKind(ServiceType)
 	StatefulSets           = AppsGroupVersionV1.WithKind(StatefulSetType)
 
@@ -114,6 +118,11 @@ var (
 		Version: "v1",
 	}
 
+	ArgoRolloutsGroupVersionV1Alpha1 = schema.GroupVersion{
+		Group:   "argoproj.io",
+		Version: "v1alpha1",
+	}
+
 	AppsOpenShiftGroupVersionV1 = schema.GroupVersion{
 		Group:   "apps.openshift.io",
 		Version: "v1",
@@ -124,6 +133,11 @@ var (
 		Version: "v1alpha3",
 	}
 
+	NetworkingGroupVersionV1Beta1 = schema.GroupVersion{
+		Group:   "networking.istio.io",
+		Version: "v1beta1",
+	}
+
 	NetworkingGroupVersionV1 = schema.GroupVersion{
 		Group:   "networking.istio.io",
 		Version: "v1",
@@ -164,6 +178,7 @@ var (
 		DestinationRules.String(): DestinationRules,
 		EnvoyFilters.String():     EnvoyFilters,
 		Gateways.String():         Gateways,
+		ProxyConfigs.String():     ProxyConfigs,
 		ServiceEntries.String():   ServiceEntries,
 		Sidecars.String():         Sidecars,
 		VirtualServices.String():  VirtualServices,
@@ -259,6 +274,10 @@ type IstioService struct {
 	ClusterVIPs12 struct {
 		Addresses map[string][]string `json:"Addresses,omitempty"`
 	} `json:"clusterVIPs,omitempty"`
+	// The VIPs allocated by Istio to the ServiceEntries without addresses. The sidecars answer them to the DNS queries
+	// of the host when both ISTIO_META_DNS_CAPTURE and ISTIO_META_DNS_AUTO_ALLOCATE are enabled.
+	AutoAllocatedIPv4Address string `json:"autoAllocatedIPv4Address,omitempty"`
+	AutoAllocatedIPv6Address string `json:"autoAllocatedIPv6Address,omitempty"`
 }
 
 type RegistryStatus struct {
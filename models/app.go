@@ -91,6 +91,10 @@ type WorkloadItem struct {
 	// Labels for Workload
 	Labels map[string]string `json:"labels"`
 
+	// Argo Rollout owning the workload, the stable and canary ReplicaSets are distinct versions of the app
+	// required: false
+	Rollout *RolloutInfo `json:"rollout,omitempty"`
+
 	// List of service accounts involved in this application
 	// required: true
 	ServiceAccountNames []string `json:"serviceAccountNames"`
@@ -131,6 +135,9 @@ type App struct {
 	// Runtimes and associated dashboards
 	Runtimes []Runtime `json:"runtimes"`
 
+	// Links to external systems configured for the application
+	DeepLinks []DeepLink `json:"deepLinks,omitempty"`
+
 	// Health
 	Health AppHealth `json:"health"`
 }
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"time"
+)
+
+// KubeCacheStats reports the state of the informers of the Kiali cache of a cluster.
+type KubeCacheStats struct {
+	// Cluster of the cache
+	// required: true
+	Cluster string `json:"cluster"`
+
+	// ClusterScoped is true when the informers watch the whole cluster, otherwise there are informers for every
+	// accessible namespace
+	// required: true
+	ClusterScoped bool `json:"clusterScoped"`
+
+	// ResyncPeriod of the informers, in seconds
+	// required: true
+	// example: 300
+	ResyncPeriod int64 `json:"resyncPeriod"`
+
+	// Informers of the cache, by scope
+	// required: true
+	Informers []CacheInformersStats `json:"informers"`
+
+	// Namespaces with cached objects
+	// required: true
+	Namespaces []NamespaceCacheStats `json:"namespaces"`
+}
+
+// CacheInformersStats reports the state of the informers of a scope of the cache: the whole cluster or a namespace.
+type CacheInformersStats struct {
+	// Namespace watched by the informers, empty for a cluster-scoped cache
+	Namespace string `json:"namespace,omitempty"`
+
+	// Synced is true when the informers completed their initial sync
+	// required: true
+	Synced bool `json:"synced"`
+
+	// LastSync is the time the informers last completed their initial sync
+	LastSync *time.Time `json:"lastSync,omitempty"`
+
+	// Objects held by the informers, by kind
+	// required: true
+	Objects map[string]int `json:"objects"`
+
+	// WatchErrors is the number of errors encountered by the watches of the informers
+	// required: true
+	WatchErrors int `json:"watchErrors"`
+
+	// LastWatchError is the latest error encountered by the watches of the informers
+	LastWatchError string `json:"lastWatchError,omitempty"`
+}
+
+// NamespaceCacheStats reports how fresh the cached objects of a namespace are.
+type NamespaceCacheStats struct {
+	// Namespace of the objects
+	// required: true
+	Namespace string `json:"namespace"`
+
+	// LastEvent is the time the informers last received a change of an object of the namespace
+	LastEvent *time.Time `json:"lastEvent,omitempty"`
+
+	// Staleness is the number of seconds since the latest change received or the latest sync of the informers
+	// watching the namespace, whichever is the most recent
+	// required: true
+	// example: 42
+	Staleness int64 `json:"staleness"`
+}
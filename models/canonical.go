@@ -0,0 +1,56 @@
+package models
+
+import (
+	"github.com/kiali/kiali/config"
+)
+
+// Labels used by Istio to compute the canonical service and revision of a workload, which are reported
+// in the canonical_service and canonical_revision telemetry labels.
+const (
+	CanonicalNameLabel       = "service.istio.io/canonical-name"
+	CanonicalRevisionLabel   = "service.istio.io/canonical-revision"
+	K8sAppNameLabel          = "app.kubernetes.io/name"
+	K8sAppVersionLabel       = "app.kubernetes.io/version"
+	DefaultCanonicalRevision = "latest"
+)
+
+// CanonicalApp returns the app of a workload from its labels, mirroring the Istio canonical service:
+// the canonical name label first, then the app.kubernetes.io/name label and then the app label.
+// It returns false when none of these labels is set.
+func CanonicalApp(labels map[string]string) (string, bool) {
+	return firstLabel(labels, CanonicalNameLabel, K8sAppNameLabel, config.Get().IstioLabels.AppLabelName)
+}
+
+// CanonicalVersion returns the version of a workload from its labels, mirroring the Istio canonical revision:
+// the canonical revision label first, then the app.kubernetes.io/version label and then the version label.
+// It returns false when none of these labels is set.
+func CanonicalVersion(labels map[string]string) (string, bool) {
+	return firstLabel(labels, CanonicalRevisionLabel, K8sAppVersionLabel, config.Get().IstioLabels.VersionLabelName)
+}
+
+// CanonicalService returns the canonical service of a workload as computed by Istio,
+// which defaults to the workload name when the workload has no app labels.
+func CanonicalService(labels map[string]string, workloadName string) string {
+	if app, ok := CanonicalApp(labels); ok {
+		return app
+	}
+	return workloadName
+}
+
+// CanonicalRevision returns the canonical revision of a workload as computed by Istio,
+// which defaults to "latest" when the workload has no version labels.
+func CanonicalRevision(labels map[string]string) string {
+	if version, ok := CanonicalVersion(labels); ok {
+		return version
+	}
+	return DefaultCanonicalRevision
+}
+
+func firstLabel(labels map[string]string, names ...string) (string, bool) {
+	for _, name := range names {
+		if value, ok := labels[name]; ok && value != "" {
+			return value, true
+		}
+	}
+	return "", false
+}
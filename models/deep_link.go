@@ -0,0 +1,34 @@
+package models
+
+import "github.com/kiali/kiali/config"
+
+// The kinds of the objects, other than the Istio objects, the deep links are generated for.
+const (
+	DeepLinkKindApp      = "app"
+	DeepLinkKindService  = "service"
+	DeepLinkKindWorkload = "workload"
+)
+
+// DeepLink is a link to an external system generated for an object from the deep links of the config.
+type DeepLink struct {
+	// The name of the link
+	// example: Source repository
+	Name string `json:"name"`
+	// The URL of the link
+	// example: https://git.example.com/bookinfo/reviews
+	URL string `json:"url"`
+}
+
+// GetDeepLinks returns the deep links configured for the object of the kind.
+func GetDeepLinks(conf *config.Config, kind, cluster, namespace, name string) []DeepLink {
+	links := []DeepLink{}
+	for _, linkConfig := range conf.DeepLinks {
+		if linkConfig.AppliesTo(kind, namespace) {
+			links = append(links, DeepLink{
+				Name: linkConfig.Name,
+				URL:  linkConfig.URL(cluster, kind, namespace, name),
+			})
+		}
+	}
+	return links
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kiali/kiali/config"
+)
+
+func TestDeepLinks(t *testing.T) {
+	assert := assert.New(t)
+	conf := config.NewConfig()
+	conf.DeepLinks = []config.DeepLink{
+		{
+			Name:        "Source",
+			URLTemplate: "https://git.example.com/${namespace}/${name}",
+		},
+		{
+			Name:        "Catalog",
+			Kinds:       []string{DeepLinkKindService},
+			URLTemplate: "https://backstage.example.com/catalog/${cluster}/component/${name}",
+		},
+		{
+			Name:        "Logs",
+			Namespace:   "istio-.*",
+			URLTemplate: "https://logs.example.com/${namespace}/${kind}/${name}",
+		},
+	}
+
+	links := GetDeepLinks(conf, DeepLinkKindService, "east", "bookinfo", "reviews")
+	assert.Equal([]DeepLink{
+		{Name: "Source", URL: "https://git.example.com/bookinfo/reviews"},
+		{Name: "Catalog", URL: "https://backstage.example.com/catalog/east/component/reviews"},
+	}, links)
+
+	links = GetDeepLinks(conf, "Gateway", "east", "istio-system", "ingress")
+	assert.Equal([]DeepLink{
+		{Name: "Source", URL: "https://git.example.com/istio-system/ingress"},
+		{Name: "Logs", URL: "https://logs.example.com/istio-system/Gateway/ingress"},
+	}, links)
+}
+
+func TestEmptyDeepLinks(t *testing.T) {
+	assert.Empty(t, GetDeepLinks(config.NewConfig(), DeepLinkKindWorkload, "east", "bookinfo", "reviews-v1"))
+}
@@ -0,0 +1,43 @@
+package models
+
+// The levels of the traffic policy of a DestinationRule, from the least to the most specific.
+const (
+	TrafficPolicyLevelMesh       = "mesh"
+	TrafficPolicyLevelNamespace  = "namespace"
+	TrafficPolicyLevelHost       = "host"
+	TrafficPolicyLevelPort       = "port"
+	TrafficPolicyLevelSubset     = "subset"
+	TrafficPolicyLevelSubsetPort = "subsetPort"
+)
+
+// TrafficPolicyField is a field of a combined traffic policy, with the level setting it.
+type TrafficPolicyField struct {
+	// Field of the traffic policy, i.e. tls or connectionPool
+	Field  string         `json:"field"`
+	Level  string         `json:"level"`
+	Source IstioReference `json:"source"`
+	Value  interface{}    `json:"value"`
+	// Overridden are the less specific levels setting the field too
+	Overridden []string `json:"overridden"`
+}
+
+// TrafficPolicyScope is the traffic policy combined for the connections to the host, to a port and/or to a subset.
+type TrafficPolicyScope struct {
+	Port   uint32               `json:"port,omitempty"`
+	Subset string               `json:"subset,omitempty"`
+	Fields []TrafficPolicyField `json:"fields"`
+}
+
+// DestinationRuleTrafficPolicies is how the traffic policies of a DestinationRule combine with the defaults of its
+// namespace and of the mesh, its port level settings and its subsets.
+type DestinationRuleTrafficPolicies struct {
+	DestinationRule IstioReference `json:"destinationRule"`
+	// Host is the FQDN of the host of the DestinationRule
+	Host string `json:"host"`
+	// MeshDefault is the DestinationRule of the root namespace whose policy is inherited, if any
+	MeshDefault *IstioReference `json:"meshDefault"`
+	// NamespaceDefault is the DestinationRule of the namespace whose policy is inherited, if any
+	NamespaceDefault *IstioReference `json:"namespaceDefault"`
+	// Scopes are the combined policies of the host, then of its ports, then of its subsets
+	Scopes []TrafficPolicyScope `json:"scopes"`
+}
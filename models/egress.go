@@ -0,0 +1,120 @@
+package models
+
+import (
+	"time"
+
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+)
+
+// EgressDestination is an outbound destination not covered by any ServiceEntry.
+type EgressDestination struct {
+	// The Envoy cluster that handled the traffic: PassthroughCluster when the traffic was allowed by
+	// the ALLOW_ANY outboundTrafficPolicy, BlackHoleCluster when it was blocked by REGISTRY_ONLY.
+	EgressCluster string `json:"egressCluster"`
+	// The destination host. For TCP traffic it is usually the egress cluster name since the host is unknown.
+	Host string `json:"host"`
+	// Number of requests during the window
+	Requests float64 `json:"requests"`
+	// Number of TCP connections opened during the window
+	TCPConnections float64 `json:"tcpConnections"`
+	// Workloads, as namespace/name, that sent the traffic
+	Sources []string `json:"sources"`
+}
+
+// EgressReport lists the outbound traffic of a namespace to destinations not covered by any ServiceEntry,
+// sorted by the most used destinations first.
+type EgressReport struct {
+	Cluster     string    `json:"cluster"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	Namespace   string    `json:"namespace"`
+	// The window used to look for the traffic, i.e. "1d"
+	TrafficWindow string              `json:"trafficWindow"`
+	Destinations  []EgressDestination `json:"destinations"`
+}
+
+// DNSCaptureSettings are the settings of the DNS proxy of the sidecars, set by the ISTIO_META_DNS_CAPTURE and
+// ISTIO_META_DNS_AUTO_ALLOCATE proxy metadata.
+type DNSCaptureSettings struct {
+	// The sidecars answer the DNS queries of the hosts known to the mesh
+	Capture bool `json:"capture"`
+	// The sidecars answer the VIPs allocated by Istio to the ServiceEntries without addresses
+	AutoAllocate bool `json:"autoAllocate"`
+	// Where the settings come from: "meshConfig", "annotation" for the proxy.istio.io/config annotation, or the
+	// ProxyConfig as namespace/name
+	Source string `json:"source"`
+}
+
+// WorkloadDNSCapture is the DNS capture of the sidecars of a workload.
+type WorkloadDNSCapture struct {
+	Name string `json:"name"`
+	DNSCaptureSettings
+}
+
+// ServiceEntryHostDNS is how the sidecars capturing DNS answer the queries of a host of a ServiceEntry.
+type ServiceEntryHostDNS struct {
+	Host string `json:"host"`
+	// The addresses declared by the ServiceEntry
+	Addresses []string `json:"addresses"`
+	// The VIPs allocated by Istio, since the ServiceEntry declares no address
+	AutoAllocatedAddresses []string `json:"autoAllocatedAddresses"`
+	// The addresses answered by the sidecars capturing DNS. When AutoAllocated is true they are only answered by the
+	// sidecars with auto allocation enabled.
+	ResolvedAddresses []string `json:"resolvedAddresses"`
+	AutoAllocated     bool     `json:"autoAllocated"`
+	// Why the sidecars forward the queries of the host to the upstream DNS server, when no address is resolved
+	Reason string `json:"reason,omitempty"`
+}
+
+// ServiceEntryDNS lists the hosts of a ServiceEntry with the addresses resolved by the sidecars.
+type ServiceEntryDNS struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// The resolution of the ServiceEntry, i.e. "DNS"
+	Resolution string                `json:"resolution"`
+	Hosts      []ServiceEntryHostDNS `json:"hosts"`
+}
+
+// DNSCaptureReport shows the DNS capture of the workloads of a namespace and the addresses their sidecars answer
+// to the DNS queries of the hosts of the ServiceEntries visible from the namespace.
+type DNSCaptureReport struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	// The settings of the mesh config, applied to the proxies without overrides
+	Mesh           DNSCaptureSettings   `json:"mesh"`
+	Workloads      []WorkloadDNSCapture `json:"workloads"`
+	ServiceEntries []ServiceEntryDNS    `json:"serviceEntries"`
+}
+
+// SidecarEgressHost is an outbound destination observed in the telemetry, allowed by a generated Sidecar.
+type SidecarEgressHost struct {
+	// The host as listed in the egress of the Sidecar: namespace/dnsName, with the namespace of the Service or of
+	// the ServiceEntry, or "*" when it is unknown
+	Host string `json:"host"`
+	// Number of requests during the window
+	Requests float64 `json:"requests"`
+	// Number of TCP connections opened during the window
+	TCPConnections float64 `json:"tcpConnections"`
+}
+
+// GeneratedSidecar is the namespace-wide Sidecar restricting the egress of the workloads of a namespace to the
+// destinations they sent traffic to during a window, generated from the current Sidecar or from scratch.
+type GeneratedSidecar struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	// The window used to look for the traffic, i.e. "1d"
+	TrafficWindow string `json:"trafficWindow"`
+	// The observed destinations allowed by the Sidecar, sorted by host
+	Hosts []SidecarEgressHost `json:"hosts"`
+	// The observed destinations not known to the mesh, handled by the PassthroughCluster or the BlackHoleCluster:
+	// a Sidecar can't allow them, they need a ServiceEntry
+	Unregistered []string `json:"unregistered"`
+	// The Sidecar with the generated egress
+	Sidecar *networking_v1.Sidecar `json:"sidecar"`
+	// Exists is true when the Sidecar already exists and is updated, otherwise it is created
+	Exists bool `json:"exists"`
+	// Changes of the spec of the Sidecar: the current values are on the source side and the generated ones on the
+	// target side
+	Changes []IstioConfigFieldDiff `json:"changes"`
+	// Applied is true once the Sidecar has been created or updated
+	Applied bool `json:"applied"`
+}
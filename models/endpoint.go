@@ -2,6 +2,7 @@ package models
 
 import (
 	core_v1 "k8s.io/api/core/v1"
+	discovery_v1 "k8s.io/api/discovery/v1"
 )
 
 type Endpoints []Endpoint
@@ -26,3 +27,126 @@ func (endpoint *Endpoint) Parse(s core_v1.EndpointSubset) {
 	(&endpoint.Ports).ParseEndpointPorts(s.Ports)
 	(&endpoint.Addresses).Parse(s.Addresses)
 }
+
+// The sources of the endpoint status of a service.
+const (
+	EndpointSourceEndpointSlices = "EndpointSlices"
+	EndpointSourceEndpoints      = "Endpoints"
+)
+
+// EndpointStatus is the readiness of the endpoints of a service, to trace the requests failing with no healthy
+// upstream back to empty or not ready endpoints.
+type EndpointStatus struct {
+	// Source is the Kubernetes resource the endpoints are read from: EndpointSlices, or Endpoints when the
+	// EndpointSlices cannot be read
+	// example: EndpointSlices
+	Source string `json:"source"`
+	// required: true
+	Ready int `json:"ready"`
+	// required: true
+	NotReady int `json:"notReady"`
+	// Terminating is the number of endpoints of terminating pods, only known from EndpointSlices
+	// required: true
+	Terminating int `json:"terminating"`
+	// required: true
+	Addresses []EndpointAddressStatus `json:"addresses"`
+	// Issue explains why the service has no ready endpoint, if so
+	// example: No pod matches the selector of the service
+	Issue string `json:"issue,omitempty"`
+}
+
+// EndpointAddressStatus is an address of the endpoints of a service, with its readiness.
+type EndpointAddressStatus struct {
+	// example: 10.244.0.12
+	IP       string `json:"ip"`
+	Hostname string `json:"hostname,omitempty"`
+	// example: Pod
+	Kind string `json:"kind,omitempty"`
+	// example: reviews-v1-5b9d8f7c6-x2x7l
+	Name     string `json:"name,omitempty"`
+	NodeName string `json:"nodeName,omitempty"`
+	// Zone is the zone of the node of the endpoint, only known from EndpointSlices
+	// example: us-west-1a
+	Zone string `json:"zone,omitempty"`
+	// required: true
+	Ready bool `json:"ready"`
+	// Serving is whether the endpoint accepts requests, even while terminating
+	// required: true
+	Serving bool `json:"serving"`
+	// required: true
+	Terminating bool `json:"terminating"`
+}
+
+// ParseEndpointSlices sets the status from the EndpointSlices of the service. An address of several slices is
+// only counted once.
+func (status *EndpointStatus) ParseEndpointSlices(slices []discovery_v1.EndpointSlice) {
+	status.Source = EndpointSourceEndpointSlices
+	status.Addresses = []EndpointAddressStatus{}
+	seen := map[string]bool{}
+	for _, slice := range slices {
+		for _, endpoint := range slice.Endpoints {
+			if len(endpoint.Addresses) == 0 || seen[endpoint.Addresses[0]] {
+				continue
+			}
+			seen[endpoint.Addresses[0]] = true
+
+			address := EndpointAddressStatus{
+				IP: endpoint.Addresses[0],
+				// A nil condition is an unknown state, to be interpreted as ready and serving
+				Ready:       endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready,
+				Serving:     endpoint.Conditions.Serving == nil || *endpoint.Conditions.Serving,
+				Terminating: endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating,
+			}
+			if endpoint.Hostname != nil {
+				address.Hostname = *endpoint.Hostname
+			}
+			if endpoint.TargetRef != nil {
+				address.Kind = endpoint.TargetRef.Kind
+				address.Name = endpoint.TargetRef.Name
+			}
+			if endpoint.NodeName != nil {
+				address.NodeName = *endpoint.NodeName
+			}
+			if endpoint.Zone != nil {
+				address.Zone = *endpoint.Zone
+			}
+			status.add(address)
+		}
+	}
+}
+
+// ParseEndpoints sets the status from the Endpoints of the service.
+func (status *EndpointStatus) ParseEndpoints(es *core_v1.Endpoints) {
+	status.Source = EndpointSourceEndpoints
+	status.Addresses = []EndpointAddressStatus{}
+	if es == nil {
+		return
+	}
+	for _, subset := range es.Subsets {
+		for i, addresses := range [][]core_v1.EndpointAddress{subset.Addresses, subset.NotReadyAddresses} {
+			for _, a := range addresses {
+				address := EndpointAddressStatus{IP: a.IP, Hostname: a.Hostname, Ready: i == 0, Serving: i == 0}
+				if a.TargetRef != nil {
+					address.Kind = a.TargetRef.Kind
+					address.Name = a.TargetRef.Name
+				}
+				if a.NodeName != nil {
+					address.NodeName = *a.NodeName
+				}
+				status.add(address)
+			}
+		}
+	}
+}
+
+func (status *EndpointStatus) add(address EndpointAddressStatus) {
+	switch {
+	case address.Terminating:
+		status.Terminating++
+	case address.Ready:
+		status.Ready++
+	default:
+		status.NotReady++
+	}
+	status.Addresses = append(status.Addresses, address)
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// EnvoyRouteDestination is a destination of a route: a host and, optionally, one of its subsets.
+type EnvoyRouteDestination struct {
+	Host   string `json:"host"`
+	Subset string `json:"subset,omitempty"`
+}
+
+// EnvoyRouteVerification tells whether the HTTP routes of a VirtualService are live in the proxy of a pod,
+// comparing the destinations of the VirtualService with the ones of the routes of the proxy generated from it.
+type EnvoyRouteVerification struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+
+	VirtualService  IstioReference `json:"virtualService"`
+	ResourceVersion string         `json:"resourceVersion"`
+	// ChangedAt is the time of the last change of the VirtualService
+	ChangedAt time.Time `json:"changedAt"`
+
+	// Propagated is whether the routes of the proxy match the last change of the VirtualService
+	Propagated bool `json:"propagated"`
+	// PropagatedAt is when the proxy last updated the routes generated from the VirtualService, if it did
+	PropagatedAt *time.Time `json:"propagatedAt,omitempty"`
+	// RDS is the sync status of the routes of the proxy with istiod
+	RDS string `json:"rds,omitempty"`
+	// RouteConfigs are the names of the route configs of the proxy with routes generated from the VirtualService
+	RouteConfigs []string `json:"routeConfigs"`
+
+	Expected   []EnvoyRouteDestination `json:"expected"`
+	Proxy      []EnvoyRouteDestination `json:"proxy"`
+	Missing    []EnvoyRouteDestination `json:"missing"`
+	Unexpected []EnvoyRouteDestination `json:"unexpected"`
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"sort"
+
+	core_v1 "k8s.io/api/core/v1"
+)
+
+// Event is a Kubernetes event of one of the objects of a workload, such as a crash loop, an image pull error
+// or a failed scheduling.
+type Event struct {
+	// Kind of the object involved in the event
+	// required: true
+	// example: Pod
+	Kind string `json:"kind"`
+
+	// Name of the object involved in the event
+	// required: true
+	// example: reviews-v1-5b4c8d7f9-x2k4j
+	Name string `json:"name"`
+
+	// Type of the event, Normal or Warning
+	// required: true
+	// example: Warning
+	Type string `json:"type"`
+
+	// Reason of the event
+	// required: true
+	// example: BackOff
+	Reason string `json:"reason"`
+
+	// Message of the event
+	// example: Back-off restarting failed container
+	Message string `json:"message"`
+
+	// Number of times the event has occurred
+	// required: true
+	// example: 3
+	Count int32 `json:"count"`
+
+	// First time the event occurred (in RFC3339 format)
+	// example: 2018-07-31T12:24:17Z
+	FirstTimestamp string `json:"firstTimestamp"`
+
+	// Last time the event occurred (in RFC3339 format)
+	// example: 2018-07-31T12:24:17Z
+	LastTimestamp string `json:"lastTimestamp"`
+}
+
+type Events []Event
+
+// Parse converts a Kubernetes event. Events reported through the events.k8s.io API only have an event time,
+// which is used as first and last timestamps.
+func (event *Event) Parse(e *core_v1.Event) {
+	event.Kind = e.InvolvedObject.Kind
+	event.Name = e.InvolvedObject.Name
+	event.Type = e.Type
+	event.Reason = e.Reason
+	event.Message = e.Message
+	event.Count = e.Count
+	if event.Count == 0 {
+		event.Count = 1
+	}
+	first, last := e.FirstTimestamp.Time, e.LastTimestamp.Time
+	if first.IsZero() {
+		first = e.EventTime.Time
+	}
+	if last.IsZero() {
+		last = first
+	}
+	if !first.IsZero() {
+		event.FirstTimestamp = formatTime(first)
+	}
+	if !last.IsZero() {
+		event.LastTimestamp = formatTime(last)
+	}
+}
+
+// SortByLastTimestamp sorts the events from the most recent to the oldest.
+func (events Events) SortByLastTimestamp() {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTimestamp > events[j].LastTimestamp
+	})
+}
@@ -0,0 +1,33 @@
+package models
+
+// GatewayServer is a host of a server of an Istio Gateway.
+type GatewayServer struct {
+	IstioReference
+	// Path of the host field, i.e. "spec.servers[0].hosts[1]"
+	Field string `json:"field"`
+	// The host as written in the field, with its optional namespace prefix
+	Value    string `json:"value"`
+	Protocol string `json:"protocol"`
+	// The ingress workloads selected by the Gateway, which serve the host
+	Workloads []WorkloadReference `json:"workloads"`
+	// Conflict is true when the server matches the host and port of a server of another Gateway
+	// selecting the same ingress workloads
+	Conflict bool `json:"conflict"`
+}
+
+// GatewayHostPort groups the Gateway servers declaring a host and port.
+type GatewayHostPort struct {
+	// The host, without its namespace prefix
+	Host     string          `json:"host"`
+	Port     int             `json:"port"`
+	Servers  []GatewayServer `json:"servers"`
+	Conflict bool            `json:"conflict"`
+}
+
+// GatewayServerMap lists the servers of the Istio Gateways of a cluster by host and port.
+type GatewayServerMap struct {
+	Cluster   string            `json:"cluster"`
+	HostPorts []GatewayHostPort `json:"hostPorts"`
+	// Number of host and port pairs with conflicting servers
+	Conflicts int `json:"conflicts"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	security_v1 "istio.io/client-go/pkg/apis/security/v1"
+)
+
+// Granularities of the sources allowed by a generated AuthorizationPolicy
+const (
+	AuthorizationPolicyGranularityPrincipal = "principal"
+	AuthorizationPolicyGranularityNamespace = "namespace"
+)
+
+// AuthorizationPolicySource is a source observed calling a service, allowed by a generated AuthorizationPolicy.
+type AuthorizationPolicySource struct {
+	// The source principal, or the source namespace with the namespace granularity
+	// example: cluster.local/ns/bookinfo/sa/bookinfo-productpage
+	Source string `json:"source"`
+	// The workloads observed sending the traffic, as namespace/name
+	// example: ["bookinfo/productpage-v1"]
+	Workloads []string `json:"workloads"`
+	// The number of requests received from the source during the window
+	Requests float64 `json:"requests"`
+	// The number of TCP connections opened by the source during the window
+	TCPConnections float64 `json:"tcpConnections"`
+}
+
+// GeneratedAuthorizationPolicy is the AuthorizationPolicy allowing only the sources observed calling a service during
+// a window, generated from the previously generated policy or from scratch.
+type GeneratedAuthorizationPolicy struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+	Service   string `json:"service"`
+	// The window used to look for the traffic, i.e. "1d"
+	TrafficWindow string `json:"trafficWindow"`
+	// Whether the sources are allowed by principal or by namespace
+	// example: principal
+	Granularity string `json:"granularity"`
+	// The observed sources allowed by the AuthorizationPolicy, sorted
+	Sources []AuthorizationPolicySource `json:"sources"`
+	// The workloads observed calling the service without mTLS, as namespace/name: they have no identity to allow
+	// and are denied once the AuthorizationPolicy is applied
+	Unauthenticated []string `json:"unauthenticated"`
+	// The AuthorizationPolicy with the generated rules
+	AuthorizationPolicy *security_v1.AuthorizationPolicy `json:"authorizationPolicy"`
+	// Exists is true when the AuthorizationPolicy already exists and is updated, otherwise it is created
+	Exists bool `json:"exists"`
+	// Changes of the spec of the AuthorizationPolicy: the current values are on the source side and the generated
+	// ones on the target side
+	Changes []IstioConfigFieldDiff `json:"changes"`
+	// Applied is true once the AuthorizationPolicy has been created or updated
+	Applied bool `json:"applied"`
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"strings"
+	"time"
+
 	"github.com/prometheus/common/model"
 
 	"github.com/kiali/kiali/log"
@@ -25,6 +28,8 @@ type NamespaceWorkloadHealth map[string]*WorkloadHealth
 // ServiceHealth contains aggregated health from various sources, for a given service
 type ServiceHealth struct {
 	Requests RequestHealth `json:"requests"`
+	// SLO is the last SLO evaluation of the service when it has an SLO definition.
+	SLO *SLOStatus `json:"slo,omitempty"`
 }
 
 // AppHealth contains aggregated health from various sources, for a given app
@@ -87,6 +92,8 @@ type WorkloadStatus struct {
 	CurrentReplicas   int32  `json:"currentReplicas"`
 	AvailableReplicas int32  `json:"availableReplicas"`
 	SyncedProxies     int32  `json:"syncedProxies"`
+	// ScaledToZero is whether a serverless workload has no replicas because it is idle, which is not a failure
+	ScaledToZero bool `json:"scaledToZero,omitempty"`
 }
 
 // ProxyStatus gives the sync status of the sidecar proxy.
@@ -188,6 +195,35 @@ func aggregate(sample *model.Sample, requests map[string]map[string]float64) {
 	requests[protocol][code] += float64(sample.Value)
 }
 
+// IsErrorCode returns true when the given code, as aggregated by RequestHealth, is a failed request for the protocol:
+// a request without response, a gRPC status other than OK or an HTTP 5xx code, as in the default health config.
+func IsErrorCode(protocol, code string) bool {
+	if code == "-" {
+		return true
+	}
+	if protocol == "grpc" {
+		return code != "0"
+	}
+	return strings.HasPrefix(code, "5")
+}
+
+// ErrorRatio returns the total inbound request rate and the ratio of those requests that failed.
+func (in RequestHealth) ErrorRatio() (float64, float64) {
+	var total, errors float64
+	for protocol, codes := range in.Inbound {
+		for code, rate := range codes {
+			total += rate
+			if IsErrorCode(protocol, code) {
+				errors += rate
+			}
+		}
+	}
+	if total == 0 {
+		return 0, 0
+	}
+	return total, errors / total
+}
+
 // CastWorkloadStatus returns a WorkloadStatus out of a given Workload
 func (w Workload) CastWorkloadStatus() *WorkloadStatus {
 	syncedProxies := int32(-1)
@@ -201,6 +237,7 @@ func (w Workload) CastWorkloadStatus() *WorkloadStatus {
 		CurrentReplicas:   w.CurrentReplicas,
 		AvailableReplicas: w.AvailableReplicas,
 		SyncedProxies:     syncedProxies,
+		ScaledToZero:      w.Knative != nil && w.DesiredReplicas == 0,
 	}
 }
 
@@ -223,3 +260,13 @@ func (ps ProxyStatus) IsSynced() bool {
 func isComponentStatusSynced(componentStatus string) bool {
 	return componentStatus == "Synced"
 }
+
+// AppHealthTransition is a change of the health status of an app, as evaluated for the reports. The first status of
+// an app has no previous status, and an app that is gone has no status.
+type AppHealthTransition struct {
+	Namespace string    `json:"namespace"`
+	App       string    `json:"app"`
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestHealthErrorRatio(t *testing.T) {
+	assert := assert.New(t)
+
+	rqHealth := NewEmptyRequestHealth()
+	rqHealth.Inbound["http"] = map[string]float64{"200": 6, "404": 1, "503": 1, "-": 1}
+	rqHealth.Inbound["grpc"] = map[string]float64{"0": 0.5, "14": 0.5}
+
+	// 4xx codes are not failures
+	total, errorRatio := rqHealth.ErrorRatio()
+	assert.Equal(float64(10), total)
+	assert.InDelta(0.25, errorRatio, 0.0001)
+
+	total, errorRatio = NewEmptyRequestHealth().ErrorRatio()
+	assert.Zero(total)
+	assert.Zero(errorRatio)
+}
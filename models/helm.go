@@ -0,0 +1,63 @@
+package models
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Metadata set by Helm on the objects of a release
+const (
+	HelmChartLabel                 = "helm.sh/chart"
+	HelmReleaseNameAnnotation      = "meta.helm.sh/release-name"
+	HelmReleaseNamespaceAnnotation = "meta.helm.sh/release-namespace"
+)
+
+// HelmRelease identifies the Helm release managing an object.
+// Objects changed outside of Helm are reverted by the next upgrade of the release.
+type HelmRelease struct {
+	// Name of the Helm release
+	// required: true
+	// example: bookinfo
+	Name string `json:"name"`
+	// Namespace of the Helm release
+	// example: bookinfo
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the chart of the release
+	// example: bookinfo-gateway
+	Chart string `json:"chart,omitempty"`
+	// Version of the chart of the release
+	// example: 1.2.0
+	Version string `json:"version,omitempty"`
+}
+
+// HelmReleases are the Helm releases of the objects of an Istio config list.
+// Key: object type; Value: map of the releases, with the BuildNameNSKey of the objects as key.
+type HelmReleases map[string]map[string]*HelmRelease
+
+// GetHelmRelease returns the Helm release managing the object, or nil when the object is not managed by Helm.
+func GetHelmRelease(obj metav1.Object) *HelmRelease {
+	annotations := obj.GetAnnotations()
+	name := annotations[HelmReleaseNameAnnotation]
+	if name == "" {
+		return nil
+	}
+
+	release := &HelmRelease{
+		Name:      name,
+		Namespace: annotations[HelmReleaseNamespaceAnnotation],
+	}
+	if chart := obj.GetLabels()[HelmChartLabel]; chart != "" {
+		release.Chart, release.Version = parseHelmChart(chart)
+	}
+	return release
+}
+
+// parseHelmChart splits the helm.sh/chart label, set as <chart>-<version>, in the chart name and version.
+// Chart names can contain dashes, the version is the suffix after the last dash followed by a digit.
+func parseHelmChart(chart string) (string, string) {
+	for i := len(chart) - 2; i > 0; i-- {
+		if chart[i] == '-' && chart[i+1] >= '0' && chart[i+1] <= '9' {
+			return chart[:i], chart[i+1:]
+		}
+	}
+	return chart, ""
+}
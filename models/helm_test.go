@@ -0,0 +1,92 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+)
+
+func fakeHelmVirtualService(name, release, chart string) *networking_v1.VirtualService {
+	vs := &networking_v1.VirtualService{ObjectMeta: meta_v1.ObjectMeta{Name: name, Namespace: "bookinfo"}}
+	if release != "" {
+		vs.Annotations = map[string]string{
+			HelmReleaseNameAnnotation:      release,
+			HelmReleaseNamespaceAnnotation: "bookinfo",
+		}
+	}
+	if chart != "" {
+		vs.Labels = map[string]string{HelmChartLabel: chart}
+	}
+	return vs
+}
+
+func TestGetHelmRelease(t *testing.T) {
+	assert := assert.New(t)
+
+	release := GetHelmRelease(fakeHelmVirtualService("reviews", "bookinfo", "bookinfo-gateway-1.2.0-rc.1"))
+	assert.Equal(&HelmRelease{Name: "bookinfo", Namespace: "bookinfo", Chart: "bookinfo-gateway", Version: "1.2.0-rc.1"}, release)
+
+	release = GetHelmRelease(fakeHelmVirtualService("reviews", "bookinfo", "bookinfo"))
+	assert.Equal("bookinfo", release.Chart)
+	assert.Empty(release.Version)
+
+	release = GetHelmRelease(fakeHelmVirtualService("reviews", "bookinfo", ""))
+	assert.Empty(release.Chart)
+
+	// The chart label alone doesn't identify the release
+	assert.Nil(GetHelmRelease(fakeHelmVirtualService("reviews", "", "bookinfo-1.0.0")))
+}
+
+func TestIstioConfigListHelmReleases(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	list := IstioConfigList{
+		VirtualServices: []*networking_v1.VirtualService{
+			fakeHelmVirtualService("reviews", "bookinfo", "bookinfo-1.0.0"),
+			fakeHelmVirtualService("ratings", "", ""),
+		},
+	}
+
+	b, err := json.Marshal(list)
+	require.NoError(err)
+
+	var result struct {
+		HelmReleases HelmReleases `json:"helmReleases"`
+	}
+	require.NoError(json.Unmarshal(b, &result))
+	require.Len(result.HelmReleases, 1)
+	releases := result.HelmReleases[kubernetes.VirtualServices.String()]
+	require.Len(releases, 1)
+	assert.Equal("bookinfo", releases["reviews.bookinfo"].Name)
+	assert.Equal("1.0.0", releases["reviews.bookinfo"].Version)
+
+	// Lists without Helm releases don't include the key
+	b, err = json.Marshal(IstioConfigList{VirtualServices: list.VirtualServices[1:]})
+	require.NoError(err)
+	assert.NotContains(string(b), "helmReleases")
+}
+
+func TestIstioConfigDetailsHelmRelease(t *testing.T) {
+	require := require.New(t)
+
+	details := IstioConfigDetails{
+		ObjectGVK:      kubernetes.VirtualServices,
+		VirtualService: fakeHelmVirtualService("reviews", "bookinfo", "bookinfo-1.0.0"),
+	}
+	details.HelmRelease = GetHelmRelease(details.Resource())
+
+	b, err := json.Marshal(details)
+	require.NoError(err)
+
+	var unmarshaled IstioConfigDetails
+	require.NoError(json.Unmarshal(b, &unmarshaled))
+	require.Equal(details.HelmRelease, unmarshaled.HelmRelease)
+	require.Equal("reviews", unmarshaled.VirtualService.Name)
+}
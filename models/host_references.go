@@ -0,0 +1,23 @@
+package models
+
+// HostReference is a field of an Istio object that references a host.
+type HostReference struct {
+	IstioReference
+	// Path of the field referencing the host, i.e. "spec.http[0].route[1].destination.host"
+	Field string `json:"field"`
+	// The host, or host pattern, as written in the field
+	Value string `json:"value"`
+	// Precedence among the objects of the same type declaring the host, 1 being the one Istio applies.
+	// It is 0 for references that don't declare the host, such as route destinations.
+	Precedence int `json:"precedence"`
+	// Human readable explanation of the precedence
+	PrecedenceReason string `json:"precedenceReason,omitempty"`
+}
+
+// HostReferences lists the Istio objects referencing a host.
+type HostReferences struct {
+	Cluster string `json:"cluster"`
+	// The host looked up, as a FQDN when it is a service of the mesh
+	Host       string          `json:"host"`
+	References []HostReference `json:"references"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// IngressRouteTraffic is the traffic entering the mesh through a gateway to a route destination.
+type IngressRouteTraffic struct {
+	// VirtualService routing the traffic. Empty when the destination is not routed by any VirtualService bound to the gateway.
+	VirtualService *IstioReference `json:"virtualService,omitempty"`
+	// Hosts of the VirtualService
+	Hosts []string `json:"hosts"`
+	// Name of the HTTP route, or its index in the VirtualService when it has no name.
+	// The TCP and TLS routes are not reported, their traffic has no request metrics.
+	Route string `json:"route"`
+	// FQDN of the destination service
+	Destination string `json:"destination"`
+	// Request rate, in requests per second.
+	// Metrics are reported per destination: the traffic of a destination is reported by its first route only.
+	RequestRate float64 `json:"requestRate"`
+	// Ratio, between 0 and 1, of failed requests, with the same error codes as the request health
+	ErrorRatio float64 `json:"errorRatio"`
+	// Request duration quantiles in milliseconds, indexed by quantile (0.5, 0.95, 0.99)
+	Latencies map[string]float64 `json:"latencies"`
+	// Route reporting the traffic of the destination, as namespace/virtualService/route, when it is an earlier route.
+	// The traffic of this route is then part of it, and its rates are left empty.
+	TrafficReportedBy string `json:"trafficReportedBy,omitempty"`
+}
+
+// IngressTraffic is the inbound traffic going through a gateway, broken down by VirtualService route.
+type IngressTraffic struct {
+	Cluster   string    `json:"cluster"`
+	Namespace string    `json:"namespace"`
+	Gateway   string    `json:"gateway"`
+	QueryTime time.Time `json:"queryTime"`
+	// Interval used to compute the rates
+	RateInterval string `json:"rateInterval"`
+	// Gateway workloads, as namespace/name, selected by the Gateway
+	Workloads []string              `json:"workloads"`
+	Routes    []IngressRouteTraffic `json:"routes"`
+}
@@ -1,19 +1,26 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"sort"
+	"strconv"
 
 	extentions_v1alpha1 "istio.io/client-go/pkg/apis/extensions/v1alpha1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
 	networking_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	networking_v1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	security_v1 "istio.io/client-go/pkg/apis/security/v1"
 	telemetry_v1 "istio.io/client-go/pkg/apis/telemetry/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8s_networking_v1 "sigs.k8s.io/gateway-api/apis/v1"
 	k8s_networking_v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	k8s_networking_v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/util"
 )
 
 // IstioConfigList istioConfigList
@@ -23,6 +30,7 @@ type IstioConfigList struct {
 	DestinationRules []*networking_v1.DestinationRule   `json:"-"`
 	EnvoyFilters     []*networking_v1alpha3.EnvoyFilter `json:"-"`
 	Gateways         []*networking_v1.Gateway           `json:"-"`
+	ProxyConfigs     []*networking_v1beta1.ProxyConfig  `json:"-"`
 	ServiceEntries   []*networking_v1.ServiceEntry      `json:"-"`
 	Sidecars         []*networking_v1.Sidecar           `json:"-"`
 	VirtualServices  []*networking_v1.VirtualService    `json:"-"`
@@ -42,6 +50,31 @@ type IstioConfigList struct {
 	PeerAuthentications    []*security_v1.PeerAuthentication    `json:"-"`
 	RequestAuthentications []*security_v1.RequestAuthentication `json:"-"`
 	IstioValidations       IstioValidations                     `json:"-"`
+
+	// RequestedTypes are the types of objects that were fetched. Only these types are serialized, so that a
+	// client can tell a type without objects from a type that was not requested. Nil means all the types.
+	RequestedTypes []schema.GroupVersionKind `json:"-"`
+
+	// ResourceVersion is the bookmark of the list: the latest resourceVersion of its objects. A client holding the
+	// list gets the later changes with a delta list from this bookmark.
+	ResourceVersion string `json:"-"`
+
+	// Deleted are the deletions of the objects of a delta list, it only holds the objects changed after a bookmark.
+	// Nil for a full list.
+	Deleted []IstioConfigEvent `json:"-"`
+}
+
+// IsRequested returns whether the objects of the type were requested in the list.
+func (i IstioConfigList) IsRequested(gvk schema.GroupVersionKind) bool {
+	if i.RequestedTypes == nil {
+		return true
+	}
+	for _, requested := range i.RequestedTypes {
+		if requested == gvk {
+			return true
+		}
+	}
+	return false
 }
 
 func (i IstioConfigList) MarshalJSON() ([]byte, error) {
@@ -49,37 +82,58 @@ func (i IstioConfigList) MarshalJSON() ([]byte, error) {
 	jsonMap := make(map[string]interface{})
 
 	resources := make(map[string]interface{})
+	requestedTypes := []string{}
+	add := func(gvk schema.GroupVersionKind, objects interface{}) {
+		if i.IsRequested(gvk) {
+			resources[gvk.String()] = objects
+			requestedTypes = append(requestedTypes, gvk.String())
+		}
+	}
 
-	resources[kubernetes.DestinationRules.String()] = i.DestinationRules
-	resources[kubernetes.EnvoyFilters.String()] = i.EnvoyFilters
-	resources[kubernetes.Gateways.String()] = i.Gateways
-	resources[kubernetes.ServiceEntries.String()] = i.ServiceEntries
-	resources[kubernetes.Sidecars.String()] = i.Sidecars
-	resources[kubernetes.VirtualServices.String()] = i.VirtualServices
-	resources[kubernetes.WorkloadEntries.String()] = i.WorkloadEntries
-	resources[kubernetes.WorkloadGroups.String()] = i.WorkloadGroups
-	resources[kubernetes.WasmPlugins.String()] = i.WasmPlugins
-	resources[kubernetes.Telemetries.String()] = i.Telemetries
-	resources[kubernetes.K8sGateways.String()] = i.K8sGateways
-	resources[kubernetes.K8sGRPCRoutes.String()] = i.K8sGRPCRoutes
-	resources[kubernetes.K8sHTTPRoutes.String()] = i.K8sHTTPRoutes
-	resources[kubernetes.K8sReferenceGrants.String()] = i.K8sReferenceGrants
-	resources[kubernetes.K8sTCPRoutes.String()] = i.K8sTCPRoutes
-	resources[kubernetes.K8sTLSRoutes.String()] = i.K8sTLSRoutes
-	resources[kubernetes.AuthorizationPolicies.String()] = i.AuthorizationPolicies
-	resources[kubernetes.PeerAuthentications.String()] = i.PeerAuthentications
-	resources[kubernetes.RequestAuthentications.String()] = i.RequestAuthentications
+	add(kubernetes.DestinationRules, i.DestinationRules)
+	add(kubernetes.EnvoyFilters, i.EnvoyFilters)
+	add(kubernetes.Gateways, i.Gateways)
+	add(kubernetes.ProxyConfigs, i.ProxyConfigs)
+	add(kubernetes.ServiceEntries, i.ServiceEntries)
+	add(kubernetes.Sidecars, i.Sidecars)
+	add(kubernetes.VirtualServices, i.VirtualServices)
+	add(kubernetes.WorkloadEntries, i.WorkloadEntries)
+	add(kubernetes.WorkloadGroups, i.WorkloadGroups)
+	add(kubernetes.WasmPlugins, i.WasmPlugins)
+	add(kubernetes.Telemetries, i.Telemetries)
+	add(kubernetes.K8sGateways, i.K8sGateways)
+	add(kubernetes.K8sGRPCRoutes, i.K8sGRPCRoutes)
+	add(kubernetes.K8sHTTPRoutes, i.K8sHTTPRoutes)
+	add(kubernetes.K8sReferenceGrants, i.K8sReferenceGrants)
+	add(kubernetes.K8sTCPRoutes, i.K8sTCPRoutes)
+	add(kubernetes.K8sTLSRoutes, i.K8sTLSRoutes)
+	add(kubernetes.AuthorizationPolicies, i.AuthorizationPolicies)
+	add(kubernetes.PeerAuthentications, i.PeerAuthentications)
+	add(kubernetes.RequestAuthentications, i.RequestAuthentications)
 
 	jsonMap["resources"] = resources
+	jsonMap["requestedTypes"] = requestedTypes
 	jsonMap["validations"] = i.IstioValidations
+	if helmReleases := i.HelmReleases(); len(helmReleases) > 0 {
+		jsonMap["helmReleases"] = helmReleases
+	}
+	if i.ResourceVersion != "" {
+		jsonMap["resourceVersion"] = i.ResourceVersion
+	}
+	if i.Deleted != nil {
+		jsonMap["deleted"] = i.Deleted
+	}
 
 	return json.Marshal(jsonMap)
 }
 
 func (i *IstioConfigList) UnmarshalJSON(data []byte) error {
 	var temp struct {
-		Resources   map[string]json.RawMessage `json:"resources"`
-		Validations IstioValidations           `json:"validations"`
+		Resources       map[string]json.RawMessage `json:"resources"`
+		RequestedTypes  []string                   `json:"requestedTypes"`
+		Validations     IstioValidations           `json:"validations"`
+		ResourceVersion string                     `json:"resourceVersion"`
+		Deleted         []IstioConfigEvent         `json:"deleted"`
 	}
 
 	if err := json.Unmarshal(data, &temp); err != nil {
@@ -88,6 +142,19 @@ func (i *IstioConfigList) UnmarshalJSON(data []byte) error {
 
 	i.ConvertToResponse()
 	i.IstioValidations = temp.Validations
+	i.ResourceVersion = temp.ResourceVersion
+	i.Deleted = temp.Deleted
+
+	// Lists serialized before requestedTypes existed hold all the types
+	i.RequestedTypes = nil
+	if temp.RequestedTypes != nil {
+		i.RequestedTypes = []schema.GroupVersionKind{}
+		for _, requested := range temp.RequestedTypes {
+			if gvk, ok := kubernetes.ResourceTypesToAPI[requested]; ok {
+				i.RequestedTypes = append(i.RequestedTypes, gvk)
+			}
+		}
+	}
 
 	// Iterate over the resources map and unmarshal each resource type
 	for resourceType, rawMessage := range temp.Resources {
@@ -108,6 +175,10 @@ func (i *IstioConfigList) UnmarshalJSON(data []byte) error {
 			if err := json.Unmarshal(rawMessage, &i.Gateways); err != nil {
 				return err
 			}
+		case kubernetes.ProxyConfigs.String():
+			if err := json.Unmarshal(rawMessage, &i.ProxyConfigs); err != nil {
+				return err
+			}
 		case kubernetes.ServiceEntries.String():
 			if err := json.Unmarshal(rawMessage, &i.ServiceEntries); err != nil {
 				return err
@@ -196,6 +267,9 @@ func (i *IstioConfigList) ConvertToResponse() {
 	if i.Gateways == nil {
 		i.Gateways = []*networking_v1.Gateway{}
 	}
+	if i.ProxyConfigs == nil {
+		i.ProxyConfigs = []*networking_v1beta1.ProxyConfig{}
+	}
 	if i.ServiceEntries == nil {
 		i.ServiceEntries = []*networking_v1.ServiceEntry{}
 	}
@@ -251,6 +325,25 @@ func (i *IstioConfigList) ConvertToResponse() {
 // IstioConfigMap holds a map of IstioConfigList per cluster
 type IstioConfigMap map[string]IstioConfigList
 
+// IstioConfigNamespaceMap holds the result of listing the Istio config of each namespace
+type IstioConfigNamespaceMap map[string]IstioConfigNamespaceResult
+
+// IstioConfigNamespaceResult is the result of listing the Istio config of a namespace: either its list, or the error
+// that prevented listing it, i.e. when the namespace is not accessible.
+type IstioConfigNamespaceResult struct {
+	List  *IstioConfigList `json:"list,omitempty"`
+	Error string           `json:"error,omitempty"`
+}
+
+// ConvertToResponse coerces the nil arrays of each list to empty ones before returning them.
+func (m IstioConfigNamespaceMap) ConvertToResponse() {
+	for _, result := range m {
+		if result.List != nil {
+			result.List.ConvertToResponse()
+		}
+	}
+}
+
 type IstioConfigDetails struct {
 	Namespace Namespace               `json:"-"`
 	ObjectGVK schema.GroupVersionKind `json:"-"`
@@ -260,6 +353,7 @@ type IstioConfigDetails struct {
 	EnvoyFilter           *networking_v1alpha3.EnvoyFilter   `json:"-"`
 	Gateway               *networking_v1.Gateway             `json:"-"`
 	PeerAuthentication    *security_v1.PeerAuthentication    `json:"-"`
+	ProxyConfig           *networking_v1beta1.ProxyConfig    `json:"-"`
 	RequestAuthentication *security_v1.RequestAuthentication `json:"-"`
 	ServiceEntry          *networking_v1.ServiceEntry        `json:"-"`
 	Sidecar               *networking_v1.Sidecar             `json:"-"`
@@ -280,62 +374,93 @@ type IstioConfigDetails struct {
 	IstioValidation       *IstioValidation    `json:"-"`
 	IstioReferences       *IstioReferences    `json:"-"`
 	IstioConfigHelpFields []IstioConfigHelp   `json:"-"`
+	DeepLinks             []DeepLink          `json:"-"`
+	HelmRelease           *HelmRelease        `json:"-"`
+}
+
+// Resource returns the Istio object of the details, only one of the fields is set.
+// It returns nil when the object has not been fetched.
+func (i IstioConfigDetails) Resource() metav1.Object {
+	if i.AuthorizationPolicy != nil {
+		return i.AuthorizationPolicy
+	}
+	if i.DestinationRule != nil {
+		return i.DestinationRule
+	}
+	if i.EnvoyFilter != nil {
+		return i.EnvoyFilter
+	}
+	if i.Gateway != nil {
+		return i.Gateway
+	}
+	if i.PeerAuthentication != nil {
+		return i.PeerAuthentication
+	}
+	if i.ProxyConfig != nil {
+		return i.ProxyConfig
+	}
+	if i.RequestAuthentication != nil {
+		return i.RequestAuthentication
+	}
+	if i.ServiceEntry != nil {
+		return i.ServiceEntry
+	}
+	if i.Sidecar != nil {
+		return i.Sidecar
+	}
+	if i.VirtualService != nil {
+		return i.VirtualService
+	}
+	if i.WorkloadEntry != nil {
+		return i.WorkloadEntry
+	}
+	if i.WorkloadGroup != nil {
+		return i.WorkloadGroup
+	}
+	if i.WasmPlugin != nil {
+		return i.WasmPlugin
+	}
+	if i.Telemetry != nil {
+		return i.Telemetry
+	}
+	if i.K8sGateway != nil {
+		return i.K8sGateway
+	}
+	if i.K8sGRPCRoute != nil {
+		return i.K8sGRPCRoute
+	}
+	if i.K8sHTTPRoute != nil {
+		return i.K8sHTTPRoute
+	}
+	if i.K8sReferenceGrant != nil {
+		return i.K8sReferenceGrant
+	}
+	if i.K8sTCPRoute != nil {
+		return i.K8sTCPRoute
+	}
+	if i.K8sTLSRoute != nil {
+		return i.K8sTLSRoute
+	}
+	return nil
 }
 
 func (i IstioConfigDetails) MarshalJSON() ([]byte, error) {
 	// result map with keys and values
 	jsonMap := make(map[string]interface{})
 
-	var resource interface{}
-
-	// Check not nil field and assign to `resource`, should be only one field
-	if i.AuthorizationPolicy != nil {
-		resource = i.AuthorizationPolicy
-	} else if i.DestinationRule != nil {
-		resource = i.DestinationRule
-	} else if i.EnvoyFilter != nil {
-		resource = i.EnvoyFilter
-	} else if i.Gateway != nil {
-		resource = i.Gateway
-	} else if i.PeerAuthentication != nil {
-		resource = i.PeerAuthentication
-	} else if i.RequestAuthentication != nil {
-		resource = i.RequestAuthentication
-	} else if i.ServiceEntry != nil {
-		resource = i.ServiceEntry
-	} else if i.Sidecar != nil {
-		resource = i.Sidecar
-	} else if i.VirtualService != nil {
-		resource = i.VirtualService
-	} else if i.WorkloadEntry != nil {
-		resource = i.WorkloadEntry
-	} else if i.WorkloadGroup != nil {
-		resource = i.WorkloadGroup
-	} else if i.WasmPlugin != nil {
-		resource = i.WasmPlugin
-	} else if i.Telemetry != nil {
-		resource = i.Telemetry
-	} else if i.K8sGateway != nil {
-		resource = i.K8sGateway
-	} else if i.K8sGRPCRoute != nil {
-		resource = i.K8sGRPCRoute
-	} else if i.K8sHTTPRoute != nil {
-		resource = i.K8sHTTPRoute
-	} else if i.K8sReferenceGrant != nil {
-		resource = i.K8sReferenceGrant
-	} else if i.K8sTCPRoute != nil {
-		resource = i.K8sTCPRoute
-	} else if i.K8sTLSRoute != nil {
-		resource = i.K8sTLSRoute
-	}
-
-	jsonMap["resource"] = resource
+	jsonMap["resource"] = i.Resource()
 	jsonMap["namespace"] = i.Namespace
 	jsonMap["gvk"] = i.ObjectGVK
 	jsonMap["permissions"] = i.Permissions
 	jsonMap["validation"] = i.IstioValidation
 	jsonMap["references"] = i.IstioReferences
 	jsonMap["help"] = i.IstioConfigHelpFields
+	if len(i.DeepLinks) > 0 {
+		jsonMap["deepLinks"] = i.DeepLinks
+	}
+	if i.HelmRelease != nil {
+		jsonMap["helmRelease"] = i.HelmRelease
+	}
 
 	return json.Marshal(jsonMap)
 }
@@ -348,6 +473,8 @@ func (icd *IstioConfigDetails) UnmarshalJSON(data []byte) error {
 		IstioValidation       *IstioValidation        `json:"validation"`
 		IstioReferences       *IstioReferences        `json:"references"`
 		IstioConfigHelpFields []IstioConfigHelp       `json:"help"`
+		DeepLinks             []DeepLink              `json:"deepLinks"`
+		HelmRelease           *HelmRelease            `json:"helmRelease"`
 		Resource              json.RawMessage         `json:"resource"`
 	}
 
@@ -361,6 +488,8 @@ func (icd *IstioConfigDetails) UnmarshalJSON(data []byte) error {
 	icd.IstioValidation = temp.IstioValidation
 	icd.IstioReferences = temp.IstioReferences
 	icd.IstioConfigHelpFields = temp.IstioConfigHelpFields
+	icd.DeepLinks = temp.DeepLinks
+	icd.HelmRelease = temp.HelmRelease
 
 	// Based on the GVK, determine which resource type to unmarshal the resource into
 	switch temp.ObjectGVK {
@@ -399,6 +528,13 @@ func (icd *IstioConfigDetails) UnmarshalJSON(data []byte) error {
 		}
 		icd.PeerAuthentication = &pa
 
+	case kubernetes.ProxyConfigs:
+		var pc networking_v1beta1.ProxyConfig
+		if err := json.Unmarshal(temp.Resource, &pc); err != nil {
+			return err
+		}
+		icd.ProxyConfig = &pc
+
 	case kubernetes.RequestAuthentications:
 		var ra security_v1.RequestAuthentication
 		if err := json.Unmarshal(temp.Resource, &ra); err != nil {
@@ -564,6 +700,12 @@ var IstioConfigHelpMessages = map[string][]IstioConfigHelp{
 		{ObjectField: "spec.selector.matchLabels", Message: "One or more labels that indicate a specific set of pods/VMs on which a policy should be applied."},
 		{ObjectField: "spec.mtls", Message: "Mutual TLS settings for workload. If not defined, inherit from parent."},
 	},
+	kubernetes.ProxyConfigs.String(): {
+		{ObjectField: "spec.selector", Message: "Optional. Selectors specify the set of pods/VMs on which this ProxyConfig resource should be applied. If not set, the ProxyConfig resource will be applied to all workloads in the namespace where this resource is defined."},
+		{ObjectField: "spec.concurrency", Message: "The number of worker threads to run. If unset, defaults to 2. If set to 0, this will be configured to use all cores on the machine using CPU requests and limits to choose a value, with limits taking precedence over requests."},
+		{ObjectField: "spec.environmentVariables", Message: "Additional environment variables for the proxy. Names starting with ISTIO_META_ will be included in the generated bootstrap configuration and sent to the XDS server."},
+		{ObjectField: "spec.image", Message: "Specifies the details of the proxy image."},
+	},
 	kubernetes.RequestAuthentications.String(): {
 		{ObjectField: "spec.selector", Message: "Optional. The selector decides where to apply the request authentication policy. The selector will match with workloads in the same namespace as the request authentication policy. If the request authentication policy is in the root namespace, the selector will additionally match with workloads in all namespaces."},
 		{ObjectField: "spec.selector.matchLabels", Message: "One or more labels that indicate a specific set of pods/VMs on which a policy should be applied."},
@@ -671,6 +813,7 @@ func (configList IstioConfigList) FilterIstioConfigs(nss []string) *IstioConfigs
 			filtered[ns].K8sReferenceGrants = []*k8s_networking_v1beta1.ReferenceGrant{}
 			filtered[ns].K8sTCPRoutes = []*k8s_networking_v1alpha2.TCPRoute{}
 			filtered[ns].K8sTLSRoutes = []*k8s_networking_v1alpha2.TLSRoute{}
+			filtered[ns].ProxyConfigs = []*networking_v1beta1.ProxyConfig{}
 			filtered[ns].VirtualServices = []*networking_v1.VirtualService{}
 			filtered[ns].ServiceEntries = []*networking_v1.ServiceEntry{}
 			filtered[ns].Sidecars = []*networking_v1.Sidecar{}
@@ -736,6 +879,12 @@ func (configList IstioConfigList) FilterIstioConfigs(nss []string) *IstioConfigs
 			}
 		}
 
+		for _, pc := range configList.ProxyConfigs {
+			if pc.Namespace == ns {
+				filtered[ns].ProxyConfigs = append(filtered[ns].ProxyConfigs, pc)
+			}
+		}
+
 		for _, se := range configList.ServiceEntries {
 			if se.Namespace == ns {
 				filtered[ns].ServiceEntries = append(filtered[ns].ServiceEntries, se)
@@ -817,6 +966,7 @@ func (configList IstioConfigList) MergeConfigs(ns IstioConfigList) IstioConfigLi
 	configList.K8sTCPRoutes = append(configList.K8sTCPRoutes, ns.K8sTCPRoutes...)
 	configList.K8sTLSRoutes = append(configList.K8sTLSRoutes, ns.K8sTLSRoutes...)
 	configList.PeerAuthentications = append(configList.PeerAuthentications, ns.PeerAuthentications...)
+	configList.ProxyConfigs = append(configList.ProxyConfigs, ns.ProxyConfigs...)
 	configList.RequestAuthentications = append(configList.RequestAuthentications, ns.RequestAuthentications...)
 	configList.ServiceEntries = append(configList.ServiceEntries, ns.ServiceEntries...)
 	configList.Sidecars = append(configList.Sidecars, ns.Sidecars...)
@@ -828,3 +978,122 @@ func (configList IstioConfigList) MergeConfigs(ns IstioConfigList) IstioConfigLi
 
 	return configList
 }
+
+// ResourceVersionsHash returns a hash of the kind, namespace, name and resourceVersion of every object of the list.
+// It changes whenever an object is added, updated or removed, regardless of the order of the objects.
+// Validations are not part of the hash.
+func (configList IstioConfigList) ResourceVersionsHash() string {
+	keys := []string{}
+	keys = appendResourceVersionKeys(keys, kubernetes.DestinationRules, configList.DestinationRules)
+	keys = appendResourceVersionKeys(keys, kubernetes.EnvoyFilters, configList.EnvoyFilters)
+	keys = appendResourceVersionKeys(keys, kubernetes.Gateways, configList.Gateways)
+	keys = appendResourceVersionKeys(keys, kubernetes.ProxyConfigs, configList.ProxyConfigs)
+	keys = appendResourceVersionKeys(keys, kubernetes.ServiceEntries, configList.ServiceEntries)
+	keys = appendResourceVersionKeys(keys, kubernetes.Sidecars, configList.Sidecars)
+	keys = appendResourceVersionKeys(keys, kubernetes.VirtualServices, configList.VirtualServices)
+	keys = appendResourceVersionKeys(keys, kubernetes.WorkloadEntries, configList.WorkloadEntries)
+	keys = appendResourceVersionKeys(keys, kubernetes.WorkloadGroups, configList.WorkloadGroups)
+	keys = appendResourceVersionKeys(keys, kubernetes.WasmPlugins, configList.WasmPlugins)
+	keys = appendResourceVersionKeys(keys, kubernetes.Telemetries, configList.Telemetries)
+	keys = appendResourceVersionKeys(keys, kubernetes.K8sGateways, configList.K8sGateways)
+	keys = appendResourceVersionKeys(keys, kubernetes.K8sGRPCRoutes, configList.K8sGRPCRoutes)
+	keys = appendResourceVersionKeys(keys, kubernetes.K8sHTTPRoutes, configList.K8sHTTPRoutes)
+	keys = appendResourceVersionKeys(keys, kubernetes.K8sReferenceGrants, configList.K8sReferenceGrants)
+	keys = appendResourceVersionKeys(keys, kubernetes.K8sTCPRoutes, configList.K8sTCPRoutes)
+	keys = appendResourceVersionKeys(keys, kubernetes.K8sTLSRoutes, configList.K8sTLSRoutes)
+	keys = appendResourceVersionKeys(keys, kubernetes.AuthorizationPolicies, configList.AuthorizationPolicies)
+	keys = appendResourceVersionKeys(keys, kubernetes.PeerAuthentications, configList.PeerAuthentications)
+	keys = appendResourceVersionKeys(keys, kubernetes.RequestAuthentications, configList.RequestAuthentications)
+
+	// Cache listers don't guarantee any order
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, key := range keys {
+		_, _ = h.Write([]byte(key))
+		_, _ = h.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// LatestResourceVersion returns the highest resourceVersion of the objects of the list, 0 when the list is empty.
+// ResourceVersions are opaque for the Kubernetes API, but they are the etcd revisions of the changes in practice:
+// the objects changed later have a higher resourceVersion.
+func (configList IstioConfigList) LatestResourceVersion() uint64 {
+	latest := uint64(0)
+	latest = latestResourceVersion(latest, configList.DestinationRules)
+	latest = latestResourceVersion(latest, configList.EnvoyFilters)
+	latest = latestResourceVersion(latest, configList.Gateways)
+	latest = latestResourceVersion(latest, configList.ProxyConfigs)
+	latest = latestResourceVersion(latest, configList.ServiceEntries)
+	latest = latestResourceVersion(latest, configList.Sidecars)
+	latest = latestResourceVersion(latest, configList.VirtualServices)
+	latest = latestResourceVersion(latest, configList.WorkloadEntries)
+	latest = latestResourceVersion(latest, configList.WorkloadGroups)
+	latest = latestResourceVersion(latest, configList.WasmPlugins)
+	latest = latestResourceVersion(latest, configList.Telemetries)
+	latest = latestResourceVersion(latest, configList.K8sGateways)
+	latest = latestResourceVersion(latest, configList.K8sGRPCRoutes)
+	latest = latestResourceVersion(latest, configList.K8sHTTPRoutes)
+	latest = latestResourceVersion(latest, configList.K8sReferenceGrants)
+	latest = latestResourceVersion(latest, configList.K8sTCPRoutes)
+	latest = latestResourceVersion(latest, configList.K8sTLSRoutes)
+	latest = latestResourceVersion(latest, configList.AuthorizationPolicies)
+	latest = latestResourceVersion(latest, configList.PeerAuthentications)
+	latest = latestResourceVersion(latest, configList.RequestAuthentications)
+	return latest
+}
+
+func latestResourceVersion[T metav1.Object](latest uint64, objects []T) uint64 {
+	for _, o := range objects {
+		if rv, err := strconv.ParseUint(o.GetResourceVersion(), 10, 64); err == nil && rv > latest {
+			latest = rv
+		}
+	}
+	return latest
+}
+
+// HelmReleases returns the Helm releases managing the objects of the list, objects not managed by Helm are skipped.
+func (configList IstioConfigList) HelmReleases() HelmReleases {
+	releases := HelmReleases{}
+	addHelmReleases(releases, kubernetes.DestinationRules, configList.DestinationRules)
+	addHelmReleases(releases, kubernetes.EnvoyFilters, configList.EnvoyFilters)
+	addHelmReleases(releases, kubernetes.Gateways, configList.Gateways)
+	addHelmReleases(releases, kubernetes.ProxyConfigs, configList.ProxyConfigs)
+	addHelmReleases(releases, kubernetes.ServiceEntries, configList.ServiceEntries)
+	addHelmReleases(releases, kubernetes.Sidecars, configList.Sidecars)
+	addHelmReleases(releases, kubernetes.VirtualServices, configList.VirtualServices)
+	addHelmReleases(releases, kubernetes.WorkloadEntries, configList.WorkloadEntries)
+	addHelmReleases(releases, kubernetes.WorkloadGroups, configList.WorkloadGroups)
+	addHelmReleases(releases, kubernetes.WasmPlugins, configList.WasmPlugins)
+	addHelmReleases(releases, kubernetes.Telemetries, configList.Telemetries)
+	addHelmReleases(releases, kubernetes.K8sGateways, configList.K8sGateways)
+	addHelmReleases(releases, kubernetes.K8sGRPCRoutes, configList.K8sGRPCRoutes)
+	addHelmReleases(releases, kubernetes.K8sHTTPRoutes, configList.K8sHTTPRoutes)
+	addHelmReleases(releases, kubernetes.K8sReferenceGrants, configList.K8sReferenceGrants)
+	addHelmReleases(releases, kubernetes.K8sTCPRoutes, configList.K8sTCPRoutes)
+	addHelmReleases(releases, kubernetes.K8sTLSRoutes, configList.K8sTLSRoutes)
+	addHelmReleases(releases, kubernetes.AuthorizationPolicies, configList.AuthorizationPolicies)
+	addHelmReleases(releases, kubernetes.PeerAuthentications, configList.PeerAuthentications)
+	addHelmReleases(releases, kubernetes.RequestAuthentications, configList.RequestAuthentications)
+	return releases
+}
+
+func addHelmReleases[T metav1.Object](releases HelmReleases, gvk schema.GroupVersionKind, objects []T) {
+	for _, o := range objects {
+		release := GetHelmRelease(o)
+		if release == nil {
+			continue
+		}
+		if _, ok := releases[gvk.String()]; !ok {
+			releases[gvk.String()] = map[string]*HelmRelease{}
+		}
+		releases[gvk.String()][util.BuildNameNSKey(o.GetName(), o.GetNamespace())] = release
+	}
+}
+
+func appendResourceVersionKeys[T metav1.Object](keys []string, gvk schema.GroupVersionKind, objects []T) []string {
+	for _, o := range objects {
+		keys = append(keys, gvk.String()+"/"+o.GetNamespace()+"/"+o.GetName()+"/"+o.GetResourceVersion())
+	}
+	return keys
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// IstioConfigEvent is a change of an Istio object, captured by watching the Istio config or made through Kiali.
+type IstioConfigEvent struct {
+	// Author of the change, when known: the Kiali user for the changes made through Kiali, otherwise the field
+	// manager of the change (i.e. kubectl-edit, helm)
+	// example: jdoe
+	Author string `json:"author,omitempty"`
+
+	// Cluster of the object
+	// required: true
+	Cluster string `json:"cluster"`
+
+	// Name of the object
+	// required: true
+	Name string `json:"name"`
+
+	// Namespace of the object
+	// required: true
+	Namespace string `json:"namespace"`
+
+	// ObjectGVK is the type of the object
+	// required: true
+	ObjectGVK schema.GroupVersionKind `json:"gvk"`
+
+	// ResourceVersion of the object after the change, or before its deletion
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+
+	// Timestamp of the change, as seen by Kiali
+	// required: true
+	Timestamp time.Time `json:"timestamp"`
+
+	// Type of the change: CREATE, UPDATE or DELETE
+	// required: true
+	// example: UPDATE
+	Type string `json:"type"`
+
+	// ViaKiali is true when the change was made through Kiali
+	ViaKiali bool `json:"viaKiali"`
+}
+
+// SameObject returns true when both events are changes of the same object.
+func (e IstioConfigEvent) SameObject(other IstioConfigEvent) bool {
+	return e.Cluster == other.Cluster && e.Namespace == other.Namespace && e.ObjectGVK == other.ObjectGVK && e.Name == other.Name
+}
@@ -0,0 +1,19 @@
+package models
+
+// IstioConfigBundle is a set of Istio objects applied together to a namespace. The objects are templates: the
+// ${name} placeholders found in their names and definitions are replaced with the values of the variables, so
+// that the same bundle can be promoted across environments.
+type IstioConfigBundle struct {
+	Objects []IstioConfigSnapshotObject `json:"objects"`
+	// Values of the template variables. The well known ones are validated before applying the bundle:
+	// namespace (defaults to the target namespace), hostSuffix and gateway
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// IstioConfigBundleApply is the result of applying a bundle.
+type IstioConfigBundleApply struct {
+	// Objects created
+	Applied []IstioReference `json:"applied"`
+	// Objects left untouched because they already exist
+	Skipped []IstioReference `json:"skipped"`
+}
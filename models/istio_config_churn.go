@@ -0,0 +1,43 @@
+package models
+
+// IstioConfigChurn is the rate of the changes of the Istio config of the namespaces of a cluster, seen by the watches
+// of the Kiali cache during a window.
+type IstioConfigChurn struct {
+	// Cluster of the namespaces
+	// required: true
+	Cluster string `json:"cluster"`
+
+	// The window of the changes, i.e. "10m"
+	// required: true
+	Window string `json:"window"`
+
+	// Namespaces with changes during the window, the most changed first
+	// required: true
+	Namespaces []NamespaceConfigChurn `json:"namespaces"`
+}
+
+// NamespaceConfigChurn is the rate of the changes of the Istio config of a namespace.
+type NamespaceConfigChurn struct {
+	// Namespace of the changed objects
+	// required: true
+	Namespace string `json:"namespace"`
+
+	// Changes is the number of creations, updates and deletions of Istio objects during the window
+	// required: true
+	// example: 120
+	Changes int `json:"changes"`
+
+	// Rate is the number of changes per minute during the window
+	// required: true
+	// example: 12
+	Rate float64 `json:"rate"`
+
+	// Kinds are the numbers of changes by kind of object
+	// required: true
+	Kinds map[string]int `json:"kinds"`
+
+	// Authors are the numbers of creations and updates by field manager: the controller or the tool making them.
+	// Deletions have no author.
+	// required: true
+	Authors map[string]int `json:"authors"`
+}
@@ -0,0 +1,32 @@
+package models
+
+// IstioConfigDeleteCheckRequest holds the Istio objects of a cluster about to be deleted.
+type IstioConfigDeleteCheckRequest struct {
+	Objects []IstioReference `json:"objects"`
+}
+
+// IstioConfigDeleteCheck reports the state of an Istio object about to be deleted, for delete confirmations.
+type IstioConfigDeleteCheck struct {
+	IstioReference
+
+	// Exists is false when the object has already been deleted or the namespace is not accessible
+	// required: true
+	Exists bool `json:"exists"`
+
+	// CanDelete is true when the user has the permission to delete the object
+	// required: true
+	CanDelete bool `json:"canDelete"`
+
+	// References are the Istio objects related to the object, i.e. the VirtualServices bound to a Gateway,
+	// which can be broken by the deletion
+	// required: true
+	References []IstioReference `json:"references"`
+
+	// Dependents are the references which break when the object is deleted, checked by the delete protection
+	// required: true
+	Dependents []IstioReference `json:"dependents"`
+
+	// DeleteBlocked is true when the delete protection blocks the deletion of the object because of its dependents
+	// required: true
+	DeleteBlocked bool `json:"deleteBlocked"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import "k8s.io/apimachinery/pkg/runtime/schema"
+
+const (
+	// IstioConfigDiffChanged is set on objects found on both sides with different specs
+	IstioConfigDiffChanged = "changed"
+	// IstioConfigDiffSourceOnly is set on objects only found on the source side
+	IstioConfigDiffSourceOnly = "sourceOnly"
+	// IstioConfigDiffTargetOnly is set on objects only found on the target side
+	IstioConfigDiffTargetOnly = "targetOnly"
+	// IstioConfigDiffUnchanged is set on objects found on both sides with the same spec
+	IstioConfigDiffUnchanged = "unchanged"
+)
+
+// IstioConfigLocation is a namespace of a cluster holding Istio config.
+type IstioConfigLocation struct {
+	Cluster   string `json:"cluster"`
+	Namespace string `json:"namespace"`
+}
+
+// IstioConfigFieldDiff is a spec field with different values on each side.
+type IstioConfigFieldDiff struct {
+	// Path of the field, i.e. "spec.http[0].route[1].weight"
+	Path string `json:"path"`
+	// Value on the source side. Empty when the field is not set.
+	Source interface{} `json:"source,omitempty"`
+	// Value on the target side. Empty when the field is not set.
+	Target interface{} `json:"target,omitempty"`
+}
+
+// IstioConfigObjectDiff compares the spec of the objects of the same kind and name on each side.
+type IstioConfigObjectDiff struct {
+	ObjectGVK schema.GroupVersionKind `json:"objectGVK"`
+	Name      string                  `json:"name"`
+	// One of changed, sourceOnly, targetOnly or unchanged
+	Status string `json:"status"`
+	// Fields with different values, set for changed objects
+	Fields []IstioConfigFieldDiff `json:"fields,omitempty"`
+}
+
+// IstioConfigDiff compares the Istio config of two namespaces, of the same or of different clusters.
+type IstioConfigDiff struct {
+	Source  IstioConfigLocation     `json:"source"`
+	Target  IstioConfigLocation     `json:"target"`
+	Objects []IstioConfigObjectDiff `json:"objects"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Operations of the Istio config mutations
+const (
+	IstioConfigMutationCreate = "CREATE"
+	IstioConfigMutationUpdate = "UPDATE"
+	IstioConfigMutationDelete = "DELETE"
+)
+
+// IstioConfigMutation is a change of the Istio config sent for review to the mutation webhook.
+type IstioConfigMutation struct {
+	Operation string                  `json:"operation"`
+	Cluster   string                  `json:"cluster"`
+	Namespace string                  `json:"namespace"`
+	ObjectGVK schema.GroupVersionKind `json:"gvk"`
+	Name      string                  `json:"name"`
+	// User is the name of the logged in user making the change.
+	User string `json:"user"`
+	// Headers are the configured headers of the Kiali request, i.e. a change ticket number.
+	Headers map[string]string `json:"headers,omitempty"`
+	// OldObject is the object before the change. Empty on creation.
+	OldObject interface{} `json:"oldObject,omitempty"`
+	// Object is the object after the change. Empty on deletion.
+	Object interface{} `json:"object,omitempty"`
+	// Diff holds the fields changed, the source being the old object and the target the new one.
+	Diff []IstioConfigFieldDiff `json:"diff"`
+}
+
+// IstioConfigMutationReview is the reply of the mutation webhook.
+type IstioConfigMutationReview struct {
+	Allowed bool `json:"allowed"`
+	// Reason is reported to the user when the change is not allowed.
+	Reason string `json:"reason,omitempty"`
+}
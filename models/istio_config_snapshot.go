@@ -0,0 +1,41 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// IstioConfigSnapshotSummary describes a snapshot of the Istio config of a namespace.
+type IstioConfigSnapshotSummary struct {
+	ID        string    `json:"id"`
+	Cluster   string    `json:"cluster"`
+	Namespace string    `json:"namespace"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Number of Istio objects saved
+	ObjectCount int `json:"objectCount"`
+}
+
+// IstioConfigSnapshotObject is an Istio object saved in a snapshot.
+type IstioConfigSnapshotObject struct {
+	ObjectGVK schema.GroupVersionKind `json:"objectGVK"`
+	Name      string                  `json:"name"`
+	// The object without its status and the metadata set by the cluster, so that it can be created again
+	Object json.RawMessage `json:"object"`
+}
+
+// IstioConfigSnapshot is a snapshot of the Istio config of a namespace.
+type IstioConfigSnapshot struct {
+	IstioConfigSnapshotSummary
+	Objects []IstioConfigSnapshotObject `json:"objects"`
+}
+
+// IstioConfigSnapshotRestore is the result of restoring a snapshot.
+type IstioConfigSnapshotRestore struct {
+	Snapshot IstioConfigSnapshotSummary `json:"snapshot"`
+	// Objects created again
+	Restored []IstioReference `json:"restored"`
+	// Objects left untouched because they already exist
+	Skipped []IstioReference `json:"skipped"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// DeletedIstioConfig is an Istio object deleted from Kiali whose deletion can be undone until it expires.
+type DeletedIstioConfig struct {
+	IstioConfigSnapshotObject
+	ID        string    `json:"id"`
+	Cluster   string    `json:"cluster"`
+	Namespace string    `json:"namespace"`
+	DeletedAt time.Time `json:"deletedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// DeletedIstioConfigRestore is the result of undoing the deletion of an Istio object.
+type DeletedIstioConfigRestore struct {
+	Deleted DeletedIstioConfig `json:"deleted"`
+	// False when an object with the same name was created since the deletion. It is left untouched.
+	Restored bool `json:"restored"`
+}
@@ -0,0 +1,13 @@
+package models
+
+// IstioConfigStreamLine is a line of the NDJSON stream of an Istio config list. A line holds either an object with
+// its type, named as the keys of the resources of an IstioConfigList, the validations of the objects, sent last
+// when requested, or the error that ended the stream. An object line holds the Helm release of the object when
+// it is managed by Helm.
+type IstioConfigStreamLine struct {
+	Type        string            `json:"type,omitempty"`
+	Object      interface{}       `json:"object,omitempty"`
+	HelmRelease *HelmRelease      `json:"helmRelease,omitempty"`
+	Validations *IstioValidations `json:"validations,omitempty"`
+	Error       string            `json:"error,omitempty"`
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kiali/kiali/kubernetes"
+)
+
+func TestIstioConfigListOmitsNotRequestedTypes(t *testing.T) {
+	require := require.New(t)
+
+	list := IstioConfigList{
+		VirtualServices: []*networking_v1.VirtualService{{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}}},
+		RequestedTypes:  []schema.GroupVersionKind{kubernetes.VirtualServices, kubernetes.Gateways},
+	}
+	list.ConvertToResponse()
+
+	b, err := json.Marshal(list)
+	require.NoError(err)
+
+	raw := struct {
+		Resources      map[string][]interface{} `json:"resources"`
+		RequestedTypes []string                 `json:"requestedTypes"`
+	}{}
+	require.NoError(json.Unmarshal(b, &raw))
+	require.Len(raw.Resources, 2)
+	require.Len(raw.Resources[kubernetes.VirtualServices.String()], 1)
+	require.Contains(raw.Resources, kubernetes.Gateways.String())
+	require.NotNil(raw.Resources[kubernetes.Gateways.String()], "a requested type without objects is an empty array")
+	require.NotContains(raw.Resources, kubernetes.DestinationRules.String())
+	require.ElementsMatch([]string{kubernetes.VirtualServices.String(), kubernetes.Gateways.String()}, raw.RequestedTypes)
+
+	decoded := IstioConfigList{}
+	require.NoError(json.Unmarshal(b, &decoded))
+	require.Len(decoded.VirtualServices, 1)
+	require.ElementsMatch(list.RequestedTypes, decoded.RequestedTypes)
+	require.True(decoded.IsRequested(kubernetes.Gateways))
+	require.False(decoded.IsRequested(kubernetes.DestinationRules))
+}
+
+func TestIstioConfigListAllTypesRequested(t *testing.T) {
+	require := require.New(t)
+
+	list := IstioConfigList{}
+	list.ConvertToResponse()
+	b, err := json.Marshal(list)
+	require.NoError(err)
+
+	raw := struct {
+		Resources      map[string][]interface{} `json:"resources"`
+		RequestedTypes []string                 `json:"requestedTypes"`
+	}{}
+	require.NoError(json.Unmarshal(b, &raw))
+	require.Len(raw.Resources, len(kubernetes.ResourceTypesToAPI))
+	require.Len(raw.RequestedTypes, len(kubernetes.ResourceTypesToAPI))
+
+	// A list without requestedTypes holds all the types
+	decoded := IstioConfigList{}
+	require.NoError(json.Unmarshal([]byte(`{"resources":{}}`), &decoded))
+	require.Nil(decoded.RequestedTypes)
+	require.True(decoded.IsRequested(kubernetes.DestinationRules))
+}
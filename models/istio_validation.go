@@ -48,6 +48,21 @@ type IstioValidationSummary struct {
 // ValidationSummaries holds a map of IstioValidationSummary per cluster and namespace
 type ValidationSummaries map[string]map[string]*IstioValidationSummary
 
+// ValidationRun is the result of the validation of the Istio config of the namespaces of a cluster.
+type ValidationRun struct {
+	Cluster string `json:"cluster"`
+	// The summary of the validations of each namespace, sorted by name
+	Namespaces  []NamespaceValidationRun `json:"namespaces"`
+	Validations IstioValidations         `json:"validations"`
+}
+
+// NamespaceValidationRun is the summary of the validations of a namespace in a ValidationRun.
+type NamespaceValidationRun struct {
+	IstioValidationSummary
+	// Set when the namespace could not be validated
+	Error string `json:"error,omitempty"`
+}
+
 // IstioValidations represents a set of IstioValidation grouped by IstioValidationKey.
 type IstioValidations map[IstioValidationKey]*IstioValidation
 
@@ -188,11 +203,21 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "PeerAuthentication enabling mTLS found, permissive mode needed",
 		Severity: ErrorSeverity,
 	},
+	"destinationrules.nodest.subsetlabelkey": {
+		Code:     "KIA0211",
+		Message:  "This subset label is not found in the pod template labels of any workload of the host",
+		Severity: ErrorSeverity,
+	},
 	"destinationrules.nodest.subsetnolabels": {
 		Code:     "KIA0209",
 		Message:  "This subset has not labels",
 		Severity: WarningSeverity,
 	},
+	"destinationrules.trafficpolicy.tlsconflict": {
+		Code:     "KIA0210",
+		Message:  "Other DestinationRules of the same host set a different TLS mode",
+		Severity: WarningSeverity,
+	},
 	"gateways.multimatch": {
 		Code:     "KIA0301",
 		Message:  "More than one Gateway for the same host port combination",
@@ -203,6 +228,11 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "No matching workload found for gateway selector in this namespace",
 		Severity: WarningSeverity,
 	},
+	"generic.annotation.deprecated": {
+		Code:     "KIA0006",
+		Message:  "This Istio annotation is deprecated",
+		Severity: WarningSeverity,
+	},
 	"generic.exportto.namespacenotfound": {
 		Code:     "KIA0005",
 		Message:  "No matching namespace found or namespace is not accessible",
@@ -283,6 +313,11 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "Port appProtocol must follow <protocol> form",
 		Severity: ErrorSeverity,
 	},
+	"port.appprotocol.missing": {
+		Code:     "KIA0603",
+		Message:  "The protocol is detected from the port name, prefer setting the appProtocol of the port",
+		Severity: Unknown,
+	},
 	"port.name.mismatch": {
 		Code:     "KIA0601",
 		Message:  "Port name must follow <protocol>[-suffix] form",
@@ -293,11 +328,31 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "Deployment exposing same port as Service not found",
 		Severity: WarningSeverity,
 	},
+	"service.deployment.selectortemplate": {
+		Code:     "KIA0703",
+		Message:  "The selector of a Deployment of the Service doesn't match the labels of its pod template",
+		Severity: ErrorSeverity,
+	},
+	"service.route.bypassgateway": {
+		Code:     "KIA0704",
+		Message:  "OpenShift Route exposes the Service bypassing the ingress gateway: the edge traffic misses mTLS and telemetry",
+		Severity: WarningSeverity,
+	},
+	"service.selector.templatelabel": {
+		Code:     "KIA0702",
+		Message:  "This selector label doesn't match the pod template labels of the closest Deployment",
+		Severity: WarningSeverity,
+	},
 	"serviceentries.workloadentries.addressmatch": {
 		Code:     "KIA1201",
 		Message:  "Missing one or more addresses from matching WorkloadEntries",
 		Severity: WarningSeverity,
 	},
+	"serviceentries.resolution.none.https": {
+		Code:     "KIA1202",
+		Message:  "Resolution NONE without addresses intercepts the traffic to every host on HTTPS and TLS ports",
+		Severity: WarningSeverity,
+	},
 	"sidecar.egress.servicenotfound": {
 		Code:     "KIA1004",
 		Message:  "This host has no matching entry in the service registry",
@@ -318,6 +373,26 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "Preferred nomenclature: <gateway namespace>/<gateway name>",
 		Severity: Unknown,
 	},
+	"virtualservices.host.shortname": {
+		Code:     "KIA1109",
+		Message:  "Short host name resolved in the namespace of the VirtualService while services of the same name exist in other namespaces, prefer the FQDN",
+		Severity: WarningSeverity,
+	},
+	"virtualservices.match.regex.invalid": {
+		Code:     "KIA1110",
+		Message:  "The regex is empty or is not a valid RE2 expression, Envoy rejects the config",
+		Severity: ErrorSeverity,
+	},
+	"virtualservices.match.regex.large": {
+		Code:     "KIA1111",
+		Message:  "The regex is large: it is costly to evaluate on every request and Envoy logs a warning",
+		Severity: WarningSeverity,
+	},
+	"virtualservices.match.regex.toolarge": {
+		Code:     "KIA1112",
+		Message:  "The regex exceeds the maximum program size accepted by Envoy, Envoy rejects the config",
+		Severity: ErrorSeverity,
+	},
 	"virtualservices.nohost.hostnotfound": {
 		Code:     "KIA1101",
 		Message:  "DestinationWeight on route doesn't have a valid service (host not found)",
@@ -328,6 +403,41 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "VirtualService is pointing to a non-existent gateway",
 		Severity: ErrorSeverity,
 	},
+	"virtualservices.cors.credentials.wildcard": {
+		Code:     "KIA1117",
+		Message:  "Browsers reject the credentials of the requests allowed for any origin",
+		Severity: ErrorSeverity,
+	},
+	"virtualservices.cors.maxage.invalid": {
+		Code:     "KIA1118",
+		Message:  "The maxAge of the CORS policy must be a valid and not negative duration",
+		Severity: ErrorSeverity,
+	},
+	"virtualservices.cors.origin.invalid": {
+		Code:     "KIA1119",
+		Message:  "The allowed origin must be a scheme and a host, with an optional port and without a path, i.e. https://example.com",
+		Severity: WarningSeverity,
+	},
+	"virtualservices.redirect.conflict": {
+		Code:     "KIA1113",
+		Message:  "A route can only have one of a redirect, route destinations or a direct response",
+		Severity: ErrorSeverity,
+	},
+	"virtualservices.redirect.loop": {
+		Code:     "KIA1114",
+		Message:  "The redirect sends the clients to the same host and to a path matched by this route again, in an endless loop",
+		Severity: ErrorSeverity,
+	},
+	"virtualservices.rewrite.authority.invalid": {
+		Code:     "KIA1115",
+		Message:  "The host rewrite must be a plain authority, without a scheme nor a path",
+		Severity: ErrorSeverity,
+	},
+	"virtualservices.rewrite.noprefix": {
+		Code:     "KIA1116",
+		Message:  "The URI rewrite replaces the whole path because the route does not only match URI prefixes",
+		Severity: WarningSeverity,
+	},
 	"virtualservices.route.singleweight": {
 		Code:     "KIA1104",
 		Message:  "The weight is assumed to be 100 because there is only one route destination",
@@ -353,6 +463,26 @@ var checkDescriptors = map[string]IstioCheck{
 		Message:  "This workload is not covered by any authorization policy",
 		Severity: WarningSeverity,
 	},
+	"wasmplugin.url.insecure": {
+		Code:     "KIA1802",
+		Message:  "The Wasm module is fetched over plain HTTP without a sha256 checksum",
+		Severity: WarningSeverity,
+	},
+	"wasmplugin.url.invalid": {
+		Code:     "KIA1801",
+		Message:  "The URL of the Wasm module is not valid or its scheme is not supported",
+		Severity: ErrorSeverity,
+	},
+	"workload.image.auto": {
+		Code:     "KIA1303",
+		Message:  "Some pods run a container with the image auto, which is only replaced when the proxy is injected",
+		Severity: ErrorSeverity,
+	},
+	"workload.proxy.stale": {
+		Code:     "KIA1302",
+		Message:  "The proxy of some pods is not synced with istiod",
+		Severity: WarningSeverity,
+	},
 	"workloadgroup.labels.duplicate": {
 		Code:     "KIA1702",
 		Message:  "More than one Workload Group with duplicate labels found in the same namespace",
@@ -415,6 +545,18 @@ func (iv IstioValidations) FilterByKey(objectGVK schema.GroupVersionKind, name s
 	return fiv
 }
 
+// FilterByNamespace returns the validations of the objects of a namespace
+func (iv IstioValidations) FilterByNamespace(namespace string) IstioValidations {
+	fiv := IstioValidations{}
+	for k, v := range iv {
+		if k.Namespace == namespace {
+			fiv[k] = v
+		}
+	}
+
+	return fiv
+}
+
 // FilterByTypes takes an input as ObjectTypes, transforms to singular types and filters the validations
 func (iv IstioValidations) FilterByTypes(objectTypes []string) IstioValidations {
 	types := make(map[string]bool, len(objectTypes))
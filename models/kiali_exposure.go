@@ -0,0 +1,31 @@
+package models
+
+// KialiExposureType is the way Kiali is reached from outside of the cluster.
+type KialiExposureType string
+
+const (
+	// KialiExposureConfigured is set when the public address of Kiali is set in the configuration (server.web_fqdn).
+	KialiExposureConfigured KialiExposureType = "Configured"
+	// KialiExposureRoute is set when Kiali is exposed by an OpenShift Route.
+	KialiExposureRoute KialiExposureType = "Route"
+	// KialiExposureIngress is set when Kiali is exposed by a Kubernetes Ingress.
+	KialiExposureIngress KialiExposureType = "Ingress"
+	// KialiExposureGatewayAPI is set when Kiali is exposed by an HTTPRoute of the Gateway API.
+	KialiExposureGatewayAPI KialiExposureType = "GatewayAPI"
+	// KialiExposureIstioGateway is set when Kiali is exposed by a VirtualService bound to an Istio Gateway.
+	KialiExposureIstioGateway KialiExposureType = "IstioGateway"
+	// KialiExposureLoadBalancer is set when the Kiali Service is of type LoadBalancer.
+	KialiExposureLoadBalancer KialiExposureType = "LoadBalancer"
+	// KialiExposurePortForward is set when nothing exposes Kiali, which is then only reachable by port-forwarding.
+	KialiExposurePortForward KialiExposureType = "PortForward"
+)
+
+// KialiExposure describes how Kiali is exposed and its externally reachable URL, used for the absolute links to the
+// Kiali console.
+type KialiExposure struct {
+	Type KialiExposureType `json:"type"`
+	// The object exposing Kiali, as namespace/name. Empty when Kiali is port-forwarded or its address is configured.
+	Resource string `json:"resource,omitempty"`
+	// The URL of Kiali including the web root, without a trailing slash
+	URL string `json:"url"`
+}
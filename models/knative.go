@@ -0,0 +1,55 @@
+package models
+
+// Labels set by Knative Serving on the pod templates of the Deployments of its Revisions
+const (
+	KnativeConfigurationLabel = "serving.knative.dev/configuration"
+	KnativeRevisionLabel      = "serving.knative.dev/revision"
+	KnativeServiceLabel       = "serving.knative.dev/service"
+)
+
+// KnativeInfo identifies the Knative Service, Configuration and Revision of a serverless workload.
+// Knative labels the pods of a Revision with the Revision name as app, so Istio reports the telemetry
+// of every Revision under its own canonical service.
+type KnativeInfo struct {
+	// Name of the Knative Service, empty when the Configuration is not managed by a Service
+	// example: reviews
+	Service string `json:"service,omitempty"`
+	// Name of the Knative Configuration
+	// example: reviews
+	Configuration string `json:"configuration"`
+	// Name of the Knative Revision
+	// required: true
+	// example: reviews-00001
+	Revision string `json:"revision"`
+}
+
+// GetKnativeInfo returns the Knative info of a workload from its labels, or nil when it is not a Knative Revision.
+func GetKnativeInfo(labels map[string]string) *KnativeInfo {
+	revision, ok := labels[KnativeRevisionLabel]
+	if !ok || revision == "" {
+		return nil
+	}
+	return &KnativeInfo{
+		Service:       labels[KnativeServiceLabel],
+		Configuration: labels[KnativeConfigurationLabel],
+		Revision:      revision,
+	}
+}
+
+// App returns the app aggregating the Revisions of the Knative Service, or of the Configuration when there is
+// no Service.
+func (k *KnativeInfo) App() string {
+	if k.Service != "" {
+		return k.Service
+	}
+	return k.Configuration
+}
+
+// WorkloadApp returns the app of a workload: the Knative Service of the serverless workloads, which aggregates
+// their Revisions, and otherwise the canonical app.
+func WorkloadApp(labels map[string]string) (string, bool) {
+	if knative := GetKnativeInfo(labels); knative != nil && knative.App() != "" {
+		return knative.App(), true
+	}
+	return CanonicalApp(labels)
+}
@@ -0,0 +1,77 @@
+package models
+
+// ListWindow describes a window of a sorted list, for the clients showing long lists a part at a time, i.e. the
+// virtualized tables. The order is stable: the items of the same sort key are ordered by namespace, name and type,
+// so that the consecutive windows of an unchanged list don't overlap.
+type ListWindow struct {
+	// Offset of the first item of the window in the sorted list
+	// required: true
+	Offset int `json:"offset"`
+	// Limit is the maximum number of items of the window
+	// required: true
+	Limit int `json:"limit"`
+	// Total is the number of items of the whole list
+	// required: true
+	Total int `json:"total"`
+	// SortBy is the sort key of the list
+	// required: true
+	// example: name
+	SortBy string `json:"sortBy"`
+	// SortOrder is asc or desc
+	// required: true
+	// example: asc
+	SortOrder string `json:"sortOrder"`
+}
+
+// ServiceWindow is a window of the services of a cluster.
+type ServiceWindow struct {
+	ListWindow
+	// Cluster where the services live in
+	// required: true
+	// example: east
+	Cluster string `json:"cluster"`
+	// Services of the window
+	// required: true
+	Services []ServiceOverview `json:"services"`
+	// Validations of the services of the window
+	Validations IstioValidations `json:"validations"`
+}
+
+// WorkloadWindow is a window of the workloads of a cluster.
+type WorkloadWindow struct {
+	ListWindow
+	// Cluster where the workloads live in
+	// required: true
+	// example: east
+	Cluster string `json:"cluster"`
+	// Workloads of the window
+	// required: true
+	Workloads []WorkloadListItem `json:"workloads"`
+	// Validations of the workloads of the window
+	Validations IstioValidations `json:"validations"`
+}
+
+// IstioConfigWindowItem is an Istio object of a window, with its type named as the keys of the resources of an
+// IstioConfigList.
+type IstioConfigWindowItem struct {
+	// required: true
+	// example: networking.istio.io/v1, Kind=VirtualService
+	Type string `json:"type"`
+	// required: true
+	Object      interface{}  `json:"object"`
+	HelmRelease *HelmRelease `json:"helmRelease,omitempty"`
+}
+
+// IstioConfigWindow is a window of the Istio objects of a cluster, of all the types.
+type IstioConfigWindow struct {
+	ListWindow
+	// Cluster where the objects live in
+	// required: true
+	// example: east
+	Cluster string `json:"cluster"`
+	// Resources of the window
+	// required: true
+	Resources []IstioConfigWindowItem `json:"resources"`
+	// Validations of the objects of the window, when requested
+	Validations IstioValidations `json:"validations,omitempty"`
+}
@@ -159,6 +159,8 @@ type IstioMeshConfig struct {
 	Certificates  []Certificate `yaml:"certificates,omitempty" json:"certificates,omitempty"`
 	DefaultConfig struct {
 		MeshId string `yaml:"meshId"`
+		// ProxyMetadata are the environment variables of the proxies, i.e. ISTIO_META_DNS_CAPTURE
+		ProxyMetadata map[string]string `yaml:"proxyMetadata,omitempty" json:"proxyMetadata,omitempty"`
 	} `yaml:"defaultConfig" json:"defaultConfig"`
 	// Default Export To fields, used when objects do not have ExportTo
 	DefaultDestinationRuleExportTo []string                      `yaml:"defaultDestinationRuleExportTo,omitempty"`
@@ -170,8 +172,10 @@ type IstioMeshConfig struct {
 	MeshMTLS                       struct {
 		MinProtocolVersion string `yaml:"minProtocolVersion"`
 	} `yaml:"meshMtls"`
-	OutboundTrafficPolicy OutboundPolicy `yaml:"outboundTrafficPolicy,omitempty" json:"outboundTrafficPolicy,omitempty"`
-	TrustDomain           string         `yaml:"trustDomain,omitempty"`
+	// LocalityLbSetting is the default locality load balancing setting of the mesh
+	LocalityLbSetting     *LocalityLbSetting `yaml:"localityLbSetting,omitempty" json:"localityLbSetting,omitempty"`
+	OutboundTrafficPolicy OutboundPolicy     `yaml:"outboundTrafficPolicy,omitempty" json:"outboundTrafficPolicy,omitempty"`
+	TrustDomain           string             `yaml:"trustDomain,omitempty"`
 }
 
 func (imc IstioMeshConfig) GetEnableAutoMtls() bool {
@@ -18,22 +18,32 @@ import (
 // IstioMetricsQuery holds query parameters for a typical metrics query
 type IstioMetricsQuery struct {
 	prometheus.RangeQuery
-	Aggregate       string
-	AggregateValue  string
-	App             string
-	Cluster         string
-	Direction       string // outbound | inbound
-	IncludeAmbient  bool
-	Filters         []string
-	Namespace       string
-	RequestProtocol string // e.g. http | grpc
-	Reporter        string // source | destination | both, defaults to source if not provided
-	Service         string
-	Workload        string
+	Aggregate        string
+	AggregateValue   string
+	AnomalyMethod    string  // zscore | mad, no anomaly scoring if empty
+	AnomalyThreshold float64 // defaults to the threshold of the method if 0
+	AnomalyWindow    int     // number of trailing changes of the baseline
+	App              string
+	Cluster          string
+	Direction        string // outbound | inbound
+	IncludeAmbient   bool
+	Filters          []string
+	Namespace        string
+	RequestProtocol  string // e.g. http | grpc
+	Reporter         string // source | destination | both, defaults to source if not provided
+	Service          string
+	Workload         string
 }
 
+// Anomaly scoring methods of the metrics
+const (
+	AnomalyMethodMAD    = "mad"
+	AnomalyMethodZScore = "zscore"
+)
+
 // FillDefaults fills the struct with default parameters
 func (q *IstioMetricsQuery) FillDefaults() {
+	q.AnomalyWindow = 20
 	q.Direction = "outbound"
 	q.IncludeAmbient = false
 	q.RangeQuery.FillDefaults()
@@ -132,10 +142,20 @@ func (q *ControlPlaneMetricsQuery) FillDefaults() {
 type Metric struct {
 	Labels     map[string]string `json:"labels"`
 	Datapoints []Datapoint       `json:"datapoints"`
+	Anomalies  []Anomaly         `json:"anomalies,omitempty"`
 	Stat       string            `json:"stat,omitempty"`
 	Name       string            `json:"name"`
 }
 
+// Anomaly flags a datapoint whose change from the previous datapoint is an outlier of the changes of a trailing
+// baseline.
+type Anomaly struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+	// Score of the change against the baseline: a z-score or a modified z-score, depending on the method
+	Score float64 `json:"score"`
+}
+
 type Datapoint struct {
 	Timestamp int64
 	Value     float64
@@ -250,6 +270,17 @@ func (s Datapoint) MarshalJSON() ([]byte, error) {
 	}.MarshalJSON()
 }
 
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Datapoint) UnmarshalJSON(b []byte) error {
+	var pair pmod.SamplePair
+	if err := pair.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	s.Timestamp = int64(pair.Timestamp)
+	s.Value = float64(pair.Value)
+	return nil
+}
+
 func convertSamplePair(from *pmod.SamplePair, scale float64) Datapoint {
 	return Datapoint{
 		Timestamp: int64(from.Timestamp),
@@ -0,0 +1,49 @@
+package models
+
+import "time"
+
+// MetricsBatchQuery holds the services and workloads of a namespace whose key inbound stats are fetched together.
+type MetricsBatchQuery struct {
+	// Services of the namespace
+	// example: ["reviews","ratings"]
+	Services []string `json:"services"`
+	// Workloads of the namespace
+	// example: ["reviews-v1","ratings-v1"]
+	Workloads []string `json:"workloads"`
+	// RateInterval of the stats, as a Prometheus duration. Defaults to 10m
+	// example: 10m
+	RateInterval string `json:"rateInterval"`
+	// QueryTime of the stats, as a Unix time in seconds. Defaults to now
+	QueryTime int64 `json:"queryTime"`
+
+	Cluster   string    `json:"-"`
+	Namespace string    `json:"-"`
+	Time      time.Time `json:"-"`
+}
+
+// MetricsBatch holds the key inbound stats of services and workloads, by name. Every requested name is reported,
+// with zero stats when it has no traffic.
+type MetricsBatch struct {
+	// RateInterval of the stats, adjusted to the age of the namespace
+	// required: true
+	// example: 10m
+	RateInterval string `json:"rateInterval"`
+	// Services stats, by service name
+	// required: true
+	Services map[string]BatchStats `json:"services"`
+	// Workloads stats, by workload name
+	// required: true
+	Workloads map[string]BatchStats `json:"workloads"`
+}
+
+// BatchStats are the key inbound stats of a service or a workload, as reported by the destination.
+type BatchStats struct {
+	// Request rate, in requests per second
+	// required: true
+	RequestRate float64 `json:"requestRate"`
+	// Ratio of failed requests, 0 without traffic
+	// required: true
+	ErrorRate float64 `json:"errorRate"`
+	// 95th percentile of the response times, in milliseconds, unset without traffic
+	ResponseTimeP95 *float64 `json:"responseTimeP95,omitempty"`
+}
@@ -10,4 +10,15 @@ type MTLSStatus struct {
 	// required: true
 	// example: MTLS_ENABLED
 	Status string `json:"status"`
+	// Effective mTLS mode: ENABLED, PARTIALLY_ENABLED, DISABLED or AUTO.
+	// AUTO means that no policy enforces mTLS, but auto mTLS upgrades the traffic between sidecars.
+	// example: ENABLED
+	Mode string `json:"mode"`
+}
+
+// MTLSOverview describes the mTLS status of the mesh and of each namespace of a cluster
+type MTLSOverview struct {
+	Cluster    string       `json:"cluster"`
+	Mesh       MTLSStatus   `json:"mesh"`
+	Namespaces []MTLSStatus `json:"namespaces"`
 }
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// The sources of the notifications, each of them posted to its own webhook
+const (
+	NotificationSourceTrafficBaseline = "traffic_baseline"
+)
+
+// Notification is a webhook notification queued for delivery. It is retried with an exponential backoff until it is
+// delivered, or kept as a dead letter once its attempts are exhausted.
+type Notification struct {
+	ID     string `json:"id"`
+	Source string `json:"source"`
+	// The JSON body posted to the webhook of the source
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	Attempts      int             `json:"attempts"`
+	NextAttemptAt time.Time       `json:"nextAttemptAt"`
+	// The error of the last failed attempt
+	LastError string `json:"lastError,omitempty"`
+	// Set once the notification is a dead letter
+	DeadAt *time.Time `json:"deadAt,omitempty"`
+}
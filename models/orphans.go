@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OrphanReason describes why an Istio object is considered orphaned.
+type OrphanReason string
+
+const (
+	// OrphanReasonNoHostMatch is set on VirtualServices whose hosts and destinations match no service.
+	OrphanReasonNoHostMatch OrphanReason = "NoHostMatch"
+	// OrphanReasonNoService is set on DestinationRules whose host matches no service.
+	OrphanReasonNoService OrphanReason = "NoService"
+	// OrphanReasonNoTraffic is set on ServiceEntries without observed traffic during the traffic window.
+	OrphanReasonNoTraffic OrphanReason = "NoTraffic"
+	// OrphanReasonNoVirtualService is set on Gateways not bound to any VirtualService.
+	OrphanReasonNoVirtualService OrphanReason = "NoVirtualService"
+)
+
+// OrphanedObject is an Istio object that is likely not used anymore.
+type OrphanedObject struct {
+	// The object type
+	ObjectGVK schema.GroupVersionKind `json:"objectGVK"`
+	Name      string                  `json:"name"`
+	Namespace string                  `json:"namespace"`
+	// Why the object is considered orphaned
+	Reason OrphanReason `json:"reason"`
+	// Human readable details about the reason
+	Message string `json:"message"`
+}
+
+// OrphanReport lists the likely orphaned Istio objects of a namespace.
+type OrphanReport struct {
+	Cluster     string    `json:"cluster"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	Namespace   string    `json:"namespace"`
+	// The window used to look for ServiceEntries traffic, i.e. "7d"
+	TrafficWindow string           `json:"trafficWindow"`
+	Objects       []OrphanedObject `json:"objects"`
+}
+
+// OrphanCleanupRequest holds the objects to remove from the cluster.
+type OrphanCleanupRequest struct {
+	Objects []IstioReference `json:"objects"`
+}
+
+// OrphanCleanupResult reports the outcome of a bulk delete of orphaned objects.
+type OrphanCleanupResult struct {
+	// Objects that were deleted
+	Deleted []IstioReference `json:"deleted"`
+	// Objects that were skipped, because they are not orphaned anymore or their deletion failed, with the reason
+	Skipped map[string]string `json:"skipped"`
+}
+
+// Contains returns true if the report has an orphaned object matching the reference.
+func (r OrphanReport) Contains(ref IstioReference) bool {
+	for _, o := range r.Objects {
+		if o.ObjectGVK == ref.ObjectGVK && o.Name == ref.Name && o.Namespace == ref.Namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"slices"
 	"strings"
 
 	core_v1 "k8s.io/api/core/v1"
@@ -51,11 +52,15 @@ type Reference struct {
 
 // ContainerInfo holds container name and image
 type ContainerInfo struct {
-	Name      string `json:"name"`
-	Image     string `json:"image"`
-	IsProxy   bool   `json:"isProxy"`
-	IsReady   bool   `json:"isReady"`
-	IsAmbient bool   `json:"isAmbient"`
+	Name         string           `json:"name"`
+	Image        string           `json:"image"`
+	IsProxy      bool             `json:"isProxy"`
+	IsReady      bool             `json:"isReady"`
+	IsAmbient    bool             `json:"isAmbient"`
+	Probes       []ContainerProbe `json:"probes,omitempty"`
+	RestartCount int32            `json:"restartCount"`
+	// Number of recent probe failures reported in the events, by probe type
+	ProbeFailures map[string]int32 `json:"probeFailures,omitempty"`
 }
 
 // Parse extracts desired information from k8s []Pod info
@@ -131,11 +136,12 @@ func (pod *Pod) Parse(p *core_v1.Pod) {
 		}
 		pod.Containers = append(pod.Containers, &container)
 	}
+	pod.parseContainersHealth(p)
 	pod.Status = string(p.Status.Phase)
 	pod.StatusMessage = string(p.Status.Message)
 	pod.StatusReason = string(p.Status.Reason)
-	_, pod.AppLabel = p.Labels[conf.IstioLabels.AppLabelName]
-	_, pod.VersionLabel = p.Labels[conf.IstioLabels.VersionLabelName]
+	_, pod.AppLabel = CanonicalApp(p.Labels)
+	_, pod.VersionLabel = CanonicalVersion(p.Labels)
 	pod.ServiceAccountName = p.Spec.ServiceAccountName
 }
 
@@ -254,6 +260,33 @@ func (pods Pods) SyncedPodProxiesCount() int32 {
 	return syncedProxies
 }
 
+// StaleProxies returns the names of the Pods whose proxy is not synced with istiod.
+// Pods without proxy status are not reported.
+func (pods Pods) StaleProxies() []string {
+	stale := []string{}
+	for _, pod := range pods {
+		if pod.ProxyStatus != nil && !pod.ProxyStatus.IsSynced() {
+			stale = append(stale, pod.Name)
+		}
+	}
+	return stale
+}
+
+// ImageAuto returns the names of the Pods running a container with the image "auto", which the injector replaces
+// by the proxy image. Such container can't start: the proxy was not injected in the Pod.
+func (pods Pods) ImageAuto() []string {
+	names := []string{}
+	for _, pod := range pods {
+		for _, containers := range [][]*ContainerInfo{pod.Containers, pod.IstioContainers} {
+			if slices.ContainsFunc(containers, func(c *ContainerInfo) bool { return c.Image == "auto" }) {
+				names = append(names, pod.Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
 // ServiceAccounts returns the names of each service account of the pod list
 func (pods Pods) ServiceAccounts() []string {
 	san := map[string]int{}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	core_v1 "k8s.io/api/core/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/kiali/kiali/config"
 )
@@ -210,3 +211,83 @@ func TestServiceNames(t *testing.T) {
 	a := assert.New(t)
 	a.ElementsMatch([]string{"bookinfo-details", "bookinfo-productpage", "bookinfo-rating"}, pods.ServiceAccounts())
 }
+
+func TestStaleProxies(t *testing.T) {
+	assert := assert.New(t)
+
+	synced := &ProxyStatus{CDS: "Synced", EDS: "Synced", LDS: "Synced", RDS: "Synced"}
+	stale := &ProxyStatus{CDS: "Synced", EDS: "Stale", LDS: "Synced", RDS: "Synced"}
+	pods := Pods{
+		{Name: "reviews-v1-synced", ProxyStatus: synced},
+		{Name: "reviews-v1-stale", ProxyStatus: stale},
+		{Name: "reviews-v1-unknown"},
+	}
+
+	assert.Equal([]string{"reviews-v1-stale"}, pods.StaleProxies())
+	assert.Empty(Pods{}.StaleProxies())
+}
+
+func TestPodProbesParsing(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	k8sPod := core_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "reviews-v1-abc"},
+		Spec: core_v1.PodSpec{
+			Containers: []core_v1.Container{
+				{
+					Name: "reviews",
+					LivenessProbe: &core_v1.Probe{
+						ProbeHandler:     core_v1.ProbeHandler{TCPSocket: &core_v1.TCPSocketAction{Port: intstr.FromInt(9080)}},
+						PeriodSeconds:    10,
+						FailureThreshold: 3,
+					},
+					ReadinessProbe: &core_v1.Probe{
+						ProbeHandler:   core_v1.ProbeHandler{HTTPGet: &core_v1.HTTPGetAction{Path: "/health", Port: intstr.FromString("http")}},
+						TimeoutSeconds: 1,
+					},
+				},
+			},
+		},
+		Status: core_v1.PodStatus{
+			ContainerStatuses: []core_v1.ContainerStatus{{Name: "reviews", RestartCount: 4}},
+		},
+	}
+
+	pod := Pod{}
+	pod.Parse(&k8sPod)
+	assert.Len(pod.Containers, 1)
+	assert.Equal(int32(4), pod.Containers[0].RestartCount)
+	assert.Equal([]ContainerProbe{
+		{Type: LivenessProbe, Handler: "tcpSocket", Port: "9080", PeriodSeconds: 10, FailureThreshold: 3},
+		{Type: ReadinessProbe, Handler: "httpGet", Path: "/health", Port: "http", TimeoutSeconds: 1},
+	}, pod.Containers[0].Probes)
+
+	pods := Pods{&pod}
+	assert.False(pods.HasProbeFailures())
+	pods.AddProbeFailures([]core_v1.Event{
+		{
+			InvolvedObject: core_v1.ObjectReference{Kind: "Pod", Name: "reviews-v1-abc", FieldPath: "spec.containers{reviews}"},
+			Reason:         "Unhealthy",
+			Message:        "Readiness probe failed: HTTP probe failed with statuscode: 503",
+			Count:          5,
+		},
+		{
+			InvolvedObject: core_v1.ObjectReference{Kind: "Pod", Name: "reviews-v1-abc", FieldPath: "spec.containers{reviews}"},
+			Reason:         "Unhealthy",
+			Message:        "Liveness probe failed: dial tcp 10.0.0.1:9080: connect: connection refused",
+		},
+		{
+			InvolvedObject: core_v1.ObjectReference{Kind: "Pod", Name: "reviews-v1-abc", FieldPath: "spec.containers{reviews}"},
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+		},
+		{
+			InvolvedObject: core_v1.ObjectReference{Kind: "Pod", Name: "other-pod", FieldPath: "spec.containers{reviews}"},
+			Reason:         "Unhealthy",
+			Message:        "Readiness probe failed",
+		},
+	})
+	assert.True(pods.HasProbeFailures())
+	assert.Equal(map[string]int32{ReadinessProbe: 5, LivenessProbe: 1}, pod.Containers[0].ProbeFailures)
+}
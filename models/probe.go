@@ -0,0 +1,185 @@
+package models
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	core_v1 "k8s.io/api/core/v1"
+)
+
+const (
+	LivenessProbe  = "liveness"
+	ReadinessProbe = "readiness"
+	StartupProbe   = "startup"
+)
+
+// probeFailedReason is the reason of the events reported by the kubelet when a probe fails.
+const probeFailedReason = "Unhealthy"
+
+// containerFieldPath matches the field path of the events involving a container, e.g. spec.containers{reviews}.
+var containerFieldPath = regexp.MustCompile(`^spec\.(?:init)?[cC]ontainers\{(.+)\}$`)
+
+// ContainerProbe describes a liveness, readiness or startup probe of a container
+type ContainerProbe struct {
+	// Type of the probe: liveness, readiness or startup
+	// required: true
+	// example: readiness
+	Type string `json:"type"`
+
+	// Handler of the probe: httpGet, tcpSocket, grpc or exec
+	// required: true
+	// example: httpGet
+	Handler string `json:"handler"`
+
+	// Path requested by httpGet probes
+	// example: /healthz
+	Path string `json:"path,omitempty"`
+
+	// Port checked by httpGet, tcpSocket and grpc probes
+	// example: 8080
+	Port string `json:"port,omitempty"`
+
+	// Command run by exec probes
+	Command []string `json:"command,omitempty"`
+
+	InitialDelaySeconds int32 `json:"initialDelaySeconds"`
+	PeriodSeconds       int32 `json:"periodSeconds"`
+	TimeoutSeconds      int32 `json:"timeoutSeconds"`
+	FailureThreshold    int32 `json:"failureThreshold"`
+}
+
+// parseContainerProbes returns the probes defined in the container.
+func parseContainerProbes(c *core_v1.Container) []ContainerProbe {
+	probes := []ContainerProbe{}
+	for _, p := range []struct {
+		probeType string
+		probe     *core_v1.Probe
+	}{
+		{LivenessProbe, c.LivenessProbe},
+		{ReadinessProbe, c.ReadinessProbe},
+		{StartupProbe, c.StartupProbe},
+	} {
+		if p.probe == nil {
+			continue
+		}
+		probe := ContainerProbe{
+			Type:                p.probeType,
+			InitialDelaySeconds: p.probe.InitialDelaySeconds,
+			PeriodSeconds:       p.probe.PeriodSeconds,
+			TimeoutSeconds:      p.probe.TimeoutSeconds,
+			FailureThreshold:    p.probe.FailureThreshold,
+		}
+		switch handler := p.probe.ProbeHandler; {
+		case handler.HTTPGet != nil:
+			probe.Handler = "httpGet"
+			probe.Path = handler.HTTPGet.Path
+			probe.Port = handler.HTTPGet.Port.String()
+		case handler.TCPSocket != nil:
+			probe.Handler = "tcpSocket"
+			probe.Port = handler.TCPSocket.Port.String()
+		case handler.GRPC != nil:
+			probe.Handler = "grpc"
+			probe.Port = strconv.Itoa(int(handler.GRPC.Port))
+		case handler.Exec != nil:
+			probe.Handler = "exec"
+			probe.Command = handler.Exec.Command
+		}
+		probes = append(probes, probe)
+	}
+	return probes
+}
+
+// parseContainersHealth sets the probes and the restart count of every container of the pod.
+func (pod *Pod) parseContainersHealth(p *core_v1.Pod) {
+	specs := map[string]*core_v1.Container{}
+	for i := range p.Spec.InitContainers {
+		specs[p.Spec.InitContainers[i].Name] = &p.Spec.InitContainers[i]
+	}
+	for i := range p.Spec.Containers {
+		specs[p.Spec.Containers[i].Name] = &p.Spec.Containers[i]
+	}
+	restarts := map[string]int32{}
+	for _, s := range p.Status.InitContainerStatuses {
+		restarts[s.Name] = s.RestartCount
+	}
+	for _, s := range p.Status.ContainerStatuses {
+		restarts[s.Name] = s.RestartCount
+	}
+
+	for _, c := range pod.allContainers() {
+		if spec, ok := specs[c.Name]; ok {
+			if probes := parseContainerProbes(spec); len(probes) > 0 {
+				c.Probes = probes
+			}
+		}
+		c.RestartCount = restarts[c.Name]
+	}
+}
+
+// AddProbeFailures counts the probe failures reported in the events of the pods, per container and probe type.
+func (pods Pods) AddProbeFailures(events []core_v1.Event) {
+	byName := map[string]*Pod{}
+	for _, pod := range pods {
+		byName[pod.Name] = pod
+	}
+	for _, e := range events {
+		if e.Reason != probeFailedReason || e.InvolvedObject.Kind != "Pod" {
+			continue
+		}
+		pod, ok := byName[e.InvolvedObject.Name]
+		if !ok {
+			continue
+		}
+		match := containerFieldPath.FindStringSubmatch(e.InvolvedObject.FieldPath)
+		if match == nil {
+			continue
+		}
+		probeType := probeTypeFromMessage(e.Message)
+		if probeType == "" {
+			continue
+		}
+		count := e.Count
+		if count == 0 {
+			count = 1
+		}
+		for _, c := range pod.allContainers() {
+			if c.Name != match[1] {
+				continue
+			}
+			if c.ProbeFailures == nil {
+				c.ProbeFailures = map[string]int32{}
+			}
+			c.ProbeFailures[probeType] += count
+		}
+	}
+}
+
+// HasProbeFailures returns whether any container of the pods has failed probes.
+func (pods Pods) HasProbeFailures() bool {
+	for _, pod := range pods {
+		for _, c := range pod.allContainers() {
+			if len(c.ProbeFailures) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// probeTypeFromMessage returns the type of the failed probe from the kubelet message, e.g. "Readiness probe failed: ...".
+func probeTypeFromMessage(message string) string {
+	for _, probeType := range []string{LivenessProbe, ReadinessProbe, StartupProbe} {
+		if strings.HasPrefix(strings.ToLower(message), probeType+" probe") {
+			return probeType
+		}
+	}
+	return ""
+}
+
+func (pod *Pod) allContainers() []*ContainerInfo {
+	containers := make([]*ContainerInfo, 0, len(pod.Containers)+len(pod.IstioContainers)+len(pod.IstioInitContainers))
+	containers = append(containers, pod.Containers...)
+	containers = append(containers, pod.IstioContainers...)
+	return append(containers, pod.IstioInitContainers...)
+}
@@ -0,0 +1,10 @@
+package models
+
+// ProxyLogLevels holds the log levels of the loggers of a pod's proxy.
+type ProxyLogLevels struct {
+	// Log level shared by all the loggers. Empty when the loggers have different levels.
+	// example: warning
+	Level string `json:"level"`
+	// Log level of each logger, indexed by logger name
+	Loggers map[string]string `json:"loggers"`
+}
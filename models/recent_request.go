@@ -0,0 +1,24 @@
+package models
+
+// RecentRequest is a request received by a service, parsed from the access log of the proxy of one of its pods.
+type RecentRequest struct {
+	// The start time of the request, as logged by the proxy
+	// example: 2021-02-01T21:34:35.533Z
+	Timestamp string `json:"timestamp"`
+	// The pod whose proxy received the request
+	Pod string `json:"pod"`
+
+	Authority string `json:"authority,omitempty"`
+	Method    string `json:"method,omitempty"`
+	Path      string `json:"path,omitempty"`
+	Protocol  string `json:"protocol,omitempty"`
+	// The response code, 0 when the connection was reset before a response
+	StatusCode int `json:"statusCode"`
+	// The Envoy response flags, i.e. "UF" or "URX"
+	ResponseFlags string `json:"responseFlags,omitempty"`
+	// The total duration of the request, in milliseconds
+	DurationMs int `json:"durationMs"`
+	// The address of the client of the request
+	Source    string `json:"source,omitempty"`
+	RequestID string `json:"requestId,omitempty"`
+}
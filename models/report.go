@@ -0,0 +1,132 @@
+package models
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The health statuses of the apps of a report
+const (
+	ReportHealthDegraded     = "Degraded"
+	ReportHealthFailure      = "Failure"
+	ReportHealthHealthy      = "Healthy"
+	ReportHealthNotAvailable = "NA"
+)
+
+// The formats of a report
+const (
+	ReportFormatHTML = "html"
+	ReportFormatJSON = "json"
+	ReportFormatPDF  = "pdf"
+)
+
+// The statuses of a report job
+const (
+	ReportJobFailed    = "failed"
+	ReportJobPending   = "pending"
+	ReportJobRunning   = "running"
+	ReportJobSucceeded = "succeeded"
+)
+
+// NamespaceReport is a snapshot of the mesh status of a namespace, rendered into a standalone document for audits.
+type NamespaceReport struct {
+	Cluster     string    `json:"cluster"`
+	Namespace   string    `json:"namespace"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	// The time range of the telemetry, as a Prometheus duration
+	Duration string `json:"duration"`
+	// Nil when the traffic graph could not be generated
+	Graph       *ReportGraph          `json:"graph,omitempty"`
+	Health      ReportHealth          `json:"health"`
+	Inventory   []ReportInventoryItem `json:"inventory"`
+	Validations []ReportValidation    `json:"validations"`
+	// Link to the graph of the namespace in the Kiali console, empty when the URL of Kiali is unknown
+	URL string `json:"url,omitempty"`
+}
+
+// ReportHealth is the health of the apps of a namespace.
+type ReportHealth struct {
+	Apps []ReportAppHealth `json:"apps"`
+	// Number of apps by status
+	Statuses map[string]int `json:"statuses"`
+}
+
+// ReportAppHealth is the health of an app, evaluated from its workloads and its inbound requests.
+type ReportAppHealth struct {
+	Name              string  `json:"name"`
+	Status            string  `json:"status"`
+	AvailableReplicas int32   `json:"availableReplicas"`
+	DesiredReplicas   int32   `json:"desiredReplicas"`
+	ErrorRatio        float64 `json:"errorRatio"`
+	RequestRate       float64 `json:"requestRate"`
+}
+
+// ReportInventoryItem lists the Istio objects of a kind.
+type ReportInventoryItem struct {
+	ObjectGVK schema.GroupVersionKind `json:"objectGVK"`
+	Names     []string                `json:"names"`
+}
+
+// ReportValidation is a validation finding of an Istio object.
+type ReportValidation struct {
+	ObjectGVK schema.GroupVersionKind `json:"objectGVK"`
+	Name      string                  `json:"name"`
+	Code      string                  `json:"code"`
+	Message   string                  `json:"message"`
+	Severity  SeverityLevel           `json:"severity"`
+}
+
+// ReportGraph is the traffic graph of a report, laid out server-side.
+type ReportGraph struct {
+	Nodes []ReportGraphNode `json:"nodes"`
+	Edges []ReportGraphEdge `json:"edges"`
+}
+
+// ReportGraphNode is a node of the traffic graph, at its position.
+type ReportGraphNode struct {
+	ID       string  `json:"id"`
+	Label    string  `json:"label"`
+	NodeType string  `json:"nodeType"`
+	X        float64 `json:"x"`
+	Y        float64 `json:"y"`
+}
+
+// ReportGraphEdge is an edge of the traffic graph.
+type ReportGraphEdge struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Protocol string `json:"protocol"`
+}
+
+// ReportJob is the asynchronous generation of a report. The content is downloaded once the job succeeded.
+type ReportJob struct {
+	ID         string     `json:"id"`
+	Cluster    string     `json:"cluster"`
+	Namespace  string     `json:"namespace"`
+	Format     string     `json:"format"`
+	Status     string     `json:"status"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// Set when the job failed
+	Error string `json:"error,omitempty"`
+	// Set when the job reports the progress of its items
+	Progress *ReportJobProgress `json:"progress,omitempty"`
+}
+
+// ReportJobProgress is the progress of a job processing several items, i.e. the namespaces of a validation run.
+type ReportJobProgress struct {
+	// Number of items
+	Total int `json:"total"`
+	// Number of items that succeeded or failed
+	Done  int                     `json:"done"`
+	Items []ReportJobItemProgress `json:"items"`
+}
+
+// ReportJobItemProgress is the status of an item processed by a job.
+type ReportJobItemProgress struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// Set when the item failed
+	Error string `json:"error,omitempty"`
+}
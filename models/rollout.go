@@ -0,0 +1,112 @@
+package models
+
+import (
+	apps_v1 "k8s.io/api/apps/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+)
+
+// Roles of the ReplicaSets of an Argo Rollout
+const (
+	RolloutRoleActive  = "active"
+	RolloutRoleCanary  = "canary"
+	RolloutRolePreview = "preview"
+	RolloutRoleStable  = "stable"
+)
+
+// Strategies of an Argo Rollout
+const (
+	RolloutStrategyBlueGreen = "blueGreen"
+	RolloutStrategyCanary    = "canary"
+)
+
+// RolloutInfo identifies the Argo Rollout owning a ReplicaSet workload and the role of the ReplicaSet in the rollout.
+// Every revision of a Rollout is a ReplicaSet, so the stable and canary pods are reported as distinct workloads.
+type RolloutInfo struct {
+	// Name of the Argo Rollout
+	// required: true
+	// example: reviews
+	Name string `json:"name"`
+	// Strategy of the Argo Rollout: canary or blueGreen
+	// required: true
+	// example: canary
+	Strategy string `json:"strategy"`
+	// Role of the ReplicaSet in the rollout: stable or canary for the canary strategy, active or preview for
+	// the blueGreen strategy. Empty for the older revisions.
+	// example: canary
+	Role string `json:"role,omitempty"`
+	// Pod template hash of the ReplicaSet, it identifies the revision of the Rollout
+	// required: true
+	// example: 6cf4c7d5b9
+	PodTemplateHash string `json:"podTemplateHash"`
+	// Percentage of the traffic the rollout controller intends to send to the ReplicaSet
+	// example: 20
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// GetRolloutInfo returns the role in the Rollout of one of its ReplicaSets and the traffic weight the rollout
+// controller assigns to it. The weights reported by the controller when it manages the traffic routing are preferred,
+// otherwise the weight is taken from the last setWeight step reached by the canary.
+func GetRolloutInfo(r *kubernetes.Rollout, rs *apps_v1.ReplicaSet) *RolloutInfo {
+	info := &RolloutInfo{
+		Name:            r.Name,
+		PodTemplateHash: rs.Labels[kubernetes.RolloutPodTemplateHashLabel],
+	}
+	if info.PodTemplateHash == "" {
+		info.PodTemplateHash = rs.Spec.Template.Labels[kubernetes.RolloutPodTemplateHashLabel]
+	}
+
+	if r.Spec.Strategy.BlueGreen != nil {
+		info.Strategy = RolloutStrategyBlueGreen
+		switch info.PodTemplateHash {
+		case r.Status.BlueGreen.ActiveSelector:
+			info.Role = RolloutRoleActive
+			info.Weight = weight(100)
+		case r.Status.BlueGreen.PreviewSelector:
+			info.Role = RolloutRolePreview
+			info.Weight = weight(0)
+		}
+		return info
+	}
+
+	info.Strategy = RolloutStrategyCanary
+	canaryWeight := rolloutCanaryWeight(r)
+	switch {
+	case info.PodTemplateHash == r.Status.StableRS:
+		info.Role = RolloutRoleStable
+		info.Weight = weight(100 - canaryWeight)
+	case info.PodTemplateHash == r.Status.CurrentPodHash:
+		info.Role = RolloutRoleCanary
+		info.Weight = weight(canaryWeight)
+	}
+	return info
+}
+
+// rolloutCanaryWeight returns the percentage of the traffic intended for the canary ReplicaSet of a Rollout.
+func rolloutCanaryWeight(r *kubernetes.Rollout) int32 {
+	if r.Status.StableRS == "" || r.Status.CurrentPodHash == r.Status.StableRS {
+		// Fully promoted
+		return 0
+	}
+	if weights := r.Status.Canary.Weights; weights != nil {
+		return weights.Canary.Weight
+	}
+	if r.Spec.Strategy.Canary == nil || r.Status.CurrentStepIndex == nil {
+		return 0
+	}
+	steps := r.Spec.Strategy.Canary.Steps
+	current := int(*r.Status.CurrentStepIndex)
+	if current >= len(steps) {
+		return 100
+	}
+	for i := current; i >= 0; i-- {
+		if steps[i].SetWeight != nil {
+			return *steps[i].SetWeight
+		}
+	}
+	return 0
+}
+
+func weight(w int32) *int32 {
+	return &w
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apps_v1 "k8s.io/api/apps/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kiali/kiali/kubernetes"
+)
+
+func fakeRolloutReplicaSet(hash string) *apps_v1.ReplicaSet {
+	return &apps_v1.ReplicaSet{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:   "reviews-" + hash,
+			Labels: map[string]string{kubernetes.RolloutPodTemplateHashLabel: hash},
+		},
+	}
+}
+
+func fakeCanaryRollout(currentStep int32) *kubernetes.Rollout {
+	firstWeight := int32(20)
+	secondWeight := int32(50)
+	return &kubernetes.Rollout{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "reviews"},
+		Spec: kubernetes.RolloutSpec{
+			Strategy: kubernetes.RolloutStrategy{
+				Canary: &kubernetes.RolloutCanaryStrategy{
+					Steps: []kubernetes.RolloutCanaryStep{
+						{SetWeight: &firstWeight},
+						{},
+						{SetWeight: &secondWeight},
+						{},
+					},
+				},
+			},
+		},
+		Status: kubernetes.RolloutStatus{
+			CurrentPodHash:   "canary",
+			CurrentStepIndex: &currentStep,
+			StableRS:         "stable",
+		},
+	}
+}
+
+func TestGetRolloutInfoFromSteps(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	rollout := fakeCanaryRollout(1)
+
+	stable := GetRolloutInfo(rollout, fakeRolloutReplicaSet("stable"))
+	assert.Equal("reviews", stable.Name)
+	assert.Equal(RolloutStrategyCanary, stable.Strategy)
+	assert.Equal(RolloutRoleStable, stable.Role)
+	require.NotNil(stable.Weight)
+	assert.Equal(int32(80), *stable.Weight)
+
+	canary := GetRolloutInfo(rollout, fakeRolloutReplicaSet("canary"))
+	assert.Equal(RolloutRoleCanary, canary.Role)
+	require.NotNil(canary.Weight)
+	assert.Equal(int32(20), *canary.Weight)
+
+	canary = GetRolloutInfo(fakeCanaryRollout(3), fakeRolloutReplicaSet("canary"))
+	assert.Equal(int32(50), *canary.Weight)
+
+	// All the steps are completed, but the canary is not promoted yet
+	canary = GetRolloutInfo(fakeCanaryRollout(4), fakeRolloutReplicaSet("canary"))
+	assert.Equal(int32(100), *canary.Weight)
+
+	old := GetRolloutInfo(rollout, fakeRolloutReplicaSet("old"))
+	assert.Empty(old.Role)
+	assert.Nil(old.Weight)
+}
+
+func TestGetRolloutInfoFromTrafficWeights(t *testing.T) {
+	assert := assert.New(t)
+
+	rollout := fakeCanaryRollout(1)
+	rollout.Status.Canary.Weights = &kubernetes.RolloutTrafficWeights{
+		Canary: kubernetes.RolloutWeightDestination{Weight: 5, PodTemplateHash: "canary"},
+		Stable: kubernetes.RolloutWeightDestination{Weight: 95, PodTemplateHash: "stable"},
+	}
+
+	assert.Equal(int32(95), *GetRolloutInfo(rollout, fakeRolloutReplicaSet("stable")).Weight)
+	assert.Equal(int32(5), *GetRolloutInfo(rollout, fakeRolloutReplicaSet("canary")).Weight)
+}
+
+func TestGetRolloutInfoPromoted(t *testing.T) {
+	assert := assert.New(t)
+
+	rollout := fakeCanaryRollout(4)
+	rollout.Status.StableRS = "canary"
+
+	info := GetRolloutInfo(rollout, fakeRolloutReplicaSet("canary"))
+	assert.Equal(RolloutRoleStable, info.Role)
+	assert.Equal(int32(100), *info.Weight)
+}
+
+func TestGetRolloutInfoBlueGreen(t *testing.T) {
+	assert := assert.New(t)
+
+	rollout := &kubernetes.Rollout{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "reviews"},
+		Spec: kubernetes.RolloutSpec{
+			Strategy: kubernetes.RolloutStrategy{
+				BlueGreen: &kubernetes.RolloutBlueGreenStrategy{ActiveService: "reviews", PreviewService: "reviews-preview"},
+			},
+		},
+		Status: kubernetes.RolloutStatus{
+			BlueGreen: kubernetes.RolloutBlueGreenStatus{ActiveSelector: "blue", PreviewSelector: "green"},
+		},
+	}
+
+	active := GetRolloutInfo(rollout, fakeRolloutReplicaSet("blue"))
+	assert.Equal(RolloutStrategyBlueGreen, active.Strategy)
+	assert.Equal(RolloutRoleActive, active.Role)
+	assert.Equal(int32(100), *active.Weight)
+
+	preview := GetRolloutInfo(rollout, fakeRolloutReplicaSet("green"))
+	assert.Equal(RolloutRolePreview, preview.Role)
+	assert.Equal(int32(0), *preview.Weight)
+}
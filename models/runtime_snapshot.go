@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// RuntimeSnapshot is the state of the Go runtime of the Kiali server, to diagnose its CPU and memory usage.
+type RuntimeSnapshot struct {
+	// GoMaxProcs is the number of CPUs executing Go code simultaneously
+	GoMaxProcs int `json:"goMaxProcs"`
+
+	// Goroutines is the number of the goroutines
+	// example: 214
+	Goroutines int `json:"goroutines"`
+
+	// GoroutineStacks are the goroutines grouped by identical stacks, most frequent first
+	GoroutineStacks []GoroutineStack `json:"goroutineStacks,omitempty"`
+
+	// Memory is the memory usage of the server
+	Memory RuntimeMemory `json:"memory"`
+
+	// NumCPU is the number of CPUs usable by the server
+	NumCPU int `json:"numCPU"`
+
+	// Timestamp of the snapshot
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// GoroutineStack is a stack shared by goroutines.
+type GoroutineStack struct {
+	// Count is the number of the goroutines with this stack
+	Count int `json:"count"`
+
+	// Frames are the functions of the stack, innermost first
+	Frames []string `json:"frames"`
+}
+
+// RuntimeMemory is the memory usage of the Go runtime, in bytes.
+type RuntimeMemory struct {
+	HeapAlloc    uint64 `json:"heapAlloc"`
+	HeapIdle     uint64 `json:"heapIdle"`
+	HeapInuse    uint64 `json:"heapInuse"`
+	HeapObjects  uint64 `json:"heapObjects"`
+	HeapReleased uint64 `json:"heapReleased"`
+	NextGC       uint64 `json:"nextGC"`
+	NumGC        uint32 `json:"numGC"`
+	// PauseTotal is the cumulative time spent in the garbage collection pauses
+	PauseTotal time.Duration `json:"pauseTotal"`
+	StackInuse uint64        `json:"stackInuse"`
+	Sys        uint64        `json:"sys"`
+	TotalAlloc uint64        `json:"totalAlloc"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SearchKind is the kind of entity found by a search.
+type SearchKind string
+
+const (
+	SearchKindApp      SearchKind = "app"
+	SearchKindService  SearchKind = "service"
+	SearchKindWorkload SearchKind = "workload"
+	SearchKindIstio    SearchKind = "istio"
+)
+
+// SearchKinds are the kinds of entities of the search index, in the order results of the same relevance are returned.
+var SearchKinds = []SearchKind{SearchKindApp, SearchKindService, SearchKindWorkload, SearchKindIstio}
+
+// SearchIndexKey identifies the search index of a namespace.
+type SearchIndexKey struct {
+	Cluster   string
+	Namespace string
+}
+
+// SearchIndex holds the searchable entities of a namespace.
+type SearchIndex struct {
+	Entries []SearchEntry
+	BuiltAt time.Time
+}
+
+// SearchEntry is an entity of the search index.
+type SearchEntry struct {
+	Kind SearchKind `json:"kind"`
+	// Type of the entity, i.e. App, Service, Deployment or VirtualService
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster"`
+	// Only set for Istio objects
+	ObjectGVK *schema.GroupVersionKind `json:"objectGVK,omitempty"`
+}
+
+// SearchResults are the entities matching a search, the most relevant first.
+type SearchResults struct {
+	Query   string        `json:"query"`
+	Results []SearchEntry `json:"results"`
+	// Number of matching entities before the limit is applied
+	Total int `json:"total"`
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	osroutes_v1 "github.com/openshift/api/route/v1"
 	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
 	core_v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -86,12 +87,15 @@ type ServiceDefinitionList struct {
 type ServiceDetails struct {
 	DestinationRules   []*networking_v1.DestinationRule         `json:"destinationRules"`
 	Endpoints          Endpoints                                `json:"endpoints"`
+	EndpointStatus     *EndpointStatus                          `json:"endpointStatus,omitempty"`
+	DeepLinks          []DeepLink                               `json:"deepLinks,omitempty"`
 	IstioPermissions   ResourcePermissions                      `json:"istioPermissions"`
 	IsAmbient          bool                                     `json:"isAmbient"`
 	IstioSidecar       bool                                     `json:"istioSidecar"`
 	K8sGRPCRoutes      []*k8s_networking_v1.GRPCRoute           `json:"k8sGRPCRoutes"`
 	K8sHTTPRoutes      []*k8s_networking_v1.HTTPRoute           `json:"k8sHTTPRoutes"`
 	K8sReferenceGrants []*k8s_networking_v1beta1.ReferenceGrant `json:"k8sReferenceGrants"`
+	Routes             []ServiceRoute                           `json:"routes,omitempty"`
 	Service            Service                                  `json:"service"`
 	ServiceEntries     []*networking_v1.ServiceEntry            `json:"serviceEntries"`
 	VirtualServices    []*networking_v1.VirtualService          `json:"virtualServices"`
@@ -104,6 +108,37 @@ type ServiceDetails struct {
 	WaypointWorkloads []WorkloadReferenceInfo `json:"waypointWorkloads"`
 }
 
+// ServiceRoute is an OpenShift Route exposing the Service out of the cluster
+type ServiceRoute struct {
+	// Name of the Route
+	// required: true
+	Name string `json:"name"`
+	// Host exposed by the Route
+	// required: true
+	// example: reviews-bookinfo.apps.example.com
+	Host string `json:"host"`
+	// Path of the Host routed to the Service
+	Path string `json:"path,omitempty"`
+	// TLS termination of the Route, empty when the Route is not secured
+	// example: edge
+	TLSTermination string `json:"tlsTermination,omitempty"`
+	// URL of the Route
+	// required: true
+	// example: https://reviews-bookinfo.apps.example.com
+	URL string `json:"url"`
+}
+
+func (r *ServiceRoute) Parse(route *osroutes_v1.Route) {
+	r.Name = route.Name
+	r.Host = route.Spec.Host
+	r.Path = route.Spec.Path
+	r.URL = "http://" + route.Spec.Host + route.Spec.Path
+	if route.Spec.TLS != nil {
+		r.TLSTermination = string(route.Spec.TLS.Termination)
+		r.URL = "https://" + route.Spec.Host + route.Spec.Path
+	}
+}
+
 type (
 	Services []*Service
 	Service  struct {
@@ -0,0 +1,81 @@
+package models
+
+import (
+	api_networking_v1 "istio.io/api/networking/v1"
+	api_security_v1 "istio.io/api/security/v1"
+)
+
+// The levels of the Istio config applying to a service, from the most to the least specific.
+const (
+	EffectiveConfigLevelWorkload  = "workload"
+	EffectiveConfigLevelHost      = "host"
+	EffectiveConfigLevelNamespace = "namespace"
+	EffectiveConfigLevelMesh      = "mesh"
+)
+
+// ServiceEffectiveConfig is the Istio config applied to the traffic of a service, computed from the objects
+// that Istio selects for it, as seen by the clients of a namespace.
+type ServiceEffectiveConfig struct {
+	Cluster string `json:"cluster"`
+	// ClientNamespace is the namespace of the clients the outbound config is computed for
+	ClientNamespace string `json:"clientNamespace"`
+	// Host is the FQDN of the service
+	Host      string `json:"host"`
+	Namespace string `json:"namespace"`
+	Service   string `json:"service"`
+
+	Authentication EffectiveAuthentication `json:"authentication"`
+	Routing        EffectiveRouting        `json:"routing"`
+	SidecarScope   EffectiveSidecarScope   `json:"sidecarScope"`
+	TrafficPolicy  EffectiveTrafficPolicy  `json:"trafficPolicy"`
+}
+
+// EffectiveRouting is the routing of the requests to the service, from the single VirtualService Istio applies
+// to the host. Without VirtualService the requests are sent to the service as they are.
+type EffectiveRouting struct {
+	// VirtualService is the one applied, nil when there is none
+	VirtualService *IstioReference `json:"virtualService"`
+	// Shadowed are the VirtualServices declaring the host that are ignored, by precedence
+	Shadowed []IstioReference               `json:"shadowed"`
+	HTTP     []*api_networking_v1.HTTPRoute `json:"http"`
+	TCP      []*api_networking_v1.TCPRoute  `json:"tcp"`
+	TLS      []*api_networking_v1.TLSRoute  `json:"tls"`
+}
+
+// EffectiveTrafficPolicy is the policy of the connections to the service, from the single DestinationRule
+// Istio applies to the host.
+type EffectiveTrafficPolicy struct {
+	// DestinationRule is the one applied, nil when there is none
+	DestinationRule *IstioReference `json:"destinationRule"`
+	// Level of the DestinationRule: host, namespace for a wildcard on the namespace, or mesh
+	Level string `json:"level,omitempty"`
+	// Shadowed are the DestinationRules matching the host that are ignored, by precedence
+	Shadowed []IstioReference `json:"shadowed"`
+	// Policy is the traffic policy of the DestinationRule, nil when it has none
+	Policy  *api_networking_v1.TrafficPolicy `json:"policy"`
+	Subsets []*api_networking_v1.Subset      `json:"subsets"`
+}
+
+// EffectiveSidecarScope tells whether the clients can reach the service according to their Sidecar.
+type EffectiveSidecarScope struct {
+	// Sidecar is the namespace wide, or else the mesh wide, Sidecar of the clients. nil when there is none.
+	Sidecar *IstioReference `json:"sidecar"`
+	// Visible is whether the host is in the egress scope of the clients
+	Visible bool `json:"visible"`
+	// OutboundTrafficPolicy of the Sidecar, empty when it inherits the one of the mesh
+	OutboundTrafficPolicy string `json:"outboundTrafficPolicy,omitempty"`
+}
+
+// EffectiveAuthentication is the authentication required by the workloads of the service.
+type EffectiveAuthentication struct {
+	// MTLSMode is the mTLS mode accepted by the workloads, PERMISSIVE unless a PeerAuthentication sets it
+	MTLSMode string `json:"mtlsMode"`
+	// MTLSLevel is the level of the PeerAuthentication setting the mode, empty for the default
+	MTLSLevel string `json:"mtlsLevel,omitempty"`
+	// PortLevelMTLS are the modes of the ports overridden by the workload PeerAuthentication
+	PortLevelMTLS map[uint32]*api_security_v1.PeerAuthentication_MutualTLS `json:"portLevelMtls,omitempty"`
+	// PeerAuthentications applying to the workloads, from the most to the least specific
+	PeerAuthentications []IstioReference `json:"peerAuthentications"`
+	// RequestAuthentications applying to the workloads. Their rules are all combined.
+	RequestAuthentications []IstioReference `json:"requestAuthentications"`
+}
@@ -0,0 +1,80 @@
+package models
+
+// LocalityLbSetting is the locality load balancing setting of the mesh or of a DestinationRule.
+type LocalityLbSetting struct {
+	// Distribute are the weights of the traffic of the source localities to the destination localities
+	Distribute []LocalityDistribute `yaml:"distribute,omitempty" json:"distribute,omitempty"`
+	// Failover are the regions the traffic of a region fails over to
+	Failover []LocalityFailover `yaml:"failover,omitempty" json:"failover,omitempty"`
+	// FailoverPriority are the labels the endpoints are prioritized by, in order
+	FailoverPriority []string `yaml:"failoverPriority,omitempty" json:"failoverPriority,omitempty"`
+	// Enabled is whether the locality load balancing is enabled, it is by default
+	Enabled *bool `yaml:"enabled,omitempty" json:"enabled,omitempty"`
+}
+
+// IsEnabled returns whether the locality load balancing setting is enabled.
+func (s LocalityLbSetting) IsEnabled() bool {
+	return s.Enabled == nil || *s.Enabled
+}
+
+// LocalityDistribute is the distribution of the traffic of a source locality.
+type LocalityDistribute struct {
+	// example: us-west/zone1/*
+	From string `yaml:"from,omitempty" json:"from,omitempty"`
+	// example: {"us-west/zone1/*":80,"us-west/zone2/*":20}
+	To map[string]uint32 `yaml:"to,omitempty" json:"to,omitempty"`
+}
+
+// LocalityFailover is the region the traffic of a region fails over to.
+type LocalityFailover struct {
+	// example: us-east
+	From string `yaml:"from,omitempty" json:"from,omitempty"`
+	// example: us-west
+	To string `yaml:"to,omitempty" json:"to,omitempty"`
+}
+
+// LocalityDestinationRule is the locality load balancing setting of a DestinationRule applying to a service.
+type LocalityDestinationRule struct {
+	DestinationRule IstioReference `json:"destinationRule"`
+	// Setting is the locality load balancing setting of the DestinationRule, nil when it has none
+	Setting *LocalityLbSetting `json:"setting,omitempty"`
+	// OutlierDetection is whether the DestinationRule detects outliers, the failover needs it
+	// required: true
+	OutlierDetection bool `json:"outlierDetection"`
+}
+
+// LocalityEndpoints are the endpoints of a service in a locality.
+type LocalityEndpoints struct {
+	// Locality is the region, zone and subzone of the endpoints, empty when unknown
+	// example: us-west/zone1/
+	Locality string `json:"locality"`
+	Region   string `json:"region,omitempty"`
+	Zone     string `json:"zone,omitempty"`
+	Subzone  string `json:"subzone,omitempty"`
+	// required: true
+	Ready int `json:"ready"`
+	// required: true
+	NotReady int `json:"notReady"`
+	// Pods are the names of the pods of the endpoints
+	Pods []string `json:"pods"`
+}
+
+// ServiceLocality is the locality load balancing configuration of a service, and the localities of its endpoints.
+type ServiceLocality struct {
+	// MeshSetting is the locality load balancing setting of the MeshConfig, nil when it has none
+	MeshSetting *LocalityLbSetting `json:"meshSetting,omitempty"`
+	// DestinationRules are the DestinationRules applying to the service, the first one being applied
+	// required: true
+	DestinationRules []LocalityDestinationRule `json:"destinationRules"`
+	// Effective is the locality load balancing setting applied to the service, nil when it has none
+	Effective *LocalityLbSetting `json:"effective,omitempty"`
+	// EffectiveSource is where the effective setting comes from: mesh, or the DestinationRule as namespace/name
+	// example: bookinfo/reviews
+	EffectiveSource string `json:"effectiveSource,omitempty"`
+	// Localities are the localities of the endpoints of the service
+	// required: true
+	Localities []LocalityEndpoints `json:"localities"`
+	// Warnings are the mismatches between the setting and the localities of the endpoints
+	// required: true
+	Warnings []string `json:"warnings"`
+}
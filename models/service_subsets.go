@@ -0,0 +1,31 @@
+package models
+
+import (
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+)
+
+// ServiceSubsets is the DestinationRule of a service with a subset for every version of its workloads, generated
+// from the current DestinationRule of the service or from scratch.
+type ServiceSubsets struct {
+	// Versions of the workloads of the service, by the version label
+	// required: true
+	// example: ["v1","v2"]
+	Versions []string `json:"versions"`
+
+	// DestinationRule with the generated subsets
+	// required: true
+	DestinationRule *networking_v1.DestinationRule `json:"destinationRule"`
+
+	// Exists is true when the DestinationRule already exists and is updated, otherwise it is created
+	// required: true
+	Exists bool `json:"exists"`
+
+	// Changes of the spec of the DestinationRule: the current values are on the source side and the generated ones
+	// on the target side
+	// required: true
+	Changes []IstioConfigFieldDiff `json:"changes"`
+
+	// Applied is true once the DestinationRule has been created or updated
+	// required: true
+	Applied bool `json:"applied"`
+}
@@ -0,0 +1,112 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SLOAvailabilityAnnotation sets the availability target (percentage of successful requests) of a service.
+	SLOAvailabilityAnnotation AnnotationKey = "slo.kiali.io/availability"
+	// SLOLatencyAnnotation sets the latency threshold of a service in milliseconds.
+	SLOLatencyAnnotation AnnotationKey = "slo.kiali.io/latency"
+	// SLOLatencyQuantileAnnotation sets the quantile compared against the latency threshold.
+	SLOLatencyQuantileAnnotation AnnotationKey = "slo.kiali.io/latency-quantile"
+	// SLOWindowAnnotation sets the window, as a Prometheus duration, used to compute the SLO.
+	SLOWindowAnnotation AnnotationKey = "slo.kiali.io/window"
+)
+
+const (
+	SLOSourceAnnotation = "annotation"
+	SLOSourceConfig     = "config"
+)
+
+// SLOKey identifies the SLO status of a service.
+type SLOKey struct {
+	Cluster   string
+	Namespace string
+	Service   string
+}
+
+// SLODefinition holds the objectives tracked for a service.
+type SLODefinition struct {
+	// AvailabilityTarget is the percentage of successful requests expected, i.e. 99.9
+	AvailabilityTarget float64 `json:"availabilityTarget"`
+	// LatencyQuantile is the quantile compared against the LatencyThreshold, i.e. 0.99
+	LatencyQuantile string `json:"latencyQuantile,omitempty"`
+	// LatencyThreshold in milliseconds. 0 means latency is not tracked.
+	LatencyThreshold float64 `json:"latencyThreshold,omitempty"`
+	// Source is where the definition comes from: "annotation" or "config"
+	Source string `json:"source"`
+	// Window is the Prometheus duration used to evaluate the SLO, i.e. 30d
+	Window string `json:"window"`
+}
+
+// SLOStatus is the result of evaluating an SLODefinition against the service telemetry.
+type SLOStatus struct {
+	Cluster    string        `json:"cluster"`
+	Definition SLODefinition `json:"definition"`
+	Namespace  string        `json:"namespace"`
+	Service    string        `json:"service"`
+
+	// Availability is the percentage of successful requests in the window.
+	Availability float64 `json:"availability"`
+	// BurnRate is the ratio between the observed error rate and the error rate allowed by the target.
+	// A burn rate of 1 consumes exactly the whole error budget in the window.
+	BurnRate float64 `json:"burnRate"`
+	// Compliant is true when the availability and latency objectives are met.
+	Compliant bool `json:"compliant"`
+	// ErrorBudgetRemaining is the ratio of the error budget not consumed yet. It can be negative.
+	ErrorBudgetRemaining float64 `json:"errorBudgetRemaining"`
+	// EvaluatedAt is the time of the last evaluation.
+	EvaluatedAt time.Time `json:"evaluatedAt"`
+	// HasTraffic is false when no requests were observed in the window.
+	HasTraffic bool `json:"hasTraffic"`
+	// Latency in milliseconds at the configured quantile.
+	Latency float64 `json:"latency,omitempty"`
+}
+
+// SLOStatuses is a list of SLO statuses
+type SLOStatuses []*SLOStatus
+
+// ParseSLOAnnotations returns the SLO definition set through the slo.kiali.io annotations.
+// Returns nil when the availability annotation is missing or invalid.
+func ParseSLOAnnotations(annotations map[string]string, defaultWindow string) *SLODefinition {
+	availability, ok := annotations[string(SLOAvailabilityAnnotation)]
+	if !ok {
+		return nil
+	}
+	target, err := strconv.ParseFloat(strings.TrimSpace(availability), 64)
+	if err != nil || target <= 0 || target >= 100 {
+		return nil
+	}
+
+	def := &SLODefinition{
+		AvailabilityTarget: target,
+		Source:             SLOSourceAnnotation,
+		Window:             defaultWindow,
+	}
+	if latency, ok := annotations[string(SLOLatencyAnnotation)]; ok {
+		if threshold, err := strconv.ParseFloat(strings.TrimSpace(latency), 64); err == nil && threshold > 0 {
+			def.LatencyThreshold = threshold
+		}
+	}
+	if quantile, ok := annotations[string(SLOLatencyQuantileAnnotation)]; ok {
+		def.LatencyQuantile, _ = ParseSLOQuantile(quantile)
+	}
+	if window, ok := annotations[string(SLOWindowAnnotation)]; ok && window != "" {
+		def.Window = strings.TrimSpace(window)
+	}
+	return def
+}
+
+// ParseSLOQuantile returns the latency quantile of an SLO, formatted as a plain decimal number, when it is a number
+// between 0 and 1 exclusive. The quantile is part of the Prometheus queries, nothing else is accepted.
+func ParseSLOQuantile(value string) (string, bool) {
+	quantile, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || !(quantile > 0 && quantile < 1) {
+		return "", false
+	}
+	return strconv.FormatFloat(quantile, 'f', -1, 64), true
+}
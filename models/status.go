@@ -28,3 +28,67 @@ type ExternalServiceInfo struct {
 
 	TempoConfig config.TempoConfig `json:"tempoConfig,omitempty"`
 }
+
+// Outcomes of the authentication to an external service
+const (
+	ExternalServiceAuthFailed    = "failed"
+	ExternalServiceAuthForbidden = "forbidden"
+	ExternalServiceAuthOK        = "ok"
+	ExternalServiceAuthUnknown   = "unknown"
+)
+
+// ExternalServiceConnection is the result of a connection test to an external service
+// swagger:model externalServiceConnection
+type ExternalServiceConnection struct {
+	// The name of the service
+	//
+	// required: true
+	// example: Prometheus
+	Name string `json:"name"`
+
+	// The url used to test the connection
+	//
+	// required: false
+	// example: http://prometheus.istio-system:9090
+	Url string `json:"url,omitempty"`
+
+	// Enabled is false when the service is disabled by configuration, in which case it is not tested
+	//
+	// required: true
+	Enabled bool `json:"enabled"`
+
+	// Reachable is true when the service answered the request, whatever its status code
+	//
+	// required: true
+	Reachable bool `json:"reachable"`
+
+	// The HTTP status code of the response
+	//
+	// required: false
+	// example: 200
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// The time to get the response, in milliseconds
+	//
+	// required: false
+	// example: 12
+	LatencyMs int64 `json:"latencyMs,omitempty"`
+
+	// The version detected from the response
+	//
+	// required: false
+	// example: 2.53.0
+	Version string `json:"version,omitempty"`
+
+	// The outcome of the authentication: ok, failed, forbidden or unknown when the service was not reachable
+	// or answered with an unexpected status code
+	//
+	// required: true
+	// example: ok
+	Auth string `json:"auth"`
+
+	// The error explaining why the connection test failed
+	//
+	// required: false
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// TelemetryGap is a workload whose proxies logged requests that are missing from the Istio metrics,
+// pointing to a problem with the Prometheus scraping or the Telemetry config.
+type TelemetryGap struct {
+	Workload string `json:"workload"`
+	// Pods whose proxies logged requests during the window
+	Pods []string `json:"pods"`
+	// Number of access log entries found in the logs of the proxies
+	AccessLogEntries int `json:"accessLogEntries"`
+	// Human readable details about the gap
+	Message string `json:"message"`
+}
+
+// TelemetryDiagnostics reports the workloads with sidecars of a namespace that are missing telemetry.
+type TelemetryDiagnostics struct {
+	Cluster     string    `json:"cluster"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	Namespace   string    `json:"namespace"`
+	// The window during which the telemetry and the access logs are compared, i.e. "10m"
+	Window string `json:"window"`
+	// Number of workloads with sidecars checked
+	CheckedWorkloads int `json:"checkedWorkloads"`
+	// Workloads with sidecars without telemetry nor access logs, whose state can't be told
+	IdleWorkloads []string       `json:"idleWorkloads"`
+	Gaps          []TelemetryGap `json:"gaps"`
+}
@@ -0,0 +1,69 @@
+package models
+
+// TestRequest is an HTTP request sent to a service from a test pod of the mesh, to verify its routing rules.
+type TestRequest struct {
+	// Method of the request, GET by default
+	// example: GET
+	Method string `json:"method,omitempty"`
+	// Port of the service, its first port by default
+	// example: 9080
+	Port int `json:"port,omitempty"`
+	// Path of the request, with its query, / by default
+	// example: /reviews/1
+	Path string `json:"path,omitempty"`
+	// Headers of the request
+	// example: {"end-user":"jason"}
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// TestRequestResult is the response to a test request, with the route of the VirtualServices matched by the request.
+type TestRequestResult struct {
+	// The test pod sending the request, as namespace/name
+	// example: sleep/curl-7f6b8c9d4-x2x7l
+	SourcePod string `json:"sourcePod"`
+	// The URL requested
+	// example: http://reviews.bookinfo.svc.cluster.local:9080/reviews/1
+	URL string `json:"url"`
+	// The status code of the response, 0 when no response was received
+	// required: true
+	// example: 200
+	Status int `json:"status"`
+	// The time taken by the request, in milliseconds
+	// required: true
+	// example: 12.5
+	Latency float64 `json:"latency"`
+	// The headers of the response
+	ResponseHeaders map[string]string `json:"responseHeaders,omitempty"`
+	// The error of the request, when no response was received
+	Error string `json:"error,omitempty"`
+	// The route matched by the request, nil when no VirtualService routes it
+	MatchedRoute *TestRequestRoute `json:"matchedRoute,omitempty"`
+}
+
+// TestRequestRoute is an HTTP route of a VirtualService matched by a test request.
+type TestRequestRoute struct {
+	// The VirtualService, as namespace/name
+	// required: true
+	// example: bookinfo/reviews
+	VirtualService string `json:"virtualService"`
+	// The name of the route, or its index in the HTTP routes when it has no name
+	// required: true
+	// example: jason
+	Route string `json:"route"`
+	// The destinations of the route
+	// required: true
+	Destinations []TestRequestDestination `json:"destinations"`
+}
+
+// TestRequestDestination is a destination of the route matched by a test request.
+type TestRequestDestination struct {
+	// example: reviews
+	Host string `json:"host"`
+	// example: v2
+	Subset string `json:"subset,omitempty"`
+	// example: 9080
+	Port uint32 `json:"port,omitempty"`
+	// The share of the traffic sent to the destination, in percent
+	// example: 100
+	Weight int32 `json:"weight"`
+}
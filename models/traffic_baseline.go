@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// TrafficFindingType is the kind of deviation from the traffic baseline.
+type TrafficFindingType string
+
+const (
+	// TrafficFindingNewDestination is set on services receiving traffic that had none in the baseline.
+	TrafficFindingNewDestination TrafficFindingType = "NewDestination"
+	// TrafficFindingStopped is set on services that had traffic in the baseline and have none now.
+	TrafficFindingStopped TrafficFindingType = "TrafficStopped"
+)
+
+// TrafficFindingKey identifies a finding of a service.
+type TrafficFindingKey struct {
+	Cluster   string
+	Namespace string
+	Service   string
+	Type      TrafficFindingType
+}
+
+// TrafficFinding is a service whose current traffic deviates from its traffic baseline.
+type TrafficFinding struct {
+	Cluster   string             `json:"cluster"`
+	Namespace string             `json:"namespace"`
+	Service   string             `json:"service"`
+	Type      TrafficFindingType `json:"type"`
+	// Request rate, in requests per second, in the baseline
+	BaselineRate float64 `json:"baselineRate"`
+	// Current request rate, in requests per second
+	CurrentRate float64 `json:"currentRate"`
+	// When the deviation was first detected
+	DetectedAt time.Time `json:"detectedAt"`
+	// Link to the service in the Kiali console, only set in the notifications
+	URL string `json:"url,omitempty"`
+}
+
+// Key returns the key of the finding.
+func (f TrafficFinding) Key() TrafficFindingKey {
+	return TrafficFindingKey{Cluster: f.Cluster, Namespace: f.Namespace, Service: f.Service, Type: f.Type}
+}
+
+// TrafficFindings is a list of traffic findings.
+type TrafficFindings []*TrafficFinding
@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// TrendQuery holds the parameters of a long-term trend query. The trends of the namespace are reported unless
+// an app, a service or a workload is set.
+type TrendQuery struct {
+	App       string
+	Cluster   string
+	Namespace string
+	Service   string
+	Workload  string
+	Start     time.Time
+	End       time.Time
+}
+
+// TrendPoint is a value of a trend at a Unix time (seconds).
+type TrendPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// Trends holds the coarse inbound traffic and error rate of an entity in a long range.
+type Trends struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Step between the points, in seconds
+	Step int64 `json:"step"`
+	// Resolution of the Thanos downsampled blocks read, empty when the raw samples are read
+	MaxSourceResolution string `json:"maxSourceResolution,omitempty"`
+	// Request rate, in requests per second, averaged over each step
+	Traffic []TrendPoint `json:"traffic"`
+	// Ratio of failed requests over each step, 0 without traffic
+	ErrorRate []TrendPoint `json:"errorRate"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ValidationWebhookRequest is the Istio config of a namespace sent to a validation webhook.
+type ValidationWebhookRequest struct {
+	Cluster   string                    `json:"cluster"`
+	Namespace string                    `json:"namespace"`
+	Objects   []ValidationWebhookObject `json:"objects"`
+}
+
+// ValidationWebhookObject is an Istio object, in its generic JSON form without status.
+type ValidationWebhookObject struct {
+	ObjectGVK schema.GroupVersionKind `json:"objectGVK"`
+	Object    map[string]interface{}  `json:"object"`
+}
+
+// ValidationWebhookResponse is the reply of a validation webhook, with the checks of the objects it found issues with.
+type ValidationWebhookResponse struct {
+	Validations []ValidationWebhookValidation `json:"validations"`
+}
+
+// ValidationWebhookValidation is the checks of an Istio object sent to a validation webhook.
+type ValidationWebhookValidation struct {
+	ObjectGVK schema.GroupVersionKind `json:"objectGVK"`
+	Name      string                  `json:"name"`
+	Checks    []*IstioCheck           `json:"checks"`
+}
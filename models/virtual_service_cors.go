@@ -0,0 +1,50 @@
+package models
+
+// The CORS behaviors of a route for the requests of an origin.
+const (
+	// CorsBehaviorDisabled is a route without CORS policy: the requests are forwarded without CORS headers
+	CorsBehaviorDisabled = "disabled"
+	// CorsBehaviorAllowed is an origin allowed by the CORS policy: Envoy answers the preflight requests itself
+	CorsBehaviorAllowed = "allowed"
+	// CorsBehaviorDenied is an origin not allowed by the CORS policy: the responses have no CORS headers and the
+	// browsers block them
+	CorsBehaviorDenied = "denied"
+)
+
+// VirtualServiceCors is the effective CORS behavior of the HTTP routes of a VirtualService.
+type VirtualServiceCors struct {
+	VirtualService IstioReference `json:"virtualService"`
+	// The origin the behaviors are computed for, if any
+	// example: https://bookinfo.example.com
+	Origin string `json:"origin,omitempty"`
+	// required: true
+	Routes []RouteCors `json:"routes"`
+}
+
+// RouteCors is the effective CORS policy of an HTTP route.
+type RouteCors struct {
+	// The name of the route, or its index in the HTTP routes when it has no name
+	// required: true
+	// example: reviews-v1
+	Route string `json:"route"`
+	// Enabled is whether the route has a CORS policy
+	// required: true
+	Enabled bool `json:"enabled"`
+	// The allowed origins, as match type and value
+	// example: ["exact:https://bookinfo.example.com","regex:https://.*\\.example\\.com"]
+	AllowOrigins  []string `json:"allowOrigins,omitempty"`
+	AllowMethods  []string `json:"allowMethods,omitempty"`
+	AllowHeaders  []string `json:"allowHeaders,omitempty"`
+	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
+	// The time the browsers cache the preflight responses, in seconds
+	// example: 86400
+	MaxAgeSeconds    *int64 `json:"maxAgeSeconds,omitempty"`
+	AllowCredentials bool   `json:"allowCredentials"`
+	// The behavior for the origin, one of disabled, allowed or denied. Only set when an origin is given.
+	// example: allowed
+	Behavior string `json:"behavior,omitempty"`
+	// The CORS headers of the responses to the preflight requests of the allowed origin
+	PreflightHeaders map[string]string `json:"preflightHeaders,omitempty"`
+	// The CORS headers added to the responses to the other requests of the allowed origin
+	ResponseHeaders map[string]string `json:"responseHeaders,omitempty"`
+}
@@ -0,0 +1,25 @@
+package models
+
+// WasmModuleReachability is the result of fetching the Wasm module of a WasmPlugin from Kiali. The proxies can reach
+// the module through a different network path.
+type WasmModuleReachability struct {
+	// Checked is false for the modules that Kiali cannot fetch, like the files local to the proxies
+	Checked   bool `json:"checked"`
+	Reachable bool `json:"reachable"`
+	// StatusCode is the HTTP status code answered by the server or by the OCI registry
+	StatusCode int    `json:"statusCode,omitempty"`
+	Message    string `json:"message"`
+}
+
+// WasmPluginStatus is the rollout of a WasmPlugin: where its module is fetched from and the workloads it attaches to.
+type WasmPluginStatus struct {
+	WasmPlugin IstioReference `json:"wasmPlugin"`
+	// URL of the module, with its scheme: oci, http, https or file
+	URL          string                 `json:"url"`
+	Scheme       string                 `json:"scheme"`
+	Reachability WasmModuleReachability `json:"reachability"`
+	// MeshWide is true for the plugins of the root namespace without selector, attached to all the workloads of the mesh
+	MeshWide bool `json:"meshWide"`
+	// Workloads are the workloads of the namespace the plugin attaches to
+	Workloads []string `json:"workloads"`
+}
@@ -131,6 +131,24 @@ type WorkloadListItem struct {
 	// example: true
 	VersionLabel bool `json:"versionLabel"`
 
+	// Canonical service of the Workload, as computed by Istio from the app labels
+	// required: true
+	// example: reviews
+	CanonicalService string `json:"canonicalService"`
+
+	// Canonical revision of the Workload, as computed by Istio from the version labels
+	// required: true
+	// example: v1
+	CanonicalRevision string `json:"canonicalRevision"`
+
+	// Knative Service and Revision of a serverless workload
+	// required: false
+	Knative *KnativeInfo `json:"knative,omitempty"`
+
+	// Argo Rollout owning a ReplicaSet workload, with the role of the ReplicaSet in the rollout
+	// required: false
+	Rollout *RolloutInfo `json:"rollout,omitempty"`
+
 	// Number of current workload pods
 	// required: true
 	// example: 1
@@ -167,6 +185,14 @@ type WorkloadListItem struct {
 
 	// Names of the waypoint proxy workloads, if any
 	WaypointWorkloads []string `json:"waypointWorkloads"`
+
+	// Names of the workload pods whose proxy is not synced with istiod
+	// required: false
+	StaleProxyPods []string `json:"staleProxyPods,omitempty"`
+
+	// Names of the workload pods running a container with the image auto
+	// required: false
+	ImageAutoPods []string `json:"imageAutoPods,omitempty"`
 }
 
 type WorkloadOverviews []*WorkloadListItem
@@ -232,6 +258,9 @@ type Workload struct {
 	// Additional details to display, such as configured annotations
 	AdditionalDetails []AdditionalItem `json:"additionalDetails"`
 
+	// Links to external systems configured for the workload
+	DeepLinks []DeepLink `json:"deepLinks,omitempty"`
+
 	Validations IstioValidations `json:"validations"`
 
 	// Ambient waypoint services
@@ -243,6 +272,14 @@ type Workload struct {
 	// WorkloadEntries bound to the workload
 	WorkloadEntries WorkloadEntries `json:"workloadEntries"`
 
+	// Kubernetes events of the workload, its pods and their controllers
+	Events Events `json:"events,omitempty"`
+
+	// Define if the containers of the workload pods have recently failed their probes,
+	// which makes the health flap regardless of the mesh traffic
+	// example: false
+	ProbeFailures bool `json:"probeFailures"`
+
 	// Health
 	Health WorkloadHealth `json:"health"`
 }
@@ -274,7 +311,6 @@ type Workloads []*Workload
 type WorkloadEntries []*WorkloadEntry
 
 func (workload *WorkloadListItem) ParseWorkload(w *Workload) {
-	conf := config.Get()
 	workload.Name = w.Name
 	workload.Namespace = w.Namespace
 	workload.WorkloadGVK = w.WorkloadGVK
@@ -291,20 +327,33 @@ func (workload *WorkloadListItem) ParseWorkload(w *Workload) {
 	workload.Labels = w.Labels
 	workload.PodCount = len(w.Pods)
 	workload.ServiceAccountNames = w.Pods.ServiceAccounts()
+	workload.StaleProxyPods = w.Pods.StaleProxies()
+	workload.ImageAutoPods = w.Pods.ImageAuto()
 	workload.AdditionalDetailSample = w.AdditionalDetailSample
+	workload.Rollout = w.Rollout
 	if len(w.Annotations) > 0 {
 		workload.Annotations = w.Annotations
 	} else {
 		workload.Annotations = map[string]string{}
 	}
 	workload.HealthAnnotations = w.HealthAnnotations
+	workload.TemplateAnnotations = w.TemplateAnnotations
 	workload.IstioReferences = []*IstioValidationKey{}
 	if w.IsWaypoint() {
 		workload.Ambient = "waypoint"
 	}
 	/** Check the labels app and version required by Istio in template Pods*/
-	_, workload.AppLabel = w.Labels[conf.IstioLabels.AppLabelName]
-	_, workload.VersionLabel = w.Labels[conf.IstioLabels.VersionLabelName]
+	workload.parseCanonicalLabels(w.Labels)
+}
+
+// parseCanonicalLabels sets the app and version flags and the canonical service and revision from the labels.
+// The app.kubernetes.io labels and the Istio canonical labels are accepted as app and version labels.
+func (workload *WorkloadListItem) parseCanonicalLabels(labels map[string]string) {
+	_, workload.AppLabel = CanonicalApp(labels)
+	_, workload.VersionLabel = CanonicalVersion(labels)
+	workload.CanonicalService = CanonicalService(labels, workload.Name)
+	workload.CanonicalRevision = CanonicalRevision(labels)
+	workload.Knative = GetKnativeInfo(labels)
 }
 
 func (workload *Workload) parseObjectMeta(meta *meta_v1.ObjectMeta, tplMeta *meta_v1.ObjectMeta) {
@@ -315,10 +364,10 @@ func (workload *Workload) parseObjectMeta(meta *meta_v1.ObjectMeta, tplMeta *met
 		// TODO: This is not right since the template labels won't match the workload's labels.
 		workload.Labels = tplMeta.Labels
 		/** Check the labels app and version required by Istio in template Pods*/
-		_, workload.AppLabel = tplMeta.Labels[conf.IstioLabels.AppLabelName]
-		_, workload.VersionLabel = tplMeta.Labels[conf.IstioLabels.VersionLabelName]
+		workload.parseCanonicalLabels(tplMeta.Labels)
 	} else {
 		workload.Labels = map[string]string{}
+		workload.parseCanonicalLabels(workload.Labels)
 	}
 	annotations := meta.Annotations
 	// TODO: This is not right since the template labels won't match the workload's labels.
@@ -499,7 +548,6 @@ func (workload *Workload) ParseDaemonSet(ds *apps_v1.DaemonSet) {
 }
 
 func (workload *Workload) ParseWorkloadGroup(wg *networking_v1.WorkloadGroup, wentries []*networking_v1.WorkloadEntry, sidecars []*networking_v1.Sidecar) {
-	conf := config.Get()
 	workload.WorkloadGVK = kubernetes.WorkloadGroups
 	workload.parseObjectMeta(&wg.ObjectMeta, &wg.ObjectMeta)
 	workload.DesiredReplicas = int32(len(wentries))
@@ -523,8 +571,7 @@ func (workload *Workload) ParseWorkloadGroup(wg *networking_v1.WorkloadGroup, we
 		}
 	}
 	/** Check the labels app and version required by Istio in template Pods*/
-	_, workload.AppLabel = workload.Labels[conf.IstioLabels.AppLabelName]
-	_, workload.VersionLabel = workload.Labels[conf.IstioLabels.VersionLabelName]
+	workload.parseCanonicalLabels(workload.Labels)
 	for _, entry := range wentries {
 		podStatus := core_v1.PodFailed
 		if healthutil.IsWorkloadEntryHealthy(entry) {
@@ -545,7 +592,6 @@ func (workload *Workload) ParseWorkloadGroup(wg *networking_v1.WorkloadGroup, we
 }
 
 func (workload *Workload) ParsePods(controllerName string, controllerGVK schema.GroupVersionKind, pods []core_v1.Pod) {
-	conf := config.Get()
 	workload.Name = controllerName
 	workload.WorkloadGVK = controllerGVK
 	// We don't have the information of this controller
@@ -578,8 +624,7 @@ func (workload *Workload) ParsePods(controllerName string, controllerGVK schema.
 	}
 
 	/** Check the labels app and version required by Istio in template Pods*/
-	_, workload.AppLabel = workload.Labels[conf.IstioLabels.AppLabelName]
-	_, workload.VersionLabel = workload.Labels[conf.IstioLabels.VersionLabelName]
+	workload.parseCanonicalLabels(workload.Labels)
 }
 
 func (workload *Workload) SetPods(pods []core_v1.Pod) {
@@ -448,3 +448,73 @@ func fakeWaypointPod() *core_v1.Pod {
 		},
 	}
 }
+
+func TestParseCanonicalLabels(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	w := Workload{}
+	w.ParsePods("ratings-v1", schema.GroupVersionKind{Kind: "ReplicaSet"}, []core_v1.Pod{
+		{ObjectMeta: meta_v1.ObjectMeta{Name: "ratings-v1-abc", Labels: map[string]string{K8sAppNameLabel: "ratings", K8sAppVersionLabel: "v1"}}},
+	})
+	assert.True(w.AppLabel)
+	assert.True(w.VersionLabel)
+	assert.Equal("ratings", w.CanonicalService)
+	assert.Equal("v1", w.CanonicalRevision)
+
+	w = Workload{}
+	w.ParsePods("ratings-v1", schema.GroupVersionKind{Kind: "ReplicaSet"}, []core_v1.Pod{
+		{ObjectMeta: meta_v1.ObjectMeta{Name: "ratings-v1-abc", Labels: map[string]string{"app": "ratings", CanonicalNameLabel: "ratings-canonical"}}},
+	})
+	assert.True(w.AppLabel)
+	assert.False(w.VersionLabel)
+	assert.Equal("ratings-canonical", w.CanonicalService)
+	assert.Equal(DefaultCanonicalRevision, w.CanonicalRevision)
+
+	w = Workload{}
+	w.ParsePods("ratings-v1", schema.GroupVersionKind{Kind: "ReplicaSet"}, []core_v1.Pod{
+		{ObjectMeta: meta_v1.ObjectMeta{Name: "ratings-v1-abc"}},
+	})
+	assert.False(w.AppLabel)
+	assert.False(w.VersionLabel)
+	assert.Equal("ratings-v1", w.CanonicalService)
+	assert.Equal(DefaultCanonicalRevision, w.CanonicalRevision)
+}
+
+func TestParseKnativeDeploymentToWorkload(t *testing.T) {
+	assert := assert.New(t)
+	config.Set(config.NewConfig())
+
+	replicas := int32(0)
+	deployment := apps_v1.Deployment{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "reviews-00002-deployment", Namespace: "bookinfo"},
+		Spec: apps_v1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: core_v1.PodTemplateSpec{
+				ObjectMeta: meta_v1.ObjectMeta{
+					Labels: map[string]string{
+						"app":                     "reviews-00002",
+						KnativeServiceLabel:       "reviews",
+						KnativeConfigurationLabel: "reviews",
+						KnativeRevisionLabel:      "reviews-00002",
+					},
+				},
+			},
+		},
+	}
+
+	w := Workload{}
+	w.ParseDeployment(&deployment)
+
+	assert.Equal(&KnativeInfo{Service: "reviews", Configuration: "reviews", Revision: "reviews-00002"}, w.Knative)
+	assert.Equal("reviews-00002", w.CanonicalService)
+	app, ok := WorkloadApp(w.Labels)
+	assert.True(ok)
+	assert.Equal("reviews", app)
+	assert.True(w.CastWorkloadStatus().ScaledToZero)
+
+	w = Workload{}
+	w.ParseDeployment(fakeDeployment())
+	assert.Nil(w.Knative)
+	assert.False(w.CastWorkloadStatus().ScaledToZero)
+}
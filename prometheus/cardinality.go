@@ -0,0 +1,77 @@
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	prom_v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+)
+
+// buildCardinalityQuery returns the query counting the series that the metric grouped by the labels returns
+// during the range.
+func buildCardinalityQuery(metricName string, labels []string, grouping string, bounds prom_v1.Range) string {
+	rangeDuration := bounds.End.Sub(bounds.Start).Round(time.Second)
+	if rangeDuration < time.Second {
+		rangeDuration = time.Second
+	}
+	selectors := make([]string, 0, len(labels))
+	for _, labelsInstance := range labels {
+		selectors = append(selectors, fmt.Sprintf("count_over_time(%s%s[%s])", metricName, labelsInstance, model.Duration(rangeDuration)))
+	}
+	// Example: count(count(count_over_time(my_counter{foo=bar}[30m])) by (baz))
+	return fmt.Sprintf("count(count(%s) by (%s))", strings.Join(selectors, " or "), grouping)
+}
+
+// estimateCardinality returns the number of series that the metric grouped by the labels returns during the range.
+func estimateCardinality(ctx context.Context, api prom_v1.API, metricName string, labels []string, grouping string, bounds prom_v1.Range) (int, error) {
+	query := buildCardinalityQuery(metricName, labels, grouping, bounds)
+	log.Tracef("[Prom] estimateCardinality: %s", query)
+	result, warnings, err := api.Query(ctx, query, bounds.End)
+	if len(warnings) > 0 {
+		log.Warningf("estimateCardinality. Prometheus Warnings: [%s]", strings.Join(warnings, ","))
+	}
+	if err != nil {
+		return 0, err
+	}
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return 0, fmt.Errorf("invalid query, vector expected: %s", query)
+	}
+	// No series: the count returns no sample
+	if len(vector) == 0 {
+		return 0, nil
+	}
+	return int(vector[0].Value), nil
+}
+
+// limitGrouping checks the cardinality of the metric grouped by the labels against the configured limit, before
+// running the grouped query. It returns the grouping to use: the given one when it is within the limit, or none
+// when the query is downgraded. Queries exceeding the limit are rejected with a bad request error explaining the
+// limit. The grouping is kept when the cardinality can't be estimated.
+func limitGrouping(ctx context.Context, api prom_v1.API, cardinality config.QueryCardinalityConfig, metricName string, labels []string, grouping string, bounds prom_v1.Range) (string, error) {
+	if grouping == "" || cardinality.MaxSeries <= 0 {
+		return grouping, nil
+	}
+
+	series, err := estimateCardinality(ctx, api, metricName, labels, grouping, bounds)
+	if err != nil {
+		log.Debugf("Unable to estimate the cardinality of metric [%s] by [%s]: %s", metricName, grouping, err)
+		return grouping, nil
+	}
+	if series <= cardinality.MaxSeries {
+		return grouping, nil
+	}
+
+	if cardinality.Action == config.QueryCardinalityDowngrade {
+		log.Infof("Metric [%s] by [%s] would return %d series, more than the limit of %d: the grouping is dropped", metricName, grouping, series, cardinality.MaxSeries)
+		return "", nil
+	}
+	return "", errors.NewBadRequest(fmt.Sprintf("The metric [%s] grouped by [%s] would return %d series, more than the limit of %d. Group by fewer labels or narrow the query.", metricName, grouping, series, cardinality.MaxSeries))
+}
@@ -26,10 +26,13 @@ var invalidLabelCharRE = regexp.MustCompile(`[^a-zA-Z0-9_]`)
 // ClientInterface for mocks (only mocked function are necessary here)
 type ClientInterface interface {
 	FetchDelta(metricName, labels, grouping string, queryTime time.Time, duration time.Duration) Metric
+	FetchIncrease(metricName, labels, grouping string, queryTime time.Time, duration time.Duration) Metric
 	FetchHistogramRange(metricName, labels, grouping string, q *RangeQuery) Histogram
 	FetchHistogramValues(metricName, labels, grouping, rateInterval string, avg bool, quantiles []string, queryTime time.Time) (map[string]model.Vector, error)
 	FetchRange(metricName, labels, grouping, aggregator string, q *RangeQuery) Metric
 	FetchRateRange(metricName string, labels []string, grouping string, q *RangeQuery) Metric
+	FetchRateValues(metricName string, labels []string, grouping, rateInterval string, queryTime time.Time) (model.Vector, error)
+	FetchTrend(query string, bounds prom_v1.Range, maxSourceResolution string) Metric
 	GetAllRequestRates(namespace, cluster, ratesInterval string, queryTime time.Time) (model.Vector, error)
 	GetAppRequestRates(namespace, cluster, app, ratesInterval string, queryTime time.Time) (model.Vector, model.Vector, error)
 	GetConfiguration() (prom_v1.ConfigResult, error)
@@ -47,13 +50,23 @@ type Client struct {
 	p8s api.Client
 	api prom_v1.API
 	ctx context.Context
+	// cardinality limits the series of the queries grouped by labels
+	cardinality config.QueryCardinalityConfig
 }
 
 var (
 	once      sync.Once
 	promCache PromCache
+	// apiProvider, when set, provides the API of the new clients instead of the configured Prometheus server.
+	apiProvider func() prom_v1.API
 )
 
+// SetAPIProvider replaces the configured Prometheus server with the API of the provider for the new clients, i.e.
+// to serve synthetic metrics. A nil provider restores the configured Prometheus server.
+func SetAPIProvider(provider func() prom_v1.API) {
+	apiProvider = provider
+}
+
 func initPromCache() {
 	if config.Get().ExternalServices.Prometheus.CacheEnabled {
 		log.Infof("[Prom Cache] Enabled")
@@ -77,6 +90,10 @@ func NewClientForConfig(cfg config.PrometheusConfig) (*Client, error) {
 	// Prom Cache will be initialized once at first use of Prometheus Client
 	once.Do(initPromCache)
 
+	if apiProvider != nil {
+		return &Client{api: apiProvider(), ctx: context.Background(), cardinality: cfg.QueryCardinality}, nil
+	}
+
 	// Be sure to copy config.Auth and not modify the existing
 	auth := cfg.Auth
 	if auth.UseKialiToken {
@@ -112,7 +129,7 @@ func NewClientForConfig(cfg config.PrometheusConfig) (*Client, error) {
 	if err != nil {
 		return nil, errors.NewServiceUnavailable(err.Error())
 	}
-	client := Client{p8s: p8s, api: prom_v1.NewAPI(p8s), ctx: context.Background()}
+	client := Client{p8s: p8s, api: prom_v1.NewAPI(p8s), ctx: context.Background(), cardinality: cfg.QueryCardinality}
 	return &client, nil
 }
 
@@ -240,6 +257,16 @@ func (in *Client) FetchDelta(metricName, labels, grouping string, queryTime time
 	return fetchQuery(in.ctx, in.api, query, queryTime)
 }
 
+// FetchIncrease fetches the increase of a counter for a given duration, summed by the grouping labels
+func (in *Client) FetchIncrease(metricName, labels, grouping string, queryTime time.Time, duration time.Duration) Metric {
+	increase := fmt.Sprintf("increase(%s%s[%s])", metricName, labels, model.Duration(duration.Round(time.Second)).String())
+	query := fmt.Sprintf("sum(%s)", increase)
+	if grouping != "" {
+		query = fmt.Sprintf("sum by (%s) (%s)", grouping, increase)
+	}
+	return fetchQuery(in.ctx, in.api, query, queryTime)
+}
+
 // FetchRange fetches a simple metric (gauge or counter) in given range
 func (in *Client) FetchRange(metricName, labels, grouping, aggregator string, q *RangeQuery) Metric {
 	query := fmt.Sprintf("%s(%s%s)", aggregator, metricName, labels)
@@ -249,13 +276,44 @@ func (in *Client) FetchRange(metricName, labels, grouping, aggregator string, q
 	return fetchRange(in.ctx, in.api, query, q.Range)
 }
 
-// FetchRateRange fetches a counter's rate in given range
+// FetchRateRange fetches a counter's rate in given range. The grouping is subject to the cardinality limit.
 func (in *Client) FetchRateRange(metricName string, labels []string, grouping string, q *RangeQuery) Metric {
+	grouping, err := limitGrouping(in.ctx, in.api, in.cardinality, metricName, labels, grouping, q.Range)
+	if err != nil {
+		return Metric{Err: err}
+	}
 	return fetchRateRange(in.ctx, in.api, metricName, labels, grouping, q)
 }
 
-// FetchHistogramRange fetches bucketed metric as histogram in given range
+// FetchRateValues fetches a counter's rate at a given specific time, summed by the grouping labels
+func (in *Client) FetchRateValues(metricName string, labels []string, grouping, rateInterval string, queryTime time.Time) (model.Vector, error) {
+	return fetchRateValues(in.ctx, in.api, metricName, labels, grouping, rateInterval, queryTime)
+}
+
+// FetchTrend fetches a query in a long range. The maxSourceResolution, when not empty, lets Thanos use its
+// downsampled blocks (raw, 5m or 1h), which the Prometheus V1 HTTP API does not support.
+func (in *Client) FetchTrend(query string, bounds prom_v1.Range, maxSourceResolution string) Metric {
+	if maxSourceResolution == "" || in.p8s == nil {
+		return fetchRange(in.ctx, in.api, query, bounds)
+	}
+	return fetchDownsampledRange(in.ctx, in.p8s, query, bounds, maxSourceResolution)
+}
+
+// FetchHistogramRange fetches bucketed metric as histogram in given range. The grouping is subject to the
+// cardinality limit.
 func (in *Client) FetchHistogramRange(metricName, labels, grouping string, q *RangeQuery) Histogram {
+	grouping, err := limitGrouping(in.ctx, in.api, in.cardinality, metricName+"_count", []string{labels}, grouping, q.Range)
+	if err != nil {
+		// The error is reported by every statistic
+		histogram := Histogram{}
+		for _, quantile := range q.Quantiles {
+			histogram[quantile] = Metric{Err: err}
+		}
+		if q.Avg || len(histogram) == 0 {
+			histogram["avg"] = Metric{Err: err}
+		}
+		return histogram
+	}
 	return fetchHistogramRange(in.ctx, in.api, metricName, labels, grouping, q)
 }
 
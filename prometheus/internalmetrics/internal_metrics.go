@@ -3,6 +3,7 @@ package internalmetrics
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	// Because this package is used all throughout the codebase, be VERY careful adding new
@@ -23,6 +24,8 @@ const (
 	labelService          = "service"
 	labelType             = "type"
 	labelName             = "name"
+	labelCluster          = "cluster"
+	labelKind             = "kind"
 )
 
 // MetricsType defines all of Kiali's own internal metrics.
@@ -42,6 +45,11 @@ type MetricsType struct {
 	SingleValidationProcessingTime *prometheus.HistogramVec
 	CacheTotalRequests             *prometheus.CounterVec
 	CacheHitsTotal                 *prometheus.CounterVec
+	CacheLastEventTime             *prometheus.GaugeVec
+	CacheLastSyncTime              *prometheus.GaugeVec
+	CacheObjects                   *prometheus.GaugeVec
+	CacheWatchErrors               *prometheus.CounterVec
+	IstioConfigChanges             *prometheus.CounterVec
 	ValidationProcessingTime       *prometheus.HistogramVec
 }
 
@@ -140,6 +148,41 @@ var Metrics = MetricsType{
 		},
 		[]string{labelName},
 	),
+	CacheObjects: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kiali_cache_objects",
+			Help: "The number of objects of a kind held by the informers of the Kiali cache in a namespace.",
+		},
+		[]string{labelCluster, labelNamespace, labelKind},
+	),
+	CacheLastSyncTime: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kiali_cache_last_sync_timestamp_seconds",
+			Help: "The time the informers of the Kiali cache last completed their initial sync. The namespace is empty for a cluster-scoped cache.",
+		},
+		[]string{labelCluster, labelNamespace},
+	),
+	CacheLastEventTime: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kiali_cache_last_event_timestamp_seconds",
+			Help: "The time the informers of the Kiali cache last received a change of an object of a namespace.",
+		},
+		[]string{labelCluster, labelNamespace},
+	),
+	CacheWatchErrors: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kiali_cache_watch_errors_total",
+			Help: "The number of errors encountered by the watches of the Kiali cache informers. The namespace is empty for a cluster-scoped cache.",
+		},
+		[]string{labelCluster, labelNamespace},
+	),
+	IstioConfigChanges: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kiali_istio_config_changes_total",
+			Help: "The number of changes of the Istio objects of a kind in a namespace, seen by the watches of the Kiali cache.",
+		},
+		[]string{labelCluster, labelNamespace, labelKind},
+	),
 }
 
 // SuccessOrFailureMetricType let's you capture metrics for both successes and failures,
@@ -203,6 +246,11 @@ func RegisterInternalMetrics() {
 		Metrics.SingleValidationProcessingTime,
 		Metrics.CacheTotalRequests,
 		Metrics.CacheHitsTotal,
+		Metrics.CacheObjects,
+		Metrics.CacheLastSyncTime,
+		Metrics.CacheLastEventTime,
+		Metrics.CacheWatchErrors,
+		Metrics.IstioConfigChanges,
 	)
 }
 
@@ -422,3 +470,53 @@ func GetCacheHitsTotalMetric(cache string) prometheus.Counter {
 		labelName: cache,
 	})
 }
+
+func GetCacheObjectsMetric(cluster string, namespace string, kind string) prometheus.Gauge {
+	return Metrics.CacheObjects.With(prometheus.Labels{
+		labelCluster:   cluster,
+		labelNamespace: namespace,
+		labelKind:      kind,
+	})
+}
+
+// SetCacheLastSyncTime sets the time the informers of a cache scope, a namespace or the whole cluster when the
+// namespace is empty, completed their sync.
+func SetCacheLastSyncTime(cluster string, namespace string, t time.Time) {
+	Metrics.CacheLastSyncTime.With(prometheus.Labels{
+		labelCluster:   cluster,
+		labelNamespace: namespace,
+	}).Set(float64(t.Unix()))
+}
+
+// SetCacheLastEventTime sets the time an informer of the cache received a change of an object of the namespace.
+func SetCacheLastEventTime(cluster string, namespace string, t time.Time) {
+	Metrics.CacheLastEventTime.With(prometheus.Labels{
+		labelCluster:   cluster,
+		labelNamespace: namespace,
+	}).Set(float64(t.Unix()))
+}
+
+func GetCacheWatchErrorsMetric(cluster string, namespace string) prometheus.Counter {
+	return Metrics.CacheWatchErrors.With(prometheus.Labels{
+		labelCluster:   cluster,
+		labelNamespace: namespace,
+	})
+}
+
+func GetIstioConfigChangesMetric(cluster string, namespace string, kind string) prometheus.Counter {
+	return Metrics.IstioConfigChanges.With(prometheus.Labels{
+		labelCluster:   cluster,
+		labelNamespace: namespace,
+		labelKind:      kind,
+	})
+}
+
+// DeleteCacheObjectsMetrics removes the object counts of a cache scope, i.e. before its informers are restarted.
+// All the namespaces of the cluster are removed when the namespace is empty.
+func DeleteCacheObjectsMetrics(cluster string, namespace string) {
+	labels := prometheus.Labels{labelCluster: cluster}
+	if namespace != "" {
+		labels[labelNamespace] = namespace
+	}
+	Metrics.CacheObjects.DeletePartialMatch(labels)
+}
@@ -2,10 +2,15 @@ package prometheus
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/prometheus/client_golang/api"
 	prom_v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -16,6 +21,28 @@ import (
 )
 
 func fetchRateRange(ctx context.Context, api prom_v1.API, metricName string, labels []string, grouping string, q *RangeQuery) Metric {
+	query := buildRateQuery(metricName, labels, grouping, q.RateFunc, q.RateInterval)
+	return fetchRange(ctx, api, query, q.Range)
+}
+
+func fetchRateValues(ctx context.Context, api prom_v1.API, metricName string, labels []string, grouping, rateInterval string, queryTime time.Time) (model.Vector, error) {
+	query := buildRateQuery(metricName, labels, grouping, "rate", rateInterval)
+	log.Tracef("[Prom] fetchRateValues: %s", query)
+	result, warnings, err := api.Query(ctx, query, queryTime)
+	if len(warnings) > 0 {
+		log.Warningf("fetchRateValues. Prometheus Warnings: [%s]", strings.Join(warnings, ","))
+	}
+	if err != nil {
+		return nil, errors.NewServiceUnavailable(err.Error())
+	}
+	vector, ok := result.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("invalid query, unexpected result type [%s]: [%s]", result.Type(), query)
+	}
+	return vector, nil
+}
+
+func buildRateQuery(metricName string, labels []string, grouping, rateFunc, rateInterval string) string {
 	var query string
 	// Example: sum(rate(my_counter{foo=bar}[5m])) by (baz)
 	for i, labelsInstance := range labels {
@@ -23,15 +50,15 @@ func fetchRateRange(ctx context.Context, api prom_v1.API, metricName string, lab
 			query += " OR "
 		}
 		if grouping == "" {
-			query += fmt.Sprintf("sum(%s(%s%s[%s]))", q.RateFunc, metricName, labelsInstance, q.RateInterval)
+			query += fmt.Sprintf("sum(%s(%s%s[%s]))", rateFunc, metricName, labelsInstance, rateInterval)
 		} else {
-			query += fmt.Sprintf("sum(%s(%s%s[%s])) by (%s)", q.RateFunc, metricName, labelsInstance, q.RateInterval, grouping)
+			query += fmt.Sprintf("sum(%s(%s%s[%s])) by (%s)", rateFunc, metricName, labelsInstance, rateInterval, grouping)
 		}
 	}
 	if len(labels) > 1 {
 		query = fmt.Sprintf("(%s)", query)
 	}
-	return fetchRange(ctx, api, query, q.Range)
+	return query
 }
 
 func fetchHistogramRange(ctx context.Context, api prom_v1.API, metricName, labels, grouping string, q *RangeQuery) Histogram {
@@ -124,6 +151,51 @@ func vectorToMatrix(vector []*model.Sample, t model.Time) model.Matrix {
 	return matrix
 }
 
+// fetchDownsampledRange runs a range query against the Thanos query API, reading the downsampled blocks up to the
+// given resolution.
+func fetchDownsampledRange(ctx context.Context, client api.Client, query string, bounds prom_v1.Range, maxSourceResolution string) Metric {
+	u := client.URL("/api/v1/query_range", nil)
+	args := url.Values{}
+	args.Set("query", query)
+	args.Set("start", strconv.FormatInt(bounds.Start.Unix(), 10))
+	args.Set("end", strconv.FormatInt(bounds.End.Unix(), 10))
+	args.Set("step", strconv.FormatFloat(bounds.Step.Seconds(), 'f', -1, 64))
+	args.Set("max_source_resolution", maxSourceResolution)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(args.Encode()))
+	if err != nil {
+		return Metric{Err: err}
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	_, body, err := client.Do(ctx, req)
+	if err != nil {
+		return Metric{Err: err}
+	}
+
+	var response struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string       `json:"resultType"`
+			Result     model.Matrix `json:"result"`
+		} `json:"data"`
+		Warnings []string `json:"warnings"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return Metric{Err: fmt.Errorf("invalid response of query [%s]: %s", query, err)}
+	}
+	if len(response.Warnings) > 0 {
+		log.Warningf("fetchDownsampledRange. Prometheus Warnings: [%s]", strings.Join(response.Warnings, ","))
+	}
+	if response.Status != "success" {
+		return Metric{Err: fmt.Errorf("query [%s] failed: %s", query, response.Error)}
+	}
+	if response.Data.ResultType != model.ValMatrix.String() {
+		return Metric{Err: fmt.Errorf("invalid query, matrix expected: %s", query)}
+	}
+	return Metric{Matrix: response.Data.Result}
+}
+
 func fetchRange(ctx context.Context, api prom_v1.API, query string, bounds prom_v1.Range) Metric {
 	result, warnings, err := api.QueryRange(ctx, query, bounds)
 	if len(warnings) > 0 {
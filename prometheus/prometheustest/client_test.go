@@ -8,6 +8,8 @@ import (
 	"github.com/prometheus/common/model"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/prometheus"
@@ -144,3 +146,74 @@ func mockConfig(api *PromAPIMock, ret prom_v1.ConfigResult) {
 func mockRuntimeinfoResult(api *PromAPIMock, ret prom_v1.RuntimeinfoResult) {
 	api.On("Runtimeinfo", mock.Anything).Return(ret, nil)
 }
+
+func TestFetchIncrease(t *testing.T) {
+	require := require.New(t)
+	client, api, err := setupMocked()
+	require.NoError(err)
+
+	queryTime := time.Date(2017, 01, 15, 0, 0, 0, 0, time.UTC)
+	api.OnQueryTime(`sum by (destination_service_name,source_workload) (increase(istio_requests_total{reporter="source"}[1d]))`, &queryTime, model.Vector{
+		{Metric: model.Metric{"destination_service_name": "PassthroughCluster", "source_workload": "reviews-v1"}, Value: 30},
+	})
+	api.OnQueryTime(`sum(increase(istio_requests_total{reporter="source"}[1h]))`, &queryTime, model.Vector{{Value: 42}})
+
+	metric := client.FetchIncrease("istio_requests_total", `{reporter="source"}`, "destination_service_name,source_workload", queryTime, 24*time.Hour)
+	require.NoError(metric.Err)
+	require.Len(metric.Matrix, 1)
+	require.Equal(model.LabelValue("reviews-v1"), metric.Matrix[0].Metric["source_workload"])
+	require.Equal(model.SampleValue(30), metric.Matrix[0].Values[0].Value)
+
+	metric = client.FetchIncrease("istio_requests_total", `{reporter="source"}`, "", queryTime, time.Hour)
+	require.NoError(metric.Err)
+	require.Equal(model.SampleValue(42), metric.Matrix[0].Values[0].Value)
+}
+
+func TestFetchRateRangeCardinalityLimit(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Prometheus.QueryCardinality = config.QueryCardinalityConfig{Action: config.QueryCardinalityReject, MaxSeries: 100}
+	config.Set(conf)
+	client, err := prometheus.NewClient()
+	require.NoError(err)
+	api := new(PromAPIMock)
+	client.Inject(api)
+
+	q := &prometheus.RangeQuery{}
+	q.FillDefaults()
+	api.OnQueryTime(`count(count(count_over_time(istio_requests_total{reporter="source"}[30m])) by (request_path))`, &q.End, model.Vector{{Value: 5000}})
+	api.OnQueryTime(`count(count(count_over_time(istio_requests_total{reporter="source"}[30m])) by (source_workload))`, &q.End, model.Vector{{Value: 10}})
+	api.MockRange(`sum(rate(istio_requests_total{reporter="source"}[1m])) by (source_workload)`, 1)
+
+	metric := client.FetchRateRange("istio_requests_total", []string{`{reporter="source"}`}, "request_path", q)
+	require.True(errors.IsBadRequest(metric.Err))
+	require.Contains(metric.Err.Error(), "5000 series, more than the limit of 100")
+	api.AssertNotCalled(t, "QueryRange", mock.Anything, `sum(rate(istio_requests_total{reporter="source"}[1m])) by (request_path)`, mock.Anything)
+
+	metric = client.FetchRateRange("istio_requests_total", []string{`{reporter="source"}`}, "source_workload", q)
+	require.NoError(metric.Err)
+	require.Len(metric.Matrix, 1)
+}
+
+func TestFetchHistogramRangeCardinalityDowngrade(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Prometheus.QueryCardinality = config.QueryCardinalityConfig{Action: config.QueryCardinalityDowngrade, MaxSeries: 100}
+	config.Set(conf)
+	client, err := prometheus.NewClient()
+	require.NoError(err)
+	api := new(PromAPIMock)
+	client.Inject(api)
+
+	q := &prometheus.RangeQuery{}
+	q.FillDefaults()
+	api.OnQueryTime(`count(count(count_over_time(istio_request_duration_milliseconds_count{reporter="source"}[30m])) by (request_path))`, &q.End, model.Vector{{Value: 5000}})
+	// Without grouping
+	api.MockRange(`sum(rate(istio_request_duration_milliseconds_sum{reporter="source"}[1m])) / sum(rate(istio_request_duration_milliseconds_count{reporter="source"}[1m]))`, 12)
+
+	histogram := client.FetchHistogramRange("istio_request_duration_milliseconds", `{reporter="source"}`, "request_path", q)
+	require.NoError(histogram["avg"].Err)
+	require.Len(histogram["avg"].Matrix, 1)
+}
@@ -0,0 +1,428 @@
+package prometheustest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	prom_v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"gopkg.in/yaml.v2"
+
+	"github.com/kiali/kiali/prometheus"
+)
+
+// QuantileLabel restricts a series to the histogram_quantile queries of its quantile, i.e. 0.95
+const QuantileLabel = "quantile"
+
+var (
+	// selectorRegexp matches the first selector of a query, i.e. istio_requests_total{reporter="source"}
+	selectorRegexp = regexp.MustCompile(`([a-zA-Z_:][a-zA-Z0-9_:]*)\s*\{([^}]*)\}`)
+	// matcherRegexp matches a label matcher of a selector
+	matcherRegexp = regexp.MustCompile(`([a-zA-Z_][a-zA-Z0-9_]*)\s*(=~|!~|!=|=)\s*"((?:[^"\\]|\\.)*)"`)
+	// aggregationRegexp matches the first aggregation of a query
+	aggregationRegexp = regexp.MustCompile(`\b(sum|avg|max|min|count)\s*(\(|by\b|without\b)`)
+	// groupingRegexp matches the labels of the first aggregation of a query, i.e. by (source_workload,destination_workload)
+	groupingRegexp = regexp.MustCompile(`\bby\s*\(([^)]*)\)`)
+	// quantileRegexp matches the quantile of a histogram_quantile query
+	quantileRegexp = regexp.MustCompile(`histogram_quantile\(\s*([0-9.]+)`)
+	// windowRegexp matches the range of a range vector, i.e. [10m]
+	windowRegexp = regexp.MustCompile(`\[([0-9a-z]+)\]`)
+)
+
+// Series is a series of a FakePromAPI. Its value is the rate per second of a counter, or the value of a gauge.
+type Series struct {
+	Labels map[string]string `yaml:"labels"`
+	Metric string            `yaml:"metric"`
+	Value  float64           `yaml:"value"`
+	// ValueFunc, when set, returns the value of the series at a time instead of Value
+	ValueFunc func(time.Time) float64 `yaml:"-"`
+}
+
+func (s Series) valueAt(t time.Time) float64 {
+	if s.ValueFunc != nil {
+		return s.ValueFunc(t)
+	}
+	return s.Value
+}
+
+// Scenario is a set of series served by a FakePromAPI, usually loaded from a YAML fixture:
+//
+//	series:
+//	- metric: istio_requests_total
+//	  labels: {reporter: destination, destination_workload: reviews-v1, response_code: "200"}
+//	  value: 10
+type Scenario struct {
+	Series []Series `yaml:"series"`
+}
+
+// LoadScenario parses a YAML scenario.
+func LoadScenario(data []byte) (*Scenario, error) {
+	scenario := &Scenario{}
+	if err := yaml.Unmarshal(data, scenario); err != nil {
+		return nil, fmt.Errorf("invalid Prometheus scenario: %w", err)
+	}
+	for i, series := range scenario.Series {
+		if series.Metric == "" {
+			return nil, fmt.Errorf("invalid Prometheus scenario: series %d has no metric", i)
+		}
+	}
+	return scenario, nil
+}
+
+// LoadScenarioFile parses a YAML scenario file.
+func LoadScenarioFile(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadScenario(data)
+}
+
+// FakePromAPI is an in-memory Prometheus API serving a set of series, for the tests running the business layer
+// without a Prometheus server. It doesn't implement PromQL: a query is answered by the series of its first selector,
+// filtered by its label matchers and aggregated by the labels of its first aggregation. The histogram_quantile and
+// ratio queries average the series, the others sum them up. The increase queries multiply the rates by their range.
+type FakePromAPI struct {
+	series []Series
+}
+
+// NewFakePromAPI returns a FakePromAPI serving the series.
+func NewFakePromAPI(series ...Series) *FakePromAPI {
+	return &FakePromAPI{series: series}
+}
+
+// NewFakePromAPIFromFile returns a FakePromAPI serving the series of a YAML scenario file.
+func NewFakePromAPIFromFile(path string) (*FakePromAPI, error) {
+	scenario, err := LoadScenarioFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewFakePromAPI(scenario.Series...), nil
+}
+
+// NewFakeClient returns a Prometheus client querying a fake API.
+func NewFakeClient(api prom_v1.API) (*prometheus.Client, error) {
+	client, err := prometheus.NewClient()
+	if err != nil {
+		return nil, err
+	}
+	client.Inject(api)
+	return client, nil
+}
+
+type matcher struct {
+	name  string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func (m matcher) matches(value string) bool {
+	switch m.op {
+	case "=":
+		return value == m.value
+	case "!=":
+		return value != m.value
+	case "=~":
+		return m.re.MatchString(value)
+	default:
+		return !m.re.MatchString(value)
+	}
+}
+
+// parseSelector returns the metric name and the label matchers of a selector.
+func parseSelector(selector string) (string, []matcher, error) {
+	name := ""
+	body := selector
+	if s := selectorRegexp.FindStringSubmatch(selector); s != nil {
+		name, body = s[1], s[2]
+	}
+	matchers := []matcher{}
+	for _, m := range matcherRegexp.FindAllStringSubmatch(body, -1) {
+		value, err := strconv.Unquote(`"` + m[3] + `"`)
+		if err != nil {
+			value = m[3]
+		}
+		mt := matcher{name: m[1], op: m[2], value: value}
+		if mt.op == "=~" || mt.op == "!~" {
+			if mt.re, err = regexp.Compile("^(?:" + value + ")$"); err != nil {
+				return "", nil, err
+			}
+		}
+		if mt.name == model.MetricNameLabel && mt.op == "=" {
+			name = value
+			continue
+		}
+		matchers = append(matchers, mt)
+	}
+	return name, matchers, nil
+}
+
+func (s Series) matches(name string, matchers []matcher) bool {
+	if name != "" && s.Metric != name {
+		return false
+	}
+	for _, m := range matchers {
+		if !m.matches(s.Labels[m.name]) {
+			return false
+		}
+	}
+	return true
+}
+
+// evaluate answers a query at a time.
+func (f *FakePromAPI) evaluate(query string, t time.Time) (model.Vector, error) {
+	vector := model.Vector{}
+	if !selectorRegexp.MatchString(query) {
+		return vector, nil
+	}
+	name, matchers, err := parseSelector(query)
+	if err != nil {
+		return nil, err
+	}
+
+	quantile := ""
+	if q := quantileRegexp.FindStringSubmatch(query); q != nil {
+		quantile = q[1]
+	}
+	factor := 1.0
+	if strings.Contains(query, "increase(") {
+		if w := windowRegexp.FindStringSubmatch(query); w != nil {
+			if window, err := model.ParseDuration(w[1]); err == nil {
+				factor = time.Duration(window).Seconds()
+			}
+		}
+	}
+
+	aggregation := ""
+	if a := aggregationRegexp.FindStringSubmatch(query); a != nil {
+		aggregation = a[1]
+		if quantile != "" || strings.Contains(query, "/") {
+			aggregation = "avg"
+		}
+	}
+	var grouping []model.LabelName
+	if g := groupingRegexp.FindStringSubmatch(query); g != nil {
+		for _, label := range strings.Split(g[1], ",") {
+			if label = strings.TrimSpace(label); label != "" && label != "le" {
+				grouping = append(grouping, model.LabelName(label))
+			}
+		}
+	}
+
+	type group struct {
+		sample *model.Sample
+		count  int
+	}
+	groups := map[model.Fingerprint]*group{}
+	for _, s := range f.series {
+		if q, ok := s.Labels[QuantileLabel]; ok && q != quantile {
+			continue
+		}
+		if !s.matches(name, matchers) {
+			continue
+		}
+		labels := model.Metric{}
+		if aggregation == "" {
+			labels[model.MetricNameLabel] = model.LabelValue(s.Metric)
+			for label, value := range s.Labels {
+				if label != QuantileLabel {
+					labels[model.LabelName(label)] = model.LabelValue(value)
+				}
+			}
+		} else {
+			for _, label := range grouping {
+				if value, ok := s.Labels[string(label)]; ok {
+					labels[label] = model.LabelValue(value)
+				}
+			}
+		}
+
+		value := s.valueAt(t) * factor
+		g, ok := groups[labels.Fingerprint()]
+		if !ok {
+			g = &group{sample: &model.Sample{Metric: labels, Value: model.SampleValue(value), Timestamp: model.TimeFromUnixNano(t.UnixNano())}}
+			if aggregation == "count" {
+				g.sample.Value = 1
+			}
+			groups[labels.Fingerprint()] = g
+			vector = append(vector, g.sample)
+			g.count = 1
+			continue
+		}
+		g.count++
+		current := float64(g.sample.Value)
+		switch aggregation {
+		case "avg":
+			current += (value - current) / float64(g.count)
+		case "max":
+			current = math.Max(current, value)
+		case "min":
+			current = math.Min(current, value)
+		case "count":
+			current = float64(g.count)
+		default:
+			current += value
+		}
+		g.sample.Value = model.SampleValue(current)
+	}
+	return vector, nil
+}
+
+func (f *FakePromAPI) Query(ctx context.Context, query string, ts time.Time, opts ...prom_v1.Option) (model.Value, prom_v1.Warnings, error) {
+	vector, err := f.evaluate(query, ts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return vector, nil, nil
+}
+
+func (f *FakePromAPI) QueryRange(ctx context.Context, query string, r prom_v1.Range, opts ...prom_v1.Option) (model.Value, prom_v1.Warnings, error) {
+	matrix := model.Matrix{}
+	if r.Step <= 0 {
+		return matrix, nil, nil
+	}
+	streams := map[model.Fingerprint]*model.SampleStream{}
+	for t := r.Start; !t.After(r.End); t = t.Add(r.Step) {
+		vector, err := f.evaluate(query, t)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, sample := range vector {
+			stream, ok := streams[sample.Metric.Fingerprint()]
+			if !ok {
+				stream = &model.SampleStream{Metric: sample.Metric}
+				streams[sample.Metric.Fingerprint()] = stream
+				matrix = append(matrix, stream)
+			}
+			stream.Values = append(stream.Values, model.SamplePair{Timestamp: sample.Timestamp, Value: sample.Value})
+		}
+	}
+	return matrix, nil, nil
+}
+
+// Series returns the label sets of the series matching any of the selectors.
+func (f *FakePromAPI) Series(ctx context.Context, matches []string, startTime, endTime time.Time) ([]model.LabelSet, prom_v1.Warnings, error) {
+	result := []model.LabelSet{}
+	seen := map[model.Fingerprint]bool{}
+	for _, match := range matches {
+		name, matchers, err := parseSelector(match)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, s := range f.series {
+			if !s.matches(name, matchers) {
+				continue
+			}
+			labels := model.LabelSet{model.MetricNameLabel: model.LabelValue(s.Metric)}
+			for label, value := range s.Labels {
+				labels[model.LabelName(label)] = model.LabelValue(value)
+			}
+			if !seen[labels.Fingerprint()] {
+				seen[labels.Fingerprint()] = true
+				result = append(result, labels)
+			}
+		}
+	}
+	return result, nil, nil
+}
+
+func (f *FakePromAPI) LabelNames(ctx context.Context, matches []string, startTime, endTime time.Time) ([]string, prom_v1.Warnings, error) {
+	names := map[string]bool{}
+	for _, s := range f.series {
+		for label := range s.Labels {
+			names[label] = true
+		}
+	}
+	result := []string{}
+	for name := range names {
+		result = append(result, name)
+	}
+	return result, nil, nil
+}
+
+func (f *FakePromAPI) LabelValues(ctx context.Context, label string, matches []string, startTime, endTime time.Time) (model.LabelValues, prom_v1.Warnings, error) {
+	values := map[string]bool{}
+	for _, s := range f.series {
+		if value, ok := s.Labels[label]; ok {
+			values[value] = true
+		} else if label == model.MetricNameLabel {
+			values[s.Metric] = true
+		}
+	}
+	result := model.LabelValues{}
+	for value := range values {
+		result = append(result, model.LabelValue(value))
+	}
+	return result, nil, nil
+}
+
+func (f *FakePromAPI) Alerts(ctx context.Context) (prom_v1.AlertsResult, error) {
+	return prom_v1.AlertsResult{}, nil
+}
+
+func (f *FakePromAPI) AlertManagers(ctx context.Context) (prom_v1.AlertManagersResult, error) {
+	return prom_v1.AlertManagersResult{}, nil
+}
+
+func (f *FakePromAPI) Buildinfo(ctx context.Context) (prom_v1.BuildinfoResult, error) {
+	return prom_v1.BuildinfoResult{Version: "fake"}, nil
+}
+
+func (f *FakePromAPI) CleanTombstones(ctx context.Context) error {
+	return nil
+}
+
+func (f *FakePromAPI) Config(ctx context.Context) (prom_v1.ConfigResult, error) {
+	return prom_v1.ConfigResult{YAML: "global:\n  scrape_interval: 15s\n"}, nil
+}
+
+func (f *FakePromAPI) DeleteSeries(ctx context.Context, matches []string, startTime, endTime time.Time) error {
+	return nil
+}
+
+func (f *FakePromAPI) Flags(ctx context.Context) (prom_v1.FlagsResult, error) {
+	return prom_v1.FlagsResult{"storage.tsdb.retention.time": "15d"}, nil
+}
+
+func (f *FakePromAPI) Metadata(ctx context.Context, metric, limit string) (map[string][]prom_v1.Metadata, error) {
+	return map[string][]prom_v1.Metadata{}, nil
+}
+
+func (f *FakePromAPI) QueryExemplars(ctx context.Context, query string, startTime, endTime time.Time) ([]prom_v1.ExemplarQueryResult, error) {
+	return []prom_v1.ExemplarQueryResult{}, nil
+}
+
+func (f *FakePromAPI) Rules(ctx context.Context) (prom_v1.RulesResult, error) {
+	return prom_v1.RulesResult{}, nil
+}
+
+func (f *FakePromAPI) Runtimeinfo(ctx context.Context) (prom_v1.RuntimeinfoResult, error) {
+	return prom_v1.RuntimeinfoResult{}, nil
+}
+
+func (f *FakePromAPI) Snapshot(ctx context.Context, skipHead bool) (prom_v1.SnapshotResult, error) {
+	return prom_v1.SnapshotResult{}, nil
+}
+
+func (f *FakePromAPI) Targets(ctx context.Context) (prom_v1.TargetsResult, error) {
+	return prom_v1.TargetsResult{}, nil
+}
+
+func (f *FakePromAPI) TargetsMetadata(ctx context.Context, matchTarget, metric, limit string) ([]prom_v1.MetricMetadata, error) {
+	return []prom_v1.MetricMetadata{}, nil
+}
+
+func (f *FakePromAPI) TSDB(ctx context.Context) (prom_v1.TSDBResult, error) {
+	return prom_v1.TSDBResult{}, nil
+}
+
+func (f *FakePromAPI) WalReplay(ctx context.Context) (prom_v1.WalReplayStatus, error) {
+	return prom_v1.WalReplayStatus{}, nil
+}
@@ -0,0 +1,55 @@
+package prometheustest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+)
+
+func TestFakePromAPI(t *testing.T) {
+	require := require.New(t)
+	config.Set(config.NewConfig())
+
+	api, err := NewFakePromAPIFromFile("testdata/bookinfo.yaml")
+	require.NoError(err)
+	client, err := NewFakeClient(api)
+	require.NoError(err)
+
+	now := time.Unix(1700000000, 0)
+	rates, err := client.GetServiceRequestRates("bookinfo", "east", "reviews", "1m", now)
+	require.NoError(err)
+	total := 0.0
+	for _, sample := range rates {
+		total += float64(sample.Value)
+	}
+	require.Equal(10.0, total)
+
+	value, _, err := api.Query(context.Background(), `sum(rate(istio_requests_total{destination_service_namespace="bookinfo",response_code=~"5.."}[1m])) by (destination_workload)`, now)
+	require.NoError(err)
+	require.Equal(model.Vector{{Metric: model.Metric{"destination_workload": "reviews-v2"}, Value: 1, Timestamp: model.TimeFromUnix(now.Unix())}}, value)
+
+	value, _, err = api.Query(context.Background(), `sum(increase(istio_requests_total{destination_service_name!="ratings"}[10m]))`, now)
+	require.NoError(err)
+	require.Equal(model.SampleValue(6000), value.(model.Vector)[0].Value)
+
+	value, _, err = api.Query(context.Background(), `histogram_quantile(0.95, sum(rate(istio_request_duration_milliseconds_bucket{destination_service_name="reviews"}[1m])) by (le))`, now)
+	require.NoError(err)
+	require.Equal(model.SampleValue(120), value.(model.Vector)[0].Value)
+
+	value, _, err = api.Query(context.Background(), `histogram_quantile(0.99, sum(rate(istio_request_duration_milliseconds_bucket{destination_service_name="reviews"}[1m])) by (le))`, now)
+	require.NoError(err)
+	require.Empty(value)
+
+	series, _, err := api.Series(context.Background(), []string{`{destination_service_name="ratings"}`}, now, now)
+	require.NoError(err)
+	require.Len(series, 1)
+	require.Equal(model.LabelValue("istio_requests_total"), series[0][model.MetricNameLabel])
+
+	_, err = LoadScenario([]byte("series:\n- value: 1\n"))
+	require.Error(err)
+}
@@ -344,6 +344,11 @@ func (o *PromClientMock) FetchDelta(metricName, labels, grouping string, queryTi
 	return args.Get(0).(prometheus.Metric)
 }
 
+func (o *PromClientMock) FetchIncrease(metricName, labels, grouping string, queryTime time.Time, duration time.Duration) prometheus.Metric {
+	args := o.Called(metricName, labels, grouping, queryTime, duration)
+	return args.Get(0).(prometheus.Metric)
+}
+
 func (o *PromClientMock) FetchRange(metricName, labels, grouping, aggregator string, q *prometheus.RangeQuery) prometheus.Metric {
 	args := o.Called(metricName, labels, grouping, aggregator, q)
 	return args.Get(0).(prometheus.Metric)
@@ -354,6 +359,16 @@ func (o *PromClientMock) FetchRateRange(metricName string, labels []string, grou
 	return args.Get(0).(prometheus.Metric)
 }
 
+func (o *PromClientMock) FetchRateValues(metricName string, labels []string, grouping, rateInterval string, queryTime time.Time) (model.Vector, error) {
+	args := o.Called(metricName, labels, grouping, rateInterval, queryTime)
+	return args.Get(0).(model.Vector), args.Error(1)
+}
+
+func (o *PromClientMock) FetchTrend(query string, bounds prom_v1.Range, maxSourceResolution string) prometheus.Metric {
+	args := o.Called(query, bounds, maxSourceResolution)
+	return args.Get(0).(prometheus.Metric)
+}
+
 func (o *PromClientMock) FetchHistogramRange(metricName, labels, grouping string, q *prometheus.RangeQuery) prometheus.Histogram {
 	args := o.Called(metricName, labels, grouping, q)
 	return args.Get(0).(prometheus.Histogram)
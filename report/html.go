@@ -0,0 +1,197 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"math"
+	"strings"
+
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/models"
+)
+
+const (
+	graphMargin     float64 = 80 // room around the nodes for their labels
+	graphNodeRadius float64 = 14
+)
+
+// svgGraph is the traffic graph of a report, in the coordinates of its SVG image.
+type svgGraph struct {
+	Width, Height float64
+	NodeRadius    float64
+	Nodes         []svgNode
+	Edges         []svgEdge
+}
+
+type svgNode struct {
+	models.ReportGraphNode
+	Color string
+}
+
+type svgEdge struct {
+	X1, Y1, X2, Y2 float64
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"percent": func(ratio float64) string { return fmt.Sprintf("%.2f%%", ratio*100) },
+	"rate":    func(rate float64) string { return fmt.Sprintf("%.2f", rate) },
+	"join":    strings.Join,
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Mesh status of namespace {{ .Report.Namespace }}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #151515; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #d2d2d2; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }
+.Healthy { color: #3e8635; } .Degraded { color: #f0ab00; } .Failure, .error { color: #c9190b; } .warning { color: #f0ab00; }
+svg text { font-size: 11px; }
+</style>
+</head>
+<body>
+<h1>Mesh status of namespace {{ .Report.Namespace }}</h1>
+<p>Cluster <b>{{ .Report.Cluster }}</b>, generated at {{ .Report.GeneratedAt.UTC.Format "2006-01-02 15:04:05 MST" }}, telemetry of the last {{ .Report.Duration }}.</p>
+{{- if .Report.URL }}
+<p><a href="{{ .Report.URL }}">Open in Kiali</a></p>
+{{- end }}
+
+<h2>Traffic graph</h2>
+{{- if not .Graph }}
+<p>The traffic graph is not available.</p>
+{{- else if not .Graph.Nodes }}
+<p>No traffic.</p>
+{{- else }}
+<svg xmlns="http://www.w3.org/2000/svg" width="{{ .Graph.Width }}" height="{{ .Graph.Height }}" viewBox="0 0 {{ .Graph.Width }} {{ .Graph.Height }}">
+<defs><marker id="arrow" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="8" markerHeight="8" orient="auto"><path d="M0,0 L10,5 L0,10 z" fill="#6a6e73"/></marker></defs>
+{{- range .Graph.Edges }}
+<line x1="{{ .X1 }}" y1="{{ .Y1 }}" x2="{{ .X2 }}" y2="{{ .Y2 }}" stroke="#6a6e73" marker-end="url(#arrow)"/>
+{{- end }}
+{{- range .Graph.Nodes }}
+<circle cx="{{ .X }}" cy="{{ .Y }}" r="{{ $.Graph.NodeRadius }}" fill="{{ .Color }}"><title>{{ .NodeType }}</title></circle>
+<text x="{{ .X }}" y="{{ .Y }}" dy="28" text-anchor="middle">{{ .Label }}</text>
+{{- end }}
+</svg>
+{{- end }}
+
+<h2>Health</h2>
+{{- if not .Report.Health.Apps }}
+<p>No apps.</p>
+{{- else }}
+<p>{{ range $status, $count := .Report.Health.Statuses }}<span class="{{ $status }}">{{ $status }}: {{ $count }}</span> {{ end }}</p>
+<table>
+<tr><th>App</th><th>Status</th><th>Replicas</th><th>Request rate (rps)</th><th>Error ratio</th></tr>
+{{- range .Report.Health.Apps }}
+<tr><td>{{ .Name }}</td><td class="{{ .Status }}">{{ .Status }}</td><td>{{ .AvailableReplicas }} / {{ .DesiredReplicas }}</td><td>{{ rate .RequestRate }}</td><td>{{ percent .ErrorRatio }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+
+<h2>Validation findings</h2>
+{{- if not .Report.Validations }}
+<p>No findings.</p>
+{{- else }}
+<table>
+<tr><th>Severity</th><th>Kind</th><th>Name</th><th>Code</th><th>Message</th></tr>
+{{- range .Report.Validations }}
+<tr><td class="{{ .Severity }}">{{ .Severity }}</td><td>{{ .ObjectGVK.Kind }}</td><td>{{ .Name }}</td><td>{{ .Code }}</td><td>{{ .Message }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+
+<h2>Istio config inventory</h2>
+{{- if not .Report.Inventory }}
+<p>No Istio config.</p>
+{{- else }}
+<table>
+<tr><th>Kind</th><th>Count</th><th>Names</th></tr>
+{{- range .Report.Inventory }}
+<tr><td>{{ .ObjectGVK.Kind }}</td><td>{{ len .Names }}</td><td>{{ join .Names ", " }}</td></tr>
+{{- end }}
+</table>
+{{- end }}
+</body>
+</html>
+`))
+
+func renderHTML(report *models.NamespaceReport) ([]byte, error) {
+	var buf bytes.Buffer
+	data := struct {
+		Report *models.NamespaceReport
+		Graph  *svgGraph
+	}{Report: report, Graph: newSVGGraph(report.Graph)}
+	if err := htmlTemplate.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// newSVGGraph translates the graph so that its nodes start at the margin. The edges end at the border of the nodes so
+// that their arrows are visible.
+func newSVGGraph(g *models.ReportGraph) *svgGraph {
+	if g == nil {
+		return nil
+	}
+	minX, minY, maxX, maxY := graphBounds(g)
+	result := &svgGraph{
+		Width:      maxX - minX + 2*graphMargin,
+		Height:     maxY - minY + 2*graphMargin,
+		NodeRadius: graphNodeRadius,
+		Nodes:      []svgNode{},
+		Edges:      []svgEdge{},
+	}
+	positions := map[string]models.ReportGraphNode{}
+	for _, n := range g.Nodes {
+		n.X = n.X - minX + graphMargin
+		n.Y = n.Y - minY + graphMargin
+		positions[n.ID] = n
+		result.Nodes = append(result.Nodes, svgNode{ReportGraphNode: n, Color: nodeColor(n.NodeType)})
+	}
+	for _, e := range g.Edges {
+		source, sourceFound := positions[e.Source]
+		target, targetFound := positions[e.Target]
+		if !sourceFound || !targetFound || e.Source == e.Target {
+			continue
+		}
+		x1, y1, x2, y2 := clipEdge(source.X, source.Y, target.X, target.Y)
+		result.Edges = append(result.Edges, svgEdge{X1: x1, Y1: y1, X2: x2, Y2: y2})
+	}
+	return result
+}
+
+// graphBounds returns the bounding box of the nodes, empty for a graph without nodes.
+func graphBounds(g *models.ReportGraph) (minX, minY, maxX, maxY float64) {
+	for i, n := range g.Nodes {
+		if i == 0 {
+			minX, minY, maxX, maxY = n.X, n.Y, n.X, n.Y
+			continue
+		}
+		minX, minY = min(minX, n.X), min(minY, n.Y)
+		maxX, maxY = max(maxX, n.X), max(maxY, n.Y)
+	}
+	return minX, minY, maxX, maxY
+}
+
+// clipEdge shortens an edge by the node radius at both ends.
+func clipEdge(x1, y1, x2, y2 float64) (float64, float64, float64, float64) {
+	dx, dy := x2-x1, y2-y1
+	length := math.Hypot(dx, dy)
+	if length <= 2*graphNodeRadius {
+		return x1, y1, x2, y2
+	}
+	ux, uy := dx/length*graphNodeRadius, dy/length*graphNodeRadius
+	return x1 + ux, y1 + uy, x2 - ux, y2 - uy
+}
+
+func nodeColor(nodeType string) string {
+	switch nodeType {
+	case graph.NodeTypeService:
+		return "#73bcf7"
+	case graph.NodeTypeApp, graph.NodeTypeWorkload:
+		return "#0066cc"
+	default:
+		return "#b8bbbe"
+	}
+}
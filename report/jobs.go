@@ -0,0 +1,268 @@
+package report
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util"
+)
+
+// ErrTooManyJobs is returned when a job is started while the registry is full of running jobs.
+var ErrTooManyJobs = errors.New("too many reports are being generated, retry later")
+
+// GenerateFunc generates the content of a report. The context is canceled when the generation times out.
+type GenerateFunc func(ctx context.Context) ([]byte, error)
+
+// ProgressFunc reports the status of an item of a job, and the error of the items that failed.
+type ProgressFunc func(item, status string, err error)
+
+// ProgressGenerateFunc generates the content of a job processing several items, reporting their progress.
+type ProgressGenerateFunc func(ctx context.Context, progress ProgressFunc) ([]byte, error)
+
+type job struct {
+	models.ReportJob
+	content   []byte
+	expiresAt time.Time
+	user      string
+}
+
+// JobRegistry keeps the report jobs of this Kiali instance, and the generated reports until their retention expires.
+// A job is only visible to the user who started it.
+type JobRegistry struct {
+	lock sync.RWMutex
+	jobs map[string]*job
+}
+
+// Jobs is the registry of the report jobs of the Kiali instance.
+var Jobs = NewJobRegistry()
+
+// NewJobRegistry creates an empty JobRegistry.
+func NewJobRegistry() *JobRegistry {
+	return &JobRegistry{jobs: map[string]*job{}}
+}
+
+// Start registers a new job of the user and runs the generation in the background. The oldest finished jobs are
+// forgotten to make room for it, and ErrTooManyJobs is returned when every job is still running.
+func (r *JobRegistry) Start(conf config.Reports, user, cluster, namespace, format string, generate GenerateFunc) (models.ReportJob, error) {
+	return r.StartWithProgress(conf, user, cluster, namespace, format, nil, func(ctx context.Context, _ ProgressFunc) ([]byte, error) {
+		return generate(ctx)
+	})
+}
+
+// StartWithProgress is Start for a job processing the items, which are pending until the generation reports their
+// progress. The job has no progress when there are no items.
+func (r *JobRegistry) StartWithProgress(conf config.Reports, user, cluster, namespace, format string, items []string, generate ProgressGenerateFunc) (models.ReportJob, error) {
+	retention, err := model.ParseDuration(conf.Retention)
+	if err != nil {
+		return models.ReportJob{}, err
+	}
+	id, err := newJobID()
+	if err != nil {
+		return models.ReportJob{}, err
+	}
+
+	r.lock.Lock()
+	r.prune()
+	if len(r.jobs) >= conf.MaxJobs && !r.forgetOldestFinished() {
+		r.lock.Unlock()
+		return models.ReportJob{}, ErrTooManyJobs
+	}
+	j := &job{
+		ReportJob: models.ReportJob{
+			ID:        id,
+			Cluster:   cluster,
+			Namespace: namespace,
+			Format:    format,
+			Status:    models.ReportJobRunning,
+			CreatedAt: util.Clock.Now(),
+		},
+		user: user,
+	}
+	if len(items) > 0 {
+		j.Progress = &models.ReportJobProgress{Total: len(items)}
+		for _, item := range items {
+			j.Progress.Items = append(j.Progress.Items, models.ReportJobItemProgress{Name: item, Status: models.ReportJobPending})
+		}
+	}
+	r.jobs[id] = j
+	started := j.snapshot()
+	r.lock.Unlock()
+
+	go r.run(j, time.Duration(conf.TimeoutSeconds)*time.Second, time.Duration(retention), generate)
+	return started, nil
+}
+
+func (r *JobRegistry) run(j *job, timeout, retention time.Duration, generate ProgressGenerateFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	content, err := func() (content []byte, err error) {
+		// the graph generation reports its errors with panics
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = fmt.Errorf("%v", recovered)
+			}
+		}()
+		return generate(ctx, func(item, status string, err error) { r.progress(j, item, status, err) })
+	}()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := util.Clock.Now()
+	j.FinishedAt = &now
+	j.expiresAt = now.Add(retention)
+	if err != nil {
+		log.Errorf("Error generating the report of namespace [%s] of cluster [%s]: %s", j.Namespace, j.Cluster, err)
+		j.Status = models.ReportJobFailed
+		j.Error = err.Error()
+		return
+	}
+	j.Status = models.ReportJobSucceeded
+	j.content = content
+}
+
+// progress updates the status of an item of the job.
+func (r *JobRegistry) progress(j *job, item, status string, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if j.Progress == nil {
+		return
+	}
+	for i := range j.Progress.Items {
+		itemProgress := &j.Progress.Items[i]
+		if itemProgress.Name != item {
+			continue
+		}
+		wasDone := isItemDone(itemProgress.Status)
+		itemProgress.Status = status
+		itemProgress.Error = ""
+		if err != nil {
+			itemProgress.Error = err.Error()
+		}
+		if isDone := isItemDone(status); isDone && !wasDone {
+			j.Progress.Done++
+		} else if !isDone && wasDone {
+			j.Progress.Done--
+		}
+		return
+	}
+}
+
+// Get returns a job of the user.
+func (r *JobRegistry) Get(user, id string) (models.ReportJob, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	j, ok := r.get(user, id)
+	if !ok {
+		return models.ReportJob{}, false
+	}
+	return j.snapshot(), true
+}
+
+// Content returns a job of the user and the report it generated, nil until the job succeeded.
+func (r *JobRegistry) Content(user, id string) (models.ReportJob, []byte, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	j, ok := r.get(user, id)
+	if !ok {
+		return models.ReportJob{}, nil, false
+	}
+	return j.snapshot(), j.content, true
+}
+
+// List returns the jobs of the user, the newest first.
+func (r *JobRegistry) List(user string) []models.ReportJob {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	now := util.Clock.Now()
+	jobs := []models.ReportJob{}
+	for _, j := range r.jobs {
+		if j.user == user && !j.isExpired(now) {
+			jobs = append(jobs, j.snapshot())
+		}
+	}
+	sort.Slice(jobs, func(i, k int) bool {
+		if jobs[i].CreatedAt.Equal(jobs[k].CreatedAt) {
+			return jobs[i].ID < jobs[k].ID
+		}
+		return jobs[i].CreatedAt.After(jobs[k].CreatedAt)
+	})
+	return jobs
+}
+
+func (r *JobRegistry) get(user, id string) (*job, bool) {
+	j, ok := r.jobs[id]
+	if !ok || j.user != user || j.isExpired(util.Clock.Now()) {
+		return nil, false
+	}
+	return j, true
+}
+
+// prune forgets the jobs whose retention expired.
+func (r *JobRegistry) prune() {
+	now := util.Clock.Now()
+	for id, j := range r.jobs {
+		if j.isExpired(now) {
+			delete(r.jobs, id)
+		}
+	}
+}
+
+// forgetOldestFinished forgets the job finished first. It returns false when every job is still running.
+func (r *JobRegistry) forgetOldestFinished() bool {
+	var oldest *job
+	for _, j := range r.jobs {
+		if j.FinishedAt != nil && (oldest == nil || j.FinishedAt.Before(*oldest.FinishedAt)) {
+			oldest = j
+		}
+	}
+	if oldest == nil {
+		return false
+	}
+	delete(r.jobs, oldest.ID)
+	return true
+}
+
+// snapshot returns the job with a copy of its progress, which keeps changing while the job runs.
+func (j *job) snapshot() models.ReportJob {
+	reportJob := j.ReportJob
+	if j.Progress != nil {
+		progress := *j.Progress
+		progress.Items = append([]models.ReportJobItemProgress(nil), j.Progress.Items...)
+		reportJob.Progress = &progress
+	}
+	return reportJob
+}
+
+func (j *job) isExpired(now time.Time) bool {
+	// the running jobs have no expiration yet
+	return j.FinishedAt != nil && !now.Before(j.expiresAt)
+}
+
+func isItemDone(status string) bool {
+	return status == models.ReportJobFailed || status == models.ReportJobSucceeded
+}
+
+func newJobID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,138 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util"
+)
+
+func TestJobRegistry(t *testing.T) {
+	require := require.New(t)
+	util.Clock = util.RealClock{}
+
+	conf := config.NewConfig().Reports
+	conf.MaxJobs = 2
+	registry := NewJobRegistry()
+
+	release := make(chan struct{})
+	job, err := registry.Start(conf, "alice", "east", "bookinfo", models.ReportFormatHTML, func(ctx context.Context) ([]byte, error) {
+		<-release
+		return []byte("<html></html>"), nil
+	})
+	require.NoError(err)
+	require.Equal(models.ReportJobRunning, job.Status)
+
+	// only visible to its user
+	_, found := registry.Get("bob", job.ID)
+	require.False(found)
+	require.Empty(registry.List("bob"))
+
+	_, content, found := registry.Content("alice", job.ID)
+	require.True(found)
+	require.Nil(content)
+
+	// the panics of the graph generation fail the job
+	failed, err := registry.Start(conf, "alice", "east", "bookinfo", models.ReportFormatPDF, func(ctx context.Context) ([]byte, error) {
+		panic(errors.New("prometheus is not reachable"))
+	})
+	require.NoError(err)
+	require.Eventually(func() bool {
+		j, _ := registry.Get("alice", failed.ID)
+		return j.Status == models.ReportJobFailed
+	}, time.Second, 10*time.Millisecond)
+	failed, _ = registry.Get("alice", failed.ID)
+	require.Equal("prometheus is not reachable", failed.Error)
+	require.NotNil(failed.FinishedAt)
+
+	// the failed job is forgotten to make room for a new one
+	_, err = registry.Start(conf, "bob", "east", "bookinfo", models.ReportFormatHTML, func(ctx context.Context) ([]byte, error) {
+		<-release
+		return []byte("<html></html>"), nil
+	})
+	require.NoError(err)
+	_, found = registry.Get("alice", failed.ID)
+	require.False(found)
+
+	// every job is running
+	_, err = registry.Start(conf, "alice", "east", "bookinfo", models.ReportFormatHTML, nil)
+	require.ErrorIs(err, ErrTooManyJobs)
+
+	close(release)
+	require.Eventually(func() bool {
+		j, _ := registry.Get("alice", job.ID)
+		return j.Status == models.ReportJobSucceeded
+	}, time.Second, 10*time.Millisecond)
+	_, content, _ = registry.Content("alice", job.ID)
+	require.Equal("<html></html>", string(content))
+}
+
+func TestJobRegistryRetention(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	util.Clock = util.ClockMock{Time: now}
+	defer func() { util.Clock = util.RealClock{} }()
+
+	conf := config.NewConfig().Reports
+	registry := NewJobRegistry()
+	job, err := registry.Start(conf, "alice", "east", "bookinfo", models.ReportFormatHTML, func(ctx context.Context) ([]byte, error) {
+		return []byte("report"), nil
+	})
+	require.NoError(err)
+	require.Eventually(func() bool {
+		j, _ := registry.Get("alice", job.ID)
+		return j.Status == models.ReportJobSucceeded
+	}, time.Second, 10*time.Millisecond)
+
+	util.Clock = util.ClockMock{Time: now.Add(59 * time.Minute)}
+	_, found := registry.Get("alice", job.ID)
+	require.True(found)
+
+	util.Clock = util.ClockMock{Time: now.Add(time.Hour)}
+	_, found = registry.Get("alice", job.ID)
+	require.False(found)
+	require.Empty(registry.List("alice"))
+}
+
+func TestJobRegistryProgress(t *testing.T) {
+	require := require.New(t)
+	util.Clock = util.RealClock{}
+
+	registry := NewJobRegistry()
+	release := make(chan struct{})
+	job, err := registry.StartWithProgress(config.NewConfig().Reports, "alice", "east", "", models.ReportFormatJSON, []string{"bookinfo", "travel-agency"}, func(ctx context.Context, progress ProgressFunc) ([]byte, error) {
+		progress("bookinfo", models.ReportJobRunning, nil)
+		progress("bookinfo", models.ReportJobSucceeded, nil)
+		progress("travel-agency", models.ReportJobFailed, errors.New("forbidden"))
+		<-release
+		return []byte("{}"), nil
+	})
+	require.NoError(err)
+	require.Equal(2, job.Progress.Total)
+	require.Equal(models.ReportJobPending, job.Progress.Items[0].Status)
+
+	require.Eventually(func() bool {
+		j, _ := registry.Get("alice", job.ID)
+		return j.Progress.Done == 2
+	}, time.Second, 10*time.Millisecond)
+	running, _ := registry.Get("alice", job.ID)
+	require.Equal(models.ReportJobRunning, running.Status)
+	require.Equal(models.ReportJobSucceeded, running.Progress.Items[0].Status)
+	require.Equal(models.ReportJobFailed, running.Progress.Items[1].Status)
+	require.Equal("forbidden", running.Progress.Items[1].Error)
+	// the returned progress is a copy
+	require.Equal(models.ReportJobPending, job.Progress.Items[0].Status)
+
+	close(release)
+	require.Eventually(func() bool {
+		j, _ := registry.Get("alice", job.ID)
+		return j.Status == models.ReportJobSucceeded
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,298 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kiali/kiali/models"
+)
+
+// A4 pages, in points
+const (
+	pdfPageHeight float64 = 842
+	pdfPageMargin float64 = 50
+	pdfPageWidth  float64 = 595
+)
+
+const (
+	pdfFontRegular = "F1"
+	pdfFontBold    = "F2"
+	// pdfCharWidth is the average width of the Helvetica characters, relative to the font size. It is used to wrap and
+	// truncate the text without the font metrics.
+	pdfCharWidth float64 = 0.5
+)
+
+// pdfWriter lays out a document top-down on A4 pages. It only uses the standard Helvetica fonts, so that no font
+// needs to be embedded, and the text is restricted to ASCII.
+type pdfWriter struct {
+	pages []*bytes.Buffer
+	// y is the distance of the cursor from the top of the current page
+	y float64
+}
+
+func renderPDF(report *models.NamespaceReport) []byte {
+	w := &pdfWriter{}
+	w.newPage()
+
+	w.paragraph("Mesh status of namespace "+report.Namespace, 18, pdfFontBold)
+	w.paragraph(fmt.Sprintf("Cluster %s, generated at %s, telemetry of the last %s.", report.Cluster, report.GeneratedAt.UTC().Format("2006-01-02 15:04:05 MST"), report.Duration), 10, pdfFontRegular)
+	if report.URL != "" {
+		w.paragraph("Kiali: "+report.URL, 10, pdfFontRegular)
+	}
+
+	w.heading("Traffic graph")
+	switch g := newSVGGraph(report.Graph); {
+	case g == nil:
+		w.paragraph("The traffic graph is not available.", 10, pdfFontRegular)
+	case len(g.Nodes) == 0:
+		w.paragraph("No traffic.", 10, pdfFontRegular)
+	default:
+		w.graph(g)
+	}
+
+	w.heading("Health")
+	if len(report.Health.Apps) == 0 {
+		w.paragraph("No apps.", 10, pdfFontRegular)
+	} else {
+		statuses := []string{}
+		for status, count := range report.Health.Statuses {
+			statuses = append(statuses, fmt.Sprintf("%s: %d", status, count))
+		}
+		sort.Strings(statuses)
+		w.paragraph(strings.Join(statuses, "  "), 10, pdfFontRegular)
+		columns := []float64{0, 170, 250, 330, 430}
+		w.row([]string{"App", "Status", "Replicas", "Request rate (rps)", "Error ratio"}, columns, pdfFontBold)
+		for _, app := range report.Health.Apps {
+			w.row([]string{
+				app.Name,
+				app.Status,
+				fmt.Sprintf("%d / %d", app.AvailableReplicas, app.DesiredReplicas),
+				fmt.Sprintf("%.2f", app.RequestRate),
+				fmt.Sprintf("%.2f%%", app.ErrorRatio*100),
+			}, columns, pdfFontRegular)
+		}
+	}
+
+	w.heading("Validation findings")
+	if len(report.Validations) == 0 {
+		w.paragraph("No findings.", 10, pdfFontRegular)
+	} else {
+		columns := []float64{0, 50, 160, 280, 330}
+		w.row([]string{"Severity", "Kind", "Name", "Code", "Message"}, columns, pdfFontBold)
+		for _, v := range report.Validations {
+			w.row([]string{string(v.Severity), v.ObjectGVK.Kind, v.Name, v.Code, v.Message}, columns, pdfFontRegular)
+		}
+	}
+
+	w.heading("Istio config inventory")
+	if len(report.Inventory) == 0 {
+		w.paragraph("No Istio config.", 10, pdfFontRegular)
+	} else {
+		for _, item := range report.Inventory {
+			w.paragraph(fmt.Sprintf("%s (%d): %s", item.ObjectGVK.Kind, len(item.Names), strings.Join(item.Names, ", ")), 10, pdfFontRegular)
+		}
+	}
+
+	return w.bytes()
+}
+
+func (w *pdfWriter) newPage() {
+	w.pages = append(w.pages, &bytes.Buffer{})
+	w.y = pdfPageMargin
+}
+
+// reserve starts a new page when the height doesn't fit in the current one.
+func (w *pdfWriter) reserve(height float64) {
+	if w.y+height > pdfPageHeight-pdfPageMargin && w.y > pdfPageMargin {
+		w.newPage()
+	}
+}
+
+func (w *pdfWriter) content() *bytes.Buffer {
+	return w.pages[len(w.pages)-1]
+}
+
+func (w *pdfWriter) heading(text string) {
+	w.y += 10
+	w.paragraph(text, 14, pdfFontBold)
+}
+
+// paragraph writes the text wrapped at the page width.
+func (w *pdfWriter) paragraph(text string, size float64, font string) {
+	maxChars := int((pdfPageWidth - 2*pdfPageMargin) / (size * pdfCharWidth))
+	for _, line := range wrapText(text, maxChars) {
+		w.reserve(size * 1.4)
+		w.y += size * 1.4
+		w.text(pdfPageMargin, w.y, size, font, line)
+	}
+	w.y += size * 0.4
+}
+
+// row writes the cells at the column offsets, each truncated to the width of its column.
+func (w *pdfWriter) row(cells []string, columns []float64, font string) {
+	const size float64 = 9
+	w.reserve(size * 1.4)
+	w.y += size * 1.4
+	for i, cell := range cells {
+		width := pdfPageWidth - 2*pdfPageMargin - columns[i]
+		if i+1 < len(columns) {
+			width = columns[i+1] - columns[i] - 5
+		}
+		w.text(pdfPageMargin+columns[i], w.y, size, font, truncateText(cell, int(width/(size*pdfCharWidth))))
+	}
+}
+
+func (w *pdfWriter) text(x, y, size float64, font, text string) {
+	fmt.Fprintf(w.content(), "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, pdfNumber(size), pdfNumber(x), pdfNumber(pdfPageHeight-y), escapePDFText(text))
+}
+
+// graph draws the graph scaled down to fit in a page.
+func (w *pdfWriter) graph(g *svgGraph) {
+	scale := math.Min(1, math.Min((pdfPageWidth-2*pdfPageMargin)/g.Width, (pdfPageHeight-2*pdfPageMargin)/g.Height))
+	w.reserve(g.Height * scale)
+	left, top := pdfPageMargin, w.y
+	point := func(x, y float64) string {
+		return pdfNumber(left+x*scale) + " " + pdfNumber(pdfPageHeight-top-y*scale)
+	}
+
+	c := w.content()
+	c.WriteString("0.42 0.43 0.45 RG 0.42 0.43 0.45 rg 0.75 w\n")
+	for _, e := range g.Edges {
+		fmt.Fprintf(c, "%s m %s l S\n", point(e.X1, e.Y1), point(e.X2, e.Y2))
+		// the arrow head
+		angle := math.Atan2(e.Y2-e.Y1, e.X2-e.X1)
+		const arrowLength, arrowAngle = 8.0, 0.4
+		fmt.Fprintf(c, "%s m %s l %s l f\n",
+			point(e.X2, e.Y2),
+			point(e.X2-arrowLength*math.Cos(angle-arrowAngle), e.Y2-arrowLength*math.Sin(angle-arrowAngle)),
+			point(e.X2-arrowLength*math.Cos(angle+arrowAngle), e.Y2-arrowLength*math.Sin(angle+arrowAngle)))
+	}
+	for _, n := range g.Nodes {
+		r, gr, b := hexColor(n.Color)
+		fmt.Fprintf(c, "%s %s %s rg\n", pdfNumber(r), pdfNumber(gr), pdfNumber(b))
+		// a circle approximated by 4 Bezier curves
+		k := 0.5523 * g.NodeRadius
+		radius := g.NodeRadius
+		fmt.Fprintf(c, "%s m %s %s %s c %s %s %s c %s %s %s c %s %s %s c f\n",
+			point(n.X+radius, n.Y),
+			point(n.X+radius, n.Y+k), point(n.X+k, n.Y+radius), point(n.X, n.Y+radius),
+			point(n.X-k, n.Y+radius), point(n.X-radius, n.Y+k), point(n.X-radius, n.Y),
+			point(n.X-radius, n.Y-k), point(n.X-k, n.Y-radius), point(n.X, n.Y-radius),
+			point(n.X+k, n.Y-radius), point(n.X+radius, n.Y-k), point(n.X+radius, n.Y))
+	}
+	c.WriteString("0 0 0 rg\n")
+	labelSize := math.Max(5, 9*scale)
+	for _, n := range g.Nodes {
+		label := escapePDFText(n.Label)
+		x := left + n.X*scale - float64(len(label))*labelSize*pdfCharWidth/2
+		fmt.Fprintf(c, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", pdfFontRegular, pdfNumber(labelSize), pdfNumber(x), pdfNumber(pdfPageHeight-top-(n.Y+2*g.NodeRadius)*scale), label)
+	}
+
+	w.y += g.Height*scale + 10
+}
+
+// bytes assembles the PDF document: the catalog, the page tree, the fonts, then every page with its content stream.
+func (w *pdfWriter) bytes() []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // the page tree, once the page objects are numbered
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+	kids := []string{}
+	for _, page := range w.pages {
+		pageObject := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageObject))
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>",
+				pdfNumber(pdfPageWidth), pdfNumber(pdfPageHeight), pdfFontRegular, pdfFontBold, pageObject+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(w.pages))
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
+
+// wrapText splits the text in lines of at most maxChars characters, at the spaces when possible.
+func wrapText(text string, maxChars int) []string {
+	lines := []string{}
+	line := ""
+	for _, word := range strings.Fields(text) {
+		for len(word) > maxChars {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			lines = append(lines, word[:maxChars])
+			word = word[maxChars:]
+		}
+		switch {
+		case line == "":
+			line = word
+		case len(line)+1+len(word) <= maxChars:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func truncateText(text string, maxChars int) string {
+	if len(text) <= maxChars || maxChars < 3 {
+		return text
+	}
+	return text[:maxChars-3] + "..."
+}
+
+// escapePDFText escapes the delimiters of the PDF strings and replaces the characters not in ASCII.
+func escapePDFText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < ' ' || r > '~':
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// pdfNumber formats a number with at most 2 decimals.
+func pdfNumber(n float64) string {
+	return strconv.FormatFloat(math.Round(n*100)/100, 'f', -1, 64)
+}
+
+// hexColor returns the RGB components of a #rrggbb color, between 0 and 1.
+func hexColor(color string) (float64, float64, float64) {
+	value, err := strconv.ParseUint(strings.TrimPrefix(color, "#"), 16, 32)
+	if err != nil {
+		return 0, 0, 0
+	}
+	return float64(value>>16&0xff) / 255, float64(value>>8&0xff) / 255, float64(value&0xff) / 255
+}
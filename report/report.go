@@ -0,0 +1,140 @@
+// Package report renders the mesh status of a namespace into standalone documents for audits.
+//
+// A report holds the traffic graph, laid out server-side, the health of the apps, the validation findings and the
+// inventory of the Istio config of a namespace. It is rendered as HTML, with the graph as an inline SVG image, as
+// PDF or as JSON. The reports are generated by asynchronous jobs, see JobRegistry.
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kiali/kiali/business"
+	"github.com/kiali/kiali/graph"
+	"github.com/kiali/kiali/graph/api"
+	"github.com/kiali/kiali/graph/config/cytoscape"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+)
+
+// ContentType returns the media type of a report format, empty for an unknown format.
+func ContentType(format string) string {
+	switch format {
+	case models.ReportFormatHTML:
+		return "text/html; charset=utf-8"
+	case models.ReportFormatJSON:
+		return "application/json"
+	case models.ReportFormatPDF:
+		return "application/pdf"
+	default:
+		return ""
+	}
+}
+
+// Generate gathers the mesh status of the namespace and renders it in the format. A report without graph is rendered
+// when the graph cannot be generated, i.e. when Prometheus is not reachable.
+func Generate(ctx context.Context, layer *business.Layer, cluster, namespace, duration, format string) ([]byte, error) {
+	queryTime := time.Now()
+	report, err := layer.Report.GetNamespaceReport(ctx, cluster, namespace, duration, queryTime)
+	if err != nil {
+		return nil, err
+	}
+	if report.Graph, err = generateGraph(ctx, layer, namespace, duration, queryTime); err != nil {
+		log.Errorf("Error generating the graph of the report of namespace [%s] of cluster [%s]: %s", namespace, cluster, err)
+	}
+	return Render(report, format)
+}
+
+// Render renders a report in the format.
+func Render(report *models.NamespaceReport, format string) ([]byte, error) {
+	switch format {
+	case models.ReportFormatHTML:
+		return renderHTML(report)
+	case models.ReportFormatJSON:
+		return json.Marshal(report)
+	case models.ReportFormatPDF:
+		return renderPDF(report), nil
+	default:
+		return nil, fmt.Errorf("report format not supported: %s", format)
+	}
+}
+
+// generateGraph generates the workload graph of the namespace with the hierarchical layout.
+func generateGraph(ctx context.Context, layer *business.Layer, namespace, duration string, queryTime time.Time) (reportGraph *models.ReportGraph, err error) {
+	// the graph generation reports its errors with panics
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if response, ok := recovered.(graph.Response); ok {
+				err = fmt.Errorf("%s", response.Message)
+				return
+			}
+			err = fmt.Errorf("%v", recovered)
+		}
+	}()
+
+	query := url.Values{}
+	query.Set("duration", duration)
+	query.Set("graphType", graph.GraphTypeWorkload)
+	query.Set("injectServiceNodes", "true")
+	query.Set("layout", graph.LayoutHierarchical)
+	query.Set("namespaces", namespace)
+	query.Set("queryTime", fmt.Sprintf("%d", queryTime.Unix()))
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, "/?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	o := graph.NewOptions(r, &layer.Namespace)
+	code, payload := api.GraphNamespaces(ctx, layer, o)
+	config, ok := payload.(cytoscape.Config)
+	if code != http.StatusOK || !ok {
+		return nil, fmt.Errorf("graph generation failed with code %d", code)
+	}
+
+	reportGraph = &models.ReportGraph{Nodes: []models.ReportGraphNode{}, Edges: []models.ReportGraphEdge{}}
+	for _, n := range config.Elements.Nodes {
+		if n.Data.IsBox != "" || n.Position == nil {
+			continue
+		}
+		reportGraph.Nodes = append(reportGraph.Nodes, models.ReportGraphNode{
+			ID:       n.Data.ID,
+			Label:    nodeLabel(n.Data),
+			NodeType: n.Data.NodeType,
+			X:        n.Position.X,
+			Y:        n.Position.Y,
+		})
+	}
+	for _, e := range config.Elements.Edges {
+		reportGraph.Edges = append(reportGraph.Edges, models.ReportGraphEdge{
+			Source:   e.Data.Source,
+			Target:   e.Data.Target,
+			Protocol: e.Data.Traffic.Protocol,
+		})
+	}
+	return reportGraph, nil
+}
+
+func nodeLabel(n *cytoscape.NodeData) string {
+	label := ""
+	switch n.NodeType {
+	case graph.NodeTypeService:
+		label = n.Service
+	case graph.NodeTypeWorkload:
+		label = n.Workload
+	case graph.NodeTypeApp:
+		label = n.App
+		if n.Version != "" {
+			label += " " + n.Version
+		}
+	default:
+		label = n.NodeType
+	}
+	if n.Namespace != "" && n.Namespace != graph.Unknown && n.IsOutside {
+		label += " (" + n.Namespace + ")"
+	}
+	return label
+}
@@ -0,0 +1,106 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+)
+
+func fakeReport() *models.NamespaceReport {
+	return &models.NamespaceReport{
+		Cluster:     "east",
+		Namespace:   "bookinfo",
+		GeneratedAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		Duration:    "10m",
+		Graph: &models.ReportGraph{
+			Nodes: []models.ReportGraphNode{
+				{ID: "n0", Label: "productpage-v1", NodeType: "workload", X: 0, Y: 0},
+				{ID: "n1", Label: "reviews", NodeType: "service", X: 200, Y: 0},
+			},
+			Edges: []models.ReportGraphEdge{{Source: "n0", Target: "n1", Protocol: "http"}},
+		},
+		Health: models.ReportHealth{
+			Apps:     []models.ReportAppHealth{{Name: "reviews", Status: models.ReportHealthDegraded, AvailableReplicas: 1, DesiredReplicas: 2, ErrorRatio: 0.05, RequestRate: 10}},
+			Statuses: map[string]int{models.ReportHealthDegraded: 1},
+		},
+		Inventory: []models.ReportInventoryItem{{ObjectGVK: kubernetes.VirtualServices, Names: []string{"reviews"}}},
+		Validations: []models.ReportValidation{
+			{ObjectGVK: kubernetes.VirtualServices, Name: "reviews", Code: "KIA1101", Message: "DestinationWeight on route doesn't have a valid service (host not found)", Severity: models.ErrorSeverity},
+		},
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	require := require.New(t)
+
+	content, err := Render(fakeReport(), models.ReportFormatHTML)
+	require.NoError(err)
+
+	html := string(content)
+	require.Contains(html, "<title>Mesh status of namespace bookinfo</title>")
+	require.Contains(html, "<svg")
+	require.Equal(2, strings.Count(html, "<circle"))
+	require.Equal(1, strings.Count(html, "<line"))
+	require.Contains(html, "5.00%")
+	require.Contains(html, "KIA1101")
+	// escaped
+	require.Contains(html, "doesn&#39;t have a valid service")
+	require.Contains(html, "<td>VirtualService</td><td>1</td><td>reviews</td>")
+}
+
+func TestRenderHTMLWithoutGraph(t *testing.T) {
+	report := fakeReport()
+	report.Graph = nil
+
+	content, err := Render(report, models.ReportFormatHTML)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "The traffic graph is not available.")
+	assert.NotContains(t, string(content), "<svg")
+}
+
+func TestRenderPDF(t *testing.T) {
+	require := require.New(t)
+
+	content, err := Render(fakeReport(), models.ReportFormatPDF)
+	require.NoError(err)
+
+	pdf := string(content)
+	require.True(strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	require.True(strings.HasSuffix(pdf, "%%EOF\n"))
+	require.Contains(pdf, "(Mesh status of namespace bookinfo) Tj")
+	require.Contains(pdf, "(productpage-v1) Tj")
+	require.Contains(pdf, "/Count 1")
+
+	// the xref offsets point to the objects
+	xref := strings.Index(pdf, "xref\n")
+	require.Positive(xref)
+	entries := strings.Split(pdf[xref:], "\n")[3:]
+	for i := 0; i < 6; i++ {
+		offset := 0
+		_, err := fmt.Sscanf(entries[i], "%010d", &offset)
+		require.NoError(err)
+		require.True(strings.HasPrefix(pdf[offset:], fmt.Sprintf("%d 0 obj", i+1)))
+	}
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	_, err := Render(fakeReport(), "docx")
+	assert.Error(t, err)
+	assert.Empty(t, ContentType("docx"))
+}
+
+func TestWrapText(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal([]string{"one two", "three"}, wrapText("one two three", 8))
+	assert.Equal([]string{"abcd", "efgh", "ij"}, wrapText("abcdefghij", 4))
+	assert.Equal([]string{""}, wrapText("", 4))
+	assert.Equal(`a \(b\) \\ ?`, escapePDFText(`a (b) \ é`))
+}
@@ -0,0 +1,106 @@
+package routing
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/kiali/kiali/handlers"
+	"github.com/kiali/kiali/handlers/authentication"
+)
+
+// mutationLimiter limits the mutating requests of each user, with a token bucket refilled at the rate of the quota.
+type mutationLimiter struct {
+	perMinute int
+	now       func() time.Time
+
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// newMutationLimiter returns the limiter of the given number of mutations per minute, or nil when it is not positive.
+func newMutationLimiter(perMinute int) *mutationLimiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	return &mutationLimiter{perMinute: perMinute, now: time.Now, limiters: map[string]*rate.Limiter{}}
+}
+
+// reserve takes a token of the user, returning how long to wait when there is none left.
+func (l *mutationLimiter) reserve(user string) time.Duration {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	limiter, ok := l.limiters[user]
+	if !ok {
+		l.evictIdle(now)
+		limiter = rate.NewLimiter(rate.Limit(float64(l.perMinute)/60), l.perMinute)
+		l.limiters[user] = limiter
+	}
+
+	reservation := limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		// The request is rejected, the token stays available to the next one
+		reservation.CancelAt(now)
+	}
+	return delay
+}
+
+// evictIdle drops the limiters of the users that have a full quota, they are the same as new ones.
+func (l *mutationLimiter) evictIdle(now time.Time) {
+	for user, limiter := range l.limiters {
+		if limiter.TokensAt(now) >= float64(l.perMinute) {
+			delete(l.limiters, user)
+		}
+	}
+}
+
+// requestUser returns the user of the request, or the address of the client for the anonymous requests.
+func requestUser(r *http.Request) string {
+	for _, session := range authentication.GetUserSessionsContext(r.Context()) {
+		if session.Username != "" {
+			return "user:" + session.Username
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "addr:" + host
+}
+
+// isMutation returns true for the methods of the requests changing the state of the cluster or of Kiali.
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// rateLimitHandler rejects the mutating requests of the users over their quota with a 429 status. The Retry-After
+// header tells the client when the next request will be accepted. The unauthenticated routes, i.e. the login, are not
+// limited.
+func rateLimitHandler(next http.Handler, route Route, limiter *mutationLimiter) http.Handler {
+	if limiter == nil || !route.Authenticated || !isMutation(route.Method) {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if delay := limiter.reserve(requestUser(r)); delay > 0 {
+			seconds := int(math.Ceil(delay.Seconds()))
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			handlers.RespondWithError(w, http.StatusTooManyRequests, fmt.Sprintf("Too many changes, the limit is %d per minute. Retry in %d seconds", limiter.perMinute, seconds))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
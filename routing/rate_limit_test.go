@@ -0,0 +1,60 @@
+package routing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/handlers/authentication"
+)
+
+func TestRateLimitHandler(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newMutationLimiter(2)
+	limiter.now = func() time.Time { return now }
+
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	update := rateLimitHandler(ok, Route{Method: http.MethodPatch, Authenticated: true}, limiter)
+	get := rateLimitHandler(ok, Route{Method: http.MethodGet, Authenticated: true}, limiter)
+	login := rateLimitHandler(ok, Route{Method: http.MethodPost}, limiter)
+
+	serve := func(handler http.Handler, user string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPatch, "/api/namespaces/bookinfo/istio/reviews", nil)
+		ctx := authentication.SetUserSessionsContext(r.Context(), authentication.UserSessions{
+			"east": &authentication.UserSessionData{Username: user},
+		})
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r.WithContext(ctx))
+		return w
+	}
+
+	require.Equal(http.StatusOK, serve(update, "jdoe").Code)
+	require.Equal(http.StatusOK, serve(update, "jdoe").Code)
+	limited := serve(update, "jdoe")
+	require.Equal(http.StatusTooManyRequests, limited.Code)
+	require.Equal("30", limited.Header().Get("Retry-After"))
+
+	// The quota is per user, and only the authenticated mutations are limited
+	require.Equal(http.StatusOK, serve(update, "admin").Code)
+	require.Equal(http.StatusOK, serve(get, "jdoe").Code)
+	require.Equal(http.StatusOK, serve(login, "jdoe").Code)
+
+	// A token is refilled every 30 seconds
+	now = now.Add(30 * time.Second)
+	require.Equal(http.StatusOK, serve(update, "jdoe").Code)
+	require.Equal(http.StatusTooManyRequests, serve(update, "jdoe").Code)
+
+	// The users with a full quota are forgotten
+	now = now.Add(time.Minute)
+	serve(update, "jsmith")
+	require.Len(limiter.limiters, 1)
+
+	require.Nil(newMutationLimiter(0))
+}
@@ -1,6 +1,7 @@
 package routing
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"path/filepath"
 	rpprof "runtime/pprof"
 	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
@@ -27,6 +29,13 @@ import (
 	"github.com/kiali/kiali/tracing"
 )
 
+// streamingRoutes are the names of the routes streaming events until the client leaves. Like the profiler routes,
+// their requests are not bounded by the write timeout.
+var streamingRoutes = map[string]bool{
+	"IstioConfigActivity":   true,
+	"NamespaceHealthEvents": true,
+}
+
 // NewRouter creates the router with all API routes and the static files handler
 func NewRouter(
 	conf *config.Config,
@@ -165,10 +174,15 @@ func NewRouter(
 
 	allRoutes := apiRoutes.Routes
 
-	// Add the Profiler handlers if enabled
+	// Add the Profiler handlers if enabled. Profiles can last longer than the write timeout: their requests are not
+	// bounded by it.
+	profilerRoutes := []Route{}
 	if conf.Server.Profiler.Enabled {
 		log.Infof("Profiler is enabled")
-		allRoutes = append(allRoutes,
+		if conf.Auth.Strategy == config.AuthStrategyAnonymous {
+			log.Warningf("Profiler endpoints are not protected: the Kiali auth strategy is anonymous")
+		}
+		profilerRoutes = append(profilerRoutes,
 			Route{
 				Method:        "GET",
 				Name:          "PProf Index",
@@ -204,9 +218,16 @@ func NewRouter(
 				HandlerFunc:   hpprof.Trace,
 				Authenticated: true,
 			},
+			Route{
+				Method:        "GET",
+				Name:          "RuntimeSnapshot",
+				Pattern:       "/api/debug/runtime",
+				HandlerFunc:   handlers.RuntimeSnapshot,
+				Authenticated: true,
+			},
 		)
 		for _, p := range rpprof.Profiles() {
-			allRoutes = append(allRoutes,
+			profilerRoutes = append(profilerRoutes,
 				Route{
 					Method:        "GET",
 					Name:          "PProf " + p.Name(),
@@ -218,8 +239,9 @@ func NewRouter(
 		}
 	}
 
-	for _, route := range allRoutes {
-		handlerFunction := metricHandler(route.HandlerFunc, route)
+	mutationLimiter := newMutationLimiter(conf.Server.RequestLimits.MutationsPerMinute)
+	addRoute := func(route Route, timeout time.Duration) {
+		handlerFunction := metricHandler(rateLimitHandler(validationHandler(layerHandler(route.HandlerFunc, timeout), route, conf), route, mutationLimiter), route)
 		if route.Authenticated {
 			handlerFunction = authenticationHandler.Handle(handlerFunction)
 		} else {
@@ -231,6 +253,16 @@ func NewRouter(
 			Name(route.Name).
 			Handler(handlerFunction)
 	}
+	for _, route := range allRoutes {
+		if streamingRoutes[route.Name] {
+			addRoute(route, 0)
+			continue
+		}
+		addRoute(route, conf.Server.WriteTimeout*time.Second)
+	}
+	for _, route := range profilerRoutes {
+		addRoute(route, 0)
+	}
 
 	if authController != nil {
 		if ac, ok := authController.(*authentication.OpenIdAuthController); ok {
@@ -268,6 +300,12 @@ func (srw *statusResponseWriter) WriteHeader(code int) {
 	srw.StatusCode = code
 }
 
+// Unwrap returns the wrapped ResponseWriter, so that the handlers streaming their response can flush it with a
+// http.ResponseController.
+func (srw *statusResponseWriter) Unwrap() http.ResponseWriter {
+	return srw.ResponseWriter
+}
+
 // updateMetric evaluates the StatusCode, if there is an error, increase the API failure counter, otherwise save the duration
 func updateMetric(route string, srw *statusResponseWriter, timer *prometheus.Timer) {
 	// Always measure the duration even if the API call ended in an error
@@ -278,6 +316,20 @@ func updateMetric(route string, srw *statusResponseWriter, timer *prometheus.Tim
 	}
 }
 
+// layerHandler scopes a business layer to each request, and bounds the request context by the timeout, the write
+// timeout of the server: the business calls are cancelled once the response can't be sent anymore.
+func layerHandler(next http.Handler, timeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := business.NewRequestContext(r.Context())
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func metricHandler(next http.Handler, route Route) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// By default, if there is no call to WriteHeader, an 200 will be
@@ -113,6 +113,12 @@ func TestProfilerRoute(t *testing.T) {
 		}
 		assert.Equal(t, 400, resp.StatusCode, "pprof endpoint [%v] should exist but needed credentials", p)
 	}
+
+	resp, err = http.Get(ts.URL + "/api/debug/runtime")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 400, resp.StatusCode, "runtime snapshot should exist but needed credentials")
 }
 
 func TestDisabledProfilerRoute(t *testing.T) {
@@ -229,3 +235,22 @@ func TestMetricHandlerAPIFailures(t *testing.T) {
 		}
 	}
 }
+
+func TestStreamingRoutesExist(t *testing.T) {
+	conf := new(config.Config)
+
+	mockClientFactory := kubetest.NewK8SClientFactoryMock(kubetest.NewFakeK8sClient())
+	router, _ := NewRouter(conf, nil, mockClientFactory, nil, nil, nil, nil, nil)
+
+	for name := range streamingRoutes {
+		assert.NotNil(t, router.Get(name), "streaming route [%s] should exist", name)
+	}
+}
+
+func TestLayerHandlerWithoutTimeout(t *testing.T) {
+	handler := layerHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, hasDeadline := r.Context().Deadline()
+		assert.False(t, hasDeadline)
+	}), 0)
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/stream", nil))
+}
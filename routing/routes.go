@@ -162,9 +162,9 @@ func NewRoutes(
 			handlers.AuthenticationInfo(conf, authController, maps.Keys(clientFactory.GetSAClients())),
 			false,
 		},
-		// swagger:route GET /status status getStatus
+		// swagger:route GET /sessions auth sessionsList
 		// ---
-		// Endpoint to get the status of Kiali
+		// Endpoint to list the active sessions of the user
 		//
 		//     Produces:
 		//     - application/json
@@ -173,17 +173,34 @@ func NewRoutes(
 		//
 		// responses:
 		//      500: internalError
-		//      200: statusInfo
+		//      200: sessionsResponse
 		{
-			"Status",
+			"SessionsList",
 			"GET",
-			"/api/status",
-			handlers.Root(conf, clientFactory, kialiCache, grafana),
+			"/api/sessions",
+			handlers.SessionsList,
 			true,
 		},
-		// swagger:route GET /config kiali getConfig
+		// swagger:route DELETE /sessions/{session} auth sessionRevoke
 		// ---
-		// Endpoint to get the config of Kiali
+		// Endpoint to revoke a session of the user. The session admins can revoke any session.
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      500: internalError
+		//      204: noContent
+		{
+			"SessionRevoke",
+			"DELETE",
+			"/api/sessions/{session}",
+			handlers.SessionRevoke,
+			true,
+		},
+		// swagger:route GET /sessions/current/pin auth sessionTimePin
+		// ---
+		// Endpoint to get the query time pinned for the session of the user
 		//
 		//     Produces:
 		//     - application/json
@@ -191,18 +208,20 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      404: notFoundError
 		//      500: internalError
-		//      200: statusInfo
+		//      200: timePinResponse
 		{
-			"Config",
+			"SessionTimePin",
 			"GET",
-			"/api/config",
-			handlers.Config(conf, discovery),
+			"/api/sessions/current/pin",
+			handlers.SessionTimePin,
 			true,
 		},
-		// swagger:route GET /crippled kiali getCrippledFeatures
+		// swagger:route PUT /sessions/current/pin auth sessionTimePinSet
 		// ---
-		// Endpoint to get the crippled features of Kiali
+		// Endpoint to pin the query time of the session of the user. The graph, health and metrics requests of the
+		// session not setting a queryTime are evaluated at the pinned time until the pin expires.
 		//
 		//     Produces:
 		//     - application/json
@@ -210,18 +229,70 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      400: badRequestError
 		//      500: internalError
-		//      200: statusInfo
+		//      200: timePinResponse
 		{
-			"Crippled",
-			"GET",
-			"/api/crippled",
-			handlers.CrippledFeatures,
+			"SessionTimePinSet",
+			"PUT",
+			"/api/sessions/current/pin",
+			handlers.SessionTimePinSet,
 			true,
 		},
-		// swagger:route GET /istio/permissions config getPermissions
+		// swagger:route DELETE /sessions/current/pin auth sessionTimePinDelete
 		// ---
-		// Endpoint to get the caller permissions on new Istio Config objects
+		// Endpoint to remove the query time pinned for the session of the user
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      204: noContent
+		{
+			"SessionTimePinDelete",
+			"DELETE",
+			"/api/sessions/current/pin",
+			handlers.SessionTimePinDelete,
+			true,
+		},
+		// swagger:route DELETE /sessions auth sessionsRevokeAll
+		// ---
+		// Endpoint to revoke the sessions of every user. Only the session admins can revoke all the sessions.
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      403: forbiddenError
+		//      500: internalError
+		//      204: noContent
+		{
+			"SessionsRevokeAll",
+			"DELETE",
+			"/api/sessions",
+			handlers.SessionsRevokeAll,
+			true,
+		},
+		// swagger:route POST /cache/refresh auth tokenCachesRefresh
+		// ---
+		// Endpoint to clear the namespaces and permissions cached for every user. Only the cache admins can refresh them.
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      403: forbiddenError
+		//      500: internalError
+		//      204: noContent
+		{
+			"TokenCachesRefresh",
+			"POST",
+			"/api/cache/refresh",
+			handlers.TokenCachesRefresh(kialiCache),
+			true,
+		},
+		// swagger:route GET /notifications/deadletters kiali notificationDeadLetters
+		// ---
+		// Endpoint to list the notifications that failed all their delivery attempts, the most recent first. Only the
+		// notification admins can list them.
 		//
 		//     Produces:
 		//     - application/json
@@ -229,18 +300,20 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      403: forbiddenError
 		//      500: internalError
-		//      200: istioConfigPermissions
+		//      200: notificationDeadLettersResponse
 		{
-			"IstioConfigPermissions",
+			"NotificationDeadLetters",
 			"GET",
-			"/api/istio/permissions",
-			handlers.IstioConfigPermissions,
+			"/api/notifications/deadletters",
+			handlers.NotificationDeadLetters,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/istio config istioConfigList
+		// swagger:route POST /notifications/deadletters/{notification}/retry kiali notificationDeadLetterRetry
 		// ---
-		// Endpoint to get the list of Istio Config of a namespace
+		// Endpoint to queue a dead letter again for the next delivery of the notifications. Only the notification
+		// admins can retry them.
 		//
 		//     Produces:
 		//     - application/json
@@ -248,19 +321,21 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      403: forbiddenError
+		//      404: notFoundError
 		//      500: internalError
-		//      200: istioConfigList
-		//
+		//      200: notificationResponse
 		{
-			"IstioConfigList",
-			"GET",
-			"/api/namespaces/{namespace}/istio",
-			handlers.IstioConfigList,
+			"NotificationDeadLetterRetry",
+			"POST",
+			"/api/notifications/deadletters/{notification}/retry",
+			handlers.NotificationDeadLetterRetry,
 			true,
 		},
-		// swagger:route GET /istio config istioConfigListAll
+		// swagger:route GET /cache/stats kiali cacheStats
 		// ---
-		// Endpoint to get the list of Istio Config of all namespaces
+		// Endpoint to get the state of the informers of the Kiali cache of every cluster: the cached objects, the
+		// last syncs, the watch errors and the staleness of the namespaces. Only the cache admins can get them.
 		//
 		//     Produces:
 		//     - application/json
@@ -268,19 +343,19 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      403: forbiddenError
 		//      500: internalError
-		//      200: istioConfigList
-		//
+		//      200: cacheStatsResponse
 		{
-			"IstioConfigListAll",
+			"CacheStats",
 			"GET",
-			"/api/istio/config",
-			handlers.IstioConfigList,
+			"/api/cache/stats",
+			handlers.CacheStats(kialiCache),
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/istio/{group}/{version}/{kind}/{object} config istioConfigDetails
+		// swagger:route GET /status status getStatus
 		// ---
-		// Endpoint to get the Istio Config of an Istio object
+		// Endpoint to get the status of Kiali
 		//
 		//     Produces:
 		//     - application/json
@@ -288,21 +363,19 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      400: badRequestError
-		//      404: notFoundError
 		//      500: internalError
-		//      200: istioConfigDetailsResponse
-		//
+		//      200: statusInfo
 		{
-			"IstioConfigDetails",
+			"Status",
 			"GET",
-			"/api/namespaces/{namespace}/istio/{group}/{version}/{kind}/{object}",
-			handlers.IstioConfigDetails,
+			"/api/status",
+			handlers.Root(conf, clientFactory, kialiCache, grafana),
 			true,
 		},
-		// swagger:route DELETE /namespaces/{namespace}/istio/{group}/{version}/{kind}/{object} config istioConfigDelete
+		// swagger:route GET /status/connections status externalServicesConnections
 		// ---
-		// Endpoint to delete the Istio Config of an (arbitrary) Istio object
+		// Endpoint to check the connection and the authentication to the external services.
+		// Only the external service admins can check the connections.
 		//
 		//     Produces:
 		//     - application/json
@@ -310,23 +383,21 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      404: notFoundError
+		//      403: forbiddenError
 		//      500: internalError
-		//      200
+		//      200: externalServicesConnectionsResponse
 		//
 		{
-			"IstioConfigDelete",
-			"DELETE",
-			"/api/namespaces/{namespace}/istio/{group}/{version}/{kind}/{object}",
-			handlers.IstioConfigDelete,
+			"ExternalServicesConnections",
+			"GET",
+			"/api/status/connections",
+			handlers.ExternalServicesConnections(conf, clientFactory, grafana),
 			true,
 		},
-		// swagger:route PATCH /namespaces/{namespace}/istio/{group}/{version}/{kind}/{object} config istioConfigUpdate
+		// swagger:route GET /status/connections/{service} status externalServiceConnection
 		// ---
-		// Endpoint to update the Istio Config of an Istio object used for templates and adapters using Json Merge Patch strategy.
-		//
-		//     Consumes:
-		//	   - application/json
+		// Endpoint to check the connection and the authentication to an external service: prometheus, tracing,
+		// grafana or alertmanager. Only the external service admins can check the connections.
 		//
 		//     Produces:
 		//     - application/json
@@ -334,21 +405,21 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      400: badRequestError
+		//      403: forbiddenError
 		//      404: notFoundError
 		//      500: internalError
-		//      200: istioConfigDetailsResponse
+		//      200: externalServicesConnectionsResponse
 		//
 		{
-			"IstioConfigUpdate",
-			"PATCH",
-			"/api/namespaces/{namespace}/istio/{group}/{version}/{kind}/{object}",
-			handlers.IstioConfigUpdate,
+			"ExternalServiceConnection",
+			"GET",
+			"/api/status/connections/{service}",
+			handlers.ExternalServicesConnections(conf, clientFactory, grafana),
 			true,
 		},
-		// swagger:route POST /namespaces/{namespace}/istio/{group}/{version}/{kind} config istioConfigCreate
+		// swagger:route GET /config kiali getConfig
 		// ---
-		// Endpoint to create an Istio object by using an Istio Config item
+		// Endpoint to get the config of Kiali
 		//
 		//     Produces:
 		//     - application/json
@@ -356,22 +427,18 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      404: notFoundError
 		//      500: internalError
-		//		202
-		//		201: istioConfigDetailsResponse
-		//      200: istioConfigDetailsResponse
-		//
+		//      200: statusInfo
 		{
-			"IstioConfigCreate",
-			"POST",
-			"/api/namespaces/{namespace}/istio/{group}/{version}/{kind}",
-			handlers.IstioConfigCreate,
+			"Config",
+			"GET",
+			"/api/config",
+			handlers.Config(conf, discovery),
 			true,
 		},
-		// swagger:route GET /clusters/services services serviceList
+		// swagger:route GET /crippled kiali getCrippledFeatures
 		// ---
-		// Endpoint to get the list of services for a given cluster
+		// Endpoint to get the crippled features of Kiali
 		//
 		//     Produces:
 		//     - application/json
@@ -380,18 +447,17 @@ func NewRoutes(
 		//
 		// responses:
 		//      500: internalError
-		//      200: serviceListResponse
-		//
+		//      200: statusInfo
 		{
-			"ClustersServices",
+			"Crippled",
 			"GET",
-			"/api/clusters/services",
-			handlers.ClustersServices,
+			"/api/crippled",
+			handlers.CrippledFeatures,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/services/{service} services serviceDetails
+		// swagger:route GET /istio/permissions config getPermissions
 		// ---
-		// Endpoint to get the details of a given service
+		// Endpoint to get the caller permissions on new Istio Config objects
 		//
 		//     Produces:
 		//     - application/json
@@ -399,23 +465,18 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      404: notFoundError
 		//      500: internalError
-		//      200: serviceDetailsResponse
-		//
+		//      200: istioConfigPermissions
 		{
-			"ServiceDetails",
+			"IstioConfigPermissions",
 			"GET",
-			"/api/namespaces/{namespace}/services/{service}",
-			handlers.ServiceDetails,
+			"/api/istio/permissions",
+			handlers.IstioConfigPermissions,
 			true,
 		},
-		// swagger:route PATCH /namespaces/{namespace}/services/{service} services serviceUpdate
+		// swagger:route GET /istio/hosts config istioConfigHostReferences
 		// ---
-		// Endpoint to update the Service configuration using Json Merge Patch strategy.
-		//
-		//     Consumes:
-		//	   - application/json
+		// Endpoint to get the Istio objects referencing a host, with the precedence of the objects declaring it
 		//
 		//     Produces:
 		//     - application/json
@@ -424,77 +485,79 @@ func NewRoutes(
 		//
 		// responses:
 		//      400: badRequestError
-		//      404: notFoundError
 		//      500: internalError
-		//      200: serviceDetailsResponse
-		//
+		//      200: istioConfigHostReferencesResponse
 		{
-			"ServiceUpdate",
-			"PATCH",
-			"/api/namespaces/{namespace}/services/{service}",
-			handlers.ServiceUpdate,
+			"IstioConfigHostReferences",
+			"GET",
+			"/api/istio/hosts",
+			handlers.IstioConfigHostReferences,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/apps/{app}/spans traces appSpans
+		// swagger:route GET /search search search
 		// ---
-		// Endpoint to get Tracing spans for a given app
+		// Endpoint to look up the apps, services, workloads and Istio objects of the accessible namespaces by name,
+		// the most relevant first
 		//
-		//		Produces:
-		//		- application/json
+		//     Produces:
+		//     - application/json
 		//
-		//		Schemes: http, https
+		//     Schemes: http, https
 		//
 		// responses:
-		// 		500: internalError
-		//		200: spansResponse
+		//      400: badRequestError
+		//      500: internalError
+		//      200: searchResponse
 		{
-			"AppSpans",
+			"Search",
 			"GET",
-			"/api/namespaces/{namespace}/apps/{app}/spans",
-			handlers.AppSpans,
+			"/api/search",
+			handlers.Search,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/spans traces workloadSpans
+		// swagger:route GET /istio/gateways/servers config istioGatewayServers
 		// ---
-		// Endpoint to get Tracing spans for a given workload
+		// Endpoint to get the servers of the Istio Gateways of a cluster by host and port, with the ingress workloads
+		// serving them and the conflicts between them
 		//
-		//		Produces:
-		//		- application/json
+		//     Produces:
+		//     - application/json
 		//
-		//		Schemes: http, https
+		//     Schemes: http, https
 		//
 		// responses:
-		// 		500: internalError
-		//		200: spansResponse
+		//      500: internalError
+		//      200: istioGatewayServersResponse
 		{
-			"WorkloadSpans",
+			"IstioGatewayServers",
 			"GET",
-			"/api/namespaces/{namespace}/workloads/{workload}/spans",
-			handlers.WorkloadSpans,
+			"/api/istio/gateways/servers",
+			handlers.IstioGatewayServers,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/services/{service}/spans traces serviceSpans
+		// swagger:route GET /istio/snapshots config istioConfigSnapshots
 		// ---
-		// Endpoint to get Tracing spans for a given service
+		// Endpoint to list the snapshots of the Istio config of a cluster, the newest first
 		//
-		//		Produces:
-		//		- application/json
+		//     Produces:
+		//     - application/json
 		//
-		//		Schemes: http, https
+		//     Schemes: http, https
 		//
 		// responses:
-		// 		500: internalError
-		//		200: spansResponse
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: istioConfigSnapshotsResponse
 		{
-			"ServiceSpans",
+			"IstioConfigSnapshots",
 			"GET",
-			"/api/namespaces/{namespace}/services/{service}/spans",
-			handlers.ServiceSpans,
+			"/api/istio/snapshots",
+			handlers.IstioConfigSnapshots,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/apps/{app}/traces traces appTraces
+		// swagger:route GET /istio/snapshots/{snapshot} config istioConfigSnapshot
 		// ---
-		// Endpoint to get the traces of a given app
+		// Endpoint to get a snapshot of the Istio config of a namespace
 		//
 		//     Produces:
 		//     - application/json
@@ -502,20 +565,20 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      403: forbiddenError
 		//      404: notFoundError
 		//      500: internalError
-		//      200: traceDetailsResponse
-		//
+		//      200: istioConfigSnapshotResponse
 		{
-			"AppTraces",
+			"IstioConfigSnapshot",
 			"GET",
-			"/api/namespaces/{namespace}/apps/{app}/traces",
-			handlers.AppTraces,
+			"/api/istio/snapshots/{snapshot}",
+			handlers.IstioConfigSnapshot,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/services/{service}/traces traces serviceTraces
+		// swagger:route POST /istio/snapshots/{snapshot}/restore config istioConfigSnapshotRestore
 		// ---
-		// Endpoint to get the traces of a given service
+		// Endpoint to create again the Istio objects of a snapshot that don't exist anymore
 		//
 		//     Produces:
 		//     - application/json
@@ -523,20 +586,40 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      403: forbiddenError
 		//      404: notFoundError
 		//      500: internalError
-		//      200: traceDetailsResponse
+		//      200: istioConfigSnapshotRestoreResponse
+		{
+			"IstioConfigSnapshotRestore",
+			"POST",
+			"/api/istio/snapshots/{snapshot}/restore",
+			handlers.IstioConfigSnapshotRestore,
+			true,
+		},
+		// swagger:route GET /istio/deleted config istioConfigDeleted
+		// ---
+		// Endpoint to list the Istio objects of a cluster whose deletion can still be undone, the most recently deleted first
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
 		//
+		// responses:
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: istioConfigDeletedResponse
 		{
-			"ServiceTraces",
+			"IstioConfigDeleted",
 			"GET",
-			"/api/namespaces/{namespace}/services/{service}/traces",
-			handlers.ServiceTraces,
+			"/api/istio/deleted",
+			handlers.IstioConfigDeleted,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/traces traces workloadTraces
+		// swagger:route POST /istio/deleted/{deleted}/undo config istioConfigUndoDelete
 		// ---
-		// Endpoint to get the traces of a given workload
+		// Endpoint to create again a deleted Istio object, while its undo window is not expired
 		//
 		//     Produces:
 		//     - application/json
@@ -544,20 +627,1205 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      403: forbiddenError
 		//      404: notFoundError
 		//      500: internalError
-		//      200: traceDetailsResponse
-		//
+		//      200: istioConfigUndoDeleteResponse
 		{
-			"WorkloadTraces",
+			"IstioConfigUndoDelete",
+			"POST",
+			"/api/istio/deleted/{deleted}/undo",
+			handlers.IstioConfigUndoDelete,
+			true,
+		},
+		// swagger:route GET /istio/diff config istioConfigDiff
+		// ---
+		// Endpoint to compare the Istio config of two namespaces, of the same or of different clusters
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: istioConfigDiffResponse
+		{
+			"IstioConfigDiff",
+			"GET",
+			"/api/istio/diff",
+			handlers.IstioConfigDiff,
+			true,
+		},
+		// swagger:route POST /istio/delete/check config istioConfigDeleteCheck
+		// ---
+		// Endpoint to check Istio objects about to be deleted: whether they still exist, whether the user can delete them
+		// and the Istio objects related to them
+		//
+		//     Consumes:
+		//     - application/json
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      500: internalError
+		//      200: istioConfigDeleteCheckResponse
+		{
+			"IstioConfigDeleteCheck",
+			"POST",
+			"/api/istio/delete/check",
+			handlers.IstioConfigDeleteCheck,
+			true,
+		},
+		// swagger:route POST /namespaces/{namespace}/istio/bundle config istioConfigBundleApply
+		// ---
+		// Endpoint to apply a bundle of Istio object templates to a namespace, replacing their ${name} variables
+		//
+		//     Consumes:
+		//     - application/json
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: istioConfigBundleApplyResponse
+		{
+			"IstioConfigBundleApply",
+			"POST",
+			"/api/namespaces/{namespace}/istio/bundle",
+			handlers.IstioConfigBundleApply,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/istio config istioConfigList
+		// ---
+		// Endpoint to get the list of Istio Config of a namespace
+		//
+		//     Produces:
+		//     - application/json
+		//     - application/x-ndjson
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      410: goneError
+		//      500: internalError
+		//      200: istioConfigList
+		//
+		{
+			"IstioConfigList",
+			"GET",
+			"/api/namespaces/{namespace}/istio",
+			handlers.IstioConfigList,
+			true,
+		},
+		// swagger:route GET /istio config istioConfigListAll
+		// ---
+		// Endpoint to get the list of Istio Config of all namespaces
+		//
+		//     Produces:
+		//     - application/json
+		//     - application/x-ndjson
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      410: goneError
+		//      500: internalError
+		//      200: istioConfigList
+		//
+		{
+			"IstioConfigListAll",
+			"GET",
+			"/api/istio/config",
+			handlers.IstioConfigList,
+			true,
+		},
+		// swagger:route GET /istio/config/window config istioConfigListWindow
+		// ---
+		// Endpoint to get a window of the sorted list of Istio objects of a cluster, of all the requested types, with
+		// the size of the whole list, for the clients showing long lists a part at a time
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: istioConfigWindowResponse
+		//
+		{
+			"IstioConfigListWindow",
+			"GET",
+			"/api/istio/config/window",
+			handlers.IstioConfigListWindow,
+			true,
+		},
+		// swagger:route GET /istio/config/namespaces config istioConfigListByNamespace
+		// ---
+		// Endpoint to get the list of Istio Config of several namespaces, all the accessible namespaces by default, as
+		// one list per namespace
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: istioConfigNamespaceMap
+		//
+		{
+			"IstioConfigListByNamespace",
+			"GET",
+			"/api/istio/config/namespaces",
+			handlers.IstioConfigListByNamespace,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/istio/activity config istioConfigActivity
+		// ---
+		// Endpoint to get the latest changes of the Istio config of a namespace, newest first, as JSON or as an RSS feed,
+		// or to follow them as server-sent events
+		//
+		//     Produces:
+		//     - application/json
+		//     - application/rss+xml
+		//     - text/event-stream
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: istioConfigActivityResponse
+		//
+		{
+			"IstioConfigActivity",
+			"GET",
+			"/api/namespaces/{namespace}/istio/activity",
+			handlers.IstioConfigActivity,
+			true,
+		},
+		// swagger:route GET /istio/churn config istioConfigChurn
+		// ---
+		// Endpoint to get the rate of the changes of the Istio config of the namespaces of a cluster during a window,
+		// the most changed first
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      500: internalError
+		//      200: istioConfigChurnResponse
+		//
+		{
+			"IstioConfigChurn",
+			"GET",
+			"/api/istio/churn",
+			handlers.IstioConfigChurn,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/istio/orphans config istioConfigOrphans
+		// ---
+		// Endpoint to get the report of the likely orphaned Istio objects of a namespace
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: istioConfigOrphansResponse
+		//
+		{
+			"IstioConfigOrphans",
+			"GET",
+			"/api/namespaces/{namespace}/istio/orphans",
+			handlers.IstioConfigOrphans,
+			true,
+		},
+		// swagger:route DELETE /namespaces/{namespace}/istio/orphans config istioConfigOrphansDelete
+		// ---
+		// Endpoint to delete the given Istio objects of a namespace, when they are still reported as orphaned
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      200: istioConfigOrphansDeleteResponse
+		//
+		{
+			"IstioConfigOrphansDelete",
+			"DELETE",
+			"/api/namespaces/{namespace}/istio/orphans",
+			handlers.IstioConfigOrphansDelete,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/diagnostics/telemetry namespaces namespaceTelemetryDiagnostics
+		// ---
+		// Endpoint to get the workloads with sidecars of a namespace whose proxies logged requests missing from the Istio metrics
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//      503: serviceUnavailableError
+		//      200: namespaceTelemetryDiagnosticsResponse
+		//
+		{
+			"NamespaceTelemetryDiagnostics",
+			"GET",
+			"/api/namespaces/{namespace}/diagnostics/telemetry",
+			handlers.NamespaceTelemetryDiagnostics,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/istio/{group}/{version}/{kind}/{object} config istioConfigDetails
+		// ---
+		// Endpoint to get the Istio Config of an Istio object
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: istioConfigDetailsResponse
+		//
+		{
+			"IstioConfigDetails",
+			"GET",
+			"/api/namespaces/{namespace}/istio/{group}/{version}/{kind}/{object}",
+			handlers.IstioConfigDetails,
+			true,
+		},
+		// swagger:route DELETE /namespaces/{namespace}/istio/{group}/{version}/{kind}/{object} config istioConfigDelete
+		// ---
+		// Endpoint to delete the Istio Config of an (arbitrary) Istio object. The delete protection blocks the
+		// deletion of the Gateways, DestinationRules and ServiceEntries still referenced, or reports their dependents
+		// in Warning headers.
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      409: conflictError
+		//      500: internalError
+		//      200
+		//
+		{
+			"IstioConfigDelete",
+			"DELETE",
+			"/api/namespaces/{namespace}/istio/{group}/{version}/{kind}/{object}",
+			handlers.IstioConfigDelete,
+			true,
+		},
+		// swagger:route PATCH /namespaces/{namespace}/istio/{group}/{version}/{kind}/{object} config istioConfigUpdate
+		// ---
+		// Endpoint to update the Istio Config of an Istio object used for templates and adapters using Json Merge Patch strategy.
+		// The patch can be YAML, it is converted to JSON. The updated object is returned as YAML when the Accept header asks for it.
+		// With dryRun, nothing is persisted: the patched object is returned with its validations.
+		//
+		//     Consumes:
+		//	   - application/json
+		//	   - application/yaml
+		//
+		//     Produces:
+		//     - application/json
+		//     - application/yaml
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: istioConfigDetailsResponse
+		//
+		{
+			"IstioConfigUpdate",
+			"PATCH",
+			"/api/namespaces/{namespace}/istio/{group}/{version}/{kind}/{object}",
+			handlers.IstioConfigUpdate,
+			true,
+		},
+		// swagger:route POST /namespaces/{namespace}/istio/{group}/{version}/{kind} config istioConfigCreate
+		// ---
+		// Endpoint to create an Istio object by using an Istio Config item, as JSON or YAML. The created object is returned as
+		// YAML when the Accept header asks for it.
+		// With dryRun, nothing is persisted: the object is returned with its validations.
+		//
+		//     Consumes:
+		//     - application/json
+		//     - application/yaml
+		//
+		//     Produces:
+		//     - application/json
+		//     - application/yaml
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      500: internalError
+		//		202
+		//		201: istioConfigDetailsResponse
+		//      200: istioConfigDetailsResponse
+		//
+		{
+			"IstioConfigCreate",
+			"POST",
+			"/api/namespaces/{namespace}/istio/{group}/{version}/{kind}",
+			handlers.IstioConfigCreate,
+			true,
+		},
+		// swagger:route GET /clusters/services services serviceList
+		// ---
+		// Endpoint to get the list of services for a given cluster
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      200: serviceListResponse
+		//
+		{
+			"ClustersServices",
+			"GET",
+			"/api/clusters/services",
+			handlers.ClustersServices,
+			true,
+		},
+		// swagger:route GET /clusters/services/window services serviceListWindow
+		// ---
+		// Endpoint to get a window of the sorted list of services of a cluster, with the size of the whole list, for
+		// the clients showing long lists a part at a time
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      500: internalError
+		//      200: serviceWindowResponse
+		//
+		{
+			"ClustersServicesWindow",
+			"GET",
+			"/api/clusters/services/window",
+			handlers.ClustersServicesWindow,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/services/{service} services serviceDetails
+		// ---
+		// Endpoint to get the details of a given service
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      500: internalError
+		//      200: serviceDetailsResponse
+		//
+		{
+			"ServiceDetails",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}",
+			handlers.ServiceDetails,
+			true,
+		},
+		// swagger:route PATCH /namespaces/{namespace}/services/{service} services serviceUpdate
+		// ---
+		// Endpoint to update the Service configuration using Json Merge Patch strategy.
+		//
+		//     Consumes:
+		//	   - application/json
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: serviceDetailsResponse
+		//
+		{
+			"ServiceUpdate",
+			"PATCH",
+			"/api/namespaces/{namespace}/services/{service}",
+			handlers.ServiceUpdate,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/services/{service}/requests services serviceRecentRequests
+		// ---
+		// Endpoint to stream the requests recently received by a service, parsed from the access logs of its proxies
+		//
+		//     Produces:
+		//     - application/x-ndjson
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: serviceRecentRequestsResponse
+		//
+		{
+			"ServiceRecentRequests",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}/requests",
+			handlers.ServiceRecentRequests,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/apps/{app}/spans traces appSpans
+		// ---
+		// Endpoint to get Tracing spans for a given app
+		//
+		//		Produces:
+		//		- application/json
+		//
+		//		Schemes: http, https
+		//
+		// responses:
+		// 		500: internalError
+		//		200: spansResponse
+		{
+			"AppSpans",
+			"GET",
+			"/api/namespaces/{namespace}/apps/{app}/spans",
+			handlers.AppSpans,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/spans traces workloadSpans
+		// ---
+		// Endpoint to get Tracing spans for a given workload
+		//
+		//		Produces:
+		//		- application/json
+		//
+		//		Schemes: http, https
+		//
+		// responses:
+		// 		500: internalError
+		//		200: spansResponse
+		{
+			"WorkloadSpans",
+			"GET",
+			"/api/namespaces/{namespace}/workloads/{workload}/spans",
+			handlers.WorkloadSpans,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/services/{service}/spans traces serviceSpans
+		// ---
+		// Endpoint to get Tracing spans for a given service
+		//
+		//		Produces:
+		//		- application/json
+		//
+		//		Schemes: http, https
+		//
+		// responses:
+		// 		500: internalError
+		//		200: spansResponse
+		{
+			"ServiceSpans",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}/spans",
+			handlers.ServiceSpans,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/apps/{app}/traces traces appTraces
+		// ---
+		// Endpoint to get the traces of a given app
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      500: internalError
+		//      200: traceDetailsResponse
+		//
+		{
+			"AppTraces",
+			"GET",
+			"/api/namespaces/{namespace}/apps/{app}/traces",
+			handlers.AppTraces,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/services/{service}/traces traces serviceTraces
+		// ---
+		// Endpoint to get the traces of a given service
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      500: internalError
+		//      200: traceDetailsResponse
+		//
+		{
+			"ServiceTraces",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}/traces",
+			handlers.ServiceTraces,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/traces traces workloadTraces
+		// ---
+		// Endpoint to get the traces of a given workload
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      500: internalError
+		//      200: traceDetailsResponse
+		//
+		{
+			"WorkloadTraces",
+			"GET",
+			"/api/namespaces/{namespace}/workloads/{workload}/traces",
+			handlers.WorkloadTraces,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/apps/{app}/errortraces traces errorTraces
+		// ---
+		// Endpoint to get the number of traces in error for a given service
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      500: internalError
+		//      200: errorTracesResponse
+		//
+		{
+			"ErrorTraces",
+			"GET",
+			"/api/namespaces/{namespace}/apps/{app}/errortraces",
+			handlers.ErrorTraces,
+			true,
+		},
+		// swagger:route GET /traces/{traceID} traces traceDetails
+		// ---
+		// Endpoint to get a specific trace from ID
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      404: notFoundError
+		//      500: internalError
+		//      200: traceDetailsResponse
+		//
+		{
+			"TracesDetails",
+			"GET",
+			"/api/traces/{traceID}",
+			handlers.TraceDetails,
+			true,
+		},
+		// swagger:route GET /clusters/workloads workloads workloadList
+		// ---
+		// Endpoint to get the list of workloads for a cluster
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      200: workloadListResponse
+		//
+		{
+			"ClustersWorkloads",
+			"GET",
+			"/api/clusters/workloads",
+			handlers.ClustersWorkloads,
+			true,
+		},
+		// swagger:route GET /clusters/workloads/window workloads workloadListWindow
+		// ---
+		// Endpoint to get a window of the sorted list of workloads of a cluster, with the size of the whole list, for
+		// the clients showing long lists a part at a time
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      500: internalError
+		//      200: workloadWindowResponse
+		//
+		{
+			"ClustersWorkloadsWindow",
+			"GET",
+			"/api/clusters/workloads/window",
+			handlers.ClustersWorkloadsWindow,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/workloads/{workload} workloads workloadDetails
+		// ---
+		// Endpoint to get the workload details
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      404: notFoundError
+		//      200: workloadDetails
+		//
+		{
+			"WorkloadDetails",
+			"GET",
+			"/api/namespaces/{namespace}/workloads/{workload}",
+			handlers.WorkloadDetails,
+			true,
+		},
+		// swagger:route PATCH /namespaces/{namespace}/workloads/{workload} workloads workloadUpdate
+		// ---
+		// Endpoint to update the Workload configuration using Json Merge Patch strategy.
+		//
+		//     Consumes:
+		//	   - application/json
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: workloadDetails
+		//
+		{
+			"WorkloadUpdate",
+			"PATCH",
+			"/api/namespaces/{namespace}/workloads/{workload}",
+			handlers.WorkloadUpdate,
+			true,
+		},
+		// swagger:route GET /clusters/apps apps appList
+		// ---
+		// Endpoint to get the list of apps for a cluster
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      200: appListResponse
+		//
+		{
+			"ClustersApps",
+			"GET",
+			"/api/clusters/apps",
+			handlers.ClustersApps,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/apps/{app} apps appDetails
+		// ---
+		// Endpoint to get the app details
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      404: notFoundError
+		//      200: appDetails
+		//
+		{
+			"AppDetails",
+			"GET",
+			"/api/namespaces/{namespace}/apps/{app}",
+			handlers.AppDetails,
+			true,
+		},
+		// swagger:route GET /namespaces namespaces namespaceList
+		// ---
+		// Endpoint to get the list of the available namespaces
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      200: namespaceList
+		//
+		{
+			"NamespaceList",
+			"GET",
+			"/api/namespaces",
+			handlers.NamespaceList,
+			true,
+		},
+		// swagger:route PATCH /namespaces/{namespace} namespaces namespaceUpdate
+		// ---
+		// Endpoint to update the Namespace configuration using Json Merge Patch strategy.
+		//
+		//     Consumes:
+		//	   - application/json
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: namespaceResponse
+		//
+		{
+			"NamespaceUpdate",
+			"PATCH",
+			"/api/namespaces/{namespace}",
+			handlers.NamespaceUpdate,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/info namespaces namespaceInfo
+		// ---
+		// Endpoint to get info about a single namespace
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      200: namespaceList
+		//
+		{
+			"NamespaceInfo",
+			"GET",
+			"/api/namespaces/{namespace}/info",
+			handlers.NamespaceInfo,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/services/{service}/metrics services serviceMetrics
+		// ---
+		// Endpoint to fetch metrics to be displayed, related to a single service
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: metricsResponse
+		//
+		{
+			"ServiceMetrics",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}/metrics",
+			handlers.ServiceMetrics,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/aggregates/{aggregate}/{aggregateValue}/metrics aggregates aggregateMetrics
+		// ---
+		// Endpoint to fetch metrics to be displayed, related to a single aggregate
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: metricsResponse
+		//
+		{
+			"AggregateMetrics",
+			"GET",
+			"/api/namespaces/{namespace}/aggregates/{aggregate}/{aggregateValue}/metrics",
+			handlers.AggregateMetrics,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/apps/{app}/metrics apps appMetrics
+		// ---
+		// Endpoint to fetch metrics to be displayed, related to a single app
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: metricsResponse
+		//
+		{
+			"AppMetrics",
+			"GET",
+			"/api/namespaces/{namespace}/apps/{app}/metrics",
+			handlers.AppMetrics,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/metrics workloads workloadMetrics
+		// ---
+		// Endpoint to fetch metrics to be displayed, related to a single workload
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: metricsResponse
+		//
+		{
+			"WorkloadMetrics",
+			"GET",
+			"/api/namespaces/{namespace}/workloads/{workload}/metrics",
+			handlers.WorkloadMetrics,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/controlplanes/{controlplane}/metrics controlplanes controlPlaneMetrics
+		// ---
+		// Endpoint to fetch metrics to be displayed, related to a single control plane
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: metricsResponse
+		//
+		{
+			"ControlPlaneMetrics",
+			"GET",
+			"/api/namespaces/{namespace}/controlplanes/{controlplane}/metrics",
+			handlers.ControlPlaneMetrics(handlers.DefaultPromClientSupplier),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/services/{service}/dashboard services serviceDashboard
+		// ---
+		// Endpoint to fetch dashboard to be displayed, related to a single service
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: dashboardResponse
+		//
+		{
+			"ServiceDashboard",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}/dashboard",
+			handlers.ServiceDashboard(conf, grafana),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/apps/{app}/dashboard apps appDashboard
+		// ---
+		// Endpoint to fetch dashboard to be displayed, related to a single app
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: dashboardResponse
+		//
+		{
+			"AppDashboard",
+			"GET",
+			"/api/namespaces/{namespace}/apps/{app}/dashboard",
+			handlers.AppDashboard(conf, grafana),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/dashboard workloads workloadDashboard
+		// ---
+		// Endpoint to fetch dashboard to be displayed, related to a single workload
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: dashboardResponse
+		//
+		{
+			"WorkloadDashboard",
+			"GET",
+			"/api/namespaces/{namespace}/workloads/{workload}/dashboard",
+			handlers.WorkloadDashboard(conf, grafana),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/customdashboard/{dashboard} dashboards customDashboard
+		// ---
+		// Endpoint to fetch a custom dashboard
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: dashboardResponse
+		//
+		{
+			"CustomDashboard",
+			"GET",
+			"/api/namespaces/{namespace}/customdashboard/{dashboard}",
+			handlers.CustomDashboard(conf, grafana),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/metrics namespaces namespaceMetrics
+		// ---
+		// Endpoint to fetch metrics to be displayed, related to a namespace
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: metricsResponse
+		//
+		{
+			"NamespaceMetrics",
+			"GET",
+			"/api/namespaces/{namespace}/metrics",
+			handlers.NamespaceMetrics(handlers.DefaultPromClientSupplier),
+			true,
+		},
+		// swagger:route POST /namespaces/{namespace}/metrics:batch namespaces metricsBatch
+		// ---
+		// Endpoint to fetch the inbound request rate, error rate and p95 response time of many services and workloads
+		// of a namespace in one request
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      503: serviceUnavailableError
+		//      200: metricsBatchResponse
+		//
+		{
+			"MetricsBatch",
+			"POST",
+			"/api/namespaces/{namespace}/metrics:batch",
+			handlers.MetricsBatch(handlers.DefaultPromClientSupplier),
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/trends namespaces namespaceTrends
+		// ---
+		// Endpoint to fetch the long-term inbound traffic and error rate trends of a namespace, app, service or workload,
+		// downsampled to a coarse step
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      400: badRequestError
+		//      503: serviceUnavailableError
+		//      200: trendsResponse
+		//
+		{
+			"NamespaceTrends",
+			"GET",
+			"/api/namespaces/{namespace}/trends",
+			handlers.NamespaceTrends(handlers.DefaultPromClientSupplier),
+			true,
+		},
+		// swagger:route GET /clusters/health cluster namespaces Health
+		// ---
+		// Get health for all objects in namespaces of the given cluster
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      200: clustersNamespaceHealthResponse
+		//      400: badRequestError
+		//      500: internalError
+		//
+		{
+			"ClustersHealth",
+			"GET",
+			"/api/clusters/health",
+			handlers.ClustersHealth,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/health/events namespaces namespaceHealthEvents
+		// ---
+		// Follow the transitions of the health status of the apps of the given namespace as server-sent events
+		//
+		//     Produces:
+		//     - text/event-stream
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      200: namespaceHealthEventsResponse
+		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
+		//
+		{
+			"NamespaceHealthEvents",
+			"GET",
+			"/api/namespaces/{namespace}/health/events",
+			handlers.NamespaceHealthEvents,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/slo namespaces namespaceSLO
+		// ---
+		// Get the SLO status of the services of the given namespace
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      200: namespaceSLOResponse
+		//      500: internalError
+		//
+		{
+			"NamespaceSLO",
+			"GET",
+			"/api/namespaces/{namespace}/slo",
+			handlers.NamespaceSLO,
+			true,
+		},
+		// swagger:route GET /namespaces/{namespace}/traffic/findings namespaces namespaceTrafficFindings
+		// ---
+		// Get the services of the given namespace that stopped receiving traffic or are new destinations, compared to the traffic baseline
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      200: namespaceTrafficFindingsResponse
+		//      500: internalError
+		//
+		{
+			"NamespaceTrafficFindings",
 			"GET",
-			"/api/namespaces/{namespace}/workloads/{workload}/traces",
-			handlers.WorkloadTraces,
+			"/api/namespaces/{namespace}/traffic/findings",
+			handlers.NamespaceTrafficFindings,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/apps/{app}/errortraces traces errorTraces
+		// swagger:route GET /namespaces/{namespace}/istio/gateways/{gateway}/traffic config gatewayTraffic
 		// ---
-		// Endpoint to get the number of traces in error for a given service
+		// Get the traffic going through the workloads of a Gateway, broken down by the HTTP routes of the VirtualServices bound to it.
+		// The TCP and TLS routes are not reported.
 		//
 		//     Produces:
 		//     - application/json
@@ -565,20 +1833,23 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: gatewayTrafficResponse
+		//      400: badRequestError
 		//      404: notFoundError
 		//      500: internalError
-		//      200: errorTracesResponse
+		//      503: serviceUnavailableError
 		//
 		{
-			"ErrorTraces",
+			"GatewayTraffic",
 			"GET",
-			"/api/namespaces/{namespace}/apps/{app}/errortraces",
-			handlers.ErrorTraces,
+			"/api/namespaces/{namespace}/istio/gateways/{gateway}/traffic",
+			handlers.GatewayTraffic,
 			true,
 		},
-		// swagger:route GET /traces/{traceID} traces traceDetails
+		// swagger:route GET /namespaces/{namespace}/istio/destinationrules/{destinationrule}/trafficpolicies config destinationRuleTrafficPolicies
 		// ---
-		// Endpoint to get a specific trace from ID
+		// Get how the traffic policy of a DestinationRule combines with the defaults of its namespace and of the mesh,
+		// its port level settings and its subsets
 		//
 		//     Produces:
 		//     - application/json
@@ -586,20 +1857,21 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: destinationRuleTrafficPoliciesResponse
 		//      404: notFoundError
 		//      500: internalError
-		//      200: traceDetailsResponse
 		//
 		{
-			"TracesDetails",
+			"DestinationRuleTrafficPolicies",
 			"GET",
-			"/api/traces/{traceID}",
-			handlers.TraceDetails,
+			"/api/namespaces/{namespace}/istio/destinationrules/{destinationrule}/trafficpolicies",
+			handlers.DestinationRuleTrafficPolicies,
 			true,
 		},
-		// swagger:route GET /clusters/workloads workloads workloadList
+		// swagger:route GET /namespaces/{namespace}/istio/virtualservices/{virtualservice}/cors config virtualServiceCors
 		// ---
-		// Endpoint to get the list of workloads for a cluster
+		// Get the effective CORS behavior of the routes of a VirtualService and, for a given origin, the CORS headers
+		// added to the responses
 		//
 		//     Produces:
 		//     - application/json
@@ -607,19 +1879,20 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: virtualServiceCorsResponse
+		//      404: notFoundError
 		//      500: internalError
-		//      200: workloadListResponse
 		//
 		{
-			"ClustersWorkloads",
+			"VirtualServiceCors",
 			"GET",
-			"/api/clusters/workloads",
-			handlers.ClustersWorkloads,
+			"/api/namespaces/{namespace}/istio/virtualservices/{virtualservice}/cors",
+			handlers.VirtualServiceCors,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/workloads/{workload} workloads workloadDetails
+		// swagger:route GET /namespaces/{namespace}/istio/wasmplugins/{wasmplugin}/status config wasmPluginStatus
 		// ---
-		// Endpoint to get the workload details
+		// Get the rollout of a WasmPlugin: whether Kiali can fetch its module and the workloads it attaches to
 		//
 		//     Produces:
 		//     - application/json
@@ -627,23 +1900,21 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      500: internalError
+		//      200: wasmPluginStatusResponse
 		//      404: notFoundError
-		//      200: workloadDetails
+		//      500: internalError
 		//
 		{
-			"WorkloadDetails",
+			"WasmPluginStatus",
 			"GET",
-			"/api/namespaces/{namespace}/workloads/{workload}",
-			handlers.WorkloadDetails,
+			"/api/namespaces/{namespace}/istio/wasmplugins/{wasmplugin}/status",
+			handlers.WasmPluginStatus,
 			true,
 		},
-		// swagger:route PATCH /namespaces/{namespace}/workloads/{workload} workloads workloadUpdate
+		// swagger:route GET /namespaces/{namespace}/traffic/egress namespaces namespaceEgressReport
 		// ---
-		// Endpoint to update the Workload configuration using Json Merge Patch strategy.
-		//
-		//     Consumes:
-		//	   - application/json
+		// Get the outbound traffic of the given namespace to destinations not covered by any ServiceEntry,
+		// i.e. handled by the PassthroughCluster or the BlackHoleCluster
 		//
 		//     Produces:
 		//     - application/json
@@ -651,21 +1922,21 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: namespaceEgressReportResponse
 		//      400: badRequestError
-		//      404: notFoundError
 		//      500: internalError
-		//      200: workloadDetails
 		//
 		{
-			"WorkloadUpdate",
-			"PATCH",
-			"/api/namespaces/{namespace}/workloads/{workload}",
-			handlers.WorkloadUpdate,
+			"NamespaceEgressReport",
+			"GET",
+			"/api/namespaces/{namespace}/traffic/egress",
+			handlers.NamespaceEgressReport,
 			true,
 		},
-		// swagger:route GET /clusters/apps apps appList
+		// swagger:route GET /namespaces/{namespace}/traffic/egress/sidecar namespaces namespaceSidecarGenerate
 		// ---
-		// Endpoint to get the list of apps for a cluster
+		// Preview the namespace-wide Sidecar restricting the egress of the given namespace to the Services and
+		// ServiceEntries its workloads sent traffic to during the traffic window
 		//
 		//     Produces:
 		//     - application/json
@@ -673,19 +1944,22 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: generatedSidecarResponse
+		//      400: badRequestError
+		//      403: forbiddenError
 		//      500: internalError
-		//      200: appListResponse
 		//
 		{
-			"ClustersApps",
+			"NamespaceSidecarGenerate",
 			"GET",
-			"/api/clusters/apps",
-			handlers.ClustersApps,
+			"/api/namespaces/{namespace}/traffic/egress/sidecar",
+			handlers.NamespaceSidecarGenerate,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/apps/{app} apps appDetails
+		// swagger:route POST /namespaces/{namespace}/traffic/egress/sidecar namespaces namespaceSidecarApply
 		// ---
-		// Endpoint to get the app details
+		// Create or update the namespace-wide Sidecar restricting the egress of the given namespace to the Services and
+		// ServiceEntries its workloads sent traffic to during the traffic window
 		//
 		//     Produces:
 		//     - application/json
@@ -693,20 +1967,22 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: generatedSidecarResponse
+		//      400: badRequestError
+		//      403: forbiddenError
 		//      500: internalError
-		//      404: notFoundError
-		//      200: appDetails
 		//
 		{
-			"AppDetails",
-			"GET",
-			"/api/namespaces/{namespace}/apps/{app}",
-			handlers.AppDetails,
+			"NamespaceSidecarApply",
+			"POST",
+			"/api/namespaces/{namespace}/traffic/egress/sidecar",
+			handlers.NamespaceSidecarApply,
 			true,
 		},
-		// swagger:route GET /namespaces namespaces namespaceList
+		// swagger:route GET /namespaces/{namespace}/traffic/egress/dns namespaces namespaceDNSCapture
 		// ---
-		// Endpoint to get the list of the available namespaces
+		// Get the DNS capture of the workloads of the given namespace and the addresses, declared or auto-allocated,
+		// answered by their sidecars to the DNS queries of the hosts of the ServiceEntries
 		//
 		//     Produces:
 		//     - application/json
@@ -714,22 +1990,20 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: namespaceDNSCaptureResponse
+		//      400: badRequestError
 		//      500: internalError
-		//      200: namespaceList
 		//
 		{
-			"NamespaceList",
+			"NamespaceDNSCapture",
 			"GET",
-			"/api/namespaces",
-			handlers.NamespaceList,
+			"/api/namespaces/{namespace}/traffic/egress/dns",
+			handlers.NamespaceDNSCapture,
 			true,
 		},
-		// swagger:route PATCH /namespaces/{namespace} namespaces namespaceUpdate
+		// swagger:route GET /namespaces/{namespace}/services/{service}/slo services serviceSLO
 		// ---
-		// Endpoint to update the Namespace configuration using Json Merge Patch strategy.
-		//
-		//     Consumes:
-		//	   - application/json
+		// Get the SLO status of the given service
 		//
 		//     Produces:
 		//     - application/json
@@ -737,21 +2011,21 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      400: badRequestError
+		//      200: serviceSLOResponse
 		//      404: notFoundError
 		//      500: internalError
-		//      200: namespaceResponse
+		//      503: serviceUnavailableError
 		//
 		{
-			"NamespaceUpdate",
-			"PATCH",
-			"/api/namespaces/{namespace}",
-			handlers.NamespaceUpdate,
+			"ServiceSLO",
+			"GET",
+			"/api/namespaces/{namespace}/services/{service}/slo",
+			handlers.ServiceSLO,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/info namespaces namespaceInfo
+		// swagger:route GET /namespaces/{namespace}/services/{service}/effectiveconfig services serviceEffectiveConfig
 		// ---
-		// Endpoint to get info about a single namespace
+		// Endpoint to compute the Istio config applied to the traffic of a service
 		//
 		//     Produces:
 		//     - application/json
@@ -759,19 +2033,20 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: serviceEffectiveConfigResponse
+		//      404: notFoundError
 		//      500: internalError
-		//      200: namespaceList
 		//
 		{
-			"NamespaceInfo",
+			"ServiceEffectiveConfig",
 			"GET",
-			"/api/namespaces/{namespace}/info",
-			handlers.NamespaceInfo,
+			"/api/namespaces/{namespace}/services/{service}/effectiveconfig",
+			handlers.ServiceEffectiveConfig,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/services/{service}/metrics services serviceMetrics
+		// swagger:route GET /namespaces/{namespace}/services/{service}/subsets services serviceSubsets
 		// ---
-		// Endpoint to fetch metrics to be displayed, related to a single service
+		// Endpoint to preview the DestinationRule subsets generated for the versions of the workloads of a service
 		//
 		//     Produces:
 		//     - application/json
@@ -780,19 +2055,21 @@ func NewRoutes(
 		//
 		// responses:
 		//      400: badRequestError
-		//      503: serviceUnavailableError
-		//      200: metricsResponse
+		//      403: forbiddenError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: serviceSubsetsResponse
 		//
 		{
-			"ServiceMetrics",
+			"ServiceSubsets",
 			"GET",
-			"/api/namespaces/{namespace}/services/{service}/metrics",
-			handlers.ServiceMetrics,
+			"/api/namespaces/{namespace}/services/{service}/subsets",
+			handlers.ServiceSubsets,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/aggregates/{aggregate}/{aggregateValue}/metrics aggregates aggregateMetrics
+		// swagger:route POST /namespaces/{namespace}/services/{service}/subsets services serviceSubsetsApply
 		// ---
-		// Endpoint to fetch metrics to be displayed, related to a single aggregate
+		// Endpoint to create or update the DestinationRule of a service with a subset for every version of its workloads
 		//
 		//     Produces:
 		//     - application/json
@@ -801,19 +2078,22 @@ func NewRoutes(
 		//
 		// responses:
 		//      400: badRequestError
-		//      503: serviceUnavailableError
-		//      200: metricsResponse
+		//      403: forbiddenError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: serviceSubsetsResponse
 		//
 		{
-			"AggregateMetrics",
-			"GET",
-			"/api/namespaces/{namespace}/aggregates/{aggregate}/{aggregateValue}/metrics",
-			handlers.AggregateMetrics,
+			"ServiceSubsetsApply",
+			"POST",
+			"/api/namespaces/{namespace}/services/{service}/subsets",
+			handlers.ServiceSubsetsApply,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/apps/{app}/metrics apps appMetrics
+		// swagger:route GET /namespaces/{namespace}/services/{service}/authorizationpolicy services serviceAuthorizationPolicyGenerate
 		// ---
-		// Endpoint to fetch metrics to be displayed, related to a single app
+		// Endpoint to preview the AuthorizationPolicy allowing only the sources observed calling a service during the
+		// traffic window, by principal or by namespace
 		//
 		//     Produces:
 		//     - application/json
@@ -822,19 +2102,22 @@ func NewRoutes(
 		//
 		// responses:
 		//      400: badRequestError
-		//      503: serviceUnavailableError
-		//      200: metricsResponse
+		//      403: forbiddenError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: generatedAuthorizationPolicyResponse
 		//
 		{
-			"AppMetrics",
+			"ServiceAuthorizationPolicyGenerate",
 			"GET",
-			"/api/namespaces/{namespace}/apps/{app}/metrics",
-			handlers.AppMetrics,
+			"/api/namespaces/{namespace}/services/{service}/authorizationpolicy",
+			handlers.ServiceAuthorizationPolicyGenerate,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/metrics workloads workloadMetrics
+		// swagger:route POST /namespaces/{namespace}/services/{service}/authorizationpolicy services serviceAuthorizationPolicyApply
 		// ---
-		// Endpoint to fetch metrics to be displayed, related to a single workload
+		// Endpoint to create or update the AuthorizationPolicy allowing only the sources observed calling a service
+		// during the traffic window, by principal or by namespace
 		//
 		//     Produces:
 		//     - application/json
@@ -843,19 +2126,25 @@ func NewRoutes(
 		//
 		// responses:
 		//      400: badRequestError
-		//      503: serviceUnavailableError
-		//      200: metricsResponse
+		//      403: forbiddenError
+		//      404: notFoundError
+		//      500: internalError
+		//      200: generatedAuthorizationPolicyResponse
 		//
 		{
-			"WorkloadMetrics",
-			"GET",
-			"/api/namespaces/{namespace}/workloads/{workload}/metrics",
-			handlers.WorkloadMetrics,
+			"ServiceAuthorizationPolicyApply",
+			"POST",
+			"/api/namespaces/{namespace}/services/{service}/authorizationpolicy",
+			handlers.ServiceAuthorizationPolicyApply,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/controlplanes/{controlplane}/metrics controlplanes controlPlaneMetrics
+		// swagger:route POST /namespaces/{namespace}/services/{service}/testrequest services serviceTestRequest
 		// ---
-		// Endpoint to fetch metrics to be displayed, related to a single control plane
+		// Endpoint to send an HTTP request to a service from a test pod of the mesh, returning the status, the latency
+		// and the VirtualService route matched by the request
+		//
+		//     Consumes:
+		//     - application/json
 		//
 		//     Produces:
 		//     - application/json
@@ -864,19 +2153,23 @@ func NewRoutes(
 		//
 		// responses:
 		//      400: badRequestError
+		//      403: forbiddenError
+		//      404: notFoundError
+		//      500: internalError
 		//      503: serviceUnavailableError
-		//      200: metricsResponse
+		//      200: testRequestResponse
 		//
 		{
-			"ControlPlaneMetrics",
-			"GET",
-			"/api/namespaces/{namespace}/controlplanes/{controlplane}/metrics",
-			handlers.ControlPlaneMetrics(handlers.DefaultPromClientSupplier),
+			"ServiceTestRequest",
+			"POST",
+			"/api/namespaces/{namespace}/services/{service}/testrequest",
+			handlers.ServiceTestRequest,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/services/{service}/dashboard services serviceDashboard
+		// swagger:route GET /namespaces/{namespace}/services/{service}/locality services serviceLocality
 		// ---
-		// Endpoint to fetch dashboard to be displayed, related to a single service
+		// Endpoint to get the locality load balancing settings of the mesh and of the DestinationRules applying to a
+		// service, with the localities of its endpoints
 		//
 		//     Produces:
 		//     - application/json
@@ -884,20 +2177,20 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      400: badRequestError
-		//      503: serviceUnavailableError
-		//      200: dashboardResponse
+		//      404: notFoundError
+		//      500: internalError
+		//      200: serviceLocalityResponse
 		//
 		{
-			"ServiceDashboard",
+			"ServiceLocality",
 			"GET",
-			"/api/namespaces/{namespace}/services/{service}/dashboard",
-			handlers.ServiceDashboard(conf, grafana),
+			"/api/namespaces/{namespace}/services/{service}/locality",
+			handlers.ServiceLocality,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/apps/{app}/dashboard apps appDashboard
+		// swagger:route GET /namespaces/{namespace}/validations namespaces namespaceValidations
 		// ---
-		// Endpoint to fetch dashboard to be displayed, related to a single app
+		// Get validation summary for all objects in the given namespace
 		//
 		//     Produces:
 		//     - application/json
@@ -905,20 +2198,20 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      200: namespaceValidationSummaryResponse
 		//      400: badRequestError
-		//      503: serviceUnavailableError
-		//      200: dashboardResponse
+		//      500: internalError
 		//
 		{
-			"AppDashboard",
+			"NamespaceValidationSummary",
 			"GET",
-			"/api/namespaces/{namespace}/apps/{app}/dashboard",
-			handlers.AppDashboard(conf, grafana),
+			"/api/namespaces/{namespace}/validations",
+			handlers.NamespaceValidationSummary(discovery),
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/workloads/{workload}/dashboard workloads workloadDashboard
+		// swagger:route POST /namespaces/{namespace}/reports namespaces namespaceReportCreate
 		// ---
-		// Endpoint to fetch dashboard to be displayed, related to a single workload
+		// Endpoint to start the generation of the report of the mesh status of a namespace, as HTML or PDF
 		//
 		//     Produces:
 		//     - application/json
@@ -926,20 +2219,23 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
+		//      202: reportJobResponse
 		//      400: badRequestError
+		//      403: forbiddenError
+		//      500: internalError
 		//      503: serviceUnavailableError
-		//      200: dashboardResponse
 		//
 		{
-			"WorkloadDashboard",
-			"GET",
-			"/api/namespaces/{namespace}/workloads/{workload}/dashboard",
-			handlers.WorkloadDashboard(conf, grafana),
+			"NamespaceReportCreate",
+			"POST",
+			"/api/namespaces/{namespace}/reports",
+			handlers.NamespaceReportCreate,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/customdashboard/{dashboard} dashboards customDashboard
+		// swagger:route POST /istio/validations/runs reports validationsRunCreate
 		// ---
-		// Endpoint to fetch a custom dashboard
+		// Endpoint to start the validation of the Istio config of the namespaces of a cluster, as a report job
+		// reporting the progress of each namespace
 		//
 		//     Produces:
 		//     - application/json
@@ -947,20 +2243,21 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      400: badRequestError
+		//      202: reportJobResponse
+		//      403: forbiddenError
+		//      500: internalError
 		//      503: serviceUnavailableError
-		//      200: dashboardResponse
 		//
 		{
-			"CustomDashboard",
-			"GET",
-			"/api/namespaces/{namespace}/customdashboard/{dashboard}",
-			handlers.CustomDashboard(conf, grafana),
+			"ValidationsRunCreate",
+			"POST",
+			"/api/istio/validations/runs",
+			handlers.ValidationsRunCreate,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/metrics namespaces namespaceMetrics
+		// swagger:route GET /reports reports reportJobs
 		// ---
-		// Endpoint to fetch metrics to be displayed, related to a namespace
+		// Endpoint to list the report jobs of the user, the newest first
 		//
 		//     Produces:
 		//     - application/json
@@ -968,20 +2265,18 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      400: badRequestError
-		//      503: serviceUnavailableError
-		//      200: metricsResponse
+		//      200: reportJobsResponse
 		//
 		{
-			"NamespaceMetrics",
+			"ReportJobs",
 			"GET",
-			"/api/namespaces/{namespace}/metrics",
-			handlers.NamespaceMetrics(handlers.DefaultPromClientSupplier),
+			"/api/reports",
+			handlers.ReportJobs,
 			true,
 		},
-		// swagger:route GET /clusters/health cluster namespaces Health
+		// swagger:route GET /reports/{report} reports reportJob
 		// ---
-		// Get health for all objects in namespaces of the given cluster
+		// Endpoint to get a report job of the user
 		//
 		//     Produces:
 		//     - application/json
@@ -989,36 +2284,36 @@ func NewRoutes(
 		//     Schemes: http, https
 		//
 		// responses:
-		//      200: clustersNamespaceHealthResponse
-		//      400: badRequestError
-		//      500: internalError
+		//      200: reportJobResponse
+		//      404: notFoundError
 		//
 		{
-			"ClustersHealth",
+			"ReportJob",
 			"GET",
-			"/api/clusters/health",
-			handlers.ClustersHealth,
+			"/api/reports/{report}",
+			handlers.ReportJob,
 			true,
 		},
-		// swagger:route GET /namespaces/{namespace}/validations namespaces namespaceValidations
+		// swagger:route GET /reports/{report}/content reports reportContent
 		// ---
-		// Get validation summary for all objects in the given namespace
+		// Endpoint to download the report generated by a job of the user
 		//
 		//     Produces:
+		//     - text/html
 		//     - application/json
+		//     - application/pdf
 		//
 		//     Schemes: http, https
 		//
 		// responses:
-		//      200: namespaceValidationSummaryResponse
-		//      400: badRequestError
-		//      500: internalError
+		//      200: reportContentResponse
+		//      404: notFoundError
 		//
 		{
-			"NamespaceValidationSummary",
+			"ReportContent",
 			"GET",
-			"/api/namespaces/{namespace}/validations",
-			handlers.NamespaceValidationSummary(discovery),
+			"/api/reports/{report}/content",
+			handlers.ReportContent,
 			true,
 		},
 		// swagger:route GET /istio/validations namespaces namespacesValidations
@@ -1063,6 +2358,27 @@ func NewRoutes(
 			handlers.MeshTls,
 			true,
 		},
+		// swagger:route GET /mesh/tls/overview tls meshTlsOverview
+		// ---
+		// Get the effective mTLS mode of the whole mesh and of each namespace
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      200: meshTlsOverviewResponse
+		//      400: badRequestError
+		//      500: internalError
+		//
+		{
+			"MeshTlsOverview",
+			"GET",
+			"/api/mesh/tls/overview",
+			handlers.MeshTlsOverview,
+			true,
+		},
 		// swagger:route GET /namespaces/{namespace}/tls tls namespaceTls
 		// ---
 		// Get TLS status for the given namespace
@@ -1421,6 +2737,28 @@ func NewRoutes(
 			handlers.ConfigDump,
 			true,
 		},
+		// swagger:route GET /namespaces/{namespace}/pods/{pod}/routes/verify pods podRoutesVerify
+		// ---
+		// Endpoint to check whether the last change of a VirtualService is live in the pod proxy
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      404: notFoundError
+		//      400: badRequestError
+		//      200: envoyRouteVerificationResponse
+		//
+		{
+			"PodRoutesVerify",
+			"GET",
+			"/api/namespaces/{namespace}/pods/{pod}/routes/verify",
+			handlers.VerifyVirtualServiceRoutes,
+			true,
+		},
 		// swagger:route GET /namespaces/{namespace}/pods/{pod}/config_dump/{resource} pods podProxyResource
 		// ---
 		// Endpoint to get pod logs
@@ -1463,6 +2801,27 @@ func NewRoutes(
 			handlers.ConfigDumpZtunnel,
 			true,
 		},
+		// swagger:route GET /namespaces/{namespace}/pods/{pod}/logging pods podProxyLoggingLevels
+		// ---
+		// Endpoint to get the log level of each logger of the pod proxy
+		//
+		//     Produces:
+		//     - application/json
+		//
+		//     Schemes: http, https
+		//
+		// responses:
+		//      500: internalError
+		//      404: notFoundError
+		//      200: proxyLogLevelsResponse
+		//
+		{
+			"PodProxyLoggingLevels",
+			"GET",
+			"/api/namespaces/{namespace}/pods/{pod}/logging",
+			handlers.LoggingLevels,
+			true,
+		},
 		// swagger:route POST /namespaces/{namespace}/pods/{pod}/logging pods podProxyLogging
 		// ---
 		// Endpoint to set pod proxy log level
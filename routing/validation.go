@@ -0,0 +1,117 @@
+package routing
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/handlers"
+)
+
+// allowedContentTypes are the media types of the request bodies accepted by the API.
+var allowedContentTypes = map[string]bool{
+	"application/json":                  true,
+	"application/json-patch+json":       true,
+	"application/merge-patch+json":      true,
+	"application/x-www-form-urlencoded": true,
+	"application/x-yaml":                true,
+	"application/yaml":                  true,
+	"multipart/form-data":               true,
+	"text/yaml":                         true,
+}
+
+// pathValidators check the path variables of the routes, by variable name.
+var pathValidators = map[string]func(string) []string{
+	"namespace": validation.IsDNS1123Label,
+	"object":    validation.IsDNS1123Subdomain,
+	"pod":       validation.IsDNS1123Subdomain,
+	"service":   validation.IsDNS1123Subdomain,
+	"workload":  validation.IsDNS1123Subdomain,
+}
+
+// queryValidators check the query params of the routes, by param name.
+var queryValidators = map[string]func(string) error{
+	"duration":     validateSecondsOrDuration,
+	"queryTime":    validateUnixTime,
+	"rateInterval": validateDuration,
+	"step":         validateSecondsOrDuration,
+}
+
+// validateSecondsOrDuration accepts a number of seconds or a Prometheus duration, i.e. 600 or 10m.
+func validateSecondsOrDuration(value string) error {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if seconds < 0 {
+			return fmt.Errorf("must not be negative")
+		}
+		return nil
+	}
+	return validateDuration(value)
+}
+
+func validateDuration(value string) error {
+	_, err := model.ParseDuration(value)
+	return err
+}
+
+func validateUnixTime(value string) error {
+	_, err := strconv.ParseInt(value, 10, 64)
+	return err
+}
+
+// maxBodySize returns the limit of the request bodies of a route, 0 or less meaning no limit.
+func maxBodySize(limits config.RequestLimits, route Route) int64 {
+	if size, ok := limits.RouteMaxBodySizes[route.Name]; ok {
+		return size
+	}
+	return limits.MaxBodySize
+}
+
+// validationHandler rejects the requests with an invalid path variable or query param, too big a body, or a body of
+// an unexpected content type before they reach the handler, so that the business layer only gets well formed input.
+func validationHandler(next http.Handler, route Route, conf *config.Config) http.Handler {
+	limit := maxBodySize(conf.Server.RequestLimits, route)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for name, value := range mux.Vars(r) {
+			if validate, ok := pathValidators[name]; ok {
+				if errs := validate(value); len(errs) > 0 {
+					handlers.RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid %s [%s]: %s", name, value, errs[0]))
+					return
+				}
+			}
+		}
+
+		query := r.URL.Query()
+		for name, validate := range queryValidators {
+			if value := query.Get(name); value != "" {
+				if err := validate(value); err != nil {
+					handlers.RespondWithError(w, http.StatusBadRequest, fmt.Sprintf("Invalid query param %s [%s]: %s", name, value, err))
+					return
+				}
+			}
+		}
+
+		if r.Body != nil && r.ContentLength != 0 {
+			if limit > 0 {
+				if r.ContentLength > limit {
+					handlers.RespondWithError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("Request body is larger than %d bytes", limit))
+					return
+				}
+				// The length is unknown for chunked bodies, the reader fails when the limit is exceeded
+				r.Body = http.MaxBytesReader(w, r.Body, limit)
+			}
+			mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil || !allowedContentTypes[mediaType] {
+				handlers.RespondWithError(w, http.StatusUnsupportedMediaType, fmt.Sprintf("Unsupported content type [%s]", r.Header.Get("Content-Type")))
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,115 @@
+package routing
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+)
+
+func TestValidationHandler(t *testing.T) {
+	conf := config.NewConfig()
+	conf.Server.RequestLimits.MaxBodySize = 16
+	conf.Server.RequestLimits.RouteMaxBodySizes = map[string]int64{"Unlimited": -1}
+
+	newRouter := func(name string) *mux.Router {
+		route := Route{Name: name}
+		router := mux.NewRouter()
+		router.Handle("/api/namespaces/{namespace}/istio/{object}", validationHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, err := io.ReadAll(r.Body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}), route, conf))
+		return router
+	}
+
+	cases := map[string]struct {
+		route       string
+		method      string
+		url         string
+		body        string
+		contentType string
+		expected    int
+	}{
+		"valid request": {
+			method:   http.MethodGet,
+			url:      "/api/namespaces/bookinfo/istio/reviews?duration=600&step=15s&queryTime=1700000000",
+			expected: http.StatusOK,
+		},
+		"invalid namespace": {
+			method:   http.MethodGet,
+			url:      "/api/namespaces/Book_Info/istio/reviews",
+			expected: http.StatusBadRequest,
+		},
+		"invalid object name": {
+			method:   http.MethodGet,
+			url:      "/api/namespaces/bookinfo/istio/-reviews",
+			expected: http.StatusBadRequest,
+		},
+		"invalid duration": {
+			method:   http.MethodGet,
+			url:      "/api/namespaces/bookinfo/istio/reviews?duration=ten",
+			expected: http.StatusBadRequest,
+		},
+		"json body": {
+			method:      http.MethodPost,
+			url:         "/api/namespaces/bookinfo/istio/reviews",
+			body:        `{"spec":{}}`,
+			contentType: "application/json; charset=utf-8",
+			expected:    http.StatusOK,
+		},
+		"yaml body": {
+			method:      http.MethodPost,
+			url:         "/api/namespaces/bookinfo/istio/reviews",
+			body:        "spec: {}",
+			contentType: "application/yaml",
+			expected:    http.StatusOK,
+		},
+		"unsupported content type": {
+			method:      http.MethodPost,
+			url:         "/api/namespaces/bookinfo/istio/reviews",
+			body:        `{"spec":{}}`,
+			contentType: "text/plain",
+			expected:    http.StatusUnsupportedMediaType,
+		},
+		"too large body": {
+			method:      http.MethodPost,
+			url:         "/api/namespaces/bookinfo/istio/reviews",
+			body:        `{"spec":{"hosts":["reviews"]}}`,
+			contentType: "application/json",
+			expected:    http.StatusRequestEntityTooLarge,
+		},
+		"route without limit": {
+			route:       "Unlimited",
+			method:      http.MethodPost,
+			url:         "/api/namespaces/bookinfo/istio/reviews",
+			body:        `{"spec":{"hosts":["reviews"]}}`,
+			contentType: "application/json",
+			expected:    http.StatusOK,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var body io.Reader
+			if tc.body != "" {
+				body = strings.NewReader(tc.body)
+			}
+			req := httptest.NewRequest(tc.method, tc.url, body)
+			if tc.contentType != "" {
+				req.Header.Set("Content-Type", tc.contentType)
+			}
+			rr := httptest.NewRecorder()
+			newRouter(tc.route).ServeHTTP(rr, req)
+			require.Equal(t, tc.expected, rr.Code)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/kiali/kiali/config"
+)
+
+// corsPolicy is the CORS configuration of the server, prepared to answer the requests.
+type corsPolicy struct {
+	allowAll         bool
+	allowCredentials bool
+	headers          string
+	maxAge           string
+	methods          string
+	origins          map[string]bool
+	// suffixes are the allowed subdomain wildcards, as "scheme://" and ".domain" pairs.
+	suffixes [][2]string
+}
+
+func newCORSPolicy(conf *config.Config) *corsPolicy {
+	cors := conf.Server.CORS
+	policy := &corsPolicy{
+		allowAll:         conf.Server.CORSAllowAll,
+		allowCredentials: cors.AllowCredentials,
+		headers:          strings.Join(cors.AllowedHeaders, ", "),
+		methods:          strings.Join(append([]string{http.MethodOptions}, cors.AllowedMethods...), ", "),
+		origins:          map[string]bool{},
+	}
+	if cors.MaxAge > 0 {
+		policy.maxAge = strconv.Itoa(cors.MaxAge)
+	}
+	for _, origin := range cors.AllowedOrigins {
+		origin = strings.ToLower(strings.TrimSuffix(origin, "/"))
+		if origin == "*" {
+			policy.allowAll = true
+		} else if scheme, host, ok := strings.Cut(origin, "://*."); ok {
+			policy.suffixes = append(policy.suffixes, [2]string{scheme + "://", "." + host})
+		} else {
+			policy.origins[origin] = true
+		}
+	}
+	return policy
+}
+
+func (p *corsPolicy) enabled() bool {
+	return p.allowAll || len(p.origins) > 0 || len(p.suffixes) > 0
+}
+
+// listed returns true when the origin is explicitly allowed, not only by "*".
+func (p *corsPolicy) listed(origin string) bool {
+	origin = strings.ToLower(origin)
+	if p.origins[origin] {
+		return true
+	}
+	for _, suffix := range p.suffixes {
+		if strings.HasPrefix(origin, suffix[0]) && strings.HasSuffix(origin, suffix[1]) && len(origin) > len(suffix[0])+len(suffix[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// corsHandler answers the CORS preflight requests and adds the CORS headers to the responses of the allowed origins.
+// It wraps the router, as the preflight requests don't match the methods of the routes.
+func corsHandler(policy *corsPolicy, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		listed := policy.listed(origin)
+		if !listed && !policy.allowAll {
+			if preflight {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			// The browser doesn't expose the response to the page of a disallowed origin
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The cookies of the session are only sent by the listed origins: allowing them to any origin would allow any
+		// site to use the API on behalf of the users of Kiali
+		if listed {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			if policy.allowCredentials {
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+			}
+		} else {
+			w.Header().Set("Access-Control-Allow-Origin", "*")
+		}
+
+		if !preflight {
+			next.ServeHTTP(w, r)
+			return
+		}
+		w.Header().Add("Vary", "Access-Control-Request-Method")
+		w.Header().Add("Vary", "Access-Control-Request-Headers")
+		w.Header().Set("Access-Control-Allow-Methods", policy.methods)
+		if policy.headers != "" {
+			w.Header().Set("Access-Control-Allow-Headers", policy.headers)
+		}
+		if policy.maxAge != "" {
+			w.Header().Set("Access-Control-Max-Age", policy.maxAge)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
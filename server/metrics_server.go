@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -28,6 +29,17 @@ func StartMetricsServer() {
 	}()
 }
 
+// ShutdownMetricsServer stops the metrics server once its in-flight scrapes complete, or when the context is done.
+func ShutdownMetricsServer(ctx context.Context) {
+	if metricsServer != nil {
+		log.Info("Stopping Metrics Server")
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			metricsServer.Close()
+		}
+		metricsServer = nil
+	}
+}
+
 // StopMetricsServer stops the metrics server
 func StopMetricsServer() {
 	if metricsServer != nil {
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/handlers"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/util/httputil"
+)
+
+const (
+	// CSRFCookieName is the cookie issuing the CSRF token. It is readable by the UI, that sends it back in the CSRF header.
+	CSRFCookieName = "kiali-csrf-token"
+	// CSRFHeaderName is the header carrying the CSRF token of the mutating requests.
+	CSRFHeaderName = "X-Kiali-CSRF-Token"
+)
+
+// isSecure returns true when Kiali is served over https to the client of the request.
+func isSecure(conf *config.Config, r *http.Request) bool {
+	return conf.IsServerHTTPS() || strings.HasPrefix(httputil.GuessKialiURL(conf, r), "https:")
+}
+
+// securityHeaders adds the configured security headers to the responses.
+func securityHeaders(conf *config.Config) mux.MiddlewareFunc {
+	headers := conf.Server.Security.Headers
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for name, value := range map[string]string{
+				"Content-Security-Policy": headers.ContentSecurityPolicy,
+				"X-Content-Type-Options":  headers.ContentTypeOptions,
+				"X-Frame-Options":         headers.FrameOptions,
+				"Referrer-Policy":         headers.ReferrerPolicy,
+			} {
+				if value != "" {
+					w.Header().Set(name, value)
+				}
+			}
+			if headers.StrictTransportSecurity != "" && isSecure(conf, r) {
+				w.Header().Set("Strict-Transport-Security", headers.StrictTransportSecurity)
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// csrfProtection issues a CSRF token to the API clients and verifies it on the mutating API requests (double submit
+// cookie): a cross-site request gets the session cookie but it can't read the token to set the CSRF header.
+// The login and the requests authenticated with a bearer token, that a cross-site request can't set, are not verified,
+// unless the bearer token is set by a proxy (header strategy).
+func csrfProtection(conf *config.Config) mux.MiddlewareFunc {
+	apiPrefix := strings.TrimSuffix(conf.Server.WebRoot, "/") + "/api/"
+	loginPath := apiPrefix + "authenticate"
+	bearerExempted := conf.Auth.Strategy != config.AuthStrategyHeader
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.URL.Path, apiPrefix) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cookie, err := r.Cookie(CSRFCookieName)
+			hasToken := err == nil && cookie.Value != ""
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+			default:
+				bearer := strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ")
+				if r.URL.Path != loginPath && !(bearer && bearerExempted) {
+					if !hasToken || subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(r.Header.Get(CSRFHeaderName))) != 1 {
+						handlers.RespondWithError(w, http.StatusForbidden, "Missing or invalid CSRF token")
+						return
+					}
+				}
+			}
+
+			if !hasToken {
+				token := make([]byte, 32)
+				if _, err := rand.Read(token); err != nil {
+					log.Errorf("Error generating a CSRF token: %s", err)
+				} else {
+					http.SetCookie(w, &http.Cookie{
+						Name:     CSRFCookieName,
+						Value:    base64.RawURLEncoding.EncodeToString(token),
+						Path:     conf.Server.WebRoot,
+						Secure:   isSecure(conf, r),
+						SameSite: http.SameSiteStrictMode,
+					})
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
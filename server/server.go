@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/kiali/kiali/business"
 	"github.com/kiali/kiali/config"
 	"github.com/kiali/kiali/grafana"
+	"github.com/kiali/kiali/handlers"
 	"github.com/kiali/kiali/istio"
 	"github.com/kiali/kiali/kubernetes"
 	"github.com/kiali/kiali/kubernetes/cache"
@@ -61,9 +64,9 @@ func NewServer(controlPlaneMonitor business.ControlPlaneMonitor,
 		tracingProvider = observability.InitTracer(conf.Server.Observability.Tracing.CollectorURL)
 	}
 
-	middlewares := []mux.MiddlewareFunc{}
-	if conf.Server.CORSAllowAll {
-		middlewares = append(middlewares, corsAllowed)
+	middlewares := []mux.MiddlewareFunc{securityHeaders(conf)}
+	if conf.Server.Security.CSRF {
+		middlewares = append(middlewares, csrfProtection(conf))
 	}
 	if conf.Server.Observability.Tracing.Enabled {
 		middlewares = append(middlewares, otelmux.Middleware(observability.TracingService))
@@ -73,7 +76,10 @@ func NewServer(controlPlaneMonitor business.ControlPlaneMonitor,
 
 	handler := http.Handler(router)
 	if conf.Server.GzipEnabled {
-		handler = configureGzipHandler(router)
+		handler = configureCompressionHandler(router, conf.Server.Compression)
+	}
+	if policy := newCORSPolicy(conf); policy.enabled() {
+		handler = corsHandler(policy, handler)
 	}
 
 	// The Kiali server has only a single http server ever during its lifetime. But to support
@@ -104,6 +110,13 @@ func NewServer(controlPlaneMonitor business.ControlPlaneMonitor,
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: writeTimeout,
 	}
+	// The graceful shutdown waits for the in-flight requests, it ends the streams which otherwise last until the
+	// clients leave
+	shutdown := make(chan struct{})
+	httpServer.BaseContext = func(net.Listener) context.Context {
+		return handlers.WithShutdown(context.Background(), shutdown)
+	}
+	httpServer.RegisterOnShutdown(func() { close(shutdown) })
 
 	// return our new Server
 	s := &Server{
@@ -151,6 +164,18 @@ func (s *Server) Start() {
 	}
 }
 
+// Shutdown stops the HTTP server gracefully: it stops accepting new connections and waits for the in-flight requests
+// to complete until the context is done, when the remaining connections are closed.
+func (s *Server) Shutdown(ctx context.Context) {
+	log.Infof("Server endpoint will stop at [%v] once the in-flight requests complete", s.httpServer.Addr)
+	if err := s.httpServer.Shutdown(ctx); err != nil {
+		log.Warningf("Server endpoint closed with in-flight requests: %s", err)
+		s.httpServer.Close()
+	}
+	ShutdownMetricsServer(ctx)
+	observability.StopTracer(s.tracer)
+}
+
 // Stop the HTTP server
 func (s *Server) Stop() {
 	StopMetricsServer()
@@ -159,15 +184,7 @@ func (s *Server) Stop() {
 	observability.StopTracer(s.tracer)
 }
 
-func corsAllowed(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Headers", "Origin, X-Requested-With, Content-Type, Accept")
-		next.ServeHTTP(w, r)
-	})
-}
-
-func configureGzipHandler(handler http.Handler) http.Handler {
+func configureGzipHandlerWithMinSize(handler http.Handler, minSize int) http.Handler {
 	contentTypeOption := gziphandler.ContentTypes([]string{
 		"application/javascript",
 		"application/json",
@@ -175,7 +192,7 @@ func configureGzipHandler(handler http.Handler) http.Handler {
 		"text/css",
 		"text/html",
 	})
-	if handlerFunc, err := gziphandler.GzipHandlerWithOpts(contentTypeOption); err == nil {
+	if handlerFunc, err := gziphandler.GzipHandlerWithOpts(contentTypeOption, gziphandler.MinSize(minSize)); err == nil {
 		return handlerFunc(handler)
 	} else {
 		// This could happen by a wrong configuration being sent to GzipHandlerWithOpts
@@ -183,6 +200,39 @@ func configureGzipHandler(handler http.Handler) http.Handler {
 	}
 }
 
+// configureCompressionHandler compresses the responses bigger than the configured min size.
+// Routes can override the min size, or disable the compression with a negative size.
+func configureCompressionHandler(router *mux.Router, compression config.Compression) http.Handler {
+	defaultHandler := configureGzipHandlerWithMinSize(router, compression.MinSize)
+
+	routeHandlers := make(map[string]http.Handler, len(compression.RouteMinSizes))
+	for name, minSize := range compression.RouteMinSizes {
+		if router.Get(name) == nil {
+			log.Warningf("Ignoring the compression settings of unknown route [%s]", name)
+			continue
+		}
+		if minSize < 0 {
+			routeHandlers[name] = router
+		} else {
+			routeHandlers[name] = configureGzipHandlerWithMinSize(router, minSize)
+		}
+	}
+	if len(routeHandlers) == 0 {
+		return defaultHandler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var match mux.RouteMatch
+		if router.Match(r, &match) && match.Route != nil {
+			if routeHandler, found := routeHandlers[match.Route.GetName()]; found {
+				routeHandler.ServeHTTP(w, r)
+				return
+			}
+		}
+		defaultHandler.ServeHTTP(w, r)
+	})
+}
+
 func plainHttpMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		r.URL.Scheme = "http"
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/tls"
@@ -13,12 +14,14 @@ import (
 	rnd "math/rand"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	rpprof "runtime/pprof"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kiali/kiali/business"
@@ -354,7 +357,243 @@ func TestConfigureGzipHandler(t *testing.T) {
 			t.Errorf("Failed to create Gzip handler [%v]", err)
 		}
 	}()
-	configureGzipHandler(nil)
+	configureCompressionHandler(mux.NewRouter(), config.NewConfig().Server.Compression)
+}
+
+func TestConfigureCompressionHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	body := strings.Repeat("a", 2000)
+	router := mux.NewRouter()
+	for _, name := range []string{"graph", "list", "disabled"} {
+		router.Path("/" + name).Name(name).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = io.WriteString(w, body)
+		})
+	}
+	handler := configureCompressionHandler(router, config.Compression{
+		MinSize:       4000,
+		RouteMinSizes: map[string]int{"graph": 100, "disabled": -1, "unknown": 100},
+	})
+
+	contentEncoding := func(path string) string {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr.Header().Get("Content-Encoding")
+	}
+	assert.Equal("gzip", contentEncoding("/graph"))
+	// Below the default min size
+	assert.Empty(contentEncoding("/list"))
+	assert.Empty(contentEncoding("/disabled"))
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := config.NewConfig()
+	conf.Server.Security.Headers.FrameOptions = "DENY"
+	conf.Server.Security.Headers.StrictTransportSecurity = "max-age=31536000"
+	router := mux.NewRouter()
+	router.Path("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	router.Use(securityHeaders(conf))
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal("DENY", rr.Header().Get("X-Frame-Options"))
+	assert.Equal("nosniff", rr.Header().Get("X-Content-Type-Options"))
+	assert.Equal("same-origin", rr.Header().Get("Referrer-Policy"))
+	assert.Empty(rr.Header().Get("Content-Security-Policy"))
+	// Served over http
+	assert.Empty(rr.Header().Get("Strict-Transport-Security"))
+}
+
+func TestCSRFProtection(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := config.NewConfig()
+	conf.Server.WebRoot = "/kiali"
+	router := mux.NewRouter()
+	router.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	router.Use(csrfProtection(conf))
+
+	serve := func(method, path string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		rr := httptest.NewRecorder()
+		router.ServeHTTP(rr, req)
+		return rr
+	}
+
+	// The token is issued to the API clients
+	rr := serve(http.MethodGet, "/kiali/api/namespaces", nil)
+	assert.Equal(http.StatusOK, rr.Code)
+	cookies := rr.Result().Cookies()
+	assert.Len(cookies, 1)
+	token := cookies[0].Value
+	assert.Equal(CSRFCookieName, cookies[0].Name)
+	assert.NotEmpty(token)
+
+	// The mutating requests must send it back
+	assert.Equal(http.StatusForbidden, serve(http.MethodDelete, "/kiali/api/namespaces/bookinfo", nil).Code)
+	assert.Equal(http.StatusForbidden, serve(http.MethodDelete, "/kiali/api/namespaces/bookinfo", map[string]string{
+		"Cookie":       CSRFCookieName + "=" + token,
+		CSRFHeaderName: "other",
+	}).Code)
+	assert.Equal(http.StatusOK, serve(http.MethodDelete, "/kiali/api/namespaces/bookinfo", map[string]string{
+		"Cookie":       CSRFCookieName + "=" + token,
+		CSRFHeaderName: token,
+	}).Code)
+
+	// The login, the bearer token clients and the static files are not verified
+	assert.Equal(http.StatusOK, serve(http.MethodPost, "/kiali/api/authenticate", nil).Code)
+	assert.Equal(http.StatusOK, serve(http.MethodPost, "/kiali/api/namespaces/bookinfo/istio/bundle", map[string]string{"Authorization": "Bearer token"}).Code)
+	assert.Equal(http.StatusOK, serve(http.MethodPost, "/kiali/console", nil).Code)
+}
+
+func TestCORSHandler(t *testing.T) {
+	assert := assert.New(t)
+
+	conf := config.NewConfig()
+	conf.Server.CORS.AllowCredentials = true
+	conf.Server.CORS.AllowedOrigins = []string{"https://portal.example.com", "https://*.dashboards.example.com"}
+	router := mux.NewRouter()
+	router.Methods(http.MethodGet).Path("/api/namespaces").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	policy := newCORSPolicy(conf)
+	assert.True(policy.enabled())
+	handler := corsHandler(policy, router)
+
+	serve := func(method, origin string, preflight bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/namespaces", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		if preflight {
+			req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	// Same origin requests are not changed
+	rr := serve(http.MethodGet, "", false)
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.Empty(rr.Header().Get("Access-Control-Allow-Origin"))
+
+	rr = serve(http.MethodGet, "https://portal.example.com", false)
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.Equal("https://portal.example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal("true", rr.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal("Origin", rr.Header().Get("Vary"))
+
+	rr = serve(http.MethodOptions, "https://team.dashboards.example.com", true)
+	assert.Equal(http.StatusNoContent, rr.Code)
+	assert.Equal("https://team.dashboards.example.com", rr.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal("OPTIONS, GET, POST, PUT, PATCH, DELETE", rr.Header().Get("Access-Control-Allow-Methods"))
+	assert.Contains(rr.Header().Get("Access-Control-Allow-Headers"), CSRFHeaderName)
+	assert.Equal("600", rr.Header().Get("Access-Control-Max-Age"))
+
+	// Disallowed origins
+	assert.Equal(http.StatusForbidden, serve(http.MethodOptions, "https://evil.com", true).Code)
+	assert.Equal(http.StatusForbidden, serve(http.MethodOptions, "https://dashboards.example.com", true).Code)
+	rr = serve(http.MethodGet, "https://evil.com", false)
+	assert.Equal(http.StatusOK, rr.Code)
+	assert.Empty(rr.Header().Get("Access-Control-Allow-Origin"))
+
+	// Any origin is allowed, without the credentials
+	conf.Server.CORSAllowAll = true
+	handler = corsHandler(newCORSPolicy(conf), router)
+	rr = serve(http.MethodGet, "https://evil.com", false)
+	assert.Equal("*", rr.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(rr.Header().Get("Access-Control-Allow-Credentials"))
+
+	assert.False(newCORSPolicy(config.NewConfig()).enabled())
+}
+
+func TestShutdownDrainsInFlightRequests(t *testing.T) {
+	assert := assert.New(t)
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	listener, err := net.Listen("tcp", testHostname+":0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{httpServer: &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+	})}}
+	go func() { _ = s.httpServer.Serve(listener) }()
+
+	get := func() <-chan error {
+		result := make(chan error, 1)
+		go func() {
+			resp, err := http.Get("http://" + listener.Addr().String())
+			if err == nil {
+				resp.Body.Close()
+			}
+			result <- err
+		}()
+		<-started
+		return result
+	}
+
+	// The in-flight request completes
+	inFlight := get()
+	stopped := make(chan struct{})
+	go func() {
+		s.Shutdown(context.Background())
+		close(stopped)
+	}()
+	assert.Eventually(func() bool {
+		conn, err := net.Dial("tcp", listener.Addr().String())
+		if err == nil {
+			conn.Close()
+		}
+		return err != nil
+	}, 5*time.Second, 10*time.Millisecond, "new connections should be refused")
+	select {
+	case <-stopped:
+		t.Fatal("Shutdown should wait for the in-flight request")
+	default:
+	}
+	close(release)
+	assert.NoError(<-inFlight)
+	<-stopped
+}
+
+func TestShutdownClosesAfterGracePeriod(t *testing.T) {
+	started := make(chan struct{}, 1)
+	listener, err := net.Listen("tcp", testHostname+":0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocked := make(chan struct{})
+	defer close(blocked)
+	s := &Server{httpServer: &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-blocked
+	})}}
+	go func() { _ = s.httpServer.Serve(listener) }()
+
+	result := make(chan error, 1)
+	go func() {
+		resp, err := http.Get("http://" + listener.Addr().String())
+		if err == nil {
+			resp.Body.Close()
+		}
+		result <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	s.Shutdown(ctx)
+	assert.Error(t, <-result)
 }
 
 func getRequestResults(t *testing.T, httpClient *http.Client, url string, credentials *security.Credentials) (string, error) {
@@ -0,0 +1,160 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/grafana"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util/httputil"
+)
+
+// The external services whose connection can be checked
+const (
+	AlertmanagerService = "alertmanager"
+	GrafanaService      = "grafana"
+	PrometheusService   = "prometheus"
+	TracingService      = "tracing"
+)
+
+// ExternalServiceNames are the names of the external services whose connection can be checked, in check order.
+var ExternalServiceNames = []string{PrometheusService, TracingService, GrafanaService, AlertmanagerService}
+
+const connectionCheckTimeout = 10 * time.Second
+
+type alertmanagerResponseVersion struct {
+	VersionInfo struct {
+		Version string `json:"version"`
+	} `json:"versionInfo"`
+}
+
+// connectionCheck describes the request checking the connection to an external service.
+type connectionCheck struct {
+	auth          config.Auth
+	customHeaders map[string]string
+	enabled       bool
+	name          string
+	url           string
+	version       func(body []byte) string
+}
+
+// CheckConnections actively checks the connection and the authentication to the given external services and
+// returns the outcome of each check, so that their misconfiguration can be diagnosed without reading the logs.
+func CheckConnections(ctx context.Context, conf *config.Config, homeClusterSAClient kubernetes.ClientInterface, grafana *grafana.Service, services []string) []models.ExternalServiceConnection {
+	connections := make([]models.ExternalServiceConnection, 0, len(services))
+	for _, service := range services {
+		check := newConnectionCheck(ctx, conf, grafana, service)
+		if check.auth.UseKialiToken {
+			check.auth.Token = homeClusterSAClient.GetToken()
+		}
+		connections = append(connections, check.run())
+	}
+	return connections
+}
+
+func newConnectionCheck(ctx context.Context, conf *config.Config, grafana *grafana.Service, service string) connectionCheck {
+	switch service {
+	case AlertmanagerService:
+		cfg := conf.ExternalServices.Alertmanager
+		check := connectionCheck{auth: cfg.Auth, customHeaders: cfg.CustomHeaders, enabled: cfg.Enabled, name: "Alertmanager"}
+		if cfg.URL != "" {
+			// see https://github.com/prometheus/alertmanager/blob/main/api/v2/openapi.yaml
+			check.url = cfg.URL + "/api/v2/status"
+		}
+		check.version = func(body []byte) string {
+			alertmanagerV := new(alertmanagerResponseVersion)
+			if err := json.Unmarshal(body, &alertmanagerV); err != nil {
+				return ""
+			}
+			return alertmanagerV.VersionInfo.Version
+		}
+		return check
+	case GrafanaService:
+		cfg := conf.ExternalServices.Grafana
+		check := connectionCheck{auth: cfg.Auth, enabled: cfg.Enabled, name: "Grafana"}
+		if cfg.Enabled {
+			check.url = grafana.VersionURL(ctx)
+		}
+		check.version = func(body []byte) string {
+			grafanaV := new(grafanaResponseVersion)
+			if err := json.Unmarshal(body, &grafanaV); err != nil {
+				return ""
+			}
+			return grafanaV.BuildInfo.Version
+		}
+		return check
+	case PrometheusService:
+		cfg := conf.ExternalServices.Prometheus
+		check := connectionCheck{auth: cfg.Auth, customHeaders: cfg.CustomHeaders, enabled: true, name: "Prometheus"}
+		if cfg.URL != "" {
+			// see https://prometheus.io/docs/prometheus/latest/querying/api/#build-information
+			check.url = cfg.URL + "/api/v1/status/buildinfo"
+		}
+		check.version = func(body []byte) string {
+			prometheusV := new(p8sResponseVersion)
+			if err := json.Unmarshal(body, &prometheusV); err != nil {
+				return ""
+			}
+			return prometheusV.Data.Version
+		}
+		return check
+	case TracingService:
+		cfg := conf.ExternalServices.Tracing
+		check := connectionCheck{auth: cfg.Auth, customHeaders: cfg.CustomHeaders, enabled: cfg.Enabled, name: string(cfg.Provider), url: tracingVersionURL(cfg)}
+		if cfg.Provider == config.TempoProvider {
+			check.version = tempoVersion
+		} else {
+			check.version = jaegerVersion
+		}
+		return check
+	}
+	return connectionCheck{name: service}
+}
+
+func (c connectionCheck) run() models.ExternalServiceConnection {
+	connection := models.ExternalServiceConnection{
+		Name:    c.name,
+		Url:     c.url,
+		Enabled: c.enabled,
+		Auth:    models.ExternalServiceAuthUnknown,
+	}
+	if !c.enabled {
+		return connection
+	}
+	if c.url == "" {
+		connection.Error = "The url of the service is not configured"
+		return connection
+	}
+
+	start := time.Now()
+	body, statusCode, _, err := httputil.HttpGet(c.url, &c.auth, connectionCheckTimeout, c.customHeaders, nil)
+	connection.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		connection.Error = err.Error()
+		return connection
+	}
+
+	connection.Reachable = true
+	connection.StatusCode = statusCode
+	switch statusCode {
+	case http.StatusUnauthorized:
+		connection.Auth = models.ExternalServiceAuthFailed
+		connection.Error = "The service rejected the credentials"
+	case http.StatusForbidden:
+		connection.Auth = models.ExternalServiceAuthForbidden
+		connection.Error = "The credentials are not allowed to access the service"
+	default:
+		if statusCode > 399 {
+			connection.Error = fmt.Sprintf("Unexpected status code %d", statusCode)
+			break
+		}
+		connection.Auth = models.ExternalServiceAuthOK
+		connection.Version = c.version(body)
+	}
+	return connection
+}
@@ -0,0 +1,80 @@
+package status
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/grafana"
+	"github.com/kiali/kiali/kubernetes/kubetest"
+	"github.com/kiali/kiali/models"
+)
+
+func TestCheckConnections(t *testing.T) {
+	require := require.New(t)
+
+	prometheus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal("/api/v1/status/buildinfo", r.URL.Path)
+		require.Equal("Bearer secret", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"status":"success","data":{"version":"2.53.0"}}`))
+	}))
+	t.Cleanup(prometheus.Close)
+	alertmanager := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(alertmanager.Close)
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	conf := config.NewConfig()
+	conf.ExternalServices.Prometheus.URL = prometheus.URL
+	conf.ExternalServices.Prometheus.Auth = config.Auth{Type: config.AuthTypeBearer, Token: "secret"}
+	conf.ExternalServices.Alertmanager = config.AlertmanagerConfig{Enabled: true, URL: alertmanager.URL}
+	conf.ExternalServices.Grafana.Enabled = false
+	conf.ExternalServices.Tracing.Enabled = true
+	conf.ExternalServices.Tracing.Provider = config.TempoProvider
+	conf.ExternalServices.Tracing.InternalURL = unreachable.URL
+
+	k8s := kubetest.NewFakeK8sClient()
+	connections := CheckConnections(context.TODO(), conf, k8s, grafana.NewService(conf, k8s), ExternalServiceNames)
+	require.Len(connections, 4)
+
+	prom := connections[0]
+	require.Equal("Prometheus", prom.Name)
+	require.True(prom.Reachable)
+	require.Equal(http.StatusOK, prom.StatusCode)
+	require.Equal(models.ExternalServiceAuthOK, prom.Auth)
+	require.Equal("2.53.0", prom.Version)
+	require.Empty(prom.Error)
+
+	tracing := connections[1]
+	require.Equal("tempo", tracing.Name)
+	require.Equal(unreachable.URL+"/api/status/buildinfo", tracing.Url)
+	require.False(tracing.Reachable)
+	require.Equal(models.ExternalServiceAuthUnknown, tracing.Auth)
+	require.NotEmpty(tracing.Error)
+
+	grafana := connections[2]
+	require.False(grafana.Enabled)
+	require.False(grafana.Reachable)
+	require.Empty(grafana.Url)
+
+	am := connections[3]
+	require.Equal("Alertmanager", am.Name)
+	require.True(am.Reachable)
+	require.Equal(http.StatusUnauthorized, am.StatusCode)
+	require.Equal(models.ExternalServiceAuthFailed, am.Auth)
+	require.Empty(am.Version)
+}
+
+func TestJaegerVersion(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("1.57.0", jaegerVersion([]byte(`<script>const JAEGER_VERSION = {"gitCommit":"abc","gitVersion":"1.57.0"};</script>`)))
+	require.Empty(jaegerVersion([]byte(`<html></html>`)))
+	require.Empty(jaegerVersion([]byte(`const JAEGER_VERSION = {"gitVersion":"1.57.0"}`)))
+}
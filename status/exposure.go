@@ -0,0 +1,303 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	osroutes_v1 "github.com/openshift/api/route/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	k8s_networking_v1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/kubernetes"
+	"github.com/kiali/kiali/kubernetes/cache"
+	"github.com/kiali/kiali/log"
+	"github.com/kiali/kiali/models"
+	"github.com/kiali/kiali/util"
+)
+
+// kialiExposureExpiration is how long the detected exposure of Kiali is reused before looking it up again.
+const kialiExposureExpiration = time.Minute
+
+var kialiExposure struct {
+	sync.Mutex
+	exposure   *models.KialiExposure
+	detectedAt time.Time
+}
+
+// GetKialiExposure returns how Kiali is exposed and its externally reachable URL, used for the absolute links to the
+// console. The public address of the configuration (server.web_fqdn) takes precedence, otherwise the objects routing
+// to the Kiali Service in the home cluster are looked up: OpenShift Routes, Ingresses, Gateway API HTTPRoutes, Istio
+// VirtualServices and then a LoadBalancer Service. When nothing exposes Kiali, it is assumed to be port-forwarded.
+func GetKialiExposure(ctx context.Context, conf *config.Config, clientFactory kubernetes.ClientFactory, kialiCache cache.KialiCache) models.KialiExposure {
+	kialiExposure.Lock()
+	defer kialiExposure.Unlock()
+	if kialiExposure.exposure != nil && time.Since(kialiExposure.detectedAt) < kialiExposureExpiration {
+		return *kialiExposure.exposure
+	}
+
+	exposure := detectKialiExposure(conf, getExposureSources(ctx, conf, clientFactory, kialiCache))
+	kialiExposure.exposure = &exposure
+	kialiExposure.detectedAt = time.Now()
+	return exposure
+}
+
+// GetKialiURL returns the externally reachable URL of Kiali, without a trailing slash.
+func GetKialiURL(ctx context.Context, conf *config.Config, clientFactory kubernetes.ClientFactory, kialiCache cache.KialiCache) string {
+	return GetKialiExposure(ctx, conf, clientFactory, kialiCache).URL
+}
+
+// exposureSources are the objects of the home cluster that can expose Kiali.
+type exposureSources struct {
+	service         *core_v1.Service
+	routes          []osroutes_v1.Route
+	ingresses       []k8s_networking_v1.Ingress
+	httpRoutes      []*gatewayapi_v1.HTTPRoute
+	k8sGateways     []*gatewayapi_v1.Gateway
+	virtualServices []*networking_v1.VirtualService
+	gateways        []*networking_v1.Gateway
+}
+
+// getExposureSources reads the objects that can expose Kiali. The errors are only logged since the detection falls
+// back to port-forward.
+func getExposureSources(ctx context.Context, conf *config.Config, clientFactory kubernetes.ClientFactory, kialiCache cache.KialiCache) exposureSources {
+	sources := exposureSources{}
+	if conf.Server.WebFQDN != "" {
+		return sources
+	}
+
+	namespace := conf.Deployment.Namespace
+	client := clientFactory.GetSAHomeClusterClient()
+	var err error
+	if sources.service, err = client.Kube().CoreV1().Services(namespace).Get(ctx, conf.Deployment.InstanceName, metav1.GetOptions{}); err != nil {
+		log.Debugf("Unable to get the Kiali Service to detect how Kiali is exposed: %v", err)
+		return sources
+	}
+	if client.IsOpenShift() {
+		if sources.routes, err = client.GetRoutes(ctx, namespace); err != nil {
+			log.Debugf("Unable to list the Routes to detect how Kiali is exposed: %v", err)
+		}
+	}
+	if ingresses, err := client.Kube().NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{}); err != nil {
+		log.Debugf("Unable to list the Ingresses to detect how Kiali is exposed: %v", err)
+	} else {
+		sources.ingresses = ingresses.Items
+	}
+
+	kubeCache, err := kialiCache.GetKubeCache(conf.KubernetesConfig.ClusterName)
+	if err != nil {
+		log.Debugf("Unable to get the kube cache of the home cluster to detect how Kiali is exposed: %v", err)
+		return sources
+	}
+	if client.IsGatewayAPI() {
+		if sources.httpRoutes, err = kubeCache.GetK8sHTTPRoutes(ctx, metav1.NamespaceAll, ""); err != nil {
+			log.Debugf("Unable to list the HTTPRoutes to detect how Kiali is exposed: %v", err)
+		}
+		if sources.k8sGateways, err = kubeCache.GetK8sGateways(ctx, metav1.NamespaceAll, ""); err != nil {
+			log.Debugf("Unable to list the Gateway API Gateways to detect how Kiali is exposed: %v", err)
+		}
+	}
+	if sources.virtualServices, err = kubeCache.GetVirtualServices(ctx, metav1.NamespaceAll, ""); err != nil {
+		log.Debugf("Unable to list the VirtualServices to detect how Kiali is exposed: %v", err)
+	}
+	if sources.gateways, err = kubeCache.GetGateways(ctx, metav1.NamespaceAll, ""); err != nil {
+		log.Debugf("Unable to list the Istio Gateways to detect how Kiali is exposed: %v", err)
+	}
+	return sources
+}
+
+func detectKialiExposure(conf *config.Config, sources exposureSources) models.KialiExposure {
+	webRoot := strings.TrimSuffix(conf.Server.WebRoot, "/")
+	kialiURL := func(scheme, host string) string {
+		return scheme + "://" + host + webRoot
+	}
+
+	if conf.Server.WebFQDN != "" {
+		scheme := conf.Server.WebSchema
+		if scheme == "" {
+			scheme = "https"
+		}
+		host := conf.Server.WebFQDN
+		if port := conf.Server.WebPort; port != "" && !(scheme == "http" && port == "80") && !(scheme == "https" && port == "443") {
+			host = net.JoinHostPort(host, port)
+		}
+		return models.KialiExposure{Type: models.KialiExposureConfigured, URL: kialiURL(scheme, host)}
+	}
+
+	namespace := conf.Deployment.Namespace
+	service := conf.Deployment.InstanceName
+	resource := func(o metav1.Object) string {
+		return o.GetNamespace() + "/" + o.GetName()
+	}
+
+	if sources.service != nil {
+		for _, route := range sources.routes {
+			if route.Namespace == namespace && route.Spec.To.Name == service && route.Spec.Host != "" {
+				scheme := "http"
+				if route.Spec.TLS != nil {
+					scheme = "https"
+				}
+				return models.KialiExposure{Type: models.KialiExposureRoute, Resource: resource(&route), URL: kialiURL(scheme, route.Spec.Host)}
+			}
+		}
+
+		for _, ingress := range sources.ingresses {
+			if ingress.Namespace != namespace {
+				continue
+			}
+			for _, rule := range ingress.Spec.Rules {
+				if rule.Host == "" || rule.HTTP == nil {
+					continue
+				}
+				for _, path := range rule.HTTP.Paths {
+					if path.Backend.Service == nil || path.Backend.Service.Name != service {
+						continue
+					}
+					scheme := "http"
+					for _, tls := range ingress.Spec.TLS {
+						if util.InSlice(tls.Hosts, rule.Host) {
+							scheme = "https"
+						}
+					}
+					return models.KialiExposure{Type: models.KialiExposureIngress, Resource: resource(&ingress), URL: kialiURL(scheme, rule.Host)}
+				}
+			}
+		}
+
+		for _, route := range sources.httpRoutes {
+			if len(route.Spec.Hostnames) == 0 || !routesToService(route, namespace, service) {
+				continue
+			}
+			scheme := "http"
+			if hasHTTPSListener(route, sources.k8sGateways) {
+				scheme = "https"
+			}
+			return models.KialiExposure{Type: models.KialiExposureGatewayAPI, Resource: resource(route), URL: kialiURL(scheme, string(route.Spec.Hostnames[0]))}
+		}
+
+		for _, vs := range sources.virtualServices {
+			host := ""
+			for _, h := range vs.Spec.Hosts {
+				if !strings.Contains(h, "*") {
+					host = h
+					break
+				}
+			}
+			if host == "" || len(vs.Spec.Gateways) == 0 || !virtualServiceRoutesToService(vs, namespace, service) {
+				continue
+			}
+			scheme := "http"
+			for _, gw := range sources.gateways {
+				if !isGatewayOfVirtualService(gw, vs) {
+					continue
+				}
+				for _, server := range gw.Spec.Servers {
+					if server.Port != nil && strings.EqualFold(server.Port.Protocol, "HTTPS") {
+						scheme = "https"
+					}
+				}
+			}
+			return models.KialiExposure{Type: models.KialiExposureIstioGateway, Resource: resource(vs), URL: kialiURL(scheme, host)}
+		}
+
+		if sources.service.Spec.Type == core_v1.ServiceTypeLoadBalancer && len(sources.service.Status.LoadBalancer.Ingress) > 0 {
+			lb := sources.service.Status.LoadBalancer.Ingress[0]
+			host := lb.Hostname
+			if host == "" {
+				host = lb.IP
+			}
+			if host != "" {
+				if len(sources.service.Spec.Ports) > 0 {
+					host = net.JoinHostPort(host, fmt.Sprint(sources.service.Spec.Ports[0].Port))
+				}
+				return models.KialiExposure{Type: models.KialiExposureLoadBalancer, Resource: resource(sources.service), URL: kialiURL(serverScheme(conf), host)}
+			}
+		}
+	}
+
+	return models.KialiExposure{
+		Type: models.KialiExposurePortForward,
+		URL:  kialiURL(serverScheme(conf), net.JoinHostPort("localhost", fmt.Sprint(conf.Server.Port))),
+	}
+}
+
+func serverScheme(conf *config.Config) string {
+	if conf.IsServerHTTPS() {
+		return "https"
+	}
+	return "http"
+}
+
+// routesToService returns whether a rule of the HTTPRoute has the Service as backend.
+func routesToService(route *gatewayapi_v1.HTTPRoute, namespace, service string) bool {
+	for _, rule := range route.Spec.Rules {
+		for _, ref := range rule.BackendRefs {
+			if ref.Kind != nil && *ref.Kind != "Service" {
+				continue
+			}
+			refNamespace := route.Namespace
+			if ref.Namespace != nil {
+				refNamespace = string(*ref.Namespace)
+			}
+			if refNamespace == namespace && string(ref.Name) == service {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasHTTPSListener returns whether a parent Gateway of the HTTPRoute has an HTTPS listener.
+func hasHTTPSListener(route *gatewayapi_v1.HTTPRoute, gateways []*gatewayapi_v1.Gateway) bool {
+	for _, parent := range route.Spec.ParentRefs {
+		parentNamespace := route.Namespace
+		if parent.Namespace != nil {
+			parentNamespace = string(*parent.Namespace)
+		}
+		for _, gw := range gateways {
+			if gw.Namespace != parentNamespace || gw.Name != string(parent.Name) {
+				continue
+			}
+			for _, listener := range gw.Spec.Listeners {
+				if listener.Protocol == gatewayapi_v1.HTTPSProtocolType {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// virtualServiceRoutesToService returns whether an HTTP route of the VirtualService has the Service as destination.
+func virtualServiceRoutesToService(vs *networking_v1.VirtualService, namespace, service string) bool {
+	for _, route := range vs.Spec.Http {
+		for _, dest := range route.Route {
+			if dest.Destination == nil {
+				continue
+			}
+			host := dest.Destination.Host
+			if host == service && vs.Namespace == namespace || strings.HasPrefix(host, service+"."+namespace+".") || host == service+"."+namespace {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isGatewayOfVirtualService returns whether the Istio Gateway is referenced by the VirtualService, as name or
+// namespace/name.
+func isGatewayOfVirtualService(gw *networking_v1.Gateway, vs *networking_v1.VirtualService) bool {
+	for _, ref := range vs.Spec.Gateways {
+		if ref == gw.Namespace+"/"+gw.Name || (ref == gw.Name && gw.Namespace == vs.Namespace) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package status
+
+import (
+	"testing"
+
+	osroutes_v1 "github.com/openshift/api/route/v1"
+	"github.com/stretchr/testify/require"
+	api_networking_v1 "istio.io/api/networking/v1"
+	networking_v1 "istio.io/client-go/pkg/apis/networking/v1"
+	core_v1 "k8s.io/api/core/v1"
+	k8s_networking_v1 "k8s.io/api/networking/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kiali/kiali/config"
+	"github.com/kiali/kiali/models"
+)
+
+func TestDetectKialiExposure(t *testing.T) {
+	require := require.New(t)
+
+	conf := config.NewConfig()
+	conf.Deployment.Namespace = "istio-system"
+	conf.Deployment.InstanceName = "kiali"
+	conf.Server.Port = 20001
+	conf.Server.WebRoot = "/kiali"
+
+	meta := func(namespace, name string) meta_v1.ObjectMeta {
+		return meta_v1.ObjectMeta{Name: name, Namespace: namespace}
+	}
+	service := &core_v1.Service{ObjectMeta: meta("istio-system", "kiali")}
+
+	exposure := detectKialiExposure(conf, exposureSources{})
+	require.Equal(models.KialiExposure{Type: models.KialiExposurePortForward, URL: "http://localhost:20001/kiali"}, exposure)
+
+	lbService := service.DeepCopy()
+	lbService.Spec.Type = core_v1.ServiceTypeLoadBalancer
+	lbService.Spec.Ports = []core_v1.ServicePort{{Port: 20001}}
+	lbService.Status.LoadBalancer.Ingress = []core_v1.LoadBalancerIngress{{IP: "10.0.0.1"}}
+	exposure = detectKialiExposure(conf, exposureSources{service: lbService})
+	require.Equal(models.KialiExposureLoadBalancer, exposure.Type)
+	require.Equal("http://10.0.0.1:20001/kiali", exposure.URL)
+
+	vs := &networking_v1.VirtualService{ObjectMeta: meta("istio-system", "kiali")}
+	vs.Spec.Hosts = []string{"*", "kiali.istio.example.com"}
+	vs.Spec.Gateways = []string{"ingress/public"}
+	vs.Spec.Http = []*api_networking_v1.HTTPRoute{{Route: []*api_networking_v1.HTTPRouteDestination{{
+		Destination: &api_networking_v1.Destination{Host: "kiali.istio-system.svc.cluster.local"},
+	}}}}
+	gw := &networking_v1.Gateway{ObjectMeta: meta("ingress", "public")}
+	gw.Spec.Servers = []*api_networking_v1.Server{{Port: &api_networking_v1.Port{Protocol: "HTTPS"}}}
+	sources := exposureSources{service: service, virtualServices: []*networking_v1.VirtualService{vs}, gateways: []*networking_v1.Gateway{gw}}
+	exposure = detectKialiExposure(conf, sources)
+	require.Equal(models.KialiExposure{Type: models.KialiExposureIstioGateway, Resource: "istio-system/kiali", URL: "https://kiali.istio.example.com/kiali"}, exposure)
+
+	gatewayNamespace := gatewayapi_v1.Namespace("gateways")
+	httpRoute := &gatewayapi_v1.HTTPRoute{ObjectMeta: meta("istio-system", "kiali")}
+	httpRoute.Spec.Hostnames = []gatewayapi_v1.Hostname{"kiali.gateway.example.com"}
+	httpRoute.Spec.ParentRefs = []gatewayapi_v1.ParentReference{{Name: "public", Namespace: &gatewayNamespace}}
+	httpRoute.Spec.Rules = []gatewayapi_v1.HTTPRouteRule{{BackendRefs: []gatewayapi_v1.HTTPBackendRef{{
+		BackendRef: gatewayapi_v1.BackendRef{BackendObjectReference: gatewayapi_v1.BackendObjectReference{Name: "kiali"}},
+	}}}}
+	k8sGateway := &gatewayapi_v1.Gateway{ObjectMeta: meta("gateways", "public")}
+	k8sGateway.Spec.Listeners = []gatewayapi_v1.Listener{{Protocol: gatewayapi_v1.HTTPProtocolType}}
+	sources.httpRoutes = []*gatewayapi_v1.HTTPRoute{httpRoute}
+	sources.k8sGateways = []*gatewayapi_v1.Gateway{k8sGateway}
+	exposure = detectKialiExposure(conf, sources)
+	require.Equal(models.KialiExposure{Type: models.KialiExposureGatewayAPI, Resource: "istio-system/kiali", URL: "http://kiali.gateway.example.com/kiali"}, exposure)
+
+	pathType := k8s_networking_v1.PathTypePrefix
+	ingress := k8s_networking_v1.Ingress{ObjectMeta: meta("istio-system", "kiali")}
+	ingress.Spec.TLS = []k8s_networking_v1.IngressTLS{{Hosts: []string{"kiali.example.com"}}}
+	ingress.Spec.Rules = []k8s_networking_v1.IngressRule{{
+		Host: "kiali.example.com",
+		IngressRuleValue: k8s_networking_v1.IngressRuleValue{HTTP: &k8s_networking_v1.HTTPIngressRuleValue{Paths: []k8s_networking_v1.HTTPIngressPath{{
+			Path:     "/kiali",
+			PathType: &pathType,
+			Backend:  k8s_networking_v1.IngressBackend{Service: &k8s_networking_v1.IngressServiceBackend{Name: "kiali"}},
+		}}}},
+	}}
+	sources.ingresses = []k8s_networking_v1.Ingress{ingress}
+	exposure = detectKialiExposure(conf, sources)
+	require.Equal(models.KialiExposure{Type: models.KialiExposureIngress, Resource: "istio-system/kiali", URL: "https://kiali.example.com/kiali"}, exposure)
+
+	route := osroutes_v1.Route{ObjectMeta: meta("istio-system", "kiali")}
+	route.Spec.Host = "kiali-istio-system.apps.example.com"
+	route.Spec.To = osroutes_v1.RouteTargetReference{Kind: "Service", Name: "kiali"}
+	route.Spec.TLS = &osroutes_v1.TLSConfig{Termination: osroutes_v1.TLSTerminationReencrypt}
+	sources.routes = []osroutes_v1.Route{route}
+	exposure = detectKialiExposure(conf, sources)
+	require.Equal(models.KialiExposure{Type: models.KialiExposureRoute, Resource: "istio-system/kiali", URL: "https://kiali-istio-system.apps.example.com/kiali"}, exposure)
+
+	conf.Server.WebFQDN = "kiali.mesh.example.com"
+	conf.Server.WebPort = "8443"
+	exposure = detectKialiExposure(conf, sources)
+	require.Equal(models.KialiExposure{Type: models.KialiExposureConfigured, URL: "https://kiali.mesh.example.com:8443/kiali"}, exposure)
+}
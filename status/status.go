@@ -50,6 +50,10 @@ type StatusInfo struct {
 	//
 	// required: true
 	IstioEnvironment *IstioEnvironment `json:"istioEnvironment"`
+	// How Kiali is exposed and its externally reachable URL
+	//
+	// required: true
+	KialiExposure models.KialiExposure `json:"kialiExposure"`
 }
 
 // addWarningMessages add warning messages to status
@@ -82,6 +86,7 @@ func Get(ctx context.Context, conf *config.Config, clientFactory kubernetes.Clie
 	}
 
 	info.ExternalServices = getVersions(ctx, conf, clientFactory, grafana)
+	info.KialiExposure = GetKialiExposure(ctx, conf, clientFactory, cache)
 
 	return info
 }
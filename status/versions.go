@@ -81,12 +81,7 @@ func tracingVersion(conf *config.Config, homeClusterSAClient kubernetes.ClientIn
 	product.Name = string(tracingConfig.Provider)
 	product.Url = tracingConfig.ExternalURL
 
-	// we want to go to the internal URL to obtain the version. If it isn't specified, fallback to the external URL.
-	versionUrl := tracingConfig.InternalURL
-	if versionUrl == "" {
-		versionUrl = tracingConfig.ExternalURL
-	}
-
+	versionUrl := tracingVersionURL(tracingConfig)
 	if versionUrl != "" {
 		// try to determine version by querying
 		if tracingConfig.Provider == config.JaegerProvider {
@@ -95,50 +90,20 @@ func tracingVersion(conf *config.Config, homeClusterSAClient kubernetes.ClientIn
 				auth.Token = homeClusterSAClient.GetToken()
 			}
 
-			// there is no known way to get the version from GRPC. So we'll try to change the URL to go over HTTP,
-			// but this is not guaranteed to work. But it is worth a try.
-			if tracingConfig.UseGRPC {
-				parsedUrl, err := url.Parse(versionUrl)
-				if err == nil {
-					// strip the port - if the URL is http, it'll go over 80, if https, it'll go over 443
-					if host := parsedUrl.Hostname(); host != "" {
-						parsedUrl.Host = host
-						versionUrl = parsedUrl.String()
-						log.Debugf("Cannot get tracing version via GRPC; will try over HTTP: [%v]", versionUrl)
-					}
-				}
-			}
-
 			body, statusCode, _, err := httputil.HttpGet(versionUrl, &auth, 10*time.Second, nil, nil)
 			if err != nil || statusCode > 399 {
 				log.Infof("jaeger version check failed: url=[%v], code=[%v], err=[%v]", versionUrl, statusCode, err)
 			} else {
-				// Jaeger does not provide api to get version, so it is obtained from js function inside html main page
-				// const JAEGER_VERSION = {"gitCommit: xxx, gitVersion: yyy, buildDate: zzz"}
-				bodyStr := string(body)
-				jaegerVersionConst := "const JAEGER_VERSION = "
-				jsonStartIndex := strings.Index(bodyStr, jaegerVersionConst) + len(jaegerVersionConst)
-				jsonEndIndex := jsonStartIndex + strings.Index(bodyStr[jsonStartIndex:], ";") // version json ends with ;
-				jaegerVersion := bodyStr[jsonStartIndex:jsonEndIndex]
-
-				jaegerV := new(jaegerResponseVersion)
-				err = json.Unmarshal([]byte(jaegerVersion), &jaegerV)
-				if err == nil {
-					product.Version = jaegerV.Version
-				}
+				product.Version = jaegerVersion(body)
 			}
 		} else {
 			// Tempo
 			if tracingConfig.Provider == config.TempoProvider {
-				body, statusCode, _, err := httputil.HttpGet(fmt.Sprintf("%s/api/status/buildinfo", versionUrl), &tracingConfig.Auth, 10*time.Second, nil, nil)
+				body, statusCode, _, err := httputil.HttpGet(versionUrl, &tracingConfig.Auth, 10*time.Second, nil, nil)
 				if err != nil || statusCode > 399 {
 					log.Infof("tempo version check failed: url=[%v], code=[%v], err=[%v]", versionUrl, statusCode, err)
 				} else {
-					tempoV := new(tempoResponseVersion)
-					err = json.Unmarshal(body, &tempoV)
-					if err == nil {
-						product.Version = tempoV.Version
-					}
+					product.Version = tempoVersion(body)
 				}
 			}
 		}
@@ -149,6 +114,69 @@ func tracingVersion(conf *config.Config, homeClusterSAClient kubernetes.ClientIn
 	return &product, nil
 }
 
+// tracingVersionURL returns the url of the tracing provider from which the version can be obtained.
+func tracingVersionURL(tracingConfig config.TracingConfig) string {
+	// we want to go to the internal URL to obtain the version. If it isn't specified, fallback to the external URL.
+	versionUrl := tracingConfig.InternalURL
+	if versionUrl == "" {
+		versionUrl = tracingConfig.ExternalURL
+	}
+	if versionUrl == "" {
+		return ""
+	}
+
+	if tracingConfig.Provider == config.TempoProvider {
+		return fmt.Sprintf("%s/api/status/buildinfo", versionUrl)
+	}
+
+	// there is no known way to get the version from GRPC. So we'll try to change the URL to go over HTTP,
+	// but this is not guaranteed to work. But it is worth a try.
+	if tracingConfig.UseGRPC {
+		parsedUrl, err := url.Parse(versionUrl)
+		if err == nil {
+			// strip the port - if the URL is http, it'll go over 80, if https, it'll go over 443
+			if host := parsedUrl.Hostname(); host != "" {
+				parsedUrl.Host = host
+				versionUrl = parsedUrl.String()
+				log.Debugf("Cannot get tracing version via GRPC; will try over HTTP: [%v]", versionUrl)
+			}
+		}
+	}
+	return versionUrl
+}
+
+// jaegerVersion returns the version of Jaeger found in the body of its main page, if any.
+func jaegerVersion(body []byte) string {
+	// Jaeger does not provide api to get version, so it is obtained from js function inside html main page
+	// const JAEGER_VERSION = {"gitCommit: xxx, gitVersion: yyy, buildDate: zzz"}
+	bodyStr := string(body)
+	jaegerVersionConst := "const JAEGER_VERSION = "
+	constIndex := strings.Index(bodyStr, jaegerVersionConst)
+	if constIndex < 0 {
+		return ""
+	}
+	jsonStartIndex := constIndex + len(jaegerVersionConst)
+	jsonLength := strings.Index(bodyStr[jsonStartIndex:], ";") // version json ends with ;
+	if jsonLength < 0 {
+		return ""
+	}
+
+	jaegerV := new(jaegerResponseVersion)
+	if err := json.Unmarshal([]byte(bodyStr[jsonStartIndex:jsonStartIndex+jsonLength]), &jaegerV); err != nil {
+		return ""
+	}
+	return jaegerV.Version
+}
+
+// tempoVersion returns the version of Tempo found in the body of its build info, if any.
+func tempoVersion(body []byte) string {
+	tempoV := new(tempoResponseVersion)
+	if err := json.Unmarshal(body, &tempoV); err != nil {
+		return ""
+	}
+	return tempoV.Version
+}
+
 type grafanaBuildInfo struct {
 	Version string `json:"version"`
 }
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -152,14 +153,48 @@ func CreateTransport(auth *config.Auth, transportConfig *http.Transport, timeout
 		if tlscfg != nil {
 			transportConfig.TLSClientConfig = tlscfg
 		}
+		if auth.HTTPProxy != "" || auth.HTTPSProxy != "" {
+			proxy, err := proxyFunc(auth.HTTPProxy, auth.HTTPSProxy)
+			if err != nil {
+				return nil, err
+			}
+			transportConfig.Proxy = proxy
+		}
 		outerRoundTripper = newAuthRoundTripper(auth, outerRoundTripper)
 	}
 
 	return outerRoundTripper, nil
 }
 
+// proxyFunc returns the proxy of the requests, chosen by the scheme of their URL.
+// The proxy of the environment is used for the schemes without proxy.
+func proxyFunc(httpProxy, httpsProxy string) (func(req *http.Request) (*url.URL, error), error) {
+	proxies := map[string]*url.URL{}
+	for scheme, proxy := range map[string]string{"http": httpProxy, "https": httpsProxy} {
+		if proxy == "" {
+			continue
+		}
+		proxyURL, err := url.Parse(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s proxy [%s]: %s", scheme, proxy, err)
+		}
+		proxies[scheme] = proxyURL
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		if proxyURL, found := proxies[req.URL.Scheme]; found {
+			return proxyURL, nil
+		}
+		return http.ProxyFromEnvironment(req)
+	}, nil
+}
+
 func GetTLSConfig(auth *config.Auth) (*tls.Config, error) {
-	if auth.InsecureSkipVerify || auth.CAFile != "" {
+	if auth.CertFile != "" || auth.KeyFile != "" {
+		if auth.CertFile == "" || auth.KeyFile == "" {
+			return nil, fmt.Errorf("both the client certificate and key files must be set")
+		}
+	}
+	if auth.InsecureSkipVerify || auth.CAFile != "" || auth.CertFile != "" {
 		var certPool *x509.CertPool
 		if auth.CAFile != "" {
 			certPool = x509.NewCertPool()
@@ -172,7 +207,16 @@ func GetTLSConfig(auth *config.Auth) (*tls.Config, error) {
 				return nil, fmt.Errorf("supplied CA file could not be parsed")
 			}
 		}
+		var certificates []tls.Certificate
+		if auth.CertFile != "" {
+			certificate, err := tls.LoadX509KeyPair(auth.CertFile, auth.KeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load the client certificate: %s", err)
+			}
+			certificates = append(certificates, certificate)
+		}
 		return &tls.Config{
+			Certificates:       certificates,
 			InsecureSkipVerify: auth.InsecureSkipVerify,
 			RootCAs:            certPool,
 		}, nil
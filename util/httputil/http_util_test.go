@@ -146,3 +146,25 @@ func TestHTTPPostSendsPostRequest(t *testing.T) {
 	_, _, _, err := httputil.HttpPost(server.URL, nil, nil, time.Second, nil)
 	assert.NoError(err)
 }
+
+func TestHTTPGetUsesConfiguredProxy(t *testing.T) {
+	assert := assert.New(t)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Requests sent to a proxy carry the absolute URL of the target
+		assert.Equal("external.example", r.URL.Host)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(proxy.Close)
+
+	_, code, _, err := httputil.HttpGet("http://external.example/api", &config.Auth{HTTPProxy: proxy.URL}, time.Second, nil, nil)
+	assert.NoError(err)
+	assert.Equal(http.StatusNoContent, code)
+}
+
+func TestGetTLSConfigRequiresClientCertAndKey(t *testing.T) {
+	_, err := httputil.GetTLSConfig(&config.Auth{CertFile: "/tmp/client.crt"})
+	assert.Error(t, err)
+
+	_, err = httputil.GetTLSConfig(&config.Auth{CertFile: "/does/not/exist.crt", KeyFile: "/does/not/exist.key"})
+	assert.Error(t, err)
+}